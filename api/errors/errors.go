@@ -28,6 +28,12 @@ var ErrGetValueForKey = errors.New("get value for key error")
 // ErrGetKeyValuePairs signals an error in getting the key-value pairs of a key for an account
 var ErrGetKeyValuePairs = errors.New("get key-value pairs error")
 
+// ErrGetAccountHistory signals an error in getting the history of an account
+var ErrGetAccountHistory = errors.New("get account history error")
+
+// ErrInvalidPageSize signals that an invalid page size has been provided
+var ErrInvalidPageSize = errors.New("invalid page size")
+
 // ErrGetESDTBalance signals an error in getting esdt balance for given address
 var ErrGetESDTBalance = errors.New("get esdt balance for account error")
 
@@ -64,6 +70,9 @@ var ErrTxGenerationFailed = errors.New("transaction generation failed")
 // ErrValidationEmptyTxHash signals that an empty tx hash was provided
 var ErrValidationEmptyTxHash = errors.New("TxHash is empty")
 
+// ErrValidationEmptyTxsBundle signals that an empty transactions bundle was provided
+var ErrValidationEmptyTxsBundle = errors.New("transactions bundle is empty")
+
 // ErrInvalidBlockNonce signals that an invalid block nonce was provided
 var ErrInvalidBlockNonce = errors.New("invalid block nonce")
 
@@ -79,6 +88,9 @@ var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
 // ErrGetTransaction signals an error happening when trying to fetch a transaction
 var ErrGetTransaction = errors.New("getting transaction failed")
 
+// ErrTraceTransaction signals an error happening when trying to trace a transaction
+var ErrTraceTransaction = errors.New("tracing transaction failed")
+
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
@@ -109,6 +121,12 @@ var ErrValidationEmptyAddress = errors.New("address is empty")
 // ErrValidationEmptyKey signals that an empty key was provided
 var ErrValidationEmptyKey = errors.New("key is empty")
 
+// ErrValidationEmptyKeys signals that an empty list of keys was provided
+var ErrValidationEmptyKeys = errors.New("keys list is empty")
+
+// ErrTooManyKeys signals that too many keys were provided in a single request
+var ErrTooManyKeys = errors.New("too many keys")
+
 // ErrGetProof signals an error happening when trying to compute a Merkle proof
 var ErrGetProof = errors.New("getting proof failed")
 
@@ -151,6 +169,12 @@ var ErrFetchingLatestNonceCannotIncludeFields = errors.New("fetching latest nonc
 // ErrFetchingNonceGapsCannotIncludeFields signals that an error happened when trying to fetch nonce gaps
 var ErrFetchingNonceGapsCannotIncludeFields = errors.New("fetching nonce gaps cannot include fields")
 
+// ErrTransactionsPoolFilterCannotIncludeSender signals that filtering the transactions pool by receiver or function was requested together with a sender
+var ErrTransactionsPoolFilterCannotIncludeSender = errors.New("filtering by receiver or function cannot be combined with by-sender")
+
+// ErrEmptySenderToGetPoolDetails signals that an empty sender was provided when trying to fetch the pool details for sender
+var ErrEmptySenderToGetPoolDetails = errors.New("empty sender to get pool details")
+
 // ErrInvalidFields signals that invalid fields were provided
 var ErrInvalidFields = errors.New("invalid fields")
 
@@ -174,3 +198,21 @@ var ErrGetWaitingManagedKeys = errors.New("error getting the waiting managed key
 
 // ErrGetWaitingEpochsLeftForPublicKey signals that an error occurred while getting the waiting epochs left for public key
 var ErrGetWaitingEpochsLeftForPublicKey = errors.New("error getting the waiting epochs left for public key")
+
+// ErrGetStateDiff signals that an error occurred while getting the state diff between two blocks
+var ErrGetStateDiff = errors.New("error getting the state diff")
+
+// ErrGetTrieStatistics signals that an error occurred while getting the trie statistics
+var ErrGetTrieStatistics = errors.New("error getting the trie statistics")
+
+// ErrUnauthorized signals that the request does not hold valid admin credentials
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrAddManagedKey signals an error in adding a managed key
+var ErrAddManagedKey = errors.New("error adding the managed key")
+
+// ErrRemoveManagedKey signals an error in removing a managed key
+var ErrRemoveManagedKey = errors.New("error removing the managed key")
+
+// ErrStepDownRedundancyLease signals an error in releasing the redundancy lease
+var ErrStepDownRedundancyLease = errors.New("error stepping down from the redundancy lease")
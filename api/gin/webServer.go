@@ -192,6 +192,12 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["proof"] = proofGroup
 
+	stateGroup, err := groups.NewStateGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["state"] = stateGroup
+
 	transactionGroup, err := groups.NewTransactionGroup(ws.facade)
 	if err != nil {
 		return err
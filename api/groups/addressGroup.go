@@ -13,7 +13,9 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
 	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 const (
@@ -24,6 +26,8 @@ const (
 	getCodeHashPath                = "/:address/code-hash"
 	getKeysPath                    = "/:address/keys"
 	getKeyPath                     = "/:address/key/:key"
+	getAccountHistoryPath          = "/:address/history"
+	getAccountHistoryEndpoint      = "/address/:address/history"
 	getDataTrieMigrationStatusPath = "/:address/is-data-trie-migrated"
 	getESDTTokensPath              = "/:address/esdt"
 	getESDTBalancePath             = "/:address/esdt/:tokenIdentifier"
@@ -39,6 +43,13 @@ const (
 	urlParamBlockRootHash          = "blockRootHash"
 	urlParamHintEpoch              = "hintEpoch"
 	urlParamWithKeys               = "withKeys"
+	urlParamFromKey                = "fromKey"
+	urlParamPageSize               = "pageSize"
+	urlParamKeyPrefix              = "keyPrefix"
+	defaultKeysPageSize            = 1000
+	maxKeysPageSize                = 10000
+	defaultHistoryPageSize         = 100
+	maxHistoryPageSize             = 1000
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
@@ -55,8 +66,11 @@ type addressFacadeHandler interface {
 	GetESDTsWithRole(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetAllESDTTokens(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetKeyValuePairsPage(address string, options api.AccountQueryOptions, startKey []byte, pageSize int, keyPrefix []byte) (*common.KeyValuePairsPage, api.BlockInfo, error)
+	GetAccountHistory(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryAPIResponse, error)
 	GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
 
@@ -132,6 +146,17 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ag.getKeyValuePairs,
 		},
+		{
+			Path:    getAccountHistoryPath,
+			Method:  http.MethodGet,
+			Handler: ag.getAccountHistory,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getAccountHistoryEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 		{
 			Path:    getESDTBalancePath,
 			Method:  http.MethodGet,
@@ -325,7 +350,8 @@ func (ag *addressGroup) getGuardianData(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"guardianData": guardianData, "blockInfo": blockInfo})
 }
 
-// addressGroup returns all the key-value pairs for the given address
+// getKeyValuePairs returns the key-value pairs for the given address. All the pairs are returned at once, unless
+// any of the pagination parameters is provided
 func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 	addr, options, err := extractBaseParams(c)
 	if err != nil {
@@ -333,6 +359,11 @@ func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 		return
 	}
 
+	if isKeyValuePairsPageRequest(c) {
+		ag.getKeyValuePairsPage(c, addr, options)
+		return
+	}
+
 	value, blockInfo, err := ag.getFacade().GetKeyValuePairs(addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, err)
@@ -342,6 +373,106 @@ func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"pairs": value, "blockInfo": blockInfo})
 }
 
+func (ag *addressGroup) getKeyValuePairsPage(c *gin.Context, addr string, options api.AccountQueryOptions) {
+	fromKey, pageSize, keyPrefix, err := extractKeyValuePairsPageParams(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetKeyValuePairs, err)
+		return
+	}
+
+	page, blockInfo, err := ag.getFacade().GetKeyValuePairsPage(addr, options, fromKey, pageSize, keyPrefix)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"pairs": page.Pairs, "nextKey": page.NextKey, "blockInfo": blockInfo})
+}
+
+func isKeyValuePairsPageRequest(c *gin.Context) bool {
+	query := c.Request.URL.Query()
+	return query.Has(urlParamFromKey) || query.Has(urlParamPageSize) || query.Has(urlParamKeyPrefix)
+}
+
+func extractKeyValuePairsPageParams(c *gin.Context) ([]byte, int, []byte, error) {
+	fromKey, err := parseHexBytesUrlParam(c, urlParamFromKey)
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+
+	keyPrefix, err := parseHexBytesUrlParam(c, urlParamKeyPrefix)
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+
+	pageSize, err := parseUint32UrlParam(c, urlParamPageSize)
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+	if !pageSize.HasValue {
+		return fromKey, defaultKeysPageSize, keyPrefix, nil
+	}
+	if pageSize.Value == 0 || pageSize.Value > maxKeysPageSize {
+		return nil, 0, nil, fmt.Errorf("%w: %v, provided: %d, maximum: %d", errors.ErrBadUrlParams, errors.ErrInvalidPageSize, pageSize.Value, maxKeysPageSize)
+	}
+
+	return fromKey, int(pageSize.Value), keyPrefix, nil
+}
+
+// getAccountHistory returns, newest first, a page of the balances and nonces recorded for the given address
+func (ag *addressGroup) getAccountHistory(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountHistory, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := extractAccountHistoryQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountHistory, err)
+		return
+	}
+
+	history, err := ag.getFacade().GetAccountHistory(addr, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAccountHistory, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"history": history})
+}
+
+func extractAccountHistoryQueryOptions(c *gin.Context) (common.AccountHistoryQueryOptions, error) {
+	fromNonce, err := parseUint64UrlParam(c, urlParamFromNonce)
+	if err != nil {
+		return common.AccountHistoryQueryOptions{}, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, urlParamFromNonce)
+	}
+
+	toNonce, err := parseUint64UrlParam(c, urlParamToNonce)
+	if err != nil {
+		return common.AccountHistoryQueryOptions{}, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, urlParamToNonce)
+	}
+
+	pageSize, err := parseUint32UrlParam(c, urlParamPageSize)
+	if err != nil {
+		return common.AccountHistoryQueryOptions{}, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+	options := common.AccountHistoryQueryOptions{
+		FromNonce: fromNonce.Value,
+		ToNonce:   toNonce,
+		PageSize:  defaultHistoryPageSize,
+	}
+	if !pageSize.HasValue {
+		return options, nil
+	}
+	if pageSize.Value == 0 || pageSize.Value > maxHistoryPageSize {
+		return common.AccountHistoryQueryOptions{}, fmt.Errorf("%w: %v, provided: %d, maximum: %d", errors.ErrBadUrlParams, errors.ErrInvalidPageSize, pageSize.Value, maxHistoryPageSize)
+	}
+	options.PageSize = int(pageSize.Value)
+
+	return options, nil
+}
+
 // getESDTBalance returns the balance for the given address and esdt token
 func (ag *addressGroup) getESDTBalance(c *gin.Context) {
 	addr, tokenIdentifier, options, err := extractGetESDTBalanceParams(c)
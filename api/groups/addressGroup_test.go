@@ -3,6 +3,7 @@ package groups_test
 import (
 	"bytes"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,12 +14,14 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -116,7 +119,8 @@ type esdtTokensCompleteResponse struct {
 }
 
 type keyValuePairsResponseData struct {
-	Pairs map[string]string `json:"pairs"`
+	Pairs   map[string]string `json:"pairs"`
+	NextKey string            `json:"nextKey"`
 }
 
 type keyValuePairsResponse struct {
@@ -125,6 +129,27 @@ type keyValuePairsResponse struct {
 	Code  string
 }
 
+type keyValuePairsPageResponseData struct {
+	Pairs   []common.KeyValuePair `json:"pairs"`
+	NextKey string                `json:"nextKey"`
+}
+
+type keyValuePairsPageResponse struct {
+	Data  keyValuePairsPageResponseData `json:"data"`
+	Error string                        `json:"error"`
+	Code  string
+}
+
+type accountHistoryResponseData struct {
+	History common.AccountHistoryAPIResponse `json:"history"`
+}
+
+type accountHistoryResponse struct {
+	Data  accountHistoryResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string
+}
+
 type esdtRolesResponseData struct {
 	Roles map[string][]string `json:"roles"`
 }
@@ -660,6 +685,211 @@ func TestAddressGroup_getKeyValuePairs(t *testing.T) {
 		)
 		assert.Equal(t, pairs, response.Data.Pairs)
 	})
+	t.Run("invalid fromKey should error",
+		testErrorScenario("/address/erd1alice/keys?fromKey=not-hex", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetKeyValuePairs, apiErrors.ErrBadUrlParams)))
+	t.Run("invalid keyPrefix should error",
+		testErrorScenario("/address/erd1alice/keys?keyPrefix=not-hex", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetKeyValuePairs, apiErrors.ErrBadUrlParams)))
+	t.Run("invalid pageSize should error",
+		testErrorScenario("/address/erd1alice/keys?pageSize=not-uint", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetKeyValuePairs, apiErrors.ErrBadUrlParams)))
+	t.Run("zero pageSize should error",
+		testErrorScenario("/address/erd1alice/keys?pageSize=0", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetKeyValuePairs, apiErrors.ErrBadUrlParams)))
+	t.Run("too big pageSize should error",
+		testErrorScenario("/address/erd1alice/keys?pageSize=10001", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetKeyValuePairs, apiErrors.ErrBadUrlParams)))
+	t.Run("page with node fail should err", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetKeyValuePairsPageCalled: func(_ string, _ api.AccountQueryOptions, _ []byte, _ int, _ []byte) (*common.KeyValuePairsPage, api.BlockInfo, error) {
+				return nil, api.BlockInfo{}, expectedErr
+			},
+		}
+		testAddressGroup(
+			t,
+			facade,
+			"/address/erd1alice/keys?pageSize=10",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			formatExpectedErr(apiErrors.ErrGetKeyValuePairs, expectedErr),
+		)
+	})
+	t.Run("page should use the default page size", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetKeyValuePairsPageCalled: func(_ string, _ api.AccountQueryOptions, startKey []byte, pageSize int, keyPrefix []byte) (*common.KeyValuePairsPage, api.BlockInfo, error) {
+				assert.Equal(t, []byte("next"), startKey)
+				assert.Equal(t, 1000, pageSize)
+				assert.Nil(t, keyPrefix)
+				return &common.KeyValuePairsPage{}, api.BlockInfo{}, nil
+			},
+		}
+
+		response := &keyValuePairsPageResponse{}
+		loadAddressGroupResponse(
+			t,
+			facade,
+			"/address/erd1alice/keys?fromKey="+hex.EncodeToString([]byte("next")),
+			"GET",
+			nil,
+			response,
+		)
+		assert.Empty(t, response.Data.Pairs)
+		assert.Empty(t, response.Data.NextKey)
+	})
+	t.Run("page should work", func(t *testing.T) {
+		t.Parallel()
+
+		pairs := []common.KeyValuePair{
+			{Key: "6b32", Value: "7632"},
+			{Key: "6b31", Value: "7631"},
+		}
+		facade := &mock.FacadeStub{
+			GetKeyValuePairsCalled: func(_ string, _ api.AccountQueryOptions) (map[string]string, api.BlockInfo, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, api.BlockInfo{}, nil
+			},
+			GetKeyValuePairsPageCalled: func(_ string, _ api.AccountQueryOptions, startKey []byte, pageSize int, keyPrefix []byte) (*common.KeyValuePairsPage, api.BlockInfo, error) {
+				assert.Nil(t, startKey)
+				assert.Equal(t, 2, pageSize)
+				assert.Equal(t, []byte("k"), keyPrefix)
+				return &common.KeyValuePairsPage{
+					Pairs:   pairs,
+					NextKey: "abcd",
+				}, api.BlockInfo{}, nil
+			},
+		}
+
+		response := &keyValuePairsPageResponse{}
+		loadAddressGroupResponse(
+			t,
+			facade,
+			"/address/erd1alice/keys?pageSize=2&keyPrefix="+hex.EncodeToString([]byte("k")),
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, pairs, response.Data.Pairs)
+		assert.Equal(t, "abcd", response.Data.NextKey)
+	})
+}
+
+func TestAddressGroup_getAccountHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should error",
+		testErrorScenario("/address//history", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAccountHistory, apiErrors.ErrEmptyAddress)))
+	t.Run("invalid fromNonce should error",
+		testErrorScenario("/address/erd1alice/history?fromNonce=not-uint64", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAccountHistory, apiErrors.ErrBadUrlParams)))
+	t.Run("invalid toNonce should error",
+		testErrorScenario("/address/erd1alice/history?toNonce=not-uint64", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAccountHistory, apiErrors.ErrBadUrlParams)))
+	t.Run("zero pageSize should error",
+		testErrorScenario("/address/erd1alice/history?pageSize=0", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAccountHistory, apiErrors.ErrBadUrlParams)))
+	t.Run("too big pageSize should error",
+		testErrorScenario("/address/erd1alice/history?pageSize=1001", "GET", nil,
+			formatExpectedErr(apiErrors.ErrGetAccountHistory, apiErrors.ErrBadUrlParams)))
+	t.Run("too many requests should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				assert.Equal(t, "/address/:address/history", endpoint)
+				return &mock.ThrottlerStub{
+					CanProcessCalled: func() bool { return false },
+				}, true
+			},
+		}
+		testAddressGroup(
+			t,
+			facade,
+			"/address/erd1alice/history",
+			"GET",
+			nil,
+			http.StatusTooManyRequests,
+			apiErrors.ErrTooManyRequests.Error(),
+		)
+	})
+	t.Run("with node fail should err", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountHistoryCalled: func(_ string, _ common.AccountHistoryQueryOptions) (*common.AccountHistoryAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testAddressGroup(
+			t,
+			facade,
+			"/address/erd1alice/history",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			formatExpectedErr(apiErrors.ErrGetAccountHistory, expectedErr),
+		)
+	})
+	t.Run("should use the default options", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetAccountHistoryCalled: func(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryAPIResponse, error) {
+				assert.Equal(t, "erd1alice", address)
+				assert.Equal(t, common.AccountHistoryQueryOptions{PageSize: 100}, options)
+				return &common.AccountHistoryAPIResponse{}, nil
+			},
+		}
+
+		response := &accountHistoryResponse{}
+		loadAddressGroupResponse(
+			t,
+			facade,
+			"/address/erd1alice/history",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Empty(t, response.Data.History.Entries)
+		assert.False(t, response.Data.History.HasMore)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedHistory := common.AccountHistoryAPIResponse{
+			Entries: []*common.AccountHistoryEntryAPIResponse{
+				{BlockNonce: 20, BlockHash: "abcd", Balance: "100", Nonce: 3},
+				{BlockNonce: 15, BlockHash: "dcba", Balance: "200", Nonce: 2},
+			},
+			HasMore:     true,
+			NextToNonce: 14,
+		}
+		facade := &mock.FacadeStub{
+			GetAccountHistoryCalled: func(_ string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryAPIResponse, error) {
+				assert.Equal(t, uint64(10), options.FromNonce)
+				assert.Equal(t, core.OptionalUint64{Value: 20, HasValue: true}, options.ToNonce)
+				assert.Equal(t, 2, options.PageSize)
+				return &expectedHistory, nil
+			},
+		}
+
+		response := &accountHistoryResponse{}
+		loadAddressGroupResponse(
+			t,
+			facade,
+			"/address/erd1alice/history?fromNonce=10&toNonce=20&pageSize=2",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, expectedHistory, response.Data.History)
+	})
 }
 
 func TestAddressGroup_getESDTBalance(t *testing.T) {
@@ -1143,6 +1373,7 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/username", Open: true},
 					{Name: "/:address/code-hash", Open: true},
 					{Name: "/:address/keys", Open: true},
+					{Name: "/:address/history", Open: true},
 					{Name: "/:address/key/:key", Open: true},
 					{Name: "/:address/esdt", Open: true},
 					{Name: "/:address/esdts/roles", Open: true},
@@ -1,6 +1,7 @@
 package groups
 
 import (
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"sync"
@@ -9,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/debug"
@@ -18,9 +20,12 @@ import (
 
 const (
 	pidQueryParam             = "pid"
+	rootHashQueryParam        = "rootHash"
+	prometheusContentType     = "text/plain; version=0.0.4; charset=utf-8"
 	debugPath                 = "/debug"
 	heartbeatStatusPath       = "/heartbeatstatus"
 	metricsPath               = "/metrics"
+	prometheusMetricsPath     = "/metrics/prometheus"
 	p2pStatusPath             = "/p2pstatus"
 	peerInfoPath              = "/peerinfo"
 	statusPath                = "/status"
@@ -32,7 +37,11 @@ const (
 	managedKeysCount          = "/managed-keys/count"
 	eligibleManagedKeys       = "/managed-keys/eligible"
 	waitingManagedKeys        = "/managed-keys/waiting"
+	addManagedKeyPath         = "/managed-keys/add"
+	removeManagedKeyPath      = "/managed-keys/remove"
+	redundancyStepDownPath    = "/redundancy/step-down"
 	epochsLeftInWaiting       = "/waiting-epochs-left/:key"
+	trieStatisticsPath        = "/trie-statistics"
 )
 
 // nodeFacadeHandler defines the methods to be implemented by a facade for node requests
@@ -40,6 +49,7 @@ type nodeFacadeHandler interface {
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
+	GetInterceptorResolverDebugCounters() []*debug.InterceptorResolverCounters
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetwork() (string, error)
@@ -49,6 +59,10 @@ type nodeFacadeHandler interface {
 	GetEligibleManagedKeys() ([]string, error)
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
+	GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error)
+	AddManagedKey(key string) (string, error)
+	RemoveManagedKey(publicKey string) error
+	StepDownRedundancyLease() error
 	IsInterfaceNil() bool
 }
 
@@ -58,6 +72,17 @@ type QueryDebugRequest struct {
 	Search string `form:"search" json:"search"`
 }
 
+// AddManagedKeyRequest represents the structure of the request used to add a new managed key. The key is the hex
+// encoded private key, or the hex encoded public key if the node uses a remote signer
+type AddManagedKeyRequest struct {
+	Key string `json:"key"`
+}
+
+// RemoveManagedKeyRequest represents the structure of the request used to remove a managed key
+type RemoveManagedKeyRequest struct {
+	PublicKey string `json:"publicKey"`
+}
+
 type nodeGroup struct {
 	*baseGroup
 	facade    nodeFacadeHandler
@@ -96,6 +121,11 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.prometheusMetrics,
 		},
+		{
+			Path:    prometheusMetricsPath,
+			Method:  http.MethodGet,
+			Handler: ng.typedPrometheusMetrics,
+		},
 		{
 			Path:    debugPath,
 			Method:  http.MethodPost,
@@ -151,6 +181,44 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.waitingEpochsLeft,
 		},
+		{
+			Path:    trieStatisticsPath,
+			Method:  http.MethodGet,
+			Handler: ng.trieStatistics,
+		},
+		{
+			Path:    addManagedKeyPath,
+			Method:  http.MethodPost,
+			Handler: ng.addManagedKey,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateAdminAuthorizationFromFacade(facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    removeManagedKeyPath,
+			Method:  http.MethodPost,
+			Handler: ng.removeManagedKey,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateAdminAuthorizationFromFacade(facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    redundancyStepDownPath,
+			Method:  http.MethodPost,
+			Handler: ng.redundancyStepDown,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateAdminAuthorizationFromFacade(facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -342,6 +410,31 @@ func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
 	)
 }
 
+// typedPrometheusMetrics is the endpoint which will return all the numeric metrics, including the p2p and the
+// interceptor-resolver debug ones, as typed and labeled metrics in the prometheus text exposition format
+func (ng *nodeGroup) typedPrometheusMetrics(c *gin.Context) {
+	nodeFacade := ng.getFacade()
+	debugCounters := nodeFacade.GetInterceptorResolverDebugCounters()
+	metrics, err := nodeFacade.StatusMetrics().StatusMetricsPrometheusString(debugCounters)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.Header("Content-Type", prometheusContentType)
+	c.String(
+		http.StatusOK,
+		metrics,
+	)
+}
+
 // bootstrapMetrics returns the node's bootstrap statistics exported by a StatusMetricsHandler
 func (ng *nodeGroup) bootstrapMetrics(c *gin.Context) {
 	metrics, err := ng.getFacade().StatusMetrics().BootstrapMetrics()
@@ -479,6 +572,72 @@ func (ng *nodeGroup) waitingEpochsLeft(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"epochsLeft": epochsLeft})
 }
 
+// trieStatistics starts collecting the statistics of the state trie with the provided root hash and returns the state
+// of the collecting job. The endpoint is polled with the same root hash until the report is ready
+func (ng *nodeGroup) trieStatistics(c *gin.Context) {
+	rootHash := c.Request.URL.Query().Get(rootHashQueryParam)
+	_, err := hex.DecodeString(rootHash)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetTrieStatistics, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, rootHashQueryParam))
+		return
+	}
+
+	trieStatistics, err := ng.getFacade().GetTrieStatistics(rootHash)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetTrieStatistics, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"trieStatistics": trieStatistics})
+}
+
+// addManagedKey adds a new managed key while the node is running
+func (ng *nodeGroup) addManagedKey(c *gin.Context) {
+	request := AddManagedKeyRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrAddManagedKey, err)
+		return
+	}
+
+	publicKey, err := ng.getFacade().AddManagedKey(request.Key)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrAddManagedKey, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"publicKey": publicKey})
+}
+
+// removeManagedKey removes a managed key while the node is running
+func (ng *nodeGroup) removeManagedKey(c *gin.Context) {
+	request := RemoveManagedKeyRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrRemoveManagedKey, err)
+		return
+	}
+
+	err = ng.getFacade().RemoveManagedKey(request.PublicKey)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrRemoveManagedKey, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{})
+}
+
+// redundancyStepDown releases the redundancy lease held by the node, so another instance can take over the signing
+func (ng *nodeGroup) redundancyStepDown(c *gin.Context) {
+	err := ng.getFacade().StepDownRedundancyLease()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrStepDownRedundancyLease, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{})
+}
+
 func (ng *nodeGroup) getFacade() nodeFacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
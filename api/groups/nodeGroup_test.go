@@ -109,6 +109,20 @@ type waitingEpochsLeftResponse struct {
 	generalResponse
 }
 
+type trieStatisticsResponse struct {
+	Data struct {
+		TrieStatistics *common.TrieStatisticsAPIResponse `json:"trieStatistics"`
+	} `json:"data"`
+	generalResponse
+}
+
+type addManagedKeyResponse struct {
+	Data struct {
+		PublicKey string `json:"publicKey"`
+	} `json:"data"`
+	generalResponse
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -714,6 +728,70 @@ func TestPrometheusMetrics_ShouldWork(t *testing.T) {
 	assert.True(t, keyAndValueFoundInResponse)
 }
 
+func TestTypedPrometheusMetrics_ShouldReturnErrorIfFacadeReturnsError(t *testing.T) {
+	facade := mock.FacadeStub{
+		StatusMetricsHandler: func() external.StatusMetricsHandler {
+			return &testscommon.StatusMetricsStub{
+				StatusMetricsPrometheusStringCalled: func(_ []*debug.InterceptorResolverCounters) (string, error) {
+					return "", expectedErr
+				},
+			}
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/metrics/prometheus", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, expectedErr.Error(), response.Error)
+}
+
+func TestTypedPrometheusMetrics_ShouldWork(t *testing.T) {
+	statusMetricsProvider := statusHandler.NewStatusMetrics()
+	statusMetricsProvider.SetUInt64Value(common.MetricCountConsensus, 37)
+
+	facade := mock.FacadeStub{}
+	facade.StatusMetricsHandler = func() external.StatusMetricsHandler {
+		return statusMetricsProvider
+	}
+	facade.GetInterceptorResolverDebugCountersCalled = func() []*debug.InterceptorResolverCounters {
+		return []*debug.InterceptorResolverCounters{
+			{
+				EventType: "request",
+				Topic:     "topic",
+				NumEvents: 2,
+			},
+		}
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/metrics/prometheus", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	respBytes, _ := io.ReadAll(resp.Body)
+	respStr := string(respBytes)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", resp.Header().Get("Content-Type"))
+	assert.Contains(t, respStr, "# TYPE erd_count_consensus counter\n")
+	assert.Contains(t, respStr, `erd_count_consensus{erd_shard_id="0",erd_epoch_number="0",erd_peer_type=""} 37`)
+	assert.Contains(t, respStr, `erd_debug_interceptor_resolver_num_events{erd_shard_id="0",erd_epoch_number="0",erd_peer_type="",event_type="request",topic="topic"} 2`)
+}
+
 func TestNodeGroup_ManagedKeysCount(t *testing.T) {
 	t.Parallel()
 
@@ -974,6 +1052,463 @@ func TestNodeGroup_WaitingEpochsLeft(t *testing.T) {
 	})
 }
 
+func TestNodeGroup_TrieStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetTrieStatisticsCalled: func(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/trie-statistics?rootHash=not-hex", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTrieStatistics.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetTrieStatisticsCalled: func(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/trie-statistics", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedResponse := &common.TrieStatisticsAPIResponse{
+			RootHash: "abcd",
+			Status:   common.TrieStatisticsJobFinished,
+			NumTries: 2,
+			NumNodes: 10,
+			Report: &common.TriesStatisticsReport{
+				NumNodes:  10,
+				TotalSize: 100,
+			},
+		}
+		facade := mock.FacadeStub{
+			GetTrieStatisticsCalled: func(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+				assert.Equal(t, "abcd", rootHash)
+				return providedResponse, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/trie-statistics?rootHash=abcd", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &trieStatisticsResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, providedResponse, response.Data.TrieStatistics)
+	})
+}
+
+func TestNodeGroup_AddManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminAuthorizedCalled: func(username string, password string) bool {
+				require.Fail(t, "should have not been called")
+				return true
+			},
+			AddManagedKeyCalled: func(key string) (string, error) {
+				require.Fail(t, "should have not been called")
+				return "", nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/managed-keys/add", bytes.NewBuffer([]byte(`{"key":"abcd"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Equal(t, apiErrors.ErrUnauthorized.Error(), response.Error)
+	})
+	t.Run("wrong credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminAuthorizedCalled: func(username string, password string) bool {
+				return false
+			},
+			AddManagedKeyCalled: func(key string) (string, error) {
+				require.Fail(t, "should have not been called")
+				return "", nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/managed-keys/add", bytes.NewBuffer([]byte(`{"key":"abcd"}`)))
+		req.SetBasicAuth("admin", "wrong password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Equal(t, apiErrors.ErrUnauthorized.Error(), response.Error)
+	})
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminAuthorizedCalled: func(username string, password string) bool {
+				return true
+			},
+			AddManagedKeyCalled: func(key string) (string, error) {
+				require.Fail(t, "should have not been called")
+				return "", nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/managed-keys/add", bytes.NewBuffer([]byte("invalid")))
+		req.SetBasicAuth("admin", "password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrAddManagedKey.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminAuthorizedCalled: func(username string, password string) bool {
+				return true
+			},
+			AddManagedKeyCalled: func(key string) (string, error) {
+				return "", expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/managed-keys/add", bytes.NewBuffer([]byte(`{"key":"abcd"}`)))
+		req.SetBasicAuth("admin", "password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminAuthorizedCalled: func(username string, password string) bool {
+				return username == "admin" && password == "password"
+			},
+			AddManagedKeyCalled: func(key string) (string, error) {
+				assert.Equal(t, "abcd", key)
+				return "public key", nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/managed-keys/add", bytes.NewBuffer([]byte(`{"key":"abcd"}`)))
+		req.SetBasicAuth("admin", "password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &addManagedKeyResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, "public key", response.Data.PublicKey)
+	})
+}
+
+func TestNodeGroup_RemoveManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminAuthorizedCalled: func(username string, password string) bool {
+				return false
+			},
+			RemoveManagedKeyCalled: func(publicKey string) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/managed-keys/remove", bytes.NewBuffer([]byte(`{"publicKey":"abcd"}`)))
+		req.SetBasicAuth("admin", "wrong password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Equal(t, apiErrors.ErrUnauthorized.Error(), response.Error)
+	})
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminAuthorizedCalled: func(username string, password string) bool {
+				return true
+			},
+			RemoveManagedKeyCalled: func(publicKey string) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/managed-keys/remove", bytes.NewBuffer([]byte("invalid")))
+		req.SetBasicAuth("admin", "password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrRemoveManagedKey.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminAuthorizedCalled: func(username string, password string) bool {
+				return true
+			},
+			RemoveManagedKeyCalled: func(publicKey string) error {
+				return expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/managed-keys/remove", bytes.NewBuffer([]byte(`{"publicKey":"abcd"}`)))
+		req.SetBasicAuth("admin", "password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := mock.FacadeStub{
+			IsAdminAuthorizedCalled: func(username string, password string) bool {
+				return true
+			},
+			RemoveManagedKeyCalled: func(publicKey string) error {
+				assert.Equal(t, "abcd", publicKey)
+				wasCalled = true
+				return nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/managed-keys/remove", bytes.NewBuffer([]byte(`{"publicKey":"abcd"}`)))
+		req.SetBasicAuth("admin", "password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestNodeGroup_RedundancyStepDown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminAuthorizedCalled: func(username string, password string) bool {
+				return false
+			},
+			StepDownRedundancyLeaseCalled: func() error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/redundancy/step-down", nil)
+		req.SetBasicAuth("admin", "wrong password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Equal(t, apiErrors.ErrUnauthorized.Error(), response.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminAuthorizedCalled: func(username string, password string) bool {
+				return true
+			},
+			StepDownRedundancyLeaseCalled: func() error {
+				return expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/redundancy/step-down", nil)
+		req.SetBasicAuth("admin", "password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrStepDownRedundancyLease.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := mock.FacadeStub{
+			IsAdminAuthorizedCalled: func(username string, password string) bool {
+				return true
+			},
+			StepDownRedundancyLeaseCalled: func() error {
+				wasCalled = true
+				return nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/redundancy/step-down", nil)
+		req.SetBasicAuth("admin", "password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.True(t, wasCalled)
+	})
+}
+
 func TestNodeGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -1074,6 +1609,7 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/status", Open: true},
 					{Name: "/metrics", Open: true},
+					{Name: "/metrics/prometheus", Open: true},
 					{Name: "/heartbeatstatus", Open: true},
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
@@ -1087,6 +1623,10 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/managed-keys/eligible", Open: true},
 					{Name: "/managed-keys/waiting", Open: true},
 					{Name: "/waiting-epochs-left/:key", Open: true},
+					{Name: "/trie-statistics", Open: true},
+					{Name: "/managed-keys/add", Open: true},
+					{Name: "/managed-keys/remove", Open: true},
+					{Name: "/redundancy/step-down", Open: true},
 				},
 			},
 		},
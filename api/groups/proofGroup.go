@@ -20,10 +20,18 @@ const (
 	getProofEndpoint                = "/proof/root-hash/:roothash/address/:address"
 	getProofDataTrieEndpoint        = "/proof/root-hash/:roothash/address/:address/key/:key"
 	verifyProofEndpoint             = "/proof/verify"
+	getMultiProofEndpoint           = "/proof/root-hash/:roothash/multi"
+	getMultiProofDataTrieEndpoint   = "/proof/root-hash/:roothash/address/:address/multi"
+	verifyMultiProofEndpoint        = "/proof/verify-multi"
 	getProofCurrentRootHashPath     = "/address/:address"
 	getProofPath                    = "/root-hash/:roothash/address/:address"
 	getProofDataTriePath            = "/root-hash/:roothash/address/:address/key/:key"
 	verifyProofPath                 = "/verify"
+	getMultiProofPath               = "/root-hash/:roothash/multi"
+	getMultiProofDataTriePath       = "/root-hash/:roothash/address/:address/multi"
+	verifyMultiProofPath            = "/verify-multi"
+
+	maxKeysPerMultiProof = 256
 )
 
 // proofFacadeHandler defines the methods to be implemented by a facade for proof requests
@@ -32,6 +40,9 @@ type proofFacadeHandler interface {
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, keys []string) (*common.GetMultiProofResponse, error)
+	GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetMultiProofDataTrieResponse, error)
+	VerifyMultiProof(rootHash string, keys []string, values [][]byte, proof [][]byte) (bool, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
@@ -98,6 +109,39 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 				},
 			},
 		},
+		{
+			Path:    getMultiProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.getMultiProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getMultiProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getMultiProofDataTriePath,
+			Method:  http.MethodPost,
+			Handler: pg.getMultiProofDataTrie,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getMultiProofDataTrieEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    verifyMultiProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.verifyMultiProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyMultiProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	pg.endpoints = endpoints
 
@@ -111,6 +155,32 @@ type VerifyProofRequest struct {
 	Proof    []string `json:"proof"`
 }
 
+// GetMultiProofRequest represents the parameters needed to compute a Merkle multi proof
+type GetMultiProofRequest struct {
+	Keys []string `json:"keys"`
+}
+
+// MultiProofKeyValue represents a key of a Merkle multi proof together with its hex encoded value. An empty value
+// signals that the key is proven to be missing from the trie
+type MultiProofKeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// VerifyMultiProofRequest represents the parameters needed to verify a Merkle multi proof
+type VerifyMultiProofRequest struct {
+	RootHash string               `json:"roothash"`
+	Keys     []MultiProofKeyValue `json:"keys"`
+	Proof    []string             `json:"proof"`
+}
+
+// multiProofValueResponse represents the proven value of a key from a Merkle multi proof
+type multiProofValueResponse struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Exists bool   `json:"exists"`
+}
+
 // getProof will receive a rootHash and an address from the client, and it will return the Merkle proof
 func (pg *proofGroup) getProof(c *gin.Context) {
 	rootHash := c.Param("roothash")
@@ -238,6 +308,180 @@ func (pg *proofGroup) verifyProof(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"ok": proofOk})
 }
 
+// getMultiProof will receive a rootHash and a list of keys from the client, and it will return a single Merkle proof
+// which proves the inclusion or the exclusion of all the keys
+func (pg *proofGroup) getMultiProof(c *gin.Context) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyRootHash)
+		return
+	}
+
+	var getMultiProofParams = &GetMultiProofRequest{}
+	err := c.ShouldBindJSON(&getMultiProofParams)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = checkMultiProofKeysCount(len(getMultiProofParams.Keys))
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	response, err := pg.getFacade().GetMultiProof(rootHash, getMultiProofParams.Keys)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"proof":    bytesToHex(response.Proof),
+		"values":   createMultiProofValuesResponse(getMultiProofParams.Keys, response.Values),
+		"rootHash": response.RootHash,
+	})
+}
+
+// getMultiProofDataTrie will receive a rootHash, an address and a list of keys from the client, and it will return the
+// Merkle proof for the address together with a single Merkle proof which proves the inclusion or the exclusion of all
+// the keys in the data trie of the account. The returned data trie keys and their values can be used to verify the
+// data trie proof
+func (pg *proofGroup) getMultiProofDataTrie(c *gin.Context) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyRootHash)
+		return
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyAddress)
+		return
+	}
+
+	var getMultiProofParams = &GetMultiProofRequest{}
+	err := c.ShouldBindJSON(&getMultiProofParams)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = checkMultiProofKeysCount(len(getMultiProofParams.Keys))
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	response, err := pg.getFacade().GetMultiProofDataTrie(rootHash, address, getMultiProofParams.Keys)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	dataTrieKeys := make([]MultiProofKeyValue, 0, len(response.DataTrieKeys))
+	for i, dataTrieKey := range response.DataTrieKeys {
+		var value []byte
+		if i < len(response.DataTrieProof.Values) {
+			value = response.DataTrieProof.Values[i]
+		}
+
+		dataTrieKeys = append(dataTrieKeys, MultiProofKeyValue{
+			Key:   hex.EncodeToString(dataTrieKey),
+			Value: hex.EncodeToString(value),
+		})
+	}
+
+	proofs := make(map[string]interface{})
+	proofs["mainProof"] = bytesToHex(response.MainProof.Proof)
+	proofs["dataTrieProof"] = bytesToHex(response.DataTrieProof.Proof)
+
+	shared.RespondWithSuccess(c, gin.H{
+		"proofs":           proofs,
+		"values":           createMultiProofValuesResponse(getMultiProofParams.Keys, response.Values),
+		"dataTrieKeys":     dataTrieKeys,
+		"dataTrieRootHash": response.DataTrieProof.RootHash,
+	})
+}
+
+func createMultiProofValuesResponse(keys []string, values [][]byte) []multiProofValueResponse {
+	valuesResponse := make([]multiProofValueResponse, 0, len(keys))
+	for i, key := range keys {
+		var value []byte
+		if i < len(values) {
+			value = values[i]
+		}
+
+		valuesResponse = append(valuesResponse, multiProofValueResponse{
+			Key:    key,
+			Value:  hex.EncodeToString(value),
+			Exists: len(value) > 0,
+		})
+	}
+
+	return valuesResponse
+}
+
+// verifyMultiProof will receive a rootHash, a list of keys with their expected values and a Merkle multi proof from
+// the client, and it will verify the proof
+func (pg *proofGroup) verifyMultiProof(c *gin.Context) {
+	var verifyMultiProofParams = &VerifyMultiProofRequest{}
+	err := c.ShouldBindJSON(&verifyMultiProofParams)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = checkMultiProofKeysCount(len(verifyMultiProofParams.Keys))
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	keys := make([]string, 0, len(verifyMultiProofParams.Keys))
+	values := make([][]byte, 0, len(verifyMultiProofParams.Keys))
+	for _, keyValue := range verifyMultiProofParams.Keys {
+		value, errDecode := hex.DecodeString(keyValue.Value)
+		if errDecode != nil {
+			shared.RespondWithValidationError(c, errors.ErrValidation, errDecode)
+			return
+		}
+
+		keys = append(keys, keyValue.Key)
+		values = append(values, value)
+	}
+
+	proof := make([][]byte, 0, len(verifyMultiProofParams.Proof))
+	for _, hexProof := range verifyMultiProofParams.Proof {
+		bytesProof, errDecode := hex.DecodeString(hexProof)
+		if errDecode != nil {
+			shared.RespondWithValidationError(c, errors.ErrValidation, errDecode)
+			return
+		}
+
+		proof = append(proof, bytesProof)
+	}
+
+	proofOk, err := pg.getFacade().VerifyMultiProof(verifyMultiProofParams.RootHash, keys, values, proof)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrVerifyProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"ok": proofOk})
+}
+
+func checkMultiProofKeysCount(numKeys int) error {
+	if numKeys == 0 {
+		return errors.ErrValidationEmptyKeys
+	}
+	if numKeys > maxKeysPerMultiProof {
+		return fmt.Errorf("%w, provided: %d, maximum: %d", errors.ErrTooManyKeys, numKeys, maxKeysPerMultiProof)
+	}
+
+	return nil
+}
+
 func (pg *proofGroup) getFacade() proofFacadeHandler {
 	pg.mutFacade.RLock()
 	defer pg.mutFacade.RUnlock()
@@ -379,6 +379,396 @@ func TestVerifyProof(t *testing.T) {
 	assert.True(t, isValid)
 }
 
+func TestGetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("bad request should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/multi", bytes.NewBuffer([]byte("invalid bytes")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("empty keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.GetMultiProofRequest{})
+		req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEmptyKeys.Error()))
+	})
+	t.Run("too many keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		keys := make([]string, 257)
+		for i := range keys {
+			keys[i] = fmt.Sprintf("key%d", i)
+		}
+		requestBytes, _ := json.Marshal(groups.GetMultiProofRequest{Keys: keys})
+		req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrTooManyKeys.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		getMultiProofErr := fmt.Errorf("GetMultiProof error")
+		facade := &mock.FacadeStub{
+			GetMultiProofCalled: func(rootHash string, keys []string) (*common.GetMultiProofResponse, error) {
+				return nil, getMultiProofErr
+			},
+		}
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.GetMultiProofRequest{Keys: []string{"key"}})
+		req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		validProof := [][]byte{[]byte("valid"), []byte("proof")}
+		facade := &mock.FacadeStub{
+			GetMultiProofCalled: func(rootHash string, keys []string) (*common.GetMultiProofResponse, error) {
+				assert.Equal(t, "roothash", rootHash)
+				assert.Equal(t, []string{"key1", "key2"}, keys)
+				return &common.GetMultiProofResponse{
+					Proof:    validProof,
+					Values:   [][]byte{[]byte("value1"), nil},
+					RootHash: rootHash,
+				}, nil
+			},
+		}
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.GetMultiProofRequest{Keys: []string{"key1", "key2"}})
+		req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+		responseMap, ok := response.Data.(map[string]interface{})
+		require.True(t, ok)
+
+		proofs, ok := responseMap["proof"].([]interface{})
+		require.True(t, ok)
+		require.Equal(t, len(validProof), len(proofs))
+		for i := range validProof {
+			assert.Equal(t, hex.EncodeToString(validProof[i]), proofs[i])
+		}
+
+		values, ok := responseMap["values"].([]interface{})
+		require.True(t, ok)
+		require.Equal(t, 2, len(values))
+
+		firstValue := values[0].(map[string]interface{})
+		assert.Equal(t, "key1", firstValue["key"])
+		assert.Equal(t, hex.EncodeToString([]byte("value1")), firstValue["value"])
+		assert.Equal(t, true, firstValue["exists"])
+
+		secondValue := values[1].(map[string]interface{})
+		assert.Equal(t, "key2", secondValue["key"])
+		assert.Equal(t, "", secondValue["value"])
+		assert.Equal(t, false, secondValue["exists"])
+	})
+}
+
+func TestGetMultiProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.GetMultiProofRequest{})
+		req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/address/addr/multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEmptyKeys.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		getMultiProofErr := fmt.Errorf("GetMultiProofDataTrie error")
+		facade := &mock.FacadeStub{
+			GetMultiProofDataTrieCalled: func(rootHash string, address string, keys []string) (*common.GetMultiProofDataTrieResponse, error) {
+				return nil, getMultiProofErr
+			},
+		}
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.GetMultiProofRequest{Keys: []string{"key"}})
+		req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/address/addr/multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		mainProof := [][]byte{[]byte("main"), []byte("proof")}
+		dataTrieProof := [][]byte{[]byte("data trie"), []byte("proof")}
+		facade := &mock.FacadeStub{
+			GetMultiProofDataTrieCalled: func(rootHash string, address string, keys []string) (*common.GetMultiProofDataTrieResponse, error) {
+				assert.Equal(t, "roothash", rootHash)
+				assert.Equal(t, "addr", address)
+				assert.Equal(t, []string{"key1"}, keys)
+				return &common.GetMultiProofDataTrieResponse{
+					MainProof: &common.GetProofResponse{
+						Proof: mainProof,
+					},
+					DataTrieProof: &common.GetMultiProofResponse{
+						Proof:    dataTrieProof,
+						Values:   [][]byte{[]byte("trie value"), nil},
+						RootHash: "dataTrieRootHash",
+					},
+					DataTrieKeys: [][]byte{[]byte("hashed key1"), []byte("key1")},
+					Values:       [][]byte{[]byte("value1")},
+				}, nil
+			},
+		}
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.GetMultiProofRequest{Keys: []string{"key1"}})
+		req, _ := http.NewRequest("POST", "/proof/root-hash/roothash/address/addr/multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+		responseMap, ok := response.Data.(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, "dataTrieRootHash", responseMap["dataTrieRootHash"])
+
+		proofs, ok := responseMap["proofs"].(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, []interface{}{hex.EncodeToString(mainProof[0]), hex.EncodeToString(mainProof[1])}, proofs["mainProof"])
+		assert.Equal(t, []interface{}{hex.EncodeToString(dataTrieProof[0]), hex.EncodeToString(dataTrieProof[1])}, proofs["dataTrieProof"])
+
+		values, ok := responseMap["values"].([]interface{})
+		require.True(t, ok)
+		require.Equal(t, 1, len(values))
+		value := values[0].(map[string]interface{})
+		assert.Equal(t, "key1", value["key"])
+		assert.Equal(t, hex.EncodeToString([]byte("value1")), value["value"])
+		assert.Equal(t, true, value["exists"])
+
+		dataTrieKeys, ok := responseMap["dataTrieKeys"].([]interface{})
+		require.True(t, ok)
+		require.Equal(t, 2, len(dataTrieKeys))
+		hashedKey := dataTrieKeys[0].(map[string]interface{})
+		assert.Equal(t, hex.EncodeToString([]byte("hashed key1")), hashedKey["key"])
+		assert.Equal(t, hex.EncodeToString([]byte("trie value")), hashedKey["value"])
+		plainKey := dataTrieKeys[1].(map[string]interface{})
+		assert.Equal(t, hex.EncodeToString([]byte("key1")), plainKey["key"])
+		assert.Equal(t, "", plainKey["value"])
+	})
+}
+
+func TestVerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("bad request should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/proof/verify-multi", bytes.NewBuffer([]byte("invalid bytes")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("invalid value should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.VerifyMultiProofRequest{
+			RootHash: "roothash",
+			Keys:     []groups.MultiProofKeyValue{{Key: "key", Value: "not hex"}},
+		})
+		req, _ := http.NewRequest("POST", "/proof/verify-multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("too many keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		keys := make([]groups.MultiProofKeyValue, 257)
+		for i := range keys {
+			keys[i] = groups.MultiProofKeyValue{Key: fmt.Sprintf("key%d", i)}
+		}
+		requestBytes, _ := json.Marshal(groups.VerifyMultiProofRequest{
+			RootHash: "roothash",
+			Keys:     keys,
+		})
+		req, _ := http.NewRequest("POST", "/proof/verify-multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrTooManyKeys.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		verifyErr := fmt.Errorf("VerifyMultiProof error")
+		facade := &mock.FacadeStub{
+			VerifyMultiProofCalled: func(rootHash string, keys []string, values [][]byte, proof [][]byte) (bool, error) {
+				return false, verifyErr
+			},
+		}
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.VerifyMultiProofRequest{
+			RootHash: "roothash",
+			Keys:     []groups.MultiProofKeyValue{{Key: "key"}},
+		})
+		req, _ := http.NewRequest("POST", "/proof/verify-multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrVerifyProof.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		validProof := []string{hex.EncodeToString([]byte("valid")), hex.EncodeToString([]byte("proof"))}
+		facade := &mock.FacadeStub{
+			VerifyMultiProofCalled: func(rootHash string, keys []string, values [][]byte, proof [][]byte) (bool, error) {
+				assert.Equal(t, "roothash", rootHash)
+				assert.Equal(t, []string{"key1", "key2"}, keys)
+				assert.Equal(t, [][]byte{[]byte("value1"), {}}, values)
+				for i := range proof {
+					assert.Equal(t, validProof[i], hex.EncodeToString(proof[i]))
+				}
+
+				return true, nil
+			},
+		}
+		proofGroup, err := groups.NewProofGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.VerifyMultiProofRequest{
+			RootHash: "roothash",
+			Keys: []groups.MultiProofKeyValue{
+				{Key: "key1", Value: hex.EncodeToString([]byte("value1"))},
+				{Key: "key2"},
+			},
+			Proof: validProof,
+		})
+		req, _ := http.NewRequest("POST", "/proof/verify-multi", bytes.NewBuffer(requestBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+		responseMap, ok := response.Data.(map[string]interface{})
+		require.True(t, ok)
+
+		isValid, ok := responseMap["ok"].(bool)
+		require.True(t, ok)
+		assert.True(t, isValid)
+	})
+}
+
 func TestProofGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -476,6 +866,9 @@ func getProofRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/root-hash/:roothash/address/:address/key/:key", Open: true},
 					{Name: "/address/:address", Open: true},
 					{Name: "/verify", Open: true},
+					{Name: "/root-hash/:roothash/multi", Open: true},
+					{Name: "/root-hash/:roothash/address/:address/multi", Open: true},
+					{Name: "/verify-multi", Open: true},
 				},
 			},
 		},
@@ -0,0 +1,144 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	getStateDiffEndpoint     = "/state/diff"
+	getStateDiffPath         = "/diff"
+	urlParamFromNonce        = "fromNonce"
+	urlParamToNonce          = "toNonce"
+	urlParamFromAddress      = "fromAddress"
+	defaultStateDiffPageSize = 100
+	maxStateDiffPageSize     = 1000
+)
+
+// stateFacadeHandler defines the methods to be implemented by a facade for state requests
+type stateFacadeHandler interface {
+	GetStateDiff(options common.StateDiffQueryOptions) (*common.StateDiffAPIResponse, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+type stateGroup struct {
+	*baseGroup
+	facade    stateFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewStateGroup returns a new instance of stateGroup
+func NewStateGroup(facade stateFacadeHandler) (*stateGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for state group", errors.ErrNilFacadeHandler)
+	}
+
+	sg := &stateGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    getStateDiffPath,
+			Method:  http.MethodGet,
+			Handler: sg.getStateDiff,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getStateDiffEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+	}
+	sg.endpoints = endpoints
+
+	return sg, nil
+}
+
+// getStateDiff will receive two block nonces from the client, and it will return a page of the accounts which were
+// added, removed or modified between the two blocks. The next page starts with the returned next address
+func (sg *stateGroup) getStateDiff(c *gin.Context) {
+	options, err := extractStateDiffQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetStateDiff, err)
+		return
+	}
+
+	stateDiff, err := sg.getFacade().GetStateDiff(options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetStateDiff, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"stateDiff": stateDiff})
+}
+
+func extractStateDiffQueryOptions(c *gin.Context) (common.StateDiffQueryOptions, error) {
+	fromNonce, err := parseUint64UrlParam(c, urlParamFromNonce)
+	if err != nil || !fromNonce.HasValue {
+		return common.StateDiffQueryOptions{}, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, urlParamFromNonce)
+	}
+
+	toNonce, err := parseUint64UrlParam(c, urlParamToNonce)
+	if err != nil || !toNonce.HasValue {
+		return common.StateDiffQueryOptions{}, fmt.Errorf("%w for %s", errors.ErrBadUrlParams, urlParamToNonce)
+	}
+
+	pageSize, err := parseUint32UrlParam(c, urlParamPageSize)
+	if err != nil {
+		return common.StateDiffQueryOptions{}, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+	if !pageSize.HasValue {
+		pageSize.Value = defaultStateDiffPageSize
+	}
+	if pageSize.Value == 0 || pageSize.Value > maxStateDiffPageSize {
+		return common.StateDiffQueryOptions{}, fmt.Errorf("%w: %v, provided: %d, maximum: %d", errors.ErrBadUrlParams, errors.ErrInvalidPageSize, pageSize.Value, maxStateDiffPageSize)
+	}
+
+	return common.StateDiffQueryOptions{
+		FromNonce:   fromNonce.Value,
+		ToNonce:     toNonce.Value,
+		FromAddress: c.Request.URL.Query().Get(urlParamFromAddress),
+		PageSize:    int(pageSize.Value),
+	}, nil
+}
+
+func (sg *stateGroup) getFacade() stateFacadeHandler {
+	sg.mutFacade.RLock()
+	defer sg.mutFacade.RUnlock()
+
+	return sg.facade
+}
+
+// UpdateFacade will update the facade
+func (sg *stateGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(stateFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	sg.mutFacade.Lock()
+	sg.facade = castFacade
+	sg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *stateGroup) IsInterfaceNil() bool {
+	return sg == nil
+}
@@ -0,0 +1,218 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stateDiffResponseData struct {
+	StateDiff common.StateDiffAPIResponse `json:"stateDiff"`
+}
+
+type stateDiffResponse struct {
+	Data  stateDiffResponseData `json:"data"`
+	Error string                `json:"error"`
+	Code  string                `json:"code"`
+}
+
+func TestNewStateGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		sg, err := groups.NewStateGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, sg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		sg, err := groups.NewStateGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, sg)
+	})
+}
+
+func TestStateGroup_GetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing fromNonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		testStateGroupErrorScenario(t, "/state/diff?toNonce=2", &mock.FacadeStub{}, apiErrors.ErrBadUrlParams.Error())
+	})
+	t.Run("invalid toNonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		testStateGroupErrorScenario(t, "/state/diff?fromNonce=1&toNonce=invalid", &mock.FacadeStub{}, apiErrors.ErrBadUrlParams.Error())
+	})
+	t.Run("invalid pageSize should error", func(t *testing.T) {
+		t.Parallel()
+
+		testStateGroupErrorScenario(t, "/state/diff?fromNonce=1&toNonce=2&pageSize=invalid", &mock.FacadeStub{}, apiErrors.ErrBadUrlParams.Error())
+	})
+	t.Run("zero pageSize should error", func(t *testing.T) {
+		t.Parallel()
+
+		testStateGroupErrorScenario(t, "/state/diff?fromNonce=1&toNonce=2&pageSize=0", &mock.FacadeStub{}, apiErrors.ErrInvalidPageSize.Error())
+	})
+	t.Run("pageSize above maximum should error", func(t *testing.T) {
+		t.Parallel()
+
+		testStateGroupErrorScenario(t, "/state/diff?fromNonce=1&toNonce=2&pageSize=1001", &mock.FacadeStub{}, apiErrors.ErrInvalidPageSize.Error())
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetStateDiffCalled: func(options common.StateDiffQueryOptions) (*common.StateDiffAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		testStateGroupErrorScenario(t, "/state/diff?fromNonce=1&toNonce=2", facade, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStateDiff := &common.StateDiffAPIResponse{
+			FromBlock: api.BlockInfo{Nonce: 1, Hash: "aa", RootHash: "bb"},
+			ToBlock:   api.BlockInfo{Nonce: 2, Hash: "cc", RootHash: "dd"},
+			Added:     make([]*common.AccountDiffAPIResponse, 0),
+			Removed:   make([]*common.AccountDiffAPIResponse, 0),
+			Modified: []*common.AccountDiffAPIResponse{
+				{
+					Address:    "erd1alice",
+					OldAccount: &api.AccountResponse{Address: "erd1alice", Balance: "1"},
+					NewAccount: &api.AccountResponse{Address: "erd1alice", Balance: "2"},
+					DataTrieChanges: []*common.DataTrieChangeAPIResponse{
+						{Key: "6b6579", OldValue: "", NewValue: "76616c7565"},
+					},
+				},
+			},
+			NextAddress: "erd1bob",
+		}
+		facade := &mock.FacadeStub{
+			GetStateDiffCalled: func(options common.StateDiffQueryOptions) (*common.StateDiffAPIResponse, error) {
+				require.Equal(t, uint64(1), options.FromNonce)
+				require.Equal(t, uint64(2), options.ToNonce)
+				require.Equal(t, "erd1alice", options.FromAddress)
+				require.Equal(t, 10, options.PageSize)
+				return expectedStateDiff, nil
+			},
+		}
+
+		stateGroup, err := groups.NewStateGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(stateGroup, "state", getStateRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/state/diff?fromNonce=1&toNonce=2&fromAddress=erd1alice&pageSize=10", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := stateDiffResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedStateDiff, response.Data.StateDiff)
+	})
+}
+
+func testStateGroupErrorScenario(t *testing.T, url string, facade *mock.FacadeStub, expectedErr string) {
+	stateGroup, err := groups.NewStateGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(stateGroup, "state", getStateRoutesConfig())
+
+	req, _ := http.NewRequest("GET", url, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.NotEqual(t, shared.ReturnCodeSuccess, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStateDiff.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr))
+}
+
+func TestStateGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		stateGroup, err := groups.NewStateGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		err = stateGroup.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		stateGroup, err := groups.NewStateGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		err = stateGroup.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		stateGroup, err := groups.NewStateGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		expectedErr := errors.New("expected error")
+		newFacade := &mock.FacadeStub{
+			GetStateDiffCalled: func(options common.StateDiffQueryOptions) (*common.StateDiffAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		err = stateGroup.UpdateFacade(newFacade)
+		require.NoError(t, err)
+
+		ws := startWebServer(stateGroup, "state", getStateRoutesConfig())
+		req, _ := http.NewRequest("GET", "/state/diff?fromNonce=1&toNonce=2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+}
+
+func TestStateGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	stateGroup, _ := groups.NewStateGroup(nil)
+	require.True(t, stateGroup.IsInterfaceNil())
+
+	stateGroup, _ = groups.NewStateGroup(&mock.FacadeStub{})
+	require.False(t, stateGroup.IsInterfaceNil())
+}
+
+func getStateRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"state": {
+				Routes: []config.RouteConfig{
+					{Name: "/diff", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -22,16 +22,24 @@ import (
 )
 
 const (
-	sendTransactionEndpoint          = "/transaction/send"
-	simulateTransactionEndpoint      = "/transaction/simulate"
-	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
-	getTransactionEndpoint           = "/transaction/:hash"
-	sendTransactionPath              = "/send"
-	simulateTransactionPath          = "/simulate"
-	costPath                         = "/cost"
-	sendMultiplePath                 = "/send-multiple"
-	getTransactionPath               = "/:txhash"
-	getTransactionsPool              = "/pool"
+	sendTransactionEndpoint           = "/transaction/send"
+	simulateTransactionEndpoint       = "/transaction/simulate"
+	simulateBundleEndpoint            = "/transaction/simulate-bundle"
+	sendMultipleTransactionsEndpoint  = "/transaction/send-multiple"
+	getTransactionEndpoint            = "/transaction/:hash"
+	traceTransactionEndpoint          = "/transaction/:txhash/trace"
+	getTransactionsPoolSenderEndpoint = "/transaction/pool/sender/:sender"
+	getTransactionsPoolStatsEndpoint  = "/transaction/pool/statistics"
+	sendTransactionPath               = "/send"
+	simulateTransactionPath           = "/simulate"
+	simulateBundlePath                = "/simulate-bundle"
+	costPath                          = "/cost"
+	sendMultiplePath                  = "/send-multiple"
+	getTransactionPath                = "/:txhash"
+	traceTransactionPath              = "/:txhash/trace"
+	getTransactionsPool               = "/pool"
+	getTransactionsPoolSenderPath     = "/pool/sender/:sender"
+	getTransactionsPoolStatsPath      = "/pool/statistics"
 
 	queryParamWithResults    = "withResults"
 	queryParamCheckSignature = "checkSignature"
@@ -39,21 +47,28 @@ const (
 	queryParamFields         = "fields"
 	queryParamLastNonce      = "last-nonce"
 	queryParamNonceGaps      = "nonce-gaps"
+	queryParamReceiver       = "receiver"
+	queryParamFunction       = "function"
+	queryParamTrace          = "trace"
 )
 
 // transactionFacadeHandler defines the methods to be implemented by a facade for transaction requests
 type transactionFacadeHandler interface {
 	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
-	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
+	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool, stateOverrides map[string]*txSimData.AccountOverride) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransaction(txHash string) (*txSimData.SimulationResultsWithVMOutput, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
-	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	GetTransactionsPoolSenderDetails(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error)
+	GetTransactionsPoolStatistics() (*common.TransactionsPoolStatisticsApiResponse, error)
+	ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
@@ -99,6 +114,17 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    simulateBundlePath,
+			Method:  http.MethodPost,
+			Handler: tg.simulateTransactionsBundle,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(simulateBundleEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 		{
 			Path:    costPath,
 			Method:  http.MethodPost,
@@ -115,6 +141,28 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    getTransactionsPoolSenderPath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionsPoolSenderDetails,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionsPoolSenderEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getTransactionsPoolStatsPath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionsPoolStatistics,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionsPoolStatsEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 		{
 			Path:    sendMultiplePath,
 			Method:  http.MethodPost,
@@ -137,6 +185,17 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    traceTransactionPath,
+			Method:  http.MethodGet,
+			Handler: tg.traceTransaction,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(traceTransactionEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	tg.endpoints = endpoints
 
@@ -153,10 +212,17 @@ type TxResponse struct {
 	Timestamp   uint64 `json:"timestamp"`
 }
 
+// SimulationRequest represents the structure of a transaction simulation or cost request. The optional state overrides,
+// keyed by address, are applied on top of the current accounts state before the execution
+type SimulationRequest struct {
+	transaction.FrontendTransaction
+	StateOverrides map[string]*txSimData.AccountOverride `json:"stateOverrides,omitempty"`
+}
+
 // simulateTransaction will receive a transaction from the client and will simulate its execution and return the results
 func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
-	var ftx = transaction.FrontendTransaction{}
-	err := c.ShouldBindJSON(&ftx)
+	var request = SimulationRequest{}
+	err := c.ShouldBindJSON(&request)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -182,22 +248,35 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
+	withTrace, err := getQueryParameterTrace(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	txArgs := &external.ArgsCreateTransaction{
-		Nonce:            ftx.Nonce,
-		Value:            ftx.Value,
-		Receiver:         ftx.Receiver,
-		ReceiverUsername: ftx.ReceiverUsername,
-		Sender:           ftx.Sender,
-		SenderUsername:   ftx.SenderUsername,
-		GasPrice:         ftx.GasPrice,
-		GasLimit:         ftx.GasLimit,
-		DataField:        ftx.Data,
-		SignatureHex:     ftx.Signature,
-		ChainID:          ftx.ChainID,
-		Version:          ftx.Version,
-		Options:          ftx.Options,
-		Guardian:         ftx.GuardianAddr,
-		GuardianSigHex:   ftx.GuardianSignature,
+		Nonce:            request.Nonce,
+		Value:            request.Value,
+		Receiver:         request.Receiver,
+		ReceiverUsername: request.ReceiverUsername,
+		Sender:           request.Sender,
+		SenderUsername:   request.SenderUsername,
+		GasPrice:         request.GasPrice,
+		GasLimit:         request.GasLimit,
+		DataField:        request.Data,
+		SignatureHex:     request.Signature,
+		ChainID:          request.ChainID,
+		Version:          request.Version,
+		Options:          request.Options,
+		Guardian:         request.GuardianAddr,
+		GuardianSigHex:   request.GuardianSignature,
 	}
 	start := time.Now()
 	tx, txHash, err := tg.getFacade().CreateTransaction(txArgs)
@@ -216,7 +295,7 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	}
 
 	start = time.Now()
-	err = tg.getFacade().ValidateTransactionForSimulation(tx, checkSignature)
+	err = tg.getFacade().ValidateTransactionForSimulation(tx, checkSignature, request.StateOverrides)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: ValidateTransactionForSimulation")
 	if err != nil {
 		c.JSON(
@@ -231,7 +310,7 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	}
 
 	start = time.Now()
-	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx)
+	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx, request.StateOverrides, withTrace)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionExecution")
 	if err != nil {
 		c.JSON(
@@ -256,6 +335,132 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	)
 }
 
+// simulateTransactionsBundle will receive an ordered list of transactions from the client and will simulate their
+// execution, each transaction being executed on top of the state changes produced by the previous ones
+func (tg *transactionGroup) simulateTransactionsBundle(c *gin.Context) {
+	var ftxs []transaction.FrontendTransaction
+	err := c.ShouldBindJSON(&ftxs)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+	if len(ftxs) == 0 {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxsBundle.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	checkSignature, err := getQueryParameterCheckSignature(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txs := make([]*transaction.Transaction, 0, len(ftxs))
+	txsHashes := make([]string, 0, len(ftxs))
+	for idx, receivedTx := range ftxs {
+		txArgs := &external.ArgsCreateTransaction{
+			Nonce:            receivedTx.Nonce,
+			Value:            receivedTx.Value,
+			Receiver:         receivedTx.Receiver,
+			ReceiverUsername: receivedTx.ReceiverUsername,
+			Sender:           receivedTx.Sender,
+			SenderUsername:   receivedTx.SenderUsername,
+			GasPrice:         receivedTx.GasPrice,
+			GasLimit:         receivedTx.GasLimit,
+			DataField:        receivedTx.Data,
+			SignatureHex:     receivedTx.Signature,
+			ChainID:          receivedTx.ChainID,
+			Version:          receivedTx.Version,
+			Options:          receivedTx.Options,
+			Guardian:         receivedTx.GuardianAddr,
+			GuardianSigHex:   receivedTx.GuardianSignature,
+		}
+		start := time.Now()
+		tx, txHash, errCreate := tg.getFacade().CreateTransaction(txArgs)
+		logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
+		if errCreate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: %s for transaction at index %d", errors.ErrTxGenerationFailed.Error(), errCreate.Error(), idx),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		start = time.Now()
+		errValidate := tg.getFacade().ValidateTransactionForSimulation(tx, checkSignature, nil)
+		logging.LogAPIActionDurationIfNeeded(start, "API call: ValidateTransactionForSimulation")
+		if errValidate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: %s for transaction at index %d", errors.ErrTxGenerationFailed.Error(), errValidate.Error(), idx),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		txs = append(txs, tx)
+		txsHashes = append(txsHashes, hex.EncodeToString(txHash))
+	}
+
+	start := time.Now()
+	bundleResults, err := tg.getFacade().SimulateTransactionsBundleExecution(txs)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionsBundleExecution")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	for idx, result := range bundleResults.Results {
+		if idx < len(txsHashes) {
+			result.Hash = txsHashes[idx]
+		}
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": bundleResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // sendTransaction will receive a transaction from the client and propagate it for processing
 func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 	var ftx = transaction.FrontendTransaction{}
@@ -483,10 +688,52 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 	)
 }
 
+// traceTransaction will re-execute the transaction with the given hash on the state preceding its block and will
+// return the execution results along with the execution trace
+func (tg *transactionGroup) traceTransaction(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	executionResults, err := tg.getFacade().TraceTransaction(txhash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: TraceTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTraceTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	executionResults.Hash = txhash
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": executionResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // computeTransactionGasLimit returns how many gas units a transaction wil consume
 func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
-	var ftx transaction.FrontendTransaction
-	err := c.ShouldBindJSON(&ftx)
+	var request SimulationRequest
+	err := c.ShouldBindJSON(&request)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -500,21 +747,21 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 	}
 
 	txArgs := &external.ArgsCreateTransaction{
-		Nonce:            ftx.Nonce,
-		Value:            ftx.Value,
-		Receiver:         ftx.Receiver,
-		ReceiverUsername: ftx.ReceiverUsername,
-		Sender:           ftx.Sender,
-		SenderUsername:   ftx.SenderUsername,
-		GasPrice:         ftx.GasPrice,
-		GasLimit:         ftx.GasLimit,
-		DataField:        ftx.Data,
-		SignatureHex:     ftx.Signature,
-		ChainID:          ftx.ChainID,
-		Version:          ftx.Version,
-		Options:          ftx.Options,
-		Guardian:         ftx.GuardianAddr,
-		GuardianSigHex:   ftx.GuardianSignature,
+		Nonce:            request.Nonce,
+		Value:            request.Value,
+		Receiver:         request.Receiver,
+		ReceiverUsername: request.ReceiverUsername,
+		Sender:           request.Sender,
+		SenderUsername:   request.SenderUsername,
+		GasPrice:         request.GasPrice,
+		GasLimit:         request.GasLimit,
+		DataField:        request.Data,
+		SignatureHex:     request.Signature,
+		ChainID:          request.ChainID,
+		Version:          request.Version,
+		Options:          request.Options,
+		Guardian:         request.GuardianAddr,
+		GuardianSigHex:   request.GuardianSignature,
 	}
 	start := time.Now()
 	tx, _, err := tg.getFacade().CreateTransaction(txArgs)
@@ -532,7 +779,7 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 	}
 
 	start = time.Now()
-	cost, err := tg.getFacade().ComputeTransactionGasLimit(tx)
+	cost, err := tg.getFacade().ComputeTransactionGasLimit(tx, request.StateOverrides)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: ComputeTransactionGasLimit")
 	if err != nil {
 		c.JSON(
@@ -559,7 +806,7 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 // getTransactionsPool returns the transactions details in the pool
 func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 	// extract and validate query parameters
-	sender, fields, lastNonce, nonceGaps, err := tg.extractQueryParameters(c)
+	sender, fields, lastNonce, nonceGaps, filter, err := tg.extractQueryParameters(c)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -572,7 +819,7 @@ func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 		return
 	}
 
-	err = validateQuery(sender, fields, lastNonce, nonceGaps)
+	err = validateQuery(sender, fields, lastNonce, nonceGaps, filter)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -587,7 +834,7 @@ func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 
 	// if no sender was provided, the fields for all transactions from pool should be returned in response
 	if sender == "" {
-		tg.getTxPool(fields, c)
+		tg.getTxPool(fields, filter, c)
 		return
 	}
 
@@ -604,26 +851,31 @@ func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 	tg.getTxPoolForSender(sender, fields, c)
 }
 
-func (tg *transactionGroup) extractQueryParameters(c *gin.Context) (string, string, bool, bool, error) {
+func (tg *transactionGroup) extractQueryParameters(c *gin.Context) (string, string, bool, bool, common.TransactionsPoolFilter, error) {
 	senderAddress := getQueryParameterSender(c)
 	fields := getQueryParameterFields(c)
 	lastNonce, err := getQueryParameterLastNonce(c)
 	if err != nil {
-		return "", "", false, false, err
+		return "", "", false, false, common.TransactionsPoolFilter{}, err
 	}
 
 	nonceGaps, err := getQueryParameterNonceGaps(c)
 	if err != nil {
-		return "", "", false, false, err
+		return "", "", false, false, common.TransactionsPoolFilter{}, err
+	}
+
+	filter := common.TransactionsPoolFilter{
+		Receiver: c.Request.URL.Query().Get(queryParamReceiver),
+		Function: c.Request.URL.Query().Get(queryParamFunction),
 	}
 
-	return senderAddress, fields, lastNonce, nonceGaps, nil
+	return senderAddress, fields, lastNonce, nonceGaps, filter, nil
 }
 
-// getTxPool returns the fields for all txs in pool
-func (tg *transactionGroup) getTxPool(fields string, c *gin.Context) {
+// getTxPool returns the fields for all txs in pool matching the filter
+func (tg *transactionGroup) getTxPool(fields string, filter common.TransactionsPoolFilter, c *gin.Context) {
 	start := time.Now()
-	txPool, err := tg.getFacade().GetTransactionsPool(fields)
+	txPool, err := tg.getFacade().GetTransactionsPool(fields, filter)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsPool")
 	if err != nil {
 		c.JSON(
@@ -728,7 +980,12 @@ func (tg *transactionGroup) getTransactionsPoolNonceGapsForSender(sender string,
 	)
 }
 
-func validateQuery(sender, fields string, lastNonce, nonceGaps bool) error {
+func validateQuery(sender, fields string, lastNonce, nonceGaps bool, filter common.TransactionsPoolFilter) error {
+	hasFilter := filter.Receiver != "" || filter.Function != ""
+	if sender != "" && hasFilter {
+		return errors.ErrTransactionsPoolFilterCannotIncludeSender
+	}
+
 	if fields != "" && lastNonce {
 		return errors.ErrFetchingLatestNonceCannotIncludeFields
 	}
@@ -752,6 +1009,73 @@ func validateQuery(sender, fields string, lastNonce, nonceGaps bool) error {
 	return nil
 }
 
+// getTransactionsPoolSenderDetails returns the transactions in pool for sender, together with the nonce gaps and the stuck transactions
+func (tg *transactionGroup) getTransactionsPoolSenderDetails(c *gin.Context) {
+	sender := c.Param("sender")
+	if sender == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrEmptySenderToGetPoolDetails.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	details, err := tg.getFacade().GetTransactionsPoolSenderDetails(sender)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsPoolSenderDetails")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"details": details},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionsPoolStatistics returns the statistics of the caches of the pool and the most recent evictions
+func (tg *transactionGroup) getTransactionsPoolStatistics(c *gin.Context) {
+	start := time.Now()
+	statistics, err := tg.getFacade().GetTransactionsPoolStatistics()
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsPoolStatistics")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"statistics": statistics},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 func validateFields(fields string) error {
 	for _, c := range fields {
 		if c == ',' {
@@ -786,6 +1110,15 @@ func getQueryParameterCheckSignature(c *gin.Context) (bool, error) {
 	return strconv.ParseBool(bypassSignatureStr)
 }
 
+func getQueryParameterTrace(c *gin.Context) (bool, error) {
+	traceStr := c.Request.URL.Query().Get(queryParamTrace)
+	if traceStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(traceStr)
+}
+
 func getQueryParameterSender(c *gin.Context) string {
 	senderAddress := c.Request.URL.Query().Get(queryParamSender)
 	return senderAddress
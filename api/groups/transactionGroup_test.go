@@ -69,6 +69,26 @@ type simulateTxResponse struct {
 	Code  string      `json:"code"`
 }
 
+type simulateBundleResponseData struct {
+	Result txSimData.BundleSimulationResults `json:"result"`
+}
+
+type simulateBundleResponse struct {
+	Data  simulateBundleResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string                     `json:"code"`
+}
+
+type traceTxResponseData struct {
+	Result txSimData.SimulationResultsWithVMOutput `json:"result"`
+}
+
+type traceTxResponse struct {
+	Data  traceTxResponseData `json:"data"`
+	Error string              `json:"error"`
+	Code  string              `json:"code"`
+}
+
 type sendSingleTxResponseData struct {
 	TxHash string `json:"txHash"`
 }
@@ -129,6 +149,26 @@ type txPoolNonceGapsForSenderResponse struct {
 	Code  string                               `json:"code"`
 }
 
+type txPoolSenderDetailsResponseData struct {
+	Details common.TransactionsPoolSenderDetailsApiResponse `json:"details"`
+}
+
+type txPoolSenderDetailsResponse struct {
+	Data  txPoolSenderDetailsResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
+type txPoolStatisticsResponseData struct {
+	Statistics common.TransactionsPoolStatisticsApiResponse `json:"statistics"`
+}
+
+type txPoolStatisticsResponse struct {
+	Data  txPoolStatisticsResponseData `json:"data"`
+	Error string                       `json:"error"`
+	Code  string                       `json:"code"`
+}
+
 var (
 	sender      = "sender"
 	receiver    = "receiver"
@@ -236,6 +276,71 @@ func TestTransactionsGroup_getTransaction(t *testing.T) {
 	})
 }
 
+func TestTransactionsGroup_traceTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/"+hexTxHash+"/trace", nil))
+	t.Run("facade returns error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			TraceTransactionHandler: func(txHash string) (*txSimData.SimulationResultsWithVMOutput, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/"+hexTxHash+"/trace",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTrace := []*txSimData.ExecutionFrame{
+			{
+				Type:     "scCall",
+				Function: "function",
+				GasUsed:  100,
+				Calls: []*txSimData.ExecutionFrame{
+					{
+						Type:     "builtInFunction",
+						Function: "ESDTTransfer",
+					},
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			TraceTransactionHandler: func(txHash string) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, hexTxHash, txHash)
+				return &txSimData.SimulationResultsWithVMOutput{
+					SimulationResults: dataTx.SimulationResults{
+						Status: "success",
+					},
+					Trace: expectedTrace,
+				}, nil
+			},
+		}
+
+		response := &traceTxResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/"+hexTxHash+"/trace",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		assert.Equal(t, hexTxHash, response.Data.Result.Hash)
+		assert.Equal(t, expectedTrace, response.Data.Result.Trace)
+	})
+}
+
 func TestTransactionGroup_sendTransaction(t *testing.T) {
 	t.Parallel()
 
@@ -484,7 +589,7 @@ func TestTransactionGroup_computeTransactionGasLimit(t *testing.T) {
 			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 				return nil, nil, expectedErr
 			},
-			ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction) (*dataTx.CostResponse, error) {
+			ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*dataTx.CostResponse, error) {
 				require.Fail(t, "should not have been called")
 				return nil, nil
 			},
@@ -506,7 +611,7 @@ func TestTransactionGroup_computeTransactionGasLimit(t *testing.T) {
 			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 				return nil, nil, nil
 			},
-			ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction) (*dataTx.CostResponse, error) {
+			ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*dataTx.CostResponse, error) {
 				return nil, expectedErr
 			},
 		}
@@ -529,7 +634,7 @@ func TestTransactionGroup_computeTransactionGasLimit(t *testing.T) {
 			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 				return &dataTx.Transaction{}, nil, nil
 			},
-			ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction) (*dataTx.CostResponse, error) {
+			ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*dataTx.CostResponse, error) {
 				return &dataTx.CostResponse{
 					GasUnits:      expectedGasLimit,
 					ReturnMessage: "",
@@ -569,6 +674,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/simulate", &dataTx.FrontendTransaction{}))
 	t.Run("invalid param transaction should error", testTransactionGroupErrorScenario("/transaction/simulate", "POST", jsonTxStr, http.StatusBadRequest, apiErrors.ErrValidation))
 	t.Run("invalid param checkSignature should error", testTransactionGroupErrorScenario("/transaction/simulate?checkSignature=not-bool", "POST", &dataTx.FrontendTransaction{}, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("invalid param trace should error", testTransactionGroupErrorScenario("/transaction/simulate?trace=not-bool", "POST", &dataTx.FrontendTransaction{}, http.StatusBadRequest, apiErrors.ErrValidation))
 	t.Run("CreateTransaction error should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -576,7 +682,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 				return nil, nil, expectedErr
 			},
-			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool, stateOverrides map[string]*txSimData.AccountOverride) error {
 				require.Fail(t, "should have not been called")
 				return nil
 			},
@@ -598,10 +704,10 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 				return nil, nil, nil
 			},
-			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool, stateOverrides map[string]*txSimData.AccountOverride) error {
 				return expectedErr
 			},
-			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 				require.Fail(t, "should have not been called")
 				return nil, nil
 			},
@@ -623,10 +729,10 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 				return nil, nil, nil
 			},
-			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool, stateOverrides map[string]*txSimData.AccountOverride) error {
 				return nil
 			},
-			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 				return nil, expectedErr
 			},
 		}
@@ -646,7 +752,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 		processTxWasCalled := false
 
 		facade := &mock.FacadeStub{
-			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 				processTxWasCalled = true
 				return &txSimData.SimulationResultsWithVMOutput{
 					SimulationResults: dataTx.SimulationResults{
@@ -661,7 +767,7 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 				return &dataTx.Transaction{}, []byte("hash"), nil
 			},
-			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool, stateOverrides map[string]*txSimData.AccountOverride) error {
 				return nil
 			},
 		}
@@ -692,6 +798,238 @@ func TestTransactionGroup_simulateTransaction(t *testing.T) {
 	})
 }
 
+func TestTransactionGroup_simulateTransactionWithTrace(t *testing.T) {
+	t.Parallel()
+
+	jsonBytes, _ := json.Marshal(&dataTx.FrontendTransaction{})
+	testWithTrace := func(url string, expectedWithTrace bool) func(t *testing.T) {
+		return func(t *testing.T) {
+			t.Parallel()
+
+			facade := &mock.FacadeStub{
+				CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+					return &dataTx.Transaction{}, []byte("hash"), nil
+				},
+				SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
+					require.Equal(t, expectedWithTrace, withTrace)
+					return &txSimData.SimulationResultsWithVMOutput{}, nil
+				},
+			}
+
+			response := &simulateTxResponse{}
+			loadTransactionGroupResponse(
+				t,
+				facade,
+				url,
+				"POST",
+				bytes.NewBuffer(jsonBytes),
+				response,
+			)
+			assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		}
+	}
+
+	t.Run("trace should be disabled by default", testWithTrace("/transaction/simulate", false))
+	t.Run("trace=true should enable the trace", testWithTrace("/transaction/simulate?trace=true", true))
+	t.Run("trace=false should disable the trace", testWithTrace("/transaction/simulate?trace=false", false))
+}
+
+func TestTransactionGroup_simulateTransactionWithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	nonceOverride := uint64(7)
+	expectedStateOverrides := map[string]*txSimData.AccountOverride{
+		"erd1address": {
+			Balance: "1000",
+			Nonce:   &nonceOverride,
+			Code:    "0102",
+			Storage: map[string]string{"6b6579": "76616c7565"},
+		},
+	}
+	request := groups.SimulationRequest{
+		FrontendTransaction: dataTx.FrontendTransaction{
+			Sender:   "sender1",
+			Receiver: "receiver1",
+			Value:    "100",
+		},
+		StateOverrides: expectedStateOverrides,
+	}
+	jsonBytes, _ := json.Marshal(request)
+
+	t.Run("simulate should pass the state overrides", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				require.Equal(t, "sender1", txArgs.Sender)
+				return &dataTx.Transaction{}, []byte("hash"), nil
+			},
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, expectedStateOverrides, stateOverrides)
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+
+		response := &simulateTxResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/simulate",
+			"POST",
+			bytes.NewBuffer(jsonBytes),
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+	})
+	t.Run("cost should pass the state overrides", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				require.Equal(t, "sender1", txArgs.Sender)
+				return &dataTx.Transaction{}, []byte("hash"), nil
+			},
+			ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*dataTx.CostResponse, error) {
+				require.Equal(t, expectedStateOverrides, stateOverrides)
+				return &dataTx.CostResponse{GasUnits: 10}, nil
+			},
+		}
+
+		response := &transactionCostResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/cost",
+			"POST",
+			bytes.NewBuffer(jsonBytes),
+			response,
+		)
+		assert.Equal(t, uint64(10), response.Data.Cost)
+	})
+}
+
+func TestTransactionGroup_simulateTransactionsBundle(t *testing.T) {
+	t.Parallel()
+
+	bundle := []*dataTx.FrontendTransaction{{}, {}}
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/simulate-bundle", bundle))
+	t.Run("invalid param transactions should error", testTransactionGroupErrorScenario("/transaction/simulate-bundle", "POST", jsonTxStr, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("empty bundle should error", testTransactionGroupErrorScenario("/transaction/simulate-bundle", "POST", []*dataTx.FrontendTransaction{}, http.StatusBadRequest, apiErrors.ErrValidationEmptyTxsBundle))
+	t.Run("invalid param checkSignature should error", testTransactionGroupErrorScenario("/transaction/simulate-bundle?checkSignature=not-bool", "POST", bundle, http.StatusBadRequest, apiErrors.ErrValidation))
+	t.Run("CreateTransaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return nil, nil, expectedErr
+			},
+			SimulateTransactionsBundleExecutionHandler: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/simulate-bundle",
+			"POST",
+			bundle,
+			http.StatusBadRequest,
+			expectedErr,
+		)
+	})
+	t.Run("ValidateTransactionForSimulation error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool, stateOverrides map[string]*txSimData.AccountOverride) error {
+				return expectedErr
+			},
+			SimulateTransactionsBundleExecutionHandler: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/simulate-bundle",
+			"POST",
+			bundle,
+			http.StatusBadRequest,
+			expectedErr,
+		)
+	})
+	t.Run("SimulateTransactionsBundleExecution error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			SimulateTransactionsBundleExecutionHandler: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/simulate-bundle",
+			"POST",
+			bundle,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{Nonce: txArgs.Nonce}, []byte{byte(txArgs.Nonce)}, nil
+			},
+			SimulateTransactionsBundleExecutionHandler: func(txs []*dataTx.Transaction) (*txSimData.BundleSimulationResults, error) {
+				require.Equal(t, 2, len(txs))
+				require.Equal(t, uint64(0), txs[0].Nonce)
+				require.Equal(t, uint64(1), txs[1].Nonce)
+				return &txSimData.BundleSimulationResults{
+					Results: []*txSimData.SimulationResultsWithVMOutput{
+						{SimulationResults: dataTx.SimulationResults{Status: dataTx.TxStatusSuccess}},
+						{SimulationResults: dataTx.SimulationResults{Status: dataTx.TxStatusFail}},
+					},
+					AccountsDiff: map[string]*txSimData.AccountDiff{
+						"sender": {
+							NonceBefore: 0,
+							NonceAfter:  2,
+						},
+					},
+				}, nil
+			},
+		}
+
+		jsonBytes, _ := json.Marshal([]*dataTx.FrontendTransaction{{Nonce: 0}, {Nonce: 1}})
+		response := &simulateBundleResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/simulate-bundle",
+			"POST",
+			bytes.NewBuffer(jsonBytes),
+			response,
+		)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+		require.Equal(t, 2, len(response.Data.Result.Results))
+		assert.Equal(t, "00", response.Data.Result.Results[0].Hash)
+		assert.Equal(t, dataTx.TxStatusSuccess, response.Data.Result.Results[0].Status)
+		assert.Equal(t, "01", response.Data.Result.Results[1].Hash)
+		assert.Equal(t, dataTx.TxStatusFail, response.Data.Result.Results[1].Status)
+		assert.Equal(t, uint64(2), response.Data.Result.AccountsDiff["sender"].NonceAfter)
+	})
+}
+
 func TestTransactionGroup_getTransactionsPool(t *testing.T) {
 	t.Parallel()
 
@@ -704,11 +1042,13 @@ func TestTransactionGroup_getTransactionsPool(t *testing.T) {
 	t.Run("fields + nonce gaps", testTxPoolWithInvalidQuery("?fields=sender,receiver&nonce-gaps=true", apiErrors.ErrFetchingNonceGapsCannotIncludeFields))
 	t.Run("fields has spaces", testTxPoolWithInvalidQuery("?fields=sender ,receiver", apiErrors.ErrInvalidFields))
 	t.Run("fields has numbers", testTxPoolWithInvalidQuery("?fields=sender1", apiErrors.ErrInvalidFields))
+	t.Run("sender + receiver filter", testTxPoolWithInvalidQuery("?by-sender=sender&receiver=receiver", apiErrors.ErrTransactionsPoolFilterCannotIncludeSender))
+	t.Run("sender + function filter", testTxPoolWithInvalidQuery("?by-sender=sender&function=claim", apiErrors.ErrTransactionsPoolFilterCannotIncludeSender))
 	t.Run("GetTransactionsPool error should error", func(t *testing.T) {
 		t.Parallel()
 
 		facade := &mock.FacadeStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return nil, expectedErr
 			},
 		}
@@ -795,7 +1135,7 @@ func TestTransactionGroup_getTransactionsPool(t *testing.T) {
 			},
 		}
 		facade := &mock.FacadeStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return expectedTxPool, nil
 			},
 		}
@@ -812,6 +1152,38 @@ func TestTransactionGroup_getTransactionsPool(t *testing.T) {
 		assert.Empty(t, response.Error)
 		assert.Equal(t, *expectedTxPool, response.Data.TxPool)
 	})
+	t.Run("should work with filter", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTxPool := &common.TransactionsPoolAPIResponse{
+			RegularTransactions: []common.Transaction{
+				{
+					TxFields: map[string]interface{}{
+						"hash": "tx",
+					},
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
+				assert.Equal(t, "receiver", fields)
+				assert.Equal(t, common.TransactionsPoolFilter{Receiver: "erd1receiver", Function: "claim"}, filter)
+				return expectedTxPool, nil
+			},
+		}
+
+		response := &txsPoolResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/pool?fields=receiver&receiver=erd1receiver&function=claim",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedTxPool, response.Data.TxPool)
+	})
 	t.Run("should work for sender", func(t *testing.T) {
 		t.Parallel()
 
@@ -912,6 +1284,143 @@ func TestTransactionGroup_getTransactionsPool(t *testing.T) {
 	})
 }
 
+func TestTransactionGroup_getTransactionsPoolSenderDetails(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/pool/sender/sender", nil))
+	t.Run("number of go routines exceeded for its own endpoint", testExceededNumGoRoutinesForEndpoint("/transaction/pool/sender/sender", "/transaction/pool/sender/:sender"))
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolSenderDetailsCalled: func(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool/sender/sender",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSender := "sender"
+		expectedDetails := &common.TransactionsPoolSenderDetailsApiResponse{
+			Sender:            expectedSender,
+			AccountNonce:      30,
+			NumTransactions:   2,
+			Gaps:              []common.NonceGapApiResponse{{From: 31, To: 32}},
+			StuckTransactions: []string{"txHash2"},
+			Transactions: []common.Transaction{
+				{
+					TxFields: map[string]interface{}{
+						"hash": "txHash1",
+					},
+				},
+				{
+					TxFields: map[string]interface{}{
+						"hash": "txHash2",
+					},
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolSenderDetailsCalled: func(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+				assert.Equal(t, expectedSender, sender)
+				return expectedDetails, nil
+			},
+		}
+
+		response := &txPoolSenderDetailsResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/pool/sender/"+expectedSender,
+			"GET",
+			nil,
+			response,
+		)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedDetails, response.Data.Details)
+	})
+}
+
+func TestTransactionGroup_getTransactionsPoolStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("number of go routines exceeded", testExceededNumGoRoutines("/transaction/pool/statistics", nil))
+	t.Run("number of go routines exceeded for its own endpoint", testExceededNumGoRoutinesForEndpoint("/transaction/pool/statistics", "/transaction/pool/statistics"))
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolStatisticsCalled: func() (*common.TransactionsPoolStatisticsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+		testTransactionsGroup(
+			t,
+			facade,
+			"/transaction/pool/statistics",
+			"GET",
+			nil,
+			http.StatusInternalServerError,
+			expectedErr,
+		)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStatistics := &common.TransactionsPoolStatisticsApiResponse{
+			Caches: []common.TransactionsPoolCacheStatisticsApiResponse{
+				{
+					CacheID:         "0",
+					NumTransactions: 10,
+					NumSenders:      3,
+					GasPrice: common.GasPriceDistributionApiResponse{
+						Min:    1000000000,
+						Max:    2000000000,
+						Median: 1000000000,
+						P90:    1500000000,
+					},
+				},
+			},
+			Evictions: []common.TransactionsPoolEvictionRecord{
+				{
+					CacheID:           "0",
+					Timestamp:         1700000000,
+					Reason:            "capacity",
+					NumTxsEvicted:     20,
+					NumSendersEvicted: 4,
+				},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetTransactionsPoolStatisticsCalled: func() (*common.TransactionsPoolStatisticsApiResponse, error) {
+				return expectedStatistics, nil
+			},
+		}
+
+		response := &txPoolStatisticsResponse{}
+		loadTransactionGroupResponse(
+			t,
+			facade,
+			"/transaction/pool/statistics",
+			"GET",
+			nil,
+			response,
+		)
+		assert.Empty(t, response.Error)
+		assert.Equal(t, *expectedStatistics, response.Data.Statistics)
+	})
+}
+
 func testTxPoolWithInvalidQuery(query string, expectedErr error) func(t *testing.T) {
 	return func(t *testing.T) {
 		t.Parallel()
@@ -969,7 +1478,7 @@ func TestTransactionsGroup_UpdateFacade(t *testing.T) {
 			},
 		}
 		facade := mock.FacadeStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return expectedTxPool, nil
 			},
 		}
@@ -990,7 +1499,7 @@ func TestTransactionsGroup_UpdateFacade(t *testing.T) {
 		assert.Equal(t, *expectedTxPool, txsPoolResp.Data.TxPool)
 
 		newFacade := mock.FacadeStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return nil, expectedErr
 			},
 		}
@@ -1083,6 +1592,32 @@ func testExceededNumGoRoutines(url string, body interface{}) func(t *testing.T)
 	}
 }
 
+func testExceededNumGoRoutinesForEndpoint(url string, endpoint string) func(t *testing.T) {
+	return func(t *testing.T) {
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(requestedEndpoint string) (core.Throttler, bool) {
+				if requestedEndpoint != endpoint {
+					return nil, false
+				}
+
+				return &mock.ThrottlerStub{
+					CanProcessCalled: func() bool { return false },
+				}, true
+			},
+		}
+
+		testTransactionsGroup(
+			t,
+			facade,
+			url,
+			"GET",
+			nil,
+			http.StatusTooManyRequests,
+			apiErrors.ErrTooManyRequests,
+		)
+	}
+}
+
 func testTransactionsGroup(
 	t *testing.T,
 	facade shared.FacadeHandler,
@@ -1119,9 +1654,13 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/send-multiple", Open: true},
 					{Name: "/cost", Open: true},
 					{Name: "/pool", Open: true},
+					{Name: "/pool/sender/:sender", Open: true},
+					{Name: "/pool/statistics", Open: true},
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
+					{Name: "/:txhash/trace", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/simulate-bundle", Open: true},
 				},
 			},
 		},
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
+
+type adminAuthorizer interface {
+	IsAdminAuthorized(username string, password string) bool
+}
+
+// CreateAdminAuthorizationFromFacade will create a middleware-type of handler to be used in conjunction with the admin
+// REST API end points, requiring the basic authorization credentials configured for the admin routes
+func CreateAdminAuthorizationFromFacade(facade interface{}) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authorizer, ok := facade.(adminAuthorizer)
+		if !ok {
+			c.AbortWithStatusJSON(
+				http.StatusInternalServerError,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: errors.ErrInvalidAppContext.Error(),
+					Code:  shared.ReturnCodeInternalError,
+				},
+			)
+			return
+		}
+
+		username, password, hasCredentials := c.Request.BasicAuth()
+		if !hasCredentials || !authorizer.IsAdminAuthorized(username, password) {
+			c.Header("WWW-Authenticate", `Basic realm="admin"`)
+			c.AbortWithStatusJSON(
+				http.StatusUnauthorized,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: errors.ErrUnauthorized.Error(),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,112 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func startNodeServerAdminAuthorization(handler func(c *gin.Context), facade interface{}) *gin.Engine {
+	ws := gin.New()
+	ws.Use(middleware.CreateAdminAuthorizationFromFacade(facade))
+
+	ginNodeRoutes := ws.Group("/node")
+	ginNodeRoutes.Handle(http.MethodPost, "/admin", handler)
+
+	return ws
+}
+
+func TestCreateAdminAuthorizationFromFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid facade should not execute", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		handlerFunc := func(c *gin.Context) {
+			wasCalled = true
+		}
+		ws := startNodeServerAdminAuthorization(handlerFunc, "invalid facade")
+
+		req, _ := http.NewRequest(http.MethodPost, "/node/admin", nil)
+		req.SetBasicAuth("admin", "password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.False(t, wasCalled)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	})
+	t.Run("missing credentials should not execute", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		handlerFunc := func(c *gin.Context) {
+			wasCalled = true
+		}
+		facade := &mock.FacadeStub{
+			IsAdminAuthorizedCalled: func(username string, password string) bool {
+				assert.Fail(t, "should have not been called")
+				return true
+			},
+		}
+		ws := startNodeServerAdminAuthorization(handlerFunc, facade)
+
+		req, _ := http.NewRequest(http.MethodPost, "/node/admin", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.False(t, wasCalled)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.NotEmpty(t, resp.Header().Get("WWW-Authenticate"))
+	})
+	t.Run("wrong credentials should not execute", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		handlerFunc := func(c *gin.Context) {
+			wasCalled = true
+		}
+		facade := &mock.FacadeStub{
+			IsAdminAuthorizedCalled: func(username string, password string) bool {
+				return false
+			},
+		}
+		ws := startNodeServerAdminAuthorization(handlerFunc, facade)
+
+		req, _ := http.NewRequest(http.MethodPost, "/node/admin", nil)
+		req.SetBasicAuth("admin", "wrong password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.False(t, wasCalled)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("valid credentials should execute", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		handlerFunc := func(c *gin.Context) {
+			wasCalled = true
+			c.JSON(http.StatusOK, "ok")
+		}
+		facade := &mock.FacadeStub{
+			IsAdminAuthorizedCalled: func(username string, password string) bool {
+				return username == "admin" && password == "password"
+			},
+		}
+		ws := startNodeServerAdminAuthorization(handlerFunc, facade)
+
+		req, _ := http.NewRequest(http.MethodPost, "/node/admin", nil)
+		req.SetBasicAuth("admin", "password")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.True(t, wasCalled)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+}
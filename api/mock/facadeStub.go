@@ -32,24 +32,30 @@ type FacadeStub struct {
 	GetTransactionHandler                       func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	CreateTransactionHandler                    func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler                  func(tx *transaction.Transaction) error
-	ValidateTransactionForSimulationHandler     func(tx *transaction.Transaction, bypassSignature bool) error
+	ValidateTransactionForSimulationHandler     func(tx *transaction.Transaction, bypassSignature bool, stateOverrides map[string]*txSimData.AccountOverride) error
 	SendBulkTransactionsHandler                 func(txs []*transaction.Transaction) (uint64, error)
 	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vm.VMOutputApi, api.BlockInfo, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler                  func() (map[string]*validator.ValidatorStatistics, error)
-	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error)
 	NodeConfigCalled                            func() map[string]interface{}
 	GetQueryHandlerCalled                       func(name string) (debug.QueryHandler, error)
+	GetInterceptorResolverDebugCountersCalled   func() []*debug.InterceptorResolverCounters
 	GetValueForKeyCalled                        func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetGuardianDataCalled                       func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	GetPeerInfoCalled                           func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetworkCalled func() (string, error)
 	GetEpochStartDataAPICalled                  func(epoch uint32) (*common.EpochStartDataAPI, error)
+	GetTrieStatisticsCalled                     func(rootHash string) (*common.TrieStatisticsAPIResponse, error)
 	GetThrottlerForEndpointCalled               func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                           func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetCodeHashCalled                           func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
 	GetKeyValuePairsCalled                      func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
-	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	GetKeyValuePairsPageCalled                  func(address string, options api.AccountQueryOptions, startKey []byte, pageSize int, keyPrefix []byte) (*common.KeyValuePairsPage, api.BlockInfo, error)
+	GetAccountHistoryCalled                     func(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryAPIResponse, error)
+	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundleExecutionHandler  func(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransactionHandler                     func(txHash string) (*txSimData.SimulationResultsWithVMOutput, error)
 	GetESDTDataCalled                           func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetAllESDTTokensCalled                      func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetESDTsWithRoleCalled                      func(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
@@ -76,13 +82,19 @@ type FacadeStub struct {
 	GetProofCurrentRootHashCalled               func(string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                      func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                           func(string, string, [][]byte) (bool, error)
+	GetMultiProofCalled                         func(rootHash string, keys []string) (*common.GetMultiProofResponse, error)
+	GetMultiProofDataTrieCalled                 func(rootHash string, address string, keys []string) (*common.GetMultiProofDataTrieResponse, error)
+	VerifyMultiProofCalled                      func(rootHash string, keys []string, values [][]byte, proof [][]byte) (bool, error)
+	GetStateDiffCalled                          func(options common.StateDiffQueryOptions) (*common.StateDiffAPIResponse, error)
 	GetTokenSupplyCalled                        func(token string) (*api.ESDTSupply, error)
 	GetGenesisNodesPubKeysCalled                func() (map[uint32][]string, map[uint32][]string, error)
 	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
-	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPoolCalled                   func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolSenderDetailsCalled      func(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error)
+	GetTransactionsPoolStatisticsCalled         func() (*common.TransactionsPoolStatisticsApiResponse, error)
 	GetGasConfigsCalled                         func() (map[string]map[string]uint64, error)
 	RestApiInterfaceCalled                      func() string
 	RestAPIServerDebugModeCalled                func() bool
@@ -95,6 +107,10 @@ type FacadeStub struct {
 	GetEligibleManagedKeysCalled                func() ([]string, error)
 	GetWaitingManagedKeysCalled                 func() ([]string, error)
 	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
+	AddManagedKeyCalled                         func(key string) (string, error)
+	RemoveManagedKeyCalled                      func(publicKey string) error
+	StepDownRedundancyLeaseCalled               func() error
+	IsAdminAuthorizedCalled                     func(username string, password string) bool
 	P2PPrometheusMetricsEnabledCalled           func() bool
 	AuctionListHandler                          func() ([]*common.AuctionListValidatorAPIResponse, error)
 }
@@ -117,6 +133,42 @@ func (f *FacadeStub) GetProof(rootHash string, address string) (*common.GetProof
 	return nil, nil
 }
 
+// GetMultiProof -
+func (f *FacadeStub) GetMultiProof(rootHash string, keys []string) (*common.GetMultiProofResponse, error) {
+	if f.GetMultiProofCalled != nil {
+		return f.GetMultiProofCalled(rootHash, keys)
+	}
+
+	return nil, nil
+}
+
+// GetMultiProofDataTrie -
+func (f *FacadeStub) GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetMultiProofDataTrieResponse, error) {
+	if f.GetMultiProofDataTrieCalled != nil {
+		return f.GetMultiProofDataTrieCalled(rootHash, address, keys)
+	}
+
+	return nil, nil
+}
+
+// VerifyMultiProof -
+func (f *FacadeStub) VerifyMultiProof(rootHash string, keys []string, values [][]byte, proof [][]byte) (bool, error) {
+	if f.VerifyMultiProofCalled != nil {
+		return f.VerifyMultiProofCalled(rootHash, keys, values, proof)
+	}
+
+	return false, nil
+}
+
+// GetStateDiff -
+func (f *FacadeStub) GetStateDiff(options common.StateDiffQueryOptions) (*common.StateDiffAPIResponse, error) {
+	if f.GetStateDiffCalled != nil {
+		return f.GetStateDiffCalled(options)
+	}
+
+	return nil, nil
+}
+
 // GetProofCurrentRootHash -
 func (f *FacadeStub) GetProofCurrentRootHash(address string) (*common.GetProofResponse, error) {
 	if f.GetProofCurrentRootHashCalled != nil {
@@ -231,6 +283,24 @@ func (f *FacadeStub) GetKeyValuePairs(address string, options api.AccountQueryOp
 	return nil, api.BlockInfo{}, nil
 }
 
+// GetKeyValuePairsPage -
+func (f *FacadeStub) GetKeyValuePairsPage(address string, options api.AccountQueryOptions, startKey []byte, pageSize int, keyPrefix []byte) (*common.KeyValuePairsPage, api.BlockInfo, error) {
+	if f.GetKeyValuePairsPageCalled != nil {
+		return f.GetKeyValuePairsPageCalled(address, options, startKey, pageSize, keyPrefix)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
+// GetAccountHistory -
+func (f *FacadeStub) GetAccountHistory(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryAPIResponse, error) {
+	if f.GetAccountHistoryCalled != nil {
+		return f.GetAccountHistoryCalled(address, options)
+	}
+
+	return nil, nil
+}
+
 // GetGuardianData -
 func (f *FacadeStub) GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error) {
 	if f.GetGuardianDataCalled != nil {
@@ -330,9 +400,27 @@ func (f *FacadeStub) GetTransaction(hash string, withResults bool) (*transaction
 }
 
 // SimulateTransactionExecution is the mock implementation of a handler's SimulateTransactionExecution method
-func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 	if f.SimulateTransactionExecutionHandler != nil {
-		return f.SimulateTransactionExecutionHandler(tx)
+		return f.SimulateTransactionExecutionHandler(tx, stateOverrides, withTrace)
+	}
+
+	return nil, nil
+}
+
+// SimulateTransactionsBundleExecution is the mock implementation of a handler's SimulateTransactionsBundleExecution method
+func (f *FacadeStub) SimulateTransactionsBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if f.SimulateTransactionsBundleExecutionHandler != nil {
+		return f.SimulateTransactionsBundleExecutionHandler(txs)
+	}
+
+	return nil, nil
+}
+
+// TraceTransaction is the mock implementation of a handler's TraceTransaction method
+func (f *FacadeStub) TraceTransaction(txHash string) (*txSimData.SimulationResultsWithVMOutput, error) {
+	if f.TraceTransactionHandler != nil {
+		return f.TraceTransactionHandler(txHash)
 	}
 
 	return nil, nil
@@ -357,9 +445,9 @@ func (f *FacadeStub) ValidateTransaction(tx *transaction.Transaction) error {
 }
 
 // ValidateTransactionForSimulation -
-func (f *FacadeStub) ValidateTransactionForSimulation(tx *transaction.Transaction, bypassSignature bool) error {
+func (f *FacadeStub) ValidateTransactionForSimulation(tx *transaction.Transaction, bypassSignature bool, stateOverrides map[string]*txSimData.AccountOverride) error {
 	if f.ValidateTransactionForSimulationHandler != nil {
-		return f.ValidateTransactionForSimulationHandler(tx, bypassSignature)
+		return f.ValidateTransactionForSimulationHandler(tx, bypassSignature, stateOverrides)
 	}
 
 	return nil
@@ -429,9 +517,9 @@ func (f *FacadeStub) GetDelegatorsList() ([]*api.Delegator, error) {
 }
 
 // ComputeTransactionGasLimit -
-func (f *FacadeStub) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+func (f *FacadeStub) ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error) {
 	if f.ComputeTransactionGasLimitHandler != nil {
-		return f.ComputeTransactionGasLimitHandler(tx)
+		return f.ComputeTransactionGasLimitHandler(tx, stateOverrides)
 	}
 
 	return nil, nil
@@ -468,6 +556,15 @@ func (f *FacadeStub) GetQueryHandler(name string) (debug.QueryHandler, error) {
 	return nil, nil
 }
 
+// GetInterceptorResolverDebugCounters -
+func (f *FacadeStub) GetInterceptorResolverDebugCounters() []*debug.InterceptorResolverCounters {
+	if f.GetInterceptorResolverDebugCountersCalled != nil {
+		return f.GetInterceptorResolverDebugCountersCalled()
+	}
+
+	return nil
+}
+
 // GetPeerInfo -
 func (f *FacadeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	if f.GetPeerInfoCalled != nil {
@@ -491,6 +588,15 @@ func (f *FacadeStub) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataA
 	return f.GetEpochStartDataAPICalled(epoch)
 }
 
+// GetTrieStatistics -
+func (f *FacadeStub) GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+	if f.GetTrieStatisticsCalled != nil {
+		return f.GetTrieStatisticsCalled(rootHash)
+	}
+
+	return nil, nil
+}
+
 // GetBlockByNonce -
 func (f *FacadeStub) GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
 	if f.GetBlockByNonceCalled != nil {
@@ -607,9 +713,9 @@ func (f *FacadeStub) GetGenesisBalances() ([]*common.InitialAccountAPI, error) {
 }
 
 // GetTransactionsPool -
-func (f *FacadeStub) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
+func (f *FacadeStub) GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 	if f.GetTransactionsPoolCalled != nil {
-		return f.GetTransactionsPoolCalled(fields)
+		return f.GetTransactionsPoolCalled(fields, filter)
 	}
 
 	return nil, nil
@@ -642,6 +748,24 @@ func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*comm
 	return nil, nil
 }
 
+// GetTransactionsPoolSenderDetails -
+func (f *FacadeStub) GetTransactionsPoolSenderDetails(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+	if f.GetTransactionsPoolSenderDetailsCalled != nil {
+		return f.GetTransactionsPoolSenderDetailsCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolStatistics -
+func (f *FacadeStub) GetTransactionsPoolStatistics() (*common.TransactionsPoolStatisticsApiResponse, error) {
+	if f.GetTransactionsPoolStatisticsCalled != nil {
+		return f.GetTransactionsPoolStatisticsCalled()
+	}
+
+	return nil, nil
+}
+
 // GetGasConfigs -
 func (f *FacadeStub) GetGasConfigs() (map[string]map[string]uint64, error) {
 	if f.GetGasConfigsCalled != nil {
@@ -727,6 +851,38 @@ func (f *FacadeStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32,
 	return 0, nil
 }
 
+// AddManagedKey -
+func (f *FacadeStub) AddManagedKey(key string) (string, error) {
+	if f.AddManagedKeyCalled != nil {
+		return f.AddManagedKeyCalled(key)
+	}
+	return "", nil
+}
+
+// RemoveManagedKey -
+func (f *FacadeStub) RemoveManagedKey(publicKey string) error {
+	if f.RemoveManagedKeyCalled != nil {
+		return f.RemoveManagedKeyCalled(publicKey)
+	}
+	return nil
+}
+
+// StepDownRedundancyLease -
+func (f *FacadeStub) StepDownRedundancyLease() error {
+	if f.StepDownRedundancyLeaseCalled != nil {
+		return f.StepDownRedundancyLeaseCalled()
+	}
+	return nil
+}
+
+// IsAdminAuthorized -
+func (f *FacadeStub) IsAdminAuthorized(username string, password string) bool {
+	if f.IsAdminAuthorizedCalled != nil {
+		return f.IsAdminAuthorizedCalled(username, password)
+	}
+	return false
+}
+
 // P2PPrometheusMetricsEnabled -
 func (f *FacadeStub) P2PPrometheusMetricsEnabled() bool {
 	if f.P2PPrometheusMetricsEnabledCalled != nil {
@@ -74,6 +74,8 @@ type FacadeHandler interface {
 	GetESDTsWithRole(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetAllESDTTokens(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetKeyValuePairsPage(address string, options api.AccountQueryOptions, startKey []byte, pageSize int, keyPrefix []byte) (*common.KeyValuePairsPage, api.BlockInfo, error)
+	GetAccountHistory(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryAPIResponse, error)
 	GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
@@ -98,21 +100,29 @@ type FacadeHandler interface {
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
 	GetQueryHandler(name string) (debug.QueryHandler, error)
+	GetInterceptorResolverDebugCounters() []*debug.InterceptorResolverCounters
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
+	GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetwork() (string, error)
 	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, keys []string) (*common.GetMultiProofResponse, error)
+	GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetMultiProofDataTrieResponse, error)
+	VerifyMultiProof(rootHash string, keys []string, values [][]byte, proof [][]byte) (bool, error)
+	GetStateDiff(options common.StateDiffQueryOptions) (*common.StateDiffAPIResponse, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
-	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
+	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool, stateOverrides map[string]*txSimData.AccountOverride) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransaction(txHash string) (*txSimData.SimulationResultsWithVMOutput, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error)
 	AuctionListApi() ([]*common.AuctionListValidatorAPIResponse, error)
@@ -124,10 +134,12 @@ type FacadeHandler interface {
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error)
 	GetGenesisBalances() ([]*common.InitialAccountAPI, error)
 	GetGasConfigs() (map[string]map[string]uint64, error)
-	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolSenderDetails(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error)
+	GetTransactionsPoolStatistics() (*common.TransactionsPoolStatisticsApiResponse, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
 	GetManagedKeysCount() int
 	GetManagedKeys() []string
@@ -135,6 +147,10 @@ type FacadeHandler interface {
 	GetEligibleManagedKeys() ([]string, error)
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
+	AddManagedKey(key string) (string, error)
+	RemoveManagedKey(publicKey string) error
+	StepDownRedundancyLease() error
+	IsAdminAuthorized(username string, password string) bool
 	P2PPrometheusMetricsEnabled() bool
 	IsInterfaceNil() bool
 }
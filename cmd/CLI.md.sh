@@ -2,10 +2,15 @@
 
 generate() {
     generateForAssessmentTool
+    generateForBlockReplay
+    generateForDBInspect
+    generateForDBPruner
     generateForKeyGenerator
     generateForLogViewer
     generateForNode
+    generateForRemoteSigner
     generateForSeedNode
+    generateForSlashingProtection
     generateForTermUi
 }
 
@@ -23,6 +28,48 @@ $(code)
     echo "$HELP" > ./assessment/CLI.md
 }
 
+generateForBlockReplay() {
+    HELP="
+# MultiversX BlockReplay CLI
+
+The **MultiversX BlockReplay Tool** exposes the following Command Line Interface:
+$(code)
+\$ blockreplay --help
+
+$(./blockreplay/blockreplay --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./blockreplay/CLI.md
+}
+
+generateForDBInspect() {
+    HELP="
+# MultiversX DBInspect CLI
+
+The **MultiversX DBInspect Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbinspect --help
+
+$(./dbinspect/dbinspect --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbinspect/CLI.md
+}
+
+generateForDBPruner() {
+    HELP="
+# MultiversX DBPruner CLI
+
+The **MultiversX DBPruner Tool** exposes the following Command Line Interface:
+$(code)
+\$ dbpruner --help
+
+$(./dbpruner/dbpruner --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbpruner/CLI.md
+}
+
 generateForKeyGenerator() {
     HELP="
 # Keygenerator CLI
@@ -65,6 +112,20 @@ $(code)
     echo "$HELP" > ./node/CLI.md
 }
 
+generateForRemoteSigner() {
+    HELP="
+# MultiversX RemoteSigner CLI
+
+The **MultiversX RemoteSigner Tool** exposes the following Command Line Interface:
+$(code)
+\$ remotesigner --help
+
+$(./remotesigner/remotesigner --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./remotesigner/CLI.md
+}
+
 generateForSeedNode() {
     HELP="
 # MultiversX SeedNode CLI
@@ -79,6 +140,20 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForSlashingProtection() {
+    HELP="
+# MultiversX SlashingProtection CLI
+
+The **MultiversX SlashingProtection Tool** exposes the following Command Line Interface:
+$(code)
+\$ slashingprotection --help
+
+$(./slashingprotection/slashingprotection --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./slashingprotection/CLI.md
+}
+
 generateForTermUi() {
     HELP="
 # MultiversX TermUI CLI
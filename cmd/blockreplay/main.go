@@ -0,0 +1,274 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/node"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+var (
+	blockReplayHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// pathToNodeConfigs defines a flag for the path to the directory holding the node configuration files
+	pathToNodeConfigs = cli.StringFlag{
+		Name:  "node-configs",
+		Usage: "The `[path]` to the directory holding the configuration files of the node that produced the storage",
+		Value: "../node/config/",
+	}
+	// workingDirectory defines a flag for the directory holding the node storage
+	workingDirectory = cli.StringFlag{
+		Name: "working-directory",
+		Usage: "The `[path]` to the working directory of the node, holding the db directory. The tool should be run " +
+			"on a copy of the node's db directory, never on the storage of a running node. If empty, the current " +
+			"directory is used",
+		Value: "",
+	}
+	// destinationShard defines a flag for the shard of the replayed block
+	destinationShard = cli.StringFlag{
+		Name:  "destination-shard",
+		Usage: "The `shard` that produced the storage. It can be a shard ID or metachain",
+		Value: "0",
+	}
+	// nonce defines a flag for the nonce of the replayed block
+	nonce = cli.Uint64Flag{
+		Name:  "nonce",
+		Usage: "The `nonce` of the block to be replayed on top of the state of its previous block",
+	}
+	// outputFile defines a flag for the file where the replay results will be written
+	outputFile = cli.StringFlag{
+		Name:  "output-file",
+		Usage: "The `[path]` to the file where the replay results will be written as JSON. If empty, the results are printed",
+		Value: "",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = blockReplayHelpTemplate
+	app.Name = "BlockReplay CLI App"
+	app.Usage = "This tool re-executes a stored block on top of the state of its previous block and reports the " +
+		"accounts that differ when the computed root hash does not match the one recorded in the block header"
+	app.Flags = []cli.Flag{
+		pathToNodeConfigs,
+		workingDirectory,
+		destinationShard,
+		nonce,
+		outputFile,
+		logLevel,
+	}
+	app.Version = "v0.0.1"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return replayBlock(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func replayBlock(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	workingDir := ctx.GlobalString(workingDirectory.Name)
+	if len(workingDir) == 0 {
+		workingDir, err = os.Getwd()
+		if err != nil {
+			return err
+		}
+	}
+
+	configs, err := loadConfigs(ctx.GlobalString(pathToNodeConfigs.Name), workingDir, ctx.App.Version)
+	if err != nil {
+		return err
+	}
+	configs.PreferencesConfig.Preferences.DestinationShardAsObserver = ctx.GlobalString(destinationShard.Name)
+
+	nodeRunner, err := node.NewNodeRunner(configs)
+	if err != nil {
+		return err
+	}
+
+	results, err := nodeRunner.ReplayBlock(ctx.GlobalUint64(nonce.Name))
+	if err != nil {
+		return err
+	}
+
+	resultsBytes, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	log.Info("block replayed",
+		"shard", results.ShardID,
+		"nonce", results.Nonce,
+		"root hash matches", results.RootHashMatches,
+		"num different accounts", len(results.AccountsDiff),
+	)
+
+	outputFilePath := ctx.GlobalString(outputFile.Name)
+	if len(outputFilePath) == 0 {
+		fmt.Println(string(resultsBytes))
+		return nil
+	}
+
+	return os.WriteFile(outputFilePath, resultsBytes, core.FileModeUserReadWrite)
+}
+
+func loadConfigs(configsPath string, workingDir string, version string) (*config.Configs, error) {
+	configurationPaths := &config.ConfigurationPathsHolder{
+		MainConfig:               path.Join(configsPath, "config.toml"),
+		ApiRoutes:                path.Join(configsPath, "api.toml"),
+		Economics:                path.Join(configsPath, "economics.toml"),
+		SystemSC:                 path.Join(configsPath, "systemSmartContractsConfig.toml"),
+		Ratings:                  path.Join(configsPath, "ratings.toml"),
+		Preferences:              path.Join(configsPath, "prefs.toml"),
+		External:                 path.Join(configsPath, "external.toml"),
+		MainP2p:                  path.Join(configsPath, "p2p.toml"),
+		FullArchiveP2p:           path.Join(configsPath, "fullArchiveP2P.toml"),
+		GasScheduleDirectoryName: path.Join(configsPath, "gasSchedules"),
+		Nodes:                    path.Join(configsPath, "nodesSetup.json"),
+		Genesis:                  path.Join(configsPath, "genesis.json"),
+		SmartContracts:           path.Join(configsPath, "genesisSmartContracts.json"),
+		ValidatorKey:             path.Join(configsPath, "validatorKey.pem"),
+		AllValidatorKeys:         path.Join(configsPath, "allValidatorsKeys.pem"),
+		Epoch:                    path.Join(configsPath, "enableEpochs.toml"),
+		RoundActivation:          path.Join(configsPath, "enableRounds.toml"),
+		P2pKey:                   path.Join(configsPath, "p2pKey.pem"),
+	}
+
+	generalConfig, err := common.LoadMainConfig(configurationPaths.MainConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	apiRoutesConfig, err := common.LoadApiConfig(configurationPaths.ApiRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	economicsConfig, err := common.LoadEconomicsConfig(configurationPaths.Economics)
+	if err != nil {
+		return nil, err
+	}
+
+	systemSCConfig, err := common.LoadSystemSmartContractsConfig(configurationPaths.SystemSC)
+	if err != nil {
+		return nil, err
+	}
+
+	ratingsConfig, err := common.LoadRatingsConfig(configurationPaths.Ratings)
+	if err != nil {
+		return nil, err
+	}
+
+	preferencesConfig, err := common.LoadPreferencesConfig(configurationPaths.Preferences)
+	if err != nil {
+		return nil, err
+	}
+
+	externalConfig, err := common.LoadExternalConfig(configurationPaths.External)
+	if err != nil {
+		return nil, err
+	}
+
+	mainP2PConfig, err := common.LoadP2PConfig(configurationPaths.MainP2p)
+	if err != nil {
+		return nil, err
+	}
+
+	fullArchiveP2PConfig, err := common.LoadP2PConfig(configurationPaths.FullArchiveP2p)
+	if err != nil {
+		return nil, err
+	}
+
+	epochConfig, err := common.LoadEpochConfig(configurationPaths.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	roundConfig, err := common.LoadRoundConfig(configurationPaths.RoundActivation)
+	if err != nil {
+		return nil, err
+	}
+
+	// the block is replayed from the local storage, without connecting to the network
+	generalConfig.GeneralSettings.StartInEpochEnabled = false
+	mainP2PConfig.Node.MinNumPeersToWaitForOnBootstrap = 0
+	mainP2PConfig.Node.ThresholdMinConnectedPeers = 0
+	mainP2PConfig.KadDhtPeerDiscovery.Enabled = false
+	fullArchiveP2PConfig.Node.MinNumPeersToWaitForOnBootstrap = 0
+	fullArchiveP2PConfig.Node.ThresholdMinConnectedPeers = 0
+	fullArchiveP2PConfig.KadDhtPeerDiscovery.Enabled = false
+
+	absoluteWorkingDir, err := filepath.Abs(workingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &config.Configs{
+		GeneralConfig:            generalConfig,
+		ApiRoutesConfig:          apiRoutesConfig,
+		EconomicsConfig:          economicsConfig,
+		SystemSCConfig:           systemSCConfig,
+		RatingsConfig:            ratingsConfig,
+		PreferencesConfig:        preferencesConfig,
+		ExternalConfig:           externalConfig,
+		MainP2pConfig:            mainP2PConfig,
+		FullArchiveP2pConfig:     fullArchiveP2PConfig,
+		ConfigurationPathsHolder: configurationPaths,
+		EpochConfig:              epochConfig,
+		RoundConfig:              roundConfig,
+		FlagsConfig: &config.ContextFlagsConfig{
+			WorkingDir: absoluteWorkingDir,
+			DbDir:      absoluteWorkingDir,
+			LogsDir:    absoluteWorkingDir,
+			Version:    version,
+		},
+		ImportDbConfig: &config.ImportDbConfig{},
+	}, nil
+}
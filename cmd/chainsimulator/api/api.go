@@ -0,0 +1,357 @@
+package api
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	simulatorGroupPath          = "/simulator"
+	generateBlocksPath          = "/generate-blocks/:num"
+	generateBlocksUntilEpoch    = "/generate-blocks-until-epoch-reached/:epoch"
+	fastForwardUntilEpoch       = "/fast-forward-until-epoch-reached/:epoch"
+	setStatePath                = "/set-state"
+	addValidatorKeysPath        = "/add-validator-keys"
+	mintWalletPath              = "/mint-wallet"
+	sendTxAndWaitPath           = "/send-and-wait"
+	initialWalletsPath          = "/initial-wallets"
+	nodesPath                   = "/nodes"
+	snapshotPath                = "/snapshot"
+	revertPath                  = "/revert/:id"
+	maxNumOfBlocksParam         = "maxNumOfBlocks"
+	defaultMaxNumOfBlocksForTxs = 20
+)
+
+var log = logger.GetOrCreate("chainsimulator/api")
+
+type simulatorGroup struct {
+	simulator       SimulatorHandler
+	pubKeyConverter core.PubkeyConverter
+}
+
+// Start will boot up the api and appropriate routes, handlers and validators
+func Start(restApiInterface string, simulator SimulatorHandler, pubKeyConverter core.PubkeyConverter) error {
+	ws, err := createEngine(simulator, pubKeyConverter)
+	if err != nil {
+		return err
+	}
+
+	log.Info("starting chain simulator REST API", "interface", restApiInterface)
+
+	return ws.Run(restApiInterface)
+}
+
+func createEngine(simulator SimulatorHandler, pubKeyConverter core.PubkeyConverter) (*gin.Engine, error) {
+	if check.IfNil(simulator) {
+		return nil, ErrNilSimulatorHandler
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	ws := gin.Default()
+	ws.Use(cors.Default())
+
+	group := &simulatorGroup{
+		simulator:       simulator,
+		pubKeyConverter: pubKeyConverter,
+	}
+	group.registerRoutes(ws.Group(simulatorGroupPath))
+
+	return ws, nil
+}
+
+func (sg *simulatorGroup) registerRoutes(routes *gin.RouterGroup) {
+	routes.POST(generateBlocksPath, sg.generateBlocks)
+	routes.POST(generateBlocksUntilEpoch, sg.generateBlocksUntilEpochIsReached)
+	routes.POST(fastForwardUntilEpoch, sg.fastForwardUntilEpochIsReached)
+	routes.POST(setStatePath, sg.setState)
+	routes.POST(addValidatorKeysPath, sg.addValidatorKeys)
+	routes.POST(mintWalletPath, sg.mintWallet)
+	routes.POST(sendTxAndWaitPath, sg.sendTxAndWait)
+	routes.GET(initialWalletsPath, sg.getInitialWallets)
+	routes.GET(nodesPath, sg.getNodes)
+	routes.POST(snapshotPath, sg.snapshot)
+	routes.POST(revertPath, sg.revert)
+}
+
+func (sg *simulatorGroup) generateBlocks(c *gin.Context) {
+	numOfBlocks, err := strconv.ParseUint(c.Param("num"), 10, 32)
+	if err != nil {
+		respondWithValidationError(c, err)
+		return
+	}
+
+	err = sg.simulator.GenerateBlocks(int(numOfBlocks))
+	if err != nil {
+		respondWithInternalError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, gin.H{})
+}
+
+func (sg *simulatorGroup) generateBlocksUntilEpochIsReached(c *gin.Context) {
+	epoch, err := strconv.ParseInt(c.Param("epoch"), 10, 32)
+	if err != nil {
+		respondWithValidationError(c, err)
+		return
+	}
+
+	err = sg.simulator.GenerateBlocksUntilEpochIsReached(int32(epoch))
+	if err != nil {
+		respondWithInternalError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, gin.H{})
+}
+
+func (sg *simulatorGroup) fastForwardUntilEpochIsReached(c *gin.Context) {
+	epoch, err := strconv.ParseInt(c.Param("epoch"), 10, 32)
+	if err != nil {
+		respondWithValidationError(c, err)
+		return
+	}
+
+	err = sg.simulator.FastForwardUntilEpochIsReached(int32(epoch))
+	if err != nil {
+		respondWithInternalError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, gin.H{})
+}
+
+func (sg *simulatorGroup) setState(c *gin.Context) {
+	stateSlice := make([]*dtos.AddressState, 0)
+	err := c.ShouldBindJSON(&stateSlice)
+	if err != nil {
+		respondWithValidationError(c, err)
+		return
+	}
+
+	err = sg.simulator.SetStateMultiple(stateSlice)
+	if err != nil {
+		respondWithInternalError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, gin.H{})
+}
+
+func (sg *simulatorGroup) addValidatorKeys(c *gin.Context) {
+	request := AddValidatorKeysRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		respondWithValidationError(c, err)
+		return
+	}
+
+	privateKeys := make([][]byte, 0, len(request.PrivateKeysHex))
+	for _, privateKeyHex := range request.PrivateKeysHex {
+		privateKey, errDecode := hex.DecodeString(privateKeyHex)
+		if errDecode != nil {
+			respondWithValidationError(c, errDecode)
+			return
+		}
+
+		privateKeys = append(privateKeys, privateKey)
+	}
+
+	err = sg.simulator.AddValidatorKeys(privateKeys)
+	if err != nil {
+		respondWithInternalError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, gin.H{})
+}
+
+func (sg *simulatorGroup) mintWallet(c *gin.Context) {
+	request := MintWalletRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		respondWithValidationError(c, err)
+		return
+	}
+
+	value, ok := big.NewInt(0).SetString(request.Value, 10)
+	if !ok {
+		respondWithValidationError(c, fmt.Errorf("%w for the value field: %s", ErrInvalidValue, request.Value))
+		return
+	}
+
+	address, err := sg.simulator.GenerateAndMintWalletAddress(request.ShardID, value)
+	if err != nil {
+		respondWithInternalError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, gin.H{"address": address})
+}
+
+func (sg *simulatorGroup) sendTxAndWait(c *gin.Context) {
+	maxNumOfBlocks := uint64(defaultMaxNumOfBlocksForTxs)
+	maxNumOfBlocksString := c.Query(maxNumOfBlocksParam)
+	if len(maxNumOfBlocksString) > 0 {
+		var err error
+		maxNumOfBlocks, err = strconv.ParseUint(maxNumOfBlocksString, 10, 32)
+		if err != nil {
+			respondWithValidationError(c, err)
+			return
+		}
+	}
+
+	ftx := transaction.FrontendTransaction{}
+	err := c.ShouldBindJSON(&ftx)
+	if err != nil {
+		respondWithValidationError(c, err)
+		return
+	}
+
+	tx, err := sg.createTransaction(&ftx)
+	if err != nil {
+		respondWithValidationError(c, err)
+		return
+	}
+
+	result, err := sg.simulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, int(maxNumOfBlocks))
+	if err != nil {
+		respondWithInternalError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, gin.H{"transaction": result})
+}
+
+func (sg *simulatorGroup) createTransaction(ftx *transaction.FrontendTransaction) (*transaction.Transaction, error) {
+	value, ok := big.NewInt(0).SetString(ftx.Value, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w for the value field: %s", ErrInvalidValue, ftx.Value)
+	}
+
+	sender, err := sg.pubKeyConverter.Decode(ftx.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the sender field: %s", err, ftx.Sender)
+	}
+
+	receiver, err := sg.pubKeyConverter.Decode(ftx.Receiver)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the receiver field: %s", err, ftx.Receiver)
+	}
+
+	signature, err := hex.DecodeString(ftx.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the signature field", err)
+	}
+
+	tx := &transaction.Transaction{
+		Nonce:       ftx.Nonce,
+		Value:       value,
+		RcvAddr:     receiver,
+		RcvUserName: ftx.ReceiverUsername,
+		SndAddr:     sender,
+		SndUserName: ftx.SenderUsername,
+		GasPrice:    ftx.GasPrice,
+		GasLimit:    ftx.GasLimit,
+		Data:        ftx.Data,
+		ChainID:     []byte(ftx.ChainID),
+		Version:     ftx.Version,
+		Signature:   signature,
+		Options:     ftx.Options,
+	}
+
+	if len(ftx.GuardianAddr) > 0 {
+		tx.GuardianAddr, err = sg.pubKeyConverter.Decode(ftx.GuardianAddr)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the guardian field: %s", err, ftx.GuardianAddr)
+		}
+
+		tx.GuardianSignature, err = hex.DecodeString(ftx.GuardianSignature)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the guardian signature field", err)
+		}
+	}
+
+	return tx, nil
+}
+
+func (sg *simulatorGroup) getInitialWallets(c *gin.Context) {
+	respondWithSuccess(c, gin.H{"initialWallets": sg.simulator.GetInitialWalletKeys()})
+}
+
+func (sg *simulatorGroup) getNodes(c *gin.Context) {
+	respondWithSuccess(c, gin.H{"restApiInterfaces": sg.simulator.GetRestAPIInterfaces()})
+}
+
+func (sg *simulatorGroup) snapshot(c *gin.Context) {
+	snapshotID, err := sg.simulator.Snapshot()
+	if err != nil {
+		respondWithInternalError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, gin.H{"snapshotID": snapshotID})
+}
+
+func (sg *simulatorGroup) revert(c *gin.Context) {
+	snapshotID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		respondWithValidationError(c, err)
+		return
+	}
+
+	err = sg.simulator.RevertTo(snapshotID)
+	if err != nil {
+		respondWithInternalError(c, err)
+		return
+	}
+
+	respondWithSuccess(c, gin.H{})
+}
+
+func respondWithSuccess(c *gin.Context, data interface{}) {
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  data,
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func respondWithValidationError(c *gin.Context, err error) {
+	c.JSON(
+		http.StatusBadRequest,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: fmt.Sprintf("%s: %s", ErrValidation.Error(), err.Error()),
+			Code:  shared.ReturnCodeRequestError,
+		},
+	)
+}
+
+func respondWithInternalError(c *gin.Context, err error) {
+	c.JSON(
+		http.StatusInternalServerError,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: err.Error(),
+			Code:  shared.ReturnCodeInternalError,
+		},
+	)
+}
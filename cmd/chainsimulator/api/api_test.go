@@ -0,0 +1,338 @@
+package api
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	chainSimulatorMocks "github.com/multiversx/mx-chain-go/testscommon/chainSimulator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSender   = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	testReceiver = "erd1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqzu66jx"
+)
+
+var expectedErr = errors.New("expected error")
+
+type simulatorResponse struct {
+	Data  map[string]interface{} `json:"data"`
+	Error string                 `json:"error"`
+	Code  shared.ReturnCode      `json:"code"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func createEngineWithStub(t *testing.T, stub *chainSimulatorMocks.SimulatorHandlerStub) *gin.Engine {
+	ws, err := createEngine(stub, testscommon.RealWorldBech32PubkeyConverter)
+	require.Nil(t, err)
+
+	return ws
+}
+
+func doRequest(ws *gin.Engine, method string, path string, body interface{}) (int, *simulatorResponse) {
+	buff, _ := json.Marshal(body)
+	req, _ := http.NewRequest(method, path, bytes.NewBuffer(buff))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &simulatorResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(response)
+
+	return resp.Code, response
+}
+
+func TestCreateEngine(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil simulator should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws, err := createEngine(nil, testscommon.RealWorldBech32PubkeyConverter)
+		assert.Nil(t, ws)
+		assert.Equal(t, ErrNilSimulatorHandler, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws, err := createEngine(&chainSimulatorMocks.SimulatorHandlerStub{}, nil)
+		assert.Nil(t, ws)
+		assert.Equal(t, ErrNilPubKeyConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ws, err := createEngine(&chainSimulatorMocks.SimulatorHandlerStub{}, testscommon.RealWorldBech32PubkeyConverter)
+		assert.NotNil(t, ws)
+		assert.Nil(t, err)
+	})
+}
+
+func TestSimulatorGroup_GenerateBlocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of blocks should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws := createEngineWithStub(t, &chainSimulatorMocks.SimulatorHandlerStub{})
+		code, response := doRequest(ws, http.MethodPost, "/simulator/generate-blocks/abc", nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, ErrValidation.Error()))
+	})
+	t.Run("simulator error should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws := createEngineWithStub(t, &chainSimulatorMocks.SimulatorHandlerStub{
+			GenerateBlocksCalled: func(numOfBlocks int) error {
+				return expectedErr
+			},
+		})
+		code, response := doRequest(ws, http.MethodPost, "/simulator/generate-blocks/5", nil)
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		generatedBlocks := 0
+		ws := createEngineWithStub(t, &chainSimulatorMocks.SimulatorHandlerStub{
+			GenerateBlocksCalled: func(numOfBlocks int) error {
+				generatedBlocks = numOfBlocks
+				return nil
+			},
+		})
+		code, response := doRequest(ws, http.MethodPost, "/simulator/generate-blocks/5", nil)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+		assert.Equal(t, 5, generatedBlocks)
+	})
+}
+
+func TestSimulatorGroup_GenerateBlocksUntilEpochIsReached(t *testing.T) {
+	t.Parallel()
+
+	targetEpoch := int32(0)
+	ws := createEngineWithStub(t, &chainSimulatorMocks.SimulatorHandlerStub{
+		GenerateBlocksUntilEpochIsReachedCalled: func(epoch int32) error {
+			targetEpoch = epoch
+			return nil
+		},
+	})
+	code, _ := doRequest(ws, http.MethodPost, "/simulator/generate-blocks-until-epoch-reached/4", nil)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, int32(4), targetEpoch)
+}
+
+func TestSimulatorGroup_FastForwardUntilEpochIsReached(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws := createEngineWithStub(t, &chainSimulatorMocks.SimulatorHandlerStub{})
+		code, _ := doRequest(ws, http.MethodPost, "/simulator/fast-forward-until-epoch-reached/abc", nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		targetEpoch := int32(0)
+		ws := createEngineWithStub(t, &chainSimulatorMocks.SimulatorHandlerStub{
+			FastForwardUntilEpochIsReachedCalled: func(epoch int32) error {
+				targetEpoch = epoch
+				return nil
+			},
+		})
+		code, _ := doRequest(ws, http.MethodPost, "/simulator/fast-forward-until-epoch-reached/10", nil)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int32(10), targetEpoch)
+	})
+}
+
+func TestSimulatorGroup_SetState(t *testing.T) {
+	t.Parallel()
+
+	var providedState []*dtos.AddressState
+	ws := createEngineWithStub(t, &chainSimulatorMocks.SimulatorHandlerStub{
+		SetStateMultipleCalled: func(stateSlice []*dtos.AddressState) error {
+			providedState = stateSlice
+			return nil
+		},
+	})
+
+	state := []*dtos.AddressState{
+		{
+			Address: testSender,
+			Balance: "1000",
+		},
+	}
+	code, _ := doRequest(ws, http.MethodPost, "/simulator/set-state", state)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, state, providedState)
+}
+
+func TestSimulatorGroup_AddValidatorKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid hex key should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws := createEngineWithStub(t, &chainSimulatorMocks.SimulatorHandlerStub{
+			AddValidatorKeysCalled: func(validatorsPrivateKeys [][]byte) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		})
+		request := AddValidatorKeysRequest{
+			PrivateKeysHex: []string{"not a hex"},
+		}
+		code, _ := doRequest(ws, http.MethodPost, "/simulator/add-validator-keys", request)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var providedKeys [][]byte
+		ws := createEngineWithStub(t, &chainSimulatorMocks.SimulatorHandlerStub{
+			AddValidatorKeysCalled: func(validatorsPrivateKeys [][]byte) error {
+				providedKeys = validatorsPrivateKeys
+				return nil
+			},
+		})
+		request := AddValidatorKeysRequest{
+			PrivateKeysHex: []string{hex.EncodeToString([]byte("key1")), hex.EncodeToString([]byte("key2"))},
+		}
+		code, _ := doRequest(ws, http.MethodPost, "/simulator/add-validator-keys", request)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, [][]byte{[]byte("key1"), []byte("key2")}, providedKeys)
+	})
+}
+
+func TestSimulatorGroup_MintWallet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid value should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws := createEngineWithStub(t, &chainSimulatorMocks.SimulatorHandlerStub{})
+		request := MintWalletRequest{
+			ShardID: 1,
+			Value:   "not a number",
+		}
+		code, response := doRequest(ws, http.MethodPost, "/simulator/mint-wallet", request)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.True(t, strings.Contains(response.Error, ErrInvalidValue.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ws := createEngineWithStub(t, &chainSimulatorMocks.SimulatorHandlerStub{
+			GenerateAndMintWalletAddressCalled: func(targetShardID uint32, value *big.Int) (dtos.WalletAddress, error) {
+				assert.Equal(t, uint32(1), targetShardID)
+				assert.Equal(t, big.NewInt(1000), value)
+				return dtos.WalletAddress{Bech32: testSender}, nil
+			},
+		})
+		request := MintWalletRequest{
+			ShardID: 1,
+			Value:   "1000",
+		}
+		code, response := doRequest(ws, http.MethodPost, "/simulator/mint-wallet", request)
+		assert.Equal(t, http.StatusOK, code)
+		address := response.Data["address"].(map[string]interface{})
+		assert.Equal(t, testSender, address["bech32"])
+	})
+}
+
+func TestSimulatorGroup_SendTxAndWait(t *testing.T) {
+	t.Parallel()
+
+	ftx := transaction.FrontendTransaction{
+		Nonce:     3,
+		Value:     "100",
+		Receiver:  testReceiver,
+		Sender:    testSender,
+		GasPrice:  1000000000,
+		GasLimit:  50000,
+		Data:      []byte("data"),
+		Signature: hex.EncodeToString([]byte("signature")),
+		ChainID:   "chain",
+		Version:   1,
+	}
+
+	t.Run("invalid sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidTx := ftx
+		invalidTx.Sender = "invalid"
+		ws := createEngineWithStub(t, &chainSimulatorMocks.SimulatorHandlerStub{})
+		code, _ := doRequest(ws, http.MethodPost, "/simulator/send-and-wait", invalidTx)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+	t.Run("invalid max number of blocks should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws := createEngineWithStub(t, &chainSimulatorMocks.SimulatorHandlerStub{})
+		code, _ := doRequest(ws, http.MethodPost, "/simulator/send-and-wait?maxNumOfBlocks=abc", ftx)
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ws := createEngineWithStub(t, &chainSimulatorMocks.SimulatorHandlerStub{
+			SendTxAndGenerateBlockTilTxIsExecutedCalled: func(txToSend *transaction.Transaction, maxNumOfBlocks int) (*transaction.ApiTransactionResult, error) {
+				assert.Equal(t, 7, maxNumOfBlocks)
+				assert.Equal(t, uint64(3), txToSend.Nonce)
+				assert.Equal(t, big.NewInt(100), txToSend.Value)
+				assert.Equal(t, []byte("signature"), txToSend.Signature)
+				assert.Equal(t, []byte("chain"), txToSend.ChainID)
+
+				return &transaction.ApiTransactionResult{Hash: "hash"}, nil
+			},
+		})
+		code, response := doRequest(ws, http.MethodPost, "/simulator/send-and-wait?maxNumOfBlocks=7", ftx)
+		assert.Equal(t, http.StatusOK, code)
+		result := response.Data["transaction"].(map[string]interface{})
+		assert.Equal(t, "hash", result["hash"])
+	})
+}
+
+func TestSimulatorGroup_SnapshotAndRevert(t *testing.T) {
+	t.Parallel()
+
+	revertedTo := uint64(0)
+	ws := createEngineWithStub(t, &chainSimulatorMocks.SimulatorHandlerStub{
+		SnapshotCalled: func() (uint64, error) {
+			return 2, nil
+		},
+		RevertToCalled: func(snapshotID uint64) error {
+			revertedTo = snapshotID
+			return nil
+		},
+	})
+
+	code, response := doRequest(ws, http.MethodPost, "/simulator/snapshot", nil)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, float64(2), response.Data["snapshotID"])
+
+	code, _ = doRequest(ws, http.MethodPost, "/simulator/revert/2", nil)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, uint64(2), revertedTo)
+}
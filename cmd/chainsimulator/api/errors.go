@@ -0,0 +1,15 @@
+package api
+
+import "errors"
+
+// ErrNilSimulatorHandler signals that a nil simulator handler has been provided
+var ErrNilSimulatorHandler = errors.New("nil simulator handler")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrValidation signals that the request could not be validated
+var ErrValidation = errors.New("validation error")
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
@@ -0,0 +1,24 @@
+package api
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+)
+
+// SimulatorHandler defines what the chain simulator should be able to do in order to be controlled over REST
+type SimulatorHandler interface {
+	GenerateBlocks(numOfBlocks int) error
+	GenerateBlocksUntilEpochIsReached(targetEpoch int32) error
+	FastForwardUntilEpochIsReached(targetEpoch int32) error
+	SetStateMultiple(stateSlice []*dtos.AddressState) error
+	AddValidatorKeys(validatorsPrivateKeys [][]byte) error
+	GenerateAndMintWalletAddress(targetShardID uint32, value *big.Int) (dtos.WalletAddress, error)
+	SendTxAndGenerateBlockTilTxIsExecuted(txToSend *transaction.Transaction, maxNumOfBlocksToGenerateWhenExecutingTx int) (*transaction.ApiTransactionResult, error)
+	GetInitialWalletKeys() *dtos.InitialWalletKeys
+	GetRestAPIInterfaces() map[uint32]string
+	Snapshot() (uint64, error)
+	RevertTo(snapshotID uint64) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,12 @@
+package api
+
+// MintWalletRequest holds the fields needed to generate and mint a new wallet
+type MintWalletRequest struct {
+	ShardID uint32 `json:"shardID"`
+	Value   string `json:"value"`
+}
+
+// AddValidatorKeysRequest holds the hex encoded BLS private keys to be added on all nodes
+type AddValidatorKeysRequest struct {
+	PrivateKeysHex []string `json:"privateKeysHex"`
+}
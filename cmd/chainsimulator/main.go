@@ -0,0 +1,223 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/cmd/chainsimulator/api"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/components"
+	chainSimulatorApi "github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+var (
+	chainSimulatorHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// restApiInterfaceFlag defines a flag for the interface on which the simulator REST API will try to bind with
+	restApiInterfaceFlag = cli.StringFlag{
+		Name: "rest-api-interface",
+		Usage: "The interface `address and port` to which the simulator REST API will attempt to bind. " +
+			"To bind to all available interfaces, set this flag to :8085",
+		Value: "localhost:8085",
+	}
+	// nodesApiInterfaceFlag defines a flag for the interface on which the nodes REST APIs will try to bind with
+	nodesApiInterfaceFlag = cli.StringFlag{
+		Name: "nodes-api-interface",
+		Usage: "The interface `address` to which the REST APIs of the simulated nodes will attempt to bind. " +
+			"Each node will receive a free port. If set to `off` then the nodes APIs won't be available",
+		Value: "localhost",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+	// pathToNodeConfigs defines a flag for the path to the directory holding the node configuration files
+	pathToNodeConfigs = cli.StringFlag{
+		Name:  "node-configs",
+		Usage: "The `[path]` to the directory holding the node configuration files",
+		Value: "../node/config/",
+	}
+	// numOfShards defines a flag for the number of shards to be simulated
+	numOfShards = cli.UintFlag{
+		Name:  "num-of-shards",
+		Usage: "The number of shards to be simulated",
+		Value: 3,
+	}
+	// minNodesPerShard defines a flag for the minimum number of nodes in each shard
+	minNodesPerShard = cli.UintFlag{
+		Name:  "min-nodes-per-shard",
+		Usage: "The minimum number of nodes in each shard",
+		Value: 1,
+	}
+	// metaChainMinNodes defines a flag for the minimum number of nodes in the metachain
+	metaChainMinNodes = cli.UintFlag{
+		Name:  "metachain-min-nodes",
+		Usage: "The minimum number of nodes in the metachain",
+		Value: 1,
+	}
+	// roundsPerEpoch defines a flag for the number of rounds in an epoch
+	roundsPerEpoch = cli.Uint64Flag{
+		Name:  "rounds-per-epoch",
+		Usage: "The number of rounds per epoch. If set to 0, the value from the node configuration files is used",
+		Value: 20,
+	}
+	// roundDurationInMillis defines a flag for the round duration
+	roundDurationInMillis = cli.Uint64Flag{
+		Name:  "round-duration",
+		Usage: "The round duration in milliseconds",
+		Value: 6000,
+	}
+	// bypassTxSignatureCheck defines a flag for disabling the transactions signature check
+	bypassTxSignatureCheck = cli.BoolFlag{
+		Name:  "bypass-tx-signature-check",
+		Usage: "Boolean option for disabling the transactions signature check",
+	}
+	// enableSnapshots defines a flag for enabling the snapshot and revert support
+	enableSnapshots = cli.BoolFlag{
+		Name:  "enable-snapshots",
+		Usage: "Boolean option for enabling the snapshot and revert support. When enabled, the state pruning is disabled on all nodes",
+	}
+	// tempDir defines a flag for the directory where the simulated nodes will save their data
+	tempDir = cli.StringFlag{
+		Name:  "temp-dir",
+		Usage: "The `[path]` to the directory where the simulated nodes will save their data. If empty, a new temporary directory will be created",
+	}
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = chainSimulatorHelpTemplate
+	app.Name = "ChainSimulator CLI App"
+	app.Usage = "This is the entry point for starting a new chain simulator - the app will expose a REST API for controlling the simulated chain"
+	app.Flags = []cli.Flag{
+		restApiInterfaceFlag,
+		nodesApiInterfaceFlag,
+		logLevel,
+		pathToNodeConfigs,
+		numOfShards,
+		minNodesPerShard,
+		metaChainMinNodes,
+		roundsPerEpoch,
+		roundDurationInMillis,
+		bypassTxSignatureCheck,
+		enableSnapshots,
+		tempDir,
+	}
+	app.Version = "v0.0.1"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return startChainSimulator(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startChainSimulator(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	workingDir := ctx.GlobalString(tempDir.Name)
+	if len(workingDir) == 0 {
+		workingDir, err = os.MkdirTemp("", "chainsimulator")
+		if err != nil {
+			return err
+		}
+		defer func() {
+			log.LogIfError(os.RemoveAll(workingDir))
+		}()
+	}
+
+	var apiConfigurator components.APIConfigurator = chainSimulatorApi.NewNoApiInterface()
+	nodesApiInterface := ctx.GlobalString(nodesApiInterfaceFlag.Name)
+	if nodesApiInterface != "off" {
+		apiConfigurator = chainSimulatorApi.NewFreePortAPIConfigurator(nodesApiInterface)
+	}
+
+	rounds := core.OptionalUint64{}
+	if ctx.GlobalUint64(roundsPerEpoch.Name) > 0 {
+		rounds = core.OptionalUint64{
+			HasValue: true,
+			Value:    ctx.GlobalUint64(roundsPerEpoch.Name),
+		}
+	}
+
+	log.Info("starting chain simulator...", "working dir", workingDir)
+	simulator, err := chainSimulator.NewChainSimulator(chainSimulator.ArgsChainSimulator{
+		BypassTxSignatureCheck: ctx.GlobalBool(bypassTxSignatureCheck.Name),
+		EnableSnapshots:        ctx.GlobalBool(enableSnapshots.Name),
+		TempDir:                workingDir,
+		PathToInitialConfig:    ctx.GlobalString(pathToNodeConfigs.Name),
+		NumOfShards:            uint32(ctx.GlobalUint(numOfShards.Name)),
+		MinNodesPerShard:       uint32(ctx.GlobalUint(minNodesPerShard.Name)),
+		MetaChainMinNodes:      uint32(ctx.GlobalUint(metaChainMinNodes.Name)),
+		GenesisTimestamp:       time.Now().Unix(),
+		RoundDurationInMillis:  ctx.GlobalUint64(roundDurationInMillis.Name),
+		RoundsPerEpoch:         rounds,
+		ApiInterface:           apiConfigurator,
+	})
+	if err != nil {
+		return err
+	}
+	defer simulator.Close()
+
+	metaNode := simulator.GetNodeHandler(core.MetachainShardId)
+	pubKeyConverter := metaNode.GetCoreComponents().AddressPubKeyConverter()
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- api.Start(ctx.GlobalString(restApiInterfaceFlag.Name), simulator, pubKeyConverter)
+	}()
+
+	for shardID, restApiInterface := range simulator.GetRestAPIInterfaces() {
+		log.Info("node REST API", "shard", shardID, "interface", restApiInterface)
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	log.Info("application is now running...")
+	select {
+	case <-sigs:
+		log.Info("terminating at user's signal...")
+		return nil
+	case err = <-errChan:
+		return err
+	}
+}
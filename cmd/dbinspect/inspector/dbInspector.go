@@ -0,0 +1,466 @@
+package inspector
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("cmd/dbinspect/inspector")
+
+// ArgsDBInspector holds the arguments needed to create a database inspector
+type ArgsDBInspector struct {
+	// DBPath is the path to the databases of a chain, for example <working directory>/db/<chain ID>
+	DBPath              string
+	ShardID             uint32
+	GeneralConfig       config.Config
+	Marshaller          marshal.Marshalizer
+	Hasher              hashing.Hasher
+	Uint64Converter     typeConverters.Uint64ByteSliceConverter
+	AddressConverter    core.PubkeyConverter
+	EnableEpochsHandler common.EnableEpochsHandler
+}
+
+// UnitInfo holds the locations where a storage unit was found
+type UnitInfo struct {
+	Name      string   `json:"name"`
+	FilePath  string   `json:"filePath"`
+	Locations []string `json:"locations"`
+}
+
+// UnitKeys holds the keys of a storage unit found in one location
+type UnitKeys struct {
+	Location string   `json:"location"`
+	Keys     []string `json:"keys"`
+}
+
+// Record holds a value found in a storage unit. The raw value is provided when the value could not be decoded
+type Record struct {
+	Unit        string      `json:"unit"`
+	Location    string      `json:"location"`
+	Key         string      `json:"key"`
+	Value       interface{} `json:"value,omitempty"`
+	RawValue    string      `json:"rawValue,omitempty"`
+	DecodeError string      `json:"decodeError,omitempty"`
+}
+
+type location struct {
+	name string
+	path string
+}
+
+type dbInspector struct {
+	dbPath              string
+	shardID             uint32
+	shardIDString       string
+	generalConfig       config.Config
+	marshaller          marshal.Marshalizer
+	hasher              hashing.Hasher
+	uint64Converter     typeConverters.Uint64ByteSliceConverter
+	addressConverter    core.PubkeyConverter
+	enableEpochsHandler common.EnableEpochsHandler
+	units               []*unitDefinition
+}
+
+// NewDBInspector creates a component able to read the storage units of a node without starting it
+func NewDBInspector(args ArgsDBInspector) (*dbInspector, error) {
+	if len(args.DBPath) == 0 {
+		return nil, ErrEmptyDBPath
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return nil, ErrNilUint64Converter
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, ErrNilAddressConverter
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+
+	inspector := &dbInspector{
+		dbPath:              args.DBPath,
+		shardID:             args.ShardID,
+		shardIDString:       core.GetShardIDString(args.ShardID),
+		generalConfig:       args.GeneralConfig,
+		marshaller:          args.Marshaller,
+		hasher:              args.Hasher,
+		uint64Converter:     args.Uint64Converter,
+		addressConverter:    args.AddressConverter,
+		enableEpochsHandler: args.EnableEpochsHandler,
+		units:               createUnitDefinitions(args.GeneralConfig),
+	}
+
+	shardHdrNonceHashUnits, err := inspector.discoverShardHdrNonceHashUnits(args.GeneralConfig)
+	if err != nil {
+		return nil, err
+	}
+	inspector.units = append(inspector.units, shardHdrNonceHashUnits...)
+
+	return inspector, nil
+}
+
+// discoverShardHdrNonceHashUnits returns the definitions of the static shard nonce-hash units found on disk
+func (inspector *dbInspector) discoverShardHdrNonceHashUnits(generalConfig config.Config) ([]*unitDefinition, error) {
+	units := make([]*unitDefinition, 0)
+	entries, err := os.ReadDir(inspector.staticLocation().path)
+	if os.IsNotExist(err) {
+		return units, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	filePathPrefix := generalConfig.ShardHdrNonceHashStorage.DB.FilePath
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), filePathPrefix) {
+			continue
+		}
+
+		shardID, errParse := strconv.ParseUint(strings.TrimPrefix(entry.Name(), filePathPrefix), 10, 32)
+		if errParse != nil {
+			continue
+		}
+
+		units = append(units, createShardHdrNonceHashUnitDefinition(generalConfig, uint32(shardID)))
+	}
+
+	sort.Slice(units, func(i, j int) bool {
+		return units[i].unitType < units[j].unitType
+	})
+
+	return units, nil
+}
+
+// Epochs returns the epochs for which the inspected shard has a database folder, in ascending order
+func (inspector *dbInspector) Epochs() ([]uint32, error) {
+	entries, err := os.ReadDir(inspector.dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	epochs := make([]uint32, 0)
+	epochPrefix := storage.DefaultEpochString + "_"
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), epochPrefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(entry.Name(), epochPrefix), 10, 32)
+		if errParse != nil {
+			continue
+		}
+		if !directoryExists(inspector.epochLocation(uint32(epoch)).path) {
+			continue
+		}
+
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return epochs, nil
+}
+
+// Units returns all the known storage units, together with the locations where they were found
+func (inspector *dbInspector) Units() ([]*UnitInfo, error) {
+	locations, err := inspector.getLocations(core.OptionalUint32{})
+	if err != nil {
+		return nil, err
+	}
+
+	unitsInfo := make([]*UnitInfo, 0, len(inspector.units))
+	for _, unit := range inspector.units {
+		unitInfo := &UnitInfo{
+			Name:      unit.unitType.String(),
+			FilePath:  unit.dbConfig.FilePath,
+			Locations: make([]string, 0),
+		}
+		for _, loc := range locations {
+			if directoryExists(loc.unitPath(unit)) {
+				unitInfo.Locations = append(unitInfo.Locations, loc.name)
+			}
+		}
+
+		unitsInfo = append(unitsInfo, unitInfo)
+	}
+
+	return unitsInfo, nil
+}
+
+// Keys returns the hex encoded keys of a storage unit, for each location where the unit was found. The static
+// location and all the epochs are inspected if no epoch is provided. A zero limit means no limit
+func (inspector *dbInspector) Keys(unitName string, epoch core.OptionalUint32, limit int) ([]*UnitKeys, error) {
+	unit, err := inspector.getUnit(unitName)
+	if err != nil {
+		return nil, err
+	}
+
+	locations, err := inspector.getLocations(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	numKeys := 0
+	allKeys := make([]*UnitKeys, 0)
+	for _, loc := range locations {
+		if limit > 0 && numKeys >= limit {
+			break
+		}
+
+		persister, errOpen := inspector.openPersister(unit, loc)
+		if errOpen != nil {
+			return nil, errOpen
+		}
+		if check.IfNil(persister) {
+			continue
+		}
+
+		unitKeys := &UnitKeys{
+			Location: loc.name,
+			Keys:     make([]string, 0),
+		}
+		persister.RangeKeys(func(key []byte, _ []byte) bool {
+			unitKeys.Keys = append(unitKeys.Keys, hex.EncodeToString(key))
+			numKeys++
+
+			return limit <= 0 || numKeys < limit
+		})
+		log.LogIfError(persister.Close())
+
+		allKeys = append(allKeys, unitKeys)
+	}
+
+	return allKeys, nil
+}
+
+// Get returns the decoded values of a key, from each location where the key was found. The static location and
+// all the epochs, starting with the most recent one, are inspected if no epoch is provided
+func (inspector *dbInspector) Get(unitName string, key []byte, epoch core.OptionalUint32) ([]*Record, error) {
+	unit, err := inspector.getUnit(unitName)
+	if err != nil {
+		return nil, err
+	}
+
+	return inspector.get(unit, key, epoch)
+}
+
+// HeaderByNonce returns the decoded header with the provided nonce of the provided shard, by using the nonce-hash
+// storage units. The metachain headers can be inspected on any shard, while the headers of a shard can only be
+// inspected on that shard or on the metachain
+func (inspector *dbInspector) HeaderByNonce(headerShardID uint32, nonce uint64, epoch core.OptionalUint32) ([]*Record, error) {
+	nonceHashUnitType := dataRetriever.MetaHdrNonceHashDataUnit
+	headerUnitType := dataRetriever.MetaBlockUnit
+	if headerShardID != core.MetachainShardId {
+		nonceHashUnitType = dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(headerShardID)
+		headerUnitType = dataRetriever.BlockHeaderUnit
+	}
+
+	nonceHashUnit, err := inspector.getUnit(nonceHashUnitType.String())
+	if err != nil {
+		return nil, err
+	}
+	headerUnit, err := inspector.getUnit(headerUnitType.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// the nonce-hash units are static, so they are not filtered by epoch
+	hashRecords, err := inspector.get(nonceHashUnit, inspector.uint64Converter.ToByteSlice(nonce), core.OptionalUint32{})
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*Record, 0)
+	for _, hashRecord := range hashRecords {
+		headerHash, errDecode := hex.DecodeString(fmt.Sprintf("%v", hashRecord.Value))
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w while decoding the header hash found in %s", errDecode, hashRecord.Location)
+		}
+
+		headerRecords, errGet := inspector.get(headerUnit, headerHash, epoch)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		records = append(records, headerRecords...)
+	}
+
+	return records, nil
+}
+
+func (inspector *dbInspector) get(unit *unitDefinition, key []byte, epoch core.OptionalUint32) ([]*Record, error) {
+	locations, err := inspector.getLocations(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*Record, 0)
+	for _, loc := range locations {
+		persister, errOpen := inspector.openPersister(unit, loc)
+		if errOpen != nil {
+			return nil, errOpen
+		}
+		if check.IfNil(persister) {
+			continue
+		}
+
+		value, errGet := persister.Get(key)
+		log.LogIfError(persister.Close())
+		if errors.Is(errGet, storage.ErrKeyNotFound) {
+			continue
+		}
+		if errGet != nil {
+			return nil, fmt.Errorf("%w while reading from %s in %s", errGet, unit.unitType.String(), loc.name)
+		}
+
+		records = append(records, inspector.createRecord(unit, loc, key, value))
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w, unit %s, key %s", ErrKeyNotFound, unit.unitType.String(), hex.EncodeToString(key))
+	}
+
+	return records, nil
+}
+
+func (inspector *dbInspector) createRecord(unit *unitDefinition, loc *location, key []byte, value []byte) *Record {
+	record := &Record{
+		Unit:     unit.unitType.String(),
+		Location: loc.name,
+		Key:      hex.EncodeToString(key),
+	}
+	if unit.decode == nil {
+		record.RawValue = hex.EncodeToString(value)
+		return record
+	}
+
+	decodedValue, err := unit.decode(inspector.marshaller, value)
+	if err != nil {
+		record.RawValue = hex.EncodeToString(value)
+		record.DecodeError = err.Error()
+		return record
+	}
+
+	record.Value = toPrintable(decodedValue)
+
+	return record
+}
+
+func (inspector *dbInspector) getUnit(unitName string) (*unitDefinition, error) {
+	for _, unit := range inspector.units {
+		if unit.unitType.String() == unitName {
+			return unit, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownUnit, unitName)
+}
+
+// getLocations returns the requested epoch location or, if no epoch is provided, the static location followed by
+// all the epoch locations, starting with the most recent one
+func (inspector *dbInspector) getLocations(epoch core.OptionalUint32) ([]*location, error) {
+	if epoch.HasValue {
+		return []*location{inspector.epochLocation(epoch.Value)}, nil
+	}
+
+	epochs, err := inspector.Epochs()
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]*location, 0, len(epochs)+1)
+	locations = append(locations, inspector.staticLocation())
+	for i := len(epochs) - 1; i >= 0; i-- {
+		locations = append(locations, inspector.epochLocation(epochs[i]))
+	}
+
+	return locations, nil
+}
+
+func (inspector *dbInspector) staticLocation() *location {
+	return &location{
+		name: storage.DefaultStaticDbString,
+		path: filepath.Join(
+			inspector.dbPath,
+			storage.DefaultStaticDbString,
+			fmt.Sprintf("%s_%s", storage.DefaultShardString, inspector.shardIDString),
+		),
+	}
+}
+
+func (inspector *dbInspector) epochLocation(epoch uint32) *location {
+	epochDirectory := fmt.Sprintf("%s_%d", storage.DefaultEpochString, epoch)
+
+	return &location{
+		name: epochDirectory,
+		path: filepath.Join(
+			inspector.dbPath,
+			epochDirectory,
+			fmt.Sprintf("%s_%s", storage.DefaultShardString, inspector.shardIDString),
+		),
+	}
+}
+
+// openPersister opens the unit database from the provided location. A nil persister is returned if the database
+// does not exist, so that no new database is created
+func (inspector *dbInspector) openPersister(unit *unitDefinition, loc *location) (storage.Persister, error) {
+	unitPath := loc.unitPath(unit)
+	if !directoryExists(unitPath) {
+		return nil, nil
+	}
+
+	persisterFactory, err := storageFactory.NewPersisterFactory(storageFactory.NewDBConfigHandler(unit.dbConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	persister, err := persisterFactory.Create(unitPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w while opening %s", err, unitPath)
+	}
+
+	return persister, nil
+}
+
+func (loc *location) unitPath(unit *unitDefinition) string {
+	return filepath.Join(loc.path, unit.dbConfig.FilePath)
+}
+
+func directoryExists(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+
+	return info.IsDir()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (inspector *dbInspector) IsInterfaceNil() bool {
+	return inspector == nil
+}
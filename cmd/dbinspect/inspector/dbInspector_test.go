@@ -0,0 +1,351 @@
+package inspector
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestDBConfig(filePath string) config.DBConfig {
+	return config.DBConfig{
+		FilePath:          filePath,
+		Type:              "LvlDBSerial",
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+}
+
+func createTestGeneralConfig() config.Config {
+	generalConfig := config.Config{}
+	generalConfig.TxStorage.DB = createTestDBConfig("Transactions")
+	generalConfig.BlockHeaderStorage.DB = createTestDBConfig("BlockHeaders")
+	generalConfig.MetaBlockStorage.DB = createTestDBConfig("MetaBlock")
+	generalConfig.MetaHdrNonceHashStorage.DB = createTestDBConfig("MetaHdrHashNonce")
+	generalConfig.ShardHdrNonceHashStorage.DB = createTestDBConfig("ShardHdrHashNonce")
+	generalConfig.StatusMetricsStorage.DB = createTestDBConfig("StatusMetricsStorageDB")
+	generalConfig.DbLookupExtensions.EpochByHashStorageConfig.DB = createTestDBConfig("DbLookupExtensions_EpochByHash")
+	generalConfig.DbLookupExtensions.MiniblocksMetadataStorageConfig.DB = createTestDBConfig("DbLookupExtensions/MiniblocksMetadata")
+	generalConfig.AccountsTrieStorage.DB = createTestDBConfig("AccountsTrie")
+	generalConfig.BootstrapStorage.DB = createTestDBConfig("BootstrapData")
+	generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory = 5
+
+	return generalConfig
+}
+
+func createMockArgsDBInspector(dbPath string) ArgsDBInspector {
+	return ArgsDBInspector{
+		DBPath:              dbPath,
+		ShardID:             0,
+		GeneralConfig:       createTestGeneralConfig(),
+		Marshaller:          &marshal.GogoProtoMarshalizer{},
+		Hasher:              blake2b.NewBlake2b(),
+		Uint64Converter:     uint64ByteSlice.NewBigEndianConverter(),
+		AddressConverter:    testscommon.NewPubkeyConverterMock(32),
+		EnableEpochsHandler: &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+	}
+}
+
+func getUnitPath(dbPath string, locationName string, dbConfig config.DBConfig) string {
+	return filepath.Join(dbPath, locationName, fmt.Sprintf("%s_%d", storage.DefaultShardString, 0), dbConfig.FilePath)
+}
+
+func openTestUnit(t *testing.T, unitPath string, dbConfig config.DBConfig) storage.Persister {
+	persisterFactory, err := storageFactory.NewPersisterFactory(storageFactory.NewDBConfigHandler(dbConfig))
+	require.Nil(t, err)
+
+	persister, err := persisterFactory.Create(unitPath)
+	require.Nil(t, err)
+
+	return persister
+}
+
+func putInUnit(t *testing.T, unitPath string, dbConfig config.DBConfig, key []byte, value []byte) {
+	persister := openTestUnit(t, unitPath, dbConfig)
+
+	err := persister.Put(key, value)
+	require.Nil(t, err)
+	err = persister.Close()
+	require.Nil(t, err)
+}
+
+func TestNewDBInspector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty db path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBInspector("")
+		inspector, err := NewDBInspector(args)
+		assert.Nil(t, inspector)
+		assert.Equal(t, ErrEmptyDBPath, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBInspector(t.TempDir())
+		args.Marshaller = nil
+		inspector, err := NewDBInspector(args)
+		assert.Nil(t, inspector)
+		assert.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBInspector(t.TempDir())
+		args.Hasher = nil
+		inspector, err := NewDBInspector(args)
+		assert.Nil(t, inspector)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBInspector(t.TempDir())
+		args.Uint64Converter = nil
+		inspector, err := NewDBInspector(args)
+		assert.Nil(t, inspector)
+		assert.Equal(t, ErrNilUint64Converter, err)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBInspector(t.TempDir())
+		args.AddressConverter = nil
+		inspector, err := NewDBInspector(args)
+		assert.Nil(t, inspector)
+		assert.Equal(t, ErrNilAddressConverter, err)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBInspector(t.TempDir())
+		args.EnableEpochsHandler = nil
+		inspector, err := NewDBInspector(args)
+		assert.Nil(t, inspector)
+		assert.Equal(t, ErrNilEnableEpochsHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		inspector, err := NewDBInspector(createMockArgsDBInspector(t.TempDir()))
+		assert.Nil(t, err)
+		assert.False(t, inspector.IsInterfaceNil())
+	})
+}
+
+func TestDBInspector_EpochsAndUnits(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	generalConfig := createTestGeneralConfig()
+	txDBConfig := generalConfig.TxStorage.DB
+	putInUnit(t, getUnitPath(dbPath, "Epoch_2", txDBConfig), txDBConfig, []byte("key"), []byte("value"))
+	putInUnit(t, getUnitPath(dbPath, "Epoch_10", txDBConfig), txDBConfig, []byte("key"), []byte("value"))
+	metadataDBConfig := generalConfig.DbLookupExtensions.MiniblocksMetadataStorageConfig.DB
+	putInUnit(t, getUnitPath(dbPath, "Epoch_10", metadataDBConfig), metadataDBConfig, []byte("key"), []byte("value"))
+	shardNonceHashDBConfig := createTestDBConfig("ShardHdrHashNonce0")
+	putInUnit(t, getUnitPath(dbPath, "Static", shardNonceHashDBConfig), shardNonceHashDBConfig, []byte("key"), []byte("value"))
+
+	inspector, _ := NewDBInspector(createMockArgsDBInspector(dbPath))
+
+	epochs, err := inspector.Epochs()
+	require.Nil(t, err)
+	assert.Equal(t, []uint32{2, 10}, epochs)
+
+	units, err := inspector.Units()
+	require.Nil(t, err)
+	locationsByUnit := make(map[string][]string)
+	for _, unit := range units {
+		locationsByUnit[unit.Name] = unit.Locations
+	}
+	assert.Equal(t, []string{"Epoch_10", "Epoch_2"}, locationsByUnit[dataRetriever.TransactionUnit.String()])
+	assert.Equal(t, []string{"Epoch_10"}, locationsByUnit[dataRetriever.MiniblocksMetadataUnit.String()])
+	assert.Equal(t, []string{"Static"}, locationsByUnit[dataRetriever.ShardHdrNonceHashDataUnit.String()])
+	assert.Empty(t, locationsByUnit[dataRetriever.MetaBlockUnit.String()])
+}
+
+func TestDBInspector_Keys(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	txDBConfig := createTestGeneralConfig().TxStorage.DB
+	putInUnit(t, getUnitPath(dbPath, "Epoch_1", txDBConfig), txDBConfig, []byte("key1"), []byte("value"))
+	putInUnit(t, getUnitPath(dbPath, "Epoch_2", txDBConfig), txDBConfig, []byte("key2"), []byte("value"))
+	putInUnit(t, getUnitPath(dbPath, "Epoch_2", txDBConfig), txDBConfig, []byte("key3"), []byte("value"))
+	inspector, _ := NewDBInspector(createMockArgsDBInspector(dbPath))
+
+	t.Run("unknown unit should error", func(t *testing.T) {
+		keys, err := inspector.Keys("unknown", core.OptionalUint32{}, 0)
+		assert.Nil(t, keys)
+		assert.True(t, errors.Is(err, ErrUnknownUnit))
+	})
+	t.Run("all epochs", func(t *testing.T) {
+		keys, err := inspector.Keys(dataRetriever.TransactionUnit.String(), core.OptionalUint32{}, 0)
+		require.Nil(t, err)
+		expectedKeys := []*UnitKeys{
+			{
+				Location: "Epoch_2",
+				Keys:     []string{hex.EncodeToString([]byte("key2")), hex.EncodeToString([]byte("key3"))},
+			},
+			{
+				Location: "Epoch_1",
+				Keys:     []string{hex.EncodeToString([]byte("key1"))},
+			},
+		}
+		assert.Equal(t, expectedKeys, keys)
+	})
+	t.Run("one epoch", func(t *testing.T) {
+		keys, err := inspector.Keys(dataRetriever.TransactionUnit.String(), core.OptionalUint32{Value: 1, HasValue: true}, 0)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(keys))
+		assert.Equal(t, "Epoch_1", keys[0].Location)
+	})
+	t.Run("limit should stop the iteration", func(t *testing.T) {
+		keys, err := inspector.Keys(dataRetriever.TransactionUnit.String(), core.OptionalUint32{}, 1)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(keys))
+		assert.Equal(t, 1, len(keys[0].Keys))
+	})
+}
+
+func TestDBInspector_Get(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	dbPath := t.TempDir()
+	generalConfig := createTestGeneralConfig()
+	txDBConfig := generalConfig.TxStorage.DB
+	tx := &transaction.Transaction{
+		Nonce:   7,
+		Value:   big.NewInt(100),
+		RcvAddr: []byte("receiver"),
+	}
+	txBytes, _ := marshaller.Marshal(tx)
+	putInUnit(t, getUnitPath(dbPath, "Epoch_3", txDBConfig), txDBConfig, []byte("tx hash"), txBytes)
+	putInUnit(t, getUnitPath(dbPath, "Epoch_4", txDBConfig), txDBConfig, []byte("other tx hash"), txBytes)
+	statusDBConfig := generalConfig.StatusMetricsStorage.DB
+	putInUnit(t, getUnitPath(dbPath, "Static", statusDBConfig), statusDBConfig, []byte("metric"), []byte("value"))
+	putInUnit(t, getUnitPath(dbPath, "Epoch_4", txDBConfig), txDBConfig, []byte("invalid tx"), []byte("invalid"))
+
+	inspector, _ := NewDBInspector(createMockArgsDBInspector(dbPath))
+
+	t.Run("missing key should error", func(t *testing.T) {
+		records, err := inspector.Get(dataRetriever.TransactionUnit.String(), []byte("missing"), core.OptionalUint32{})
+		assert.Nil(t, records)
+		assert.True(t, errors.Is(err, ErrKeyNotFound))
+	})
+	t.Run("key from another epoch should error", func(t *testing.T) {
+		epoch := core.OptionalUint32{Value: 4, HasValue: true}
+		records, err := inspector.Get(dataRetriever.TransactionUnit.String(), []byte("tx hash"), epoch)
+		assert.Nil(t, records)
+		assert.True(t, errors.Is(err, ErrKeyNotFound))
+	})
+	t.Run("should search all epochs and decode the value", func(t *testing.T) {
+		records, err := inspector.Get(dataRetriever.TransactionUnit.String(), []byte("tx hash"), core.OptionalUint32{})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(records))
+		assert.Equal(t, "Epoch_3", records[0].Location)
+		assert.Equal(t, hex.EncodeToString([]byte("tx hash")), records[0].Key)
+		assert.Empty(t, records[0].RawValue)
+
+		decodedTx := records[0].Value.(map[string]interface{})
+		assert.Equal(t, uint64(7), decodedTx["nonce"])
+		assert.Equal(t, "100", decodedTx["value"])
+		assert.Equal(t, hex.EncodeToString([]byte("receiver")), decodedTx["receiver"])
+	})
+	t.Run("value without decoder should be returned raw", func(t *testing.T) {
+		records, err := inspector.Get(dataRetriever.StatusMetricsUnit.String(), []byte("metric"), core.OptionalUint32{})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(records))
+		assert.Equal(t, "Static", records[0].Location)
+		assert.Nil(t, records[0].Value)
+		assert.Equal(t, hex.EncodeToString([]byte("value")), records[0].RawValue)
+	})
+	t.Run("value that can not be decoded should be returned raw", func(t *testing.T) {
+		records, err := inspector.Get(dataRetriever.TransactionUnit.String(), []byte("invalid tx"), core.OptionalUint32{})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(records))
+		assert.Nil(t, records[0].Value)
+		assert.Equal(t, hex.EncodeToString([]byte("invalid")), records[0].RawValue)
+		assert.NotEmpty(t, records[0].DecodeError)
+	})
+}
+
+func TestDBInspector_HeaderByNonce(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	dbPath := t.TempDir()
+	generalConfig := createTestGeneralConfig()
+
+	header := &block.HeaderV2{
+		Header: &block.Header{
+			Nonce:   42,
+			ShardID: 0,
+			Epoch:   5,
+		},
+	}
+	headerBytes, _ := marshaller.Marshal(header)
+	headerDBConfig := generalConfig.BlockHeaderStorage.DB
+	putInUnit(t, getUnitPath(dbPath, "Epoch_5", headerDBConfig), headerDBConfig, []byte("header hash"), headerBytes)
+	shardNonceHashDBConfig := createTestDBConfig("ShardHdrHashNonce0")
+	putInUnit(t, getUnitPath(dbPath, "Static", shardNonceHashDBConfig), shardNonceHashDBConfig, converter.ToByteSlice(42), []byte("header hash"))
+
+	metaBlock := &block.MetaBlock{Nonce: 43}
+	metaBlockBytes, _ := marshaller.Marshal(metaBlock)
+	metaBlockDBConfig := generalConfig.MetaBlockStorage.DB
+	putInUnit(t, getUnitPath(dbPath, "Epoch_5", metaBlockDBConfig), metaBlockDBConfig, []byte("meta hash"), metaBlockBytes)
+	metaNonceHashDBConfig := generalConfig.MetaHdrNonceHashStorage.DB
+	putInUnit(t, getUnitPath(dbPath, "Static", metaNonceHashDBConfig), metaNonceHashDBConfig, converter.ToByteSlice(43), []byte("meta hash"))
+
+	inspector, _ := NewDBInspector(createMockArgsDBInspector(dbPath))
+
+	t.Run("shard header", func(t *testing.T) {
+		records, err := inspector.HeaderByNonce(0, 42, core.OptionalUint32{})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(records))
+		assert.Equal(t, dataRetriever.BlockHeaderUnit.String(), records[0].Unit)
+		assert.Equal(t, hex.EncodeToString([]byte("header hash")), records[0].Key)
+
+		decodedHeader := records[0].Value.(map[string]interface{})
+		innerHeader := decodedHeader["header"].(map[string]interface{})
+		assert.Equal(t, uint64(42), innerHeader["nonce"])
+	})
+	t.Run("metachain header", func(t *testing.T) {
+		records, err := inspector.HeaderByNonce(core.MetachainShardId, 43, core.OptionalUint32{})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(records))
+		assert.Equal(t, dataRetriever.MetaBlockUnit.String(), records[0].Unit)
+		assert.Equal(t, hex.EncodeToString([]byte("meta hash")), records[0].Key)
+	})
+	t.Run("missing nonce should error", func(t *testing.T) {
+		records, err := inspector.HeaderByNonce(0, 44, core.OptionalUint32{})
+		assert.Nil(t, records)
+		assert.True(t, errors.Is(err, ErrKeyNotFound))
+	})
+	t.Run("unknown shard should error", func(t *testing.T) {
+		records, err := inspector.HeaderByNonce(1, 42, core.OptionalUint32{})
+		assert.Nil(t, records)
+		assert.True(t, errors.Is(err, ErrUnknownUnit))
+	})
+}
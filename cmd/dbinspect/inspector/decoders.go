@@ -0,0 +1,98 @@
+package inspector
+
+import (
+	"encoding/hex"
+
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+// the trie node types are appended as the last byte of each encoded trie node
+const (
+	extensionNodeType = iota
+	leafNodeType
+	branchNodeType
+)
+
+type decodeHandler func(marshaller marshal.Marshalizer, buff []byte) (interface{}, error)
+
+// TrieNode holds a decoded trie node
+type TrieNode struct {
+	Type string      `json:"type"`
+	Node interface{} `json:"node"`
+}
+
+func newProtoDecoder(createEmptyValue func() interface{}) decodeHandler {
+	return func(marshaller marshal.Marshalizer, buff []byte) (interface{}, error) {
+		value := createEmptyValue()
+		err := marshaller.Unmarshal(value, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		return value, nil
+	}
+}
+
+func decodeHash(_ marshal.Marshalizer, buff []byte) (interface{}, error) {
+	return hex.EncodeToString(buff), nil
+}
+
+func decodeShardHeader(marshaller marshal.Marshalizer, buff []byte) (interface{}, error) {
+	return process.UnmarshalShardHeader(marshaller, buff)
+}
+
+func decodeReceipts(marshaller marshal.Marshalizer, buff []byte) (interface{}, error) {
+	receiptsBatch := &batch.Batch{}
+	err := marshaller.Unmarshal(receiptsBatch, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlocks := make([]*block.MiniBlock, 0, len(receiptsBatch.Data))
+	for _, miniBlockBytes := range receiptsBatch.Data {
+		miniBlock := &block.MiniBlock{}
+		err = marshaller.Unmarshal(miniBlock, miniBlockBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		miniBlocks = append(miniBlocks, miniBlock)
+	}
+
+	return miniBlocks, nil
+}
+
+func decodeTrieNode(marshaller marshal.Marshalizer, buff []byte) (interface{}, error) {
+	if len(buff) == 0 {
+		return nil, ErrInvalidTrieNode
+	}
+
+	nodeType := buff[len(buff)-1]
+	encodedNode := buff[:len(buff)-1]
+
+	trieNode := &TrieNode{}
+	switch nodeType {
+	case extensionNodeType:
+		trieNode.Type = "extension"
+		trieNode.Node = &trie.CollapsedEn{}
+	case leafNodeType:
+		trieNode.Type = "leaf"
+		trieNode.Node = &trie.CollapsedLn{}
+	case branchNodeType:
+		trieNode.Type = "branch"
+		trieNode.Node = &trie.CollapsedBn{}
+	default:
+		return nil, ErrInvalidTrieNode
+	}
+
+	err := marshaller.Unmarshal(trieNode.Node, encodedNode)
+	if err != nil {
+		return nil, err
+	}
+
+	return trieNode, nil
+}
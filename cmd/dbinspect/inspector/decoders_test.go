@@ -0,0 +1,87 @@
+package inspector
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeReceipts(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	miniBlock := &block.MiniBlock{
+		TxHashes: [][]byte{[]byte("receipt hash")},
+		Type:     block.ReceiptBlock,
+	}
+	miniBlockBytes, _ := marshaller.Marshal(miniBlock)
+	receiptsBytes, _ := marshaller.Marshal(&batch.Batch{Data: [][]byte{miniBlockBytes}})
+
+	decoded, err := decodeReceipts(marshaller, receiptsBytes)
+	require.Nil(t, err)
+	assert.Equal(t, []*block.MiniBlock{miniBlock}, decoded)
+}
+
+func TestDecodeTrieNode(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+
+	t.Run("empty value should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := decodeTrieNode(marshaller, nil)
+		assert.Nil(t, decoded)
+		assert.Equal(t, ErrInvalidTrieNode, err)
+	})
+	t.Run("unknown node type should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := decodeTrieNode(marshaller, []byte{3})
+		assert.Nil(t, decoded)
+		assert.Equal(t, ErrInvalidTrieNode, err)
+	})
+	t.Run("leaf node", func(t *testing.T) {
+		t.Parallel()
+
+		leaf := &trie.CollapsedLn{
+			Key:   []byte("key"),
+			Value: []byte("value"),
+		}
+		leafBytes, _ := marshaller.Marshal(leaf)
+
+		decoded, err := decodeTrieNode(marshaller, append(leafBytes, leafNodeType))
+		require.Nil(t, err)
+		assert.Equal(t, &TrieNode{Type: "leaf", Node: leaf}, decoded)
+	})
+	t.Run("extension node", func(t *testing.T) {
+		t.Parallel()
+
+		extension := &trie.CollapsedEn{
+			Key:          []byte("key"),
+			EncodedChild: []byte("child"),
+		}
+		extensionBytes, _ := marshaller.Marshal(extension)
+
+		decoded, err := decodeTrieNode(marshaller, append(extensionBytes, extensionNodeType))
+		require.Nil(t, err)
+		assert.Equal(t, &TrieNode{Type: "extension", Node: extension}, decoded)
+	})
+	t.Run("branch node", func(t *testing.T) {
+		t.Parallel()
+
+		branch := &trie.CollapsedBn{
+			EncodedChildren: [][]byte{[]byte("child 0"), []byte("child 1")},
+		}
+		branchBytes, _ := marshaller.Marshal(branch)
+
+		decoded, err := decodeTrieNode(marshaller, append(branchBytes, branchNodeType))
+		require.Nil(t, err)
+		assert.Equal(t, &TrieNode{Type: "branch", Node: branch}, decoded)
+	})
+}
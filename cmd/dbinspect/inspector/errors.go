@@ -0,0 +1,33 @@
+package inspector
+
+import "errors"
+
+// ErrEmptyDBPath signals that an empty database path was provided
+var ErrEmptyDBPath = errors.New("empty database path")
+
+// ErrNilMarshaller signals that a nil marshaller was provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilUint64Converter signals that a nil uint64 byte slice converter was provided
+var ErrNilUint64Converter = errors.New("nil uint64 byte slice converter")
+
+// ErrUnknownUnit signals that the provided storage unit name is not known
+var ErrUnknownUnit = errors.New("unknown storage unit")
+
+// ErrKeyNotFound signals that the key was not found in any of the inspected locations
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrInvalidTrieNode signals that the value is not an encoded trie node
+var ErrInvalidTrieNode = errors.New("invalid trie node")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilAddressConverter signals that a nil address converter was provided
+var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrNilEnableEpochsHandler signals that a nil enable epochs handler was provided
+var ErrNilEnableEpochsHandler = errors.New("nil enable epochs handler")
+
+// ErrLastRootHashNotFound signals that the root hash of the last committed block could not be found
+var ErrLastRootHashNotFound = errors.New("last committed root hash not found")
@@ -0,0 +1,18 @@
+package inspector
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+// DBInspector defines the operations able to read the storage units of a node
+type DBInspector interface {
+	Epochs() ([]uint32, error)
+	Units() ([]*UnitInfo, error)
+	Keys(unitName string, epoch core.OptionalUint32, limit int) ([]*UnitKeys, error)
+	Get(unitName string, key []byte, epoch core.OptionalUint32) ([]*Record, error)
+	HeaderByNonce(headerShardID uint32, nonce uint64, epoch core.OptionalUint32) ([]*Record, error)
+	TrieStatistics(ctx context.Context, rootHash []byte, epoch core.OptionalUint32) (*TrieStatisticsResult, error)
+	IsInterfaceNil() bool
+}
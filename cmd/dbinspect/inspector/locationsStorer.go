@@ -0,0 +1,40 @@
+package inspector
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage/readonly"
+)
+
+// openLocationsStorer opens the unit database from each of the provided locations which holds it. The returned
+// storer is read only and returns the value found in the first location holding the key. It is used to follow the
+// trie nodes and the bootstrap data across epochs
+func (inspector *dbInspector) openLocationsStorer(unit *unitDefinition, locations []*location) (common.BaseStorer, error) {
+	sources := make([]readonly.Source, 0, len(locations))
+	closeSources := func() {
+		for _, source := range sources {
+			log.LogIfError(source.Close())
+		}
+	}
+
+	for _, loc := range locations {
+		persister, err := inspector.openPersister(unit, loc)
+		if err != nil {
+			closeSources()
+			return nil, err
+		}
+		if check.IfNil(persister) {
+			continue
+		}
+
+		sources = append(sources, persister)
+	}
+
+	storer, err := readonly.NewMultiSourceStorer(sources)
+	if err != nil {
+		closeSources()
+		return nil, err
+	}
+
+	return storer, nil
+}
@@ -0,0 +1,105 @@
+package inspector
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+var bigIntType = reflect.TypeOf(big.Int{})
+
+// toPrintable converts the decoded value into a structure that can be marshalled into a human-readable JSON:
+// byte slices become hex strings and big integers become decimal strings
+func toPrintable(value interface{}) interface{} {
+	return convertValue(reflect.ValueOf(value))
+}
+
+func convertValue(value reflect.Value) interface{} {
+	if !value.IsValid() {
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		if value.Type().Elem() == bigIntType {
+			return value.Interface().(*big.Int).String()
+		}
+		return convertValue(value.Elem())
+	case reflect.Struct:
+		if value.Type() == bigIntType {
+			bigValue := value.Interface().(big.Int)
+			return bigValue.String()
+		}
+		return convertStruct(value)
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return hex.EncodeToString(toByteSlice(value))
+		}
+		result := make([]interface{}, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			result = append(result, convertValue(value.Index(i)))
+		}
+		return result
+	case reflect.Map:
+		result := make(map[string]interface{}, value.Len())
+		iterator := value.MapRange()
+		for iterator.Next() {
+			key := convertValue(iterator.Key())
+			result[toMapKey(key)] = convertValue(iterator.Value())
+		}
+		return result
+	default:
+		return value.Interface()
+	}
+}
+
+func convertStruct(value reflect.Value) map[string]interface{} {
+	result := make(map[string]interface{}, value.NumField())
+	valueType := value.Type()
+	for i := 0; i < value.NumField(); i++ {
+		field := valueType.Field(i)
+		isUnexportedOrInternal := len(field.PkgPath) > 0 || strings.HasPrefix(field.Name, "XXX_")
+		fieldName := getFieldName(field)
+		if isUnexportedOrInternal || fieldName == "-" {
+			continue
+		}
+
+		result[fieldName] = convertValue(value.Field(i))
+	}
+
+	return result
+}
+
+func getFieldName(field reflect.StructField) string {
+	jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+	if len(jsonName) == 0 {
+		return field.Name
+	}
+
+	return jsonName
+}
+
+func toByteSlice(value reflect.Value) []byte {
+	if value.Kind() == reflect.Slice {
+		return value.Bytes()
+	}
+
+	buff := make([]byte, value.Len())
+	reflect.Copy(reflect.ValueOf(buff), value)
+
+	return buff
+}
+
+func toMapKey(key interface{}) string {
+	keyString, ok := key.(string)
+	if ok {
+		return keyString
+	}
+
+	return fmt.Sprintf("%v", key)
+}
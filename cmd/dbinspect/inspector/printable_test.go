@@ -0,0 +1,68 @@
+package inspector
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testNestedStruct struct {
+	Hash []byte `json:"hash"`
+}
+
+type testStruct struct {
+	Nonce          uint64                       `json:"nonce"`
+	Value          *big.Int                     `json:"value,omitempty"`
+	Data           []byte                       `json:"data"`
+	Fixed          [2]byte                      `json:"fixed"`
+	Nested         *testNestedStruct            `json:"nested"`
+	NestedSlice    []testNestedStruct           `json:"nestedSlice"`
+	NestedMap      map[uint32]*testNestedStruct `json:"nestedMap"`
+	NoTag          string
+	IgnoredTag     string `json:"-"`
+	unexported     string
+	XXX_unrecorded []byte
+}
+
+func TestToPrintable(t *testing.T) {
+	t.Parallel()
+
+	value := &testStruct{
+		Nonce:       3,
+		Value:       big.NewInt(1000),
+		Data:        []byte("data"),
+		Fixed:       [2]byte{1, 2},
+		Nested:      &testNestedStruct{Hash: []byte("hash")},
+		NestedSlice: []testNestedStruct{{Hash: []byte("a")}},
+		NestedMap: map[uint32]*testNestedStruct{
+			7: {Hash: []byte("b")},
+		},
+		NoTag:          "no tag",
+		IgnoredTag:     "ignored tag",
+		unexported:     "unexported",
+		XXX_unrecorded: []byte("internal"),
+	}
+
+	expected := map[string]interface{}{
+		"nonce": uint64(3),
+		"value": "1000",
+		"data":  hex.EncodeToString([]byte("data")),
+		"fixed": "0102",
+		"nested": map[string]interface{}{
+			"hash": hex.EncodeToString([]byte("hash")),
+		},
+		"nestedSlice": []interface{}{
+			map[string]interface{}{"hash": hex.EncodeToString([]byte("a"))},
+		},
+		"nestedMap": map[string]interface{}{
+			"7": map[string]interface{}{"hash": hex.EncodeToString([]byte("b"))},
+		},
+		"NoTag": "no tag",
+	}
+	assert.Equal(t, expected, toPrintable(value))
+	assert.Nil(t, toPrintable(nil))
+	assert.Nil(t, toPrintable((*testStruct)(nil)))
+	assert.Equal(t, "abc", toPrintable("abc"))
+}
@@ -0,0 +1,239 @@
+package inspector
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	commonDisabled "github.com/multiversx/mx-chain-go/common/disabled"
+	disabledStatistics "github.com/multiversx/mx-chain-go/common/statistics/disabled"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
+	"github.com/multiversx/mx-chain-go/state"
+	stateFactory "github.com/multiversx/mx-chain-go/state/factory"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/trie/statistics"
+)
+
+const trieStatisticsProgressInterval = 30 * time.Second
+
+// TrieStatisticsResult holds the statistics of an accounts trie and of all its data tries
+type TrieStatisticsResult struct {
+	RootHash  string                        `json:"rootHash"`
+	Locations []string                      `json:"locations"`
+	Report    *common.TriesStatisticsReport `json:"report"`
+}
+
+// TrieStatistics collects the statistics of the accounts trie with the provided root hash and of all its data tries.
+// If no root hash is provided, the root hash of the last committed block is used. The trie nodes are searched in the
+// provided epoch and in the older ones or, if no epoch is provided, in all the epochs, starting with the most recent one
+func (inspector *dbInspector) TrieStatistics(ctx context.Context, rootHash []byte, epoch core.OptionalUint32) (*TrieStatisticsResult, error) {
+	locations, err := inspector.getTrieLocations(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rootHash) == 0 {
+		rootHash, err = inspector.getLastCommittedRootHash(locations)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	unit, err := inspector.getUnit(dataRetriever.UserAccountsUnit.String())
+	if err != nil {
+		return nil, err
+	}
+	trieStorer, err := inspector.openLocationsStorer(unit, locations)
+	if err != nil {
+		return nil, err
+	}
+	mainTrie, err := inspector.createTrie(trieStorer)
+	if err != nil {
+		log.LogIfError(trieStorer.Close())
+		return nil, err
+	}
+	defer func() {
+		log.LogIfError(mainTrie.GetStorageManager().Close())
+	}()
+
+	accountFactory, err := stateFactory.NewAccountCreator(stateFactory.ArgsAccountCreator{
+		Hasher:              inspector.hasher,
+		Marshaller:          inspector.marshaller,
+		EnableEpochsHandler: inspector.enableEpochsHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	collector := statistics.NewTrieStatisticsCollector()
+	go logTrieStatisticsProgress(ctx, collector)
+
+	err = state.CollectTriesStatistics(ctx, state.ArgsCollectTriesStatistics{
+		MainTrie:         mainTrie,
+		RootHash:         rootHash,
+		AccountFactory:   accountFactory,
+		Marshaller:       inspector.marshaller,
+		AddressConverter: inspector.addressConverter,
+		Collector:        collector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TrieStatisticsResult{
+		RootHash:  hex.EncodeToString(rootHash),
+		Locations: make([]string, 0, len(locations)),
+		Report:    collector.GetReport(),
+	}
+	for _, loc := range locations {
+		result.Locations = append(result.Locations, loc.name)
+	}
+
+	return result, nil
+}
+
+// createTrie creates a read only accounts trie over the provided storer. The storer is closed together with the trie
+// storage manager
+func (inspector *dbInspector) createTrie(trieStorer common.BaseStorer) (common.Trie, error) {
+	args := trie.NewTrieStorageManagerArgs{
+		MainStorer:  trieStorer,
+		Marshalizer: inspector.marshaller,
+		Hasher:      inspector.hasher,
+		GeneralConfig: config.TrieStorageManagerConfig{
+			SnapshotsGoroutineNum: 1,
+		},
+		IdleProvider:   commonDisabled.NewProcessStatusHandler(),
+		Identifier:     dataRetriever.UserAccountsUnit.String(),
+		StatsCollector: disabledStatistics.NewStateStatistics(),
+	}
+	options := trie.StorageManagerOptions{
+		PruningEnabled:   false,
+		SnapshotsEnabled: false,
+	}
+	trieStorageManager, err := trie.CreateTrieStorageManager(args, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return trie.NewTrie(
+		trieStorageManager,
+		inspector.marshaller,
+		inspector.hasher,
+		inspector.enableEpochsHandler,
+		inspector.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+	)
+}
+
+// getTrieLocations returns the provided epoch location followed by the older epochs or, if no epoch is provided, all
+// the epoch locations, starting with the most recent one
+func (inspector *dbInspector) getTrieLocations(epoch core.OptionalUint32) ([]*location, error) {
+	epochs, err := inspector.Epochs()
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]*location, 0, len(epochs))
+	for i := len(epochs) - 1; i >= 0; i-- {
+		if epoch.HasValue && epochs[i] > epoch.Value {
+			continue
+		}
+
+		locations = append(locations, inspector.epochLocation(epochs[i]))
+	}
+
+	return locations, nil
+}
+
+// getLastCommittedRootHash returns the root hash of the last block committed by the node, as saved in the bootstrap
+// storage of the most recent of the provided locations
+func (inspector *dbInspector) getLastCommittedRootHash(locations []*location) ([]byte, error) {
+	bootstrapUnit, err := inspector.getUnit(dataRetriever.BootstrapUnit.String())
+	if err != nil {
+		return nil, err
+	}
+	bootstrapStorer, err := inspector.openLocationsStorer(bootstrapUnit, locations)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		log.LogIfError(bootstrapStorer.Close())
+	}()
+
+	roundBytes, err := bootstrapStorer.Get([]byte(common.HighestRoundFromBootStorage))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrLastRootHashNotFound, err.Error())
+	}
+	round := &bootstrapStorage.RoundNum{}
+	err = inspector.marshaller.Unmarshal(round, roundBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	bootstrapDataBytes, err := bootstrapStorer.Get([]byte(strconv.FormatInt(round.Num, 10)))
+	if err != nil {
+		return nil, fmt.Errorf("%w for round %d: %s", ErrLastRootHashNotFound, round.Num, err.Error())
+	}
+	bootstrapData := &bootstrapStorage.BootstrapData{}
+	err = inspector.marshaller.Unmarshal(bootstrapData, bootstrapDataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	headerUnitType := dataRetriever.BlockHeaderUnit
+	if inspector.shardID == core.MetachainShardId {
+		headerUnitType = dataRetriever.MetaBlockUnit
+	}
+	headerUnit, err := inspector.getUnit(headerUnitType.String())
+	if err != nil {
+		return nil, err
+	}
+	headerStorer, err := inspector.openLocationsStorer(headerUnit, locations)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		log.LogIfError(headerStorer.Close())
+	}()
+
+	headerBytes, err := headerStorer.Get(bootstrapData.LastHeader.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("%w, header with nonce %d: %s", ErrLastRootHashNotFound, bootstrapData.LastHeader.Nonce, err.Error())
+	}
+	header, err := process.UnmarshalHeader(inspector.shardID, inspector.marshaller, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("using the root hash of the last committed block",
+		"epoch", header.GetEpoch(),
+		"nonce", header.GetNonce(),
+		"root hash", header.GetRootHash())
+
+	return header.GetRootHash(), nil
+}
+
+func logTrieStatisticsProgress(ctx context.Context, collector common.TriesStatisticsCollector) {
+	ticker := time.NewTicker(trieStatisticsProgressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			log.Info("collecting the trie statistics",
+				"num tries", collector.GetNumTries(),
+				"num nodes", collector.GetNumNodes())
+		}
+	}
+}
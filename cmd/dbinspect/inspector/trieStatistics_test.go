@@ -0,0 +1,151 @@
+package inspector
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const lastTestRound = 100
+
+func createTrieInUnit(t *testing.T, inspector *dbInspector, locationName string, keys [][]byte, values [][]byte) []byte {
+	dbConfig := inspector.generalConfig.AccountsTrieStorage.DB
+	persister := openTestUnit(t, getUnitPath(inspector.dbPath, locationName, dbConfig), dbConfig)
+
+	tr, err := inspector.createTrie(persister)
+	require.Nil(t, err)
+	for i := range keys {
+		err = tr.Update(keys[i], values[i])
+		require.Nil(t, err)
+	}
+	err = tr.Commit()
+	require.Nil(t, err)
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+	err = tr.GetStorageManager().Close()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func putLastCommittedHeader(t *testing.T, inspector *dbInspector, locationName string, rootHash []byte) {
+	header := &block.Header{
+		Nonce:    42,
+		Epoch:    2,
+		RootHash: rootHash,
+	}
+	headerBytes, err := inspector.marshaller.Marshal(header)
+	require.Nil(t, err)
+	headerHash := inspector.hasher.Compute(string(headerBytes))
+	headerDBConfig := inspector.generalConfig.BlockHeaderStorage.DB
+	putInUnit(t, getUnitPath(inspector.dbPath, locationName, headerDBConfig), headerDBConfig, headerHash, headerBytes)
+
+	bootstrapData := &bootstrapStorage.BootstrapData{
+		LastHeader: bootstrapStorage.BootstrapHeaderInfo{
+			Epoch: header.Epoch,
+			Nonce: header.Nonce,
+			Hash:  headerHash,
+		},
+	}
+	bootstrapDataBytes, err := inspector.marshaller.Marshal(bootstrapData)
+	require.Nil(t, err)
+	roundBytes, err := inspector.marshaller.Marshal(&bootstrapStorage.RoundNum{Num: lastTestRound})
+	require.Nil(t, err)
+
+	bootstrapDBConfig := inspector.generalConfig.BootstrapStorage.DB
+	unitPath := getUnitPath(inspector.dbPath, locationName, bootstrapDBConfig)
+	persister := openTestUnit(t, unitPath, bootstrapDBConfig)
+	require.Nil(t, persister.Put([]byte(strconv.Itoa(lastTestRound)), bootstrapDataBytes))
+	require.Nil(t, persister.Put([]byte(common.HighestRoundFromBootStorage), roundBytes))
+	require.Nil(t, persister.Close())
+}
+
+// createTestTries writes in epoch 1 a data trie and in epoch 2 a main trie with two accounts, one of them pointing to
+// the data trie, so the tries can be read only by following the nodes across epochs
+func createTestTries(t *testing.T, inspector *dbInspector) []byte {
+	dataTrieRootHash := createTrieInUnit(
+		t,
+		inspector,
+		"Epoch_1",
+		[][]byte{[]byte("key1"), []byte("key2"), []byte("key3")},
+		[][]byte{[]byte("value1"), []byte("value2"), []byte("value3")},
+	)
+
+	addresses := [][]byte{bytes.Repeat([]byte("a"), 32), bytes.Repeat([]byte("b"), 32)}
+	accountsData := []*accounts.UserAccountData{
+		{Address: addresses[0], Balance: big.NewInt(10), RootHash: dataTrieRootHash},
+		{Address: addresses[1], Balance: big.NewInt(20)},
+	}
+	values := make([][]byte, 0, len(accountsData))
+	for _, accountData := range accountsData {
+		value, err := inspector.marshaller.Marshal(accountData)
+		require.Nil(t, err)
+		values = append(values, value)
+	}
+
+	mainTrieRootHash := createTrieInUnit(t, inspector, "Epoch_2", addresses, values)
+	putLastCommittedHeader(t, inspector, "Epoch_2", mainTrieRootHash)
+
+	return mainTrieRootHash
+}
+
+func TestDBInspector_TrieStatistics(t *testing.T) {
+	t.Parallel()
+
+	inspector, _ := NewDBInspector(createMockArgsDBInspector(t.TempDir()))
+	mainTrieRootHash := createTestTries(t, inspector)
+
+	checkResult := func(t *testing.T, result *TrieStatisticsResult) {
+		assert.Equal(t, hex.EncodeToString(mainTrieRootHash), result.RootHash)
+		assert.Equal(t, []string{"Epoch_2", "Epoch_1"}, result.Locations)
+		require.NotNil(t, result.Report)
+		assert.Equal(t, uint64(1), result.Report.NumTriesByType[common.MainTrie])
+		assert.Equal(t, uint64(1), result.Report.NumTriesByType[common.DataTrie])
+		assert.Equal(t, uint64(2), result.Report.StatsByType[common.MainTrie].LeafNodes.NumNodes)
+		assert.Equal(t, uint64(3), result.Report.StatsByType[common.DataTrie].LeafNodes.NumNodes)
+		require.Equal(t, 1, len(result.Report.LargestDataTries))
+		assert.Equal(t, hex.EncodeToString(bytes.Repeat([]byte("a"), 32)), result.Report.LargestDataTries[0].Address)
+	}
+
+	t.Run("should use the root hash of the last committed block", func(t *testing.T) {
+		result, err := inspector.TrieStatistics(context.Background(), nil, core.OptionalUint32{})
+		require.Nil(t, err)
+		checkResult(t, result)
+	})
+	t.Run("should use the provided root hash", func(t *testing.T) {
+		result, err := inspector.TrieStatistics(context.Background(), mainTrieRootHash, core.OptionalUint32{Value: 2, HasValue: true})
+		require.Nil(t, err)
+		checkResult(t, result)
+	})
+	t.Run("older epoch should not find the last committed block", func(t *testing.T) {
+		result, err := inspector.TrieStatistics(context.Background(), nil, core.OptionalUint32{Value: 1, HasValue: true})
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, ErrLastRootHashNotFound))
+	})
+	t.Run("older epoch should not find the main trie", func(t *testing.T) {
+		result, err := inspector.TrieStatistics(context.Background(), mainTrieRootHash, core.OptionalUint32{Value: 1, HasValue: true})
+		assert.Nil(t, result)
+		assert.NotNil(t, err)
+	})
+	t.Run("closed context should error", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		result, err := inspector.TrieStatistics(ctx, mainTrieRootHash, core.OptionalUint32{})
+		assert.Nil(t, result)
+		assert.Equal(t, core.ErrContextClosing, err)
+	})
+}
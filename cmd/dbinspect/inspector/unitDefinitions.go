@@ -0,0 +1,155 @@
+package inspector
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/scheduled"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
+)
+
+type unitDefinition struct {
+	unitType dataRetriever.UnitType
+	dbConfig config.DBConfig
+	decode   decodeHandler
+}
+
+func createUnitDefinitions(generalConfig config.Config) []*unitDefinition {
+	dbLookupConfig := generalConfig.DbLookupExtensions
+
+	return []*unitDefinition{
+		{
+			unitType: dataRetriever.TransactionUnit,
+			dbConfig: generalConfig.TxStorage.DB,
+			decode:   newProtoDecoder(func() interface{} { return &transaction.Transaction{} }),
+		},
+		{
+			unitType: dataRetriever.MiniBlockUnit,
+			dbConfig: generalConfig.MiniBlocksStorage.DB,
+			decode:   newProtoDecoder(func() interface{} { return &block.MiniBlock{} }),
+		},
+		{
+			unitType: dataRetriever.PeerChangesUnit,
+			dbConfig: generalConfig.PeerBlockBodyStorage.DB,
+			decode:   newProtoDecoder(func() interface{} { return &block.MiniBlock{} }),
+		},
+		{
+			unitType: dataRetriever.BlockHeaderUnit,
+			dbConfig: generalConfig.BlockHeaderStorage.DB,
+			decode:   decodeShardHeader,
+		},
+		{
+			unitType: dataRetriever.MetaBlockUnit,
+			dbConfig: generalConfig.MetaBlockStorage.DB,
+			decode:   newProtoDecoder(func() interface{} { return &block.MetaBlock{} }),
+		},
+		{
+			unitType: dataRetriever.UnsignedTransactionUnit,
+			dbConfig: generalConfig.UnsignedTransactionStorage.DB,
+			decode:   newProtoDecoder(func() interface{} { return &smartContractResult.SmartContractResult{} }),
+		},
+		{
+			unitType: dataRetriever.RewardTransactionUnit,
+			dbConfig: generalConfig.RewardTxStorage.DB,
+			decode:   newProtoDecoder(func() interface{} { return &rewardTx.RewardTx{} }),
+		},
+		{
+			unitType: dataRetriever.MetaHdrNonceHashDataUnit,
+			dbConfig: generalConfig.MetaHdrNonceHashStorage.DB,
+			decode:   decodeHash,
+		},
+		{
+			unitType: dataRetriever.BootstrapUnit,
+			dbConfig: generalConfig.BootstrapStorage.DB,
+			decode:   newProtoDecoder(func() interface{} { return &bootstrapStorage.BootstrapData{} }),
+		},
+		{
+			unitType: dataRetriever.StatusMetricsUnit,
+			dbConfig: generalConfig.StatusMetricsStorage.DB,
+		},
+		{
+			unitType: dataRetriever.TxLogsUnit,
+			dbConfig: generalConfig.LogsAndEvents.TxLogsStorage.DB,
+			decode:   newProtoDecoder(func() interface{} { return &transaction.Log{} }),
+		},
+		{
+			unitType: dataRetriever.MiniblocksMetadataUnit,
+			dbConfig: dbLookupConfig.MiniblocksMetadataStorageConfig.DB,
+			decode:   newProtoDecoder(func() interface{} { return &dblookupext.MiniblockMetadata{} }),
+		},
+		{
+			unitType: dataRetriever.EpochByHashUnit,
+			dbConfig: dbLookupConfig.EpochByHashStorageConfig.DB,
+			decode:   newProtoDecoder(func() interface{} { return &dblookupext.EpochByHash{} }),
+		},
+		{
+			unitType: dataRetriever.MiniblockHashByTxHashUnit,
+			dbConfig: dbLookupConfig.MiniblockHashByTxHashStorageConfig.DB,
+			decode:   decodeHash,
+		},
+		{
+			unitType: dataRetriever.ReceiptsUnit,
+			dbConfig: generalConfig.ReceiptsStorage.DB,
+			decode:   decodeReceipts,
+		},
+		{
+			unitType: dataRetriever.ResultsHashesByTxHashUnit,
+			dbConfig: dbLookupConfig.ResultsHashesByTxHashStorageConfig.DB,
+			decode:   newProtoDecoder(func() interface{} { return &dblookupext.ResultsHashesByTxHash{} }),
+		},
+		{
+			unitType: dataRetriever.TrieEpochRootHashUnit,
+			dbConfig: generalConfig.TrieEpochRootHashStorage.DB,
+			decode:   decodeHash,
+		},
+		{
+			unitType: dataRetriever.ESDTSuppliesUnit,
+			dbConfig: dbLookupConfig.ESDTSuppliesStorageConfig.DB,
+			decode:   newProtoDecoder(func() interface{} { return &esdtSupply.SupplyESDT{} }),
+		},
+		{
+			unitType: dataRetriever.RoundHdrHashDataUnit,
+			dbConfig: dbLookupConfig.RoundHashStorageConfig.DB,
+			decode:   decodeHash,
+		},
+		{
+			unitType: dataRetriever.UserAccountsUnit,
+			dbConfig: generalConfig.AccountsTrieStorage.DB,
+			decode:   decodeTrieNode,
+		},
+		{
+			unitType: dataRetriever.PeerAccountsUnit,
+			dbConfig: generalConfig.PeerAccountsTrieStorage.DB,
+			decode:   decodeTrieNode,
+		},
+		{
+			unitType: dataRetriever.ScheduledSCRsUnit,
+			dbConfig: generalConfig.ScheduledSCRsStorage.DB,
+			decode:   newProtoDecoder(func() interface{} { return &scheduled.ScheduledSCRs{} }),
+		},
+	}
+}
+
+// createShardHdrNonceHashUnitDefinition creates the definition of the nonce-hash unit of a shard. The metachain
+// holds one such unit for each shard, while a shard only holds the one of its own shard
+func createShardHdrNonceHashUnitDefinition(generalConfig config.Config, shardID uint32) *unitDefinition {
+	dbConfig := generalConfig.ShardHdrNonceHashStorage.DB
+	dbConfig.FilePath = getShardHdrNonceHashFilePath(dbConfig.FilePath, shardID)
+
+	return &unitDefinition{
+		unitType: dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardID),
+		dbConfig: dbConfig,
+		decode:   decodeHash,
+	}
+}
+
+func getShardHdrNonceHashFilePath(filePathPrefix string, shardID uint32) string {
+	return fmt.Sprintf("%s%d", filePathPrefix, shardID)
+}
@@ -0,0 +1,363 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	marshalFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	"github.com/multiversx/mx-chain-go/cmd/dbinspect/inspector"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/enablers"
+	commonFactory "github.com/multiversx/mx-chain-go/common/factory"
+	"github.com/multiversx/mx-chain-go/common/forking"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const allEpochs = -1
+
+var (
+	dbInspectHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// configurationFile defines a flag for the path to the main toml configuration file of the node
+	configurationFile = cli.StringFlag{
+		Name:  "config",
+		Usage: "The `[path]` to the main configuration file of the node that produced the databases",
+		Value: "../node/config/config.toml",
+	}
+	// epochConfigurationFile defines a flag for the path to the toml file containing the epochs activations
+	epochConfigurationFile = cli.StringFlag{
+		Name:  "epoch-config",
+		Usage: "The `[path]` to the toml file containing the activation epochs of the node features",
+		Value: "../node/config/enableEpochs.toml",
+	}
+	// dbPath defines a flag for the path to the databases of a chain
+	dbPath = cli.StringFlag{
+		Name: "db-path",
+		Usage: "The `[path]` to the databases of a chain, for example <node working directory>/db/<chain ID>. " +
+			"The databases can not be inspected while the node is running",
+		Value: "./db/1",
+	}
+	// shard defines a flag for the shard whose databases are inspected
+	shard = cli.StringFlag{
+		Name:  "shard",
+		Usage: "The `shard` whose databases are inspected. It can be a shard ID or metachain",
+		Value: "0",
+	}
+	// unit defines a flag for the name of the inspected storage unit
+	unit = cli.StringFlag{
+		Name:  "unit",
+		Usage: "The `name` of the storage unit, as listed by the units command. For example TransactionUnit",
+	}
+	// key defines a flag for the hex encoded key to be read
+	key = cli.StringFlag{
+		Name:  "key",
+		Usage: "The hex encoded `key` to be read",
+	}
+	// epoch defines a flag for the epoch whose databases are inspected
+	epoch = cli.Int64Flag{
+		Name:  "epoch",
+		Usage: "The `epoch` whose databases are inspected. If not set, the static databases and all the epochs are inspected",
+		Value: allEpochs,
+	}
+	// limit defines a flag for the maximum number of listed keys
+	limit = cli.IntFlag{
+		Name:  "limit",
+		Usage: "The maximum `number` of listed keys. If set to 0, all the keys are listed",
+		Value: 100,
+	}
+	// nonce defines a flag for the nonce of the inspected header
+	nonce = cli.Uint64Flag{
+		Name:  "nonce",
+		Usage: "The `nonce` of the header",
+	}
+	// headerShard defines a flag for the shard of the inspected header
+	headerShard = cli.StringFlag{
+		Name:  "header-shard",
+		Usage: "The `shard` of the header. It can be a shard ID or metachain. If not set, the inspected shard is used",
+	}
+	// rootHash defines a flag for the hex encoded root hash of the inspected accounts trie
+	rootHash = cli.StringFlag{
+		Name: "root-hash",
+		Usage: "The hex encoded `root hash` of the accounts trie. If not set, the root hash of the last block " +
+			"committed by the node is used",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogWarning.String(),
+	}
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbInspectHelpTemplate
+	app.Name = "DBInspect CLI App"
+	app.Usage = "This tool reads the databases of a stopped node and prints the decoded values as JSON"
+	app.Flags = []cli.Flag{
+		configurationFile,
+		epochConfigurationFile,
+		dbPath,
+		shard,
+		logLevel,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "epochs",
+			Usage:  "lists the epochs having databases for the inspected shard",
+			Action: listEpochs,
+		},
+		{
+			Name:   "units",
+			Usage:  "lists the storage units and the locations where they were found",
+			Action: listUnits,
+		},
+		{
+			Name:   "keys",
+			Usage:  "lists the hex encoded keys of a storage unit",
+			Flags:  []cli.Flag{unit, epoch, limit},
+			Action: listKeys,
+		},
+		{
+			Name:   "get",
+			Usage:  "prints the decoded values of a key from a storage unit",
+			Flags:  []cli.Flag{unit, key, epoch},
+			Action: getValue,
+		},
+		{
+			Name:   "header",
+			Usage:  "prints the decoded header with the provided nonce",
+			Flags:  []cli.Flag{nonce, headerShard, epoch},
+			Action: getHeaderByNonce,
+		},
+		{
+			Name: "trie-stats",
+			Usage: "prints the statistics of an accounts trie and of all its data tries. If an epoch is set, the " +
+				"trie nodes are searched in that epoch and in the older ones",
+			Flags:  []cli.Flag{rootHash, epoch},
+			Action: getTrieStatistics,
+		},
+	}
+	app.Version = "v0.0.1"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func listEpochs(ctx *cli.Context) error {
+	dbInspector, err := createDBInspector(ctx)
+	if err != nil {
+		return err
+	}
+
+	epochs, err := dbInspector.Epochs()
+	if err != nil {
+		return err
+	}
+
+	return printJSON(epochs)
+}
+
+func listUnits(ctx *cli.Context) error {
+	dbInspector, err := createDBInspector(ctx)
+	if err != nil {
+		return err
+	}
+
+	units, err := dbInspector.Units()
+	if err != nil {
+		return err
+	}
+
+	return printJSON(units)
+}
+
+func listKeys(ctx *cli.Context) error {
+	dbInspector, err := createDBInspector(ctx)
+	if err != nil {
+		return err
+	}
+
+	keys, err := dbInspector.Keys(ctx.String(unit.Name), getEpoch(ctx), ctx.Int(limit.Name))
+	if err != nil {
+		return err
+	}
+
+	return printJSON(keys)
+}
+
+func getValue(ctx *cli.Context) error {
+	dbInspector, err := createDBInspector(ctx)
+	if err != nil {
+		return err
+	}
+
+	keyBytes, err := hex.DecodeString(ctx.String(key.Name))
+	if err != nil {
+		return fmt.Errorf("%w while decoding the provided key", err)
+	}
+
+	records, err := dbInspector.Get(ctx.String(unit.Name), keyBytes, getEpoch(ctx))
+	if err != nil {
+		return err
+	}
+
+	return printJSON(records)
+}
+
+func getHeaderByNonce(ctx *cli.Context) error {
+	dbInspector, err := createDBInspector(ctx)
+	if err != nil {
+		return err
+	}
+
+	headerShardFlagValue := ctx.String(headerShard.Name)
+	if len(headerShardFlagValue) == 0 {
+		headerShardFlagValue = ctx.GlobalString(shard.Name)
+	}
+	headerShardID, err := common.ProcessDestinationShardAsObserver(headerShardFlagValue)
+	if err != nil {
+		return err
+	}
+
+	records, err := dbInspector.HeaderByNonce(headerShardID, ctx.Uint64(nonce.Name), getEpoch(ctx))
+	if err != nil {
+		return err
+	}
+
+	return printJSON(records)
+}
+
+func getTrieStatistics(ctx *cli.Context) error {
+	dbInspector, err := createDBInspector(ctx)
+	if err != nil {
+		return err
+	}
+
+	rootHashBytes, err := hex.DecodeString(ctx.String(rootHash.Name))
+	if err != nil {
+		return fmt.Errorf("%w while decoding the provided root hash", err)
+	}
+
+	result, err := dbInspector.TrieStatistics(context.Background(), rootHashBytes, getEpoch(ctx))
+	if err != nil {
+		return err
+	}
+
+	return printJSON(result)
+}
+
+func createDBInspector(ctx *cli.Context) (inspector.DBInspector, error) {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	generalConfig, err := common.LoadMainConfig(ctx.GlobalString(configurationFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	epochConfig, err := common.LoadEpochConfig(ctx.GlobalString(epochConfigurationFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	marshaller, err := marshalFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	addressConverter, err := commonFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	enableEpochsHandler, err := enablers.NewEnableEpochsHandler(epochConfig.EnableEpochs, forking.NewGenericEpochNotifier())
+	if err != nil {
+		return nil, err
+	}
+
+	shardID, err := common.ProcessDestinationShardAsObserver(ctx.GlobalString(shard.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	args := inspector.ArgsDBInspector{
+		DBPath:              ctx.GlobalString(dbPath.Name),
+		ShardID:             shardID,
+		GeneralConfig:       *generalConfig,
+		Marshaller:          marshaller,
+		Uint64Converter:     uint64ByteSlice.NewBigEndianConverter(),
+		Hasher:              hasher,
+		AddressConverter:    addressConverter,
+		EnableEpochsHandler: enableEpochsHandler,
+	}
+
+	return inspector.NewDBInspector(args)
+}
+
+func getEpoch(ctx *cli.Context) core.OptionalUint32 {
+	epochValue := ctx.Int64(epoch.Name)
+	if epochValue == allEpochs {
+		return core.OptionalUint32{}
+	}
+
+	return core.OptionalUint32{
+		Value:    uint32(epochValue),
+		HasValue: true,
+	}
+}
+
+func printJSON(value interface{}) error {
+	jsonBytes, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(jsonBytes))
+
+	return nil
+}
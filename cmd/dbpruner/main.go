@@ -0,0 +1,193 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	marshalFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	"github.com/multiversx/mx-chain-go/cmd/dbpruner/pruner"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/enablers"
+	"github.com/multiversx/mx-chain-go/common/forking"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+var (
+	dbPrunerHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// configurationFile defines a flag for the path to the main toml configuration file of the node
+	configurationFile = cli.StringFlag{
+		Name: "config",
+		Usage: "The `[path]` to the main configuration file the node will be started with. The storage units, the " +
+			"state tries and the number of active persisters are taken from it",
+		Value: "../node/config/config.toml",
+	}
+	// epochConfigurationFile defines a flag for the path to the toml file containing the epochs activations
+	epochConfigurationFile = cli.StringFlag{
+		Name:  "epoch-config",
+		Usage: "The `[path]` to the toml file containing the activation epochs of the node features",
+		Value: "../node/config/enableEpochs.toml",
+	}
+	// dbPath defines a flag for the path to the databases of a chain
+	dbPath = cli.StringFlag{
+		Name: "db-path",
+		Usage: "The `[path]` to the databases of a chain, for example <node working directory>/db/<chain ID>. " +
+			"The databases can not be pruned while the node is running",
+		Value: "./db/1",
+	}
+	// shard defines a flag for the shard whose databases are pruned
+	shard = cli.StringFlag{
+		Name:  "shard",
+		Usage: "The `shard` whose databases are pruned. It can be a shard ID or metachain",
+		Value: "0",
+	}
+	// numEpochsToKeep defines a flag for the number of epochs kept after pruning
+	numEpochsToKeep = cli.Uint64Flag{
+		Name: "num-epochs-to-keep",
+		Usage: "The `number` of last epochs to keep. If set to 0, the NumEpochsToKeep value from the StoragePruning " +
+			"section of the main configuration file is used",
+		Value: 0,
+	}
+	// dryRun defines a flag that only prints the pruning plan, without altering the databases
+	dryRun = cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "Boolean option for printing the epochs and the tries that would be kept, without altering the databases",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbPrunerHelpTemplate
+	app.Name = "DBPruner CLI App"
+	app.Usage = "This tool converts the databases of a stopped full history node into the databases of a pruned node, " +
+		"keeping only the last epochs and the live state tries"
+	app.Flags = []cli.Flag{
+		configurationFile,
+		epochConfigurationFile,
+		dbPath,
+		shard,
+		numEpochsToKeep,
+		dryRun,
+		logLevel,
+	}
+	app.Action = prune
+	app.Version = "v0.0.1"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func prune(ctx *cli.Context) error {
+	dbPruner, err := createDBPruner(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := dbPruner.Prune(ctx.GlobalBool(dryRun.Name))
+	if err != nil {
+		return err
+	}
+
+	return printJSON(result)
+}
+
+func createDBPruner(ctx *cli.Context) (pruner.DBPruner, error) {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	generalConfig, err := common.LoadMainConfig(ctx.GlobalString(configurationFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	epochConfig, err := common.LoadEpochConfig(ctx.GlobalString(epochConfigurationFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	marshaller, err := marshalFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	enableEpochsHandler, err := enablers.NewEnableEpochsHandler(epochConfig.EnableEpochs, forking.NewGenericEpochNotifier())
+	if err != nil {
+		return nil, err
+	}
+
+	shardID, err := common.ProcessDestinationShardAsObserver(ctx.GlobalString(shard.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	epochsToKeep := ctx.GlobalUint64(numEpochsToKeep.Name)
+	if epochsToKeep == 0 {
+		epochsToKeep = generalConfig.StoragePruning.NumEpochsToKeep
+	}
+
+	args := pruner.ArgsDBPruner{
+		DBPath:              ctx.GlobalString(dbPath.Name),
+		ShardID:             shardID,
+		NumEpochsToKeep:     uint32(epochsToKeep),
+		GeneralConfig:       *generalConfig,
+		Marshaller:          marshaller,
+		Hasher:              hasher,
+		EnableEpochsHandler: enableEpochsHandler,
+	}
+
+	return pruner.NewDBPruner(args)
+}
+
+func printJSON(value interface{}) error {
+	jsonBytes, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(jsonBytes))
+
+	return nil
+}
@@ -0,0 +1,257 @@
+package pruner
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("cmd/dbpruner/pruner")
+
+// ArgsDBPruner holds the arguments needed to create a database pruner
+type ArgsDBPruner struct {
+	// DBPath is the path to the databases of a chain, for example <working directory>/db/<chain ID>
+	DBPath              string
+	ShardID             uint32
+	NumEpochsToKeep     uint32
+	GeneralConfig       config.Config
+	Marshaller          marshal.Marshalizer
+	Hasher              hashing.Hasher
+	EnableEpochsHandler common.EnableEpochsHandler
+}
+
+// PruneResult holds the outcome of a database conversion
+type PruneResult struct {
+	DryRun        bool          `json:"dryRun"`
+	LastEpoch     uint32        `json:"lastEpoch"`
+	KeptEpochs    []uint32      `json:"keptEpochs"`
+	RemovedEpochs []uint32      `json:"removedEpochs"`
+	Tries         []*TrieResult `json:"tries"`
+}
+
+// TrieResult holds the live tries copied in the fresh trie storer of an epoch
+type TrieResult struct {
+	Unit            string   `json:"unit"`
+	Epoch           uint32   `json:"epoch"`
+	RootHashes      []string `json:"rootHashes"`
+	NumWrittenNodes uint64   `json:"numWrittenNodes"`
+}
+
+type dbPruner struct {
+	dbPath              string
+	shardID             uint32
+	shardIDString       string
+	numEpochsToKeep     uint32
+	generalConfig       config.Config
+	marshaller          marshal.Marshalizer
+	hasher              hashing.Hasher
+	enableEpochsHandler common.EnableEpochsHandler
+	pathManager         storage.PathManagerHandler
+}
+
+// NewDBPruner creates a component able to convert, offline, the databases of a full history node into the databases
+// of a pruned node, which keeps only the last epochs
+func NewDBPruner(args ArgsDBPruner) (*dbPruner, error) {
+	if len(args.DBPath) == 0 {
+		return nil, ErrEmptyDBPath
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+	numActivePersisters := args.GeneralConfig.StoragePruning.NumActivePersisters
+	if uint64(args.NumEpochsToKeep) < numActivePersisters || args.NumEpochsToKeep == 0 {
+		return nil, fmt.Errorf("%w: %d epochs to keep, while the node needs %d active persisters",
+			ErrInvalidNumEpochsToKeep, args.NumEpochsToKeep, numActivePersisters)
+	}
+
+	pathManager, err := storageFactory.CreatePathManagerFromSinglePathString(args.DBPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbPruner{
+		dbPath:              args.DBPath,
+		shardID:             args.ShardID,
+		shardIDString:       core.GetShardIDString(args.ShardID),
+		numEpochsToKeep:     args.NumEpochsToKeep,
+		generalConfig:       args.GeneralConfig,
+		marshaller:          args.Marshaller,
+		hasher:              args.Hasher,
+		enableEpochsHandler: args.EnableEpochsHandler,
+		pathManager:         pathManager,
+	}, nil
+}
+
+// Prune keeps only the last epochs of the shard databases. The live tries of the kept epochs are copied in fresh trie
+// storers, which replace the existing ones, and the databases of the older epochs are removed. If the dry run is set,
+// the databases are not altered and only the conversion plan is returned
+func (p *dbPruner) Prune(dryRun bool) (*PruneResult, error) {
+	epochs, err := p.getEpochs()
+	if err != nil {
+		return nil, err
+	}
+	if len(epochs) == 0 {
+		return nil, fmt.Errorf("%w in %s for shard %s", ErrNoEpochsFound, p.dbPath, p.shardIDString)
+	}
+
+	lastEpoch := epochs[len(epochs)-1]
+	keptEpochs, removedEpochs := p.splitEpochs(epochs)
+	result := &PruneResult{
+		DryRun:        dryRun,
+		LastEpoch:     lastEpoch,
+		KeptEpochs:    keptEpochs,
+		RemovedEpochs: removedEpochs,
+		Tries:         make([]*TrieResult, 0),
+	}
+
+	units, err := p.createTrieUnits(epochs, keptEpochs)
+	if err != nil {
+		return nil, err
+	}
+	for _, unit := range units {
+		result.Tries = append(result.Tries, unit.results()...)
+	}
+	if dryRun {
+		return result, nil
+	}
+
+	freshStorers, err := p.copyLiveTries(units, epochs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.replaceTrieStorers(freshStorers)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.removeEpochs(removedEpochs)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// getEpochs returns the epochs for which the pruned shard has a database folder, in ascending order
+func (p *dbPruner) getEpochs() ([]uint32, error) {
+	entries, err := os.ReadDir(p.dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	epochs := make([]uint32, 0)
+	epochPrefix := storage.DefaultEpochString + "_"
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), epochPrefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(entry.Name(), epochPrefix), 10, 32)
+		if errParse != nil {
+			continue
+		}
+		if !directoryExists(p.shardEpochPath(uint32(epoch))) {
+			continue
+		}
+
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return epochs, nil
+}
+
+func (p *dbPruner) splitEpochs(epochs []uint32) ([]uint32, []uint32) {
+	lastEpoch := epochs[len(epochs)-1]
+	oldestEpochToKeep := uint32(0)
+	if lastEpoch >= p.numEpochsToKeep {
+		oldestEpochToKeep = lastEpoch - p.numEpochsToKeep + 1
+	}
+
+	keptEpochs := make([]uint32, 0, len(epochs))
+	removedEpochs := make([]uint32, 0, len(epochs))
+	for _, epoch := range epochs {
+		if epoch < oldestEpochToKeep {
+			removedEpochs = append(removedEpochs, epoch)
+			continue
+		}
+
+		keptEpochs = append(keptEpochs, epoch)
+	}
+
+	return keptEpochs, removedEpochs
+}
+
+// removeEpochs removes the shard databases of the provided epochs, together with the epoch folders left empty
+func (p *dbPruner) removeEpochs(epochs []uint32) error {
+	for _, epoch := range epochs {
+		err := os.RemoveAll(p.shardEpochPath(epoch))
+		if err != nil {
+			return err
+		}
+
+		epochPath := filepath.Dir(p.shardEpochPath(epoch))
+		entries, err := os.ReadDir(epochPath)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			err = os.Remove(epochPath)
+			if err != nil {
+				return err
+			}
+		}
+
+		log.Info("removed the databases of an old epoch", "epoch", epoch, "shard", p.shardIDString)
+	}
+
+	return nil
+}
+
+func (p *dbPruner) shardEpochPath(epoch uint32) string {
+	return filepath.Join(
+		p.dbPath,
+		fmt.Sprintf("%s_%d", storage.DefaultEpochString, epoch),
+		fmt.Sprintf("%s_%s", storage.DefaultShardString, p.shardIDString))
+}
+
+func (p *dbPruner) unitPath(epoch uint32, dbConfig config.DBConfig) string {
+	return p.pathManager.PathForEpoch(p.shardIDString, epoch, dbConfig.FilePath)
+}
+
+func directoryExists(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+
+	return info.IsDir()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (p *dbPruner) IsInterfaceNil() bool {
+	return p == nil
+}
@@ -0,0 +1,520 @@
+package pruner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const lastTestRound = 100
+
+func createTestDBConfig(filePath string) config.DBConfig {
+	return config.DBConfig{
+		FilePath:          filePath,
+		Type:              "LvlDBSerial",
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+}
+
+func createTestStorageConfig(filePath string) config.StorageConfig {
+	return config.StorageConfig{
+		Cache: config.CacheConfig{
+			Type:     "LRU",
+			Capacity: 100,
+		},
+		DB: createTestDBConfig(filePath),
+	}
+}
+
+func createTestGeneralConfig() config.Config {
+	generalConfig := config.Config{}
+	generalConfig.AccountsTrieStorage = createTestStorageConfig("AccountsTrie")
+	generalConfig.PeerAccountsTrieStorage = createTestStorageConfig("PeerAccountsTrie")
+	generalConfig.BlockHeaderStorage = createTestStorageConfig("BlockHeaders")
+	generalConfig.MetaBlockStorage = createTestStorageConfig("MetaBlock")
+	generalConfig.BootstrapStorage = createTestStorageConfig("BootstrapData")
+	generalConfig.StoragePruning.NumActivePersisters = 2
+	generalConfig.StateTriesConfig.SnapshotsEnabled = true
+	generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory = 5
+	generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory = 5
+
+	return generalConfig
+}
+
+func createMockArgsDBPruner(dbPath string) ArgsDBPruner {
+	return ArgsDBPruner{
+		DBPath:              dbPath,
+		ShardID:             0,
+		NumEpochsToKeep:     2,
+		GeneralConfig:       createTestGeneralConfig(),
+		Marshaller:          &marshal.GogoProtoMarshalizer{},
+		Hasher:              blake2b.NewBlake2b(),
+		EnableEpochsHandler: &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+	}
+}
+
+func getUnitPath(dbPath string, epoch uint32, dbConfig config.DBConfig) string {
+	return filepath.Join(
+		dbPath,
+		fmt.Sprintf("%s_%d", storage.DefaultEpochString, epoch),
+		fmt.Sprintf("%s_%d", storage.DefaultShardString, 0),
+		dbConfig.FilePath)
+}
+
+func openUnit(t *testing.T, unitPath string, dbConfig config.DBConfig) storage.Persister {
+	persisterFactory, err := storageFactory.NewPersisterFactory(storageFactory.NewDBConfigHandler(dbConfig))
+	require.Nil(t, err)
+
+	persister, err := persisterFactory.Create(unitPath)
+	require.Nil(t, err)
+
+	return persister
+}
+
+func putInUnit(t *testing.T, unitPath string, dbConfig config.DBConfig, key []byte, value []byte) {
+	persister := openUnit(t, unitPath, dbConfig)
+
+	err := persister.Put(key, value)
+	require.Nil(t, err)
+	err = persister.Close()
+	require.Nil(t, err)
+}
+
+func createTestTrie(t *testing.T, args ArgsDBPruner, keys []string) common.Trie {
+	p, _ := NewDBPruner(args)
+	trieStorageManager, err := p.createTrieStorageManager(database.NewMemDB(), dataRetriever.UserAccountsUnit)
+	require.Nil(t, err)
+
+	tr, err := trie.NewTrie(trieStorageManager, args.Marshaller, args.Hasher, args.EnableEpochsHandler, 5)
+	require.Nil(t, err)
+
+	for _, key := range keys {
+		err = tr.Update([]byte(key), []byte("value of "+key))
+		require.Nil(t, err)
+	}
+	err = tr.Commit()
+	require.Nil(t, err)
+
+	return tr
+}
+
+func putTrieInUnit(t *testing.T, tr common.Trie, unitPath string, dbConfig config.DBConfig, hasher func(buff []byte) []byte) {
+	persister := openUnit(t, unitPath, dbConfig)
+
+	err := trie.WalkTrieNodes(context.Background(), tr, func(encodedNode []byte) error {
+		return persister.Put(hasher(encodedNode), encodedNode)
+	})
+	require.Nil(t, err)
+	err = persister.Close()
+	require.Nil(t, err)
+}
+
+func checkTrieInUnit(t *testing.T, args ArgsDBPruner, unitPath string, rootHash []byte, keys []string) {
+	persister := openUnit(t, unitPath, args.GeneralConfig.AccountsTrieStorage.DB)
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	p, _ := NewDBPruner(args)
+	trieStorageManager, err := p.createTrieStorageManager(persister, dataRetriever.UserAccountsUnit)
+	require.Nil(t, err)
+
+	tr, err := trie.NewTrie(trieStorageManager, args.Marshaller, args.Hasher, args.EnableEpochsHandler, 5)
+	require.Nil(t, err)
+	recreatedTrie, err := tr.Recreate(rootHash)
+	require.Nil(t, err)
+
+	for _, key := range keys {
+		value, _, errGet := recreatedTrie.Get([]byte(key))
+		require.Nil(t, errGet)
+		require.Equal(t, []byte("value of "+key), value)
+	}
+}
+
+func putHeader(t *testing.T, args ArgsDBPruner, epoch uint32, key []byte, header *block.Header) {
+	buff, err := args.Marshaller.Marshal(header)
+	require.Nil(t, err)
+
+	dbConfig := args.GeneralConfig.BlockHeaderStorage.DB
+	putInUnit(t, getUnitPath(args.DBPath, epoch, dbConfig), dbConfig, key, buff)
+}
+
+func putLastHeader(t *testing.T, args ArgsDBPruner, epoch uint32, header *block.Header) {
+	buff, err := args.Marshaller.Marshal(header)
+	require.Nil(t, err)
+	headerHash := args.Hasher.Compute(string(buff))
+	putHeader(t, args, epoch, headerHash, header)
+
+	bootstrapData := &bootstrapStorage.BootstrapData{
+		LastHeader: bootstrapStorage.BootstrapHeaderInfo{
+			ShardId: 0,
+			Epoch:   epoch,
+			Nonce:   header.Nonce,
+			Hash:    headerHash,
+		},
+	}
+	bootstrapBuff, err := args.Marshaller.Marshal(bootstrapData)
+	require.Nil(t, err)
+	roundBuff, err := args.Marshaller.Marshal(&bootstrapStorage.RoundNum{Num: lastTestRound})
+	require.Nil(t, err)
+
+	dbConfig := args.GeneralConfig.BootstrapStorage.DB
+	unitPath := getUnitPath(args.DBPath, epoch, dbConfig)
+	putInUnit(t, unitPath, dbConfig, []byte(strconv.Itoa(lastTestRound)), bootstrapBuff)
+	putInUnit(t, unitPath, dbConfig, []byte(common.HighestRoundFromBootStorage), roundBuff)
+}
+
+type testDatabase struct {
+	epochStartKeys []string
+	lastKeys       []string
+	epochStartRoot []byte
+	lastRoot       []byte
+	staleKey       []byte
+}
+
+// createTestDatabase creates a full history database with the epochs 0 to 4, where the epoch 3 start trie is found
+// only in epoch 2, as after an unfinished state snapshot, and the last trie is in epoch 4
+func createTestDatabase(t *testing.T, args ArgsDBPruner) *testDatabase {
+	hasher := func(buff []byte) []byte {
+		return args.Hasher.Compute(string(buff))
+	}
+	trieDBConfig := args.GeneralConfig.AccountsTrieStorage.DB
+
+	db := &testDatabase{
+		epochStartKeys: []string{"key1", "key2", "key3"},
+		lastKeys:       []string{"key1", "key2", "key3", "key4", "key5"},
+		staleKey:       []byte("stale trie node"),
+	}
+	epochStartTrie := createTestTrie(t, args, db.epochStartKeys)
+	db.epochStartRoot, _ = epochStartTrie.RootHash()
+	lastTrie := createTestTrie(t, args, db.lastKeys)
+	db.lastRoot, _ = lastTrie.RootHash()
+
+	for epoch := uint32(0); epoch <= 4; epoch++ {
+		putInUnit(t, getUnitPath(args.DBPath, epoch, trieDBConfig), trieDBConfig, db.staleKey, []byte("stale"))
+		putHeader(t, args, epoch, []byte("hash"), &block.Header{Epoch: epoch})
+	}
+	putTrieInUnit(t, epochStartTrie, getUnitPath(args.DBPath, 2, trieDBConfig), trieDBConfig, hasher)
+	putTrieInUnit(t, lastTrie, getUnitPath(args.DBPath, 4, trieDBConfig), trieDBConfig, hasher)
+
+	putHeader(t, args, 3, []byte(core.EpochStartIdentifier(3)), &block.Header{Epoch: 3, Nonce: 30, RootHash: db.epochStartRoot})
+	putHeader(t, args, 4, []byte(core.EpochStartIdentifier(4)), &block.Header{Epoch: 4, Nonce: 40, RootHash: db.epochStartRoot})
+	putLastHeader(t, args, 4, &block.Header{Epoch: 4, Nonce: 45, RootHash: db.lastRoot})
+
+	return db
+}
+
+func TestNewDBPruner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty db path should error", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := NewDBPruner(createMockArgsDBPruner(""))
+		assert.Nil(t, p)
+		assert.Equal(t, ErrEmptyDBPath, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBPruner(t.TempDir())
+		args.Marshaller = nil
+
+		p, err := NewDBPruner(args)
+		assert.Nil(t, p)
+		assert.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBPruner(t.TempDir())
+		args.Hasher = nil
+
+		p, err := NewDBPruner(args)
+		assert.Nil(t, p)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBPruner(t.TempDir())
+		args.EnableEpochsHandler = nil
+
+		p, err := NewDBPruner(args)
+		assert.Nil(t, p)
+		assert.Equal(t, ErrNilEnableEpochsHandler, err)
+	})
+	t.Run("fewer epochs to keep than active persisters should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBPruner(t.TempDir())
+		args.NumEpochsToKeep = 1
+
+		p, err := NewDBPruner(args)
+		assert.Nil(t, p)
+		assert.True(t, errors.Is(err, ErrInvalidNumEpochsToKeep))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := NewDBPruner(createMockArgsDBPruner(t.TempDir()))
+		assert.False(t, check.IfNil(p))
+		assert.Nil(t, err)
+	})
+}
+
+func TestDbPruner_Prune(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no epochs should error", func(t *testing.T) {
+		t.Parallel()
+
+		p, _ := NewDBPruner(createMockArgsDBPruner(t.TempDir()))
+
+		result, err := p.Prune(false)
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, ErrNoEpochsFound))
+	})
+	t.Run("missing bootstrap data should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBPruner(t.TempDir())
+		dbConfig := args.GeneralConfig.BlockHeaderStorage.DB
+		putInUnit(t, getUnitPath(args.DBPath, 0, dbConfig), dbConfig, []byte("key"), []byte("value"))
+		p, _ := NewDBPruner(args)
+
+		result, err := p.Prune(false)
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, ErrLastHeaderNotFound))
+	})
+	t.Run("dry run should not alter the databases", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBPruner(t.TempDir())
+		db := createTestDatabase(t, args)
+		p, _ := NewDBPruner(args)
+
+		result, err := p.Prune(true)
+		require.Nil(t, err)
+		assert.True(t, result.DryRun)
+		assert.Equal(t, uint32(4), result.LastEpoch)
+		assert.Equal(t, []uint32{3, 4}, result.KeptEpochs)
+		assert.Equal(t, []uint32{0, 1, 2}, result.RemovedEpochs)
+		require.Equal(t, 2, len(result.Tries))
+		assert.Equal(t, uint32(3), result.Tries[0].Epoch)
+		assert.Equal(t, 1, len(result.Tries[0].RootHashes))
+		assert.Equal(t, uint32(4), result.Tries[1].Epoch)
+		assert.Equal(t, 2, len(result.Tries[1].RootHashes))
+		assert.Equal(t, uint64(0), result.Tries[1].NumWrittenNodes)
+
+		trieDBConfig := args.GeneralConfig.AccountsTrieStorage.DB
+		assert.True(t, directoryExists(getUnitPath(args.DBPath, 0, trieDBConfig)))
+		persister := openUnit(t, getUnitPath(args.DBPath, 3, trieDBConfig), trieDBConfig)
+		_, err = persister.Get(db.staleKey)
+		assert.Nil(t, err)
+		_ = persister.Close()
+	})
+	t.Run("should keep only the live tries of the last epochs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBPruner(t.TempDir())
+		db := createTestDatabase(t, args)
+		p, _ := NewDBPruner(args)
+
+		result, err := p.Prune(false)
+		require.Nil(t, err)
+		assert.False(t, result.DryRun)
+		require.Equal(t, 2, len(result.Tries))
+		assert.True(t, result.Tries[0].NumWrittenNodes > 0)
+		assert.True(t, result.Tries[1].NumWrittenNodes > result.Tries[0].NumWrittenNodes)
+
+		for epoch := uint32(0); epoch <= 2; epoch++ {
+			assert.False(t, directoryExists(filepath.Join(args.DBPath, fmt.Sprintf("%s_%d", storage.DefaultEpochString, epoch))))
+		}
+		assert.False(t, directoryExists(filepath.Join(args.DBPath, prunedTriesDirectory)))
+
+		trieDBConfig := args.GeneralConfig.AccountsTrieStorage.DB
+		checkTrieInUnit(t, args, getUnitPath(args.DBPath, 3, trieDBConfig), db.epochStartRoot, db.epochStartKeys)
+		checkTrieInUnit(t, args, getUnitPath(args.DBPath, 4, trieDBConfig), db.epochStartRoot, db.epochStartKeys)
+		checkTrieInUnit(t, args, getUnitPath(args.DBPath, 4, trieDBConfig), db.lastRoot, db.lastKeys)
+
+		for epoch := uint32(3); epoch <= 4; epoch++ {
+			persister := openUnit(t, getUnitPath(args.DBPath, epoch, trieDBConfig), trieDBConfig)
+			_, err = persister.Get(db.staleKey)
+			assert.NotNil(t, err)
+			value, errGet := persister.Get([]byte(common.ActiveDBKey))
+			assert.Nil(t, errGet)
+			assert.Equal(t, []byte(common.ActiveDBVal), value)
+			_ = persister.Close()
+		}
+
+		headersDBConfig := args.GeneralConfig.BlockHeaderStorage.DB
+		persister := openUnit(t, getUnitPath(args.DBPath, 3, headersDBConfig), headersDBConfig)
+		_, err = persister.Get([]byte(core.EpochStartIdentifier(3)))
+		assert.Nil(t, err)
+		_ = persister.Close()
+	})
+	t.Run("disabled snapshots should only remove the old epochs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBPruner(t.TempDir())
+		args.GeneralConfig.StateTriesConfig.SnapshotsEnabled = false
+		db := createTestDatabase(t, args)
+		p, _ := NewDBPruner(args)
+
+		result, err := p.Prune(false)
+		require.Nil(t, err)
+		assert.Equal(t, 0, len(result.Tries))
+		assert.Equal(t, []uint32{0, 1, 2}, result.RemovedEpochs)
+
+		trieDBConfig := args.GeneralConfig.AccountsTrieStorage.DB
+		assert.False(t, directoryExists(getUnitPath(args.DBPath, 2, trieDBConfig)))
+		persister := openUnit(t, getUnitPath(args.DBPath, 3, trieDBConfig), trieDBConfig)
+		_, err = persister.Get(db.staleKey)
+		assert.Nil(t, err)
+		_ = persister.Close()
+	})
+}
+
+func createTestStorerDirectory(t *testing.T, path string, content string) {
+	require.Nil(t, os.MkdirAll(path, os.ModePerm))
+	require.Nil(t, os.WriteFile(filepath.Join(path, "content"), []byte(content), os.ModePerm))
+}
+
+func readTestStorerDirectory(t *testing.T, path string) string {
+	content, err := os.ReadFile(filepath.Join(path, "content"))
+	require.Nil(t, err)
+
+	return string(content)
+}
+
+func createTestFreshTrieStorers(t *testing.T, dbPath string) []*freshTrieStorer {
+	freshStorers := make([]*freshTrieStorer, 0)
+	for _, name := range []string{"first", "second"} {
+		fresh := &freshTrieStorer{
+			sourcePath: filepath.Join(dbPath, "Epoch_0", name),
+			freshPath:  filepath.Join(dbPath, prunedTriesDirectory, name),
+		}
+		createTestStorerDirectory(t, fresh.sourcePath, "existing "+name)
+		createTestStorerDirectory(t, fresh.freshPath, "fresh "+name)
+		freshStorers = append(freshStorers, fresh)
+	}
+
+	return freshStorers
+}
+
+func TestDbPruner_ReplaceTrieStorers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should move the fresh storers in place", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBPruner(t.TempDir())
+		p, _ := NewDBPruner(args)
+		freshStorers := createTestFreshTrieStorers(t, args.DBPath)
+
+		err := p.replaceTrieStorers(freshStorers)
+		require.Nil(t, err)
+		for _, fresh := range freshStorers {
+			assert.Equal(t, "fresh "+filepath.Base(fresh.sourcePath), readTestStorerDirectory(t, fresh.sourcePath))
+			assert.NoDirExists(t, fresh.replacedPath())
+		}
+		assert.NoDirExists(t, filepath.Join(args.DBPath, prunedTriesDirectory))
+	})
+	t.Run("failed replacement should restore the existing storers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBPruner(t.TempDir())
+		p, _ := NewDBPruner(args)
+		freshStorers := createTestFreshTrieStorers(t, args.DBPath)
+		require.Nil(t, os.RemoveAll(freshStorers[1].freshPath))
+
+		err := p.replaceTrieStorers(freshStorers)
+		require.NotNil(t, err)
+		for _, fresh := range freshStorers {
+			assert.Equal(t, "existing "+filepath.Base(fresh.sourcePath), readTestStorerDirectory(t, fresh.sourcePath))
+			assert.NoDirExists(t, fresh.replacedPath())
+		}
+		assert.Equal(t, "fresh first", readTestStorerDirectory(t, freshStorers[0].freshPath))
+	})
+	t.Run("leftover replaced storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDBPruner(t.TempDir())
+		p, _ := NewDBPruner(args)
+		freshStorers := createTestFreshTrieStorers(t, args.DBPath)
+		createTestStorerDirectory(t, freshStorers[0].replacedPath(), "leftover")
+
+		err := p.replaceTrieStorers(freshStorers)
+		require.True(t, errors.Is(err, ErrReplacedStorerFound))
+		assert.Equal(t, "existing first", readTestStorerDirectory(t, freshStorers[0].sourcePath))
+		assert.Equal(t, "leftover", readTestStorerDirectory(t, freshStorers[0].replacedPath()))
+	})
+}
+
+func TestDbPruner_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var p *dbPruner
+	assert.True(t, p.IsInterfaceNil())
+
+	p, _ = NewDBPruner(createMockArgsDBPruner(t.TempDir()))
+	assert.False(t, p.IsInterfaceNil())
+}
+
+func TestEpochReadOnlyStorer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDBPruner(t.TempDir())
+	dbConfig := args.GeneralConfig.AccountsTrieStorage.DB
+	for epoch := uint32(0); epoch <= 4; epoch++ {
+		putInUnit(t, getUnitPath(args.DBPath, epoch, dbConfig), dbConfig, []byte(fmt.Sprintf("key%d", epoch)), []byte("value"))
+	}
+
+	p, _ := NewDBPruner(args)
+	storer, err := p.createFullHistoryTrieStorer(storerArgs{
+		storageConfig: args.GeneralConfig.AccountsTrieStorage,
+		pathManager:   p.pathManager,
+		lastEpoch:     4,
+		numEpochs:     5,
+	})
+	require.Nil(t, err)
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	ros, err := newEpochReadOnlyStorer(storer, []uint32{0, 1, 2, 3, 4}, 2)
+	require.Nil(t, err)
+	value, err := ros.Get([]byte("key0"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+	_, err = ros.Get([]byte("key3"))
+	assert.Nil(t, err)
+	_, err = ros.Get([]byte("missing key"))
+	assert.NotNil(t, err)
+	assert.Equal(t, storage.ErrReadOnlyStorage, ros.Put([]byte("key"), []byte("value")))
+	assert.Equal(t, storage.ErrReadOnlyStorage, ros.Remove([]byte("key0")))
+}
@@ -0,0 +1,47 @@
+package pruner
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage/readonly"
+)
+
+// epochSource reads the values written in one epoch of a storer holding several epochs
+type epochSource struct {
+	storer storerWithEpochs
+	epoch  uint32
+}
+
+// Get returns the value of the key from the source epoch
+func (source *epochSource) Get(key []byte) ([]byte, error) {
+	return source.storer.GetFromEpoch(key, source.epoch)
+}
+
+// Close does nothing, as the underlying storer is shared between epochs
+func (source *epochSource) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (source *epochSource) IsInterfaceNil() bool {
+	return source == nil
+}
+
+// newEpochReadOnlyStorer creates a read only storer for the tries of the given epoch, searching from the given epoch
+// down to the oldest one. The nodes of a trie are usually found in the epoch storer, as the state snapshot copies them
+// there, but an incomplete snapshot leaves them spread across the older epochs. The provided epochs must be in
+// ascending order
+func newEpochReadOnlyStorer(storer storerWithEpochs, epochs []uint32, epoch uint32) (common.BaseStorer, error) {
+	sources := make([]readonly.Source, 0, len(epochs))
+	for i := len(epochs) - 1; i >= 0; i-- {
+		if epochs[i] > epoch {
+			continue
+		}
+
+		sources = append(sources, &epochSource{
+			storer: storer,
+			epoch:  epochs[i],
+		})
+	}
+
+	return readonly.NewMultiSourceStorer(sources)
+}
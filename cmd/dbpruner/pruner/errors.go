@@ -0,0 +1,28 @@
+package pruner
+
+import "errors"
+
+// ErrEmptyDBPath signals that an empty database path was provided
+var ErrEmptyDBPath = errors.New("empty database path")
+
+// ErrNilMarshaller signals that a nil marshaller was provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilEnableEpochsHandler signals that a nil enable epochs handler was provided
+var ErrNilEnableEpochsHandler = errors.New("nil enable epochs handler")
+
+// ErrInvalidNumEpochsToKeep signals that the number of epochs to keep is lower than the number of active persisters
+var ErrInvalidNumEpochsToKeep = errors.New("invalid number of epochs to keep")
+
+// ErrNoEpochsFound signals that no epoch databases were found for the pruned shard
+var ErrNoEpochsFound = errors.New("no epoch databases found")
+
+// ErrLastHeaderNotFound signals that the last header committed by the node could not be found
+var ErrLastHeaderNotFound = errors.New("last committed header not found")
+
+// ErrReplacedStorerFound signals that a trie storer moved aside by an interrupted run was found. It has to be moved
+// back or removed manually, after checking which of the two storers is complete
+var ErrReplacedStorerFound = errors.New("replaced trie storer found")
@@ -0,0 +1,8 @@
+package pruner
+
+// DBPruner defines the operations able to convert the databases of a full history node into the databases of a
+// pruned node
+type DBPruner interface {
+	Prune(dryRun bool) (*PruneResult, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,212 @@
+package pruner
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
+)
+
+// trieUnit holds, for each kept epoch, the root hashes of the live tries of a trie storage unit
+type trieUnit struct {
+	unitType       dataRetriever.UnitType
+	storageConfig  config.StorageConfig
+	withDataTries  bool
+	rootHashes     map[uint32][][]byte
+	resultsByEpoch map[uint32]*TrieResult
+}
+
+func newTrieUnit(unitType dataRetriever.UnitType, storageConfig config.StorageConfig, withDataTries bool) *trieUnit {
+	return &trieUnit{
+		unitType:       unitType,
+		storageConfig:  storageConfig,
+		withDataTries:  withDataTries,
+		rootHashes:     make(map[uint32][][]byte),
+		resultsByEpoch: make(map[uint32]*TrieResult),
+	}
+}
+
+func (unit *trieUnit) addRootHash(epoch uint32, rootHash []byte) {
+	if common.IsEmptyTrie(rootHash) {
+		return
+	}
+
+	for _, existing := range unit.rootHashes[epoch] {
+		if string(existing) == string(rootHash) {
+			return
+		}
+	}
+
+	unit.rootHashes[epoch] = append(unit.rootHashes[epoch], rootHash)
+}
+
+func (unit *trieUnit) sortedEpochs() []uint32 {
+	epochs := make([]uint32, 0, len(unit.rootHashes))
+	for epoch := range unit.rootHashes {
+		epochs = append(epochs, epoch)
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return epochs
+}
+
+func (unit *trieUnit) results() []*TrieResult {
+	epochs := unit.sortedEpochs()
+	results := make([]*TrieResult, 0, len(epochs))
+	for _, epoch := range epochs {
+		result := &TrieResult{
+			Unit:       unit.unitType.String(),
+			Epoch:      epoch,
+			RootHashes: make([]string, 0, len(unit.rootHashes[epoch])),
+		}
+		for _, rootHash := range unit.rootHashes[epoch] {
+			result.RootHashes = append(result.RootHashes, hex.EncodeToString(rootHash))
+		}
+
+		unit.resultsByEpoch[epoch] = result
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// createTrieUnits collects the root hashes of the tries still needed by a pruned node: the tries of each kept epoch
+// start and the tries of the last committed block
+func (p *dbPruner) createTrieUnits(epochs []uint32, keptEpochs []uint32) ([]*trieUnit, error) {
+	if !p.generalConfig.StateTriesConfig.SnapshotsEnabled {
+		log.Info("state snapshots are disabled, the tries are not split by epochs and will be kept as they are")
+		return make([]*trieUnit, 0), nil
+	}
+
+	lastEpoch := epochs[len(epochs)-1]
+	headersStorer, err := p.createFullHistoryStorer(storerArgs{
+		storageConfig: p.getHeadersStorageConfig(),
+		pathManager:   p.pathManager,
+		lastEpoch:     lastEpoch,
+		numEpochs:     uint32(len(epochs)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		log.LogIfError(headersStorer.Close())
+	}()
+
+	lastHeader, err := p.getLastHeader(headersStorer, lastEpoch, uint32(len(epochs)))
+	if err != nil {
+		return nil, err
+	}
+
+	units := p.newTrieUnits()
+	for _, epoch := range keptEpochs {
+		epochStartHeader, errGet := p.getEpochStartHeader(headersStorer, epoch)
+		if errGet != nil {
+			log.Warn("epoch start header not found, the epoch start tries will not be copied",
+				"epoch", epoch, "error", errGet)
+			continue
+		}
+
+		addHeaderRootHashes(units, epoch, epochStartHeader)
+	}
+	addHeaderRootHashes(units, lastEpoch, lastHeader)
+
+	return units, nil
+}
+
+func (p *dbPruner) newTrieUnits() []*trieUnit {
+	units := []*trieUnit{
+		newTrieUnit(dataRetriever.UserAccountsUnit, p.generalConfig.AccountsTrieStorage, true),
+	}
+	if p.shardID == core.MetachainShardId {
+		units = append(units, newTrieUnit(dataRetriever.PeerAccountsUnit, p.generalConfig.PeerAccountsTrieStorage, false))
+	}
+
+	return units
+}
+
+func addHeaderRootHashes(units []*trieUnit, epoch uint32, header data.HeaderHandler) {
+	for _, unit := range units {
+		switch unit.unitType {
+		case dataRetriever.UserAccountsUnit:
+			unit.addRootHash(epoch, header.GetRootHash())
+		case dataRetriever.PeerAccountsUnit:
+			metaHeader, ok := header.(data.MetaHeaderHandler)
+			if ok {
+				unit.addRootHash(epoch, metaHeader.GetValidatorStatsRootHash())
+			}
+		}
+	}
+}
+
+func (p *dbPruner) getHeadersStorageConfig() config.StorageConfig {
+	if p.shardID == core.MetachainShardId {
+		return p.generalConfig.MetaBlockStorage
+	}
+
+	return p.generalConfig.BlockHeaderStorage
+}
+
+// getEpochStartHeader searches the epoch start header around the given epoch, as it is saved while the storers are
+// still in the previous epoch
+func (p *dbPruner) getEpochStartHeader(headersStorer storerWithEpochs, epoch uint32) (data.HeaderHandler, error) {
+	key := []byte(core.EpochStartIdentifier(epoch))
+	buff, err := headersStorer.GetFromEpoch(key, epoch)
+	if err != nil && epoch > 0 {
+		buff, err = headersStorer.GetFromEpoch(key, epoch-1)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return process.UnmarshalHeader(p.shardID, p.marshaller, buff)
+}
+
+// getLastHeader returns the last header committed by the node, as saved in the bootstrap storage
+func (p *dbPruner) getLastHeader(headersStorer storerWithEpochs, lastEpoch uint32, numEpochs uint32) (data.HeaderHandler, error) {
+	bootstrapUnit, err := p.createFullHistoryStorer(storerArgs{
+		storageConfig: p.generalConfig.BootstrapStorage,
+		pathManager:   p.pathManager,
+		lastEpoch:     lastEpoch,
+		numEpochs:     numEpochs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		log.LogIfError(bootstrapUnit.Close())
+	}()
+
+	bootStorer, err := bootstrapStorage.NewBootstrapStorer(p.marshaller, bootstrapUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	round := bootStorer.GetHighestRound()
+	bootstrapData, err := bootStorer.Get(round)
+	if err != nil {
+		return nil, fmt.Errorf("%w for round %d: %s", ErrLastHeaderNotFound, round, err.Error())
+	}
+
+	lastHeaderInfo := bootstrapData.LastHeader
+	buff, err := headersStorer.GetFromEpoch(lastHeaderInfo.Hash, lastHeaderInfo.Epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w with nonce %d: %s", ErrLastHeaderNotFound, lastHeaderInfo.Nonce, err.Error())
+	}
+
+	log.Info("found the last committed header",
+		"epoch", lastHeaderInfo.Epoch,
+		"nonce", lastHeaderInfo.Nonce,
+		"hash", lastHeaderInfo.Hash)
+
+	return process.UnmarshalHeader(p.shardID, p.marshaller, buff)
+}
@@ -0,0 +1,121 @@
+package pruner
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common/statistics/disabled"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/epochStart/notifier"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageDisabled "github.com/multiversx/mx-chain-go/storage/databaseremover/disabled"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/pruning"
+)
+
+// storerWithEpochs defines the operations of a full history storer used by the pruner
+type storerWithEpochs interface {
+	storage.Storer
+	PutInEpoch(key []byte, data []byte, epoch uint32) error
+}
+
+type storerArgs struct {
+	storageConfig config.StorageConfig
+	pathManager   storage.PathManagerHandler
+	lastEpoch     uint32
+	numEpochs     uint32
+}
+
+// createStorerArgs prepares the arguments of a pruning storer that is able to access all the epochs up to the last one,
+// without ever removing the old databases
+func (p *dbPruner) createStorerArgs(args storerArgs) (pruning.StorerArgs, error) {
+	shardCoordinator, err := p.createShardCoordinator()
+	if err != nil {
+		return pruning.StorerArgs{}, err
+	}
+
+	numActivePersisters := uint32(p.generalConfig.StoragePruning.NumActivePersisters)
+	if numActivePersisters == 0 {
+		numActivePersisters = 1
+	}
+	numEpochsToKeep := args.numEpochs
+	if numEpochsToKeep < numActivePersisters {
+		numEpochsToKeep = numActivePersisters
+	}
+	epochsData := pruning.EpochArgs{
+		StartingEpoch:         args.lastEpoch,
+		NumOfEpochsToKeep:     numEpochsToKeep,
+		NumOfActivePersisters: numActivePersisters,
+	}
+
+	dbConfigHandler := storageFactory.NewDBConfigHandler(args.storageConfig.DB)
+	persisterFactory, err := storageFactory.NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return pruning.StorerArgs{}, err
+	}
+
+	return pruning.StorerArgs{
+		Identifier:             args.storageConfig.DB.FilePath,
+		PruningEnabled:         true,
+		OldDataCleanerProvider: &disabledOldDataCleanerProvider{},
+		CustomDatabaseRemover:  storageDisabled.NewDisabledCustomDatabaseRemover(),
+		ShardCoordinator:       shardCoordinator,
+		CacheConf:              storageFactory.GetCacherFromConfig(args.storageConfig.Cache),
+		PathManager:            args.pathManager,
+		DbPath:                 args.pathManager.PathForEpoch(p.shardIDString, args.lastEpoch, args.storageConfig.DB.FilePath),
+		PersisterFactory:       persisterFactory,
+		Notifier:               notifier.NewEpochStartSubscriptionHandler(),
+		MaxBatchSize:           args.storageConfig.DB.MaxBatchSize,
+		PersistersTracker:      pruning.NewPersistersTracker(epochsData),
+		EpochsData:             epochsData,
+		StateStatsHandler:      disabled.NewStateStatistics(),
+	}, nil
+}
+
+func (p *dbPruner) createFullHistoryStorer(args storerArgs) (storerWithEpochs, error) {
+	pruningArgs, err := p.createStorerArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return pruning.NewFullHistoryPruningStorer(pruning.FullHistoryStorerArgs{
+		StorerArgs:               pruningArgs,
+		NumOfOldActivePersisters: p.numEpochsToKeep,
+	})
+}
+
+func (p *dbPruner) createFullHistoryTrieStorer(args storerArgs) (storerWithEpochs, error) {
+	pruningArgs, err := p.createStorerArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return pruning.NewFullHistoryTriePruningStorer(pruning.FullHistoryStorerArgs{
+		StorerArgs:               pruningArgs,
+		NumOfOldActivePersisters: p.numEpochsToKeep,
+	})
+}
+
+func (p *dbPruner) createShardCoordinator() (sharding.Coordinator, error) {
+	// only the self shard ID is used by the pruning storers, so the number of shards just needs to be valid
+	numShards := p.shardID + 1
+	if p.shardID == core.MetachainShardId {
+		numShards = 1
+	}
+
+	return sharding.NewMultiShardCoordinator(numShards, p.shardID)
+}
+
+// disabledOldDataCleanerProvider never allows the pruning storers to remove old databases, as the pruner decides by
+// itself which epochs are removed
+type disabledOldDataCleanerProvider struct {
+}
+
+// ShouldClean returns false
+func (d *disabledOldDataCleanerProvider) ShouldClean() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledOldDataCleanerProvider) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,248 @@
+package pruner
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-go/common"
+	commonDisabled "github.com/multiversx/mx-chain-go/common/disabled"
+	disabledStatistics "github.com/multiversx/mx-chain-go/common/statistics/disabled"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+const (
+	// prunedTriesDirectory holds the fresh trie storers until all the live tries are copied
+	prunedTriesDirectory = "PrunedTries"
+	// replacedStorerSuffix marks an existing trie storer moved aside until all the fresh storers are in place
+	replacedStorerSuffix = ".replaced"
+)
+
+// freshTrieStorer links a fresh trie storer with the existing storer it replaces
+type freshTrieStorer struct {
+	sourcePath string
+	freshPath  string
+}
+
+func (fresh *freshTrieStorer) replacedPath() string {
+	return fresh.sourcePath + replacedStorerSuffix
+}
+
+// copyLiveTries copies the live tries of each epoch in fresh trie storers, created in a separate directory. The
+// existing databases are not altered, so a failed copy leaves the node databases as they were
+func (p *dbPruner) copyLiveTries(units []*trieUnit, epochs []uint32) ([]*freshTrieStorer, error) {
+	freshRoot := filepath.Join(p.dbPath, prunedTriesDirectory)
+	err := os.RemoveAll(freshRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	freshPathManager, err := storageFactory.CreatePathManagerFromSinglePathString(freshRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	freshStorers := make([]*freshTrieStorer, 0)
+	for _, unit := range units {
+		if len(unit.rootHashes) == 0 {
+			continue
+		}
+
+		err = p.copyUnitTries(unit, epochs, freshPathManager)
+		if err != nil {
+			log.LogIfError(os.RemoveAll(freshRoot))
+			return nil, err
+		}
+
+		for _, epoch := range unit.sortedEpochs() {
+			freshStorers = append(freshStorers, &freshTrieStorer{
+				sourcePath: p.unitPath(epoch, unit.storageConfig.DB),
+				freshPath:  freshPathManager.PathForEpoch(p.shardIDString, epoch, unit.storageConfig.DB.FilePath),
+			})
+		}
+	}
+
+	return freshStorers, nil
+}
+
+func (p *dbPruner) copyUnitTries(unit *trieUnit, epochs []uint32, freshPathManager storage.PathManagerHandler) error {
+	args := storerArgs{
+		storageConfig: unit.storageConfig,
+		pathManager:   p.pathManager,
+		lastEpoch:     epochs[len(epochs)-1],
+		numEpochs:     uint32(len(epochs)),
+	}
+	sourceStorer, err := p.createFullHistoryTrieStorer(args)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(sourceStorer.Close())
+	}()
+
+	args.pathManager = freshPathManager
+	freshStorer, err := p.createFullHistoryTrieStorer(args)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		log.LogIfError(freshStorer.Close())
+	}()
+
+	for _, epoch := range unit.sortedEpochs() {
+		epochStorer, errCopy := newEpochReadOnlyStorer(sourceStorer, epochs, epoch)
+		if errCopy != nil {
+			return errCopy
+		}
+
+		numNodes, errCopy := p.copyEpochTries(unit, epoch, epochStorer, freshStorer)
+		if errCopy != nil {
+			return errCopy
+		}
+
+		// the marker tells the node that the storer holds complete tries, as after a finished state snapshot
+		errCopy = freshStorer.PutInEpoch([]byte(common.ActiveDBKey), []byte(common.ActiveDBVal), epoch)
+		if errCopy != nil {
+			return errCopy
+		}
+
+		unit.resultsByEpoch[epoch].NumWrittenNodes = numNodes
+		log.Info("copied the live tries", "unit", unit.unitType.String(), "epoch", epoch, "num nodes", numNodes)
+	}
+
+	return nil
+}
+
+func (p *dbPruner) copyEpochTries(
+	unit *trieUnit,
+	epoch uint32,
+	sourceStorer common.BaseStorer,
+	freshStorer storerWithEpochs,
+) (uint64, error) {
+	trieStorageManager, err := p.createTrieStorageManager(sourceStorer, unit.unitType)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		log.LogIfError(trieStorageManager.Close())
+	}()
+
+	tr, err := trie.NewTrie(trieStorageManager, p.marshaller, p.hasher, p.enableEpochsHandler, p.getMaxTrieLevelInMemory(unit.unitType))
+	if err != nil {
+		return 0, err
+	}
+
+	numNodes := uint64(0)
+	writeNode := func(encodedNode []byte) error {
+		numNodes++
+		return freshStorer.PutInEpoch(p.hasher.Compute(string(encodedNode)), encodedNode, epoch)
+	}
+
+	ctx := context.Background()
+	for _, rootHash := range unit.rootHashes[epoch] {
+		rootTrie, errRecreate := tr.Recreate(rootHash)
+		if errRecreate != nil {
+			return 0, errRecreate
+		}
+
+		err = trie.WalkTrieNodes(ctx, rootTrie, writeNode)
+		if err != nil {
+			return 0, err
+		}
+		if !unit.withDataTries {
+			continue
+		}
+
+		_, err = trie.WalkDataTries(ctx, rootTrie, rootHash, p.marshaller, func(dataTrie common.Trie) error {
+			return trie.WalkTrieNodes(ctx, dataTrie, writeNode)
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return numNodes, nil
+}
+
+func (p *dbPruner) createTrieStorageManager(storer common.BaseStorer, unitType dataRetriever.UnitType) (common.StorageManager, error) {
+	args := trie.NewTrieStorageManagerArgs{
+		MainStorer:  storer,
+		Marshalizer: p.marshaller,
+		Hasher:      p.hasher,
+		GeneralConfig: config.TrieStorageManagerConfig{
+			SnapshotsGoroutineNum: 1,
+		},
+		IdleProvider:   commonDisabled.NewProcessStatusHandler(),
+		Identifier:     unitType.String(),
+		StatsCollector: disabledStatistics.NewStateStatistics(),
+	}
+	options := trie.StorageManagerOptions{
+		PruningEnabled:   false,
+		SnapshotsEnabled: false,
+	}
+
+	return trie.CreateTrieStorageManager(args, options)
+}
+
+func (p *dbPruner) getMaxTrieLevelInMemory(unitType dataRetriever.UnitType) uint {
+	if unitType == dataRetriever.PeerAccountsUnit {
+		return p.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory
+	}
+
+	return p.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory
+}
+
+// replaceTrieStorers moves the fresh trie storers in place of the existing ones. It is called only after all the
+// live tries were copied and all the storers were closed. The existing storers are first moved aside and are removed
+// only after all the fresh storers are in place, so that a failed replacement is reverted
+func (p *dbPruner) replaceTrieStorers(freshStorers []*freshTrieStorer) error {
+	for i, fresh := range freshStorers {
+		err := moveFreshStorerInPlace(fresh)
+		if err != nil {
+			restoreReplacedStorers(freshStorers[:i])
+			return err
+		}
+	}
+
+	for _, fresh := range freshStorers {
+		err := os.RemoveAll(fresh.replacedPath())
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(filepath.Join(p.dbPath, prunedTriesDirectory))
+}
+
+func moveFreshStorerInPlace(fresh *freshTrieStorer) error {
+	// a leftover of an interrupted replacement might hold the only copy of the existing storer
+	_, err := os.Stat(fresh.replacedPath())
+	if err == nil {
+		return fmt.Errorf("%w: %s", ErrReplacedStorerFound, fresh.replacedPath())
+	}
+
+	err = os.Rename(fresh.sourcePath, fresh.replacedPath())
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(fresh.freshPath, fresh.sourcePath)
+	if err != nil {
+		log.LogIfError(os.Rename(fresh.replacedPath(), fresh.sourcePath))
+		return err
+	}
+
+	return nil
+}
+
+func restoreReplacedStorers(freshStorers []*freshTrieStorer) {
+	for _, fresh := range freshStorers {
+		log.LogIfError(os.Rename(fresh.sourcePath, fresh.freshPath))
+		log.LogIfError(os.Rename(fresh.replacedPath(), fresh.sourcePath))
+	}
+}
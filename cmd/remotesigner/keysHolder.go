@@ -0,0 +1,27 @@
+package main
+
+import (
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
+
+// keysHolder maps the public keys bytes to the loaded private keys
+type keysHolder map[string]crypto.PrivateKey
+
+// GetHandledPrivateKey returns the private key associated with the provided public key, nil if the key is not loaded
+func (holder keysHolder) GetHandledPrivateKey(pkBytes []byte) crypto.PrivateKey {
+	return holder[string(pkBytes)]
+}
+
+func (holder keysHolder) publicKeys() [][]byte {
+	publicKeys := make([][]byte, 0, len(holder))
+	for pk := range holder {
+		publicKeys = append(publicKeys, []byte(pk))
+	}
+
+	return publicKeys
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder keysHolder) IsInterfaceNil() bool {
+	return holder == nil
+}
@@ -0,0 +1,333 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-chain-go/common"
+	cryptoComp "github.com/multiversx/mx-chain-go/factory/crypto"
+	"github.com/multiversx/mx-chain-go/keysManagement"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+var (
+	remoteSignerHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// configurationFile defines a flag for the path to the main toml configuration file of the node
+	configurationFile = cli.StringFlag{
+		Name: "config",
+		Usage: "The `[path]` to the main configuration file of the nodes using this signer. The consensus type and " +
+			"the multi-signer hasher are taken from it",
+		Value: "../node/config/config.toml",
+	}
+	// epochConfigurationFile defines a flag for the path to the toml file containing the epochs activations
+	epochConfigurationFile = cli.StringFlag{
+		Name:  "epoch-config",
+		Usage: "The `[path]` to the toml file containing the activation epochs of the multi-signers",
+		Value: "../node/config/enableEpochs.toml",
+	}
+	// keysFile defines a flag for the path to the pem file holding the validator keys
+	keysFile = cli.StringFlag{
+		Name:  "keys-file",
+		Usage: "The `[path]` to the pem file holding the BLS private keys, in the allValidatorsKeys.pem format",
+		Value: "./allValidatorsKeys.pem",
+	}
+	// listenAddress defines a flag for the interface and port the signer listens on
+	listenAddress = cli.StringFlag{
+		Name:  "listen-address",
+		Usage: "The `[address]` the HTTP signer listens on",
+		Value: "127.0.0.1:8090",
+	}
+	// useAuthorization defines a flag that activates the basic authorization on all routes
+	useAuthorization = cli.BoolFlag{
+		Name:  "use-authorization",
+		Usage: "Boolean option for requiring the provided username and password on all requests",
+	}
+	// username defines a flag for the username used in the basic authorization
+	username = cli.StringFlag{
+		Name:  "username",
+		Usage: "The `[username]` expected by the basic authorization",
+		Value: "",
+	}
+	// password defines a flag for the password used in the basic authorization
+	password = cli.StringFlag{
+		Name:   "password",
+		Usage:  "The `[password]` expected by the basic authorization. It can also be provided through the REMOTE_SIGNER_PASSWORD environment variable",
+		Value:  "",
+		EnvVar: "REMOTE_SIGNER_PASSWORD",
+	}
+	// tlsCertFile defines a flag for the path to the certificate served by the signer
+	tlsCertFile = cli.StringFlag{
+		Name:  "tls-cert-file",
+		Usage: "The `[path]` to the PEM certificate served by the signer. If set together with the key file, the signer serves HTTPS",
+		Value: "",
+	}
+	// tlsKeyFile defines a flag for the path to the key of the certificate served by the signer
+	tlsKeyFile = cli.StringFlag{
+		Name:  "tls-key-file",
+		Usage: "The `[path]` to the PEM key of the certificate served by the signer",
+		Value: "",
+	}
+	// clientCACertFile defines a flag for the path to the certificate authority used to verify the nodes
+	clientCACertFile = cli.StringFlag{
+		Name:  "client-ca-cert-file",
+		Usage: "The `[path]` to the PEM certificate authority used to verify the client certificates of the nodes. If set, the nodes must present a client certificate",
+		Value: "",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = remoteSignerHelpTemplate
+	app.Name = "RemoteSigner CLI App"
+	app.Usage = "This is a reference remote signer, meant for testing, that holds the validators BLS keys and signs " +
+		"on behalf of the nodes configured to use a remote signer"
+	app.Flags = []cli.Flag{
+		configurationFile,
+		epochConfigurationFile,
+		keysFile,
+		listenAddress,
+		useAuthorization,
+		username,
+		password,
+		tlsCertFile,
+		tlsKeyFile,
+		clientCACertFile,
+		logLevel,
+	}
+	app.Action = startSigner
+	app.Version = "v0.0.1"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func startSigner(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	handler, err := createSignerHandler(ctx)
+	if err != nil {
+		return err
+	}
+
+	server, err := createServer(ctx, handler)
+	if err != nil {
+		return err
+	}
+
+	certFile := ctx.GlobalString(tlsCertFile.Name)
+	keyFile := ctx.GlobalString(tlsKeyFile.Name)
+	chServerError := make(chan error, 1)
+	go func() {
+		var errServe error
+		if len(certFile) > 0 {
+			log.Info("remote signer started", "address", server.Addr, "protocol", "https")
+			errServe = server.ListenAndServeTLS(certFile, keyFile)
+		} else {
+			log.Info("remote signer started", "address", server.Addr, "protocol", "http")
+			errServe = server.ListenAndServe()
+		}
+		if !errors.Is(errServe, http.ErrServerClosed) {
+			chServerError <- errServe
+		}
+	}()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case err = <-chServerError:
+		return err
+	case <-sigs:
+	}
+
+	log.Info("terminating the remote signer...")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	return server.Shutdown(shutdownCtx)
+}
+
+func createServer(ctx *cli.Context, handler http.Handler) (*http.Server, error) {
+	certFile := ctx.GlobalString(tlsCertFile.Name)
+	keyFile := ctx.GlobalString(tlsKeyFile.Name)
+	if len(certFile) == 0 != (len(keyFile) == 0) {
+		return nil, errors.New("both the TLS certificate and the TLS key files should be provided")
+	}
+
+	server := &http.Server{
+		Addr:              ctx.GlobalString(listenAddress.Name),
+		Handler:           handler,
+		ReadHeaderTimeout: shutdownTimeout,
+	}
+
+	caCertFile := ctx.GlobalString(clientCACertFile.Name)
+	if len(caCertFile) == 0 {
+		return server, nil
+	}
+	if len(certFile) == 0 {
+		return nil, errors.New("the client certificates can be verified only when serving HTTPS")
+	}
+
+	caCert, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("no certificate found in file %s", caCertFile)
+	}
+
+	server.TLSConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+
+	return server, nil
+}
+
+func createSignerHandler(ctx *cli.Context) (http.Handler, error) {
+	generalConfig, err := common.LoadMainConfig(ctx.GlobalString(configurationFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	epochConfig, err := common.LoadEpochConfig(ctx.GlobalString(epochConfigurationFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	keys, err := loadKeys(keyGen, ctx.GlobalString(keysFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	multiSignerContainer, err := cryptoComp.NewMultiSignerContainer(
+		cryptoComp.MultiSigArgs{
+			MultiSigHasherType: generalConfig.MultisigHasher.Type,
+			BlSignKeyGen:       keyGen,
+			ConsensusType:      generalConfig.Consensus.Type,
+		},
+		epochConfig.EnableEpochs.BLSMultiSignerEnableEpoch,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	keysSigner, err := keysManagement.NewLocalKeysSigner(keysManagement.ArgsLocalKeysSigner{
+		PrivateKeysProvider:  keys,
+		SingleSigner:         singlesig.NewBlsSigner(),
+		MultiSignerContainer: multiSignerContainer,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return remoteSigner.NewSignerHandler(remoteSigner.ArgsSignerHandler{
+		KeysSigner:       keysSigner,
+		PublicKeys:       keys.publicKeys(),
+		UseAuthorization: ctx.GlobalBool(useAuthorization.Name),
+		Username:         ctx.GlobalString(username.Name),
+		Password:         ctx.GlobalString(password.Name),
+	})
+}
+
+func loadKeys(keyGen crypto.KeyGenerator, filename string) (keysHolder, error) {
+	privateKeys, publicKeys, err := core.NewKeyLoader().LoadAllKeys(filename)
+	if err != nil {
+		return nil, err
+	}
+	if len(privateKeys) != len(publicKeys) {
+		return nil, fmt.Errorf("mismatch number of private and public keys in file %s", filename)
+	}
+
+	keys := make(keysHolder, len(privateKeys))
+	for i, pkString := range publicKeys {
+		skBytes, errDecode := hex.DecodeString(string(privateKeys[i]))
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for encoded secret key, key index %d", errDecode, i)
+		}
+
+		pkBytes, errDecode := hex.DecodeString(pkString)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for encoded public key %s, key index %d", errDecode, pkString, i)
+		}
+
+		sk, errKey := keyGen.PrivateKeyFromByteArray(skBytes)
+		if errKey != nil {
+			return nil, fmt.Errorf("%w for secret key, key index %d", errKey, i)
+		}
+
+		generatedPkBytes, errKey := sk.GeneratePublic().ToByteArray()
+		if errKey != nil {
+			return nil, fmt.Errorf("%w for public key %s, key index %d", errKey, pkString, i)
+		}
+		if !bytes.Equal(pkBytes, generatedPkBytes) {
+			return nil, fmt.Errorf("public keys mismatch for key index %d, read %s, generated %s",
+				i, pkString, hex.EncodeToString(generatedPkBytes))
+		}
+
+		keys[string(pkBytes)] = sk
+		log.Info("loaded key", "public key", pkString)
+	}
+
+	return keys, nil
+}
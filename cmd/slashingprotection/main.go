@@ -0,0 +1,239 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/consensus/slashingProtection"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const exportedFilePermissions = 0644
+
+var (
+	slashingProtectionHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// configurationFile defines a flag for the path to the main toml configuration file of the node
+	configurationFile = cli.StringFlag{
+		Name: "config",
+		Usage: "The `[path]` to the main configuration file of the node. The slashing protection database settings " +
+			"and the chain ID are taken from it",
+		Value: "../node/config/config.toml",
+	}
+	// workingDirectory defines a flag for the working directory of the node
+	workingDirectory = cli.StringFlag{
+		Name: "working-directory",
+		Usage: "The `[path]` to the working directory of the node, where the slashing protection database is found. " +
+			"The database can not be opened while the node is running",
+		Value: ".",
+	}
+	// file defines a flag for the path to the interchange file
+	file = cli.StringFlag{
+		Name:  "file",
+		Usage: "The `[path]` to the JSON file holding the slashing protection records in the interchange format",
+		Value: "./slashingProtection.json",
+	}
+	// publicKeys defines a flag for the public keys whose records are exported
+	publicKeys = cli.StringFlag{
+		Name:  "public-keys",
+		Usage: "The comma-separated hex encoded BLS public `keys` whose records are exported. If not set, all the records are exported",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+)
+
+var log = logger.GetOrCreate("main")
+
+type slashingProtectorHandler interface {
+	Export(chainID string, publicKeys [][]byte) (*slashingProtection.InterchangeData, error)
+	Import(data *slashingProtection.InterchangeData, chainID string) (int, error)
+	Close() error
+}
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = slashingProtectionHelpTemplate
+	app.Name = "SlashingProtection CLI App"
+	app.Usage = "This tool exports and imports the slashing protection records of a stopped node, so they can be " +
+		"moved along with the validator BLS keys"
+	app.Flags = []cli.Flag{
+		configurationFile,
+		workingDirectory,
+		logLevel,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "export",
+			Usage:  "writes the slashing protection records in the interchange format",
+			Flags:  []cli.Flag{file, publicKeys},
+			Action: exportRecords,
+		},
+		{
+			Name:   "import",
+			Usage:  "adds the slashing protection records from an interchange file. Nothing is imported if a record conflicts with an existing one",
+			Flags:  []cli.Flag{file},
+			Action: importRecords,
+		},
+	}
+	app.Version = "v0.0.1"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func exportRecords(ctx *cli.Context) error {
+	keys, err := decodePublicKeys(ctx.String(publicKeys.Name))
+	if err != nil {
+		return err
+	}
+
+	protector, chainID, err := createSlashingProtector(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSlashingProtector(protector)
+
+	data, err := protector.Export(chainID, keys)
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	filename := ctx.String(file.Name)
+	err = os.WriteFile(filename, buff, exportedFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	log.Info("exported slashing protection records", "num records", len(data.SignedHeaders), "file", filename)
+
+	return nil
+}
+
+func importRecords(ctx *cli.Context) error {
+	filename := ctx.String(file.Name)
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	data := &slashingProtection.InterchangeData{}
+	err = json.Unmarshal(buff, data)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the interchange file %s", err, filename)
+	}
+
+	protector, chainID, err := createSlashingProtector(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeSlashingProtector(protector)
+
+	numImported, err := protector.Import(data, chainID)
+	if err != nil {
+		return err
+	}
+
+	log.Info("imported slashing protection records",
+		"num records in file", len(data.SignedHeaders),
+		"num new records", numImported,
+		"file", filename)
+
+	return nil
+}
+
+func createSlashingProtector(ctx *cli.Context) (slashingProtectorHandler, string, error) {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return nil, "", err
+	}
+
+	generalConfig, err := common.LoadMainConfig(ctx.GlobalString(configurationFile.Name))
+	if err != nil {
+		return nil, "", err
+	}
+
+	protector, err := createSlashingProtectorFromConfig(generalConfig.SlashingProtection, ctx.GlobalString(workingDirectory.Name))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return protector, generalConfig.GeneralSettings.ChainID, nil
+}
+
+func createSlashingProtectorFromConfig(cfg config.SlashingProtectionConfig, workingDir string) (slashingProtectorHandler, error) {
+	storer, err := slashingProtection.CreateStorer(cfg, workingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return slashingProtection.NewSlashingProtector(storer, cfg.NumRoundsToKeep)
+}
+
+func closeSlashingProtector(protector slashingProtectorHandler) {
+	err := protector.Close()
+	if err != nil {
+		log.Warn("error closing the slashing protection database", "error", err)
+	}
+}
+
+func decodePublicKeys(commaSeparatedKeys string) ([][]byte, error) {
+	keys := make([][]byte, 0)
+	for _, pkString := range strings.Split(commaSeparatedKeys, ",") {
+		pkString = strings.TrimSpace(pkString)
+		if len(pkString) == 0 {
+			continue
+		}
+
+		pkBytes, err := hex.DecodeString(pkString)
+		if err != nil {
+			return nil, fmt.Errorf("%w for public key %s", err, pkString)
+		}
+
+		keys = append(keys, pkBytes)
+	}
+
+	return keys, nil
+}
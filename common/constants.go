@@ -323,6 +323,19 @@ const MetricRedundancyIsMainActive = "erd_redundancy_is_main_active"
 // MetricRedundancyStepInReason is the metric that specifies why the back-up machine stepped in
 const MetricRedundancyStepInReason = "erd_redundancy_step_in_reason"
 
+// MetricRedundancyLeaseInstanceID is the metric that specifies the identifier of the current instance among the
+// redundant instances coordinated through the redundancy lease
+const MetricRedundancyLeaseInstanceID = "erd_redundancy_lease_instance_id"
+
+// MetricRedundancyLeaseHolder is the metric that specifies the identifier of the instance holding the redundancy lease
+const MetricRedundancyLeaseHolder = "erd_redundancy_lease_holder"
+
+// MetricRedundancyLeaseTerm is the metric that specifies the term of the redundancy lease, incremented on each handover
+const MetricRedundancyLeaseTerm = "erd_redundancy_lease_term"
+
+// MetricRedundancyLeaseIsHolder is the metric that specifies if the current instance holds the redundancy lease
+const MetricRedundancyLeaseIsHolder = "erd_redundancy_lease_is_holder"
+
 // MetricValueNA represents the value to be used when a metric is not available/applicable
 const MetricValueNA = "N/A"
 
@@ -833,6 +846,15 @@ const (
 	// TrieLeavesChannelSyncCapacity represents the value to be used as capacity for getting main trie
 	// leaf nodes for trie sync
 	TrieLeavesChannelSyncCapacity = 1000
+
+	// TrieStatisticsJobRunning is the status of a trie statistics job which is still collecting the statistics
+	TrieStatisticsJobRunning = "running"
+
+	// TrieStatisticsJobFinished is the status of a trie statistics job which has the report ready
+	TrieStatisticsJobFinished = "finished"
+
+	// TrieStatisticsJobFailed is the status of a trie statistics job which stopped because of an error
+	TrieStatisticsJobFailed = "failed"
 )
 
 // ApiOutputFormat represents the format type returned by api
@@ -7,3 +7,11 @@ type MultiSignerContainer interface {
 	GetMultiSigner(epoch uint32) (crypto.MultiSigner, error)
 	IsInterfaceNil() bool
 }
+
+// KeysSigner defines the component able to sign messages with the BLS keys handled by the node, without
+// exposing the private keys to the caller
+type KeysSigner interface {
+	Sign(publicKey []byte, message []byte) ([]byte, error)
+	CreateSignatureShare(publicKey []byte, message []byte, epoch uint32) ([]byte, error)
+	IsInterfaceNil() bool
+}
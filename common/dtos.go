@@ -1,7 +1,9 @@
 package common
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 )
 
 // GetProofResponse is a struct that stores the response of a GetProof API request
@@ -11,6 +13,38 @@ type GetProofResponse struct {
 	RootHash string
 }
 
+// KeyValuePair holds a hex encoded key-value pair of an account
+type KeyValuePair struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// KeyValuePairsPage holds a page of the key-value pairs of an account, in the order of their trie keys, together with
+// the hex encoded cursor of the next page. The cursor is empty if there are no more pairs
+type KeyValuePairsPage struct {
+	Pairs   []KeyValuePair
+	NextKey string
+}
+
+// GetMultiProofResponse is a struct that stores the response of a GetMultiProof API request. The values follow the
+// order of the requested keys, a nil value signaling a key missing from the trie
+type GetMultiProofResponse struct {
+	Proof    [][]byte
+	Values   [][]byte
+	RootHash string
+}
+
+// GetMultiProofDataTrieResponse is a struct that stores the response of a GetMultiProofDataTrie API request. The data
+// trie proof proves the values of the data trie keys, which hold for each requested key its hashed form, used by the
+// migrated data tries, followed by its plain form, used by the older ones. The values are the ones of the requested
+// keys, in the same order, a nil value signaling a key missing from the data trie
+type GetMultiProofDataTrieResponse struct {
+	MainProof     *GetProofResponse
+	DataTrieProof *GetMultiProofResponse
+	DataTrieKeys  [][]byte
+	Values        [][]byte
+}
+
 // TransactionsPoolAPIResponse is a struct that holds the data to be returned when getting the transaction pool from an API call
 type TransactionsPoolAPIResponse struct {
 	RegularTransactions  []Transaction `json:"regularTransactions"`
@@ -42,6 +76,56 @@ type TransactionsPoolNonceGapsForSenderApiResponse struct {
 	Gaps   []NonceGapApiResponse `json:"gaps"`
 }
 
+// TransactionsPoolFilter holds the optional filters applied when getting the transaction pool from an API call
+// Receiver - the bech32 address of the receiver
+// Function - the name of the called function, as found at the beginning of the data field
+type TransactionsPoolFilter struct {
+	Receiver string
+	Function string
+}
+
+// TransactionsPoolSenderDetailsApiResponse is a struct that holds the data to be returned when getting the details of a sender from transactions pool from an API call
+// StuckTransactions - the hashes of the transactions that can not be selected until the first nonce gap is filled
+type TransactionsPoolSenderDetailsApiResponse struct {
+	Sender            string                `json:"sender"`
+	AccountNonce      uint64                `json:"accountNonce"`
+	NumTransactions   int                   `json:"numTransactions"`
+	Gaps              []NonceGapApiResponse `json:"gaps"`
+	StuckTransactions []string              `json:"stuckTransactions"`
+	Transactions      []Transaction         `json:"transactions"`
+}
+
+// GasPriceDistributionApiResponse is a struct that holds the distribution of the gas prices of the transactions from a transactions pool cache
+type GasPriceDistributionApiResponse struct {
+	Min    uint64 `json:"min"`
+	Max    uint64 `json:"max"`
+	Median uint64 `json:"median"`
+	P90    uint64 `json:"p90"`
+}
+
+// TransactionsPoolCacheStatisticsApiResponse is a struct that holds the statistics of a transactions pool cache
+type TransactionsPoolCacheStatisticsApiResponse struct {
+	CacheID         string                          `json:"cacheID"`
+	NumTransactions int                             `json:"numTransactions"`
+	NumSenders      int                             `json:"numSenders"`
+	GasPrice        GasPriceDistributionApiResponse `json:"gasPrice"`
+}
+
+// TransactionsPoolEvictionRecord holds an eviction performed by a transactions pool cache
+type TransactionsPoolEvictionRecord struct {
+	CacheID           string `json:"cacheID"`
+	Timestamp         int64  `json:"timestamp"`
+	Reason            string `json:"reason"`
+	NumTxsEvicted     uint32 `json:"numTxsEvicted"`
+	NumSendersEvicted uint32 `json:"numSendersEvicted"`
+}
+
+// TransactionsPoolStatisticsApiResponse is a struct that holds the data to be returned when getting the transactions pool statistics from an API call
+type TransactionsPoolStatisticsApiResponse struct {
+	Caches    []TransactionsPoolCacheStatisticsApiResponse `json:"caches"`
+	Evictions []TransactionsPoolEvictionRecord             `json:"evictions"`
+}
+
 // DelegationDataAPI will be used when requesting the genesis balances from API
 type DelegationDataAPI struct {
 	Address string `json:"address"`
@@ -91,3 +175,124 @@ type AuctionListValidatorAPIResponse struct {
 	QualifiedTopUp string         `json:"qualifiedTopUp"`
 	Nodes          []*AuctionNode `json:"nodes"`
 }
+
+// StateDiffQueryOptions holds the options of a state diff request. The accounts are compared in the increasing order of
+// their addresses, starting with FromAddress, and at most PageSize accounts are returned
+type StateDiffQueryOptions struct {
+	FromNonce   uint64
+	ToNonce     uint64
+	FromAddress string
+	PageSize    int
+}
+
+// StateDiffAPIResponse holds the accounts which differ between two blocks. If NextAddress is not empty, the next page
+// is fetched by using it as the start address
+type StateDiffAPIResponse struct {
+	FromBlock   api.BlockInfo             `json:"fromBlock"`
+	ToBlock     api.BlockInfo             `json:"toBlock"`
+	Added       []*AccountDiffAPIResponse `json:"added"`
+	Removed     []*AccountDiffAPIResponse `json:"removed"`
+	Modified    []*AccountDiffAPIResponse `json:"modified"`
+	NextAddress string                    `json:"nextAddress,omitempty"`
+}
+
+// AccountDiffAPIResponse holds the old and the new version of an account, together with its changed data trie keys
+type AccountDiffAPIResponse struct {
+	Address         string                       `json:"address"`
+	OldAccount      *api.AccountResponse         `json:"oldAccount,omitempty"`
+	NewAccount      *api.AccountResponse         `json:"newAccount,omitempty"`
+	DataTrieChanges []*DataTrieChangeAPIResponse `json:"dataTrieChanges,omitempty"`
+}
+
+// DataTrieChangeAPIResponse holds the hex encoded old and new values of a data trie key
+type DataTrieChangeAPIResponse struct {
+	Key      string `json:"key"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+}
+
+// TriesStatisticsReport holds the statistics collected for a main trie and for all its data tries
+type TriesStatisticsReport struct {
+	NumNodes         uint64                           `json:"numNodes"`
+	TotalSize        uint64                           `json:"totalSize"`
+	NumTriesByType   map[TrieType]uint64              `json:"numTriesByType"`
+	StatsByType      map[TrieType]*TrieTypeStatistics `json:"statsByType"`
+	LargestDataTries []*TrieSummary                   `json:"largestDataTries"`
+	DataTriesVersion *DataTriesVersionStatistics      `json:"dataTriesVersion"`
+}
+
+// TrieTypeStatistics holds the merged statistics of all the tries of the same type
+type TrieTypeStatistics struct {
+	BranchNodes     NodesStatistics   `json:"branchNodes"`
+	ExtensionNodes  NodesStatistics   `json:"extensionNodes"`
+	LeafNodes       NodesStatistics   `json:"leafNodes"`
+	MaxDepth        uint32            `json:"maxDepth"`
+	NodesPerDepth   map[uint32]uint64 `json:"nodesPerDepth"`
+	LeavesByVersion map[string]uint64 `json:"leavesByVersion"`
+}
+
+// NodesStatistics holds the number and the total size of the trie nodes of a certain kind
+type NodesStatistics struct {
+	NumNodes uint64 `json:"numNodes"`
+	Size     uint64 `json:"size"`
+}
+
+// TrieSummary holds the main statistics of a single trie
+type TrieSummary struct {
+	Address  string `json:"address"`
+	RootHash string `json:"rootHash"`
+	NumNodes uint64 `json:"numNodes"`
+	Size     uint64 `json:"size"`
+	MaxDepth uint32 `json:"maxDepth"`
+}
+
+// DataTriesVersionStatistics holds the number of data tries which have all their leaves migrated to the auto balanced
+// version and the number of data tries which still have leaves with no version
+type DataTriesVersionStatistics struct {
+	NumMigrated    uint64 `json:"numMigrated"`
+	NumNotMigrated uint64 `json:"numNotMigrated"`
+}
+
+// TrieStatisticsAPIResponse holds the state of a trie statistics job
+type TrieStatisticsAPIResponse struct {
+	RootHash  string                 `json:"rootHash"`
+	Status    string                 `json:"status"`
+	StartTime int64                  `json:"startTime"`
+	EndTime   int64                  `json:"endTime,omitempty"`
+	NumTries  uint64                 `json:"numTries"`
+	NumNodes  uint64                 `json:"numNodes"`
+	Error     string                 `json:"error,omitempty"`
+	Report    *TriesStatisticsReport `json:"report,omitempty"`
+}
+
+// RedundancyLeaseState holds the state of the redundancy lease as seen by the current instance
+type RedundancyLeaseState struct {
+	InstanceID    string `json:"instanceID"`
+	Holder        string `json:"holder"`
+	Term          uint64 `json:"term"`
+	IsLeaseHolder bool   `json:"isLeaseHolder"`
+}
+
+// AccountHistoryQueryOptions holds the options of an account history request. If ToNonce is not set, the history is
+// returned starting with the most recent entry
+type AccountHistoryQueryOptions struct {
+	FromNonce uint64
+	ToNonce   core.OptionalUint64
+	PageSize  int
+}
+
+// AccountHistoryAPIResponse holds a page of the history of an account, newest first. If HasMore is set, the next page
+// is fetched by using NextToNonce as the upper bound of the block nonces range
+type AccountHistoryAPIResponse struct {
+	Entries     []*AccountHistoryEntryAPIResponse `json:"entries"`
+	HasMore     bool                              `json:"hasMore"`
+	NextToNonce uint64                            `json:"nextToNonce"`
+}
+
+// AccountHistoryEntryAPIResponse holds the balance and the nonce of an account after the block which altered it
+type AccountHistoryEntryAPIResponse struct {
+	BlockNonce uint64 `json:"blockNonce"`
+	BlockHash  string `json:"blockHash"`
+	Balance    string `json:"balance"`
+	Nonce      uint64 `json:"nonce"`
+}
@@ -50,10 +50,15 @@ type Trie interface {
 	GetOldRoot() []byte
 	GetSerializedNodes([]byte, uint64) ([][]byte, uint64, error)
 	GetSerializedNode([]byte) ([]byte, error)
+	GetSerializedLeavesRange(rootHash []byte, startKey []byte, maxBuffToSend uint64) ([]byte, error)
 	GetAllLeavesOnChannel(allLeavesChan *TrieIteratorChannels, ctx context.Context, rootHash []byte, keyBuilder KeyBuilder, trieLeafParser TrieLeafParser) error
+	GetLeavesPage(ctx context.Context, rootHash []byte, startKey []byte, maxLeaves int, keyPrefix []byte, trieLeafParser TrieLeafParser) (*TrieLeavesPage, error)
+	GetLeavesDiff(ctx context.Context, newTrie Trie, trieLeafParser TrieLeafParser, handler func(leafDiff *TrieLeafDiff) error) error
 	GetAllHashes() ([][]byte, error)
 	GetProof(key []byte) ([][]byte, []byte, error)
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error)
+	VerifyMultiProof(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error)
 	GetStorageManager() StorageManager
 	IsMigratedToLatestVersion() (bool, error)
 	Close() error
@@ -89,6 +94,7 @@ type KeyBuilder interface {
 type DataTrieHandler interface {
 	RootHash() ([]byte, error)
 	GetAllLeavesOnChannel(leavesChannels *TrieIteratorChannels, ctx context.Context, rootHash []byte, keyBuilder KeyBuilder, trieLeafParser TrieLeafParser) error
+	GetLeavesPage(ctx context.Context, rootHash []byte, startKey []byte, maxLeaves int, keyPrefix []byte, trieLeafParser TrieLeafParser) (*TrieLeavesPage, error)
 	IsMigratedToLatestVersion() (bool, error)
 	IsInterfaceNil() bool
 }
@@ -163,6 +169,7 @@ type Locker interface {
 // MerkleProofVerifier is used to verify merkle proofs
 type MerkleProofVerifier interface {
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	VerifyMultiProof(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error)
 }
 
 // SizeSyncStatisticsHandler extends the SyncStatisticsHandler interface by allowing setting up the trie node size
@@ -205,6 +212,9 @@ type TrieStatisticsHandler interface {
 	GetLeafNodesSize() uint64
 	GetNumLeafNodes() uint64
 	GetLeavesMigrationStats() map[core.TrieNodeVersion]uint64
+	GetNodesPerDepth() map[uint32]uint64
+	GetAddress() string
+	GetRootHash() []byte
 
 	MergeTriesStatistics(statsToBeMerged TrieStatisticsHandler)
 	ToString() []string
@@ -216,6 +226,8 @@ type TriesStatisticsCollector interface {
 	Add(trieStats TrieStatisticsHandler, trieType TrieType)
 	Print()
 	GetNumNodes() uint64
+	GetNumTries() uint64
+	GetReport() *TriesStatisticsReport
 }
 
 // StateStatisticsHandler defines the behaviour of a storage statistics handler
@@ -307,6 +319,8 @@ type EnableEpochsHandler interface {
 // ManagedPeersHolder defines the operations of an entity that holds managed identities for a node
 type ManagedPeersHolder interface {
 	AddManagedPeer(privateKeyBytes []byte) error
+	AddManagedPublicKey(publicKeyBytes []byte) error
+	RemoveManagedPeer(pkBytes []byte) error
 	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
 	GetP2PIdentity(pkBytes []byte) ([]byte, core.PeerID, error)
 	GetMachineID(pkBytes []byte) (string, error)
@@ -327,6 +341,24 @@ type ManagedPeersHolder interface {
 	IsInterfaceNil() bool
 }
 
+// ManagedKeysUpdater defines the operations of an entity able to add or remove managed keys while the node is running
+type ManagedKeysUpdater interface {
+	AddManagedKey(keyBytes []byte) ([]byte, error)
+	RemoveManagedKey(pkBytes []byte) error
+	IsInterfaceNil() bool
+}
+
+// RedundancyLeaseHandler defines the operations of an entity that coordinates the redundant instances of a node through
+// a lease: only the instance holding the lease should sign with the validator keys
+type RedundancyLeaseHandler interface {
+	IsEnabled() bool
+	IsLeaseHolder() bool
+	StepDown() error
+	State() RedundancyLeaseState
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // MissingTrieNodesNotifier defines the operations of an entity that notifies about missing trie nodes
 type MissingTrieNodesNotifier interface {
 	RegisterHandler(handler StateSyncNotifierSubscriber) error
@@ -33,3 +33,18 @@ func TrimSuffixFromValue(value []byte, suffixLength int) ([]byte, error) {
 
 	return value[:dataLength], nil
 }
+
+// TrieLeavesPage holds a page of trie leaves together with the trie key of the first leaf of the next page. The next
+// key is nil if there are no more leaves
+type TrieLeavesPage struct {
+	Leaves  []core.KeyValueHolder
+	NextKey []byte
+}
+
+// TrieLeafDiff holds the values of a leaf which differs between two tries. The old value is nil for an added leaf,
+// while the new value is nil for a removed one
+type TrieLeafDiff struct {
+	Key      []byte
+	OldValue []byte
+	NewValue []byte
+}
@@ -165,6 +165,7 @@ type Config struct {
 	PeerAccountsTrieStorage  StorageConfig
 	EvictionWaitingList      EvictionWaitingListConfig
 	StateTriesConfig         StateTriesConfig
+	StateSnapshotFiles       StateSnapshotFilesConfig
 	TrieStorageManagerConfig TrieStorageManagerConfig
 	BadBlocksCache           CacheConfig
 
@@ -174,6 +175,7 @@ type Config struct {
 	UnsignedTransactionDataPool CacheConfig
 	RewardTransactionDataPool   CacheConfig
 	TrieNodesChunksDataPool     CacheConfig
+	TrieLeavesRangesDataPool    CacheConfig
 	WhiteListPool               CacheConfig
 	WhiteListerVerifiedTxs      CacheConfig
 	SmartContractDataPool       CacheConfig
@@ -228,6 +230,9 @@ type Config struct {
 	PeersRatingConfig   PeersRatingConfig
 	PoolsCleanersConfig PoolsCleanersConfig
 	Redundancy          RedundancyConfig
+	RemoteSigner        RemoteSignerConfig
+	SlashingProtection  SlashingProtectionConfig
+	ManagedKeysWatcher  ManagedKeysWatcherConfig
 }
 
 // PeersRatingConfig will hold settings related to peers rating
@@ -312,6 +317,18 @@ type StateTriesConfig struct {
 	MaxStateTrieLevelInMemory   uint
 	MaxPeerTrieLevelInMemory    uint
 	StateStatisticsEnabled      bool
+	NumDataTriesCommitWorkers   uint32
+}
+
+// StateSnapshotFilesConfig will hold the configuration for the portable state snapshot files
+type StateSnapshotFilesConfig struct {
+	WriteEnabled        bool
+	ImportEnabled       bool
+	Directory           string
+	MaxChunkSizeInBytes uint64
+	NumSnapshotsToKeep  uint32
+	SigningKeyPemFile   string
+	TrustedPublicKeys   []string
 }
 
 // TrieStorageManagerConfig will hold config information about trie storage manager
@@ -477,6 +494,8 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	AccountsHistoryEnabled             bool
+	AccountsHistoryStorageConfig       StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -540,8 +559,15 @@ type ProcessDebugConfig struct {
 
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
-	Logging     ApiLoggingConfig
-	APIPackages map[string]APIPackageConfig
+	Logging            ApiLoggingConfig
+	AdminAuthorization ApiAdminAuthorizationConfig
+	APIPackages        map[string]APIPackageConfig
+}
+
+// ApiAdminAuthorizationConfig holds the basic authorization credentials required by the admin API routes
+type ApiAdminAuthorizationConfig struct {
+	Username string
+	Password string
 }
 
 // ApiLoggingConfig holds the configuration related to API requests logging
@@ -638,4 +664,44 @@ type PoolsCleanersConfig struct {
 // RedundancyConfig represents the config options to be used when setting the redundancy configuration
 type RedundancyConfig struct {
 	MaxRoundsOfInactivityAccepted int
+	Lease                         RedundancyLeaseConfig
+}
+
+// RedundancyLeaseConfig represents the config options for the lease based coordination between the redundant instances
+// of a node
+type RedundancyLeaseConfig struct {
+	Enabled                bool
+	FilePath               string
+	InstanceID             string
+	LeaseDurationInSeconds int
+	RenewIntervalInSeconds int
+}
+
+// RemoteSignerConfig represents the config options to be used when the validator BLS keys are held by a remote signer
+type RemoteSignerConfig struct {
+	Enabled           bool
+	Url               string
+	RequestTimeoutSec int
+	UseAuthorization  bool
+	Username          string
+	Password          string
+	CACertFile        string
+	ClientCertFile    string
+	ClientKeyFile     string
+}
+
+// SlashingProtectionConfig represents the config options of the local database recording the headers signed by the
+// validator BLS keys handled by the node
+type SlashingProtectionConfig struct {
+	Enabled         bool
+	NumRoundsToKeep uint64
+	Cache           CacheConfig
+	DB              DBConfig
+}
+
+// ManagedKeysWatcherConfig represents the config options for reloading the managed keys when the allValidatorsKeys
+// file changes
+type ManagedKeysWatcherConfig struct {
+	Enabled                  bool
+	PollingIntervalInSeconds int
 }
@@ -140,9 +140,17 @@ func TestTomlParser(t *testing.T) {
 			PeerStatePruningEnabled:     true,
 			MaxStateTrieLevelInMemory:   38,
 			MaxPeerTrieLevelInMemory:    39,
+			NumDataTriesCommitWorkers:   8,
 		},
 		Redundancy: RedundancyConfig{
 			MaxRoundsOfInactivityAccepted: 3,
+			Lease: RedundancyLeaseConfig{
+				Enabled:                true,
+				FilePath:               "/shared/redundancy.lease",
+				InstanceID:             "instance-a",
+				LeaseDurationInSeconds: 12,
+				RenewIntervalInSeconds: 2,
+			},
 		},
 	}
 	testString := `
@@ -236,11 +244,19 @@ func TestTomlParser(t *testing.T) {
     PeerStatePruningEnabled = true
     MaxStateTrieLevelInMemory = 38
     MaxPeerTrieLevelInMemory = 39
+    NumDataTriesCommitWorkers = 8
 
 [Redundancy]
     # MaxRoundsOfInactivityAccepted defines the number of rounds missed by a main or higher level backup machine before
     # the current machine will take over and propose/sign blocks. Used in both single-key and multi-key modes.
     MaxRoundsOfInactivityAccepted = 3
+
+    [Redundancy.Lease]
+        Enabled = true
+        FilePath = "/shared/redundancy.lease"
+        InstanceID = "instance-a"
+        LeaseDurationInSeconds = 12
+        RenewIntervalInSeconds = 2
 `
 	cfg := Config{}
 
@@ -10,8 +10,8 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
-	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/common"
+	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/process"
@@ -40,7 +40,7 @@ type commonMessenger struct {
 	hasher                  hashing.Hasher
 	messenger               consensus.P2PMessenger
 	shardCoordinator        sharding.Coordinator
-	peerSignatureHandler    crypto.PeerSignatureHandler
+	keysSigner              cryptoCommon.KeysSigner
 	delayedBlockBroadcaster delayedBroadcaster
 	keysHandler             consensus.KeysHandler
 }
@@ -51,7 +51,7 @@ type CommonMessengerArgs struct {
 	Hasher                     hashing.Hasher
 	Messenger                  consensus.P2PMessenger
 	ShardCoordinator           sharding.Coordinator
-	PeerSignatureHandler       crypto.PeerSignatureHandler
+	KeysSigner                 cryptoCommon.KeysSigner
 	HeadersSubscriber          consensus.HeadersPoolSubscriber
 	InterceptorsContainer      process.InterceptorsContainer
 	MaxDelayCacheSize          uint32
@@ -75,8 +75,8 @@ func checkCommonMessengerNilParameters(
 	if check.IfNil(args.ShardCoordinator) {
 		return spos.ErrNilShardCoordinator
 	}
-	if check.IfNil(args.PeerSignatureHandler) {
-		return spos.ErrNilPeerSignatureHandler
+	if check.IfNil(args.KeysSigner) {
+		return spos.ErrNilKeysSigner
 	}
 	if check.IfNil(args.InterceptorsContainer) {
 		return spos.ErrNilInterceptorsContainer
@@ -99,8 +99,7 @@ func checkCommonMessengerNilParameters(
 
 // BroadcastConsensusMessage will send on consensus topic the consensus message
 func (cm *commonMessenger) BroadcastConsensusMessage(message *consensus.Message) error {
-	privateKey := cm.keysHandler.GetHandledPrivateKey(message.PubKey)
-	signature, err := cm.peerSignatureHandler.GetPeerSignature(privateKey, message.OriginatorPid)
+	signature, err := cm.keysSigner.Sign(message.PubKey, message.OriginatorPid)
 	if err != nil {
 		return err
 	}
@@ -8,11 +8,11 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/block"
-	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/broadcast"
 	"github.com/multiversx/mx-chain-go/consensus/mock"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -42,18 +42,17 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldErrWhenSignMessageFail(t
 	marshalizerMock := &mock.MarshalizerMock{}
 	messengerMock := &p2pmocks.MessengerStub{}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{
-		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	keysSigner := &cryptoMocks.KeysSignerStub{
+		SignCalled: func(publicKey []byte, message []byte) ([]byte, error) {
 			return nil, err
 		},
 	}
-	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
 
 	cm, _ := broadcast.NewCommonMessenger(
 		marshalizerMock,
 		messengerMock,
 		shardCoordinatorMock,
-		peerSigHandler,
+		keysSigner,
 		&testscommon.KeysHandlerStub{},
 	)
 
@@ -69,18 +68,17 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 		},
 	}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{
-		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	keysSigner := &cryptoMocks.KeysSignerStub{
+		SignCalled: func(publicKey []byte, message []byte) ([]byte, error) {
 			return []byte(""), nil
 		},
 	}
-	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
 
 	cm, _ := broadcast.NewCommonMessenger(
 		marshalizerMock,
 		messengerMock,
 		shardCoordinatorMock,
-		peerSigHandler,
+		keysSigner,
 		&testscommon.KeysHandlerStub{},
 	)
 
@@ -123,18 +121,17 @@ func TestSubroundEndRound_ExtractMiniBlocksAndTransactionsShouldWork(t *testing.
 		},
 	}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{
-		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	keysSigner := &cryptoMocks.KeysSignerStub{
+		SignCalled: func(publicKey []byte, message []byte) ([]byte, error) {
 			return []byte(""), nil
 		},
 	}
-	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
 
 	cm, _ := broadcast.NewCommonMessenger(
 		marshalizerMock,
 		messengerMock,
 		shardCoordinatorMock,
-		peerSigHandler,
+		keysSigner,
 		&testscommon.KeysHandlerStub{},
 	)
 
@@ -169,18 +166,17 @@ func TestCommonMessenger_BroadcastBlockData(t *testing.T) {
 		},
 	}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{
-		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	keysSigner := &cryptoMocks.KeysSignerStub{
+		SignCalled: func(publicKey []byte, message []byte) ([]byte, error) {
 			return []byte(""), nil
 		},
 	}
-	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
 
 	cm, _ := broadcast.NewCommonMessenger(
 		marshalizerMock,
 		messengerMock,
 		shardCoordinatorMock,
-		peerSigHandler,
+		keysSigner,
 		&testscommon.KeysHandlerStub{
 			IsOriginalPublicKeyOfTheNodeCalled: func(pkBytes []byte) bool {
 				return bytes.Equal(pkBytes, nodePkBytes)
@@ -254,12 +250,11 @@ func TestCommonMessenger_broadcast(t *testing.T) {
 		},
 	}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{
-		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+	keysSigner := &cryptoMocks.KeysSignerStub{
+		SignCalled: func(publicKey []byte, message []byte) ([]byte, error) {
 			return []byte(""), nil
 		},
 	}
-	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
 
 	t.Run("using the original public key bytes of the node", func(t *testing.T) {
 		mutCounters.Lock()
@@ -270,7 +265,7 @@ func TestCommonMessenger_broadcast(t *testing.T) {
 			marshallerMock,
 			messengerMock,
 			shardCoordinatorMock,
-			peerSigHandler,
+			keysSigner,
 			&testscommon.KeysHandlerStub{
 				IsOriginalPublicKeyOfTheNodeCalled: func(pkBytes []byte) bool {
 					return bytes.Equal(nodePkBytes, pkBytes)
@@ -294,7 +289,7 @@ func TestCommonMessenger_broadcast(t *testing.T) {
 			marshallerMock,
 			messengerMock,
 			shardCoordinatorMock,
-			peerSigHandler,
+			keysSigner,
 			&testscommon.KeysHandlerStub{
 				IsOriginalPublicKeyOfTheNodeCalled: func(pkBytes []byte) bool {
 					return false
@@ -318,7 +313,7 @@ func TestCommonMessenger_broadcast(t *testing.T) {
 			marshallerMock,
 			messengerMock,
 			shardCoordinatorMock,
-			peerSigHandler,
+			keysSigner,
 			&testscommon.KeysHandlerStub{
 				GetP2PIdentityCalled: func(pkBytes []byte) ([]byte, core.PeerID, error) {
 					return nil, "", expectedErr
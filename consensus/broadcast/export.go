@@ -5,7 +5,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/marshal"
-	crypto "github.com/multiversx/mx-chain-crypto-go"
+	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/sharding"
 )
@@ -171,16 +171,16 @@ func NewCommonMessenger(
 	marshalizer marshal.Marshalizer,
 	messenger consensus.P2PMessenger,
 	shardCoordinator sharding.Coordinator,
-	peerSigHandler crypto.PeerSignatureHandler,
+	keysSigner cryptoCommon.KeysSigner,
 	keysHandler consensus.KeysHandler,
 ) (*commonMessenger, error) {
 
 	return &commonMessenger{
-		marshalizer:          marshalizer,
-		messenger:            messenger,
-		shardCoordinator:     shardCoordinator,
-		peerSignatureHandler: peerSigHandler,
-		keysHandler:          keysHandler,
+		marshalizer:      marshalizer,
+		messenger:        messenger,
+		shardCoordinator: shardCoordinator,
+		keysSigner:       keysSigner,
+		keysHandler:      keysHandler,
 	}, nil
 }
 
@@ -51,7 +51,7 @@ func NewMetaChainMessenger(
 		hasher:                  args.Hasher,
 		messenger:               args.Messenger,
 		shardCoordinator:        args.ShardCoordinator,
-		peerSignatureHandler:    args.PeerSignatureHandler,
+		keysSigner:              args.KeysSigner,
 		delayedBlockBroadcaster: dbb,
 		keysHandler:             args.KeysHandler,
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus/mock"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
 	"github.com/stretchr/testify/assert"
@@ -25,11 +26,9 @@ func createDefaultMetaChainArgs() broadcast.MetaChainMessengerArgs {
 	marshalizerMock := &mock.MarshalizerMock{}
 	messengerMock := &p2pmocks.MessengerStub{}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
 	hasher := &hashingMocks.HasherMock{}
 	headersSubscriber := &mock.HeadersCacherStub{}
 	interceptorsContainer := createInterceptorContainer()
-	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
 	alarmScheduler := &mock.AlarmSchedulerStub{}
 
 	return broadcast.MetaChainMessengerArgs{
@@ -38,7 +37,7 @@ func createDefaultMetaChainArgs() broadcast.MetaChainMessengerArgs {
 			Hasher:                     hasher,
 			Messenger:                  messengerMock,
 			ShardCoordinator:           shardCoordinatorMock,
-			PeerSignatureHandler:       peerSigHandler,
+			KeysSigner:                 &cryptoMocks.KeysSignerStub{},
 			HeadersSubscriber:          headersSubscriber,
 			InterceptorsContainer:      interceptorsContainer,
 			MaxValidatorDelayCacheSize: 2,
@@ -76,13 +75,13 @@ func TestMetaChainMessenger_NewMetaChainMessengerNilShardCoordinatorShouldFail(t
 	assert.Equal(t, spos.ErrNilShardCoordinator, err)
 }
 
-func TestMetaChainMessenger_NewMetaChainMessengerNilPeerSignatureHandlerShouldFail(t *testing.T) {
+func TestMetaChainMessenger_NewMetaChainMessengerNilKeysSignerShouldFail(t *testing.T) {
 	args := createDefaultMetaChainArgs()
-	args.PeerSignatureHandler = nil
+	args.KeysSigner = nil
 	mcm, err := broadcast.NewMetaChainMessenger(args)
 
 	assert.Nil(t, mcm)
-	assert.Equal(t, spos.ErrNilPeerSignatureHandler, err)
+	assert.Equal(t, spos.ErrNilKeysSigner, err)
 }
 
 func TestMetaChainMessenger_NilKeysHandlerShouldError(t *testing.T) {
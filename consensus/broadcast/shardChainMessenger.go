@@ -37,12 +37,12 @@ func NewShardChainMessenger(
 	}
 
 	cm := &commonMessenger{
-		marshalizer:          args.Marshalizer,
-		hasher:               args.Hasher,
-		messenger:            args.Messenger,
-		shardCoordinator:     args.ShardCoordinator,
-		peerSignatureHandler: args.PeerSignatureHandler,
-		keysHandler:          args.KeysHandler,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		messenger:        args.Messenger,
+		shardCoordinator: args.ShardCoordinator,
+		keysSigner:       args.KeysSigner,
+		keysHandler:      args.KeysHandler,
 	}
 
 	dbbArgs := &ArgsDelayedBlockBroadcaster{
@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/factory"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
 	"github.com/stretchr/testify/assert"
@@ -57,12 +58,8 @@ func createDefaultShardChainArgs() broadcast.ShardChainMessengerArgs {
 	hasher := &hashingMocks.HasherMock{}
 	messengerMock := &p2pmocks.MessengerStub{}
 	shardCoordinatorMock := &mock.ShardCoordinatorMock{}
-	singleSignerMock := &mock.SingleSignerMock{}
 	headersSubscriber := &mock.HeadersCacherStub{}
 	interceptorsContainer := createInterceptorContainer()
-	peerSigHandler := &mock.PeerSignatureHandler{
-		Signer: singleSignerMock,
-	}
 	alarmScheduler := &mock.AlarmSchedulerStub{}
 
 	return broadcast.ShardChainMessengerArgs{
@@ -71,7 +68,7 @@ func createDefaultShardChainArgs() broadcast.ShardChainMessengerArgs {
 			Hasher:                     hasher,
 			Messenger:                  messengerMock,
 			ShardCoordinator:           shardCoordinatorMock,
-			PeerSignatureHandler:       peerSigHandler,
+			KeysSigner:                 &cryptoMocks.KeysSignerStub{},
 			HeadersSubscriber:          headersSubscriber,
 			InterceptorsContainer:      interceptorsContainer,
 			MaxDelayCacheSize:          1,
@@ -109,13 +106,13 @@ func TestShardChainMessenger_NewShardChainMessengerNilShardCoordinatorShouldFail
 	assert.Equal(t, spos.ErrNilShardCoordinator, err)
 }
 
-func TestShardChainMessenger_NewShardChainMessengerNilPeerSignatureHandlerShouldFail(t *testing.T) {
+func TestShardChainMessenger_NewShardChainMessengerNilKeysSignerShouldFail(t *testing.T) {
 	args := createDefaultShardChainArgs()
-	args.PeerSignatureHandler = nil
+	args.KeysSigner = nil
 	scm, err := broadcast.NewShardChainMessenger(args)
 
 	assert.Nil(t, scm)
-	assert.Equal(t, spos.ErrNilPeerSignatureHandler, err)
+	assert.Equal(t, spos.ErrNilKeysSigner, err)
 }
 
 func TestShardChainMessenger_NewShardChainMessengerNilInterceptorsContainerShouldFail(t *testing.T) {
@@ -0,0 +1,33 @@
+package slashingProtection
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrEmptyPublicKey signals that an empty public key has been provided
+var ErrEmptyPublicKey = errors.New("empty public key")
+
+// ErrEmptyHeaderHash signals that an empty header hash has been provided
+var ErrEmptyHeaderHash = errors.New("empty header hash")
+
+// ErrDoubleSigning signals that a different header hash was already signed for the same key, round and shard
+var ErrDoubleSigning = errors.New("double signing attempt")
+
+// ErrInvalidFormatVersion signals that the interchange data has an unsupported format version
+var ErrInvalidFormatVersion = errors.New("invalid interchange format version")
+
+// ErrChainIDMismatch signals that the interchange data was exported on a different chain
+var ErrChainIDMismatch = errors.New("chain ID mismatch")
+
+// ErrInvalidRecord signals that an invalid record was found in the interchange data
+var ErrInvalidRecord = errors.New("invalid slashing protection record")
+
+// ErrInvalidNumRoundsToKeep signals that an invalid number of rounds to keep has been provided
+var ErrInvalidNumRoundsToKeep = errors.New("invalid number of rounds to keep")
+
+// ErrRoundAlreadyPruned signals that the records of the requested round were already pruned
+var ErrRoundAlreadyPruned = errors.New("round already pruned")
+
+// ErrInvalidMaxBatchSize signals that the slashing protection database was configured to batch its writes
+var ErrInvalidMaxBatchSize = errors.New("invalid max batch size for the slashing protection database")
@@ -0,0 +1,25 @@
+package slashingProtection
+
+// InterchangeFormatVersion is the version of the portable format used to export and import the slashing protection records
+const InterchangeFormatVersion = 1
+
+// InterchangeData is the portable representation of the slashing protection records, used when the validator keys are
+// moved between machines
+type InterchangeData struct {
+	Metadata      InterchangeMetadata `json:"metadata"`
+	SignedHeaders []SignedHeader      `json:"signedHeaders"`
+}
+
+// InterchangeMetadata holds the data needed to validate the interchange data before importing it
+type InterchangeMetadata struct {
+	FormatVersion int    `json:"formatVersion"`
+	ChainID       string `json:"chainID"`
+}
+
+// SignedHeader is the record of a header hash signed by a validator key in a given round and shard
+type SignedHeader struct {
+	PublicKey  string `json:"publicKey"`
+	ShardID    uint32 `json:"shardID"`
+	Round      uint64 `json:"round"`
+	HeaderHash string `json:"headerHash"`
+}
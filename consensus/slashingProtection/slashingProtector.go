@@ -0,0 +1,301 @@
+package slashingProtection
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("consensus/slashingProtection")
+
+const (
+	shardIDLength   = 4
+	roundLength     = 8
+	keySuffixLength = shardIDLength + roundLength
+)
+
+type slashingProtector struct {
+	mut             sync.Mutex
+	storer          storage.Storer
+	numRoundsToKeep uint64
+	minRound        uint64
+}
+
+// NewSlashingProtector creates a new slashing protector that persists the signed headers records in the provided storer.
+// On each epoch change, the records older than numRoundsToKeep rounds are removed
+func NewSlashingProtector(storer storage.Storer, numRoundsToKeep uint64) (*slashingProtector, error) {
+	if check.IfNil(storer) {
+		return nil, ErrNilStorer
+	}
+	if numRoundsToKeep == 0 {
+		return nil, ErrInvalidNumRoundsToKeep
+	}
+
+	return &slashingProtector{
+		storer:          storer,
+		numRoundsToKeep: numRoundsToKeep,
+	}, nil
+}
+
+// CheckAndRecordSignature records that the provided key signs the provided header hash in the given round and shard.
+// It returns ErrDoubleSigning, without recording anything, if a different header hash was already signed by the
+// same key for the same round and shard. Signing again the same header hash is allowed. Signing for a round whose
+// records were already pruned is refused with ErrRoundAlreadyPruned, as a possible conflict could not be detected.
+func (sp *slashingProtector) CheckAndRecordSignature(publicKey []byte, shardID uint32, round uint64, headerHash []byte) error {
+	if len(publicKey) == 0 {
+		return ErrEmptyPublicKey
+	}
+	if len(headerHash) == 0 {
+		return ErrEmptyHeaderHash
+	}
+
+	sp.mut.Lock()
+	defer sp.mut.Unlock()
+
+	if round < sp.minRound {
+		return fmt.Errorf("%w: round %d, oldest kept round %d", ErrRoundAlreadyPruned, round, sp.minRound)
+	}
+
+	key := createKey(publicKey, shardID, round)
+	signedHeaderHash, found, err := sp.getSignedHeaderHash(key)
+	if err != nil {
+		return err
+	}
+	if found {
+		if bytes.Equal(signedHeaderHash, headerHash) {
+			return nil
+		}
+
+		log.Error("refusing to sign a different header for the same round and shard",
+			"public key", publicKey,
+			"shard", shardID,
+			"round", round,
+			"signed header hash", signedHeaderHash,
+			"requested header hash", headerHash)
+
+		return fmt.Errorf("%w for public key %s, shard %d, round %d",
+			ErrDoubleSigning, hex.EncodeToString(publicKey), shardID, round)
+	}
+
+	return sp.storer.Put(key, headerHash)
+}
+
+func (sp *slashingProtector) getSignedHeaderHash(key []byte) ([]byte, bool, error) {
+	signedHeaderHash, err := sp.storer.Get(key)
+	if err == nil {
+		return signedHeaderHash, true, nil
+	}
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		return nil, false, nil
+	}
+
+	return nil, false, fmt.Errorf("%w while reading the slashing protection record", err)
+}
+
+// Export returns all the records in the portable interchange format. If the provided public keys list is not empty,
+// only the records of those keys are exported
+func (sp *slashingProtector) Export(chainID string, publicKeys [][]byte) (*InterchangeData, error) {
+	filter := make(map[string]struct{}, len(publicKeys))
+	for _, publicKey := range publicKeys {
+		filter[string(publicKey)] = struct{}{}
+	}
+
+	sp.mut.Lock()
+	defer sp.mut.Unlock()
+
+	signedHeaders := make([]SignedHeader, 0)
+	sp.storer.RangeKeys(func(key []byte, value []byte) bool {
+		publicKey, shardID, round, ok := parseKey(key)
+		if !ok {
+			log.Warn("invalid slashing protection record key", "key", key)
+			return true
+		}
+
+		_, isFiltered := filter[string(publicKey)]
+		if len(filter) > 0 && !isFiltered {
+			return true
+		}
+
+		signedHeaders = append(signedHeaders, SignedHeader{
+			PublicKey:  hex.EncodeToString(publicKey),
+			ShardID:    shardID,
+			Round:      round,
+			HeaderHash: hex.EncodeToString(value),
+		})
+
+		return true
+	})
+
+	sort.Slice(signedHeaders, func(i, j int) bool {
+		if signedHeaders[i].PublicKey != signedHeaders[j].PublicKey {
+			return signedHeaders[i].PublicKey < signedHeaders[j].PublicKey
+		}
+		if signedHeaders[i].ShardID != signedHeaders[j].ShardID {
+			return signedHeaders[i].ShardID < signedHeaders[j].ShardID
+		}
+
+		return signedHeaders[i].Round < signedHeaders[j].Round
+	})
+
+	return &InterchangeData{
+		Metadata: InterchangeMetadata{
+			FormatVersion: InterchangeFormatVersion,
+			ChainID:       chainID,
+		},
+		SignedHeaders: signedHeaders,
+	}, nil
+}
+
+// Import adds the records from the provided interchange data. Nothing is imported if a record conflicts with an
+// existing one or with another record from the same data. It returns the number of newly added records
+func (sp *slashingProtector) Import(data *InterchangeData, chainID string) (int, error) {
+	if data == nil {
+		return 0, fmt.Errorf("%w: nil interchange data", ErrInvalidRecord)
+	}
+	if data.Metadata.FormatVersion != InterchangeFormatVersion {
+		return 0, fmt.Errorf("%w: got %d, supported %d",
+			ErrInvalidFormatVersion, data.Metadata.FormatVersion, InterchangeFormatVersion)
+	}
+	if data.Metadata.ChainID != chainID {
+		return 0, fmt.Errorf("%w: data exported on chain %s, current chain %s",
+			ErrChainIDMismatch, data.Metadata.ChainID, chainID)
+	}
+
+	sp.mut.Lock()
+	defer sp.mut.Unlock()
+
+	newRecords := make(map[string][]byte)
+	for i, signedHeader := range data.SignedHeaders {
+		key, headerHash, err := decodeSignedHeader(signedHeader)
+		if err != nil {
+			return 0, fmt.Errorf("%w, record index %d", err, i)
+		}
+
+		existingHeaderHash, found, err := sp.getSignedHeaderHash(key)
+		if err != nil {
+			return 0, err
+		}
+		if !found {
+			existingHeaderHash, found = newRecords[string(key)]
+		}
+		if !found {
+			newRecords[string(key)] = headerHash
+			continue
+		}
+		if !bytes.Equal(existingHeaderHash, headerHash) {
+			return 0, fmt.Errorf("%w: conflicting header hashes for public key %s, shard %d, round %d",
+				ErrDoubleSigning, signedHeader.PublicKey, signedHeader.ShardID, signedHeader.Round)
+		}
+	}
+
+	for key, headerHash := range newRecords {
+		err := sp.storer.Put([]byte(key), headerHash)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(newRecords), nil
+}
+
+func decodeSignedHeader(signedHeader SignedHeader) ([]byte, []byte, error) {
+	publicKey, err := hex.DecodeString(signedHeader.PublicKey)
+	if err != nil || len(publicKey) == 0 {
+		return nil, nil, fmt.Errorf("%w: invalid public key %s", ErrInvalidRecord, signedHeader.PublicKey)
+	}
+
+	headerHash, err := hex.DecodeString(signedHeader.HeaderHash)
+	if err != nil || len(headerHash) == 0 {
+		return nil, nil, fmt.Errorf("%w: invalid header hash %s", ErrInvalidRecord, signedHeader.HeaderHash)
+	}
+
+	return createKey(publicKey, signedHeader.ShardID, signedHeader.Round), headerHash, nil
+}
+
+func createKey(publicKey []byte, shardID uint32, round uint64) []byte {
+	key := make([]byte, len(publicKey)+keySuffixLength)
+	copy(key, publicKey)
+	binary.BigEndian.PutUint32(key[len(publicKey):], shardID)
+	binary.BigEndian.PutUint64(key[len(publicKey)+shardIDLength:], round)
+
+	return key
+}
+
+func parseKey(key []byte) ([]byte, uint32, uint64, bool) {
+	if len(key) <= keySuffixLength {
+		return nil, 0, 0, false
+	}
+
+	publicKeyLength := len(key) - keySuffixLength
+	shardID := binary.BigEndian.Uint32(key[publicKeyLength:])
+	round := binary.BigEndian.Uint64(key[publicKeyLength+shardIDLength:])
+
+	return key[:publicKeyLength], shardID, round, true
+}
+
+// EpochStartAction removes the records older than the retention window, relative to the round of the epoch start header
+func (sp *slashingProtector) EpochStartAction(hdr data.HeaderHandler) {
+	if check.IfNil(hdr) || hdr.GetRound() <= sp.numRoundsToKeep {
+		return
+	}
+
+	sp.prune(hdr.GetRound() - sp.numRoundsToKeep)
+}
+
+func (sp *slashingProtector) prune(minRound uint64) {
+	sp.mut.Lock()
+	defer sp.mut.Unlock()
+
+	if minRound <= sp.minRound {
+		return
+	}
+	sp.minRound = minRound
+
+	keysToRemove := make([][]byte, 0)
+	sp.storer.RangeKeys(func(key []byte, _ []byte) bool {
+		_, _, round, ok := parseKey(key)
+		if ok && round < minRound {
+			keysToRemove = append(keysToRemove, append([]byte{}, key...))
+		}
+
+		return true
+	})
+
+	for _, key := range keysToRemove {
+		err := sp.storer.Remove(key)
+		if err != nil {
+			log.Warn("could not remove slashing protection record", "key", key, "error", err)
+		}
+	}
+
+	log.Debug("pruned the slashing protection records", "min round", minRound, "num removed", len(keysToRemove))
+}
+
+// EpochStartPrepare does nothing
+func (sp *slashingProtector) EpochStartPrepare(_ data.HeaderHandler, _ data.BodyHandler) {
+}
+
+// NotifyOrder returns the notification order for a start of epoch event
+func (sp *slashingProtector) NotifyOrder() uint32 {
+	return common.ConsensusOrder
+}
+
+// Close closes the underlying storer
+func (sp *slashingProtector) Close() error {
+	return sp.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *slashingProtector) IsInterfaceNil() bool {
+	return sp == nil
+}
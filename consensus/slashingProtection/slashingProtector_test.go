@@ -0,0 +1,322 @@
+package slashingProtection
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = "T"
+const testNumRoundsToKeep = 100
+
+var (
+	pk1   = []byte("public key 1")
+	pk2   = []byte("public key 2")
+	hashA = []byte("header hash A")
+	hashB = []byte("header hash B")
+)
+
+func createProtectorWithRecords(t *testing.T) *slashingProtector {
+	sp, err := NewSlashingProtector(testscommon.CreateMemUnit(), testNumRoundsToKeep)
+	require.Nil(t, err)
+
+	require.Nil(t, sp.CheckAndRecordSignature(pk2, 0, 10, hashA))
+	require.Nil(t, sp.CheckAndRecordSignature(pk1, 1, 11, hashB))
+	require.Nil(t, sp.CheckAndRecordSignature(pk1, 0, 12, hashA))
+	require.Nil(t, sp.CheckAndRecordSignature(pk1, 0, 11, hashB))
+
+	return sp
+}
+
+func TestNewSlashingProtector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := NewSlashingProtector(nil, testNumRoundsToKeep)
+		assert.Equal(t, ErrNilStorer, err)
+		assert.True(t, check.IfNil(sp))
+	})
+	t.Run("invalid number of rounds to keep should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := NewSlashingProtector(testscommon.CreateMemUnit(), 0)
+		assert.Equal(t, ErrInvalidNumRoundsToKeep, err)
+		assert.True(t, check.IfNil(sp))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := NewSlashingProtector(testscommon.CreateMemUnit(), testNumRoundsToKeep)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(sp))
+	})
+}
+
+func TestSlashingProtector_CheckAndRecordSignature(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := NewSlashingProtector(testscommon.CreateMemUnit(), testNumRoundsToKeep)
+		err := sp.CheckAndRecordSignature(nil, 0, 1, hashA)
+		assert.Equal(t, ErrEmptyPublicKey, err)
+	})
+	t.Run("empty header hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := NewSlashingProtector(testscommon.CreateMemUnit(), testNumRoundsToKeep)
+		err := sp.CheckAndRecordSignature(pk1, 0, 1, nil)
+		assert.Equal(t, ErrEmptyHeaderHash, err)
+	})
+	t.Run("storer read error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		putCalled := false
+		sp, _ := NewSlashingProtector(&storageStubs.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return nil, expectedErr
+			},
+			PutCalled: func(key, data []byte) error {
+				putCalled = true
+				return nil
+			},
+		}, testNumRoundsToKeep)
+		err := sp.CheckAndRecordSignature(pk1, 0, 1, hashA)
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.False(t, putCalled)
+	})
+	t.Run("signing the same header hash again should work", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := NewSlashingProtector(testscommon.CreateMemUnit(), testNumRoundsToKeep)
+		assert.Nil(t, sp.CheckAndRecordSignature(pk1, 0, 1, hashA))
+		assert.Nil(t, sp.CheckAndRecordSignature(pk1, 0, 1, hashA))
+	})
+	t.Run("signing a different header hash for the same round and shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := NewSlashingProtector(testscommon.CreateMemUnit(), testNumRoundsToKeep)
+		assert.Nil(t, sp.CheckAndRecordSignature(pk1, 0, 1, hashA))
+
+		err := sp.CheckAndRecordSignature(pk1, 0, 1, hashB)
+		assert.True(t, errors.Is(err, ErrDoubleSigning))
+
+		// the first record should be kept
+		err = sp.CheckAndRecordSignature(pk1, 0, 1, hashA)
+		assert.Nil(t, err)
+	})
+	t.Run("different round, shard or key should work", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := NewSlashingProtector(testscommon.CreateMemUnit(), testNumRoundsToKeep)
+		assert.Nil(t, sp.CheckAndRecordSignature(pk1, 0, 1, hashA))
+		assert.Nil(t, sp.CheckAndRecordSignature(pk1, 0, 2, hashB))
+		assert.Nil(t, sp.CheckAndRecordSignature(pk1, 1, 1, hashB))
+		assert.Nil(t, sp.CheckAndRecordSignature(pk2, 0, 1, hashB))
+	})
+}
+
+func TestSlashingProtector_EpochStartAction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil header or round inside the retention window should not prune", func(t *testing.T) {
+		t.Parallel()
+
+		sp := createProtectorWithRecords(t)
+		sp.EpochStartAction(nil)
+		sp.EpochStartAction(&block.MetaBlock{Round: testNumRoundsToKeep})
+
+		exported, err := sp.Export(testChainID, nil)
+		require.Nil(t, err)
+		assert.Len(t, exported.SignedHeaders, 4)
+		assert.Nil(t, sp.CheckAndRecordSignature(pk1, 0, 1, hashA))
+	})
+	t.Run("should prune the records older than the retention window", func(t *testing.T) {
+		t.Parallel()
+
+		sp := createProtectorWithRecords(t)
+		sp.EpochStartAction(&block.MetaBlock{Round: testNumRoundsToKeep + 12})
+
+		exported, err := sp.Export(testChainID, nil)
+		require.Nil(t, err)
+		require.Len(t, exported.SignedHeaders, 1)
+		assert.Equal(t, uint64(12), exported.SignedHeaders[0].Round)
+
+		err = sp.CheckAndRecordSignature(pk1, 0, 11, hashA)
+		assert.True(t, errors.Is(err, ErrRoundAlreadyPruned))
+		err = sp.CheckAndRecordSignature(pk1, 0, 12, hashB)
+		assert.True(t, errors.Is(err, ErrDoubleSigning))
+		assert.Nil(t, sp.CheckAndRecordSignature(pk2, 0, 12, hashB))
+
+		// an older epoch start header should not lower the retention window
+		sp.EpochStartAction(&block.MetaBlock{Round: testNumRoundsToKeep + 1})
+		err = sp.CheckAndRecordSignature(pk1, 0, 11, hashA)
+		assert.True(t, errors.Is(err, ErrRoundAlreadyPruned))
+	})
+}
+
+func TestSlashingProtector_Export(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all keys should export sorted records", func(t *testing.T) {
+		t.Parallel()
+
+		sp := createProtectorWithRecords(t)
+		data, err := sp.Export(testChainID, nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, InterchangeFormatVersion, data.Metadata.FormatVersion)
+		assert.Equal(t, testChainID, data.Metadata.ChainID)
+		expectedSignedHeaders := []SignedHeader{
+			{PublicKey: hex.EncodeToString(pk1), ShardID: 0, Round: 11, HeaderHash: hex.EncodeToString(hashB)},
+			{PublicKey: hex.EncodeToString(pk1), ShardID: 0, Round: 12, HeaderHash: hex.EncodeToString(hashA)},
+			{PublicKey: hex.EncodeToString(pk1), ShardID: 1, Round: 11, HeaderHash: hex.EncodeToString(hashB)},
+			{PublicKey: hex.EncodeToString(pk2), ShardID: 0, Round: 10, HeaderHash: hex.EncodeToString(hashA)},
+		}
+		assert.Equal(t, expectedSignedHeaders, data.SignedHeaders)
+	})
+	t.Run("filtered keys should export only their records", func(t *testing.T) {
+		t.Parallel()
+
+		sp := createProtectorWithRecords(t)
+		data, err := sp.Export(testChainID, [][]byte{pk2})
+		require.Nil(t, err)
+
+		expectedSignedHeaders := []SignedHeader{
+			{PublicKey: hex.EncodeToString(pk2), ShardID: 0, Round: 10, HeaderHash: hex.EncodeToString(hashA)},
+		}
+		assert.Equal(t, expectedSignedHeaders, data.SignedHeaders)
+	})
+}
+
+func TestSlashingProtector_Import(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil data should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := NewSlashingProtector(testscommon.CreateMemUnit(), testNumRoundsToKeep)
+		numImported, err := sp.Import(nil, testChainID)
+		assert.True(t, errors.Is(err, ErrInvalidRecord))
+		assert.Zero(t, numImported)
+	})
+	t.Run("invalid format version should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := NewSlashingProtector(testscommon.CreateMemUnit(), testNumRoundsToKeep)
+		data := &InterchangeData{
+			Metadata: InterchangeMetadata{
+				FormatVersion: InterchangeFormatVersion + 1,
+				ChainID:       testChainID,
+			},
+		}
+		numImported, err := sp.Import(data, testChainID)
+		assert.True(t, errors.Is(err, ErrInvalidFormatVersion))
+		assert.Zero(t, numImported)
+	})
+	t.Run("chain ID mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := NewSlashingProtector(testscommon.CreateMemUnit(), testNumRoundsToKeep)
+		data := &InterchangeData{
+			Metadata: InterchangeMetadata{
+				FormatVersion: InterchangeFormatVersion,
+				ChainID:       "other chain",
+			},
+		}
+		numImported, err := sp.Import(data, testChainID)
+		assert.True(t, errors.Is(err, ErrChainIDMismatch))
+		assert.Zero(t, numImported)
+	})
+	t.Run("invalid record should error", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := NewSlashingProtector(testscommon.CreateMemUnit(), testNumRoundsToKeep)
+		data := &InterchangeData{
+			Metadata: InterchangeMetadata{
+				FormatVersion: InterchangeFormatVersion,
+				ChainID:       testChainID,
+			},
+			SignedHeaders: []SignedHeader{
+				{PublicKey: "not hex", ShardID: 0, Round: 1, HeaderHash: hex.EncodeToString(hashA)},
+			},
+		}
+		numImported, err := sp.Import(data, testChainID)
+		assert.True(t, errors.Is(err, ErrInvalidRecord))
+		assert.Zero(t, numImported)
+	})
+	t.Run("conflict with an existing record should not import anything", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := NewSlashingProtector(testscommon.CreateMemUnit(), testNumRoundsToKeep)
+		require.Nil(t, sp.CheckAndRecordSignature(pk1, 0, 1, hashA))
+
+		data := &InterchangeData{
+			Metadata: InterchangeMetadata{
+				FormatVersion: InterchangeFormatVersion,
+				ChainID:       testChainID,
+			},
+			SignedHeaders: []SignedHeader{
+				{PublicKey: hex.EncodeToString(pk1), ShardID: 0, Round: 2, HeaderHash: hex.EncodeToString(hashA)},
+				{PublicKey: hex.EncodeToString(pk1), ShardID: 0, Round: 1, HeaderHash: hex.EncodeToString(hashB)},
+			},
+		}
+		numImported, err := sp.Import(data, testChainID)
+		assert.True(t, errors.Is(err, ErrDoubleSigning))
+		assert.Zero(t, numImported)
+
+		exported, _ := sp.Export(testChainID, nil)
+		assert.Equal(t, 1, len(exported.SignedHeaders))
+	})
+	t.Run("conflicting records in the same data should not import anything", func(t *testing.T) {
+		t.Parallel()
+
+		sp, _ := NewSlashingProtector(testscommon.CreateMemUnit(), testNumRoundsToKeep)
+		data := &InterchangeData{
+			Metadata: InterchangeMetadata{
+				FormatVersion: InterchangeFormatVersion,
+				ChainID:       testChainID,
+			},
+			SignedHeaders: []SignedHeader{
+				{PublicKey: hex.EncodeToString(pk1), ShardID: 0, Round: 1, HeaderHash: hex.EncodeToString(hashA)},
+				{PublicKey: hex.EncodeToString(pk1), ShardID: 0, Round: 1, HeaderHash: hex.EncodeToString(hashB)},
+			},
+		}
+		numImported, err := sp.Import(data, testChainID)
+		assert.True(t, errors.Is(err, ErrDoubleSigning))
+		assert.Zero(t, numImported)
+
+		exported, _ := sp.Export(testChainID, nil)
+		assert.Equal(t, 0, len(exported.SignedHeaders))
+	})
+	t.Run("should import the exported records", func(t *testing.T) {
+		t.Parallel()
+
+		source := createProtectorWithRecords(t)
+		data, _ := source.Export(testChainID, nil)
+
+		destination, _ := NewSlashingProtector(testscommon.CreateMemUnit(), testNumRoundsToKeep)
+		require.Nil(t, destination.CheckAndRecordSignature(pk2, 0, 10, hashA))
+
+		numImported, err := destination.Import(data, testChainID)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, numImported)
+
+		err = destination.CheckAndRecordSignature(pk1, 1, 11, hashA)
+		assert.True(t, errors.Is(err, ErrDoubleSigning))
+
+		imported, _ := destination.Export(testChainID, nil)
+		assert.Equal(t, data, imported)
+	})
+}
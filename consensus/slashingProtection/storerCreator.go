@@ -0,0 +1,37 @@
+package slashingProtection
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+)
+
+// requiredMaxBatchSize makes each record persisted before the corresponding signature is created, so a crash right
+// after signing can not lose the record
+const requiredMaxBatchSize = 1
+
+// CreateStorer creates the storer holding the slashing protection records, inside the provided working directory
+func CreateStorer(cfg config.SlashingProtectionConfig, workingDir string) (storage.Storer, error) {
+	if cfg.DB.MaxBatchSize != requiredMaxBatchSize {
+		return nil, fmt.Errorf("%w, provided: %d, required: %d", ErrInvalidMaxBatchSize, cfg.DB.MaxBatchSize, requiredMaxBatchSize)
+	}
+
+	dbConfig := storageFactory.GetDBFromConfig(cfg.DB)
+	dbConfig.FilePath = filepath.Join(workingDir, cfg.DB.FilePath)
+
+	dbConfigHandler := storageFactory.NewDBConfigHandler(cfg.DB)
+	persisterFactory, err := storageFactory.NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageunit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(cfg.Cache),
+		dbConfig,
+		persisterFactory,
+	)
+}
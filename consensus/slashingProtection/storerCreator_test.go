@@ -0,0 +1,53 @@
+package slashingProtection
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockSlashingProtectionConfig() config.SlashingProtectionConfig {
+	return config.SlashingProtectionConfig{
+		Enabled:         true,
+		NumRoundsToKeep: testNumRoundsToKeep,
+		Cache: config.CacheConfig{
+			Type:     "LRU",
+			Capacity: 100,
+		},
+		DB: config.DBConfig{
+			FilePath:          "SlashingProtectionDB",
+			Type:              "LvlDBSerial",
+			BatchDelaySeconds: 2,
+			MaxBatchSize:      1,
+			MaxOpenFiles:      10,
+		},
+	}
+}
+
+func TestCreateStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("batched writes should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockSlashingProtectionConfig()
+		cfg.DB.MaxBatchSize = 100
+		storer, err := CreateStorer(cfg, t.TempDir())
+		assert.Nil(t, storer)
+		assert.ErrorIs(t, err, ErrInvalidMaxBatchSize)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := CreateStorer(createMockSlashingProtectionConfig(), t.TempDir())
+		require.Nil(t, err)
+
+		require.Nil(t, storer.Put([]byte("key"), []byte("value")))
+		value, err := storer.Get([]byte("key"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value"), value)
+		assert.Nil(t, storer.Close())
+	})
+}
@@ -78,6 +78,7 @@ func initFactoryWithContainer(container *mock.ConsensusCoreMock) bls.Factory {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	return fct
@@ -127,6 +128,7 @@ func TestFactory_NewFactoryNilContainerShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
@@ -147,6 +149,7 @@ func TestFactory_NewFactoryNilConsensusStateShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
@@ -169,6 +172,7 @@ func TestFactory_NewFactoryNilBlockchainShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
@@ -191,6 +195,7 @@ func TestFactory_NewFactoryNilBlockProcessorShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
@@ -213,6 +218,7 @@ func TestFactory_NewFactoryNilBootstrapperShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
@@ -235,6 +241,7 @@ func TestFactory_NewFactoryNilChronologyHandlerShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
@@ -257,6 +264,7 @@ func TestFactory_NewFactoryNilHasherShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
@@ -279,6 +287,7 @@ func TestFactory_NewFactoryNilMarshalizerShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
@@ -301,6 +310,7 @@ func TestFactory_NewFactoryNilMultiSignerContainerShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
@@ -323,6 +333,7 @@ func TestFactory_NewFactoryNilRoundHandlerShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
@@ -345,6 +356,7 @@ func TestFactory_NewFactoryNilShardCoordinatorShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
@@ -367,6 +379,7 @@ func TestFactory_NewFactoryNilSyncTimerShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
@@ -389,6 +402,7 @@ func TestFactory_NewFactoryNilValidatorGroupSelectorShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
@@ -409,6 +423,7 @@ func TestFactory_NewFactoryNilWorkerShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
@@ -430,6 +445,7 @@ func TestFactory_NewFactoryNilAppStatusHandlerShouldFail(t *testing.T) {
 		currentPid,
 		nil,
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
@@ -451,12 +467,35 @@ func TestFactory_NewFactoryNilSignaturesTrackerShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		nil,
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
 	assert.Equal(t, bls.ErrNilSentSignatureTracker, err)
 }
 
+func TestFactory_NewFactoryNilSlashingProtectorShouldFail(t *testing.T) {
+	t.Parallel()
+
+	consensusState := initConsensusState()
+	container := mock.InitConsensusCore()
+	worker := initWorker()
+
+	fct, err := bls.NewSubroundsFactory(
+		container,
+		consensusState,
+		worker,
+		chainID,
+		currentPid,
+		&statusHandler.AppStatusHandlerStub{},
+		&testscommon.SentSignatureTrackerStub{},
+		nil,
+	)
+
+	assert.Nil(t, fct)
+	assert.Equal(t, bls.ErrNilSlashingProtector, err)
+}
+
 func TestFactory_NewFactoryShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -480,6 +519,7 @@ func TestFactory_NewFactoryEmptyChainIDShouldFail(t *testing.T) {
 		currentPid,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.Nil(t, fct)
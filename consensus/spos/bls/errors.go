@@ -4,3 +4,6 @@ import "errors"
 
 // ErrNilSentSignatureTracker defines the error for setting a nil SentSignatureTracker
 var ErrNilSentSignatureTracker = errors.New("nil sent signature tracker")
+
+// ErrNilSlashingProtector defines the error for setting a nil SlashingProtector
+var ErrNilSlashingProtector = errors.New("nil slashing protector")
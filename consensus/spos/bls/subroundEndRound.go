@@ -25,6 +25,7 @@ type subroundEndRound struct {
 	appStatusHandler              core.AppStatusHandler
 	mutProcessingEndRound         sync.Mutex
 	sentSignatureTracker          spos.SentSignaturesTracker
+	slashingProtector             spos.SlashingProtector
 }
 
 // NewSubroundEndRound creates a subroundEndRound object
@@ -35,6 +36,7 @@ func NewSubroundEndRound(
 	displayStatistics func(),
 	appStatusHandler core.AppStatusHandler,
 	sentSignatureTracker spos.SentSignaturesTracker,
+	slashingProtector spos.SlashingProtector,
 ) (*subroundEndRound, error) {
 	err := checkNewSubroundEndRoundParams(
 		baseSubround,
@@ -51,6 +53,9 @@ func NewSubroundEndRound(
 	if check.IfNil(sentSignatureTracker) {
 		return nil, ErrNilSentSignatureTracker
 	}
+	if check.IfNil(slashingProtector) {
+		return nil, ErrNilSlashingProtector
+	}
 
 	srEndRound := subroundEndRound{
 		Subround:                      baseSubround,
@@ -59,6 +64,7 @@ func NewSubroundEndRound(
 		appStatusHandler:              appStatusHandler,
 		mutProcessingEndRound:         sync.Mutex{},
 		sentSignatureTracker:          sentSignatureTracker,
+		slashingProtector:             slashingProtector,
 	}
 	srEndRound.Job = srEndRound.doEndRoundJob
 	srEndRound.Check = srEndRound.doEndRoundConsensusCheck
@@ -786,6 +792,8 @@ func (sr *subroundEndRound) isConsensusHeaderReceived() (bool, data.HeaderHandle
 	return false, nil
 }
 
+// signBlockHeader records the consensus header hash in the slashing protection database before the leader signs the
+// complete header, so the leader key will never sign two different headers for the same round and shard
 func (sr *subroundEndRound) signBlockHeader() ([]byte, error) {
 	headerClone := sr.Header.ShallowClone()
 	err := headerClone.SetLeaderSignature(nil)
@@ -803,6 +811,11 @@ func (sr *subroundEndRound) signBlockHeader() ([]byte, error) {
 		return nil, errGetLeader
 	}
 
+	err = sr.slashingProtector.CheckAndRecordSignature([]byte(leader), sr.Header.GetShardID(), sr.Header.GetRound(), sr.GetData())
+	if err != nil {
+		return nil, err
+	}
+
 	return sr.SigningHandler().CreateSignatureForPublicKey(marshalizedHdr, []byte(leader))
 }
 
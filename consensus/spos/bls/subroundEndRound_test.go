@@ -30,6 +30,14 @@ import (
 func initSubroundEndRoundWithContainer(
 	container *mock.ConsensusCoreMock,
 	appStatusHandler core.AppStatusHandler,
+) bls.SubroundEndRound {
+	return initSubroundEndRoundWithContainerAndSlashingProtector(container, appStatusHandler, &testscommon.SlashingProtectorStub{})
+}
+
+func initSubroundEndRoundWithContainerAndSlashingProtector(
+	container *mock.ConsensusCoreMock,
+	appStatusHandler core.AppStatusHandler,
+	slashingProtector spos.SlashingProtector,
 ) bls.SubroundEndRound {
 	ch := make(chan bool, 1)
 	consensusState := initConsensusState()
@@ -56,6 +64,7 @@ func initSubroundEndRoundWithContainer(
 		displayStatistics,
 		appStatusHandler,
 		&testscommon.SentSignatureTrackerStub{},
+		slashingProtector,
 	)
 
 	return srEndRound
@@ -98,6 +107,7 @@ func TestNewSubroundEndRound(t *testing.T) {
 			displayStatistics,
 			&statusHandler.AppStatusHandlerStub{},
 			&testscommon.SentSignatureTrackerStub{},
+			&testscommon.SlashingProtectorStub{},
 		)
 
 		assert.Nil(t, srEndRound)
@@ -113,6 +123,7 @@ func TestNewSubroundEndRound(t *testing.T) {
 			displayStatistics,
 			&statusHandler.AppStatusHandlerStub{},
 			&testscommon.SentSignatureTrackerStub{},
+			&testscommon.SlashingProtectorStub{},
 		)
 
 		assert.Nil(t, srEndRound)
@@ -128,6 +139,7 @@ func TestNewSubroundEndRound(t *testing.T) {
 			displayStatistics,
 			nil,
 			&testscommon.SentSignatureTrackerStub{},
+			&testscommon.SlashingProtectorStub{},
 		)
 
 		assert.Nil(t, srEndRound)
@@ -143,11 +155,28 @@ func TestNewSubroundEndRound(t *testing.T) {
 			displayStatistics,
 			&statusHandler.AppStatusHandlerStub{},
 			nil,
+			&testscommon.SlashingProtectorStub{},
 		)
 
 		assert.Nil(t, srEndRound)
 		assert.Equal(t, bls.ErrNilSentSignatureTracker, err)
 	})
+	t.Run("nil slashing protector should error", func(t *testing.T) {
+		t.Parallel()
+
+		srEndRound, err := bls.NewSubroundEndRound(
+			sr,
+			extend,
+			bls.ProcessingThresholdPercent,
+			displayStatistics,
+			&statusHandler.AppStatusHandlerStub{},
+			&testscommon.SentSignatureTrackerStub{},
+			nil,
+		)
+
+		assert.Nil(t, srEndRound)
+		assert.Equal(t, bls.ErrNilSlashingProtector, err)
+	})
 }
 
 func TestSubroundEndRound_NewSubroundEndRoundNilBlockChainShouldFail(t *testing.T) {
@@ -180,6 +209,7 @@ func TestSubroundEndRound_NewSubroundEndRoundNilBlockChainShouldFail(t *testing.
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srEndRound))
@@ -216,6 +246,7 @@ func TestSubroundEndRound_NewSubroundEndRoundNilBlockProcessorShouldFail(t *test
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srEndRound))
@@ -253,6 +284,7 @@ func TestSubroundEndRound_NewSubroundEndRoundNilConsensusStateShouldFail(t *test
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srEndRound))
@@ -289,6 +321,7 @@ func TestSubroundEndRound_NewSubroundEndRoundNilMultiSignerContainerShouldFail(t
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srEndRound))
@@ -325,6 +358,7 @@ func TestSubroundEndRound_NewSubroundEndRoundNilRoundHandlerShouldFail(t *testin
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srEndRound))
@@ -361,6 +395,7 @@ func TestSubroundEndRound_NewSubroundEndRoundNilSyncTimerShouldFail(t *testing.T
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srEndRound))
@@ -397,6 +432,7 @@ func TestSubroundEndRound_NewSubroundEndRoundShouldWork(t *testing.T) {
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.False(t, check.IfNil(srEndRound))
@@ -646,6 +682,73 @@ func TestSubroundEndRound_CheckIfSignatureIsFilled(t *testing.T) {
 	assert.Equal(t, expectedSignature, sr.Header.GetLeaderSignature())
 }
 
+func TestSubroundEndRound_DoEndRoundJobWithSlashingProtection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("refused by the slashing protector should not sign the header", func(t *testing.T) {
+		t.Parallel()
+
+		container := mock.InitConsensusCore()
+		container.SetSigningHandler(&consensusMocks.SigningHandlerStub{
+			CreateSignatureForPublicKeyCalled: func(publicKeyBytes []byte, msg []byte) ([]byte, error) {
+				assert.Fail(t, "should have not signed the header")
+				return nil, nil
+			},
+		})
+		slashingProtector := &testscommon.SlashingProtectorStub{
+			CheckAndRecordSignatureCalled: func(publicKey []byte, shardID uint32, round uint64, headerHash []byte) error {
+				return errors.New("double signing attempt")
+			},
+		}
+		sr := *initSubroundEndRoundWithContainerAndSlashingProtector(container, &statusHandler.AppStatusHandlerStub{}, slashingProtector)
+		sr.SetSelfPubKey("A")
+		sr.Header = &block.Header{}
+		sr.Data = []byte("X")
+
+		r := sr.DoEndRoundJob()
+		assert.False(t, r)
+		assert.Nil(t, sr.Header.GetLeaderSignature())
+	})
+	t.Run("should check the consensus header before the leader signs it", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSignature := []byte("signature")
+		container := mock.InitConsensusCore()
+		checked := false
+		container.SetSigningHandler(&consensusMocks.SigningHandlerStub{
+			CreateSignatureForPublicKeyCalled: func(publicKeyBytes []byte, msg []byte) ([]byte, error) {
+				assert.True(t, checked)
+				return expectedSignature, nil
+			},
+		})
+		var checkedPublicKey []byte
+		slashingProtector := &testscommon.SlashingProtectorStub{
+			CheckAndRecordSignatureCalled: func(publicKey []byte, shardID uint32, round uint64, headerHash []byte) error {
+				assert.Equal(t, uint32(1), shardID)
+				assert.Equal(t, uint64(37), round)
+				assert.Equal(t, []byte("X"), headerHash)
+				checkedPublicKey = publicKey
+				checked = true
+
+				return nil
+			},
+		}
+		sr := *initSubroundEndRoundWithContainerAndSlashingProtector(container, &statusHandler.AppStatusHandlerStub{}, slashingProtector)
+		sr.SetSelfPubKey("A")
+		sr.Header = &block.Header{
+			ShardID: 1,
+			Round:   37,
+		}
+		sr.Data = []byte("X")
+
+		r := sr.DoEndRoundJob()
+		assert.True(t, r)
+		assert.True(t, checked)
+		assert.Equal(t, []byte("A"), checkedPublicKey)
+		assert.Equal(t, expectedSignature, sr.Header.GetLeaderSignature())
+	})
+}
+
 func TestSubroundEndRound_DoEndRoundConsensusCheckShouldReturnFalseWhenRoundIsCanceled(t *testing.T) {
 	t.Parallel()
 
@@ -1365,6 +1468,7 @@ func TestSubroundEndRound_ReceivedInvalidSignersInfo(t *testing.T) {
 			displayStatistics,
 			&statusHandler.AppStatusHandlerStub{},
 			&testscommon.SentSignatureTrackerStub{},
+			&testscommon.SlashingProtectorStub{},
 		)
 
 		srEndRound.SetSelfPubKey("A")
@@ -1736,6 +1840,7 @@ func TestSubroundEndRound_getMinConsensusGroupIndexOfManagedKeys(t *testing.T) {
 		displayStatistics,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	t.Run("no managed keys from consensus group", func(t *testing.T) {
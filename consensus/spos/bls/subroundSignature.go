@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -17,6 +18,7 @@ type subroundSignature struct {
 	*spos.Subround
 	appStatusHandler     core.AppStatusHandler
 	sentSignatureTracker spos.SentSignaturesTracker
+	slashingProtector    spos.SlashingProtector
 }
 
 // NewSubroundSignature creates a subroundSignature object
@@ -25,6 +27,7 @@ func NewSubroundSignature(
 	extend func(subroundId int),
 	appStatusHandler core.AppStatusHandler,
 	sentSignatureTracker spos.SentSignaturesTracker,
+	slashingProtector spos.SlashingProtector,
 ) (*subroundSignature, error) {
 	err := checkNewSubroundSignatureParams(
 		baseSubround,
@@ -41,11 +44,15 @@ func NewSubroundSignature(
 	if check.IfNil(sentSignatureTracker) {
 		return nil, ErrNilSentSignatureTracker
 	}
+	if check.IfNil(slashingProtector) {
+		return nil, ErrNilSlashingProtector
+	}
 
 	srSignature := subroundSignature{
 		Subround:             baseSubround,
 		appStatusHandler:     appStatusHandler,
 		sentSignatureTracker: sentSignatureTracker,
+		slashingProtector:    slashingProtector,
 	}
 	srSignature.Job = srSignature.doSignatureJob
 	srSignature.Check = srSignature.doSignatureConsensusCheck
@@ -89,12 +96,7 @@ func (sr *subroundSignature) doSignatureJob(_ context.Context) bool {
 			return false
 		}
 
-		signatureShare, err := sr.SigningHandler().CreateSignatureShareForPublicKey(
-			sr.GetData(),
-			uint16(selfIndex),
-			sr.Header.GetEpoch(),
-			[]byte(sr.SelfPubKey()),
-		)
+		signatureShare, err := sr.createSignatureShare([]byte(sr.SelfPubKey()), selfIndex)
 		if err != nil {
 			log.Debug("doSignatureJob.CreateSignatureShareForPublicKey", "error", err.Error())
 			return false
@@ -116,6 +118,22 @@ func (sr *subroundSignature) doSignatureJob(_ context.Context) bool {
 	return sr.doSignatureJobForManagedKeys()
 }
 
+// createSignatureShare records the header in the slashing protection database before creating the signature share,
+// so the same key will never sign two different headers for the same round and shard
+func (sr *subroundSignature) createSignatureShare(pkBytes []byte, index int) ([]byte, error) {
+	err := sr.slashingProtector.CheckAndRecordSignature(pkBytes, sr.Header.GetShardID(), sr.Header.GetRound(), sr.GetData())
+	if err != nil {
+		return nil, err
+	}
+
+	return sr.SigningHandler().CreateSignatureShareForPublicKey(
+		sr.GetData(),
+		uint16(index),
+		sr.Header.GetEpoch(),
+		pkBytes,
+	)
+}
+
 func (sr *subroundSignature) createAndSendSignatureMessage(signatureShare []byte, pkBytes []byte) bool {
 	// TODO: Analyze it is possible to send message only to leader with O(1) instead of O(n)
 	cnsMsg := consensus.NewConsensusMessage(
@@ -348,38 +366,29 @@ func (sr *subroundSignature) remainingTime() time.Duration {
 	return remainigTime
 }
 
+type managedKeySignature struct {
+	pk             string
+	index          int
+	isLeader       bool
+	signatureShare []byte
+	err            error
+}
+
 func (sr *subroundSignature) doSignatureJobForManagedKeys() bool {
 	isMultiKeyLeader := sr.IsMultiKeyLeaderInCurrentRound()
 
-	numMultiKeysSignaturesSent := 0
-	for idx, pk := range sr.ConsensusGroup() {
-		pkBytes := []byte(pk)
-		if sr.IsJobDone(pk, sr.Current()) {
-			continue
-		}
-		if !sr.IsKeyManagedByCurrentNode(pkBytes) {
-			continue
-		}
+	signatures := sr.createManagedKeysSignatureShares()
 
-		selfIndex, err := sr.ConsensusGroupIndex(pk)
-		if err != nil {
-			log.Warn("doSignatureJobForManagedKeys: index not found", "pk", pkBytes)
-			continue
-		}
-
-		signatureShare, err := sr.SigningHandler().CreateSignatureShareForPublicKey(
-			sr.GetData(),
-			uint16(selfIndex),
-			sr.Header.GetEpoch(),
-			pkBytes,
-		)
-		if err != nil {
-			log.Debug("doSignatureJobForManagedKeys.CreateSignatureShareForPublicKey", "error", err.Error())
+	numMultiKeysSignaturesSent := 0
+	for _, signature := range signatures {
+		pkBytes := []byte(signature.pk)
+		if signature.err != nil {
+			log.Debug("doSignatureJobForManagedKeys.CreateSignatureShareForPublicKey", "error", signature.err.Error())
 			return false
 		}
 
 		if !isMultiKeyLeader {
-			ok := sr.createAndSendSignatureMessage(signatureShare, pkBytes)
+			ok := sr.createAndSendSignatureMessage(signature.signatureShare, pkBytes)
 			if !ok {
 				return false
 			}
@@ -388,8 +397,7 @@ func (sr *subroundSignature) doSignatureJobForManagedKeys() bool {
 		}
 		sr.sentSignatureTracker.SignatureSent(pkBytes)
 
-		isLeader := idx == spos.IndexOfLeaderInConsensusGroup
-		ok := sr.completeSignatureSubRound(pk, isLeader)
+		ok := sr.completeSignatureSubRound(signature.pk, signature.isLeader)
 		if !ok {
 			return false
 		}
@@ -402,6 +410,45 @@ func (sr *subroundSignature) doSignatureJobForManagedKeys() bool {
 	return true
 }
 
+// createManagedKeysSignatureShares creates, in parallel, the signature shares of the managed keys from the consensus
+// group, so that a remote signer is not waited for each key in turn. The shares are returned in the consensus group order
+func (sr *subroundSignature) createManagedKeysSignatureShares() []*managedKeySignature {
+	signatures := make([]*managedKeySignature, 0)
+	for idx, pk := range sr.ConsensusGroup() {
+		if sr.IsJobDone(pk, sr.Current()) {
+			continue
+		}
+		if !sr.IsKeyManagedByCurrentNode([]byte(pk)) {
+			continue
+		}
+
+		selfIndex, err := sr.ConsensusGroupIndex(pk)
+		if err != nil {
+			log.Warn("doSignatureJobForManagedKeys: index not found", "pk", []byte(pk))
+			continue
+		}
+
+		signatures = append(signatures, &managedKeySignature{
+			pk:       pk,
+			index:    selfIndex,
+			isLeader: idx == spos.IndexOfLeaderInConsensusGroup,
+		})
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(signatures))
+	for _, signature := range signatures {
+		go func(signature *managedKeySignature) {
+			defer wg.Done()
+
+			signature.signatureShare, signature.err = sr.createSignatureShare([]byte(signature.pk), signature.index)
+		}(signature)
+	}
+	wg.Wait()
+
+	return signatures
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sr *subroundSignature) IsInterfaceNil() bool {
 	return sr == nil
@@ -1,7 +1,9 @@
 package bls_test
 
 import (
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
@@ -18,6 +20,13 @@ import (
 )
 
 func initSubroundSignatureWithContainer(container *mock.ConsensusCoreMock) bls.SubroundSignature {
+	return initSubroundSignatureWithContainerAndSlashingProtector(container, &testscommon.SlashingProtectorStub{})
+}
+
+func initSubroundSignatureWithContainerAndSlashingProtector(
+	container *mock.ConsensusCoreMock,
+	slashingProtector spos.SlashingProtector,
+) bls.SubroundSignature {
 	consensusState := initConsensusState()
 	ch := make(chan bool, 1)
 
@@ -42,6 +51,7 @@ func initSubroundSignatureWithContainer(container *mock.ConsensusCoreMock) bls.S
 		extend,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		slashingProtector,
 	)
 
 	return srSignature
@@ -83,6 +93,7 @@ func TestNewSubroundSignature(t *testing.T) {
 			extend,
 			&statusHandler.AppStatusHandlerStub{},
 			&testscommon.SentSignatureTrackerStub{},
+			&testscommon.SlashingProtectorStub{},
 		)
 
 		assert.Nil(t, srSignature)
@@ -96,6 +107,7 @@ func TestNewSubroundSignature(t *testing.T) {
 			nil,
 			&statusHandler.AppStatusHandlerStub{},
 			&testscommon.SentSignatureTrackerStub{},
+			&testscommon.SlashingProtectorStub{},
 		)
 
 		assert.Nil(t, srSignature)
@@ -109,6 +121,7 @@ func TestNewSubroundSignature(t *testing.T) {
 			extend,
 			nil,
 			&testscommon.SentSignatureTrackerStub{},
+			&testscommon.SlashingProtectorStub{},
 		)
 
 		assert.Nil(t, srSignature)
@@ -122,11 +135,26 @@ func TestNewSubroundSignature(t *testing.T) {
 			extend,
 			&statusHandler.AppStatusHandlerStub{},
 			nil,
+			&testscommon.SlashingProtectorStub{},
 		)
 
 		assert.Nil(t, srSignature)
 		assert.Equal(t, bls.ErrNilSentSignatureTracker, err)
 	})
+	t.Run("nil slashing protector should error", func(t *testing.T) {
+		t.Parallel()
+
+		srSignature, err := bls.NewSubroundSignature(
+			sr,
+			extend,
+			&statusHandler.AppStatusHandlerStub{},
+			&testscommon.SentSignatureTrackerStub{},
+			nil,
+		)
+
+		assert.Nil(t, srSignature)
+		assert.Equal(t, bls.ErrNilSlashingProtector, err)
+	})
 }
 
 func TestSubroundSignature_NewSubroundSignatureNilConsensusStateShouldFail(t *testing.T) {
@@ -158,6 +186,7 @@ func TestSubroundSignature_NewSubroundSignatureNilConsensusStateShouldFail(t *te
 		extend,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srSignature))
@@ -192,6 +221,7 @@ func TestSubroundSignature_NewSubroundSignatureNilHasherShouldFail(t *testing.T)
 		extend,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srSignature))
@@ -226,6 +256,7 @@ func TestSubroundSignature_NewSubroundSignatureNilMultiSignerContainerShouldFail
 		extend,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srSignature))
@@ -261,6 +292,7 @@ func TestSubroundSignature_NewSubroundSignatureNilRoundHandlerShouldFail(t *test
 		extend,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srSignature))
@@ -295,6 +327,7 @@ func TestSubroundSignature_NewSubroundSignatureNilSyncTimerShouldFail(t *testing
 		extend,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.True(t, check.IfNil(srSignature))
@@ -329,6 +362,7 @@ func TestSubroundSignature_NewSubroundSignatureShouldWork(t *testing.T) {
 		extend,
 		&statusHandler.AppStatusHandlerStub{},
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	assert.False(t, check.IfNil(srSignature))
@@ -377,6 +411,68 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	assert.False(t, sr.RoundCanceled)
 }
 
+func TestSubroundSignature_DoSignatureJobWithSlashingProtection(t *testing.T) {
+	t.Parallel()
+
+	t.Run("refused by the slashing protector should not sign", func(t *testing.T) {
+		t.Parallel()
+
+		container := mock.InitConsensusCore()
+		container.SetSigningHandler(&consensusMocks.SigningHandlerStub{
+			CreateSignatureShareForPublicKeyCalled: func(msg []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error) {
+				assert.Fail(t, "should have not created the signature share")
+				return nil, nil
+			},
+		})
+		slashingProtector := &testscommon.SlashingProtectorStub{
+			CheckAndRecordSignatureCalled: func(publicKey []byte, shardID uint32, round uint64, headerHash []byte) error {
+				return errors.New("double signing attempt")
+			},
+		}
+		sr := *initSubroundSignatureWithContainerAndSlashingProtector(container, slashingProtector)
+		sr.Header = &block.Header{}
+		sr.Data = []byte("X")
+
+		r := sr.DoSignatureJob()
+		assert.False(t, r)
+	})
+	t.Run("should check the signed header before signing", func(t *testing.T) {
+		t.Parallel()
+
+		container := mock.InitConsensusCore()
+		checked := false
+		container.SetSigningHandler(&consensusMocks.SigningHandlerStub{
+			CreateSignatureShareForPublicKeyCalled: func(msg []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error) {
+				assert.True(t, checked)
+				return []byte("SIG"), nil
+			},
+		})
+		var checkedPublicKey []byte
+		slashingProtector := &testscommon.SlashingProtectorStub{
+			CheckAndRecordSignatureCalled: func(publicKey []byte, shardID uint32, round uint64, headerHash []byte) error {
+				assert.Equal(t, uint32(1), shardID)
+				assert.Equal(t, uint64(37), round)
+				assert.Equal(t, []byte("X"), headerHash)
+				checkedPublicKey = publicKey
+				checked = true
+
+				return nil
+			},
+		}
+		sr := *initSubroundSignatureWithContainerAndSlashingProtector(container, slashingProtector)
+		sr.Header = &block.Header{
+			ShardID: 1,
+			Round:   37,
+		}
+		sr.Data = []byte("X")
+
+		r := sr.DoSignatureJob()
+		assert.True(t, r)
+		assert.True(t, checked)
+		assert.Equal(t, []byte(sr.SelfPubKey()), checkedPublicKey)
+	})
+}
+
 func TestSubroundSignature_DoSignatureJobWithMultikey(t *testing.T) {
 	t.Parallel()
 
@@ -416,6 +512,7 @@ func TestSubroundSignature_DoSignatureJobWithMultikey(t *testing.T) {
 				signatureSentForPks[string(pkBytes)] = struct{}{}
 			},
 		},
+		&testscommon.SlashingProtectorStub{},
 	)
 
 	srSignature.Header = &block.Header{}
@@ -466,6 +563,80 @@ func TestSubroundSignature_DoSignatureJobWithMultikey(t *testing.T) {
 	assert.Equal(t, expectedMap, signatureSentForPks)
 }
 
+func TestSubroundSignature_DoSignatureJobWithMultikeyShouldCreateTheSharesInParallel(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	consensusState := initConsensusStateWithKeysHandler(
+		&testscommon.KeysHandlerStub{
+			IsKeyManagedByCurrentNodeCalled: func(pkBytes []byte) bool {
+				return true
+			},
+		},
+	)
+	ch := make(chan bool, 1)
+
+	sr, _ := spos.NewSubround(
+		bls.SrBlock,
+		bls.SrSignature,
+		bls.SrEndRound,
+		int64(70*roundTimeDuration/100),
+		int64(85*roundTimeDuration/100),
+		"(SIGNATURE)",
+		consensusState,
+		ch,
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+		&statusHandler.AppStatusHandlerStub{},
+	)
+
+	signatureSentForPks := make([]string, 0)
+	srSignature, _ := bls.NewSubroundSignature(
+		sr,
+		extend,
+		&statusHandler.AppStatusHandlerStub{},
+		&testscommon.SentSignatureTrackerStub{
+			SignatureSentCalled: func(pkBytes []byte) {
+				signatureSentForPks = append(signatureSentForPks, string(pkBytes))
+			},
+		},
+		&testscommon.SlashingProtectorStub{},
+	)
+	srSignature.Header = &block.Header{}
+	sr.Data = []byte("X")
+	sr.SetSelfPubKey("not in consensus group")
+
+	// each signature share is created only after all the shares were requested, as a remote signer would be called
+	numKeys := len(sr.ConsensusGroup())
+	mutRequested := sync.Mutex{}
+	numRequested := 0
+	allRequested := make(chan struct{})
+	container.SetSigningHandler(&consensusMocks.SigningHandlerStub{
+		CreateSignatureShareForPublicKeyCalled: func(msg []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error) {
+			mutRequested.Lock()
+			numRequested++
+			if numRequested == numKeys {
+				close(allRequested)
+			}
+			mutRequested.Unlock()
+
+			select {
+			case <-allRequested:
+				return []byte("SIG"), nil
+			case <-time.After(time.Second):
+				return nil, errors.New("signature shares created sequentially")
+			}
+		},
+	})
+
+	r := srSignature.DoSignatureJob()
+	assert.True(t, r)
+	// the signatures are sent in the consensus group order
+	assert.Equal(t, sr.ConsensusGroup(), signatureSentForPks)
+}
+
 func TestSubroundSignature_ReceivedSignature(t *testing.T) {
 	t.Parallel()
 
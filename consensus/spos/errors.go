@@ -238,6 +238,9 @@ var ErrNilSigningHandler = errors.New("nil signing handler")
 // ErrNilKeysHandler signals that a nil keys handler was provided
 var ErrNilKeysHandler = errors.New("nil keys handler")
 
+// ErrNilKeysSigner signals that a nil keys signer was provided
+var ErrNilKeysSigner = errors.New("nil keys signer")
+
 // ErrNilFunctionHandler signals that a nil function handler was provided
 var ErrNilFunctionHandler = errors.New("nil function handler")
 
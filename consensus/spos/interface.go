@@ -177,3 +177,9 @@ type SentSignaturesTracker interface {
 	SignatureSent(pkBytes []byte)
 	IsInterfaceNil() bool
 }
+
+// SlashingProtector defines a component able to refuse signing two different headers for the same round and shard
+type SlashingProtector interface {
+	CheckAndRecordSignature(publicKey []byte, shardID uint32, round uint64, headerHash []byte) error
+	IsInterfaceNil() bool
+}
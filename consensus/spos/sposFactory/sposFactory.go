@@ -5,7 +5,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
-	"github.com/multiversx/mx-chain-crypto-go"
+	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/broadcast"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
@@ -24,6 +24,7 @@ func GetSubroundsFactory(
 	appStatusHandler core.AppStatusHandler,
 	outportHandler outport.OutportHandler,
 	sentSignatureTracker spos.SentSignaturesTracker,
+	slashingProtector spos.SlashingProtector,
 	chainID []byte,
 	currentPid core.PeerID,
 ) (spos.SubroundsFactory, error) {
@@ -37,6 +38,7 @@ func GetSubroundsFactory(
 			currentPid,
 			appStatusHandler,
 			sentSignatureTracker,
+			slashingProtector,
 		)
 		if err != nil {
 			return nil, err
@@ -66,7 +68,7 @@ func GetBroadcastMessenger(
 	hasher hashing.Hasher,
 	messenger consensus.P2PMessenger,
 	shardCoordinator sharding.Coordinator,
-	peerSignatureHandler crypto.PeerSignatureHandler,
+	keysSigner cryptoCommon.KeysSigner,
 	headersSubscriber consensus.HeadersPoolSubscriber,
 	interceptorsContainer process.InterceptorsContainer,
 	alarmScheduler core.TimersScheduler,
@@ -82,7 +84,7 @@ func GetBroadcastMessenger(
 		Hasher:                     hasher,
 		Messenger:                  messenger,
 		ShardCoordinator:           shardCoordinator,
-		PeerSignatureHandler:       peerSignatureHandler,
+		KeysSigner:                 keysSigner,
 		HeadersSubscriber:          headersSubscriber,
 		MaxDelayCacheSize:          maxDelayCacheSize,
 		MaxValidatorDelayCacheSize: maxDelayCacheSize,
@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/consensus/spos/sposFactory"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/outport"
 	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
@@ -53,6 +54,7 @@ func TestGetSubroundsFactory_BlsNilConsensusCoreShouldErr(t *testing.T) {
 		statusHandler,
 		indexer,
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 		chainID,
 		currentPid,
 	)
@@ -77,6 +79,7 @@ func TestGetSubroundsFactory_BlsNilStatusHandlerShouldErr(t *testing.T) {
 		nil,
 		indexer,
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 		chainID,
 		currentPid,
 	)
@@ -102,6 +105,7 @@ func TestGetSubroundsFactory_BlsShouldWork(t *testing.T) {
 		statusHandler,
 		indexer,
 		&testscommon.SentSignatureTrackerStub{},
+		&testscommon.SlashingProtectorStub{},
 		chainID,
 		currentPid,
 	)
@@ -122,6 +126,7 @@ func TestGetSubroundsFactory_InvalidConsensusTypeShouldErr(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		currentPid,
 	)
 
@@ -139,7 +144,7 @@ func TestGetBroadcastMessenger_ShardShouldWork(t *testing.T) {
 	shardCoord.SelfIDCalled = func() uint32 {
 		return 0
 	}
-	peerSigHandler := &mock.PeerSignatureHandler{}
+	keysSigner := &cryptoMocks.KeysSignerStub{}
 	headersSubscriber := &mock.HeadersCacherStub{}
 	interceptosContainer := &testscommon.InterceptorsContainerStub{}
 	alarmSchedulerStub := &mock.AlarmSchedulerStub{}
@@ -149,7 +154,7 @@ func TestGetBroadcastMessenger_ShardShouldWork(t *testing.T) {
 		hasher,
 		messenger,
 		shardCoord,
-		peerSigHandler,
+		keysSigner,
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
@@ -170,7 +175,7 @@ func TestGetBroadcastMessenger_MetachainShouldWork(t *testing.T) {
 	shardCoord.SelfIDCalled = func() uint32 {
 		return core.MetachainShardId
 	}
-	peerSigHandler := &mock.PeerSignatureHandler{}
+	keysSigner := &cryptoMocks.KeysSignerStub{}
 	headersSubscriber := &mock.HeadersCacherStub{}
 	interceptosContainer := &testscommon.InterceptorsContainerStub{}
 	alarmSchedulerStub := &mock.AlarmSchedulerStub{}
@@ -180,7 +185,7 @@ func TestGetBroadcastMessenger_MetachainShouldWork(t *testing.T) {
 		hasher,
 		messenger,
 		shardCoord,
-		peerSigHandler,
+		keysSigner,
 		headersSubscriber,
 		interceptosContainer,
 		alarmSchedulerStub,
@@ -20,6 +20,7 @@ type dataPool struct {
 	peerChangesBlocks      storage.Cacher
 	trieNodes              storage.Cacher
 	trieNodesChunks        storage.Cacher
+	trieLeavesRanges       storage.Cacher
 	currBlockTxs           dataRetriever.TransactionCacher
 	currEpochValidatorInfo dataRetriever.ValidatorInfoCacher
 	smartContracts         storage.Cacher
@@ -38,6 +39,7 @@ type DataPoolArgs struct {
 	PeerChangesBlocks         storage.Cacher
 	TrieNodes                 storage.Cacher
 	TrieNodesChunks           storage.Cacher
+	TrieLeavesRanges          storage.Cacher
 	CurrentBlockTransactions  dataRetriever.TransactionCacher
 	CurrentEpochValidatorInfo dataRetriever.ValidatorInfoCacher
 	SmartContracts            storage.Cacher
@@ -78,6 +80,9 @@ func NewDataPool(args DataPoolArgs) (*dataPool, error) {
 	if check.IfNil(args.TrieNodesChunks) {
 		return nil, dataRetriever.ErrNilTrieNodesChunksPool
 	}
+	if check.IfNil(args.TrieLeavesRanges) {
+		return nil, dataRetriever.ErrNilTrieLeavesRangesPool
+	}
 	if check.IfNil(args.SmartContracts) {
 		return nil, dataRetriever.ErrNilSmartContractsPool
 	}
@@ -100,6 +105,7 @@ func NewDataPool(args DataPoolArgs) (*dataPool, error) {
 		peerChangesBlocks:      args.PeerChangesBlocks,
 		trieNodes:              args.TrieNodes,
 		trieNodesChunks:        args.TrieNodesChunks,
+		trieLeavesRanges:       args.TrieLeavesRanges,
 		currBlockTxs:           args.CurrentBlockTransactions,
 		currEpochValidatorInfo: args.CurrentEpochValidatorInfo,
 		smartContracts:         args.SmartContracts,
@@ -159,6 +165,11 @@ func (dp *dataPool) TrieNodesChunks() storage.Cacher {
 	return dp.trieNodesChunks
 }
 
+// TrieLeavesRanges returns the holder for trie leaves ranges
+func (dp *dataPool) TrieLeavesRanges() storage.Cacher {
+	return dp.trieLeavesRanges
+}
+
 // SmartContracts returns the holder for smart contracts
 func (dp *dataPool) SmartContracts() storage.Cacher {
 	return dp.smartContracts
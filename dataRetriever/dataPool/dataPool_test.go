@@ -24,6 +24,7 @@ func createMockDataPoolArgs() dataPool.DataPoolArgs {
 		PeerChangesBlocks:         testscommon.NewCacherStub(),
 		TrieNodes:                 testscommon.NewCacherStub(),
 		TrieNodesChunks:           testscommon.NewCacherStub(),
+		TrieLeavesRanges:          testscommon.NewCacherStub(),
 		CurrentBlockTransactions:  &mock.TxForCurrentBlockStub{},
 		CurrentEpochValidatorInfo: &mock.ValidatorInfoForCurrentEpochStub{},
 		SmartContracts:            testscommon.NewCacherStub(),
@@ -99,6 +100,17 @@ func TestNewDataPool_NilTrieNodesShouldErr(t *testing.T) {
 	assert.Nil(t, tdp)
 }
 
+func TestNewDataPool_NilTrieLeavesRangesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockDataPoolArgs()
+	args.TrieLeavesRanges = nil
+	tdp, err := dataPool.NewDataPool(args)
+
+	assert.Equal(t, dataRetriever.ErrNilTrieLeavesRangesPool, err)
+	assert.Nil(t, tdp)
+}
+
 func TestNewDataPool_NilTrieNodesChunksShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -206,6 +218,7 @@ func TestNewDataPool_OkValsShouldWork(t *testing.T) {
 	assert.True(t, args.CurrentEpochValidatorInfo == tdp.CurrentEpochValidatorInfo())
 	assert.True(t, args.TrieNodes == tdp.TrieNodes())
 	assert.True(t, args.TrieNodesChunks == tdp.TrieNodesChunks())
+	assert.True(t, args.TrieLeavesRanges == tdp.TrieLeavesRanges())
 	assert.True(t, args.SmartContracts == tdp.SmartContracts())
 	assert.True(t, args.PeerAuthentications == tdp.PeerAuthentications())
 	assert.True(t, args.Heartbeats == tdp.Heartbeats())
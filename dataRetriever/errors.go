@@ -128,6 +128,9 @@ var ErrNilTrieNodesPool = errors.New("nil trie nodes data pool")
 // ErrNilTrieNodesChunksPool signals that a nil trie nodes chunks data pool was provided
 var ErrNilTrieNodesChunksPool = errors.New("nil trie nodes chunks data pool")
 
+// ErrNilTrieLeavesRangesPool signals that a nil trie leaves ranges data pool was provided
+var ErrNilTrieLeavesRangesPool = errors.New("nil trie leaves ranges data pool")
+
 // ErrNoSuchStorageUnit defines the error for using an invalid storage unit
 var ErrNoSuchStorageUnit = errors.New("no such unit type")
 
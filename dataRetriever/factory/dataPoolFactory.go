@@ -122,6 +122,12 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 		return nil, fmt.Errorf("%w while creating the cache for the trie chunks", err)
 	}
 
+	cacherCfg = factory.GetCacherFromConfig(mainConfig.TrieLeavesRangesDataPool)
+	trieLeavesRanges, err := storageunit.NewCache(cacherCfg)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the cache for the trie leaves ranges", err)
+	}
+
 	cacherCfg = factory.GetCacherFromConfig(mainConfig.SmartContractDataPool)
 	smartContracts, err := storageunit.NewCache(cacherCfg)
 	if err != nil {
@@ -158,6 +164,7 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 		PeerChangesBlocks:         peerChangeBlockBody,
 		TrieNodes:                 adaptedTrieNodesStorage,
 		TrieNodesChunks:           trieNodesChunks,
+		TrieLeavesRanges:          trieLeavesRanges,
 		CurrentBlockTransactions:  currBlockTransactions,
 		CurrentEpochValidatorInfo: currEpochValidatorInfo,
 		SmartContracts:            smartContracts,
@@ -121,6 +121,14 @@ func TestNewDataPoolFromConfig_BadConfigShouldErr(t *testing.T) {
 	require.True(t, errors.Is(err, storage.ErrNotSupportedCacheType))
 	require.True(t, strings.Contains(err.Error(), "the cache for the trie chunks"))
 
+	args = getGoodArgs()
+	args.Config.TrieLeavesRangesDataPool.Type = "invalid cache type"
+	holder, err = NewDataPoolFromConfig(args)
+	require.Nil(t, holder)
+	fmt.Println(err)
+	require.True(t, errors.Is(err, storage.ErrNotSupportedCacheType))
+	require.True(t, strings.Contains(err.Error(), "the cache for the trie leaves ranges"))
+
 	args = getGoodArgs()
 	args.Config.SmartContractDataPool.Type = "invalid cache type"
 	holder, err = NewDataPoolFromConfig(args)
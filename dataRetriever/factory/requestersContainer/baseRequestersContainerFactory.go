@@ -323,6 +323,32 @@ func (brcf *baseRequestersContainerFactory) createTrieNodesRequester(
 	return requesters.NewTrieNodeRequester(arg)
 }
 
+func (brcf *baseRequestersContainerFactory) createTrieLeavesRangeRequester(
+	topic string,
+	numCrossShardPeers int,
+	numIntraShardPeers int,
+	targetShardID uint32,
+) (dataRetriever.Requester, error) {
+	requestSender, err := brcf.createOneRequestSenderWithSpecifiedNumRequests(
+		topic,
+		EmptyExcludePeersOnTopic,
+		targetShardID,
+		numCrossShardPeers,
+		numIntraShardPeers,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := requesters.ArgTrieLeavesRangeRequester{
+		ArgBaseRequester: requesters.ArgBaseRequester{
+			RequestSender: requestSender,
+			Marshaller:    brcf.marshaller,
+		},
+	}
+	return requesters.NewTrieLeavesRangeRequester(arg)
+}
+
 func (brcf *baseRequestersContainerFactory) generateValidatorInfoRequester() error {
 	identifierValidatorInfo := common.ValidatorInfoTopic
 	shardC := brcf.shardCoordinator
@@ -113,6 +113,20 @@ func (mrcf *metaRequestersContainerFactory) AddShardTrieNodeRequesters(container
 
 		requestersSlice = append(requestersSlice, requester)
 		keys = append(keys, identifierTrieNodes)
+
+		identifierLeavesRange := factory.AccountTrieLeavesRangeTopic + shardC.CommunicationIdentifier(idx)
+		requester, err = mrcf.createTrieLeavesRangeRequester(
+			identifierLeavesRange,
+			mrcf.numCrossShardPeers,
+			mrcf.numTotalPeers-mrcf.numCrossShardPeers,
+			idx,
+		)
+		if err != nil {
+			return err
+		}
+
+		requestersSlice = append(requestersSlice, requester)
+		keys = append(keys, identifierLeavesRange)
 	}
 
 	return container.AddMultiple(keys, requestersSlice)
@@ -216,6 +230,20 @@ func (mrcf *metaRequestersContainerFactory) generateTrieNodesRequesters() error
 	requestersSlice = append(requestersSlice, requester)
 	keys = append(keys, identifierTrieNodes)
 
+	identifierLeavesRange := factory.AccountTrieLeavesRangeTopic + core.CommunicationIdentifierBetweenShards(core.MetachainShardId, core.MetachainShardId)
+	requester, err = mrcf.createTrieLeavesRangeRequester(
+		identifierLeavesRange,
+		0,
+		mrcf.numTotalPeers,
+		core.MetachainShardId,
+	)
+	if err != nil {
+		return err
+	}
+
+	requestersSlice = append(requestersSlice, requester)
+	keys = append(keys, identifierLeavesRange)
+
 	identifierTrieNodes = factory.ValidatorTrieNodesTopic + core.CommunicationIdentifierBetweenShards(core.MetachainShardId, core.MetachainShardId)
 	requester, err = mrcf.createTrieNodesRequester(
 		identifierTrieNodes,
@@ -221,14 +221,16 @@ func TestMetaRequestersContainerFactory_With4ShardsShouldWork(t *testing.T) {
 	numRequestersRewards := noOfShards
 	numRequestersTxs := noOfShards + 1
 	numRequestersTrieNodes := 2
+	numRequestersTrieLeavesRanges := 1
 	numRequestersPeerAuth := 1
 	numRequesterValidatorInfo := 1
 	totalRequesters := numRequestersShardHeadersForMetachain + numRequesterMetablocks + numRequestersMiniBlocks +
-		numRequestersUnsigned + numRequestersTxs + numRequestersTrieNodes + numRequestersRewards + numRequestersPeerAuth + numRequesterValidatorInfo
+		numRequestersUnsigned + numRequestersTxs + numRequestersTrieNodes + numRequestersTrieLeavesRanges + numRequestersRewards +
+		numRequestersPeerAuth + numRequesterValidatorInfo
 
 	assert.Equal(t, totalRequesters, container.Len())
 
 	err := rcf.AddShardTrieNodeRequesters(container)
 	assert.Nil(t, err)
-	assert.Equal(t, totalRequesters+noOfShards, container.Len())
+	assert.Equal(t, totalRequesters+2*noOfShards, container.Len())
 }
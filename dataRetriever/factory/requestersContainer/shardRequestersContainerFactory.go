@@ -156,6 +156,20 @@ func (srcf *shardRequestersContainerFactory) generateTrieNodesRequesters() error
 	requestersSlice = append(requestersSlice, requester)
 	keys = append(keys, identifierTrieNodes)
 
+	identifierLeavesRange := factory.AccountTrieLeavesRangeTopic + shardC.CommunicationIdentifier(core.MetachainShardId)
+	requester, err = srcf.createTrieLeavesRangeRequester(
+		identifierLeavesRange,
+		0,
+		srcf.numTotalPeers,
+		core.MetachainShardId,
+	)
+	if err != nil {
+		return err
+	}
+
+	requestersSlice = append(requestersSlice, requester)
+	keys = append(keys, identifierLeavesRange)
+
 	return srcf.container.AddMultiple(keys, requestersSlice)
 }
 
@@ -251,10 +251,12 @@ func TestShardRequestersContainerFactory_With4ShardsShouldWork(t *testing.T) {
 	numRequesterMiniBlocks := noOfShards + 2
 	numRequesterMetaBlockHeaders := 1
 	numRequesterTrieNodes := 1
+	numRequesterTrieLeavesRanges := 1
 	numRequesterPeerAuth := 1
 	numRequesterValidatorInfo := 1
 	totalRequesters := numRequesterTxs + numRequesterHeaders + numRequesterMiniBlocks + numRequesterMetaBlockHeaders +
-		numRequesterSCRs + numRequesterRewardTxs + numRequesterTrieNodes + numRequesterPeerAuth + numRequesterValidatorInfo
+		numRequesterSCRs + numRequesterRewardTxs + numRequesterTrieNodes + numRequesterTrieLeavesRanges + numRequesterPeerAuth +
+		numRequesterValidatorInfo
 
 	assert.Equal(t, totalRequesters, container.Len())
 }
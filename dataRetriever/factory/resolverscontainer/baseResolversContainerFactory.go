@@ -377,6 +377,48 @@ func (brcf *baseResolversContainerFactory) createTrieNodesResolver(
 	return resolver, nil
 }
 
+func (brcf *baseResolversContainerFactory) createTrieLeavesRangeResolver(
+	topic string,
+	trieId string,
+	targetShardID uint32,
+) (dataRetriever.Resolver, error) {
+	resolverSender, err := brcf.createOneResolverSenderWithSpecifiedNumRequests(
+		topic,
+		EmptyExcludePeersOnTopic,
+		targetShardID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	trie := brcf.triesContainer.Get([]byte(trieId))
+	argTrie := resolvers.ArgTrieLeavesRangeResolver{
+		ArgBaseResolver: resolvers.ArgBaseResolver{
+			SenderResolver:   resolverSender,
+			Marshaller:       brcf.marshalizer,
+			AntifloodHandler: brcf.inputAntifloodHandler,
+			Throttler:        brcf.trieNodesThrottler,
+		},
+		TrieLeavesRangeGetter: trie,
+	}
+	resolver, err := resolvers.NewTrieLeavesRangeResolver(argTrie)
+	if err != nil {
+		return nil, err
+	}
+
+	err = brcf.mainMessenger.RegisterMessageProcessor(resolver.RequestTopic(), common.DefaultResolversIdentifier, resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	err = brcf.fullArchiveMessenger.RegisterMessageProcessor(resolver.RequestTopic(), common.DefaultResolversIdentifier, resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolver, nil
+}
+
 func (brcf *baseResolversContainerFactory) generateValidatorInfoResolver() error {
 	identifierValidatorInfo := common.ValidatorInfoTopic
 	shardC := brcf.shardCoordinator
@@ -158,6 +158,19 @@ func (mrcf *metaResolversContainerFactory) AddShardTrieNodeResolvers(container d
 
 		resolversSlice = append(resolversSlice, resolver)
 		keys = append(keys, identifierTrieNodes)
+
+		identifierLeavesRange := factory.AccountTrieLeavesRangeTopic + shardC.CommunicationIdentifier(idx)
+		resolver, err = mrcf.createTrieLeavesRangeResolver(
+			identifierLeavesRange,
+			dataRetriever.UserAccountsUnit.String(),
+			idx,
+		)
+		if err != nil {
+			return err
+		}
+
+		resolversSlice = append(resolversSlice, resolver)
+		keys = append(keys, identifierLeavesRange)
 	}
 
 	return container.AddMultiple(keys, resolversSlice)
@@ -322,6 +335,19 @@ func (mrcf *metaResolversContainerFactory) generateTrieNodesResolvers() error {
 	resolversSlice = append(resolversSlice, resolver)
 	keys = append(keys, identifierTrieNodes)
 
+	identifierLeavesRange := factory.AccountTrieLeavesRangeTopic + core.CommunicationIdentifierBetweenShards(core.MetachainShardId, core.MetachainShardId)
+	resolver, err = mrcf.createTrieLeavesRangeResolver(
+		identifierLeavesRange,
+		dataRetriever.UserAccountsUnit.String(),
+		core.MetachainShardId,
+	)
+	if err != nil {
+		return err
+	}
+
+	resolversSlice = append(resolversSlice, resolver)
+	keys = append(keys, identifierLeavesRange)
+
 	identifierTrieNodes = factory.ValidatorTrieNodesTopic + core.CommunicationIdentifierBetweenShards(core.MetachainShardId, core.MetachainShardId)
 	resolver, err = mrcf.createTrieNodesResolver(
 		identifierTrieNodes,
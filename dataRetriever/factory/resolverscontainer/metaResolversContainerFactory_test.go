@@ -336,10 +336,12 @@ func TestMetaResolversContainerFactory_With4ShardsShouldWork(t *testing.T) {
 	numResolversRewards := noOfShards
 	numResolversTxs := noOfShards + 1
 	numResolversTrieNodes := 2
+	numResolversTrieLeavesRanges := 1
 	numResolversPeerAuth := 1
 	numResolverValidatorInfo := 1
 	totalResolvers := numResolversShardHeadersForMetachain + numResolverMetablocks + numResolversMiniBlocks +
-		numResolversUnsigned + numResolversTxs + numResolversTrieNodes + numResolversRewards + numResolversPeerAuth + numResolverValidatorInfo
+		numResolversUnsigned + numResolversTxs + numResolversTrieNodes + numResolversTrieLeavesRanges + numResolversRewards +
+		numResolversPeerAuth + numResolverValidatorInfo
 
 	assert.Equal(t, totalResolvers, container.Len())
 	assert.Equal(t, totalResolvers, registerMainCnt)
@@ -347,7 +349,7 @@ func TestMetaResolversContainerFactory_With4ShardsShouldWork(t *testing.T) {
 
 	err := rcf.AddShardTrieNodeResolvers(container)
 	assert.Nil(t, err)
-	assert.Equal(t, totalResolvers+noOfShards, container.Len())
+	assert.Equal(t, totalResolvers+2*noOfShards, container.Len())
 }
 
 func TestMetaResolversContainerFactory_IsInterfaceNil(t *testing.T) {
@@ -259,6 +259,19 @@ func (srcf *shardResolversContainerFactory) generateTrieNodesResolvers() error {
 	resolversSlice = append(resolversSlice, resolver)
 	keys = append(keys, identifierTrieNodes)
 
+	identifierLeavesRange := factory.AccountTrieLeavesRangeTopic + shardC.CommunicationIdentifier(core.MetachainShardId)
+	resolver, err = srcf.createTrieLeavesRangeResolver(
+		identifierLeavesRange,
+		dataRetriever.UserAccountsUnit.String(),
+		core.MetachainShardId,
+	)
+	if err != nil {
+		return err
+	}
+
+	resolversSlice = append(resolversSlice, resolver)
+	keys = append(keys, identifierLeavesRange)
+
 	return srcf.container.AddMultiple(keys, resolversSlice)
 }
 
@@ -234,6 +234,7 @@ type PoolsHolder interface {
 	PeerChangesBlocks() storage.Cacher
 	TrieNodes() storage.Cacher
 	TrieNodesChunks() storage.Cacher
+	TrieLeavesRanges() storage.Cacher
 	SmartContracts() storage.Cacher
 	CurrentBlockTxs() TransactionCacher
 	CurrentEpochValidatorInfo() ValidatorInfoCacher
@@ -285,6 +286,12 @@ type TrieDataGetter interface {
 	IsInterfaceNil() bool
 }
 
+// TrieLeavesRangeGetter returns ranges of leaves from the trie, together with their boundary proofs
+type TrieLeavesRangeGetter interface {
+	GetSerializedLeavesRange(rootHash []byte, startKey []byte, maxBuffToSend uint64) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
 // RequestedItemsHandler can determine if a certain key has or not been requested
 type RequestedItemsHandler interface {
 	Add(key string) error
@@ -43,6 +43,8 @@ const (
 	EpochType RequestDataType = 4
 	// ChunkType indicates that the request data object is of type chunk
 	ChunkType RequestDataType = 5
+	// LeavesRangeType indicates that the request data object contains a serialised leaves range request
+	LeavesRangeType RequestDataType = 6
 )
 
 var RequestDataType_name = map[int32]string{
@@ -52,15 +54,17 @@ var RequestDataType_name = map[int32]string{
 	3: "NonceType",
 	4: "EpochType",
 	5: "ChunkType",
+	6: "LeavesRangeType",
 }
 
 var RequestDataType_value = map[string]int32{
-	"InvalidType":   0,
-	"HashType":      1,
-	"HashArrayType": 2,
-	"NonceType":     3,
-	"EpochType":     4,
-	"ChunkType":     5,
+	"InvalidType":     0,
+	"HashType":        1,
+	"HashArrayType":   2,
+	"NonceType":       3,
+	"EpochType":       4,
+	"ChunkType":       5,
+	"LeavesRangeType": 6,
 }
 
 func (RequestDataType) EnumDescriptor() ([]byte, []int) {
@@ -132,37 +136,90 @@ func (m *RequestData) GetChunkIndex() uint32 {
 	return 0
 }
 
+// LeavesRangeRequest holds the root hash of the trie and the key after which the requested leaves range starts
+type LeavesRangeRequest struct {
+	RootHash []byte `protobuf:"bytes,1,opt,name=RootHash,proto3" json:"rootHash"`
+	StartKey []byte `protobuf:"bytes,2,opt,name=StartKey,proto3" json:"startKey"`
+}
+
+func (m *LeavesRangeRequest) Reset()      { *m = LeavesRangeRequest{} }
+func (*LeavesRangeRequest) ProtoMessage() {}
+func (*LeavesRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2e280b7501d5666, []int{1}
+}
+func (m *LeavesRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeavesRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LeavesRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeavesRangeRequest.Merge(m, src)
+}
+func (m *LeavesRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeavesRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeavesRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeavesRangeRequest proto.InternalMessageInfo
+
+func (m *LeavesRangeRequest) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *LeavesRangeRequest) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("proto.RequestDataType", RequestDataType_name, RequestDataType_value)
 	proto.RegisterType((*RequestData)(nil), "proto.RequestData")
+	proto.RegisterType((*LeavesRangeRequest)(nil), "proto.LeavesRangeRequest")
 }
 
 func init() { proto.RegisterFile("requestData.proto", fileDescriptor_d2e280b7501d5666) }
 
 var fileDescriptor_d2e280b7501d5666 = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xb1, 0x4e, 0xc2, 0x40,
-	0x18, 0x80, 0x7b, 0xd0, 0x1a, 0x38, 0x28, 0xc8, 0x0d, 0xa6, 0x71, 0xf8, 0x4b, 0x9c, 0x88, 0x89,
-	0x25, 0x51, 0x5f, 0x40, 0xd4, 0x28, 0x8b, 0x43, 0x63, 0x1c, 0xdc, 0x8e, 0x72, 0x52, 0x22, 0xb6,
-	0xb5, 0x5c, 0x89, 0x6c, 0x3e, 0x82, 0x8f, 0xe1, 0x0b, 0xf8, 0x0e, 0x8e, 0x8c, 0x4c, 0x44, 0x8e,
-	0xc5, 0x30, 0xf1, 0x08, 0xe6, 0xfe, 0x26, 0x4a, 0x9c, 0xda, 0xef, 0xbb, 0xef, 0xee, 0xfe, 0x1c,
-	0x6d, 0xa4, 0xe2, 0x39, 0x13, 0x63, 0x79, 0xc1, 0x25, 0xf7, 0x92, 0x34, 0x96, 0x31, 0xb3, 0xf0,
-	0xb3, 0x7f, 0x34, 0x18, 0xca, 0x30, 0xeb, 0x79, 0x41, 0xfc, 0xd4, 0x1e, 0xc4, 0x83, 0xb8, 0x8d,
-	0xba, 0x97, 0x3d, 0x20, 0x21, 0xe0, 0x5f, 0xbe, 0xeb, 0xe0, 0x83, 0xd0, 0x8a, 0xff, 0x77, 0x16,
-	0x3b, 0xa5, 0xe6, 0xed, 0x34, 0x11, 0x0e, 0x69, 0x92, 0x56, 0xed, 0x78, 0x2f, 0xaf, 0xbc, 0xad,
-	0x42, 0xaf, 0x76, 0x4a, 0xeb, 0x85, 0x6b, 0xca, 0x69, 0x22, 0x7c, 0xac, 0x99, 0x4b, 0xad, 0x3b,
-	0x3e, 0xca, 0x84, 0x53, 0x68, 0x92, 0x56, 0xb5, 0x53, 0x5e, 0x2f, 0x5c, 0x6b, 0xa2, 0x85, 0x9f,
-	0x7b, 0x1d, 0x5c, 0x26, 0x71, 0x10, 0x3a, 0xc5, 0x26, 0x69, 0xd9, 0x79, 0x20, 0xb4, 0xf0, 0x73,
-	0xcf, 0x3c, 0x4a, 0xcf, 0xc3, 0x2c, 0x7a, 0xec, 0x46, 0x7d, 0xf1, 0xe2, 0x98, 0x58, 0xd5, 0xd6,
-	0x0b, 0x97, 0x06, 0xbf, 0xd6, 0xdf, 0x2a, 0x0e, 0x13, 0x5a, 0xff, 0x37, 0x14, 0xab, 0xd3, 0x4a,
-	0x37, 0x9a, 0xf0, 0xd1, 0xb0, 0xaf, 0x71, 0xd7, 0x60, 0x55, 0x5a, 0xba, 0xe6, 0xe3, 0x10, 0x89,
-	0xb0, 0x06, 0xb5, 0x35, 0x9d, 0xa5, 0x29, 0x9f, 0xa2, 0x2a, 0x30, 0x9b, 0x96, 0x6f, 0xe2, 0x28,
-	0x10, 0x88, 0x45, 0x8d, 0x38, 0x0c, 0xa2, 0xa9, 0x11, 0x2f, 0x44, 0xb4, 0x3a, 0x57, 0xb3, 0x25,
-	0x18, 0xf3, 0x25, 0x18, 0x9b, 0x25, 0x90, 0x57, 0x05, 0xe4, 0x5d, 0x01, 0xf9, 0x54, 0x40, 0x66,
-	0x0a, 0xc8, 0x5c, 0x01, 0xf9, 0x52, 0x40, 0xbe, 0x15, 0x18, 0x1b, 0x05, 0xe4, 0x6d, 0x05, 0xc6,
-	0x6c, 0x05, 0xc6, 0x7c, 0x05, 0xc6, 0xbd, 0xdd, 0xe7, 0x92, 0xfb, 0x42, 0xa6, 0x43, 0x31, 0x11,
-	0x69, 0x6f, 0x07, 0xdf, 0xf4, 0xe4, 0x27, 0x00, 0x00, 0xff, 0xff, 0xd7, 0xda, 0x08, 0x2e, 0xc4,
-	0x01, 0x00, 0x00,
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xb1, 0x8e, 0xd3, 0x30,
+	0x18, 0x80, 0xe3, 0x5e, 0x7b, 0xea, 0xf9, 0x92, 0x2b, 0x67, 0x24, 0x14, 0x31, 0x38, 0xd5, 0x4d,
+	0x11, 0x12, 0x39, 0x09, 0x78, 0x01, 0x02, 0x08, 0x4e, 0x20, 0x06, 0x83, 0x18, 0xd8, 0xdc, 0xd4,
+	0x24, 0x15, 0x47, 0x1c, 0x1c, 0x27, 0x22, 0x1b, 0x0b, 0x3b, 0x8f, 0xc1, 0x0b, 0xf0, 0x0e, 0x8c,
+	0x1d, 0x3b, 0x45, 0xd4, 0x5d, 0x50, 0xa6, 0x7b, 0x04, 0xe4, 0x3f, 0x55, 0x5b, 0xdd, 0x94, 0x7c,
+	0xdf, 0xff, 0xc5, 0xca, 0x2f, 0xe3, 0x73, 0x25, 0xbe, 0x56, 0xa2, 0xd4, 0xcf, 0xb9, 0xe6, 0x51,
+	0xa1, 0xa4, 0x96, 0x64, 0x04, 0x8f, 0xfb, 0x0f, 0xd3, 0x85, 0xce, 0xaa, 0x59, 0x94, 0xc8, 0x2f,
+	0x97, 0xa9, 0x4c, 0xe5, 0x25, 0xe8, 0x59, 0xf5, 0x09, 0x08, 0x00, 0xde, 0xfa, 0xaf, 0x2e, 0x7e,
+	0x23, 0x7c, 0xca, 0xf6, 0x67, 0x91, 0x27, 0x78, 0xf8, 0xbe, 0x29, 0x84, 0x8f, 0xa6, 0x28, 0x3c,
+	0x7b, 0x74, 0xaf, 0xaf, 0xa2, 0x83, 0xc2, 0x4e, 0xe3, 0x71, 0xd7, 0x06, 0x43, 0xdd, 0x14, 0x82,
+	0x41, 0x4d, 0x02, 0x3c, 0xfa, 0xc0, 0xaf, 0x2b, 0xe1, 0x0f, 0xa6, 0x28, 0x74, 0xe3, 0x93, 0xae,
+	0x0d, 0x46, 0xb5, 0x15, 0xac, 0xf7, 0x36, 0x78, 0x51, 0xc8, 0x24, 0xf3, 0x8f, 0xa6, 0x28, 0xf4,
+	0xfa, 0x40, 0x58, 0xc1, 0x7a, 0x4f, 0x22, 0x8c, 0x9f, 0x65, 0x55, 0xfe, 0xf9, 0x2a, 0x9f, 0x8b,
+	0x6f, 0xfe, 0x10, 0xaa, 0xb3, 0xae, 0x0d, 0x70, 0xb2, 0xb3, 0xec, 0xa0, 0xb8, 0xc8, 0x30, 0x79,
+	0x23, 0x78, 0x2d, 0x4a, 0xc6, 0xf3, 0x54, 0x6c, 0xff, 0x8f, 0x84, 0x78, 0xcc, 0xa4, 0xd4, 0xaf,
+	0x78, 0x99, 0xc1, 0x06, 0x6e, 0xec, 0x76, 0x6d, 0x30, 0x56, 0x5b, 0xc7, 0x76, 0x53, 0x5b, 0xbe,
+	0xd3, 0x5c, 0xe9, 0xd7, 0xa2, 0xf1, 0x07, 0xfb, 0xb2, 0xdc, 0x3a, 0xb6, 0x9b, 0x3e, 0xf8, 0x81,
+	0xf0, 0xe4, 0xd6, 0xfe, 0x64, 0x82, 0x4f, 0xaf, 0xf2, 0x9a, 0x5f, 0x2f, 0xe6, 0x16, 0xef, 0x38,
+	0xc4, 0xc5, 0x63, 0x7b, 0x2c, 0x10, 0x22, 0xe7, 0xd8, 0xb3, 0xf4, 0x54, 0x29, 0xde, 0x80, 0x1a,
+	0x10, 0x0f, 0x9f, 0xbc, 0x95, 0x79, 0x22, 0x00, 0x8f, 0x2c, 0xc2, 0xde, 0x80, 0x43, 0x8b, 0xb0,
+	0x1b, 0xe0, 0x88, 0xdc, 0xc5, 0x93, 0x83, 0xe5, 0x40, 0x1e, 0xc7, 0x2f, 0x97, 0x6b, 0xea, 0xac,
+	0xd6, 0xd4, 0xb9, 0x59, 0x53, 0xf4, 0xdd, 0x50, 0xf4, 0xcb, 0x50, 0xf4, 0xc7, 0x50, 0xb4, 0x34,
+	0x14, 0xad, 0x0c, 0x45, 0x7f, 0x0d, 0x45, 0xff, 0x0c, 0x75, 0x6e, 0x0c, 0x45, 0x3f, 0x37, 0xd4,
+	0x59, 0x6e, 0xa8, 0xb3, 0xda, 0x50, 0xe7, 0xa3, 0x37, 0xe7, 0x9a, 0x33, 0xa1, 0xd5, 0x42, 0xd4,
+	0x42, 0xcd, 0x8e, 0xe1, 0x4e, 0x1f, 0xff, 0x1f, 0x00, 0x49, 0xa1, 0x40, 0xc3, 0x44, 0x02, 0x00,
+	0x00,
 }
 
 func (x RequestDataType) String() string {
@@ -205,6 +262,33 @@ func (this *RequestData) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *LeavesRangeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LeavesRangeRequest)
+	if !ok {
+		that2, ok := that.(LeavesRangeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.RootHash, that1.RootHash) {
+		return false
+	}
+	if !bytes.Equal(this.StartKey, that1.StartKey) {
+		return false
+	}
+	return true
+}
 func (this *RequestData) GoString() string {
 	if this == nil {
 		return "nil"
@@ -218,6 +302,17 @@ func (this *RequestData) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *LeavesRangeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&dataRetriever.LeavesRangeRequest{")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "StartKey: "+fmt.Sprintf("%#v", this.StartKey)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringRequestData(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -271,6 +366,43 @@ func (m *RequestData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LeavesRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeavesRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeavesRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintRequestData(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintRequestData(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRequestData(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRequestData(v)
 	base := offset
@@ -304,6 +436,23 @@ func (m *RequestData) Size() (n int) {
 	return n
 }
 
+func (m *LeavesRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovRequestData(uint64(l))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovRequestData(uint64(l))
+	}
+	return n
+}
+
 func sovRequestData(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -323,6 +472,17 @@ func (this *RequestData) String() string {
 	}, "")
 	return s
 }
+func (this *LeavesRangeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LeavesRangeRequest{`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`StartKey:` + fmt.Sprintf("%v", this.StartKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringRequestData(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -475,6 +635,127 @@ func (m *RequestData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LeavesRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestData
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeavesRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeavesRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestData
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestData
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestData(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestData
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestData
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRequestData(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -23,6 +23,7 @@ func TestRequestDataType_StringVals(t *testing.T) {
 		{dataRetriever.HashArrayType, "HashArrayType"},
 		{dataRetriever.NonceType, "NonceType"},
 		{dataRetriever.EpochType, "EpochType"},
+		{dataRetriever.LeavesRangeType, "LeavesRangeType"},
 	}
 
 	for _, tc := range tcs {
@@ -36,10 +37,10 @@ func TestRequestDataType_StringVals(t *testing.T) {
 func TestRequestDataType_UnknownType(t *testing.T) {
 	t.Parallel()
 
-	var requestData dataRetriever.RequestDataType = 6
+	var requestData dataRetriever.RequestDataType = 7
 	rd := requestData.String()
 
-	assert.Equal(t, fmt.Sprintf("%d", 6), rd)
+	assert.Equal(t, fmt.Sprintf("%d", 7), rd)
 }
 
 func TestRequestData_UnmarshalNilMarshalizer(t *testing.T) {
@@ -26,3 +26,9 @@ type HeaderRequester interface {
 	NonceRequester
 	EpochRequester
 }
+
+// LeavesRangeRequester can request a range of trie leaves placed after a start key
+type LeavesRangeRequester interface {
+	RequestLeavesRange(rootHash []byte, startKey []byte) error
+	IsInterfaceNil() bool
+}
@@ -475,6 +475,48 @@ func (rrh *resolverRequestHandler) RequestTrieNodes(destShardID uint32, hashes [
 	rrh.trieHashesAccumulator = make(map[string]struct{})
 }
 
+// RequestTrieLeavesRange method asks for the range of trie leaves placed after the start key, from the trie found at
+// the root hash
+func (rrh *resolverRequestHandler) RequestTrieLeavesRange(destShardID uint32, rootHash []byte, startKey []byte, topic string) {
+	rrh.whiteList.Add([][]byte{rootHash})
+
+	log.Trace("requesting trie leaves range from network",
+		"topic", topic,
+		"shard", destShardID,
+		"root hash", rootHash,
+		"start key", startKey,
+	)
+
+	requester, err := rrh.requestersFinder.MetaCrossShardRequester(topic, destShardID)
+	if err != nil {
+		log.Error("requestersFinder.MetaCrossShardRequester",
+			"error", err.Error(),
+			"topic", topic,
+			"shard", destShardID,
+		)
+		return
+	}
+
+	leavesRangeRequester, ok := requester.(LeavesRangeRequester)
+	if !ok {
+		log.Warn("wrong assertion type when creating a trie leaves range requester")
+		return
+	}
+
+	go rrh.requestLeavesRange(rootHash, startKey, leavesRangeRequester)
+}
+
+func (rrh *resolverRequestHandler) requestLeavesRange(rootHash []byte, startKey []byte, requester LeavesRangeRequester) {
+	err := requester.RequestLeavesRange(rootHash, startKey)
+	if err != nil {
+		log.Debug("requestLeavesRange.RequestLeavesRange",
+			"error", err.Error(),
+			"root hash", rootHash,
+			"start key", startKey,
+		)
+	}
+}
+
 // CreateTrieNodeIdentifier returns the requested trie node identifier that will be whitelisted
 func (rrh *resolverRequestHandler) CreateTrieNodeIdentifier(requestHash []byte, chunkIndex uint32) []byte {
 	chunkBuffer := make([]byte, bytesInUint32)
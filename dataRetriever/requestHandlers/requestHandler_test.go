@@ -1187,6 +1187,98 @@ func TestRequestTrieNodes(t *testing.T) {
 	})
 }
 
+func TestResolverRequestHandler_RequestTrieLeavesRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedRootHash := []byte("root hash")
+		providedStartKey := []byte("start key")
+		chRequested := make(chan struct{})
+		requesterMock := &dataRetrieverMocks.LeavesRangeRequesterStub{
+			RequestLeavesRangeCalled: func(rootHash []byte, startKey []byte) error {
+				assert.Equal(t, providedRootHash, rootHash)
+				assert.Equal(t, providedStartKey, startKey)
+				chRequested <- struct{}{}
+				return nil
+			},
+		}
+
+		whitelistedKeys := make([][]byte, 0)
+		rrh, _ := NewResolverRequestHandler(
+			&dataRetrieverMocks.RequestersFinderStub{
+				MetaCrossShardRequesterCalled: func(baseTopic string, crossShard uint32) (dataRetriever.Requester, error) {
+					assert.Equal(t, "topic", baseTopic)
+					assert.Equal(t, uint32(1), crossShard)
+					return requesterMock, nil
+				},
+			},
+			&mock.RequestedItemsHandlerStub{},
+			&mock.WhiteListHandlerStub{
+				AddCalled: func(keys [][]byte) {
+					whitelistedKeys = append(whitelistedKeys, keys...)
+				},
+			},
+			1,
+			0,
+			time.Second,
+		)
+
+		rrh.RequestTrieLeavesRange(1, providedRootHash, providedStartKey, "topic")
+		select {
+		case <-chRequested:
+		case <-time.After(timeoutSendRequests):
+			assert.Fail(t, "timeout while waiting to call RequestLeavesRange")
+		}
+		assert.Equal(t, [][]byte{providedRootHash}, whitelistedKeys)
+	})
+	t.Run("requester not found should not panic", func(t *testing.T) {
+		t.Parallel()
+
+		called := false
+		rrh, _ := NewResolverRequestHandler(
+			&dataRetrieverMocks.RequestersFinderStub{
+				MetaCrossShardRequesterCalled: func(baseTopic string, shId uint32) (requester dataRetriever.Requester, err error) {
+					called = true
+					return nil, errors.New("test error")
+				},
+			},
+			&mock.RequestedItemsHandlerStub{},
+			&mock.WhiteListHandlerStub{},
+			1,
+			0,
+			time.Second,
+		)
+
+		rrh.RequestTrieLeavesRange(0, []byte("root hash"), nil, "topic")
+		assert.True(t, called)
+	})
+	t.Run("wrong requester type should not request", func(t *testing.T) {
+		t.Parallel()
+
+		rrh, _ := NewResolverRequestHandler(
+			&dataRetrieverMocks.RequestersFinderStub{
+				MetaCrossShardRequesterCalled: func(baseTopic string, shId uint32) (requester dataRetriever.Requester, err error) {
+					return &dataRetrieverMocks.HashSliceRequesterStub{
+						RequestDataFromHashArrayCalled: func(hashes [][]byte, epoch uint32) error {
+							require.Fail(t, "should have not been called")
+							return nil
+						},
+					}, nil
+				},
+			},
+			&mock.RequestedItemsHandlerStub{},
+			&mock.WhiteListHandlerStub{},
+			1,
+			0,
+			time.Second,
+		)
+
+		rrh.RequestTrieLeavesRange(0, []byte("root hash"), nil, "topic")
+	})
+}
+
 func TestResolverRequestHandler_RequestStartOfEpochMetaBlock(t *testing.T) {
 	t.Parallel()
 
@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-go/dataRetriever/mock"
 	"github.com/multiversx/mx-chain-go/dataRetriever/requestHandlers"
 	dataRetrieverStub "github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,6 +22,7 @@ const (
 	txRequester       requestHandlerType = "transactionRequester"
 	trieRequester     requestHandlerType = "trieNodeRequester"
 	vInfoRequester    requestHandlerType = "validatorInfoNodeRequester"
+	leavesRequester   requestHandlerType = "trieLeavesRangeRequester"
 )
 
 var expectedErr = errors.New("expected error")
@@ -32,6 +34,7 @@ func Test_Requesters(t *testing.T) {
 	testNewRequester(t, txRequester)
 	testNewRequester(t, trieRequester)
 	testNewRequester(t, vInfoRequester)
+	testNewRequester(t, leavesRequester)
 
 	testRequestDataFromHashArray(t, peerAuthRequester)
 	testRequestDataFromHashArray(t, mbRequester)
@@ -40,6 +43,8 @@ func Test_Requesters(t *testing.T) {
 	testRequestDataFromHashArray(t, vInfoRequester)
 
 	testRequestDataFromReferenceAndChunk(t, trieRequester)
+
+	testRequestLeavesRange(t, leavesRequester)
 }
 
 func testNewRequester(t *testing.T, requesterType requestHandlerType) {
@@ -135,6 +140,52 @@ func testRequestDataFromReferenceAndChunk(t *testing.T, requesterType requestHan
 	assert.True(t, wasCalled)
 }
 
+func testRequestLeavesRange(t *testing.T, requesterType requestHandlerType) {
+	t.Run("marshaller returns error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgBaseRequester()
+		args.Marshaller = &mock.MarshalizerStub{
+			MarshalCalled: func(obj interface{}) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		requester, err := getHandler(requesterType, args)
+		assert.Nil(t, err)
+		leavesRangeHandler, ok := requester.(requestHandlers.LeavesRangeRequester)
+		assert.True(t, ok)
+		assert.Equal(t, expectedErr, leavesRangeHandler.RequestLeavesRange([]byte("root hash"), nil))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedRootHash := []byte("root hash")
+		providedStartKey := []byte("start key")
+		args := createMockArgBaseRequester()
+		args.Marshaller = &marshallerMock.MarshalizerMock{}
+		wasCalled := false
+		args.RequestSender = &dataRetrieverStub.TopicRequestSenderStub{
+			SendOnRequestTopicCalled: func(rd *dataRetriever.RequestData, originalHashes [][]byte) error {
+				wasCalled = true
+				leavesRangeRequest := &dataRetriever.LeavesRangeRequest{}
+				err := args.Marshaller.Unmarshal(leavesRangeRequest, rd.Value)
+				assert.Nil(t, err)
+				assert.Equal(t, providedRootHash, leavesRangeRequest.RootHash)
+				assert.Equal(t, providedStartKey, leavesRangeRequest.StartKey)
+				assert.Equal(t, [][]byte{providedRootHash}, originalHashes)
+				assert.Equal(t, dataRetriever.LeavesRangeType, rd.Type)
+				return nil
+			},
+		}
+		requester, err := getHandler(requesterType, args)
+		assert.Nil(t, err)
+		leavesRangeHandler, ok := requester.(requestHandlers.LeavesRangeRequester)
+		assert.True(t, ok)
+		assert.Nil(t, leavesRangeHandler.RequestLeavesRange(providedRootHash, providedStartKey))
+		assert.True(t, wasCalled)
+	})
+}
+
 func getHandler(requesterType requestHandlerType, argsBase ArgBaseRequester) (check.NilInterfaceChecker, error) {
 	switch requesterType {
 	case peerAuthRequester:
@@ -147,6 +198,8 @@ func getHandler(requesterType requestHandlerType, argsBase ArgBaseRequester) (ch
 		return NewTrieNodeRequester(ArgTrieNodeRequester{argsBase})
 	case vInfoRequester:
 		return NewValidatorInfoRequester(ArgValidatorInfoRequester{argsBase})
+	case leavesRequester:
+		return NewTrieLeavesRangeRequester(ArgTrieLeavesRangeRequester{argsBase})
 	}
 	return nil, errors.New("invalid requester type")
 }
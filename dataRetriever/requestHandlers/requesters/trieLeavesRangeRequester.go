@@ -0,0 +1,51 @@
+package requesters
+
+import (
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+)
+
+// ArgTrieLeavesRangeRequester is the argument structure used to create a new trie leaves range requester instance
+type ArgTrieLeavesRangeRequester struct {
+	ArgBaseRequester
+}
+
+type trieLeavesRangeRequester struct {
+	*baseRequester
+}
+
+// NewTrieLeavesRangeRequester returns a new instance of trie leaves range requester
+func NewTrieLeavesRangeRequester(args ArgTrieLeavesRangeRequester) (*trieLeavesRangeRequester, error) {
+	err := checkArgBase(args.ArgBaseRequester)
+	if err != nil {
+		return nil, err
+	}
+
+	return &trieLeavesRangeRequester{
+		baseRequester: createBaseRequester(args.ArgBaseRequester),
+	}, nil
+}
+
+// RequestLeavesRange requests the range of leaves placed after the start key, from the trie found at the root hash
+func (requester *trieLeavesRangeRequester) RequestLeavesRange(rootHash []byte, startKey []byte) error {
+	leavesRangeRequest := &dataRetriever.LeavesRangeRequest{
+		RootHash: rootHash,
+		StartKey: startKey,
+	}
+	buff, err := requester.marshaller.Marshal(leavesRangeRequest)
+	if err != nil {
+		return err
+	}
+
+	return requester.SendOnRequestTopic(
+		&dataRetriever.RequestData{
+			Type:  dataRetriever.LeavesRangeType,
+			Value: buff,
+		},
+		[][]byte{rootHash},
+	)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (requester *trieLeavesRangeRequester) IsInterfaceNil() bool {
+	return requester == nil
+}
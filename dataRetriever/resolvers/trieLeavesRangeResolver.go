@@ -0,0 +1,112 @@
+package resolvers
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+var _ dataRetriever.Resolver = (*TrieLeavesRangeResolver)(nil)
+
+// ArgTrieLeavesRangeResolver is the argument structure used to create new TrieLeavesRangeResolver instance
+type ArgTrieLeavesRangeResolver struct {
+	ArgBaseResolver
+	TrieLeavesRangeGetter dataRetriever.TrieLeavesRangeGetter
+}
+
+// TrieLeavesRangeResolver is a wrapper over Resolver that is specialized in resolving trie leaves range requests
+type TrieLeavesRangeResolver struct {
+	*baseResolver
+	messageProcessor
+	trieLeavesRangeGetter dataRetriever.TrieLeavesRangeGetter
+}
+
+// NewTrieLeavesRangeResolver creates a new trie leaves range resolver
+func NewTrieLeavesRangeResolver(arg ArgTrieLeavesRangeResolver) (*TrieLeavesRangeResolver, error) {
+	err := checkArgTrieLeavesRangeResolver(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrieLeavesRangeResolver{
+		baseResolver: &baseResolver{
+			TopicResolverSender: arg.SenderResolver,
+		},
+		trieLeavesRangeGetter: arg.TrieLeavesRangeGetter,
+		messageProcessor: messageProcessor{
+			marshalizer:      arg.Marshaller,
+			antifloodHandler: arg.AntifloodHandler,
+			topic:            arg.SenderResolver.RequestTopic(),
+			throttler:        arg.Throttler,
+		},
+	}, nil
+}
+
+func checkArgTrieLeavesRangeResolver(arg ArgTrieLeavesRangeResolver) error {
+	err := checkArgBase(arg.ArgBaseResolver)
+	if err != nil {
+		return err
+	}
+	if check.IfNil(arg.TrieLeavesRangeGetter) {
+		return dataRetriever.ErrNilTrieDataGetter
+	}
+	return nil
+}
+
+// ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
+// (for the topic this validator was registered to, usually a request topic)
+func (tlrRes *TrieLeavesRangeResolver) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID, source p2p.MessageHandler) error {
+	err := tlrRes.canProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+
+	tlrRes.throttler.StartProcessing()
+	defer tlrRes.throttler.EndProcessing()
+
+	rd, err := tlrRes.parseReceivedMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+
+	switch rd.Type {
+	case dataRetriever.LeavesRangeType:
+		return tlrRes.resolveLeavesRange(rd.Value, message, source)
+	default:
+		return dataRetriever.ErrRequestTypeNotImplemented
+	}
+}
+
+func (tlrRes *TrieLeavesRangeResolver) resolveLeavesRange(requestBuff []byte, message p2p.MessageP2P, source p2p.MessageHandler) error {
+	leavesRangeRequest := &dataRetriever.LeavesRangeRequest{}
+	err := tlrRes.marshalizer.Unmarshal(leavesRangeRequest, requestBuff)
+	if err != nil {
+		return err
+	}
+
+	serializedRange, err := tlrRes.trieLeavesRangeGetter.GetSerializedLeavesRange(
+		leavesRangeRequest.RootHash,
+		leavesRangeRequest.StartKey,
+		core.MaxBufferSizeToSendTrieNodes,
+	)
+	if err != nil {
+		tlrRes.DebugHandler().LogFailedToResolveData(tlrRes.topic, leavesRangeRequest.RootHash, err)
+		return err
+	}
+
+	tlrRes.DebugHandler().LogSucceededToResolveData(tlrRes.topic, leavesRangeRequest.RootHash)
+
+	buff, err := tlrRes.marshalizer.Marshal(&batch.Batch{Data: [][]byte{serializedRange}})
+	if err != nil {
+		return err
+	}
+
+	return tlrRes.Send(buff, message.Peer(), source)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tlrRes *TrieLeavesRangeResolver) IsInterfaceNil() bool {
+	return tlrRes == nil
+}
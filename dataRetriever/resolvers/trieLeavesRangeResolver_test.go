@@ -0,0 +1,123 @@
+package resolvers_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dataRetriever/mock"
+	"github.com/multiversx/mx-chain-go/dataRetriever/resolvers"
+	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgTrieLeavesRangeResolver() resolvers.ArgTrieLeavesRangeResolver {
+	return resolvers.ArgTrieLeavesRangeResolver{
+		ArgBaseResolver:       createMockArgBaseResolver(),
+		TrieLeavesRangeGetter: &trieMock.TrieStub{},
+	}
+}
+
+func TestNewTrieLeavesRangeResolver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil resolver sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgTrieLeavesRangeResolver()
+		arg.SenderResolver = nil
+		res, err := resolvers.NewTrieLeavesRangeResolver(arg)
+		assert.Equal(t, dataRetriever.ErrNilResolverSender, err)
+		assert.True(t, check.IfNil(res))
+	})
+	t.Run("nil trie leaves range getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgTrieLeavesRangeResolver()
+		arg.TrieLeavesRangeGetter = nil
+		res, err := resolvers.NewTrieLeavesRangeResolver(arg)
+		assert.Equal(t, dataRetriever.ErrNilTrieDataGetter, err)
+		assert.True(t, check.IfNil(res))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := resolvers.NewTrieLeavesRangeResolver(createMockArgTrieLeavesRangeResolver())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(res))
+	})
+}
+
+func TestTrieLeavesRangeResolver_ProcessReceivedMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wrong request type should error", func(t *testing.T) {
+		t.Parallel()
+
+		res, _ := resolvers.NewTrieLeavesRangeResolver(createMockArgTrieLeavesRangeResolver())
+		err := res.ProcessReceivedMessage(createRequestMsg(dataRetriever.HashType, []byte("hash")), fromConnectedPeer, &p2pmocks.MessengerStub{})
+		assert.Equal(t, dataRetriever.ErrRequestTypeNotImplemented, err)
+	})
+	t.Run("getter error should not send", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgTrieLeavesRangeResolver()
+		arg.TrieLeavesRangeGetter = &trieMock.TrieStub{
+			GetSerializedLeavesRangeCalled: func(rootHash []byte, startKey []byte, maxBuffToSend uint64) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		arg.SenderResolver = &mock.TopicResolverSenderStub{
+			SendCalled: func(buff []byte, peer core.PeerID, source p2p.MessageHandler) error {
+				assert.Fail(t, "should have not called send")
+				return nil
+			},
+		}
+		res, _ := resolvers.NewTrieLeavesRangeResolver(arg)
+
+		requestBuff, _ := arg.Marshaller.Marshal(&dataRetriever.LeavesRangeRequest{RootHash: []byte("root hash")})
+		err := res.ProcessReceivedMessage(createRequestMsg(dataRetriever.LeavesRangeType, requestBuff), fromConnectedPeer, &p2pmocks.MessengerStub{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should send the serialized leaves range", func(t *testing.T) {
+		t.Parallel()
+
+		providedRootHash := []byte("root hash")
+		providedStartKey := []byte("start key")
+		serializedRange := []byte("serialized range")
+		arg := createMockArgTrieLeavesRangeResolver()
+		arg.TrieLeavesRangeGetter = &trieMock.TrieStub{
+			GetSerializedLeavesRangeCalled: func(rootHash []byte, startKey []byte, maxBuffToSend uint64) ([]byte, error) {
+				assert.Equal(t, providedRootHash, rootHash)
+				assert.Equal(t, providedStartKey, startKey)
+				assert.Equal(t, uint64(core.MaxBufferSizeToSendTrieNodes), maxBuffToSend)
+				return serializedRange, nil
+			},
+		}
+		sendWasCalled := false
+		arg.SenderResolver = &mock.TopicResolverSenderStub{
+			SendCalled: func(buff []byte, peer core.PeerID, source p2p.MessageHandler) error {
+				b := &batch.Batch{}
+				err := arg.Marshaller.Unmarshal(b, buff)
+				require.Nil(t, err)
+				assert.Equal(t, [][]byte{serializedRange}, b.Data)
+				sendWasCalled = true
+				return nil
+			},
+		}
+		res, _ := resolvers.NewTrieLeavesRangeResolver(arg)
+
+		requestBuff, _ := arg.Marshaller.Marshal(&dataRetriever.LeavesRangeRequest{
+			RootHash: providedRootHash,
+			StartKey: providedStartKey,
+		})
+		err := res.ProcessReceivedMessage(createRequestMsg(dataRetriever.LeavesRangeType, requestBuff), fromConnectedPeer, &p2pmocks.MessengerStub{})
+		assert.Nil(t, err)
+		assert.True(t, sendWasCalled)
+	})
+}
@@ -0,0 +1,43 @@
+package txpool
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const maxNumEvictionRecords = 100
+
+// evictionHistory holds the most recent evictions performed by the caches of the pool, oldest first
+type evictionHistory struct {
+	mutRecords sync.RWMutex
+	records    []common.TransactionsPoolEvictionRecord
+}
+
+func newEvictionHistory() *evictionHistory {
+	return &evictionHistory{
+		records: make([]common.TransactionsPoolEvictionRecord, 0, maxNumEvictionRecords),
+	}
+}
+
+func (history *evictionHistory) add(record common.TransactionsPoolEvictionRecord) {
+	history.mutRecords.Lock()
+	defer history.mutRecords.Unlock()
+
+	if len(history.records) == maxNumEvictionRecords {
+		copy(history.records, history.records[1:])
+		history.records = history.records[:maxNumEvictionRecords-1]
+	}
+
+	history.records = append(history.records, record)
+}
+
+func (history *evictionHistory) getAll() []common.TransactionsPoolEvictionRecord {
+	history.mutRecords.RLock()
+	defer history.mutRecords.RUnlock()
+
+	records := make([]common.TransactionsPoolEvictionRecord, len(history.records))
+	copy(records, history.records)
+
+	return records
+}
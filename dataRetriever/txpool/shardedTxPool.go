@@ -3,10 +3,12 @@ package txpool
 import (
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/counting"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
@@ -28,6 +30,7 @@ type shardedTxPool struct {
 	configPrototypeSourceMe      txcache.ConfigSourceMe
 	selfShardID                  uint32
 	txGasHandler                 txcache.TxGasHandler
+	evictionHistory              *evictionHistory
 }
 
 type txPoolShard struct {
@@ -78,6 +81,7 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		configPrototypeSourceMe:      configPrototypeSourceMe,
 		selfShardID:                  args.SelfShardID,
 		txGasHandler:                 args.TxGasHandler,
+		evictionHistory:              newEvictionHistory(),
 	}
 
 	return shardedTxPoolObject, nil
@@ -134,7 +138,7 @@ func (txPool *shardedTxPool) createTxCache(cacheID string) txCache {
 	if isForSenderMe {
 		config := txPool.configPrototypeSourceMe
 		config.Name = cacheID
-		cache, err := txcache.NewTxCache(config, txPool.txGasHandler)
+		cache, err := txcache.NewTxCacheWithEvictionHandler(config, txPool.txGasHandler, txPool.createEvictionHandler(cacheID))
 		if err != nil {
 			log.Error("shardedTxPool.createTxCache()", "err", err)
 			return txcache.NewDisabledCache()
@@ -154,6 +158,18 @@ func (txPool *shardedTxPool) createTxCache(cacheID string) txCache {
 	return cache
 }
 
+func (txPool *shardedTxPool) createEvictionHandler(cacheID string) txcache.EvictionHandler {
+	return func(event txcache.EvictionEvent) {
+		txPool.evictionHistory.add(common.TransactionsPoolEvictionRecord{
+			CacheID:           cacheID,
+			Timestamp:         time.Now().Unix(),
+			Reason:            event.Reason,
+			NumTxsEvicted:     event.NumTxsEvicted,
+			NumSendersEvicted: event.NumSendersEvicted,
+		})
+	}
+}
+
 // ImmunizeSetOfDataAgainstEviction marks the items as non-evictable
 func (txPool *shardedTxPool) ImmunizeSetOfDataAgainstEviction(keys [][]byte, cacheID string) {
 	shard := txPool.getOrCreateShard(cacheID)
@@ -341,6 +357,34 @@ func (txPool *shardedTxPool) Keys() [][]byte {
 	return keys
 }
 
+// ForEachTransactionInPool iterates over all the transactions in the pool, without copying them, and calls the
+// provided handler for each of them
+func (txPool *shardedTxPool) ForEachTransactionInPool(handler func(cacheID string, tx *txcache.WrappedTransaction)) {
+	if handler == nil {
+		return
+	}
+
+	txPool.mutexBackingMap.RLock()
+	shards := make([]*txPoolShard, 0, len(txPool.backingMap))
+	for _, shard := range txPool.backingMap {
+		shards = append(shards, shard)
+	}
+	txPool.mutexBackingMap.RUnlock()
+
+	for _, shard := range shards {
+		cacheID := shard.CacheID
+		shard.Cache.ForEachTransaction(func(_ []byte, tx *txcache.WrappedTransaction) {
+			handler(cacheID, tx)
+		})
+	}
+}
+
+// GetEvictionHistory returns the most recent evictions performed by the caches holding transactions from self shard,
+// oldest first
+func (txPool *shardedTxPool) GetEvictionHistory() []common.TransactionsPoolEvictionRecord {
+	return txPool.evictionHistory.getAll()
+}
+
 // Diagnose diagnoses the internal caches
 func (txPool *shardedTxPool) Diagnose(deep bool) {
 	log.Trace("shardedTxPool.Diagnose()", "counts", txPool.GetCounts().String())
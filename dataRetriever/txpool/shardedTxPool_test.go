@@ -10,8 +10,10 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
 	"github.com/multiversx/mx-chain-go/testscommon/txcachemocks"
 	"github.com/stretchr/testify/require"
 )
@@ -354,6 +356,78 @@ func Test_Keys(t *testing.T) {
 	require.ElementsMatch(t, txsHashes, pool.Keys())
 }
 
+func TestShardedTxPool_ForEachTransactionInPool(t *testing.T) {
+	t.Parallel()
+
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+
+	t.Run("nil handler should not panic", func(t *testing.T) {
+		require.NotPanics(t, func() {
+			pool.ForEachTransactionInPool(nil)
+		})
+	})
+
+	pool.AddData([]byte("hash-x"), createTx("alice", 42), 0, "0")
+	pool.AddData([]byte("hash-y"), createTx("alice", 43), 0, "0")
+	pool.AddData([]byte("hash-z"), createTx("bob", 7), 0, "1_0")
+
+	visited := make(map[string]string)
+	pool.ForEachTransactionInPool(func(cacheID string, tx *txcache.WrappedTransaction) {
+		visited[string(tx.TxHash)] = cacheID
+	})
+
+	expected := map[string]string{
+		"hash-x": "0",
+		"hash-y": "0",
+		"hash-z": "1_0",
+	}
+	require.Equal(t, expected, visited)
+}
+
+func TestShardedTxPool_GetEvictionHistory(t *testing.T) {
+	t.Parallel()
+
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	require.Empty(t, pool.GetEvictionHistory())
+
+	// count threshold for the cache of self shard is 50 (half of the capacity)
+	for i := 0; i < 60; i++ {
+		pool.AddData([]byte(fmt.Sprintf("hash-%d", i)), createTx(fmt.Sprintf("sender-%d", i), 1), 100, "0")
+	}
+
+	history := pool.GetEvictionHistory()
+	require.NotEmpty(t, history)
+	for _, record := range history {
+		require.Equal(t, "0", record.CacheID)
+		require.Equal(t, txcache.EvictionReasonCapacity, record.Reason)
+		require.Greater(t, record.NumTxsEvicted, uint32(0))
+		require.Greater(t, record.NumSendersEvicted, uint32(0))
+	}
+
+	// caches of other shards are not tracked
+	numRecords := len(history)
+	for i := 0; i < 60; i++ {
+		pool.AddData([]byte(fmt.Sprintf("hash-cross-%d", i)), createTx(fmt.Sprintf("sender-%d", i), 1), 100, "1_0")
+	}
+	require.Len(t, pool.GetEvictionHistory(), numRecords)
+}
+
+func TestEvictionHistory_KeepsMostRecentRecords(t *testing.T) {
+	t.Parallel()
+
+	history := newEvictionHistory()
+	for i := 0; i < maxNumEvictionRecords+5; i++ {
+		history.add(common.TransactionsPoolEvictionRecord{Timestamp: int64(i)})
+	}
+
+	records := history.getAll()
+	require.Len(t, records, maxNumEvictionRecords)
+	require.Equal(t, int64(5), records[0].Timestamp)
+	require.Equal(t, int64(maxNumEvictionRecords+4), records[maxNumEvictionRecords-1].Timestamp)
+}
+
 func TestShardedTxPool_Diagnose(t *testing.T) {
 	t.Parallel()
 
@@ -55,6 +55,8 @@ func TestUnitType_String(t *testing.T) {
 	require.Equal(t, "PeerAccountsUnit", ut.String())
 	ut = ScheduledSCRsUnit
 	require.Equal(t, "ScheduledSCRsUnit", ut.String())
+	ut = AccountsHistoryUnit
+	require.Equal(t, "AccountsHistoryUnit", ut.String())
 
 	ut = 200
 	require.Equal(t, "ShardHdrNonceHashDataUnit100", ut.String())
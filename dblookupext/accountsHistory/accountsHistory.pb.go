@@ -0,0 +1,1293 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: accountsHistory.proto
+
+package accountsHistory
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_multiversx_mx_chain_core_go_data "github.com/multiversx/mx-chain-core-go/data"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccountHistoryEntry holds the balance and the nonce of an account after the block that altered it
+type AccountHistoryEntry struct {
+	BlockNonce uint64        `protobuf:"varint,1,opt,name=BlockNonce,proto3" json:"blockNonce"`
+	BlockHash  []byte        `protobuf:"bytes,2,opt,name=BlockHash,proto3" json:"blockHash"`
+	Balance    *math_big.Int `protobuf:"bytes,3,opt,name=Balance,proto3,casttypewith=math/big.Int;github.com/multiversx/mx-chain-core-go/data.BigIntCaster" json:"balance"`
+	Nonce      uint64        `protobuf:"varint,4,opt,name=Nonce,proto3" json:"nonce"`
+}
+
+func (m *AccountHistoryEntry) Reset()      { *m = AccountHistoryEntry{} }
+func (*AccountHistoryEntry) ProtoMessage() {}
+func (*AccountHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dbb0ab661eecdc9, []int{0}
+}
+func (m *AccountHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountHistoryEntry.Merge(m, src)
+}
+func (m *AccountHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountHistoryEntry proto.InternalMessageInfo
+
+func (m *AccountHistoryEntry) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *AccountHistoryEntry) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *AccountHistoryEntry) GetBalance() *math_big.Int {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+func (m *AccountHistoryEntry) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// AccountHistoryChunk holds, in ascending order of the block nonce, the history entries of an account for a range of
+// blocks, together with the index of the previous chunk holding entries of the same account
+type AccountHistoryChunk struct {
+	Entries          []*AccountHistoryEntry `protobuf:"bytes,1,rep,name=Entries,proto3" json:"entries"`
+	PreviousChunk    uint64                 `protobuf:"varint,2,opt,name=PreviousChunk,proto3" json:"previousChunk"`
+	HasPreviousChunk bool                   `protobuf:"varint,3,opt,name=HasPreviousChunk,proto3" json:"hasPreviousChunk"`
+}
+
+func (m *AccountHistoryChunk) Reset()      { *m = AccountHistoryChunk{} }
+func (*AccountHistoryChunk) ProtoMessage() {}
+func (*AccountHistoryChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dbb0ab661eecdc9, []int{1}
+}
+func (m *AccountHistoryChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountHistoryChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountHistoryChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountHistoryChunk.Merge(m, src)
+}
+func (m *AccountHistoryChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountHistoryChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountHistoryChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountHistoryChunk proto.InternalMessageInfo
+
+func (m *AccountHistoryChunk) GetEntries() []*AccountHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *AccountHistoryChunk) GetPreviousChunk() uint64 {
+	if m != nil {
+		return m.PreviousChunk
+	}
+	return 0
+}
+
+func (m *AccountHistoryChunk) GetHasPreviousChunk() bool {
+	if m != nil {
+		return m.HasPreviousChunk
+	}
+	return false
+}
+
+// AccountHistoryHead holds the index of the most recent chunk holding history entries of an account
+type AccountHistoryHead struct {
+	LastChunk uint64 `protobuf:"varint,1,opt,name=LastChunk,proto3" json:"lastChunk"`
+}
+
+func (m *AccountHistoryHead) Reset()      { *m = AccountHistoryHead{} }
+func (*AccountHistoryHead) ProtoMessage() {}
+func (*AccountHistoryHead) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dbb0ab661eecdc9, []int{2}
+}
+func (m *AccountHistoryHead) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountHistoryHead) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountHistoryHead) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountHistoryHead.Merge(m, src)
+}
+func (m *AccountHistoryHead) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountHistoryHead) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountHistoryHead.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountHistoryHead proto.InternalMessageInfo
+
+func (m *AccountHistoryHead) GetLastChunk() uint64 {
+	if m != nil {
+		return m.LastChunk
+	}
+	return 0
+}
+
+// BlockAlteredAccounts holds the accounts altered by a block, used when the block is reverted
+type BlockAlteredAccounts struct {
+	Addresses [][]byte `protobuf:"bytes,1,rep,name=Addresses,proto3" json:"addresses"`
+}
+
+func (m *BlockAlteredAccounts) Reset()      { *m = BlockAlteredAccounts{} }
+func (*BlockAlteredAccounts) ProtoMessage() {}
+func (*BlockAlteredAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dbb0ab661eecdc9, []int{3}
+}
+func (m *BlockAlteredAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockAlteredAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockAlteredAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockAlteredAccounts.Merge(m, src)
+}
+func (m *BlockAlteredAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockAlteredAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockAlteredAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockAlteredAccounts proto.InternalMessageInfo
+
+func (m *BlockAlteredAccounts) GetAddresses() [][]byte {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AccountHistoryEntry)(nil), "proto.AccountHistoryEntry")
+	proto.RegisterType((*AccountHistoryChunk)(nil), "proto.AccountHistoryChunk")
+	proto.RegisterType((*AccountHistoryHead)(nil), "proto.AccountHistoryHead")
+	proto.RegisterType((*BlockAlteredAccounts)(nil), "proto.BlockAlteredAccounts")
+}
+
+func init() { proto.RegisterFile("accountsHistory.proto", fileDescriptor_2dbb0ab661eecdc9) }
+
+var fileDescriptor_2dbb0ab661eecdc9 = []byte{
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4f, 0x8b, 0xd3, 0x40,
+	0x1c, 0xcd, 0xec, 0xb6, 0xd6, 0xce, 0x5a, 0x5d, 0xe3, 0x0a, 0x65, 0x0f, 0x93, 0xd2, 0x53, 0x41,
+	0x9a, 0x80, 0x1e, 0x3c, 0x78, 0x31, 0x29, 0x0b, 0x2d, 0x88, 0x48, 0xf0, 0xe4, 0x6d, 0x92, 0x8c,
+	0x49, 0xd8, 0x74, 0x66, 0x99, 0x99, 0x2c, 0xbb, 0x37, 0xc1, 0x2f, 0xe0, 0xc7, 0x10, 0x3f, 0x89,
+	0xc7, 0x82, 0x97, 0x9e, 0xa2, 0x4d, 0x2f, 0x92, 0xd3, 0x7e, 0x04, 0xc9, 0x4c, 0x63, 0xff, 0xe8,
+	0xa9, 0xfd, 0xbd, 0xdf, 0xfb, 0xbd, 0xe4, 0xbd, 0x17, 0xf8, 0x14, 0x87, 0x21, 0xcb, 0xa9, 0x14,
+	0xd3, 0x54, 0x48, 0xc6, 0x6f, 0xed, 0x2b, 0xce, 0x24, 0x33, 0xdb, 0xea, 0xe7, 0x7c, 0x1c, 0xa7,
+	0x32, 0xc9, 0x03, 0x3b, 0x64, 0x73, 0x27, 0x66, 0x31, 0x73, 0x14, 0x1c, 0xe4, 0x1f, 0xd5, 0xa4,
+	0x06, 0xf5, 0x4f, 0x5f, 0x0d, 0x3f, 0x1f, 0xc1, 0x27, 0xae, 0xd6, 0xdb, 0xc8, 0x5d, 0x50, 0xc9,
+	0x6f, 0x4d, 0x1b, 0x42, 0x2f, 0x63, 0xe1, 0xe5, 0x5b, 0x46, 0x43, 0xd2, 0x07, 0x03, 0x30, 0x6a,
+	0x79, 0x0f, 0xab, 0xc2, 0x82, 0xc1, 0x5f, 0xd4, 0xdf, 0x61, 0x98, 0xcf, 0x60, 0x57, 0x4d, 0x53,
+	0x2c, 0x92, 0xfe, 0xd1, 0x00, 0x8c, 0x1e, 0x78, 0xbd, 0xaa, 0xb0, 0xba, 0x41, 0x03, 0xfa, 0xdb,
+	0xbd, 0x49, 0x61, 0xc7, 0xc3, 0x19, 0xae, 0x95, 0x8f, 0x15, 0xf5, 0x7d, 0x55, 0x58, 0x9d, 0x40,
+	0x43, 0xdf, 0x7e, 0x5a, 0x17, 0x73, 0x2c, 0x13, 0x27, 0x48, 0x63, 0x7b, 0x46, 0xe5, 0xab, 0x1d,
+	0x43, 0xf3, 0x3c, 0x93, 0xe9, 0x35, 0xe1, 0xe2, 0xc6, 0x99, 0xdf, 0x8c, 0xc3, 0x04, 0xa7, 0x74,
+	0x1c, 0x32, 0x4e, 0xc6, 0x31, 0x73, 0x22, 0x2c, 0xb1, 0xed, 0xa5, 0xf1, 0x8c, 0xca, 0x09, 0x16,
+	0x92, 0x70, 0xbf, 0x79, 0x88, 0x69, 0xc1, 0xb6, 0xf6, 0xd1, 0x52, 0x3e, 0xba, 0x55, 0x61, 0xb5,
+	0xa9, 0xb2, 0xa0, 0xf1, 0xe1, 0x0f, 0x70, 0x98, 0xc2, 0x24, 0xc9, 0xe9, 0xa5, 0xe9, 0xc2, 0x4e,
+	0x1d, 0x47, 0x4a, 0x44, 0x1f, 0x0c, 0x8e, 0x47, 0x27, 0xcf, 0xcf, 0x75, 0x6c, 0xf6, 0x7f, 0x22,
+	0xf3, 0x4e, 0x6a, 0x13, 0x44, 0xd3, 0xfd, 0xe6, 0xce, 0x7c, 0x09, 0x7b, 0xef, 0x38, 0xb9, 0x4e,
+	0x59, 0x2e, 0x94, 0xa6, 0x0a, 0xa7, 0xe5, 0x3d, 0xae, 0x0a, 0xab, 0x77, 0xb5, 0xbb, 0xf0, 0xf7,
+	0x79, 0xe6, 0x6b, 0x78, 0x3a, 0xc5, 0x62, 0xff, 0xb6, 0x4e, 0xeb, 0xbe, 0x77, 0x56, 0x15, 0xd6,
+	0x69, 0x72, 0xb0, 0xf3, 0xff, 0x61, 0x0f, 0x5d, 0x68, 0xee, 0xbf, 0xe7, 0x94, 0xe0, 0xa8, 0x6e,
+	0xea, 0x0d, 0x16, 0x52, 0x0b, 0xea, 0x62, 0x55, 0x53, 0x59, 0x03, 0xfa, 0xdb, 0xfd, 0x70, 0x02,
+	0xcf, 0x54, 0x6d, 0x6e, 0x26, 0x09, 0x27, 0xd1, 0x46, 0x4e, 0xd4, 0x22, 0x6e, 0x14, 0x71, 0x22,
+	0xc4, 0x26, 0x9a, 0x4d, 0xdd, 0xb8, 0x01, 0xfd, 0xed, 0xde, 0x9b, 0x2d, 0x56, 0xc8, 0x58, 0xae,
+	0x90, 0x71, 0xb7, 0x42, 0xe0, 0x53, 0x89, 0xc0, 0xd7, 0x12, 0x81, 0xef, 0x25, 0x02, 0x8b, 0x12,
+	0x81, 0x65, 0x89, 0xc0, 0xaf, 0x12, 0x81, 0xdf, 0x25, 0x32, 0xee, 0x4a, 0x04, 0xbe, 0xac, 0x91,
+	0xb1, 0x58, 0x23, 0x63, 0xb9, 0x46, 0xc6, 0x87, 0x47, 0x07, 0x9f, 0x7a, 0x70, 0x4f, 0xc5, 0xff,
+	0xe2, 0xcf, 0x00, 0x8f, 0x40, 0xb3, 0x01, 0x04, 0x03, 0x00, 0x00,
+}
+
+func (this *AccountHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountHistoryEntry)
+	if !ok {
+		that2, ok := that.(AccountHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		if !__caster.Equal(this.Balance, that1.Balance) {
+			return false
+		}
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	return true
+}
+func (this *AccountHistoryChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountHistoryChunk)
+	if !ok {
+		that2, ok := that.(AccountHistoryChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return false
+		}
+	}
+	if this.PreviousChunk != that1.PreviousChunk {
+		return false
+	}
+	if this.HasPreviousChunk != that1.HasPreviousChunk {
+		return false
+	}
+	return true
+}
+func (this *AccountHistoryHead) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountHistoryHead)
+	if !ok {
+		that2, ok := that.(AccountHistoryHead)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LastChunk != that1.LastChunk {
+		return false
+	}
+	return true
+}
+func (this *BlockAlteredAccounts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockAlteredAccounts)
+	if !ok {
+		that2, ok := that.(BlockAlteredAccounts)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if !bytes.Equal(this.Addresses[i], that1.Addresses[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AccountHistoryEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&accountsHistory.AccountHistoryEntry{")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountHistoryChunk) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&accountsHistory.AccountHistoryChunk{")
+	if this.Entries != nil {
+		s = append(s, "Entries: "+fmt.Sprintf("%#v", this.Entries)+",\n")
+	}
+	s = append(s, "PreviousChunk: "+fmt.Sprintf("%#v", this.PreviousChunk)+",\n")
+	s = append(s, "HasPreviousChunk: "+fmt.Sprintf("%#v", this.HasPreviousChunk)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountHistoryHead) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&accountsHistory.AccountHistoryHead{")
+	s = append(s, "LastChunk: "+fmt.Sprintf("%#v", this.LastChunk)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockAlteredAccounts) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&accountsHistory.BlockAlteredAccounts{")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAccountsHistory(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AccountHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintAccountsHistory(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		size := __caster.Size(m.Balance)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Balance, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccountsHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintAccountsHistory(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintAccountsHistory(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountHistoryChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountHistoryChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountHistoryChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasPreviousChunk {
+		i--
+		if m.HasPreviousChunk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PreviousChunk != 0 {
+		i = encodeVarintAccountsHistory(dAtA, i, uint64(m.PreviousChunk))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccountsHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountHistoryHead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountHistoryHead) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountHistoryHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastChunk != 0 {
+		i = encodeVarintAccountsHistory(dAtA, i, uint64(m.LastChunk))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockAlteredAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockAlteredAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockAlteredAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintAccountsHistory(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccountsHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccountsHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockNonce != 0 {
+		n += 1 + sovAccountsHistory(uint64(m.BlockNonce))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovAccountsHistory(uint64(l))
+	}
+	{
+		__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+		l = __caster.Size(m.Balance)
+		n += 1 + l + sovAccountsHistory(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovAccountsHistory(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *AccountHistoryChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovAccountsHistory(uint64(l))
+		}
+	}
+	if m.PreviousChunk != 0 {
+		n += 1 + sovAccountsHistory(uint64(m.PreviousChunk))
+	}
+	if m.HasPreviousChunk {
+		n += 2
+	}
+	return n
+}
+
+func (m *AccountHistoryHead) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastChunk != 0 {
+		n += 1 + sovAccountsHistory(uint64(m.LastChunk))
+	}
+	return n
+}
+
+func (m *BlockAlteredAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovAccountsHistory(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAccountsHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccountsHistory(x uint64) (n int) {
+	return sovAccountsHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AccountHistoryEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountHistoryEntry{`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountHistoryChunk) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEntries := "[]*AccountHistoryEntry{"
+	for _, f := range this.Entries {
+		repeatedStringForEntries += strings.Replace(f.String(), "AccountHistoryEntry", "AccountHistoryEntry", 1) + ","
+	}
+	repeatedStringForEntries += "}"
+	s := strings.Join([]string{`&AccountHistoryChunk{`,
+		`Entries:` + repeatedStringForEntries + `,`,
+		`PreviousChunk:` + fmt.Sprintf("%v", this.PreviousChunk) + `,`,
+		`HasPreviousChunk:` + fmt.Sprintf("%v", this.HasPreviousChunk) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountHistoryHead) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountHistoryHead{`,
+		`LastChunk:` + fmt.Sprintf("%v", this.LastChunk) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockAlteredAccounts) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockAlteredAccounts{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAccountsHistory(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AccountHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountsHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_multiversx_mx_chain_core_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Balance = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountsHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountHistoryChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountsHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountHistoryChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountHistoryChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &AccountHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousChunk", wireType)
+			}
+			m.PreviousChunk = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousChunk |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPreviousChunk", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasPreviousChunk = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountsHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountHistoryHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountsHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountHistoryHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountHistoryHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastChunk", wireType)
+			}
+			m.LastChunk = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastChunk |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountsHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockAlteredAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccountsHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockAlteredAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockAlteredAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccountsHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccountsHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAccountsHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccountsHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccountsHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountsHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccountsHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccountsHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccountsHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccountsHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccountsHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccountsHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccountsHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,446 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. accountsHistory.proto
+
+package accountsHistory
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("dblookupext/accountsHistory")
+
+// numBlocksPerChunk is the number of consecutive block nonces whose history entries of an account are kept together
+const numBlocksPerChunk = 1000
+
+// jobsQueueSize is the number of blocks which can wait to be recorded. When the queue is full, the committing of the
+// blocks waits for the recording, so that the root hashes are not pruned before being compared
+const jobsQueueSize = 10
+
+// ArgsAccountsHistoryProcessor holds the arguments needed to create an accounts history processor
+type ArgsAccountsHistoryProcessor struct {
+	Marshalizer           marshal.Marshalizer
+	AccountsHistoryStorer storage.Storer
+	Store                 dataRetriever.StorageService
+	Accounts              state.AccountsAdapter
+}
+
+// AccountHistoryPage holds a page of the history entries of an account, in descending order of the block nonce
+type AccountHistoryPage struct {
+	Entries []*AccountHistoryEntry
+	HasMore bool
+}
+
+type committedBlock struct {
+	hash     []byte
+	rootHash []byte
+	epoch    uint32
+}
+
+type accountsHistoryProcessor struct {
+	historyStorage *historyStorage
+	marshalizer    marshal.Marshalizer
+	store          dataRetriever.StorageService
+	accounts       state.AccountsAdapter
+	lastBlock      *committedBlock
+	mutex          sync.RWMutex
+
+	jobs      chan func()
+	jobsWg    sync.WaitGroup
+	isClosed  bool
+	mutClosed sync.RWMutex
+}
+
+// NewAccountsHistoryProcessor will create a new instance of the accounts history processor
+func NewAccountsHistoryProcessor(args ArgsAccountsHistoryProcessor) (*accountsHistoryProcessor, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.AccountsHistoryStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.Store) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	ahp := &accountsHistoryProcessor{
+		historyStorage: newHistoryStorage(args.Marshalizer, args.AccountsHistoryStorer),
+		marshalizer:    args.Marshalizer,
+		store:          args.Store,
+		accounts:       args.Accounts,
+		jobs:           make(chan func(), jobsQueueSize),
+	}
+
+	ahp.jobsWg.Add(1)
+	go ahp.processJobs()
+
+	return ahp, nil
+}
+
+func (ahp *accountsHistoryProcessor) processJobs() {
+	defer ahp.jobsWg.Done()
+
+	for job := range ahp.jobs {
+		job()
+	}
+}
+
+func (ahp *accountsHistoryProcessor) enqueueJob(job func()) error {
+	ahp.mutClosed.RLock()
+	defer ahp.mutClosed.RUnlock()
+
+	if ahp.isClosed {
+		return ErrAccountsHistoryClosed
+	}
+
+	ahp.jobs <- job
+
+	return nil
+}
+
+// ProcessBlock enqueues the recording of the balance and the nonce of the accounts altered by the provided committed
+// block. The altered accounts are the ones which differ between the root hash of the previous block and the root hash
+// of the provided block. An account is recorded only if its balance or its nonce differ from its previous history entry
+func (ahp *accountsHistoryProcessor) ProcessBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler) error {
+	if check.IfNil(blockHeader) {
+		return process.ErrNilBlockHeader
+	}
+
+	return ahp.enqueueJob(func() {
+		err := ahp.processBlock(blockHeaderHash, blockHeader)
+		if err != nil {
+			log.Warn("accountsHistoryProcessor: cannot record block",
+				"nonce", blockHeader.GetNonce(),
+				"hash", blockHeaderHash,
+				"error", err)
+		}
+	})
+}
+
+func (ahp *accountsHistoryProcessor) processBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler) error {
+	ahp.mutex.Lock()
+	defer ahp.mutex.Unlock()
+
+	blockNonce := blockHeader.GetNonce()
+	// a block with the same nonce might have been recorded on a different fork
+	err := ahp.revertBlockNonce(blockNonce)
+	if err != nil {
+		return err
+	}
+
+	previousBlock, err := ahp.getPreviousBlock(blockHeader)
+	if err != nil {
+		return err
+	}
+
+	currentBlock := &committedBlock{
+		hash:     blockHeaderHash,
+		rootHash: blockHeader.GetRootHash(),
+		epoch:    blockHeader.GetEpoch(),
+	}
+	accountsDiff, err := ahp.accounts.GetAccountsDiff(
+		context.Background(),
+		createRootHashHolder(previousBlock),
+		createRootHashHolder(currentBlock),
+		nil,
+		state.AccountsDiffOptions{SkipDataTrieChanges: true},
+	)
+	if err != nil {
+		return err
+	}
+	ahp.lastBlock = currentBlock
+
+	alteredAccounts := make([]*state.AccountDiff, 0, len(accountsDiff.Added)+len(accountsDiff.Modified))
+	alteredAccounts = append(alteredAccounts, accountsDiff.Added...)
+	alteredAccounts = append(alteredAccounts, accountsDiff.Modified...)
+
+	recordedAddresses := make([][]byte, 0, len(alteredAccounts))
+	for _, accountDiff := range alteredAccounts {
+		entry := &AccountHistoryEntry{
+			BlockNonce: blockNonce,
+			BlockHash:  blockHeaderHash,
+			Balance:    accountDiff.NewAccount.GetBalance(),
+			Nonce:      accountDiff.NewAccount.GetNonce(),
+		}
+
+		recorded, errAppend := ahp.appendEntry(accountDiff.Address, entry)
+		if errAppend != nil {
+			return errAppend
+		}
+		if recorded {
+			recordedAddresses = append(recordedAddresses, accountDiff.Address)
+		}
+	}
+
+	log.Trace("accountsHistoryProcessor.processBlock",
+		"nonce", blockNonce,
+		"num altered accounts", len(alteredAccounts),
+		"num recorded addresses", len(recordedAddresses))
+
+	if len(recordedAddresses) == 0 {
+		return nil
+	}
+
+	return ahp.historyStorage.putBlockAlteredAccounts(blockNonce, &BlockAlteredAccounts{Addresses: recordedAddresses})
+}
+
+// getPreviousBlock returns the block the provided one was built on, loading its header from the storage if it was
+// not the last recorded block
+func (ahp *accountsHistoryProcessor) getPreviousBlock(blockHeader data.HeaderHandler) (*committedBlock, error) {
+	prevHash := blockHeader.GetPrevHash()
+	if ahp.lastBlock != nil && bytes.Equal(ahp.lastBlock.hash, prevHash) {
+		return ahp.lastBlock, nil
+	}
+
+	prevHeader, err := process.GetHeaderFromStorage(blockHeader.GetShardID(), prevHash, ahp.marshalizer, ahp.store)
+	if err != nil {
+		return nil, err
+	}
+
+	return &committedBlock{
+		hash:     prevHash,
+		rootHash: prevHeader.GetRootHash(),
+		epoch:    prevHeader.GetEpoch(),
+	}, nil
+}
+
+func createRootHashHolder(block *committedBlock) common.RootHashHolder {
+	return holders.NewRootHashHolder(block.rootHash, core.OptionalUint32{Value: block.epoch, HasValue: true})
+}
+
+func (ahp *accountsHistoryProcessor) appendEntry(address []byte, entry *AccountHistoryEntry) (bool, error) {
+	chunkIndex := entry.BlockNonce / numBlocksPerChunk
+	head, found, err := ahp.historyStorage.getHead(address)
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		err = ahp.historyStorage.putChunk(address, chunkIndex, &AccountHistoryChunk{Entries: []*AccountHistoryEntry{entry}})
+		if err != nil {
+			return false, err
+		}
+
+		return true, ahp.historyStorage.putHead(address, &AccountHistoryHead{LastChunk: chunkIndex})
+	}
+
+	lastChunk, err := ahp.historyStorage.getChunk(address, head.LastChunk)
+	if err != nil {
+		return false, err
+	}
+
+	lastEntry := lastChunk.Entries[len(lastChunk.Entries)-1]
+	if lastEntry.BlockNonce >= entry.BlockNonce {
+		log.Debug("accountsHistoryProcessor: account already has a newer history entry",
+			"address", address,
+			"last entry block nonce", lastEntry.BlockNonce,
+			"block nonce", entry.BlockNonce)
+		return false, nil
+	}
+	if hasSameState(lastEntry, entry) {
+		return false, nil
+	}
+
+	if head.LastChunk == chunkIndex {
+		lastChunk.Entries = append(lastChunk.Entries, entry)
+		return true, ahp.historyStorage.putChunk(address, chunkIndex, lastChunk)
+	}
+
+	newChunk := &AccountHistoryChunk{
+		Entries:          []*AccountHistoryEntry{entry},
+		PreviousChunk:    head.LastChunk,
+		HasPreviousChunk: true,
+	}
+	err = ahp.historyStorage.putChunk(address, chunkIndex, newChunk)
+	if err != nil {
+		return false, err
+	}
+
+	return true, ahp.historyStorage.putHead(address, &AccountHistoryHead{LastChunk: chunkIndex})
+}
+
+func hasSameState(lastEntry *AccountHistoryEntry, entry *AccountHistoryEntry) bool {
+	if lastEntry.Nonce != entry.Nonce {
+		return false
+	}
+	if lastEntry.Balance == nil || entry.Balance == nil {
+		return lastEntry.Balance == entry.Balance
+	}
+
+	return lastEntry.Balance.Cmp(entry.Balance) == 0
+}
+
+// RevertBlock enqueues the removal of the history entries recorded for the provided block
+func (ahp *accountsHistoryProcessor) RevertBlock(blockHeader data.HeaderHandler) error {
+	if check.IfNil(blockHeader) {
+		return nil
+	}
+
+	return ahp.enqueueJob(func() {
+		ahp.mutex.Lock()
+		defer ahp.mutex.Unlock()
+
+		err := ahp.revertBlockNonce(blockHeader.GetNonce())
+		if err != nil {
+			log.Warn("accountsHistoryProcessor: cannot revert block",
+				"nonce", blockHeader.GetNonce(),
+				"error", err)
+		}
+	})
+}
+
+func (ahp *accountsHistoryProcessor) revertBlockNonce(blockNonce uint64) error {
+	blockAlteredAccounts, found, err := ahp.historyStorage.getBlockAlteredAccounts(blockNonce)
+	if err != nil || !found {
+		return err
+	}
+
+	for _, address := range blockAlteredAccounts.Addresses {
+		err = ahp.removeLastEntry(address, blockNonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("accountsHistoryProcessor: reverted block",
+		"nonce", blockNonce,
+		"num addresses", len(blockAlteredAccounts.Addresses))
+
+	return ahp.historyStorage.removeBlockAlteredAccounts(blockNonce)
+}
+
+func (ahp *accountsHistoryProcessor) removeLastEntry(address []byte, blockNonce uint64) error {
+	head, found, err := ahp.historyStorage.getHead(address)
+	if err != nil || !found {
+		return err
+	}
+
+	lastChunk, err := ahp.historyStorage.getChunk(address, head.LastChunk)
+	if err != nil {
+		return err
+	}
+
+	lastEntry := lastChunk.Entries[len(lastChunk.Entries)-1]
+	if lastEntry.BlockNonce != blockNonce {
+		return nil
+	}
+
+	lastChunk.Entries = lastChunk.Entries[:len(lastChunk.Entries)-1]
+	if len(lastChunk.Entries) > 0 {
+		return ahp.historyStorage.putChunk(address, head.LastChunk, lastChunk)
+	}
+
+	err = ahp.historyStorage.removeChunk(address, head.LastChunk)
+	if err != nil {
+		return err
+	}
+	if !lastChunk.HasPreviousChunk {
+		return ahp.historyStorage.removeHead(address)
+	}
+
+	return ahp.historyStorage.putHead(address, &AccountHistoryHead{LastChunk: lastChunk.PreviousChunk})
+}
+
+// GetAccountHistory returns, in descending order of the block nonce, at most maxEntries history entries of the
+// account recorded for blocks with nonces between fromNonce and toNonce, inclusive. The first entry is the state of
+// the account at the block with the toNonce nonce
+func (ahp *accountsHistoryProcessor) GetAccountHistory(address []byte, fromNonce uint64, toNonce uint64, maxEntries int) (*AccountHistoryPage, error) {
+	if maxEntries <= 0 {
+		return nil, ErrInvalidMaxEntries
+	}
+
+	ahp.mutex.RLock()
+	defer ahp.mutex.RUnlock()
+
+	page := &AccountHistoryPage{
+		Entries: make([]*AccountHistoryEntry, 0),
+	}
+	head, found, err := ahp.historyStorage.getHead(address)
+	if err != nil || !found {
+		return page, err
+	}
+
+	chunk, err := ahp.getStartChunk(address, head, toNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		for i := len(chunk.Entries) - 1; i >= 0; i-- {
+			entry := chunk.Entries[i]
+			if entry.BlockNonce > toNonce {
+				continue
+			}
+			if entry.BlockNonce < fromNonce {
+				return page, nil
+			}
+			if len(page.Entries) == maxEntries {
+				page.HasMore = true
+				return page, nil
+			}
+
+			page.Entries = append(page.Entries, entry)
+		}
+
+		if !chunk.HasPreviousChunk {
+			return page, nil
+		}
+
+		chunk, err = ahp.historyStorage.getChunk(address, chunk.PreviousChunk)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// getStartChunk returns the chunk holding the toNonce nonce, if the account has such a chunk, so that the newer chunks
+// are not read. Otherwise, the chunks are walked starting from the last one
+func (ahp *accountsHistoryProcessor) getStartChunk(address []byte, head *AccountHistoryHead, toNonce uint64) (*AccountHistoryChunk, error) {
+	chunkIndex := toNonce / numBlocksPerChunk
+	if chunkIndex < head.LastChunk {
+		chunk, found, err := ahp.historyStorage.findChunk(address, chunkIndex)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return chunk, nil
+		}
+	}
+
+	return ahp.historyStorage.getChunk(address, head.LastChunk)
+}
+
+// Close stops accepting blocks and waits for the already enqueued ones to be recorded
+func (ahp *accountsHistoryProcessor) Close() error {
+	ahp.mutClosed.Lock()
+	defer ahp.mutClosed.Unlock()
+
+	if ahp.isClosed {
+		return nil
+	}
+
+	ahp.isClosed = true
+	close(ahp.jobs)
+	ahp.jobsWg.Wait()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ahp *accountsHistoryProcessor) IsInterfaceNil() bool {
+	return ahp == nil
+}
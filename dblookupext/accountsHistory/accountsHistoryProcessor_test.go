@@ -0,0 +1,554 @@
+package accountsHistory
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	alice = []byte("alice")
+	bob   = []byte("bob")
+	carol = []byte("carol")
+)
+
+// testContext simulates a chain whose blocks alter the accounts. The states of the accounts are kept by root hash,
+// while the headers are kept by hash, as they would be found in the storage
+type testContext struct {
+	processor   *accountsHistoryProcessor
+	states      map[string]map[string]*stateMock.AccountWrapMock
+	headers     map[string][]byte
+	blocks      []*block.Header
+	blockHashes [][]byte
+}
+
+func createTestContext(t *testing.T) *testContext {
+	tc := &testContext{
+		states:  make(map[string]map[string]*stateMock.AccountWrapMock),
+		headers: make(map[string][]byte),
+	}
+	genesisState := make(map[string]*stateMock.AccountWrapMock)
+	for _, address := range [][]byte{alice, bob, carol} {
+		genesisState[string(address)] = createAccount(address, 1000, 0, nil)
+	}
+	tc.addBlock(&block.Header{RootHash: []byte("genesisRootHash")}, []byte("genesisHash"), genesisState)
+
+	args := createMockArgsAccountsHistoryProcessor()
+	args.Accounts = &stateMock.AccountsStub{
+		GetAccountsDiffCalled: func(_ context.Context, oldRootHash common.RootHashHolder, newRootHash common.RootHashHolder, _ state.DataTrieLeafParserCreator, options state.AccountsDiffOptions) (*state.AccountsDiff, error) {
+			require.True(t, options.SkipDataTrieChanges)
+			return tc.computeAccountsDiff(oldRootHash.GetRootHash(), newRootHash.GetRootHash()), nil
+		},
+	}
+	args.Store = &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			require.Equal(t, dataRetriever.BlockHeaderUnit, unitType)
+			return &storageStubs.StorerStub{
+				GetCalled: func(key []byte) ([]byte, error) {
+					buff, found := tc.headers[string(key)]
+					if !found {
+						return nil, storage.ErrKeyNotFound
+					}
+
+					return buff, nil
+				},
+			}, nil
+		},
+	}
+
+	processor, err := NewAccountsHistoryProcessor(args)
+	require.Nil(t, err)
+	tc.processor = processor
+
+	return tc
+}
+
+func createMockArgsAccountsHistoryProcessor() ArgsAccountsHistoryProcessor {
+	return ArgsAccountsHistoryProcessor{
+		Marshalizer:           &marshallerMock.MarshalizerMock{},
+		AccountsHistoryStorer: testscommon.CreateMemUnit(),
+		Store:                 &storageStubs.ChainStorerStub{},
+		Accounts:              &stateMock.AccountsStub{},
+	}
+}
+
+func createAccount(address []byte, balance int64, nonce uint64, dataRootHash []byte) *stateMock.AccountWrapMock {
+	account := stateMock.NewAccountWrapMock(address)
+	account.Balance = big.NewInt(balance)
+	account.IncreaseNonce(nonce)
+	account.RootHash = dataRootHash
+
+	return account
+}
+
+func (tc *testContext) addBlock(header *block.Header, hash []byte, accountsState map[string]*stateMock.AccountWrapMock) {
+	tc.states[string(header.RootHash)] = accountsState
+	tc.headers[string(hash)], _ = (&marshallerMock.MarshalizerMock{}).Marshal(header)
+	tc.blocks = append(tc.blocks, header)
+	tc.blockHashes = append(tc.blockHashes, hash)
+}
+
+func (tc *testContext) computeAccountsDiff(oldRootHash []byte, newRootHash []byte) *state.AccountsDiff {
+	oldState := tc.states[string(oldRootHash)]
+	newState := tc.states[string(newRootHash)]
+	accountsDiff := &state.AccountsDiff{}
+	for address, newAccount := range newState {
+		oldAccount, found := oldState[address]
+		if !found {
+			accountsDiff.Added = append(accountsDiff.Added, &state.AccountDiff{Address: []byte(address), NewAccount: newAccount})
+			continue
+		}
+		if hasSameAccountState(oldAccount, newAccount) {
+			continue
+		}
+
+		accountsDiff.Modified = append(accountsDiff.Modified, &state.AccountDiff{
+			Address:    []byte(address),
+			OldAccount: oldAccount,
+			NewAccount: newAccount,
+		})
+	}
+
+	return accountsDiff
+}
+
+func hasSameAccountState(oldAccount *stateMock.AccountWrapMock, newAccount *stateMock.AccountWrapMock) bool {
+	return oldAccount.GetNonce() == newAccount.GetNonce() &&
+		oldAccount.Balance.Cmp(newAccount.Balance) == 0 &&
+		bytes.Equal(oldAccount.RootHash, newAccount.RootHash)
+}
+
+// processBlock builds a block on top of the last block with a lower nonce, alters the accounts state of that block
+// and records the new block
+func (tc *testContext) processBlock(t *testing.T, blockNonce uint64, alterState func(accountsState map[string]*stateMock.AccountWrapMock)) {
+	parentIndex := len(tc.blocks) - 1
+	for tc.blocks[parentIndex].Nonce >= blockNonce {
+		parentIndex--
+	}
+
+	accountsState := make(map[string]*stateMock.AccountWrapMock)
+	for address, account := range tc.states[string(tc.blocks[parentIndex].RootHash)] {
+		accountsState[address] = createAccount([]byte(address), account.Balance.Int64(), account.GetNonce(), account.RootHash)
+	}
+	alterState(accountsState)
+
+	header := &block.Header{
+		Nonce:    blockNonce,
+		PrevHash: tc.blockHashes[parentIndex],
+		RootHash: []byte(fmt.Sprintf("rootHash%d", len(tc.blocks))),
+	}
+	tc.addBlock(header, blockHash(blockNonce), accountsState)
+
+	err := tc.processor.processBlock(blockHash(blockNonce), header)
+	require.Nil(t, err)
+}
+
+// processTransfer moves the value between the two accounts and records the block holding the transfer
+func (tc *testContext) processTransfer(t *testing.T, blockNonce uint64, sender []byte, receiver []byte, value int64) {
+	tc.processBlock(t, blockNonce, func(accountsState map[string]*stateMock.AccountWrapMock) {
+		senderAccount := accountsState[string(sender)]
+		senderAccount.IncreaseNonce(1)
+		_ = senderAccount.SubFromBalance(big.NewInt(value))
+		receiverAccount, found := accountsState[string(receiver)]
+		if found {
+			_ = receiverAccount.AddToBalance(big.NewInt(value))
+		}
+	})
+}
+
+// waitForJobs waits for the go routine recording the blocks to process the already enqueued jobs
+func (tc *testContext) waitForJobs(t *testing.T) {
+	done := make(chan struct{})
+	err := tc.processor.enqueueJob(func() {
+		close(done)
+	})
+	require.Nil(t, err)
+
+	<-done
+}
+
+func (tc *testContext) getHistory(t *testing.T, address []byte, fromNonce uint64, toNonce uint64, maxEntries int) ([]uint64, bool) {
+	page, err := tc.processor.GetAccountHistory(address, fromNonce, toNonce, maxEntries)
+	require.Nil(t, err)
+
+	nonces := make([]uint64, 0, len(page.Entries))
+	for _, entry := range page.Entries {
+		nonces = append(nonces, entry.BlockNonce)
+	}
+
+	return nonces, page.HasMore
+}
+
+func blockHash(blockNonce uint64) []byte {
+	return []byte("hash" + string(rune(blockNonce)))
+}
+
+func TestNewAccountsHistoryProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAccountsHistoryProcessor()
+		args.Marshalizer = nil
+		processor, err := NewAccountsHistoryProcessor(args)
+		assert.True(t, check.IfNil(processor))
+		assert.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil accounts history storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAccountsHistoryProcessor()
+		args.AccountsHistoryStorer = nil
+		processor, err := NewAccountsHistoryProcessor(args)
+		assert.True(t, check.IfNil(processor))
+		assert.Equal(t, core.ErrNilStore, err)
+	})
+	t.Run("nil store should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAccountsHistoryProcessor()
+		args.Store = nil
+		processor, err := NewAccountsHistoryProcessor(args)
+		assert.True(t, check.IfNil(processor))
+		assert.Equal(t, core.ErrNilStore, err)
+	})
+	t.Run("nil accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAccountsHistoryProcessor()
+		args.Accounts = nil
+		processor, err := NewAccountsHistoryProcessor(args)
+		assert.True(t, check.IfNil(processor))
+		assert.Equal(t, ErrNilAccountsAdapter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		processor, err := NewAccountsHistoryProcessor(createMockArgsAccountsHistoryProcessor())
+		assert.False(t, check.IfNil(processor))
+		assert.Nil(t, err)
+		assert.Nil(t, processor.Close())
+	})
+}
+
+func TestAccountsHistoryProcessor_ProcessBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil header should error", func(t *testing.T) {
+		t.Parallel()
+
+		tc := createTestContext(t)
+		err := tc.processor.ProcessBlock([]byte("hash"), nil)
+		assert.Equal(t, process.ErrNilBlockHeader, err)
+	})
+	t.Run("closed processor should error", func(t *testing.T) {
+		t.Parallel()
+
+		tc := createTestContext(t)
+		require.Nil(t, tc.processor.Close())
+
+		err := tc.processor.ProcessBlock(blockHash(1), &block.Header{Nonce: 1})
+		assert.Equal(t, ErrAccountsHistoryClosed, err)
+		err = tc.processor.RevertBlock(&block.Header{Nonce: 1})
+		assert.Equal(t, ErrAccountsHistoryClosed, err)
+		assert.Nil(t, tc.processor.Close())
+	})
+	t.Run("missing previous header should error", func(t *testing.T) {
+		t.Parallel()
+
+		tc := createTestContext(t)
+		err := tc.processor.processBlock(blockHash(1), &block.Header{Nonce: 1, PrevHash: []byte("unknown")})
+		assert.NotNil(t, err)
+	})
+	t.Run("get accounts diff error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		tc := createTestContext(t)
+		tc.processor.accounts = &stateMock.AccountsStub{
+			GetAccountsDiffCalled: func(_ context.Context, _ common.RootHashHolder, _ common.RootHashHolder, _ state.DataTrieLeafParserCreator, _ state.AccountsDiffOptions) (*state.AccountsDiff, error) {
+				return nil, expectedErr
+			},
+		}
+		err := tc.processor.processBlock(blockHash(1), &block.Header{Nonce: 1, PrevHash: []byte("genesisHash")})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should compare the root hashes of the previous and of the processed blocks", func(t *testing.T) {
+		t.Parallel()
+
+		tc := createTestContext(t)
+		var oldRootHashes, newRootHashes []string
+		accounts := tc.processor.accounts.(*stateMock.AccountsStub)
+		getAccountsDiff := accounts.GetAccountsDiffCalled
+		accounts.GetAccountsDiffCalled = func(ctx context.Context, oldRootHash common.RootHashHolder, newRootHash common.RootHashHolder, creator state.DataTrieLeafParserCreator, options state.AccountsDiffOptions) (*state.AccountsDiff, error) {
+			oldRootHashes = append(oldRootHashes, string(oldRootHash.GetRootHash()))
+			newRootHashes = append(newRootHashes, string(newRootHash.GetRootHash()))
+			return getAccountsDiff(ctx, oldRootHash, newRootHash, creator, options)
+		}
+
+		tc.processTransfer(t, 1, alice, bob, 10)
+		tc.processTransfer(t, 2, alice, bob, 10)
+		// a block on another fork is compared with its own previous block
+		tc.processTransfer(t, 2, alice, carol, 10)
+
+		assert.Equal(t, []string{"genesisRootHash", "rootHash1", "rootHash1"}, oldRootHashes)
+		assert.Equal(t, []string{"rootHash1", "rootHash2", "rootHash3"}, newRootHashes)
+	})
+	t.Run("should record the new states of the altered accounts", func(t *testing.T) {
+		t.Parallel()
+
+		tc := createTestContext(t)
+		tc.processTransfer(t, 1, alice, bob, 10)
+		// the receiver of a transfer without value is not altered, so it is not recorded
+		tc.processTransfer(t, 2, alice, bob, 0)
+		// the receiver belongs to another shard
+		tc.processTransfer(t, 3, bob, []byte("dave"), 5)
+
+		page, err := tc.processor.GetAccountHistory(alice, 0, 10, 10)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(page.Entries))
+		assert.Equal(t, &AccountHistoryEntry{BlockNonce: 2, BlockHash: blockHash(2), Balance: big.NewInt(990), Nonce: 2}, page.Entries[0])
+		assert.Equal(t, &AccountHistoryEntry{BlockNonce: 1, BlockHash: blockHash(1), Balance: big.NewInt(990), Nonce: 1}, page.Entries[1])
+
+		page, err = tc.processor.GetAccountHistory(bob, 0, 10, 10)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(page.Entries))
+		assert.Equal(t, &AccountHistoryEntry{BlockNonce: 3, BlockHash: blockHash(3), Balance: big.NewInt(1005), Nonce: 1}, page.Entries[0])
+		assert.Equal(t, &AccountHistoryEntry{BlockNonce: 1, BlockHash: blockHash(1), Balance: big.NewInt(1010), Nonce: 0}, page.Entries[1])
+
+		nonces, _ := tc.getHistory(t, carol, 0, 10, 10)
+		assert.Empty(t, nonces)
+	})
+	t.Run("should record the added accounts", func(t *testing.T) {
+		t.Parallel()
+
+		tc := createTestContext(t)
+		dave := []byte("dave")
+		tc.processBlock(t, 4, func(accountsState map[string]*stateMock.AccountWrapMock) {
+			accountsState[string(dave)] = createAccount(dave, 7, 0, nil)
+		})
+
+		page, err := tc.processor.GetAccountHistory(dave, 0, 10, 10)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(page.Entries))
+		assert.Equal(t, big.NewInt(7), page.Entries[0].Balance)
+	})
+	t.Run("account with only the data trie altered should not be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		tc := createTestContext(t)
+		tc.processTransfer(t, 1, alice, bob, 10)
+		tc.processBlock(t, 2, func(accountsState map[string]*stateMock.AccountWrapMock) {
+			accountsState[string(bob)].RootHash = []byte("dataRootHash")
+		})
+
+		nonces, _ := tc.getHistory(t, bob, 0, 10, 10)
+		assert.Equal(t, []uint64{1}, nonces)
+	})
+	t.Run("block on another fork should replace the recorded block", func(t *testing.T) {
+		t.Parallel()
+
+		tc := createTestContext(t)
+		tc.processTransfer(t, 1, alice, bob, 10)
+		tc.processTransfer(t, 2, alice, bob, 10)
+		tc.processTransfer(t, 2, alice, carol, 20)
+
+		nonces, _ := tc.getHistory(t, alice, 0, 10, 10)
+		assert.Equal(t, []uint64{2, 1}, nonces)
+		nonces, _ = tc.getHistory(t, bob, 0, 10, 10)
+		assert.Equal(t, []uint64{1}, nonces)
+		nonces, _ = tc.getHistory(t, carol, 0, 10, 10)
+		assert.Equal(t, []uint64{2}, nonces)
+	})
+	t.Run("should record the enqueued blocks before closing", func(t *testing.T) {
+		t.Parallel()
+
+		tc := createTestContext(t)
+		for blockNonce := uint64(1); blockNonce <= 2*jobsQueueSize; blockNonce++ {
+			accountsState := tc.states[string(tc.blocks[len(tc.blocks)-1].RootHash)]
+			newState := make(map[string]*stateMock.AccountWrapMock)
+			for address, account := range accountsState {
+				newState[address] = createAccount([]byte(address), account.Balance.Int64(), account.GetNonce(), account.RootHash)
+			}
+			newState[string(alice)].IncreaseNonce(1)
+
+			header := &block.Header{
+				Nonce:    blockNonce,
+				PrevHash: tc.blockHashes[len(tc.blockHashes)-1],
+				RootHash: []byte(fmt.Sprintf("rootHash%d", blockNonce)),
+			}
+			tc.addBlock(header, blockHash(blockNonce), newState)
+		}
+		for i := 1; i < len(tc.blocks); i++ {
+			err := tc.processor.ProcessBlock(tc.blockHashes[i], tc.blocks[i])
+			require.Nil(t, err)
+		}
+		require.Nil(t, tc.processor.Close())
+
+		nonces, _ := tc.getHistory(t, alice, 0, 100, 100)
+		assert.Equal(t, 2*jobsQueueSize, len(nonces))
+	})
+}
+
+func TestAccountsHistoryProcessor_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil header should not error", func(t *testing.T) {
+		t.Parallel()
+
+		tc := createTestContext(t)
+		assert.Nil(t, tc.processor.RevertBlock(nil))
+	})
+	t.Run("not recorded block should not error", func(t *testing.T) {
+		t.Parallel()
+
+		tc := createTestContext(t)
+		assert.Nil(t, tc.processor.RevertBlock(&block.Header{Nonce: 5}))
+		assert.Nil(t, tc.processor.Close())
+	})
+	t.Run("should remove the entries of the block", func(t *testing.T) {
+		t.Parallel()
+
+		tc := createTestContext(t)
+		tc.processTransfer(t, 1, alice, bob, 10)
+		tc.processTransfer(t, 1500, alice, carol, 10)
+
+		err := tc.processor.RevertBlock(&block.Header{Nonce: 1500})
+		require.Nil(t, err)
+		tc.waitForJobs(t)
+
+		nonces, _ := tc.getHistory(t, alice, 0, 2000, 10)
+		assert.Equal(t, []uint64{1}, nonces)
+		nonces, _ = tc.getHistory(t, carol, 0, 2000, 10)
+		assert.Empty(t, nonces)
+
+		// the accounts should keep being recorded after the reverted entries
+		tc.processTransfer(t, 1501, alice, carol, 10)
+		nonces, _ = tc.getHistory(t, alice, 0, 2000, 10)
+		assert.Equal(t, []uint64{1501, 1}, nonces)
+		nonces, _ = tc.getHistory(t, carol, 0, 2000, 10)
+		assert.Equal(t, []uint64{1501}, nonces)
+	})
+}
+
+func TestAccountsHistoryProcessor_GetAccountHistory(t *testing.T) {
+	t.Parallel()
+
+	tc := createTestContext(t)
+	// the entries are spread over the chunks 0, 1, 2 and 5
+	blockNonces := []uint64{10, 20, 999, 1000, 1500, 2001, 5432}
+	for _, blockNonce := range blockNonces {
+		tc.processTransfer(t, blockNonce, alice, bob, 1)
+	}
+
+	t.Run("invalid max entries should error", func(t *testing.T) {
+		t.Parallel()
+
+		page, err := tc.processor.GetAccountHistory(alice, 0, 10, 0)
+		assert.Nil(t, page)
+		assert.Equal(t, ErrInvalidMaxEntries, err)
+	})
+	t.Run("unknown account should return an empty page", func(t *testing.T) {
+		t.Parallel()
+
+		nonces, hasMore := tc.getHistory(t, []byte("unknown"), 0, 10000, 10)
+		assert.Empty(t, nonces)
+		assert.False(t, hasMore)
+	})
+	t.Run("should return all the entries, newest first", func(t *testing.T) {
+		t.Parallel()
+
+		nonces, hasMore := tc.getHistory(t, alice, 0, 10000, 10)
+		assert.Equal(t, []uint64{5432, 2001, 1500, 1000, 999, 20, 10}, nonces)
+		assert.False(t, hasMore)
+	})
+	t.Run("should return the entries in range", func(t *testing.T) {
+		t.Parallel()
+
+		nonces, hasMore := tc.getHistory(t, alice, 20, 2000, 10)
+		assert.Equal(t, []uint64{1500, 1000, 999, 20}, nonces)
+		assert.False(t, hasMore)
+
+		nonces, _ = tc.getHistory(t, alice, 3000, 5000, 10)
+		assert.Empty(t, nonces)
+	})
+	t.Run("should paginate", func(t *testing.T) {
+		t.Parallel()
+
+		nonces, hasMore := tc.getHistory(t, alice, 0, 10000, 3)
+		assert.Equal(t, []uint64{5432, 2001, 1500}, nonces)
+		assert.True(t, hasMore)
+
+		nonces, hasMore = tc.getHistory(t, alice, 0, 1499, 3)
+		assert.Equal(t, []uint64{1000, 999, 20}, nonces)
+		assert.True(t, hasMore)
+
+		nonces, hasMore = tc.getHistory(t, alice, 0, 19, 3)
+		assert.Equal(t, []uint64{10}, nonces)
+		assert.False(t, hasMore)
+	})
+	t.Run("should not read the chunks newer than the requested range", func(t *testing.T) {
+		t.Parallel()
+
+		tcRange := createTestContext(t)
+		for _, blockNonce := range blockNonces {
+			tcRange.processTransfer(t, blockNonce, alice, bob, 1)
+		}
+		storer := tcRange.processor.historyStorage.storer
+		readKeys := make(map[string]struct{})
+		tcRange.processor.historyStorage.storer = &storageStubs.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				readKeys[string(key)] = struct{}{}
+				return storer.Get(key)
+			},
+		}
+
+		nonces, hasMore := tcRange.getHistory(t, alice, 0, 1499, 10)
+		assert.Equal(t, []uint64{1000, 999, 20, 10}, nonces)
+		assert.False(t, hasMore)
+		assert.Contains(t, readKeys, string(buildChunkKey(alice, 1)))
+		assert.NotContains(t, readKeys, string(buildChunkKey(alice, 2)))
+		assert.NotContains(t, readKeys, string(buildChunkKey(alice, 5)))
+
+		// the account was not altered in the chunk 4, so the chunks are walked starting from the last one
+		nonces, _ = tcRange.getHistory(t, alice, 0, 4500, 10)
+		assert.Equal(t, []uint64{2001, 1500, 1000, 999, 20, 10}, nonces)
+	})
+	t.Run("missing chunk should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAccountsHistoryProcessor()
+		args.AccountsHistoryStorer = &storageStubs.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				if string(key) == string(buildHeadKey(alice)) {
+					return (&marshallerMock.MarshalizerMock{}).Marshal(&AccountHistoryHead{LastChunk: 3})
+				}
+
+				return nil, storage.ErrKeyNotFound
+			},
+		}
+		processor, _ := NewAccountsHistoryProcessor(args)
+		page, err := processor.GetAccountHistory(alice, 0, 10000, 10)
+		assert.Nil(t, page)
+		assert.Equal(t, newErrMissingChunk(alice, 3), err)
+	})
+}
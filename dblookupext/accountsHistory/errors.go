@@ -0,0 +1,20 @@
+package accountsHistory
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrAccountsHistoryClosed signals that the accounts history processor was closed
+var ErrAccountsHistoryClosed = errors.New("accounts history processor is closed")
+
+// ErrInvalidMaxEntries signals that an invalid maximum number of history entries has been provided
+var ErrInvalidMaxEntries = errors.New("invalid maximum number of history entries")
+
+func newErrMissingChunk(address []byte, chunkIndex uint64) error {
+	return fmt.Errorf("missing accounts history chunk %d for address [%s]", chunkIndex, hex.EncodeToString(address))
+}
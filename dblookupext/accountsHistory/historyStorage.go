@@ -0,0 +1,135 @@
+package accountsHistory
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const (
+	headKeyPrefix  = "head_"
+	chunkKeyPrefix = "chunk_"
+	blockKeyPrefix = "block_"
+	uint64Size     = 8
+)
+
+// historyStorage reads and writes the linked chunks of history entries of each account, together with the addresses
+// altered by each block. All the records are kept in the same storer, under distinct key prefixes
+type historyStorage struct {
+	storer      storage.Storer
+	marshalizer marshal.Marshalizer
+}
+
+func newHistoryStorage(marshalizer marshal.Marshalizer, storer storage.Storer) *historyStorage {
+	return &historyStorage{
+		storer:      storer,
+		marshalizer: marshalizer,
+	}
+}
+
+func (hs *historyStorage) getHead(address []byte) (*AccountHistoryHead, bool, error) {
+	head := &AccountHistoryHead{}
+	found, err := hs.get(buildHeadKey(address), head)
+
+	return head, found, err
+}
+
+func (hs *historyStorage) putHead(address []byte, head *AccountHistoryHead) error {
+	return hs.put(buildHeadKey(address), head)
+}
+
+func (hs *historyStorage) removeHead(address []byte) error {
+	return hs.storer.Remove(buildHeadKey(address))
+}
+
+func (hs *historyStorage) findChunk(address []byte, chunkIndex uint64) (*AccountHistoryChunk, bool, error) {
+	chunk := &AccountHistoryChunk{}
+	found, err := hs.get(buildChunkKey(address, chunkIndex), chunk)
+
+	return chunk, found, err
+}
+
+func (hs *historyStorage) getChunk(address []byte, chunkIndex uint64) (*AccountHistoryChunk, error) {
+	chunk, found, err := hs.findChunk(address, chunkIndex)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, newErrMissingChunk(address, chunkIndex)
+	}
+
+	return chunk, nil
+}
+
+func (hs *historyStorage) putChunk(address []byte, chunkIndex uint64, chunk *AccountHistoryChunk) error {
+	return hs.put(buildChunkKey(address, chunkIndex), chunk)
+}
+
+func (hs *historyStorage) removeChunk(address []byte, chunkIndex uint64) error {
+	return hs.storer.Remove(buildChunkKey(address, chunkIndex))
+}
+
+func (hs *historyStorage) getBlockAlteredAccounts(blockNonce uint64) (*BlockAlteredAccounts, bool, error) {
+	blockAlteredAccounts := &BlockAlteredAccounts{}
+	found, err := hs.get(buildBlockKey(blockNonce), blockAlteredAccounts)
+
+	return blockAlteredAccounts, found, err
+}
+
+func (hs *historyStorage) putBlockAlteredAccounts(blockNonce uint64, blockAlteredAccounts *BlockAlteredAccounts) error {
+	return hs.put(buildBlockKey(blockNonce), blockAlteredAccounts)
+}
+
+func (hs *historyStorage) removeBlockAlteredAccounts(blockNonce uint64) error {
+	return hs.storer.Remove(buildBlockKey(blockNonce))
+}
+
+func (hs *historyStorage) get(key []byte, value interface{}) (bool, error) {
+	buff, err := hs.storer.Get(key)
+	if errors.Is(err, storage.ErrKeyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	err = hs.marshalizer.Unmarshal(value, buff)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (hs *historyStorage) put(key []byte, value interface{}) error {
+	buff, err := hs.marshalizer.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return hs.storer.Put(key, buff)
+}
+
+func buildHeadKey(address []byte) []byte {
+	key := make([]byte, 0, len(headKeyPrefix)+len(address))
+	key = append(key, headKeyPrefix...)
+
+	return append(key, address...)
+}
+
+func buildChunkKey(address []byte, chunkIndex uint64) []byte {
+	key := make([]byte, 0, len(chunkKeyPrefix)+len(address)+uint64Size)
+	key = append(key, chunkKeyPrefix...)
+	key = append(key, address...)
+
+	return binary.BigEndian.AppendUint64(key, chunkIndex)
+}
+
+func buildBlockKey(blockNonce uint64) []byte {
+	key := make([]byte, 0, len(blockKeyPrefix)+uint64Size)
+	key = append(key, blockKeyPrefix...)
+
+	return binary.BigEndian.AppendUint64(key, blockNonce)
+}
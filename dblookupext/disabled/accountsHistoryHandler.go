@@ -0,0 +1,43 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/dblookupext/accountsHistory"
+)
+
+var errorDisabledAccountsHistory = errors.New("accounts history is disabled")
+
+type accountsHistoryHandler struct {
+}
+
+// NewAccountsHistoryHandler returns a disabled accounts history handler
+func NewAccountsHistoryHandler() *accountsHistoryHandler {
+	return &accountsHistoryHandler{}
+}
+
+// ProcessBlock does nothing and returns nil
+func (ahh *accountsHistoryHandler) ProcessBlock(_ []byte, _ data.HeaderHandler) error {
+	return nil
+}
+
+// RevertBlock does nothing and returns nil
+func (ahh *accountsHistoryHandler) RevertBlock(_ data.HeaderHandler) error {
+	return nil
+}
+
+// GetAccountHistory returns a not implemented error
+func (ahh *accountsHistoryHandler) GetAccountHistory(_ []byte, _ uint64, _ uint64, _ int) (*accountsHistory.AccountHistoryPage, error) {
+	return nil, errorDisabledAccountsHistory
+}
+
+// Close does nothing and returns nil
+func (ahh *accountsHistoryHandler) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ahh *accountsHistoryHandler) IsInterfaceNil() bool {
+	return ahh == nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/accountsHistory"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 )
 
@@ -53,11 +54,21 @@ func (nhr *nilHistoryRepository) GetESDTSupply(_ string) (*esdtSupply.SupplyESDT
 	return nil, errorDisabledHistoryRepository
 }
 
+// GetAccountHistory returns a not implemented error
+func (nhr *nilHistoryRepository) GetAccountHistory(_ []byte, _ uint64, _ uint64, _ int) (*accountsHistory.AccountHistoryPage, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
 // GetResultsHashesByTxHash -
 func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*dblookupext.ResultsHashesByTxHash, error) {
 	return nil, nil
 }
 
+// Close returns nil
+func (nhr *nilHistoryRepository) Close() error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
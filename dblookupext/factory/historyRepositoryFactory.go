@@ -9,9 +9,11 @@ import (
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/accountsHistory"
 	"github.com/multiversx/mx-chain-go/dblookupext/disabled"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
 )
 
 // ArgsHistoryRepositoryFactory holds all dependencies required by the history processor factory in order to create
@@ -23,6 +25,7 @@ type ArgsHistoryRepositoryFactory struct {
 	Marshalizer              marshal.Marshalizer
 	Hasher                   hashing.Hasher
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	Accounts                 state.AccountsAdapter
 }
 
 type historyRepositoryFactory struct {
@@ -32,6 +35,7 @@ type historyRepositoryFactory struct {
 	marshalizer              marshal.Marshalizer
 	hasher                   hashing.Hasher
 	uInt64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	accounts                 state.AccountsAdapter
 }
 
 // NewHistoryRepositoryFactory creates an instance of historyRepositoryFactory
@@ -48,6 +52,9 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 	if check.IfNil(args.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
+	if check.IfNil(args.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
 
 	return &historyRepositoryFactory{
 		selfShardID:              args.SelfShardID,
@@ -56,6 +63,7 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 		marshalizer:              args.Marshalizer,
 		hasher:                   args.Hasher,
 		uInt64ByteSliceConverter: args.Uint64ByteSliceConverter,
+		accounts:                 args.Accounts,
 	}, nil
 }
 
@@ -84,6 +92,11 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return nil, err
 	}
 
+	accountsHistoryHandler, err := hpf.createAccountsHistoryHandler()
+	if err != nil {
+		return nil, err
+	}
+
 	roundHdrHashDataStorer, err := hpf.store.GetStorer(dataRetriever.RoundHdrHashDataUnit)
 	if err != nil {
 		return nil, err
@@ -120,10 +133,29 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: miniblockHashByTxHashStorer,
 		EventsHashesByTxHashStorer:  resultsHashesByTxHashStorer,
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
+		AccountsHistoryHandler:      accountsHistoryHandler,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
+func (hpf *historyRepositoryFactory) createAccountsHistoryHandler() (dblookupext.AccountsHistoryHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.AccountsHistoryEnabled {
+		return disabled.NewAccountsHistoryHandler(), nil
+	}
+
+	accountsHistoryStorer, err := hpf.store.GetStorer(dataRetriever.AccountsHistoryUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountsHistory.NewAccountsHistoryProcessor(accountsHistory.ArgsAccountsHistoryProcessor{
+		Marshalizer:           hpf.marshalizer,
+		AccountsHistoryStorer: accountsHistoryStorer,
+		Store:                 hpf.store,
+		Accounts:              hpf.accounts,
+	})
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hpf *historyRepositoryFactory) IsInterfaceNil() bool {
 	return hpf == nil
@@ -15,6 +15,7 @@ import (
 	processMock "github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/stretchr/testify/require"
 )
@@ -46,6 +47,12 @@ func TestNewHistoryRepositoryFactory(t *testing.T) {
 	require.Equal(t, process.ErrNilUint64Converter, err)
 	require.Nil(t, hrf)
 
+	argsNilAccounts := getArgs()
+	argsNilAccounts.Accounts = nil
+	hrf, err = factory.NewHistoryRepositoryFactory(argsNilAccounts)
+	require.Equal(t, process.ErrNilAccountsAdapter, err)
+	require.Nil(t, hrf)
+
 	hrf, err = factory.NewHistoryRepositoryFactory(args)
 	require.NoError(t, err)
 	require.False(t, check.IfNil(hrf))
@@ -77,6 +84,24 @@ func TestHistoryRepositoryFactory_CreateShouldCreateRegularRepository(t *testing
 	require.True(t, repository.IsEnabled())
 }
 
+func TestHistoryRepositoryFactory_CreateWithAccountsHistoryShouldWork(t *testing.T) {
+	args := getArgs()
+	args.Config.Enabled = true
+	args.Config.AccountsHistoryEnabled = true
+	args.Store = &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			return &storageStubs.StorerStub{}, nil
+		},
+	}
+
+	hrf, _ := factory.NewHistoryRepositoryFactory(args)
+
+	repository, err := hrf.Create()
+	require.NoError(t, err)
+	require.NotNil(t, repository)
+	require.True(t, repository.IsEnabled())
+}
+
 func TestHistoryRepositoryFactory_CreateMissingStorersReturnsError(t *testing.T) {
 	t.Parallel()
 
@@ -87,6 +112,7 @@ func TestHistoryRepositoryFactory_CreateMissingStorersReturnsError(t *testing.T)
 	t.Run("missing EpochByHashUnit", testWithMissingStorer(dataRetriever.EpochByHashUnit))
 	t.Run("missing MiniblockHashByTxHashUnit", testWithMissingStorer(dataRetriever.MiniblockHashByTxHashUnit))
 	t.Run("missing ResultsHashesByTxHashUnit", testWithMissingStorer(dataRetriever.ResultsHashesByTxHashUnit))
+	t.Run("missing AccountsHistoryUnit", testWithMissingStorer(dataRetriever.AccountsHistoryUnit))
 }
 
 func testWithMissingStorer(missingUnit dataRetriever.UnitType) func(t *testing.T) {
@@ -95,6 +121,7 @@ func testWithMissingStorer(missingUnit dataRetriever.UnitType) func(t *testing.T
 
 		args := getArgs()
 		args.Config.Enabled = true
+		args.Config.AccountsHistoryEnabled = true
 		args.Store = &storageStubs.ChainStorerStub{
 			GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
 				if unitType == missingUnit {
@@ -120,5 +147,6 @@ func getArgs() *factory.ArgsHistoryRepositoryFactory {
 		Marshalizer:              &mock.MarshalizerMock{},
 		Hasher:                   &hashingMocks.HasherMock{},
 		Uint64ByteSliceConverter: &processMock.Uint64ByteSliceConverterMock{},
+		Accounts:                 &stateMock.AccountsStub{},
 	}
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common/logging"
+	"github.com/multiversx/mx-chain-go/dblookupext/accountsHistory"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
@@ -38,6 +39,7 @@ type HistoryRepositoryArguments struct {
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
+	AccountsHistoryHandler      AccountsHistoryHandler
 }
 
 type historyRepository struct {
@@ -51,6 +53,7 @@ type historyRepository struct {
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
+	accountsHistoryHandler     AccountsHistoryHandler
 
 	// These maps temporarily hold notifications of "notarized at source or destination", to deal with unwanted concurrency effects
 	// The unwanted concurrency effects could be accentuated by the fast db-replay-validate mechanism.
@@ -94,6 +97,9 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.ESDTSuppliesHandler) {
 		return nil, errNilESDTSuppliesHandler
 	}
+	if check.IfNil(arguments.AccountsHistoryHandler) {
+		return nil, errNilAccountsHistoryHandler
+	}
 	if check.IfNil(arguments.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
@@ -117,6 +123,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
+		accountsHistoryHandler:                       arguments.AccountsHistoryHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
 	}, nil
 }
@@ -178,6 +185,12 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	// the accounts history compares the committed root hashes on its own go routine
+	err = hr.accountsHistoryHandler.ProcessBlock(blockHeaderHash, blockHeader)
+	if err != nil {
+		return err
+	}
+
 	err = hr.putHashByRound(blockHeaderHash, blockHeader)
 	if err != nil {
 		return err
@@ -480,7 +493,12 @@ func (hr *historyRepository) IsEnabled() bool {
 
 // RevertBlock will return the modification for the current block header
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
-	return hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	err := hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	if err != nil {
+		return err
+	}
+
+	return hr.accountsHistoryHandler.RevertBlock(blockHeader)
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
@@ -488,6 +506,16 @@ func (hr *historyRepository) GetESDTSupply(token string) (*esdtSupply.SupplyESDT
 	return hr.esdtSuppliesHandler.GetESDTSupply(token)
 }
 
+// GetAccountHistory will return, newest first, the history entries of the account recorded between the given block nonces
+func (hr *historyRepository) GetAccountHistory(address []byte, fromNonce uint64, toNonce uint64, maxEntries int) (*accountsHistory.AccountHistoryPage, error) {
+	return hr.accountsHistoryHandler.GetAccountHistory(address, fromNonce, toNonce, maxEntries)
+}
+
+// Close closes the accounts history handler, waiting for the already committed blocks to be recorded
+func (hr *historyRepository) Close() error {
+	return hr.accountsHistoryHandler.Close()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hr *historyRepository) IsInterfaceNil() bool {
 	return hr == nil
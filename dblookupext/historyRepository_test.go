@@ -9,12 +9,15 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/common/mock"
+	"github.com/multiversx/mx-chain-go/dblookupext/accountsHistory"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 	epochStartMocks "github.com/multiversx/mx-chain-go/epochStart/mock"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,6 +29,12 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 			return nil, storage.ErrKeyNotFound
 		},
 	}, &storageStubs.StorerStub{})
+	ahp, _ := accountsHistory.NewAccountsHistoryProcessor(accountsHistory.ArgsAccountsHistoryProcessor{
+		Marshalizer:           &mock.MarshalizerMock{},
+		AccountsHistoryStorer: testscommon.CreateMemUnit(),
+		Store:                 &storageStubs.ChainStorerStub{},
+		Accounts:              &stateMock.AccountsStub{},
+	})
 
 	args := HistoryRepositoryArguments{
 		SelfShardID:                 0,
@@ -37,6 +46,7 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		Marshalizer:                 &mock.MarshalizerMock{},
 		Hasher:                      &hashingMocks.HasherMock{},
 		ESDTSuppliesHandler:         sp,
+		AccountsHistoryHandler:      ahp,
 		Uint64ByteSliceConverter:    &epochStartMocks.Uint64ByteSliceConverterMock{},
 	}
 
@@ -88,6 +98,12 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, process.ErrNilUint64Converter, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.AccountsHistoryHandler = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, errNilAccountsHistoryHandler, err)
+
 	args = createMockHistoryRepoArgs(0)
 	repo, err = NewHistoryRepository(args)
 	require.Nil(t, err)
@@ -741,3 +757,19 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 	require.Equal(t, 4001, int(metadata.NotarizedAtDestinationInMetaNonce))
 	require.Equal(t, []byte("metablockFoo"), metadata.NotarizedAtDestinationInMetaHash)
 }
+
+func TestHistoryRepository_GetAccountHistory(t *testing.T) {
+	t.Parallel()
+
+	repo, err := NewHistoryRepository(createMockHistoryRepoArgs(0))
+	require.Nil(t, err)
+
+	page, err := repo.GetAccountHistory([]byte("address"), 0, 10, 0)
+	require.Nil(t, page)
+	require.Equal(t, accountsHistory.ErrInvalidMaxEntries, err)
+
+	page, err = repo.GetAccountHistory([]byte("address"), 0, 10, 5)
+	require.Nil(t, err)
+	require.Empty(t, page.Entries)
+	require.False(t, page.HasMore)
+}
@@ -3,6 +3,7 @@ package dblookupext
 import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/dblookupext/accountsHistory"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 )
 
@@ -27,7 +28,9 @@ type HistoryRepository interface {
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	GetAccountHistory(address []byte, fromNonce uint64, toNonce uint64, maxEntries int) (*accountsHistory.AccountHistoryPage, error)
 	IsEnabled() bool
+	Close() error
 	IsInterfaceNil() bool
 }
 
@@ -47,3 +50,12 @@ type SuppliesHandler interface {
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
 	IsInterfaceNil() bool
 }
+
+// AccountsHistoryHandler defines the interface of an accounts history processor
+type AccountsHistoryHandler interface {
+	ProcessBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler) error
+	RevertBlock(blockHeader data.HeaderHandler) error
+	GetAccountHistory(address []byte, fromNonce uint64, toNonce uint64, maxEntries int) (*accountsHistory.AccountHistoryPage, error)
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -1,5 +1,7 @@
 package factory
 
+import "github.com/multiversx/mx-chain-go/debug"
+
 // InterceptorDebugHandler hold information about requested and received information
 type InterceptorDebugHandler interface {
 	LogRequestedData(topic string, hashes [][]byte, numReqIntra int, numReqCross int)
@@ -8,6 +10,7 @@ type InterceptorDebugHandler interface {
 	LogFailedToResolveData(topic string, hash []byte, err error)
 	LogSucceededToResolveData(topic string, hash []byte)
 	Query(topic string) []string
+	Counters() []*debug.InterceptorResolverCounters
 	Close() error
 	IsInterfaceNil() bool
 }
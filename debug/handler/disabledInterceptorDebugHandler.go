@@ -1,5 +1,7 @@
 package handler
 
+import "github.com/multiversx/mx-chain-go/debug"
+
 type disabledInterceptorDebugHandler struct {
 }
 
@@ -25,6 +27,11 @@ func (didh *disabledInterceptorDebugHandler) Query(_ string) []string {
 	return make([]string, 0)
 }
 
+// Counters returns an empty slice
+func (didh *disabledInterceptorDebugHandler) Counters() []*debug.InterceptorResolverCounters {
+	return make([]*debug.InterceptorResolverCounters, 0)
+}
+
 // LogFailedToResolveData does nothing
 func (didh *disabledInterceptorDebugHandler) LogFailedToResolveData(_ string, _ []byte, _ error) {
 }
@@ -19,4 +19,5 @@ func TestDisabledInterceptorResolver(t *testing.T) {
 	dir.LogFailedToResolveData("", nil, nil)
 	dir.LogSucceededToResolveData("", nil)
 	assert.Equal(t, 0, len(dir.Query("*")))
+	assert.Equal(t, 0, len(dir.Counters()))
 }
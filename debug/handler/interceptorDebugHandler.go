@@ -378,6 +378,55 @@ func (idh *interceptorDebugHandler) query(acceptEvent func(ev *event) bool, maxN
 	return events
 }
 
+// Counters returns the counters of the recorded events, aggregated by event type and topic
+func (idh *interceptorDebugHandler) Counters() []*debug.InterceptorResolverCounters {
+	countersMap := make(map[string]*debug.InterceptorResolverCounters)
+	for _, key := range idh.cache.Keys() {
+		obj, ok := idh.cache.Get(key)
+		if !ok {
+			continue
+		}
+
+		ev, ok := obj.(*event)
+		if !ok {
+			continue
+		}
+
+		ev.mutEvent.RLock()
+		identifier := ev.eventType + ev.topic
+		counters, found := countersMap[identifier]
+		if !found {
+			counters = &debug.InterceptorResolverCounters{
+				EventType: ev.eventType,
+				Topic:     ev.topic,
+			}
+			countersMap[identifier] = counters
+		}
+
+		counters.NumEvents++
+		counters.NumReqIntra += ev.numReqIntra
+		counters.NumReqCross += ev.numReqCross
+		counters.NumReceived += ev.numReceived
+		counters.NumProcessed += ev.numProcessed
+		ev.mutEvent.RUnlock()
+	}
+
+	result := make([]*debug.InterceptorResolverCounters, 0, len(countersMap))
+	for _, counters := range countersMap {
+		result = append(result, counters)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].EventType != result[j].EventType {
+			return result[i].EventType < result[j].EventType
+		}
+
+		return result[i].Topic < result[j].Topic
+	})
+
+	return result
+}
+
 // LogFailedToResolveData adds a record stating that the resolver was unable to process the data
 func (idh *interceptorDebugHandler) LogFailedToResolveData(topic string, hash []byte, err error) {
 	identifier := idh.computeIdentifier(resolveEvent, topic, hash)
@@ -378,6 +378,47 @@ func TestInterceptorResolver_Query(t *testing.T) {
 	assert.Equal(t, 2, len(idh.Query("*")))
 }
 
+func TestInterceptorResolver_Counters(t *testing.T) {
+	t.Parallel()
+
+	topic1 := "topic1"
+	topic2 := "aaaa"
+	idh, _ := NewInterceptorDebugHandler(createWorkableConfig())
+	assert.Equal(t, 0, len(idh.Counters()))
+
+	idh.LogRequestedData(topic1, [][]byte{[]byte("hash1"), []byte("hash2")}, numIntra, numCross)
+	idh.LogReceivedHashes(topic1, [][]byte{[]byte("hash1")})
+	idh.LogProcessedHashes(topic1, [][]byte{[]byte("hash2")}, errors.New("expected error"))
+	idh.LogRequestedData(topic2, [][]byte{hash}, numIntra, 0)
+	idh.LogFailedToResolveData(topic1, hash, nil)
+	idh.LogFailedToResolveData(topic1, hash, nil)
+
+	expected := []*debug.InterceptorResolverCounters{
+		{
+			EventType:   requestEvent,
+			Topic:       topic2,
+			NumEvents:   1,
+			NumReqIntra: numIntra,
+		},
+		{
+			EventType:    requestEvent,
+			Topic:        topic1,
+			NumEvents:    2,
+			NumReqIntra:  2 * numIntra,
+			NumReqCross:  2 * numCross,
+			NumReceived:  1,
+			NumProcessed: 1,
+		},
+		{
+			EventType:   resolveEvent,
+			Topic:       topic1,
+			NumEvents:   1,
+			NumReceived: 2,
+		},
+	}
+	assert.Equal(t, expected, idh.Counters())
+}
+
 func TestInterceptorResolver_GetStringEventsShouldWork(t *testing.T) {
 	t.Parallel()
 
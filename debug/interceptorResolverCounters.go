@@ -0,0 +1,13 @@
+package debug
+
+// InterceptorResolverCounters holds the aggregated counters of the events recorded by the interceptor-resolver
+// debugger on a topic
+type InterceptorResolverCounters struct {
+	EventType    string
+	Topic        string
+	NumEvents    int
+	NumReqIntra  int
+	NumReqCross  int
+	NumReceived  int
+	NumProcessed int
+}
@@ -12,6 +12,11 @@ type QueryHandler interface {
 	IsInterfaceNil() bool
 }
 
+// InterceptorResolverCountersHandler defines a debug handler able to provide the aggregated interceptor-resolver counters
+type InterceptorResolverCountersHandler interface {
+	Counters() []*InterceptorResolverCounters
+}
+
 // GoRoutineHandlerMap represents an alias of a map of goroutineHandlers
 type GoRoutineHandlerMap = map[string]GoRoutineHandler
 
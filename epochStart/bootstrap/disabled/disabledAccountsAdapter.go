@@ -123,6 +123,16 @@ func (a *accountsAdapter) RecreateAllTries(_ []byte) (map[string]common.Trie, er
 	return nil, nil
 }
 
+// GetAccountsDiff -
+func (a *accountsAdapter) GetAccountsDiff(_ context.Context, _ common.RootHashHolder, _ common.RootHashHolder, _ state.DataTrieLeafParserCreator, _ state.AccountsDiffOptions) (*state.AccountsDiff, error) {
+	return nil, nil
+}
+
+// CollectTriesStatistics -
+func (a *accountsAdapter) CollectTriesStatistics(_ context.Context, _ []byte, _ common.TriesStatisticsCollector) error {
+	return nil
+}
+
 // CommitInEpoch -
 func (a *accountsAdapter) CommitInEpoch(_ uint32, _ uint32) ([]byte, error) {
 	return nil, nil
@@ -42,6 +42,7 @@ import (
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/snapshotFiles"
 	"github.com/multiversx/mx-chain-go/state/syncer"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/cache"
@@ -1100,15 +1101,25 @@ func (e *epochStartBootstrap) updateDataForScheduled(
 }
 
 func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
+	e.mutTrieStorageManagers.RLock()
+	trieStorageManager := e.trieStorageManagers[dataRetriever.UserAccountsUnit.String()]
+	e.mutTrieStorageManagers.RUnlock()
+
+	if e.generalConfig.StateSnapshotFiles.ImportEnabled {
+		err := e.importUserAccountsStateFromFiles(rootHash, trieStorageManager)
+		if err == nil {
+			return nil
+		}
+
+		log.Warn("could not import the user accounts state from the snapshot files, syncing it from the network",
+			"rootHash", rootHash, "error", err)
+	}
+
 	thr, err := throttler.NewNumGoRoutinesThrottler(int32(e.numConcurrentTrieSyncers))
 	if err != nil {
 		return err
 	}
 
-	e.mutTrieStorageManagers.RLock()
-	trieStorageManager := e.trieStorageManagers[dataRetriever.UserAccountsUnit.String()]
-	e.mutTrieStorageManagers.RUnlock()
-
 	argsUserAccountsSyncer := syncer.ArgsNewUserAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                            e.coreComponentsHolder.Hasher(),
@@ -1117,6 +1128,7 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 			RequestHandler:                    e.requestHandler,
 			Timeout:                           common.TimeoutGettingTrieNodes,
 			Cacher:                            e.dataPool.TrieNodes(),
+			LeavesRangesCacher:                e.dataPool.TrieLeavesRanges(),
 			MaxTrieLevelInMemory:              e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
 			MaxHardCapForMissingNodes:         e.maxHardCapForMissingNodes,
 			TrieSyncerVersion:                 e.trieSyncerVersion,
@@ -1142,6 +1154,24 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	return nil
 }
 
+func (e *epochStartBootstrap) importUserAccountsStateFromFiles(rootHash []byte, trieStorageManager common.StorageManager) error {
+	argsSnapshotFilesImporter := snapshotFiles.ArgsSnapshotFilesImporter{
+		Config:               e.generalConfig.StateSnapshotFiles,
+		Marshaller:           e.coreComponentsHolder.InternalMarshalizer(),
+		Hasher:               e.coreComponentsHolder.Hasher(),
+		EnableEpochsHandler:  e.coreComponentsHolder.EnableEpochsHandler(),
+		MaxTrieLevelInMemory: e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+		SingleSigner:         e.cryptoComponentsHolder.BlockSigner(),
+		KeyGenerator:         e.cryptoComponentsHolder.BlockSignKeyGen(),
+	}
+	snapshotFilesImporter, err := snapshotFiles.NewSnapshotFilesImporter(argsSnapshotFilesImporter)
+	if err != nil {
+		return err
+	}
+
+	return snapshotFilesImporter.ImportSnapshot(rootHash, trieStorageManager, storageMarker.NewTrieStorageMarker())
+}
+
 func (e *epochStartBootstrap) createStorageServiceForImportDB(
 	shardCoordinator sharding.Coordinator,
 	pathManager storage.PathManagerHandler,
@@ -1191,6 +1221,7 @@ func (e *epochStartBootstrap) syncValidatorAccountsState(rootHash []byte) error
 			RequestHandler:                    e.requestHandler,
 			Timeout:                           common.TimeoutGettingTrieNodes,
 			Cacher:                            e.dataPool.TrieNodes(),
+			LeavesRangesCacher:                e.dataPool.TrieLeavesRanges(),
 			MaxTrieLevelInMemory:              e.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
 			MaxHardCapForMissingNodes:         e.maxHardCapForMissingNodes,
 			TrieSyncerVersion:                 e.trieSyncerVersion,
@@ -598,3 +598,9 @@ var ErrNilSentSignatureTracker = errors.New("nil sent signature tracker")
 
 // ErrNilEpochSystemSCProcessor defines the error for setting a nil EpochSystemSCProcessor
 var ErrNilEpochSystemSCProcessor = errors.New("nil epoch system SC processor")
+
+// ErrEmptySnapshotSigningKeyPemFile signals that the state snapshot files writing is enabled without a signing key file
+var ErrEmptySnapshotSigningKeyPemFile = errors.New("empty state snapshot signing key pem file")
+
+// ErrSnapshotSigningKeyIsValidatorKey signals that the state snapshot signing key is the validator key
+var ErrSnapshotSigningKeyIsValidatorKey = errors.New("the state snapshot signing key must not be the validator key")
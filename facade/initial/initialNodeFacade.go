@@ -66,6 +66,26 @@ func (inf *initialNodeFacade) GetProof(_ string, _ string) (*common.GetProofResp
 	return nil, errNodeStarting
 }
 
+// GetMultiProof -
+func (inf *initialNodeFacade) GetMultiProof(_ string, _ []string) (*common.GetMultiProofResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetMultiProofDataTrie -
+func (inf *initialNodeFacade) GetMultiProofDataTrie(_ string, _ string, _ []string) (*common.GetMultiProofDataTrieResponse, error) {
+	return nil, errNodeStarting
+}
+
+// VerifyMultiProof -
+func (inf *initialNodeFacade) VerifyMultiProof(_ string, _ []string, _ [][]byte, _ [][]byte) (bool, error) {
+	return false, errNodeStarting
+}
+
+// GetStateDiff -
+func (inf *initialNodeFacade) GetStateDiff(_ common.StateDiffQueryOptions) (*common.StateDiffAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetProofDataTrie -
 func (inf *initialNodeFacade) GetProofDataTrie(_ string, _ string, _ string) (*common.GetProofResponse, *common.GetProofResponse, error) {
 	return nil, nil, errNodeStarting
@@ -147,7 +167,7 @@ func (inf *initialNodeFacade) ValidateTransaction(_ *transaction.Transaction) er
 }
 
 // ValidateTransactionForSimulation returns error
-func (inf *initialNodeFacade) ValidateTransactionForSimulation(_ *transaction.Transaction, _ bool) error {
+func (inf *initialNodeFacade) ValidateTransactionForSimulation(_ *transaction.Transaction, _ bool, _ map[string]*txSimData.AccountOverride) error {
 	return errNodeStarting
 }
 
@@ -167,7 +187,17 @@ func (inf *initialNodeFacade) SendBulkTransactions(_ []*transaction.Transaction)
 }
 
 // SimulateTransactionExecution returns nil and error
-func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride, _ bool) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nil, errNodeStarting
+}
+
+// SimulateTransactionsBundleExecution returns nil and error
+func (inf *initialNodeFacade) SimulateTransactionsBundleExecution(_ []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	return nil, errNodeStarting
+}
+
+// TraceTransaction returns nil and error
+func (inf *initialNodeFacade) TraceTransaction(_ string) (*txSimData.SimulationResultsWithVMOutput, error) {
 	return nil, errNodeStarting
 }
 
@@ -177,7 +207,7 @@ func (inf *initialNodeFacade) GetTransaction(_ string, _ bool) (*transaction.Api
 }
 
 // ComputeTransactionGasLimit returns 0 and error
-func (inf *initialNodeFacade) ComputeTransactionGasLimit(_ *transaction.Transaction) (*transaction.CostResponse, error) {
+func (inf *initialNodeFacade) ComputeTransactionGasLimit(_ *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error) {
 	return nil, errNodeStarting
 }
 
@@ -251,6 +281,11 @@ func (inf *initialNodeFacade) GetQueryHandler(_ string) (debug.QueryHandler, err
 	return nil, errNodeStarting
 }
 
+// GetInterceptorResolverDebugCounters returns an empty slice
+func (inf *initialNodeFacade) GetInterceptorResolverDebugCounters() []*debug.InterceptorResolverCounters {
+	return make([]*debug.InterceptorResolverCounters, 0)
+}
+
 // GetPeerInfo returns nil and error
 func (inf *initialNodeFacade) GetPeerInfo(_ string) ([]core.QueryP2PPeerInfo, error) {
 	return nil, errNodeStarting
@@ -266,6 +301,11 @@ func (inf *initialNodeFacade) GetEpochStartDataAPI(_ uint32) (*common.EpochStart
 	return nil, errNodeStarting
 }
 
+// GetTrieStatistics returns nil and error
+func (inf *initialNodeFacade) GetTrieStatistics(_ string) (*common.TrieStatisticsAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetThrottlerForEndpoint returns nil and false
 func (inf *initialNodeFacade) GetThrottlerForEndpoint(_ string) (core.Throttler, bool) {
 	return nil, false
@@ -346,6 +386,16 @@ func (inf *initialNodeFacade) GetKeyValuePairs(_ string, _ api.AccountQueryOptio
 	return nil, api.BlockInfo{}, errNodeStarting
 }
 
+// GetKeyValuePairsPage returns error
+func (inf *initialNodeFacade) GetKeyValuePairsPage(_ string, _ api.AccountQueryOptions, _ []byte, _ int, _ []byte) (*common.KeyValuePairsPage, api.BlockInfo, error) {
+	return nil, api.BlockInfo{}, errNodeStarting
+}
+
+// GetAccountHistory returns error
+func (inf *initialNodeFacade) GetAccountHistory(_ string, _ common.AccountHistoryQueryOptions) (*common.AccountHistoryAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetGuardianData returns error
 func (inf *initialNodeFacade) GetGuardianData(_ string, _ api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error) {
 	return api.GuardianData{}, api.BlockInfo{}, errNodeStarting
@@ -392,7 +442,7 @@ func (inf *initialNodeFacade) GetGenesisBalances() ([]*common.InitialAccountAPI,
 }
 
 // GetTransactionsPool returns a nil structure and error
-func (inf *initialNodeFacade) GetTransactionsPool(_ string) (*common.TransactionsPoolAPIResponse, error) {
+func (inf *initialNodeFacade) GetTransactionsPool(_ string, _ common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 	return nil, errNodeStarting
 }
 
@@ -406,6 +456,16 @@ func (inf *initialNodeFacade) GetTransactionsPoolNonceGapsForSender(_ string) (*
 	return nil, errNodeStarting
 }
 
+// GetTransactionsPoolSenderDetails returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsPoolSenderDetails(_ string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsPoolStatistics returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsPoolStatistics() (*common.TransactionsPoolStatisticsApiResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetTransactionsPoolForSender returns a nil structure and error
 func (inf *initialNodeFacade) GetTransactionsPoolForSender(_, _ string) (*common.TransactionsPoolForSenderApiResponse, error) {
 	return nil, errNodeStarting
@@ -451,6 +511,26 @@ func (inf *initialNodeFacade) GetWaitingEpochsLeftForPublicKey(_ string) (uint32
 	return 0, errNodeStarting
 }
 
+// AddManagedKey returns empty string and error
+func (inf *initialNodeFacade) AddManagedKey(_ string) (string, error) {
+	return "", errNodeStarting
+}
+
+// RemoveManagedKey returns error
+func (inf *initialNodeFacade) RemoveManagedKey(_ string) error {
+	return errNodeStarting
+}
+
+// StepDownRedundancyLease returns error
+func (inf *initialNodeFacade) StepDownRedundancyLease() error {
+	return errNodeStarting
+}
+
+// IsAdminAuthorized returns false
+func (inf *initialNodeFacade) IsAdminAuthorized(_ string, _ string) bool {
+	return false
+}
+
 // P2PPrometheusMetricsEnabled returns either the p2p prometheus metrics are enabled or not
 func (inf *initialNodeFacade) P2PPrometheusMetricsEnabled() bool {
 	return inf.p2pPrometheusMetricsEnabled
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -88,7 +89,7 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	err = inf.ValidateTransaction(nil)
 	assert.Equal(t, errNodeStarting, err)
 
-	err = inf.ValidateTransactionForSimulation(nil, false)
+	err = inf.ValidateTransactionForSimulation(nil, false, nil)
 	assert.Equal(t, errNodeStarting, err)
 
 	v1, err := inf.ValidatorStatisticsApi()
@@ -103,15 +104,23 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Equal(t, uint64(0), u1)
 	assert.Equal(t, errNodeStarting, err)
 
-	u2, err := inf.SimulateTransactionExecution(nil)
+	u2, err := inf.SimulateTransactionExecution(nil, nil, false)
 	assert.Nil(t, u2)
 	assert.Equal(t, errNodeStarting, err)
 
+	bundleResults, err := inf.SimulateTransactionsBundleExecution(nil)
+	assert.Nil(t, bundleResults)
+	assert.Equal(t, errNodeStarting, err)
+
+	traceResults, err := inf.TraceTransaction("")
+	assert.Nil(t, traceResults)
+	assert.Equal(t, errNodeStarting, err)
+
 	t1, err := inf.GetTransaction("", false)
 	assert.Nil(t, t1)
 	assert.Equal(t, errNodeStarting, err)
 
-	resp, err := inf.ComputeTransactionGasLimit(nil)
+	resp, err := inf.ComputeTransactionGasLimit(nil, nil)
 	assert.Nil(t, resp)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -151,6 +160,8 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, qh)
 	assert.Equal(t, errNodeStarting, err)
 
+	assert.Empty(t, inf.GetInterceptorResolverDebugCounters())
+
 	qp, err := inf.GetPeerInfo("")
 	assert.Nil(t, qp)
 	assert.Equal(t, errNodeStarting, err)
@@ -185,10 +196,34 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, proof)
 	assert.Equal(t, errNodeStarting, err)
 
+	stateDiff, err := inf.GetStateDiff(common.StateDiffQueryOptions{})
+	assert.Nil(t, stateDiff)
+	assert.Equal(t, errNodeStarting, err)
+
 	b, err = inf.VerifyProof("", "", nil)
 	assert.False(t, b)
 	assert.Equal(t, errNodeStarting, err)
 
+	keyValuePairsPage, _, err := inf.GetKeyValuePairsPage("", api.AccountQueryOptions{}, nil, 0, nil)
+	assert.Nil(t, keyValuePairsPage)
+	assert.Equal(t, errNodeStarting, err)
+
+	accountHistory, err := inf.GetAccountHistory("", common.AccountHistoryQueryOptions{})
+	assert.Nil(t, accountHistory)
+	assert.Equal(t, errNodeStarting, err)
+
+	multiProof, err := inf.GetMultiProof("", nil)
+	assert.Nil(t, multiProof)
+	assert.Equal(t, errNodeStarting, err)
+
+	multiProofDataTrie, err := inf.GetMultiProofDataTrie("", "", nil)
+	assert.Nil(t, multiProofDataTrie)
+	assert.Equal(t, errNodeStarting, err)
+
+	b, err = inf.VerifyMultiProof("", nil, nil, nil)
+	assert.False(t, b)
+	assert.Equal(t, errNodeStarting, err)
+
 	sa, _, err := inf.GetNFTTokenIDsRegisteredByAddress("", api.AccountQueryOptions{})
 	assert.Nil(t, sa)
 	assert.Equal(t, errNodeStarting, err)
@@ -224,7 +259,7 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, supply)
 	assert.Equal(t, errNodeStarting, err)
 
-	txPool, err := inf.GetTransactionsPool("")
+	txPool, err := inf.GetTransactionsPool("", common.TransactionsPoolFilter{})
 	assert.Nil(t, txPool)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -237,6 +272,14 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, gasConfig)
 	assert.Equal(t, errNodeStarting, err)
 
+	senderDetails, err := inf.GetTransactionsPoolSenderDetails("")
+	assert.Nil(t, senderDetails)
+	assert.Equal(t, errNodeStarting, err)
+
+	poolStatistics, err := inf.GetTransactionsPoolStatistics()
+	assert.Nil(t, poolStatistics)
+	assert.Equal(t, errNodeStarting, err)
+
 	txs, err := inf.GetTransactionsPoolForSender("", "")
 	assert.Nil(t, txs)
 	assert.Equal(t, errNodeStarting, err)
@@ -280,6 +323,10 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, epochStartData)
 	assert.Equal(t, errNodeStarting, err)
 
+	trieStatistics, err := inf.GetTrieStatistics("")
+	assert.Nil(t, trieStatistics)
+	assert.Equal(t, errNodeStarting, err)
+
 	alteredAcc, err := inf.GetAlteredAccountsForBlock(api.GetAlteredAccountsForBlockOptions{})
 	assert.Nil(t, alteredAcc)
 	assert.Equal(t, errNodeStarting, err)
@@ -2,6 +2,7 @@ package initial
 
 import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/node/external"
 )
@@ -41,6 +42,11 @@ func (provider *initialStatusMetricsProvider) StatusMetricsWithoutP2PPrometheusS
 	return "", errNodeStarting
 }
 
+// StatusMetricsPrometheusString returns an empty string and the error which specifies that the node is starting
+func (provider *initialStatusMetricsProvider) StatusMetricsPrometheusString(_ []*debug.InterceptorResolverCounters) (string, error) {
+	return "", errNodeStarting
+}
+
 // EconomicsMetrics returns an empty map and the error which specifies that the node is starting
 func (provider *initialStatusMetricsProvider) EconomicsMetrics() (map[string]interface{}, error) {
 	return getEmptyReturnValues()
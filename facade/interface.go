@@ -41,6 +41,12 @@ type NodeHandler interface {
 	// GetKeyValuePairs returns the key-value pairs under a given address
 	GetKeyValuePairs(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]string, api.BlockInfo, error)
 
+	// GetKeyValuePairsPage returns a page of the key-value pairs under a given address
+	GetKeyValuePairsPage(address string, options api.AccountQueryOptions, startKey []byte, pageSize int, keyPrefix []byte, ctx context.Context) (*common.KeyValuePairsPage, api.BlockInfo, error)
+
+	// GetAccountHistory returns a page of the balances and nonces recorded for a given address
+	GetAccountHistory(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryAPIResponse, error)
+
 	// GetAllIssuedESDTs returns all the issued esdt tokens from esdt system smart contract
 	GetAllIssuedESDTs(tokenType string, ctx context.Context) ([]string, error)
 
@@ -67,7 +73,7 @@ type NodeHandler interface {
 
 	// ValidateTransaction will validate a transaction
 	ValidateTransaction(tx *transaction.Transaction) error
-	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
+	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool, stateOverrides map[string]*txSimData.AccountOverride) error
 
 	// SendBulkTransactions will send a bulk of transactions on the 'send transactions pipe' channel
 	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
@@ -100,14 +106,20 @@ type NodeHandler interface {
 	DecodeAddressPubkey(pk string) ([]byte, error)
 
 	GetQueryHandler(name string) (debug.QueryHandler, error)
+	GetInterceptorResolverDebugCounters() []*debug.InterceptorResolverCounters
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetwork() (string, error)
 
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
+	GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error)
 
 	GetProof(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, keys []string) (*common.GetMultiProofResponse, error)
+	GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetMultiProofDataTrieResponse, error)
+	VerifyMultiProof(rootHash string, keys []string, values [][]byte, proof [][]byte) (bool, error)
+	GetStateDiff(options common.StateDiffQueryOptions, ctx context.Context) (*common.StateDiffAPIResponse, error)
 	IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error)
 }
 
@@ -120,17 +132,21 @@ type TransactionSimulatorProcessor interface {
 // ApiResolver defines a structure capable of resolving REST API requests
 type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
-	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransaction(txHash string) (*txSimData.SimulationResultsWithVMOutput, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedList(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsList(ctx context.Context) ([]*api.Delegator, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolSenderDetails(sender string, senderAccountNonce uint64) (*common.TransactionsPoolSenderDetailsApiResponse, error)
+	GetTransactionsPoolStatistics() (*common.TransactionsPoolStatisticsApiResponse, error)
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
@@ -153,6 +169,9 @@ type ApiResolver interface {
 	GetEligibleManagedKeys() ([]string, error)
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
+	AddManagedKey(key string) (string, error)
+	RemoveManagedKey(publicKey string) error
+	StepDownRedundancyLease() error
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -18,8 +18,10 @@ import (
 type ApiResolverStub struct {
 	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vmcommon.VMOutput, common.BlockInfo, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
-	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error)
+	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundleExecutionHandler  func(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransactionHandler                     func(txHash string) (*txSimData.SimulationResultsWithVMOutput, error)
 	GetTotalStakedValueHandler                  func(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedListHandler                  func(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                    func(ctx context.Context) ([]*api.Delegator, error)
@@ -38,11 +40,13 @@ type ApiResolverStub struct {
 	GetInternalStartOfEpochMetaBlockCalled      func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
 	GetInternalStartOfEpochValidatorsInfoCalled func(epoch uint32) ([]*state.ShardValidatorInfo, error)
 	GetGenesisNodesPubKeysCalled                func() (map[uint32][]string, map[uint32][]string)
-	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPoolCalled                   func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error)
 	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolSenderDetailsCalled      func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolSenderDetailsApiResponse, error)
+	GetTransactionsPoolStatisticsCalled         func() (*common.TransactionsPoolStatisticsApiResponse, error)
 	GetGasConfigsCalled                         func() map[string]map[string]uint64
 	GetManagedKeysCountCalled                   func() int
 	GetManagedKeysCalled                        func() []string
@@ -50,6 +54,9 @@ type ApiResolverStub struct {
 	GetEligibleManagedKeysCalled                func() ([]string, error)
 	GetWaitingManagedKeysCalled                 func() ([]string, error)
 	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
+	AddManagedKeyCalled                         func(key string) (string, error)
+	RemoveManagedKeyCalled                      func(publicKey string) error
+	StepDownRedundancyLeaseCalled               func() error
 }
 
 // GetTransaction -
@@ -116,18 +123,34 @@ func (ars *ApiResolverStub) StatusMetrics() external.StatusMetricsHandler {
 }
 
 // ComputeTransactionGasLimit -
-func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error) {
 	if ars.ComputeTransactionGasLimitHandler != nil {
-		return ars.ComputeTransactionGasLimitHandler(tx)
+		return ars.ComputeTransactionGasLimitHandler(tx, stateOverrides)
 	}
 
 	return nil, nil
 }
 
 // SimulateTransactionExecution -
-func (ars *ApiResolverStub) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (ars *ApiResolverStub) SimulateTransactionExecution(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 	if ars.SimulateTransactionExecutionHandler != nil {
-		return ars.SimulateTransactionExecutionHandler(tx)
+		return ars.SimulateTransactionExecutionHandler(tx, stateOverrides, withTrace)
+	}
+	return nil, nil
+}
+
+// SimulateTransactionsBundleExecution -
+func (ars *ApiResolverStub) SimulateTransactionsBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if ars.SimulateTransactionsBundleExecutionHandler != nil {
+		return ars.SimulateTransactionsBundleExecutionHandler(txs)
+	}
+	return nil, nil
+}
+
+// TraceTransaction -
+func (ars *ApiResolverStub) TraceTransaction(txHash string) (*txSimData.SimulationResultsWithVMOutput, error) {
+	if ars.TraceTransactionHandler != nil {
+		return ars.TraceTransactionHandler(txHash)
 	}
 	return nil, nil
 }
@@ -192,9 +215,9 @@ func (ars *ApiResolverStub) GetInternalMetaBlockByNonce(format common.ApiOutputF
 }
 
 // GetTransactionsPool -
-func (ars *ApiResolverStub) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
+func (ars *ApiResolverStub) GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 	if ars.GetTransactionsPoolCalled != nil {
-		return ars.GetTransactionsPoolCalled(fields)
+		return ars.GetTransactionsPoolCalled(fields, filter)
 	}
 
 	return nil, nil
@@ -227,6 +250,24 @@ func (ars *ApiResolverStub) GetTransactionsPoolNonceGapsForSender(sender string,
 	return nil, nil
 }
 
+// GetTransactionsPoolSenderDetails -
+func (ars *ApiResolverStub) GetTransactionsPoolSenderDetails(sender string, senderAccountNonce uint64) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+	if ars.GetTransactionsPoolSenderDetailsCalled != nil {
+		return ars.GetTransactionsPoolSenderDetailsCalled(sender, senderAccountNonce)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolStatistics -
+func (ars *ApiResolverStub) GetTransactionsPoolStatistics() (*common.TransactionsPoolStatisticsApiResponse, error) {
+	if ars.GetTransactionsPoolStatisticsCalled != nil {
+		return ars.GetTransactionsPoolStatisticsCalled()
+	}
+
+	return nil, nil
+}
+
 // GetInternalMetaBlockByHash -
 func (ars *ApiResolverStub) GetInternalMetaBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error) {
 	if ars.GetInternalMetaBlockByHashCalled != nil {
@@ -342,6 +383,30 @@ func (ars *ApiResolverStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (
 	return 0, nil
 }
 
+// AddManagedKey -
+func (ars *ApiResolverStub) AddManagedKey(key string) (string, error) {
+	if ars.AddManagedKeyCalled != nil {
+		return ars.AddManagedKeyCalled(key)
+	}
+	return "", nil
+}
+
+// RemoveManagedKey -
+func (ars *ApiResolverStub) RemoveManagedKey(publicKey string) error {
+	if ars.RemoveManagedKeyCalled != nil {
+		return ars.RemoveManagedKeyCalled(publicKey)
+	}
+	return nil
+}
+
+// StepDownRedundancyLease -
+func (ars *ApiResolverStub) StepDownRedundancyLease() error {
+	if ars.StepDownRedundancyLeaseCalled != nil {
+		return ars.StepDownRedundancyLeaseCalled()
+	}
+	return nil
+}
+
 // Close -
 func (ars *ApiResolverStub) Close() error {
 	return nil
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 )
 
 // NodeStub -
@@ -23,7 +24,7 @@ type NodeStub struct {
 	GenerateTransactionHandler                     func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler                       func(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
-	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction, bypassSignature bool) error
+	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction, bypassSignature bool, stateOverrides map[string]*txSimData.AccountOverride) error
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
 	GetAccountCalled                               func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
 	GetAccountWithKeysCalled                       func(address string, options api.AccountQueryOptions, ctx context.Context) (api.AccountResponse, api.BlockInfo, error)
@@ -36,11 +37,13 @@ type NodeStub struct {
 	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
+	GetInterceptorResolverDebugCountersCalled      func() []*debug.InterceptorResolverCounters
 	GetValueForKeyCalled                           func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetGuardianDataCalled                          func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetConnectedPeersRatingsOnMainNetworkCalled    func() (string, error)
 	GetEpochStartDataAPICalled                     func(epoch uint32) (*common.EpochStartDataAPI, error)
+	GetTrieStatisticsCalled                        func(rootHash string) (*common.TrieStatisticsAPIResponse, error)
 	GetUsernameCalled                              func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetCodeHashCalled                              func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
 	GetESDTDataCalled                              func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
@@ -49,10 +52,16 @@ type NodeStub struct {
 	GetESDTsWithRoleCalled                         func(address string, role string, options api.AccountQueryOptions, ctx context.Context) ([]string, api.BlockInfo, error)
 	GetESDTsRolesCalled                            func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string][]string, api.BlockInfo, error)
 	GetKeyValuePairsCalled                         func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]string, api.BlockInfo, error)
+	GetKeyValuePairsPageCalled                     func(address string, options api.AccountQueryOptions, startKey []byte, pageSize int, keyPrefix []byte, ctx context.Context) (*common.KeyValuePairsPage, api.BlockInfo, error)
+	GetAccountHistoryCalled                        func(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryAPIResponse, error)
 	GetAllIssuedESDTsCalled                        func(tokenType string, ctx context.Context) ([]string, error)
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProofCalled                            func(rootHash string, keys []string) (*common.GetMultiProofResponse, error)
+	GetMultiProofDataTrieCalled                    func(rootHash string, address string, keys []string) (*common.GetMultiProofDataTrieResponse, error)
+	VerifyMultiProofCalled                         func(rootHash string, keys []string, values [][]byte, proof [][]byte) (bool, error)
+	GetStateDiffCalled                             func(options common.StateDiffQueryOptions, ctx context.Context) (*common.StateDiffAPIResponse, error)
 	GetTokenSupplyCalled                           func(token string) (*api.ESDTSupply, error)
 	IsDataTrieMigratedCalled                       func(address string, options api.AccountQueryOptions) (bool, error)
 	AuctionListApiCalled                           func() ([]*common.AuctionListValidatorAPIResponse, error)
@@ -67,6 +76,42 @@ func (ns *NodeStub) GetProof(rootHash string, key string) (*common.GetProofRespo
 	return nil, nil
 }
 
+// GetMultiProof -
+func (ns *NodeStub) GetMultiProof(rootHash string, keys []string) (*common.GetMultiProofResponse, error) {
+	if ns.GetMultiProofCalled != nil {
+		return ns.GetMultiProofCalled(rootHash, keys)
+	}
+
+	return nil, nil
+}
+
+// GetMultiProofDataTrie -
+func (ns *NodeStub) GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetMultiProofDataTrieResponse, error) {
+	if ns.GetMultiProofDataTrieCalled != nil {
+		return ns.GetMultiProofDataTrieCalled(rootHash, address, keys)
+	}
+
+	return nil, nil
+}
+
+// VerifyMultiProof -
+func (ns *NodeStub) VerifyMultiProof(rootHash string, keys []string, values [][]byte, proof [][]byte) (bool, error) {
+	if ns.VerifyMultiProofCalled != nil {
+		return ns.VerifyMultiProofCalled(rootHash, keys, values, proof)
+	}
+
+	return false, nil
+}
+
+// GetStateDiff -
+func (ns *NodeStub) GetStateDiff(options common.StateDiffQueryOptions, ctx context.Context) (*common.StateDiffAPIResponse, error) {
+	if ns.GetStateDiffCalled != nil {
+		return ns.GetStateDiffCalled(options, ctx)
+	}
+
+	return nil, nil
+}
+
 // GetProofDataTrie -
 func (ns *NodeStub) GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error) {
 	if ns.GetProofDataTrieCalled != nil {
@@ -112,6 +157,24 @@ func (ns *NodeStub) GetKeyValuePairs(address string, options api.AccountQueryOpt
 	return nil, api.BlockInfo{}, nil
 }
 
+// GetKeyValuePairsPage -
+func (ns *NodeStub) GetKeyValuePairsPage(address string, options api.AccountQueryOptions, startKey []byte, pageSize int, keyPrefix []byte, ctx context.Context) (*common.KeyValuePairsPage, api.BlockInfo, error) {
+	if ns.GetKeyValuePairsPageCalled != nil {
+		return ns.GetKeyValuePairsPageCalled(address, options, startKey, pageSize, keyPrefix, ctx)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
+// GetAccountHistory -
+func (ns *NodeStub) GetAccountHistory(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryAPIResponse, error) {
+	if ns.GetAccountHistoryCalled != nil {
+		return ns.GetAccountHistoryCalled(address, options)
+	}
+
+	return nil, nil
+}
+
 // GetValueForKey -
 func (ns *NodeStub) GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if ns.GetValueForKeyCalled != nil {
@@ -164,9 +227,9 @@ func (ns *NodeStub) ValidateTransaction(tx *transaction.Transaction) error {
 }
 
 // ValidateTransactionForSimulation -
-func (ns *NodeStub) ValidateTransactionForSimulation(tx *transaction.Transaction, bypassSignature bool) error {
+func (ns *NodeStub) ValidateTransactionForSimulation(tx *transaction.Transaction, bypassSignature bool, stateOverrides map[string]*txSimData.AccountOverride) error {
 	if ns.ValidateTransactionForSimulationCalled != nil {
-		return ns.ValidateTransactionForSimulationCalled(tx, bypassSignature)
+		return ns.ValidateTransactionForSimulationCalled(tx, bypassSignature, stateOverrides)
 	}
 
 	return nil
@@ -253,6 +316,15 @@ func (ns *NodeStub) IsSelfTrigger() bool {
 	return false
 }
 
+// GetInterceptorResolverDebugCounters -
+func (ns *NodeStub) GetInterceptorResolverDebugCounters() []*debug.InterceptorResolverCounters {
+	if ns.GetInterceptorResolverDebugCountersCalled != nil {
+		return ns.GetInterceptorResolverDebugCountersCalled()
+	}
+
+	return nil
+}
+
 // GetQueryHandler -
 func (ns *NodeStub) GetQueryHandler(name string) (debug.QueryHandler, error) {
 	if ns.GetQueryHandlerCalled != nil {
@@ -289,6 +361,15 @@ func (ns *NodeStub) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAP
 	return &common.EpochStartDataAPI{}, nil
 }
 
+// GetTrieStatistics -
+func (ns *NodeStub) GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+	if ns.GetTrieStatisticsCalled != nil {
+		return ns.GetTrieStatisticsCalled(rootHash)
+	}
+
+	return nil, nil
+}
+
 // GetESDTData -
 func (ns *NodeStub) GetESDTData(address, tokenID string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error) {
 	if ns.GetESDTDataCalled != nil {
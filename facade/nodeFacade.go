@@ -3,6 +3,7 @@ package facade
 import (
 	"bytes"
 	"context"
+	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -229,6 +230,19 @@ func (nf *nodeFacade) GetKeyValuePairs(address string, options apiData.AccountQu
 	return nf.node.GetKeyValuePairs(address, options, ctx)
 }
 
+// GetKeyValuePairsPage returns a page of the key-value pairs under the provided address
+func (nf *nodeFacade) GetKeyValuePairsPage(address string, options apiData.AccountQueryOptions, startKey []byte, pageSize int, keyPrefix []byte) (*common.KeyValuePairsPage, apiData.BlockInfo, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetKeyValuePairsPage(address, options, startKey, pageSize, keyPrefix, ctx)
+}
+
+// GetAccountHistory returns a page of the balances and nonces recorded for the provided address
+func (nf *nodeFacade) GetAccountHistory(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryAPIResponse, error) {
+	return nf.node.GetAccountHistory(address, options)
+}
+
 // GetGuardianData returns the guardian data for the provided address
 func (nf *nodeFacade) GetGuardianData(address string, options apiData.AccountQueryOptions) (apiData.GuardianData, apiData.BlockInfo, error) {
 	return nf.node.GetGuardianData(address, options)
@@ -275,8 +289,8 @@ func (nf *nodeFacade) ValidateTransaction(tx *transaction.Transaction) error {
 }
 
 // ValidateTransactionForSimulation will validate a transaction for the simulation process
-func (nf *nodeFacade) ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error {
-	return nf.node.ValidateTransactionForSimulation(tx, checkSignature)
+func (nf *nodeFacade) ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool, stateOverrides map[string]*txSimData.AccountOverride) error {
+	return nf.node.ValidateTransactionForSimulation(tx, checkSignature, stateOverrides)
 }
 
 // ValidatorStatisticsApi will return the statistics for all validators
@@ -295,8 +309,18 @@ func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint
 }
 
 // SimulateTransactionExecution will simulate a transaction's execution and will return the results
-func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
-	return nf.apiResolver.SimulateTransactionExecution(tx)
+func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nf.apiResolver.SimulateTransactionExecution(tx, stateOverrides, withTrace)
+}
+
+// SimulateTransactionsBundleExecution will simulate the execution of the provided transactions, in order, and will return the results
+func (nf *nodeFacade) SimulateTransactionsBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	return nf.apiResolver.SimulateTransactionsBundleExecution(txs)
+}
+
+// TraceTransaction will re-execute the transaction with the specified hash and will return the execution trace
+func (nf *nodeFacade) TraceTransaction(txHash string) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nf.apiResolver.TraceTransaction(txHash)
 }
 
 // GetTransaction gets the transaction with a specified hash
@@ -305,8 +329,8 @@ func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transactio
 }
 
 // GetTransactionsPool will return a structure containing the transactions pool that is to be returned on API calls
-func (nf *nodeFacade) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
-	return nf.apiResolver.GetTransactionsPool(fields)
+func (nf *nodeFacade) GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
+	return nf.apiResolver.GetTransactionsPool(fields, filter)
 }
 
 // GetTransactionsPoolForSender will return a structure containing the transactions for sender that is to be returned on API calls
@@ -329,9 +353,24 @@ func (nf *nodeFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*com
 	return nf.apiResolver.GetTransactionsPoolNonceGapsForSender(sender, accountResponse.Nonce)
 }
 
+// GetTransactionsPoolSenderDetails will return the transactions, the nonce gaps and the stuck transactions from pool for sender, that is to be returned on API calls
+func (nf *nodeFacade) GetTransactionsPoolSenderDetails(sender string) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+	accountResponse, _, err := nf.node.GetAccount(sender, apiData.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return nf.apiResolver.GetTransactionsPoolSenderDetails(sender, accountResponse.Nonce)
+}
+
+// GetTransactionsPoolStatistics will return the statistics of the transactions pool that is to be returned on API calls
+func (nf *nodeFacade) GetTransactionsPoolStatistics() (*common.TransactionsPoolStatisticsApiResponse, error) {
+	return nf.apiResolver.GetTransactionsPoolStatistics()
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
-func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
-	return nf.apiResolver.ComputeTransactionGasLimit(tx)
+func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error) {
+	return nf.apiResolver.ComputeTransactionGasLimit(tx, stateOverrides)
 }
 
 // GetAccount returns a response containing information about the account correlated with provided address
@@ -474,11 +513,22 @@ func (nf *nodeFacade) GetQueryHandler(name string) (debug.QueryHandler, error) {
 	return nf.node.GetQueryHandler(name)
 }
 
+// GetInterceptorResolverDebugCounters returns the aggregated counters of the interceptor-resolver debugger
+func (nf *nodeFacade) GetInterceptorResolverDebugCounters() []*debug.InterceptorResolverCounters {
+	return nf.node.GetInterceptorResolverDebugCounters()
+}
+
 // GetEpochStartDataAPI returns epoch start data of the provided epoch
 func (nf *nodeFacade) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error) {
 	return nf.node.GetEpochStartDataAPI(epoch)
 }
 
+// GetTrieStatistics starts collecting the statistics of the state trie with the provided root hash and returns the
+// state of the collecting job
+func (nf *nodeFacade) GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+	return nf.node.GetTrieStatistics(rootHash)
+}
+
 // GetPeerInfo returns the peer info of a provided pid
 func (nf *nodeFacade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return nf.node.GetPeerInfo(pid)
@@ -603,6 +653,30 @@ func (nf *nodeFacade) VerifyProof(rootHash string, address string, proof [][]byt
 	return nf.node.VerifyProof(rootHash, address, proof)
 }
 
+// GetMultiProof returns the Merkle proof which proves the inclusion or the exclusion of all the given keys
+func (nf *nodeFacade) GetMultiProof(rootHash string, keys []string) (*common.GetMultiProofResponse, error) {
+	return nf.node.GetMultiProof(rootHash, keys)
+}
+
+// GetMultiProofDataTrie returns the Merkle proof for the given address, chained to a single Merkle proof which proves
+// the inclusion or the exclusion of all the given keys in the data trie of the account
+func (nf *nodeFacade) GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetMultiProofDataTrieResponse, error) {
+	return nf.node.GetMultiProofDataTrie(rootHash, address, keys)
+}
+
+// VerifyMultiProof verifies the given Merkle multi proof
+func (nf *nodeFacade) VerifyMultiProof(rootHash string, keys []string, values [][]byte, proof [][]byte) (bool, error) {
+	return nf.node.VerifyMultiProof(rootHash, keys, values, proof)
+}
+
+// GetStateDiff returns a page of the accounts which were added, removed or modified between the blocks with the provided nonces
+func (nf *nodeFacade) GetStateDiff(options common.StateDiffQueryOptions) (*common.StateDiffAPIResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetStateDiff(options, ctx)
+}
+
 // IsDataTrieMigrated returns true if the data trie for the given address is migrated
 func (nf *nodeFacade) IsDataTrieMigrated(address string, options apiData.AccountQueryOptions) (bool, error) {
 	return nf.node.IsDataTrieMigrated(address, options)
@@ -638,6 +712,35 @@ func (nf *nodeFacade) GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32
 	return nf.apiResolver.GetWaitingEpochsLeftForPublicKey(publicKey)
 }
 
+// AddManagedKey adds a managed key while the node is running, returning the public key of the added key
+func (nf *nodeFacade) AddManagedKey(key string) (string, error) {
+	return nf.apiResolver.AddManagedKey(key)
+}
+
+// RemoveManagedKey removes a managed key while the node is running
+func (nf *nodeFacade) RemoveManagedKey(publicKey string) error {
+	return nf.apiResolver.RemoveManagedKey(publicKey)
+}
+
+// StepDownRedundancyLease releases the redundancy lease held by the node, so another instance can take over the signing
+func (nf *nodeFacade) StepDownRedundancyLease() error {
+	return nf.apiResolver.StepDownRedundancyLease()
+}
+
+// IsAdminAuthorized returns true if the provided credentials match the ones configured for the admin routes.
+// It always returns false if the admin credentials are not configured
+func (nf *nodeFacade) IsAdminAuthorized(username string, password string) bool {
+	adminAuthorization := nf.apiRoutesConfig.AdminAuthorization
+	if len(adminAuthorization.Username) == 0 || len(adminAuthorization.Password) == 0 {
+		return false
+	}
+
+	isUsernameOk := subtle.ConstantTimeCompare([]byte(username), []byte(adminAuthorization.Username)) == 1
+	isPasswordOk := subtle.ConstantTimeCompare([]byte(password), []byte(adminAuthorization.Password)) == 1
+
+	return isUsernameOk && isPasswordOk
+}
+
 func (nf *nodeFacade) convertVmOutputToApiResponse(input *vmcommon.VMOutput) *vm.VMOutputApi {
 	outputAccounts := make(map[string]*vm.OutputAccountApi)
 	for key, acc := range input.OutputAccounts {
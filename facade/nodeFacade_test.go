@@ -742,6 +742,27 @@ func TestNodeFacade_GetQueryHandler(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestNodeFacade_GetInterceptorResolverDebugCounters(t *testing.T) {
+	t.Parallel()
+
+	expectedCounters := []*debug.InterceptorResolverCounters{
+		{
+			EventType: "request",
+			Topic:     "topic",
+			NumEvents: 1,
+		},
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetInterceptorResolverDebugCountersCalled: func() []*debug.InterceptorResolverCounters {
+			return expectedCounters
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	require.Equal(t, expectedCounters, nf.GetInterceptorResolverDebugCounters())
+}
+
 func TestNodeFacade_GetPeerInfo(t *testing.T) {
 	t.Parallel()
 
@@ -1023,14 +1044,14 @@ func TestNodeFacade_ValidateTransactionForSimulation(t *testing.T) {
 	called := false
 	arg := createMockArguments()
 	arg.Node = &mock.NodeStub{
-		ValidateTransactionForSimulationCalled: func(tx *transaction.Transaction, bypassSignature bool) error {
+		ValidateTransactionForSimulationCalled: func(tx *transaction.Transaction, bypassSignature bool, stateOverrides map[string]*txSimData.AccountOverride) error {
 			called = true
 			return nil
 		},
 	}
 	nf, _ := NewNodeFacade(arg)
 
-	err := nf.ValidateTransactionForSimulation(&transaction.Transaction{}, false)
+	err := nf.ValidateTransactionForSimulation(&transaction.Transaction{}, false, nil)
 	require.NoError(t, err)
 	require.True(t, called)
 }
@@ -1126,6 +1147,250 @@ func TestNodeFacade_GetProof(t *testing.T) {
 	require.Equal(t, expectedResponse, response)
 }
 
+func TestNodeFacade_GetKeyValuePairsPage(t *testing.T) {
+	t.Parallel()
+
+	expectedPage := &common.KeyValuePairsPage{
+		Pairs:   []common.KeyValuePair{{Key: "6b6579", Value: "76616c7565"}},
+		NextKey: "abcd",
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetKeyValuePairsPageCalled: func(_ string, _ api.AccountQueryOptions, startKey []byte, pageSize int, keyPrefix []byte, _ context.Context) (*common.KeyValuePairsPage, api.BlockInfo, error) {
+			require.Equal(t, []byte("start"), startKey)
+			require.Equal(t, 10, pageSize)
+			require.Equal(t, []byte("prefix"), keyPrefix)
+			return expectedPage, api.BlockInfo{}, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	page, _, err := nf.GetKeyValuePairsPage("addr", api.AccountQueryOptions{}, []byte("start"), 10, []byte("prefix"))
+	require.NoError(t, err)
+	require.Equal(t, expectedPage, page)
+}
+
+func TestNodeFacade_GetAccountHistory(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &common.AccountHistoryAPIResponse{
+		Entries: []*common.AccountHistoryEntryAPIResponse{
+			{BlockNonce: 7, BlockHash: "abcd", Balance: "100", Nonce: 2},
+		},
+	}
+	expectedOptions := common.AccountHistoryQueryOptions{
+		FromNonce: 5,
+		ToNonce:   core.OptionalUint64{Value: 10, HasValue: true},
+		PageSize:  20,
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetAccountHistoryCalled: func(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryAPIResponse, error) {
+			require.Equal(t, "addr", address)
+			require.Equal(t, expectedOptions, options)
+			return expectedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.GetAccountHistory("addr", expectedOptions)
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_GetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &common.GetMultiProofResponse{
+		Proof:    [][]byte{[]byte("valid"), []byte("proof")},
+		Values:   [][]byte{[]byte("value"), nil},
+		RootHash: "rootHash",
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetMultiProofCalled: func(_ string, _ []string) (*common.GetMultiProofResponse, error) {
+			return expectedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.GetMultiProof("hash", []string{"key1", "key2"})
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_GetMultiProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &common.GetMultiProofDataTrieResponse{
+		MainProof: &common.GetProofResponse{
+			Proof: [][]byte{[]byte("main"), []byte("proof")},
+		},
+		DataTrieProof: &common.GetMultiProofResponse{
+			Proof: [][]byte{[]byte("data trie"), []byte("proof")},
+		},
+		Values: [][]byte{[]byte("value"), nil},
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetMultiProofDataTrieCalled: func(_ string, _ string, _ []string) (*common.GetMultiProofDataTrieResponse, error) {
+			return expectedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.GetMultiProofDataTrie("hash", "address", []string{"key1", "key2"})
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_VerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		VerifyMultiProofCalled: func(_ string, _ []string, _ [][]byte, _ [][]byte) (bool, error) {
+			return true, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.VerifyMultiProof("hash", []string{"key"}, [][]byte{nil}, [][]byte{[]byte("proof")})
+	require.NoError(t, err)
+	require.True(t, response)
+}
+
+func TestNodeFacade_GetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &common.StateDiffAPIResponse{
+		Added: []*common.AccountDiffAPIResponse{
+			{
+				Address: "address",
+			},
+		},
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetStateDiffCalled: func(options common.StateDiffQueryOptions, ctx context.Context) (*common.StateDiffAPIResponse, error) {
+			require.Equal(t, uint64(1), options.FromNonce)
+			require.Equal(t, uint64(2), options.ToNonce)
+			require.NotNil(t, ctx)
+			return expectedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.GetStateDiff(common.StateDiffQueryOptions{FromNonce: 1, ToNonce: 2})
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_GetTrieStatistics(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &common.TrieStatisticsAPIResponse{
+		RootHash: "rootHash",
+		Status:   common.TrieStatisticsJobRunning,
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetTrieStatisticsCalled: func(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+			require.Equal(t, "rootHash", rootHash)
+			return expectedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.GetTrieStatistics("rootHash")
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_AddManagedKey(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		AddManagedKeyCalled: func(key string) (string, error) {
+			require.Equal(t, "key", key)
+			return "public key", nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	publicKey, err := nf.AddManagedKey("key")
+	require.NoError(t, err)
+	require.Equal(t, "public key", publicKey)
+}
+
+func TestNodeFacade_RemoveManagedKey(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		RemoveManagedKeyCalled: func(publicKey string) error {
+			require.Equal(t, "public key", publicKey)
+			return expectedErr
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	err := nf.RemoveManagedKey("public key")
+	require.Equal(t, expectedErr, err)
+}
+
+func TestNodeFacade_StepDownRedundancyLease(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		StepDownRedundancyLeaseCalled: func() error {
+			return expectedErr
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	err := nf.StepDownRedundancyLease()
+	require.Equal(t, expectedErr, err)
+}
+
+func TestNodeFacade_IsAdminAuthorized(t *testing.T) {
+	t.Parallel()
+
+	t.Run("credentials not configured should not authorize", func(t *testing.T) {
+		t.Parallel()
+
+		nf, _ := NewNodeFacade(createMockArguments())
+		require.False(t, nf.IsAdminAuthorized("", ""))
+		require.False(t, nf.IsAdminAuthorized("admin", "password"))
+	})
+	t.Run("wrong credentials should not authorize", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.ApiRoutesConfig.AdminAuthorization = config.ApiAdminAuthorizationConfig{
+			Username: "admin",
+			Password: "password",
+		}
+		nf, _ := NewNodeFacade(arg)
+		require.False(t, nf.IsAdminAuthorized("admin", "wrong password"))
+		require.False(t, nf.IsAdminAuthorized("wrong admin", "password"))
+		require.False(t, nf.IsAdminAuthorized("", ""))
+	})
+	t.Run("correct credentials should authorize", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.ApiRoutesConfig.AdminAuthorization = config.ApiAdminAuthorizationConfig{
+			Username: "admin",
+			Password: "password",
+		}
+		nf, _ := NewNodeFacade(arg)
+		require.True(t, nf.IsAdminAuthorized("admin", "password"))
+	})
+}
+
 func TestNodeFacade_GetProofCurrentRootHash(t *testing.T) {
 	t.Parallel()
 
@@ -1696,13 +1961,13 @@ func TestNodeFacade_GetTransactionsPool(t *testing.T) {
 
 		arg := createMockArguments()
 		arg.ApiResolver = &mock.ApiResolverStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return nil, expectedErr
 			},
 		}
 
 		nf, _ := NewNodeFacade(arg)
-		res, err := nf.GetTransactionsPool("")
+		res, err := nf.GetTransactionsPool("", common.TransactionsPoolFilter{})
 		require.Nil(t, res)
 		require.Equal(t, expectedErr, err)
 	})
@@ -1745,13 +2010,13 @@ func TestNodeFacade_GetTransactionsPool(t *testing.T) {
 			},
 		}
 		arg.ApiResolver = &mock.ApiResolverStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return expectedPool, nil
 			},
 		}
 
 		nf, _ := NewNodeFacade(arg)
-		res, err := nf.GetTransactionsPool("")
+		res, err := nf.GetTransactionsPool("", common.TransactionsPoolFilter{})
 		require.NoError(t, err)
 		require.Equal(t, expectedPool, res)
 	})
@@ -2080,6 +2345,86 @@ func TestNodeFacade_GetTransactionsPoolNonceGapsForSender(t *testing.T) {
 	})
 }
 
+func TestNodeFacade_GetTransactionsPoolSenderDetails(t *testing.T) {
+	t.Parallel()
+
+	t.Run("GetAccount error should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.Node = &mock.NodeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				return api.AccountResponse{}, api.BlockInfo{}, expectedErr
+			},
+		}
+		arg.ApiResolver = &mock.ApiResolverStub{
+			GetTransactionsPoolSenderDetailsCalled: func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+		res, err := nf.GetTransactionsPoolSenderDetails("")
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		expectedSender := "alice"
+		providedNonce := uint64(10)
+		expectedDetails := &common.TransactionsPoolSenderDetailsApiResponse{
+			Sender:       expectedSender,
+			AccountNonce: providedNonce,
+		}
+		arg.Node = &mock.NodeStub{
+			GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+				return api.AccountResponse{Nonce: providedNonce}, api.BlockInfo{}, nil
+			},
+		}
+		arg.ApiResolver = &mock.ApiResolverStub{
+			GetTransactionsPoolSenderDetailsCalled: func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+				require.Equal(t, expectedSender, sender)
+				require.Equal(t, providedNonce, senderAccountNonce)
+				return expectedDetails, nil
+			},
+		}
+
+		nf, _ := NewNodeFacade(arg)
+		res, err := nf.GetTransactionsPoolSenderDetails(expectedSender)
+		require.NoError(t, err)
+		require.Equal(t, expectedDetails, res)
+	})
+}
+
+func TestNodeFacade_GetTransactionsPoolStatistics(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	expectedStatistics := &common.TransactionsPoolStatisticsApiResponse{
+		Evictions: []common.TransactionsPoolEvictionRecord{
+			{
+				CacheID:       "0",
+				Reason:        "capacity",
+				NumTxsEvicted: 5,
+			},
+		},
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTransactionsPoolStatisticsCalled: func() (*common.TransactionsPoolStatisticsApiResponse, error) {
+			return expectedStatistics, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	res, err := nf.GetTransactionsPoolStatistics()
+	require.NoError(t, err)
+	require.Equal(t, expectedStatistics, res)
+}
+
 func TestNodeFacade_InternalValidatorsInfo(t *testing.T) {
 	t.Parallel()
 
@@ -2197,14 +2542,66 @@ func TestNodeFacade_SimulateTransactionExecution(t *testing.T) {
 	}
 	args := createMockArguments()
 	args.ApiResolver = &mock.ApiResolverStub{
-		SimulateTransactionExecutionHandler: func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+		SimulateTransactionExecutionHandler: func(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
+			return providedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(args)
+
+	response, err := nf.SimulateTransactionExecution(&transaction.Transaction{}, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, providedResponse, response)
+}
+
+func TestNodeFacade_SimulateTransactionsBundleExecution(t *testing.T) {
+	t.Parallel()
+
+	providedResponse := &txSimData.BundleSimulationResults{
+		Results: []*txSimData.SimulationResultsWithVMOutput{
+			{
+				SimulationResults: transaction.SimulationResults{
+					Status: "ok",
+					Hash:   "hash",
+				},
+			},
+		},
+	}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		SimulateTransactionsBundleExecutionHandler: func(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+			return providedResponse, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(args)
+
+	response, err := nf.SimulateTransactionsBundleExecution([]*transaction.Transaction{{}})
+	require.NoError(t, err)
+	require.Equal(t, providedResponse, response)
+}
+
+func TestNodeFacade_TraceTransaction(t *testing.T) {
+	t.Parallel()
+
+	providedTxHash := "hash"
+	providedResponse := &txSimData.SimulationResultsWithVMOutput{
+		SimulationResults: transaction.SimulationResults{
+			Status: "ok",
+		},
+		Trace: []*txSimData.ExecutionFrame{{Function: "function"}},
+	}
+	args := createMockArguments()
+	args.ApiResolver = &mock.ApiResolverStub{
+		TraceTransactionHandler: func(txHash string) (*txSimData.SimulationResultsWithVMOutput, error) {
+			require.Equal(t, providedTxHash, txHash)
 			return providedResponse, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(args)
 
-	response, err := nf.SimulateTransactionExecution(&transaction.Transaction{})
+	response, err := nf.TraceTransaction(providedTxHash)
 	require.NoError(t, err)
 	require.Equal(t, providedResponse, response)
 }
@@ -2217,14 +2614,14 @@ func TestNodeFacade_ComputeTransactionGasLimit(t *testing.T) {
 	}
 	args := createMockArguments()
 	args.ApiResolver = &mock.ApiResolverStub{
-		ComputeTransactionGasLimitHandler: func(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+		ComputeTransactionGasLimitHandler: func(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error) {
 			return providedResponse, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(args)
 
-	response, err := nf.ComputeTransactionGasLimit(&transaction.Transaction{})
+	response, err := nf.ComputeTransactionGasLimit(&transaction.Transaction{}, nil)
 	require.NoError(t, err)
 	require.Equal(t, providedResponse, response)
 }
@@ -15,6 +15,8 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/blacklist"
 	"github.com/multiversx/mx-chain-go/consensus/chronology"
+	"github.com/multiversx/mx-chain-go/consensus/slashingProtection"
+	disabledSlashingProtection "github.com/multiversx/mx-chain-go/consensus/slashingProtection/disabled"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/consensus/spos/sposFactory"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
@@ -77,10 +79,16 @@ type consensusComponents struct {
 	broadcastMessenger   consensus.BroadcastMessenger
 	worker               factory.ConsensusWorker
 	peerBlacklistHandler consensus.PeerBlacklistHandler
+	slashingProtector    slashingProtector
 	consensusTopic       string
 	consensusGroupSize   int
 }
 
+type slashingProtector interface {
+	spos.SlashingProtector
+	Close() error
+}
+
 // NewConsensusComponentsFactory creates an instance of consensusComponentsFactory
 func NewConsensusComponentsFactory(args ConsensusComponentsFactoryArgs) (*consensusComponentsFactory, error) {
 	err := checkArgs(args)
@@ -157,7 +165,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		ccf.coreComponents.Hasher(),
 		ccf.networkComponents.NetworkMessenger(),
 		ccf.processComponents.ShardCoordinator(),
-		ccf.cryptoComponents.PeerSignatureHandler(),
+		ccf.cryptoComponents.KeysSigner(),
 		ccf.dataComponents.Datapool().Headers(),
 		ccf.processComponents.InterceptorsContainer(),
 		ccf.coreComponents.AlarmScheduler(),
@@ -261,6 +269,11 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, err
 	}
 
+	cc.slashingProtector, err = ccf.createSlashingProtector()
+	if err != nil {
+		return nil, err
+	}
+
 	fct, err := sposFactory.GetSubroundsFactory(
 		consensusDataContainer,
 		consensusState,
@@ -269,6 +282,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		ccf.statusCoreComponents.AppStatusHandler(),
 		ccf.statusComponents.OutportHandler(),
 		ccf.processComponents.SentSignaturesTracker(),
+		cc.slashingProtector,
 		[]byte(ccf.coreComponents.ChainID()),
 		ccf.networkComponents.NetworkMessenger().ID(),
 	)
@@ -310,6 +324,10 @@ func (cc *consensusComponents) Close() error {
 	if err != nil {
 		return err
 	}
+	err = cc.slashingProtector.Close()
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -505,6 +523,7 @@ func (ccf *consensusComponentsFactory) createArgsBaseAccountsSyncer(trieStorageM
 		RequestHandler:                    ccf.processComponents.RequestHandler(),
 		Timeout:                           common.TimeoutGettingTrieNodes,
 		Cacher:                            ccf.dataComponents.Datapool().TrieNodes(),
+		LeavesRangesCacher:                ccf.dataComponents.Datapool().TrieLeavesRanges(),
 		MaxTrieLevelInMemory:              ccf.config.StateTriesConfig.MaxStateTrieLevelInMemory,
 		MaxHardCapForMissingNodes:         ccf.config.TrieSync.MaxHardCapForMissingNodes,
 		TrieSyncerVersion:                 ccf.config.TrieSync.TrieSyncerVersion,
@@ -675,6 +694,27 @@ func (ccf *consensusComponentsFactory) createP2pSigningHandler() (consensus.P2PS
 	return p2pFactory.NewMessageVerifier(p2pSignerArgs)
 }
 
+func (ccf *consensusComponentsFactory) createSlashingProtector() (slashingProtector, error) {
+	if !ccf.config.SlashingProtection.Enabled {
+		log.Debug("slashing protection is disabled")
+		return disabledSlashingProtection.NewSlashingProtector(), nil
+	}
+
+	storer, err := slashingProtection.CreateStorer(ccf.config.SlashingProtection, ccf.flagsConfig.WorkingDir)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the slashing protection storer", err)
+	}
+
+	protector, err := slashingProtection.NewSlashingProtector(storer, ccf.config.SlashingProtection.NumRoundsToKeep)
+	if err != nil {
+		return nil, err
+	}
+
+	ccf.processComponents.EpochStartNotifier().RegisterHandler(protector)
+
+	return protector, nil
+}
+
 func (ccf *consensusComponentsFactory) addCloserInstances(closers ...update.Closer) error {
 	hardforkTrigger := ccf.processComponents.HardforkTrigger()
 	for _, c := range closers {
@@ -86,6 +86,7 @@ func createMockConsensusComponentsFactoryArgs() consensusComp.ConsensusComponent
 			BlKeyGen:         &cryptoMocks.KeyGenStub{},
 			BlockSig:         &cryptoMocks.SingleSignerStub{},
 			KeysHandlerField: &testscommon.KeysHandlerStub{},
+			KeysSignerField:  &cryptoMocks.KeysSignerStub{},
 			SigHandler:       &consensusMocks.SigningHandlerStub{},
 		},
 		DataComponents: &testsMocks.DataComponentsStub{
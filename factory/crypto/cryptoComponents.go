@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -26,7 +28,10 @@ import (
 	"github.com/multiversx/mx-chain-go/factory/peerSignatureHandler"
 	"github.com/multiversx/mx-chain-go/genesis/process/disabled"
 	"github.com/multiversx/mx-chain-go/keysManagement"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
 	p2pFactory "github.com/multiversx/mx-chain-go/p2p/factory"
+	"github.com/multiversx/mx-chain-go/redundancy/lease"
+	disabledLease "github.com/multiversx/mx-chain-go/redundancy/lease/disabled"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/vm"
@@ -77,6 +82,7 @@ type cryptoParams struct {
 	publicKeyString    string
 	publicKeyBytes     []byte
 	handledPrivateKeys [][]byte
+	handledPublicKeys  [][]byte
 }
 
 // p2pCryptoParams holds the p2p public/private key data
@@ -97,7 +103,11 @@ type cryptoComponents struct {
 	p2pKeyGen               crypto.KeyGenerator
 	messageSignVerifier     vm.MessageSignVerifier
 	consensusSigningHandler consensus.SigningHandler
+	keysSigner              cryptoCommon.KeysSigner
 	managedPeersHolder      common.ManagedPeersHolder
+	managedKeysUpdater      common.ManagedKeysUpdater
+	keysFileWatcher         io.Closer
+	redundancyLeaseHandler  common.RedundancyLeaseHandler
 	keysHandler             consensus.KeysHandler
 	cryptoParams
 	p2pCryptoParams
@@ -147,7 +157,12 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 	}
 
 	blockSignKeyGen := signing.NewKeyGenerator(suite)
-	cp, err := ccf.createCryptoParams(blockSignKeyGen)
+	remoteSignerHandler, err := ccf.createRemoteKeysSigner()
+	if err != nil {
+		return nil, err
+	}
+
+	cp, err := ccf.createCryptoParams(blockSignKeyGen, remoteSignerHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -201,14 +216,22 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		return nil, err
 	}
 
+	// the lease handler starts competing for the lease, if enabled. In case of a later error, the lease is not renewed
+	// and it will expire by itself
+	redundancyLeaseHandler, err := ccf.createRedundancyLeaseHandler()
+	if err != nil {
+		return nil, err
+	}
+
 	redundancyLevel := int(ccf.prefsConfig.Preferences.RedundancyLevel)
 	maxRoundsOfInactivity := redundancyLevel * ccf.config.Redundancy.MaxRoundsOfInactivityAccepted
 	argsManagedPeersHolder := keysManagement.ArgsManagedPeersHolder{
-		KeyGenerator:          blockSignKeyGen,
-		P2PKeyGenerator:       p2pKeyGenerator,
-		MaxRoundsOfInactivity: maxRoundsOfInactivity,
-		PrefsConfig:           ccf.prefsConfig,
-		P2PKeyConverter:       p2pFactory.NewP2PKeyConverter(),
+		KeyGenerator:           blockSignKeyGen,
+		P2PKeyGenerator:        p2pKeyGenerator,
+		MaxRoundsOfInactivity:  maxRoundsOfInactivity,
+		PrefsConfig:            ccf.prefsConfig,
+		P2PKeyConverter:        p2pFactory.NewP2PKeyConverter(),
+		RedundancyLeaseHandler: redundancyLeaseHandler,
 	}
 	managedPeersHolder, err := keysManagement.NewManagedPeersHolder(argsManagedPeersHolder)
 	if err != nil {
@@ -221,6 +244,12 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 			return nil, errAddManagedPeer
 		}
 	}
+	for _, pkBytes := range cp.handledPublicKeys {
+		errAddManagedPeer := managedPeersHolder.AddManagedPublicKey(pkBytes)
+		if errAddManagedPeer != nil {
+			return nil, errAddManagedPeer
+		}
+	}
 
 	log.Debug("block sign pubkey", "value", cp.publicKeyString)
 
@@ -239,18 +268,39 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		return nil, err
 	}
 
+	keysSigner, err := ccf.createKeysSigner(remoteSignerHandler, keysHandler, interceptSingleSigner, multiSigner)
+	if err != nil {
+		return nil, err
+	}
+
 	signingHandlerArgs := ArgsSigningHandler{
 		PubKeys:              []string{cp.publicKeyString},
 		MultiSignerContainer: multiSigner,
 		KeyGenerator:         blockSignKeyGen,
 		SingleSigner:         interceptSingleSigner,
-		KeysHandler:          keysHandler,
+		KeysSigner:           keysSigner,
 	}
 	consensusSigningHandler, err := NewSigningHandler(signingHandlerArgs)
 	if err != nil {
 		return nil, err
 	}
 
+	argsManagedKeysUpdater := keysManagement.ArgsManagedKeysUpdater{
+		ManagedPeersHolder: managedPeersHolder,
+		KeyGenerator:       blockSignKeyGen,
+		UsesRemoteSigner:   !check.IfNil(remoteSignerHandler),
+	}
+	managedKeysUpdater, err := keysManagement.NewManagedKeysUpdater(argsManagedKeysUpdater)
+	if err != nil {
+		return nil, err
+	}
+
+	// the watcher starts a go routine, so it is created last
+	keysFileWatcher, err := ccf.createKeysFileWatcher(managedKeysUpdater, managedPeersHolder, remoteSignerHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	return &cryptoComponents{
 		txSingleSigner:          txSingleSigner,
 		blockSingleSigner:       interceptSingleSigner,
@@ -262,13 +312,106 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		messageSignVerifier:     messageSignVerifier,
 		consensusSigningHandler: consensusSigningHandler,
 		managedPeersHolder:      managedPeersHolder,
+		managedKeysUpdater:      managedKeysUpdater,
+		keysFileWatcher:         keysFileWatcher,
+		redundancyLeaseHandler:  redundancyLeaseHandler,
 		keysHandler:             keysHandler,
+		keysSigner:              keysSigner,
 		cryptoParams:            *cp,
 		p2pCryptoParams:         *p2pCryptoParamsInstance,
 		p2pSingleSigner:         p2pSingleSigner,
 	}, nil
 }
 
+func (ccf *cryptoComponentsFactory) createRemoteKeysSigner() (remoteKeysSigner, error) {
+	remoteSignerConfig := ccf.config.RemoteSigner
+	if !remoteSignerConfig.Enabled {
+		return nil, nil
+	}
+
+	argsRemoteKeysSigner := remoteSigner.ArgsRemoteKeysSigner{
+		BaseUrl:           remoteSignerConfig.Url,
+		RequestTimeoutSec: remoteSignerConfig.RequestTimeoutSec,
+		UseAuthorization:  remoteSignerConfig.UseAuthorization,
+		Username:          remoteSignerConfig.Username,
+		Password:          remoteSignerConfig.Password,
+		CACertFile:        remoteSignerConfig.CACertFile,
+		ClientCertFile:    remoteSignerConfig.ClientCertFile,
+		ClientKeyFile:     remoteSignerConfig.ClientKeyFile,
+	}
+
+	return remoteSigner.NewRemoteKeysSigner(argsRemoteKeysSigner)
+}
+
+func (ccf *cryptoComponentsFactory) createRedundancyLeaseHandler() (common.RedundancyLeaseHandler, error) {
+	leaseConfig := ccf.config.Redundancy.Lease
+	if !leaseConfig.Enabled {
+		return disabledLease.NewLeaseHandler(), nil
+	}
+	if ccf.prefsConfig.Preferences.RedundancyLevel != 0 {
+		log.Warn("the redundancy level is ignored because the redundant instances are coordinated through a lease",
+			"redundancy level", ccf.prefsConfig.Preferences.RedundancyLevel)
+	}
+
+	argsLeaseHandler := lease.ArgsLeaseHandler{
+		FilePath:      leaseConfig.FilePath,
+		InstanceID:    leaseConfig.InstanceID,
+		LeaseDuration: time.Duration(leaseConfig.LeaseDurationInSeconds) * time.Second,
+		RenewInterval: time.Duration(leaseConfig.RenewIntervalInSeconds) * time.Second,
+	}
+
+	return lease.NewLeaseHandler(argsLeaseHandler)
+}
+
+func (ccf *cryptoComponentsFactory) createKeysFileWatcher(
+	managedKeysSyncer keysManagement.ManagedKeysSyncer,
+	managedPeersHolder common.ManagedPeersHolder,
+	remoteSignerHandler remoteKeysSigner,
+) (io.Closer, error) {
+	watcherConfig := ccf.config.ManagedKeysWatcher
+	if !watcherConfig.Enabled {
+		return nil, nil
+	}
+	if !check.IfNil(remoteSignerHandler) {
+		log.Warn("the managed keys file watcher is not started because the node uses a remote signer")
+		return nil, nil
+	}
+	if !managedPeersHolder.IsMultiKeyMode() {
+		log.Warn("the managed keys file watcher is not started because the node was not started in multikey mode")
+		return nil, nil
+	}
+
+	argsKeysFileWatcher := keysManagement.ArgsKeysFileWatcher{
+		FilePath:          ccf.allValidatorKeysPemFileName,
+		KeysLoader:        ccf.keyLoader,
+		ManagedKeysSyncer: managedKeysSyncer,
+		PollingInterval:   time.Duration(watcherConfig.PollingIntervalInSeconds) * time.Second,
+	}
+
+	return keysManagement.NewKeysFileWatcher(argsKeysFileWatcher)
+}
+
+func (ccf *cryptoComponentsFactory) createKeysSigner(
+	remoteSignerHandler remoteKeysSigner,
+	keysHandler consensus.KeysHandler,
+	singleSigner crypto.SingleSigner,
+	multiSignerContainer cryptoCommon.MultiSignerContainer,
+) (cryptoCommon.KeysSigner, error) {
+	if !check.IfNil(remoteSignerHandler) {
+		log.Info("the node will use the remote signer for all the signing operations with the validator BLS keys",
+			"url", ccf.config.RemoteSigner.Url)
+		return remoteSignerHandler, nil
+	}
+
+	argsLocalKeysSigner := keysManagement.ArgsLocalKeysSigner{
+		PrivateKeysProvider:  keysHandler,
+		SingleSigner:         singleSigner,
+		MultiSignerContainer: multiSignerContainer,
+	}
+
+	return keysManagement.NewLocalKeysSigner(argsLocalKeysSigner)
+}
+
 func (ccf *cryptoComponentsFactory) createSingleSigner(importModeNoSigCheck bool) (crypto.SingleSigner, error) {
 	if importModeNoSigCheck {
 		log.Warn("using disabled single signer because the node is running in import-db 'turbo mode'")
@@ -314,8 +457,13 @@ func (ccf *cryptoComponentsFactory) getSuite() (crypto.Suite, error) {
 
 func (ccf *cryptoComponentsFactory) createCryptoParams(
 	keygen crypto.KeyGenerator,
+	remoteSignerHandler remoteKeysSigner,
 ) (*cryptoParams, error) {
 
+	if !check.IfNil(remoteSignerHandler) {
+		return ccf.createRemoteSignerCryptoParams(keygen, remoteSignerHandler)
+	}
+
 	handledPrivateKeys, err := ccf.processAllHandledKeys(keygen)
 	if err != nil {
 		return nil, err
@@ -347,6 +495,36 @@ func (ccf *cryptoComponentsFactory) createCryptoParams(
 	return ccf.generateCryptoParams(keygen, handledKeysInfo, handledPrivateKeys)
 }
 
+func (ccf *cryptoComponentsFactory) createRemoteSignerCryptoParams(
+	keygen crypto.KeyGenerator,
+	remoteSignerHandler remoteKeysSigner,
+) (*cryptoParams, error) {
+	if ccf.isInImportMode {
+		return nil, fmt.Errorf("invalid node configuration: import-db mode and remote signer enabled")
+	}
+
+	handledPublicKeys, err := remoteSignerHandler.GetPublicKeys()
+	if err != nil {
+		return nil, fmt.Errorf("%w while fetching the public keys from the remote signer", err)
+	}
+	if len(handledPublicKeys) == 0 {
+		return nil, ErrNoPublicKeysFromRemoteSigner
+	}
+
+	for _, pkBytes := range handledPublicKeys {
+		log.Debug("public key handled by the remote signer", "public key", hex.EncodeToString(pkBytes))
+	}
+
+	reason := fmt.Sprintf("using a remote signer managing %d keys", len(handledPublicKeys))
+	cp, err := ccf.generateCryptoParams(keygen, reason, make([][]byte, 0))
+	if err != nil {
+		return nil, err
+	}
+	cp.handledPublicKeys = handledPublicKeys
+
+	return cp, nil
+}
+
 func (ccf *cryptoComponentsFactory) readCryptoParams(keygen crypto.KeyGenerator) (*cryptoParams, error) {
 	cp := &cryptoParams{}
 	sk, readPk, err := ccf.getSkPk()
@@ -527,5 +705,19 @@ func (ccf *cryptoComponentsFactory) processPrivatePublicKey(keygen crypto.KeyGen
 
 // Close closes all underlying components that need closing
 func (cc *cryptoComponents) Close() error {
-	return nil
+	var lastErr error
+	if cc.keysFileWatcher != nil {
+		err := cc.keysFileWatcher.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+	if !check.IfNil(cc.redundancyLeaseHandler) {
+		err := cc.redundancyLeaseHandler.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
 }
@@ -371,6 +371,42 @@ func (mcc *managedCryptoComponents) KeysHandler() consensus.KeysHandler {
 	return mcc.cryptoComponents.keysHandler
 }
 
+// KeysSigner returns the component able to sign with the validator BLS keys, either locally or through a remote signer
+func (mcc *managedCryptoComponents) KeysSigner() cryptoCommon.KeysSigner {
+	mcc.mutCryptoComponents.RLock()
+	defer mcc.mutCryptoComponents.RUnlock()
+
+	if mcc.cryptoComponents == nil {
+		return nil
+	}
+
+	return mcc.cryptoComponents.keysSigner
+}
+
+// ManagedKeysUpdater returns the component able to add or remove managed keys while the node is running
+func (mcc *managedCryptoComponents) ManagedKeysUpdater() common.ManagedKeysUpdater {
+	mcc.mutCryptoComponents.RLock()
+	defer mcc.mutCryptoComponents.RUnlock()
+
+	if mcc.cryptoComponents == nil {
+		return nil
+	}
+
+	return mcc.cryptoComponents.managedKeysUpdater
+}
+
+// RedundancyLeaseHandler returns the component that coordinates the redundant instances of the node through a lease
+func (mcc *managedCryptoComponents) RedundancyLeaseHandler() common.RedundancyLeaseHandler {
+	mcc.mutCryptoComponents.RLock()
+	defer mcc.mutCryptoComponents.RUnlock()
+
+	if mcc.cryptoComponents == nil {
+		return nil
+	}
+
+	return mcc.cryptoComponents.redundancyLeaseHandler
+}
+
 // Clone creates a shallow clone of a managedCryptoComponents
 func (mcc *managedCryptoComponents) Clone() interface{} {
 	cryptoComp := (*cryptoComponents)(nil)
@@ -388,6 +424,9 @@ func (mcc *managedCryptoComponents) Clone() interface{} {
 			consensusSigningHandler: mcc.ConsensusSigningHandler(),
 			managedPeersHolder:      mcc.ManagedPeersHolder(),
 			keysHandler:             mcc.KeysHandler(),
+			keysSigner:              mcc.KeysSigner(),
+			managedKeysUpdater:      mcc.ManagedKeysUpdater(),
+			redundancyLeaseHandler:  mcc.RedundancyLeaseHandler(),
 			cryptoParams:            mcc.cryptoParams,
 			p2pCryptoParams:         mcc.p2pCryptoParams,
 		}
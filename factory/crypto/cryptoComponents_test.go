@@ -3,6 +3,8 @@ package crypto_test
 import (
 	"encoding/hex"
 	"errors"
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
 
 	"github.com/multiversx/mx-chain-crypto-go/signing"
@@ -11,7 +13,11 @@ import (
 	cryptoComp "github.com/multiversx/mx-chain-go/factory/crypto"
 	"github.com/multiversx/mx-chain-go/factory/mock"
 	integrationTestsMock "github.com/multiversx/mx-chain-go/integrationTests/mock"
+	"github.com/multiversx/mx-chain-go/keysManagement"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/multiversx/mx-chain-go/redundancy/lease"
 	componentsMock "github.com/multiversx/mx-chain-go/testscommon/components"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -515,6 +521,224 @@ func TestCryptoComponentsFactory_MultiKey(t *testing.T) {
 		skBytes, _, _ := ccf.GetSkPk()
 		assert.NotEqual(t, skBytes, privateKeys[0]) // should generate another private key and not use the one loaded with LoadKey call
 	})
+	t.Run("invalid managed keys watcher polling interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.AllValidatorKeysPemFileName = "allValidatorsKeys.pem"
+		args.Config.ManagedKeysWatcher = config.ManagedKeysWatcherConfig{
+			Enabled:                  true,
+			PollingIntervalInSeconds: 0,
+		}
+
+		privateKeys, publicKeys := createBLSPrivatePublicKeys()
+
+		args.KeyLoader = &mock.KeyLoaderStub{
+			LoadKeyCalled: func(relativePath string, skIndex int) ([]byte, string, error) {
+				return privateKeys[0], publicKeys[0], nil
+			},
+			LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				return privateKeys[1:], publicKeys[1:], nil
+			},
+		}
+
+		ccf, err := cryptoComp.NewCryptoComponentsFactory(args)
+		require.Nil(t, err)
+
+		cc, err := ccf.Create()
+		require.ErrorIs(t, err, keysManagement.ErrInvalidValue)
+		require.Nil(t, cc)
+	})
+	t.Run("should work with the managed keys watcher", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.AllValidatorKeysPemFileName = "allValidatorsKeys.pem"
+		args.Config.ManagedKeysWatcher = config.ManagedKeysWatcherConfig{
+			Enabled:                  true,
+			PollingIntervalInSeconds: 1,
+		}
+
+		privateKeys, publicKeys := createBLSPrivatePublicKeys()
+
+		args.KeyLoader = &mock.KeyLoaderStub{
+			LoadKeyCalled: func(relativePath string, skIndex int) ([]byte, string, error) {
+				return privateKeys[0], publicKeys[0], nil
+			},
+			LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				return privateKeys[1:], publicKeys[1:], nil
+			},
+		}
+
+		ccf, err := cryptoComp.NewCryptoComponentsFactory(args)
+		require.Nil(t, err)
+
+		cc, err := ccf.Create()
+		require.Nil(t, err)
+		assert.NotNil(t, cc.GetManagedKeysUpdater())
+		assert.Nil(t, cc.Close())
+	})
+}
+
+func TestCryptoComponentsFactory_RedundancyLease(t *testing.T) {
+	t.Parallel()
+
+	t.Run("lease not enabled should create a disabled lease handler", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		ccf, err := cryptoComp.NewCryptoComponentsFactory(args)
+		require.Nil(t, err)
+
+		cc, err := ccf.Create()
+		require.Nil(t, err)
+		assert.False(t, cc.GetRedundancyLeaseHandler().IsEnabled())
+		assert.Nil(t, cc.Close())
+	})
+	t.Run("invalid lease config should error", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.Config.Redundancy.Lease = config.RedundancyLeaseConfig{
+			Enabled:                true,
+			FilePath:               filepath.Join(t.TempDir(), "redundancy.lease"),
+			InstanceID:             "",
+			LeaseDurationInSeconds: 12,
+			RenewIntervalInSeconds: 2,
+		}
+		ccf, err := cryptoComp.NewCryptoComponentsFactory(args)
+		require.Nil(t, err)
+
+		cc, err := ccf.Create()
+		require.Equal(t, lease.ErrEmptyInstanceID, err)
+		require.Nil(t, cc)
+	})
+	t.Run("should work with the lease enabled", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.Config.Redundancy.Lease = config.RedundancyLeaseConfig{
+			Enabled:                true,
+			FilePath:               filepath.Join(t.TempDir(), "redundancy.lease"),
+			InstanceID:             "instance-a",
+			LeaseDurationInSeconds: 12,
+			RenewIntervalInSeconds: 2,
+		}
+		ccf, err := cryptoComp.NewCryptoComponentsFactory(args)
+		require.Nil(t, err)
+
+		cc, err := ccf.Create()
+		require.Nil(t, err)
+		assert.True(t, cc.GetRedundancyLeaseHandler().IsEnabled())
+		assert.Equal(t, "instance-a", cc.GetRedundancyLeaseHandler().State().InstanceID)
+		assert.Nil(t, cc.Close())
+	})
+}
+
+func TestCryptoComponentsFactory_RemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	_, publicKeys := createBLSPrivatePublicKeys()
+	publicKeysBytes := make([][]byte, 0, len(publicKeys))
+	for _, pkString := range publicKeys {
+		pkBytes, _ := hex.DecodeString(pkString)
+		publicKeysBytes = append(publicKeysBytes, pkBytes)
+	}
+	providedSignature := []byte("signature")
+	createSignerServer := func() *httptest.Server {
+		handler, _ := remoteSigner.NewSignerHandler(remoteSigner.ArgsSignerHandler{
+			KeysSigner: &cryptoMocks.KeysSignerStub{
+				SignCalled: func(publicKey []byte, message []byte) ([]byte, error) {
+					return providedSignature, nil
+				},
+			},
+			PublicKeys: publicKeysBytes,
+		})
+
+		return httptest.NewServer(handler)
+	}
+
+	t.Run("remote signer not reachable should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createSignerServer()
+		server.Close()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.Config.RemoteSigner = config.RemoteSignerConfig{
+			Enabled:           true,
+			Url:               server.URL,
+			RequestTimeoutSec: 1,
+		}
+
+		ccf, err := cryptoComp.NewCryptoComponentsFactory(args)
+		require.Nil(t, err)
+
+		cc, err := ccf.Create()
+		require.NotNil(t, err)
+		assert.Nil(t, cc)
+	})
+	t.Run("remote signer in import db mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createSignerServer()
+		defer server.Close()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.IsInImportMode = true
+		args.Config.RemoteSigner = config.RemoteSignerConfig{
+			Enabled:           true,
+			Url:               server.URL,
+			RequestTimeoutSec: 10,
+		}
+
+		ccf, err := cryptoComp.NewCryptoComponentsFactory(args)
+		require.Nil(t, err)
+
+		cc, err := ccf.Create()
+		require.NotNil(t, err)
+		assert.Nil(t, cc)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		server := createSignerServer()
+		defer server.Close()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.Config.RemoteSigner = config.RemoteSignerConfig{
+			Enabled:           true,
+			Url:               server.URL,
+			RequestTimeoutSec: 10,
+		}
+
+		ccf, err := cryptoComp.NewCryptoComponentsFactory(args)
+		require.Nil(t, err)
+
+		cc, err := ccf.Create()
+		require.Nil(t, err)
+
+		managedKeys := cc.GetManagedPeersHolder().GetManagedKeysByCurrentNode()
+		assert.Equal(t, len(publicKeys), len(managedKeys))
+		for _, pkBytes := range publicKeysBytes {
+			sk, found := managedKeys[string(pkBytes)]
+			assert.True(t, found)
+			assert.Nil(t, sk)
+		}
+
+		signature, err := cc.GetKeysSigner().Sign(publicKeysBytes[0], []byte("message"))
+		assert.Nil(t, err)
+		assert.Equal(t, providedSignature, signature)
+		assert.Nil(t, cc.Close())
+	})
 }
 
 func createBLSPrivatePublicKeys() ([][]byte, []string) {
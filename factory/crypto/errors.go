@@ -11,8 +11,8 @@ var ErrNilElement = errors.New("element is nil")
 // ErrNilBitmap is raised when a nil bitmap is used
 var ErrNilBitmap = errors.New("bitmap is nil")
 
-// ErrNilKeysHandler is raised when a nil keys handler was provided
-var ErrNilKeysHandler = errors.New("nil keys handler")
+// ErrNilKeysSigner is raised when a nil keys signer was provided
+var ErrNilKeysSigner = errors.New("nil keys signer")
 
 // ErrNoPublicKeySet is raised when no public key was set for a multisignature
 var ErrNoPublicKeySet = errors.New("no public key was set")
@@ -40,3 +40,6 @@ var ErrNilMessage = errors.New("message to be signed or to be verified is nil")
 
 // ErrBitmapMismatch is raised when an invalid bitmap is passed to the multisigner
 var ErrBitmapMismatch = errors.New("multi signer reported a mismatch in used bitmap")
+
+// ErrNoPublicKeysFromRemoteSigner is raised when the remote signer does not handle any public key
+var ErrNoPublicKeysFromRemoteSigner = errors.New("no public keys handled by the remote signer")
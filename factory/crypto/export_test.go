@@ -23,7 +23,7 @@ func (ccf *cryptoComponentsFactory) CreateDummyCryptoParams() *cryptoParams {
 
 // CreateCryptoParams -
 func (ccf *cryptoComponentsFactory) CreateCryptoParams(blockSignKeyGen crypto.KeyGenerator) (*cryptoParams, error) {
-	return ccf.createCryptoParams(blockSignKeyGen)
+	return ccf.createCryptoParams(blockSignKeyGen, nil)
 }
 
 // CreateMultiSignerContainer -
@@ -43,3 +43,18 @@ func (ccf *cryptoComponentsFactory) GetSuite() (crypto.Suite, error) {
 func (cc *cryptoComponents) GetManagedPeersHolder() common.ManagedPeersHolder {
 	return cc.managedPeersHolder
 }
+
+// GetKeysSigner -
+func (cc *cryptoComponents) GetKeysSigner() cryptoCommon.KeysSigner {
+	return cc.keysSigner
+}
+
+// GetManagedKeysUpdater -
+func (cc *cryptoComponents) GetManagedKeysUpdater() common.ManagedKeysUpdater {
+	return cc.managedKeysUpdater
+}
+
+// GetRedundancyLeaseHandler -
+func (cc *cryptoComponents) GetRedundancyLeaseHandler() common.RedundancyLeaseHandler {
+	return cc.redundancyLeaseHandler
+}
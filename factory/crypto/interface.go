@@ -0,0 +1,10 @@
+package crypto
+
+import cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
+
+// remoteKeysSigner defines a keys signer that delegates the signing operations to a remote signer, being also able
+// to provide the public keys handled by it
+type remoteKeysSigner interface {
+	cryptoCommon.KeysSigner
+	GetPublicKeys() ([][]byte, error)
+}
@@ -6,7 +6,6 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
-	"github.com/multiversx/mx-chain-go/consensus"
 )
 
 // ArgsSigningHandler defines the arguments needed to create a new signing handler component
@@ -15,7 +14,7 @@ type ArgsSigningHandler struct {
 	MultiSignerContainer cryptoCommon.MultiSignerContainer
 	SingleSigner         crypto.SingleSigner
 	KeyGenerator         crypto.KeyGenerator
-	KeysHandler          consensus.KeysHandler
+	KeysSigner           cryptoCommon.KeysSigner
 }
 
 type signatureHolderData struct {
@@ -30,7 +29,7 @@ type signingHandler struct {
 	multiSignerContainer cryptoCommon.MultiSignerContainer
 	singleSigner         crypto.SingleSigner
 	keyGen               crypto.KeyGenerator
-	keysHandler          consensus.KeysHandler
+	keysSigner           cryptoCommon.KeysSigner
 }
 
 // NewSigningHandler will create a new signing handler component
@@ -59,7 +58,7 @@ func NewSigningHandler(args ArgsSigningHandler) (*signingHandler, error) {
 		multiSignerContainer: args.MultiSignerContainer,
 		singleSigner:         args.SingleSigner,
 		keyGen:               args.KeyGenerator,
-		keysHandler:          args.KeysHandler,
+		keysSigner:           args.KeysSigner,
 	}, nil
 }
 
@@ -70,8 +69,8 @@ func checkArgs(args ArgsSigningHandler) error {
 	if check.IfNil(args.SingleSigner) {
 		return ErrNilSingleSigner
 	}
-	if check.IfNil(args.KeysHandler) {
-		return ErrNilKeysHandler
+	if check.IfNil(args.KeysSigner) {
+		return ErrNilKeysSigner
 	}
 	if check.IfNil(args.KeyGenerator) {
 		return ErrNilKeyGenerator
@@ -87,7 +86,7 @@ func checkArgs(args ArgsSigningHandler) error {
 func (sh *signingHandler) Create(pubKeys []string) (*signingHandler, error) {
 	args := ArgsSigningHandler{
 		PubKeys:              pubKeys,
-		KeysHandler:          sh.keysHandler,
+		KeysSigner:           sh.keysSigner,
 		MultiSignerContainer: sh.multiSignerContainer,
 		SingleSigner:         sh.singleSigner,
 		KeyGenerator:         sh.keyGen,
@@ -121,15 +120,14 @@ func (sh *signingHandler) Reset(pubKeys []string) error {
 	return nil
 }
 
-// CreateSignatureShareForPublicKey returns a signature over a message using the managed private key that was selected based on the provided
+// CreateSignatureShareForPublicKey returns a signature over a message using the managed key that was selected based on the provided
 // publicKeyBytes argument
 func (sh *signingHandler) CreateSignatureShareForPublicKey(message []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error) {
 	if message == nil {
 		return nil, ErrNilMessage
 	}
 
-	privateKey := sh.keysHandler.GetHandledPrivateKey(publicKeyBytes)
-	privateKeyBytes, err := privateKey.ToByteArray()
+	sigShareBytes, err := sh.keysSigner.CreateSignatureShare(publicKeyBytes, message, epoch)
 	if err != nil {
 		return nil, err
 	}
@@ -137,27 +135,15 @@ func (sh *signingHandler) CreateSignatureShareForPublicKey(message []byte, index
 	sh.mutSigningData.Lock()
 	defer sh.mutSigningData.Unlock()
 
-	multiSigner, err := sh.multiSignerContainer.GetMultiSigner(epoch)
-	if err != nil {
-		return nil, err
-	}
-
-	sigShareBytes, err := multiSigner.CreateSignatureShare(privateKeyBytes, message)
-	if err != nil {
-		return nil, err
-	}
-
 	sh.data.sigShares[index] = sigShareBytes
 
 	return sigShareBytes, nil
 }
 
-// CreateSignatureForPublicKey returns a signature over a message using the managed private key that was selected based on the provided
+// CreateSignatureForPublicKey returns a signature over a message using the managed key that was selected based on the provided
 // publicKeyBytes argument
 func (sh *signingHandler) CreateSignatureForPublicKey(message []byte, publicKeyBytes []byte) ([]byte, error) {
-	privateKey := sh.keysHandler.GetHandledPrivateKey(publicKeyBytes)
-
-	return sh.singleSigner.Sign(privateKey, message)
+	return sh.keysSigner.Sign(publicKeyBytes, message)
 }
 
 // VerifySingleSignature returns an error if the public key bytes & message provided doesn't match with the signature
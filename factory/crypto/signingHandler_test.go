@@ -7,7 +7,6 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	cryptoFactory "github.com/multiversx/mx-chain-go/factory/crypto"
-	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -16,7 +15,7 @@ import (
 func createMockArgsSigningHandler() cryptoFactory.ArgsSigningHandler {
 	return cryptoFactory.ArgsSigningHandler{
 		PubKeys:              []string{"pubkey1"},
-		KeysHandler:          &testscommon.KeysHandlerStub{},
+		KeysSigner:           &cryptoMocks.KeysSignerStub{},
 		MultiSignerContainer: &cryptoMocks.MultiSignerContainerMock{},
 		KeyGenerator:         &cryptoMocks.KeyGenStub{},
 		SingleSigner:         &cryptoMocks.SingleSignerStub{},
@@ -56,15 +55,15 @@ func TestNewSigningHandler(t *testing.T) {
 		require.Nil(t, signer)
 		require.Equal(t, cryptoFactory.ErrNilKeyGenerator, err)
 	})
-	t.Run("nil keys handler", func(t *testing.T) {
+	t.Run("nil keys signer", func(t *testing.T) {
 		t.Parallel()
 
 		args := createMockArgsSigningHandler()
-		args.KeysHandler = nil
+		args.KeysSigner = nil
 
 		signer, err := cryptoFactory.NewSigningHandler(args)
 		require.Nil(t, signer)
-		require.Equal(t, cryptoFactory.ErrNilKeysHandler, err)
+		require.Equal(t, cryptoFactory.ErrNilKeysSigner, err)
 	})
 	t.Run("no public keys", func(t *testing.T) {
 		t.Parallel()
@@ -172,63 +171,48 @@ func TestSigningHandler_CreateSignatureShareForPublicKey(t *testing.T) {
 		args := createMockArgsSigningHandler()
 
 		expectedErr := errors.New("expected error")
-		multiSigner := &cryptoMocks.MultiSignerStub{
-			CreateSignatureShareCalled: func(privateKeyBytes, message []byte) ([]byte, error) {
+		args.KeysSigner = &cryptoMocks.KeysSignerStub{
+			CreateSignatureShareCalled: func(publicKey []byte, message []byte, epoch uint32) ([]byte, error) {
 				return nil, expectedErr
 			},
 		}
-		args.MultiSignerContainer = cryptoMocks.NewMultiSignerContainerMock(multiSigner)
 
 		signer, _ := cryptoFactory.NewSigningHandler(args)
 		sigShare, err := signer.CreateSignatureShareForPublicKey([]byte("msg1"), selfIndex, epoch, pkBytes)
 		require.Nil(t, sigShare)
 		require.Equal(t, expectedErr, err)
-	})
-	t.Run("failed to get current multi signer", func(t *testing.T) {
-		t.Parallel()
-
-		args := createMockArgsSigningHandler()
-
-		expectedErr := errors.New("expected error")
-		args.MultiSignerContainer = &cryptoMocks.MultiSignerContainerStub{
-			GetMultiSignerCalled: func(epoch uint32) (crypto.MultiSigner, error) {
-				return nil, expectedErr
-			},
-		}
-
-		signer, _ := cryptoFactory.NewSigningHandler(args)
 
-		sigShare, err := signer.CreateSignatureShareForPublicKey([]byte("message"), uint16(0), epoch, pkBytes)
-		require.Nil(t, sigShare)
-		require.Equal(t, expectedErr, err)
+		_, err = signer.SignatureShare(selfIndex)
+		require.Equal(t, cryptoFactory.ErrNilElement, err)
 	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
 		args := createMockArgsSigningHandler()
-		getHandledPrivateKeyCalled := false
+		createSignatureShareCalled := false
 
+		providedMessage := []byte("msg1")
 		expectedSigShare := []byte("sigShare")
-		multiSigner := &cryptoMocks.MultiSignerStub{
-			CreateSignatureShareCalled: func(privateKeyBytes, message []byte) ([]byte, error) {
-				return expectedSigShare, nil
-			},
-		}
-		args.KeysHandler = &testscommon.KeysHandlerStub{
-			GetHandledPrivateKeyCalled: func(providedPkBytes []byte) crypto.PrivateKey {
-				assert.Equal(t, pkBytes, providedPkBytes)
-				getHandledPrivateKeyCalled = true
+		args.KeysSigner = &cryptoMocks.KeysSignerStub{
+			CreateSignatureShareCalled: func(publicKey []byte, message []byte, providedEpoch uint32) ([]byte, error) {
+				assert.Equal(t, pkBytes, publicKey)
+				assert.Equal(t, providedMessage, message)
+				assert.Equal(t, epoch, providedEpoch)
+				createSignatureShareCalled = true
 
-				return &cryptoMocks.PrivateKeyStub{}
+				return expectedSigShare, nil
 			},
 		}
-		args.MultiSignerContainer = cryptoMocks.NewMultiSignerContainerMock(multiSigner)
 
 		signer, _ := cryptoFactory.NewSigningHandler(args)
-		sigShare, err := signer.CreateSignatureShareForPublicKey([]byte("msg1"), selfIndex, epoch, pkBytes)
+		sigShare, err := signer.CreateSignatureShareForPublicKey(providedMessage, selfIndex, epoch, pkBytes)
 		require.Nil(t, err)
 		require.Equal(t, expectedSigShare, sigShare)
-		assert.True(t, getHandledPrivateKeyCalled)
+		assert.True(t, createSignatureShareCalled)
+
+		storedSigShare, err := signer.SignatureShare(selfIndex)
+		require.Nil(t, err)
+		require.Equal(t, expectedSigShare, storedSigShare)
 	})
 }
 
@@ -623,29 +607,26 @@ func TestSigningHandler_CreateSignatureForPublicKey(t *testing.T) {
 	t.Parallel()
 
 	args := createMockArgsSigningHandler()
-	getHandledPrivateKeyCalled := false
+	signCalled := false
 	pkBytes := []byte("public key bytes")
+	providedMessage := []byte("msg1")
 
-	expectedSigShare := []byte("sigShare")
-	args.KeysHandler = &testscommon.KeysHandlerStub{
-		GetHandledPrivateKeyCalled: func(providedPkBytes []byte) crypto.PrivateKey {
-			assert.Equal(t, pkBytes, providedPkBytes)
-			getHandledPrivateKeyCalled = true
+	expectedSig := []byte("signature")
+	args.KeysSigner = &cryptoMocks.KeysSignerStub{
+		SignCalled: func(publicKey []byte, message []byte) ([]byte, error) {
+			assert.Equal(t, pkBytes, publicKey)
+			assert.Equal(t, providedMessage, message)
+			signCalled = true
 
-			return &cryptoMocks.PrivateKeyStub{}
-		},
-	}
-	args.SingleSigner = &cryptoMocks.SingleSignerStub{
-		SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
-			return expectedSigShare, nil
+			return expectedSig, nil
 		},
 	}
 
 	signer, _ := cryptoFactory.NewSigningHandler(args)
-	sigShare, err := signer.CreateSignatureForPublicKey([]byte("msg1"), pkBytes)
+	sig, err := signer.CreateSignatureForPublicKey(providedMessage, pkBytes)
 	require.Nil(t, err)
-	require.Equal(t, expectedSigShare, sigShare)
-	assert.True(t, getHandledPrivateKeyCalled)
+	require.Equal(t, expectedSig, sig)
+	assert.True(t, signCalled)
 }
 
 func TestSigningHandler_VerifySingleSignature(t *testing.T) {
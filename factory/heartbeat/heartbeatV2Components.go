@@ -172,6 +172,7 @@ func (hcf *heartbeatV2ComponentsFactory) Create() (*heartbeatV2Components, error
 		PeerSubType:                                 peerSubType,
 		CurrentBlockProvider:                        hcf.dataComponents.Blockchain(),
 		PeerSignatureHandler:                        hcf.cryptoComponents.PeerSignatureHandler(),
+		KeysSigner:                                  hcf.cryptoComponents.KeysSigner(),
 		PrivateKey:                                  hcf.cryptoComponents.PrivateKey(),
 		RedundancyHandler:                           hcf.processComponents.NodeRedundancyHandler(),
 		NodesCoordinator:                            hcf.processComponents.NodesCoordinator(),
@@ -70,6 +70,7 @@ func createMockHeartbeatV2ComponentsFactoryArgs() heartbeatComp.ArgHeartbeatV2Co
 		CryptoComponents: &testsMocks.CryptoComponentsStub{
 			PrivKey:                 &cryptoMocks.PrivateKeyStub{},
 			PeerSignHandler:         &testsMocks.PeerSignatureHandler{},
+			KeysSignerField:         &cryptoMocks.KeysSignerStub{},
 			ManagedPeersHolderField: &testscommon.ManagedPeersHolderStub{},
 		},
 		ProcessComponents: &testsMocks.ProcessComponentsStub{
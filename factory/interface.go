@@ -188,6 +188,9 @@ type CryptoComponentsHolder interface {
 	ConsensusSigningHandler() consensus.SigningHandler
 	ManagedPeersHolder() common.ManagedPeersHolder
 	KeysHandler() consensus.KeysHandler
+	KeysSigner() cryptoCommon.KeysSigner
+	ManagedKeysUpdater() common.ManagedKeysUpdater
+	RedundancyLeaseHandler() common.RedundancyLeaseHandler
 	Clone() interface{}
 	IsInterfaceNil() bool
 }
@@ -262,8 +265,10 @@ type NetworkComponentsHandler interface {
 
 // TransactionEvaluator defines the transaction evaluator actions
 type TransactionEvaluator interface {
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
-	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransactionExecution(tx *transaction.Transaction, blockHeader data.HeaderHandler, rootHashHolder common.RootHashHolder) (*txSimData.SimulationResultsWithVMOutput, error)
+	ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error)
 	IsInterfaceNil() bool
 }
 
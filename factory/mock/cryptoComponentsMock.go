@@ -13,25 +13,28 @@ import (
 
 // CryptoComponentsMock -
 type CryptoComponentsMock struct {
-	PubKey                  crypto.PublicKey
-	PrivKey                 crypto.PrivateKey
-	P2pPubKey               crypto.PublicKey
-	P2pPrivKey              crypto.PrivateKey
-	P2pSig                  crypto.SingleSigner
-	PubKeyString            string
-	PubKeyBytes             []byte
-	BlockSig                crypto.SingleSigner
-	TxSig                   crypto.SingleSigner
-	MultiSigContainer       cryptoCommon.MultiSignerContainer
-	PeerSignHandler         crypto.PeerSignatureHandler
-	BlKeyGen                crypto.KeyGenerator
-	TxKeyGen                crypto.KeyGenerator
-	P2PKeyGen               crypto.KeyGenerator
-	MsgSigVerifier          vm.MessageSignVerifier
-	SigHandler              consensus.SigningHandler
-	ManagedPeersHolderField common.ManagedPeersHolder
-	KeysHandlerField        consensus.KeysHandler
-	mutMultiSig             sync.RWMutex
+	PubKey                      crypto.PublicKey
+	PrivKey                     crypto.PrivateKey
+	P2pPubKey                   crypto.PublicKey
+	P2pPrivKey                  crypto.PrivateKey
+	P2pSig                      crypto.SingleSigner
+	PubKeyString                string
+	PubKeyBytes                 []byte
+	BlockSig                    crypto.SingleSigner
+	TxSig                       crypto.SingleSigner
+	MultiSigContainer           cryptoCommon.MultiSignerContainer
+	PeerSignHandler             crypto.PeerSignatureHandler
+	BlKeyGen                    crypto.KeyGenerator
+	TxKeyGen                    crypto.KeyGenerator
+	P2PKeyGen                   crypto.KeyGenerator
+	MsgSigVerifier              vm.MessageSignVerifier
+	SigHandler                  consensus.SigningHandler
+	ManagedPeersHolderField     common.ManagedPeersHolder
+	KeysHandlerField            consensus.KeysHandler
+	KeysSignerField             cryptoCommon.KeysSigner
+	ManagedKeysUpdaterField     common.ManagedKeysUpdater
+	RedundancyLeaseHandlerField common.RedundancyLeaseHandler
+	mutMultiSig                 sync.RWMutex
 }
 
 // PublicKey -
@@ -151,24 +154,42 @@ func (ccm *CryptoComponentsMock) KeysHandler() consensus.KeysHandler {
 	return ccm.KeysHandlerField
 }
 
+// KeysSigner -
+func (ccm *CryptoComponentsMock) KeysSigner() cryptoCommon.KeysSigner {
+	return ccm.KeysSignerField
+}
+
+// ManagedKeysUpdater -
+func (ccm *CryptoComponentsMock) ManagedKeysUpdater() common.ManagedKeysUpdater {
+	return ccm.ManagedKeysUpdaterField
+}
+
+// RedundancyLeaseHandler -
+func (ccm *CryptoComponentsMock) RedundancyLeaseHandler() common.RedundancyLeaseHandler {
+	return ccm.RedundancyLeaseHandlerField
+}
+
 // Clone -
 func (ccm *CryptoComponentsMock) Clone() interface{} {
 	return &CryptoComponentsMock{
-		PubKey:                  ccm.PubKey,
-		PrivKey:                 ccm.PrivKey,
-		PubKeyString:            ccm.PubKeyString,
-		PubKeyBytes:             ccm.PubKeyBytes,
-		BlockSig:                ccm.BlockSig,
-		TxSig:                   ccm.TxSig,
-		MultiSigContainer:       ccm.MultiSigContainer,
-		PeerSignHandler:         ccm.PeerSignHandler,
-		BlKeyGen:                ccm.BlKeyGen,
-		TxKeyGen:                ccm.TxKeyGen,
-		P2PKeyGen:               ccm.P2PKeyGen,
-		MsgSigVerifier:          ccm.MsgSigVerifier,
-		ManagedPeersHolderField: ccm.ManagedPeersHolderField,
-		KeysHandlerField:        ccm.KeysHandlerField,
-		mutMultiSig:             sync.RWMutex{},
+		PubKey:                      ccm.PubKey,
+		PrivKey:                     ccm.PrivKey,
+		PubKeyString:                ccm.PubKeyString,
+		PubKeyBytes:                 ccm.PubKeyBytes,
+		BlockSig:                    ccm.BlockSig,
+		TxSig:                       ccm.TxSig,
+		MultiSigContainer:           ccm.MultiSigContainer,
+		PeerSignHandler:             ccm.PeerSignHandler,
+		BlKeyGen:                    ccm.BlKeyGen,
+		TxKeyGen:                    ccm.TxKeyGen,
+		P2PKeyGen:                   ccm.P2PKeyGen,
+		MsgSigVerifier:              ccm.MsgSigVerifier,
+		ManagedPeersHolderField:     ccm.ManagedPeersHolderField,
+		KeysHandlerField:            ccm.KeysHandlerField,
+		KeysSignerField:             ccm.KeysSignerField,
+		ManagedKeysUpdaterField:     ccm.ManagedKeysUpdaterField,
+		RedundancyLeaseHandlerField: ccm.RedundancyLeaseHandlerField,
+		mutMultiSig:                 sync.RWMutex{},
 	}
 }
 
@@ -683,9 +683,11 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 
 	maxRoundsOfInactivity := int(pcf.prefConfigs.Preferences.RedundancyLevel) * pcf.config.Redundancy.MaxRoundsOfInactivityAccepted
 	nodeRedundancyArg := redundancy.ArgNodeRedundancy{
-		MaxRoundsOfInactivity: maxRoundsOfInactivity,
-		Messenger:             pcf.network.NetworkMessenger(),
-		ObserverPrivateKey:    observerBLSPrivateKey,
+		MaxRoundsOfInactivity:  maxRoundsOfInactivity,
+		Messenger:              pcf.network.NetworkMessenger(),
+		ObserverPrivateKey:     observerBLSPrivateKey,
+		RedundancyLeaseHandler: pcf.crypto.RedundancyLeaseHandler(),
+		AppStatusHandler:       pcf.statusCoreComponents.AppStatusHandler(),
 	}
 	nodeRedundancyHandler, err := redundancy.NewNodeRedundancy(nodeRedundancyArg)
 	if err != nil {
@@ -2016,6 +2018,9 @@ func (pc *processComponents) Close() error {
 	if !check.IfNil(pc.blockProcessor) {
 		log.LogIfError(pc.blockProcessor.Close())
 	}
+	if !check.IfNil(pc.historyRepository) {
+		log.LogIfError(pc.historyRepository.Close())
+	}
 	if !check.IfNil(pc.validatorsProvider) {
 		log.LogIfError(pc.validatorsProvider.Close())
 	}
@@ -227,16 +227,17 @@ func createMockProcessComponentsFactoryArgs() processComp.ProcessComponentsFacto
 			MultiSigContainer: &cryptoMocks.MultiSignerContainerMock{
 				MultiSigner: &cryptoMocks.MultisignerMock{},
 			},
-			PrivKey:                 &cryptoMocks.PrivateKeyStub{},
-			PubKey:                  &cryptoMocks.PublicKeyStub{},
-			PubKeyString:            "pub key string",
-			PubKeyBytes:             []byte("pub key bytes"),
-			TxKeyGen:                &cryptoMocks.KeyGenStub{},
-			TxSig:                   &cryptoMocks.SingleSignerStub{},
-			PeerSignHandler:         &cryptoMocks.PeerSignatureHandlerStub{},
-			MsgSigVerifier:          &testscommon.MessageSignVerifierMock{},
-			ManagedPeersHolderField: &testscommon.ManagedPeersHolderStub{},
-			KeysHandlerField:        &testscommon.KeysHandlerStub{},
+			PrivKey:                     &cryptoMocks.PrivateKeyStub{},
+			PubKey:                      &cryptoMocks.PublicKeyStub{},
+			PubKeyString:                "pub key string",
+			PubKeyBytes:                 []byte("pub key bytes"),
+			TxKeyGen:                    &cryptoMocks.KeyGenStub{},
+			TxSig:                       &cryptoMocks.SingleSignerStub{},
+			PeerSignHandler:             &cryptoMocks.PeerSignatureHandlerStub{},
+			MsgSigVerifier:              &testscommon.MessageSignVerifierMock{},
+			ManagedPeersHolderField:     &testscommon.ManagedPeersHolderStub{},
+			KeysHandlerField:            &testscommon.KeysHandlerStub{},
+			RedundancyLeaseHandlerField: &testscommon.RedundancyLeaseHandlerStub{},
 		},
 		Network: &testsMocks.NetworkComponentsStub{
 			Messenger:                        &p2pmocks.MessengerStub{},
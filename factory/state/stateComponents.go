@@ -1,9 +1,14 @@
 package state
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	chainData "github.com/multiversx/mx-chain-core-go/data"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
@@ -14,6 +19,7 @@ import (
 	factoryState "github.com/multiversx/mx-chain-go/state/factory"
 	"github.com/multiversx/mx-chain-go/state/iteratorChannelsProvider"
 	"github.com/multiversx/mx-chain-go/state/lastSnapshotMarker"
+	"github.com/multiversx/mx-chain-go/state/snapshotFiles"
 	"github.com/multiversx/mx-chain-go/state/stateMetrics"
 	"github.com/multiversx/mx-chain-go/state/storagePruningManager"
 	"github.com/multiversx/mx-chain-go/state/storagePruningManager/evictionWaitingList"
@@ -28,6 +34,7 @@ type StateComponentsFactoryArgs struct {
 	Config                   config.Config
 	Core                     factory.CoreComponentsHolder
 	StatusCore               factory.StatusCoreComponentsHolder
+	Crypto                   factory.CryptoComponentsHolder
 	StorageService           dataRetriever.StorageService
 	ProcessingMode           common.NodeProcessingMode
 	ShouldSerializeSnapshots bool
@@ -38,6 +45,7 @@ type stateComponentsFactory struct {
 	config                   config.Config
 	core                     factory.CoreComponentsHolder
 	statusCore               factory.StatusCoreComponentsHolder
+	crypto                   factory.CryptoComponentsHolder
 	storageService           dataRetriever.StorageService
 	processingMode           common.NodeProcessingMode
 	shouldSerializeSnapshots bool
@@ -66,11 +74,15 @@ func NewStateComponentsFactory(args StateComponentsFactoryArgs) (*stateComponent
 	if check.IfNil(args.StatusCore) {
 		return nil, errors.ErrNilStatusCoreComponents
 	}
+	if args.Config.StateSnapshotFiles.WriteEnabled && check.IfNil(args.Crypto) {
+		return nil, errors.ErrNilCryptoComponents
+	}
 
 	return &stateComponentsFactory{
 		config:                   args.Config,
 		core:                     args.Core,
 		statusCore:               args.StatusCore,
+		crypto:                   args.Crypto,
 		storageService:           args.StorageService,
 		processingMode:           args.ProcessingMode,
 		shouldSerializeSnapshots: args.ShouldSerializeSnapshots,
@@ -115,6 +127,7 @@ func (scf *stateComponentsFactory) createSnapshotManager(
 	accountFactory state.AccountFactory,
 	stateMetrics state.StateMetrics,
 	iteratorChannelsProvider state.IteratorChannelsProvider,
+	snapshotFilesWriter state.SnapshotFilesWriter,
 ) (state.SnapshotsManager, error) {
 	if !scf.config.StateTriesConfig.SnapshotsEnabled {
 		return disabled.NewDisabledSnapshotsManager(), nil
@@ -131,10 +144,67 @@ func (scf *stateComponentsFactory) createSnapshotManager(
 		AccountFactory:           accountFactory,
 		LastSnapshotMarker:       lastSnapshotMarker.NewLastSnapshotMarker(),
 		StateStatsHandler:        scf.statusCore.StateStatsHandler(),
+		SnapshotFilesWriter:      snapshotFilesWriter,
 	}
 	return state.NewSnapshotsManager(argsSnapshotsManager)
 }
 
+func (scf *stateComponentsFactory) createSnapshotFilesWriter() (state.SnapshotFilesWriter, error) {
+	if !scf.config.StateSnapshotFiles.WriteEnabled {
+		return disabled.NewDisabledSnapshotFilesWriter(), nil
+	}
+
+	privateKey, err := scf.loadSnapshotSigningKey()
+	if err != nil {
+		return nil, err
+	}
+
+	argsSnapshotFilesWriter := snapshotFiles.ArgsSnapshotFilesWriter{
+		Config:               scf.config.StateSnapshotFiles,
+		Marshaller:           scf.core.InternalMarshalizer(),
+		Hasher:               scf.core.Hasher(),
+		EnableEpochsHandler:  scf.core.EnableEpochsHandler(),
+		MaxTrieLevelInMemory: scf.config.StateTriesConfig.MaxStateTrieLevelInMemory,
+		SingleSigner:         scf.crypto.BlockSigner(),
+		PrivateKey:           privateKey,
+	}
+	return snapshotFiles.NewSnapshotFilesWriter(argsSnapshotFilesWriter)
+}
+
+// loadSnapshotSigningKey loads the dedicated key used to sign the snapshot manifests. The validator key is not used
+// as it might be handled by a remote signer and a snapshot signature should not be produced with a consensus key
+func (scf *stateComponentsFactory) loadSnapshotSigningKey() (crypto.PrivateKey, error) {
+	pemFile := scf.config.StateSnapshotFiles.SigningKeyPemFile
+	if len(pemFile) == 0 {
+		return nil, errors.ErrEmptySnapshotSigningKeyPemFile
+	}
+
+	encodedSk, _, err := core.LoadSkPkFromPemFile(pemFile, 0)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the state snapshot signing key from %s", err, pemFile)
+	}
+
+	skBytes, err := hex.DecodeString(string(encodedSk))
+	if err != nil {
+		return nil, fmt.Errorf("%w for the encoded state snapshot signing key", err)
+	}
+
+	privateKey, err := scf.crypto.BlockSignKeyGen().PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(publicKeyBytes, scf.crypto.PublicKeyBytes()) {
+		return nil, errors.ErrSnapshotSigningKeyIsValidatorKey
+	}
+
+	return privateKey, nil
+}
+
 func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.TriesHolder) (state.AccountsAdapter, state.AccountsAdapter, state.AccountsRepository, error) {
 	argsAccCreator := factoryState.ArgsAccountCreator{
 		Hasher:              scf.core.Hasher(),
@@ -162,19 +232,25 @@ func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.
 		return nil, nil, nil, err
 	}
 
-	snapshotsManager, err := scf.createSnapshotManager(accountFactory, sm, iteratorChannelsProvider.NewUserStateIteratorChannelsProvider())
+	snapshotFilesWriter, err := scf.createSnapshotFilesWriter()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	snapshotsManager, err := scf.createSnapshotManager(accountFactory, sm, iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(), snapshotFilesWriter)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
 	argsProcessingAccountsDB := state.ArgsAccountsDB{
-		Trie:                  merkleTrie,
-		Hasher:                scf.core.Hasher(),
-		Marshaller:            scf.core.InternalMarshalizer(),
-		AccountFactory:        accountFactory,
-		StoragePruningManager: storagePruning,
-		AddressConverter:      scf.core.AddressPubKeyConverter(),
-		SnapshotsManager:      snapshotsManager,
+		Trie:                      merkleTrie,
+		Hasher:                    scf.core.Hasher(),
+		Marshaller:                scf.core.InternalMarshalizer(),
+		AccountFactory:            accountFactory,
+		StoragePruningManager:     storagePruning,
+		AddressConverter:          scf.core.AddressPubKeyConverter(),
+		SnapshotsManager:          snapshotsManager,
+		NumDataTriesCommitWorkers: scf.config.StateTriesConfig.NumDataTriesCommitWorkers,
 	}
 	accountsAdapter, err := state.NewAccountsDB(argsProcessingAccountsDB)
 	if err != nil {
@@ -238,7 +314,7 @@ func (scf *stateComponentsFactory) createPeerAdapter(triesContainer common.Tries
 		return nil, err
 	}
 
-	snapshotManager, err := scf.createSnapshotManager(accountFactory, sm, iteratorChannelsProvider.NewPeerStateIteratorChannelsProvider())
+	snapshotManager, err := scf.createSnapshotManager(accountFactory, sm, iteratorChannelsProvider.NewPeerStateIteratorChannelsProvider(), disabled.NewDisabledSnapshotFilesWriter())
 	if err != nil {
 		return nil, err
 	}
@@ -1,11 +1,18 @@
 package state_test
 
 import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/factory/mock"
 	stateComp "github.com/multiversx/mx-chain-go/factory/state"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	componentsMock "github.com/multiversx/mx-chain-go/testscommon/components"
@@ -139,6 +146,87 @@ func TestStateComponentsFactory_Create(t *testing.T) {
 	})
 }
 
+func TestStateComponentsFactory_CreateWithSnapshotFilesWriting(t *testing.T) {
+	t.Parallel()
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	createArgs := func() stateComp.StateComponentsFactoryArgs {
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetStateFactoryArgs(coreComponents, componentsMock.GetStatusCoreComponents())
+		args.Config.StateSnapshotFiles.WriteEnabled = true
+		args.Config.StateSnapshotFiles.Directory = t.TempDir()
+		args.Config.StateSnapshotFiles.MaxChunkSizeInBytes = 1024
+		args.Crypto = &mock.CryptoComponentsMock{
+			BlKeyGen:    keyGen,
+			BlockSig:    &mock.SinglesignMock{},
+			PubKeyBytes: []byte("validator public key"),
+		}
+
+		return args
+	}
+	saveSigningKey := func(t *testing.T) (string, []byte) {
+		sk, pk := keyGen.GeneratePair()
+		skBytes, _ := sk.ToByteArray()
+		pkBytes, _ := pk.ToByteArray()
+
+		pemFile := filepath.Join(t.TempDir(), "stateSnapshotSigningKey.pem")
+		file, err := os.Create(pemFile)
+		require.NoError(t, err)
+		err = core.SaveSkToPemFile(file, hex.EncodeToString(pkBytes), []byte(hex.EncodeToString(skBytes)))
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		return pemFile, pkBytes
+	}
+
+	t.Run("empty signing key file should error", func(t *testing.T) {
+		t.Parallel()
+
+		scf, _ := stateComp.NewStateComponentsFactory(createArgs())
+
+		sc, err := scf.Create()
+		require.Equal(t, errors.ErrEmptySnapshotSigningKeyPemFile, err)
+		require.Nil(t, sc)
+	})
+	t.Run("missing signing key file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Config.StateSnapshotFiles.SigningKeyPemFile = filepath.Join(t.TempDir(), "missing.pem")
+		scf, _ := stateComp.NewStateComponentsFactory(args)
+
+		sc, err := scf.Create()
+		require.Error(t, err)
+		require.Nil(t, sc)
+	})
+	t.Run("signing key being the validator key should error", func(t *testing.T) {
+		t.Parallel()
+
+		pemFile, pkBytes := saveSigningKey(t)
+		args := createArgs()
+		args.Config.StateSnapshotFiles.SigningKeyPemFile = pemFile
+		args.Crypto.(*mock.CryptoComponentsMock).PubKeyBytes = pkBytes
+		scf, _ := stateComp.NewStateComponentsFactory(args)
+
+		sc, err := scf.Create()
+		require.Equal(t, errors.ErrSnapshotSigningKeyIsValidatorKey, err)
+		require.Nil(t, sc)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pemFile, _ := saveSigningKey(t)
+		args := createArgs()
+		args.Config.StateSnapshotFiles.SigningKeyPemFile = pemFile
+		scf, _ := stateComp.NewStateComponentsFactory(args)
+
+		sc, err := scf.Create()
+		require.NoError(t, err)
+		require.NotNil(t, sc)
+		require.NoError(t, sc.Close())
+	})
+}
+
 func TestStateComponents_Close(t *testing.T) {
 	t.Parallel()
 
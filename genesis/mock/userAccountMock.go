@@ -160,3 +160,8 @@ func (uam *UserAccountMock) IsGuarded() bool {
 func (uam *UserAccountMock) GetAllLeaves(_ *common.TrieIteratorChannels, _ context.Context) error {
 	return nil
 }
+
+// GetLeavesPage -
+func (uam *UserAccountMock) GetLeavesPage(_ context.Context, _ []byte, _ int, _ []byte) (*common.TrieLeavesPage, error) {
+	return &common.TrieLeavesPage{}, nil
+}
@@ -50,6 +50,10 @@ func (r *RequestHandler) RequestMiniBlocks(_ uint32, _ [][]byte) {
 func (r *RequestHandler) RequestTrieNodes(_ uint32, _ [][]byte, _ string) {
 }
 
+// RequestTrieLeavesRange does nothing
+func (r *RequestHandler) RequestTrieLeavesRange(_ uint32, _ []byte, _ []byte, _ string) {
+}
+
 // RequestStartOfEpochMetaBlock does nothing
 func (r *RequestHandler) RequestStartOfEpochMetaBlock(_ uint32) {
 }
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 	"math/big"
-	"path"
 	"path/filepath"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -23,11 +22,11 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks/counters"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	"github.com/multiversx/mx-chain-go/sharding"
 	factoryState "github.com/multiversx/mx-chain-go/state/factory"
 	"github.com/multiversx/mx-chain-go/state/syncer"
 	"github.com/multiversx/mx-chain-go/statusHandler"
-	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/factory"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/update"
@@ -89,12 +88,11 @@ func getGenesisBlocksRoundNonceEpoch(arg ArgsGenesisBlockCreator) (uint64, uint6
 func (gbc *genesisBlockCreator) createHardForkImportHandler() error {
 	importFolder := filepath.Join(gbc.arg.WorkingDir, gbc.arg.HardForkConfig.ImportFolder)
 
-	// TODO remove duplicate code found in update/factory/exportHandlerFactory.go
-	keysStorer, err := createStorer(gbc.arg.HardForkConfig.ImportKeysStorageConfig, importFolder)
+	keysStorer, err := factory.CreateStorerInFolder(gbc.arg.HardForkConfig.ImportKeysStorageConfig, importFolder)
 	if err != nil {
 		return fmt.Errorf("%w while creating keys storer", err)
 	}
-	keysVals, err := createStorer(gbc.arg.HardForkConfig.ImportStateStorageConfig, importFolder)
+	keysVals, err := factory.CreateStorerInFolder(gbc.arg.HardForkConfig.ImportStateStorageConfig, importFolder)
 	if err != nil {
 		return fmt.Errorf("%w while creating keys-values storer", err)
 	}
@@ -128,28 +126,6 @@ func (gbc *genesisBlockCreator) createHardForkImportHandler() error {
 	return nil
 }
 
-func createStorer(storageConfig config.StorageConfig, folder string) (storage.Storer, error) {
-	dbConfig := factory.GetDBFromConfig(storageConfig.DB)
-	dbConfig.FilePath = path.Join(folder, storageConfig.DB.FilePath)
-
-	dbConfigHandler := factory.NewDBConfigHandler(storageConfig.DB)
-	persisterFactory, err := factory.NewPersisterFactory(dbConfigHandler)
-	if err != nil {
-		return nil, err
-	}
-
-	store, err := storageunit.NewStorageUnitFromConf(
-		factory.GetCacherFromConfig(storageConfig.Cache),
-		dbConfig,
-		persisterFactory,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return store, nil
-}
-
 func checkArgumentsForBlockCreator(arg ArgsGenesisBlockCreator) error {
 	if check.IfNil(arg.Accounts) {
 		return process.ErrNilAccountsAdapter
@@ -447,6 +423,7 @@ func (gbc *genesisBlockCreator) computeDNSAddresses(enableEpochsConfig config.En
 		NilCompiledSCStore:       true,
 		GasSchedule:              gbc.arg.GasSchedule,
 		Counter:                  counters.NewDisabledCounter(),
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 		MissingTrieNodesNotifier: syncer.NewMissingTrieNodesNotifier(),
 	}
 	blockChainHook, err := hooks.NewBlockChainHookImpl(argsHook)
@@ -85,3 +85,6 @@ var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
 
 // ErrInvalidConfiguration signals that an invalid configuration has been provided
 var ErrInvalidConfiguration = errors.New("invalid configuration")
+
+// ErrNilKeysSigner signals that a nil keys signer has been provided
+var ErrNilKeysSigner = errors.New("nil keys signer")
@@ -76,6 +76,7 @@ type TrieSyncStatisticsProvider interface {
 // ManagedPeersHolder defines the operations of an entity that holds managed identities for a node
 type ManagedPeersHolder interface {
 	AddManagedPeer(privateKeyBytes []byte) error
+	AddManagedPublicKey(publicKeyBytes []byte) error
 	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
 	GetP2PIdentity(pkBytes []byte) ([]byte, core.PeerID, error)
 	GetMachineID(pkBytes []byte) (string, error)
@@ -101,3 +102,9 @@ type ShardCoordinator interface {
 	ComputeId(address []byte) uint32
 	IsInterfaceNil() bool
 }
+
+// KeysSigner defines the component able to sign messages with the managed BLS keys
+type KeysSigner interface {
+	Sign(publicKey []byte, message []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
@@ -5,27 +5,26 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/data/batch"
-	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/heartbeat"
 )
 
 type commonPeerAuthenticationSender struct {
 	baseSender
 	nodesCoordinator      heartbeat.NodesCoordinator
-	peerSignatureHandler  crypto.PeerSignatureHandler
 	hardforkTrigger       heartbeat.HardforkTrigger
 	hardforkTriggerPubKey []byte
 }
 
 func (cpas *commonPeerAuthenticationSender) generateMessageBytes(
 	pkBytes []byte,
-	privateKey crypto.PrivateKey,
+	pidSignature []byte,
 	p2pSkBytes []byte,
 	pidBytes []byte,
 ) ([]byte, bool, int64, error) {
 	msg := &heartbeat.PeerAuthentication{
-		Pid:    pidBytes,
-		Pubkey: pkBytes,
+		Pid:       pidBytes,
+		Pubkey:    pkBytes,
+		Signature: pidSignature,
 	}
 
 	hardforkPayload, isTriggered := cpas.getHardforkPayload()
@@ -51,11 +50,6 @@ func (cpas *commonPeerAuthenticationSender) generateMessageBytes(
 		}
 	}
 
-	msg.Signature, err = cpas.peerSignatureHandler.GetPeerSignature(privateKey, msg.Pid)
-	if err != nil {
-		return nil, isTriggered, 0, err
-	}
-
 	msgBytes, err := cpas.marshaller.Marshal(msg)
 	if err != nil {
 		return nil, isTriggered, 0, err
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
-	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/heartbeat"
 )
 
@@ -16,7 +15,7 @@ const delayedBroadcast = 200 * time.Millisecond
 type argMultikeyPeerAuthenticationSender struct {
 	argBaseSender
 	nodesCoordinator         heartbeat.NodesCoordinator
-	peerSignatureHandler     crypto.PeerSignatureHandler
+	keysSigner               heartbeat.KeysSigner
 	hardforkTrigger          heartbeat.HardforkTrigger
 	hardforkTimeBetweenSends time.Duration
 	hardforkTriggerPubKey    []byte
@@ -27,6 +26,7 @@ type argMultikeyPeerAuthenticationSender struct {
 
 type multikeyPeerAuthenticationSender struct {
 	commonPeerAuthenticationSender
+	keysSigner               heartbeat.KeysSigner
 	hardforkTimeBetweenSends time.Duration
 	managedPeersHolder       heartbeat.ManagedPeersHolder
 	timeBetweenChecks        time.Duration
@@ -45,10 +45,10 @@ func newMultikeyPeerAuthenticationSender(args argMultikeyPeerAuthenticationSende
 		commonPeerAuthenticationSender: commonPeerAuthenticationSender{
 			baseSender:            createBaseSender(args.argBaseSender),
 			nodesCoordinator:      args.nodesCoordinator,
-			peerSignatureHandler:  args.peerSignatureHandler,
 			hardforkTrigger:       args.hardforkTrigger,
 			hardforkTriggerPubKey: args.hardforkTriggerPubKey,
 		},
+		keysSigner:               args.keysSigner,
 		hardforkTimeBetweenSends: args.hardforkTimeBetweenSends,
 		managedPeersHolder:       args.managedPeersHolder,
 		timeBetweenChecks:        args.timeBetweenChecks,
@@ -71,8 +71,8 @@ func checkMultikeyPeerAuthenticationSenderArgs(args argMultikeyPeerAuthenticatio
 	if check.IfNil(args.nodesCoordinator) {
 		return heartbeat.ErrNilNodesCoordinator
 	}
-	if check.IfNil(args.peerSignatureHandler) {
-		return heartbeat.ErrNilPeerSignatureHandler
+	if check.IfNil(args.keysSigner) {
+		return heartbeat.ErrNilKeysSigner
 	}
 	if check.IfNil(args.hardforkTrigger) {
 		return heartbeat.ErrNilHardforkTrigger
@@ -100,8 +100,8 @@ func checkMultikeyPeerAuthenticationSenderArgs(args argMultikeyPeerAuthenticatio
 func (sender *multikeyPeerAuthenticationSender) Execute() {
 	currentTimeAsUnix := sender.getCurrentTimeHandler().Unix()
 	managedKeys := sender.managedPeersHolder.GetManagedKeysByCurrentNode()
-	for pk, sk := range managedKeys {
-		err := sender.process(pk, sk, currentTimeAsUnix)
+	for pk := range managedKeys {
+		err := sender.process(pk, currentTimeAsUnix)
 		if err != nil {
 			nextTimeToCheck, errNextPeerAuth := sender.managedPeersHolder.GetNextPeerAuthenticationTime([]byte(pk))
 			if errNextPeerAuth != nil {
@@ -117,7 +117,7 @@ func (sender *multikeyPeerAuthenticationSender) Execute() {
 	sender.CreateNewTimer(sender.timeBetweenChecks)
 }
 
-func (sender *multikeyPeerAuthenticationSender) process(pk string, sk crypto.PrivateKey, currentTimeAsUnix int64) error {
+func (sender *multikeyPeerAuthenticationSender) process(pk string, currentTimeAsUnix int64) error {
 	pkBytes := []byte(pk)
 	if !sender.processIfShouldSend(pkBytes, currentTimeAsUnix) {
 		return nil
@@ -125,7 +125,7 @@ func (sender *multikeyPeerAuthenticationSender) process(pk string, sk crypto.Pri
 
 	currentTimeStamp := time.Unix(currentTimeAsUnix, 0)
 
-	data, isHardforkTriggered, _, err := sender.prepareMessage([]byte(pk), sk)
+	data, isHardforkTriggered, _, err := sender.prepareMessage(pkBytes)
 	if err != nil {
 		sender.managedPeersHolder.SetNextPeerAuthenticationTime(pkBytes, currentTimeStamp.Add(sender.timeBetweenSendsWhenError))
 		return err
@@ -176,13 +176,18 @@ func (sender *multikeyPeerAuthenticationSender) processIfShouldSend(pkBytes []by
 	return false
 }
 
-func (sender *multikeyPeerAuthenticationSender) prepareMessage(pkBytes []byte, privateKey crypto.PrivateKey) ([]byte, bool, int64, error) {
+func (sender *multikeyPeerAuthenticationSender) prepareMessage(pkBytes []byte) ([]byte, bool, int64, error) {
 	p2pSkBytes, pid, err := sender.managedPeersHolder.GetP2PIdentity(pkBytes)
 	if err != nil {
 		return nil, false, 0, err
 	}
 
-	return sender.generateMessageBytes(pkBytes, privateKey, p2pSkBytes, pid.Bytes())
+	pidSignature, err := sender.keysSigner.Sign(pkBytes, pid.Bytes())
+	if err != nil {
+		return nil, false, 0, err
+	}
+
+	return sender.generateMessageBytes(pkBytes, pidSignature, p2pSkBytes, pid.Bytes())
 }
 
 func (sender *multikeyPeerAuthenticationSender) sendData(pkBytes []byte, data []byte, isHardforkTriggered bool) {
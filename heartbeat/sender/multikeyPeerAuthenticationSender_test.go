@@ -16,7 +16,6 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
 	"github.com/multiversx/mx-chain-go/heartbeat"
-	"github.com/multiversx/mx-chain-go/heartbeat/mock"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
@@ -37,7 +36,7 @@ func createMockMultikeyPeerAuthenticationSenderArgs(argBase argBaseSender) argMu
 	return argMultikeyPeerAuthenticationSender{
 		argBaseSender:            argBase,
 		nodesCoordinator:         &shardingMocks.NodesCoordinatorStub{},
-		peerSignatureHandler:     &cryptoMocks.PeerSignatureHandlerStub{},
+		keysSigner:               &cryptoMocks.KeysSignerStub{},
 		hardforkTrigger:          &testscommon.HardforkTriggerStub{},
 		hardforkTimeBetweenSends: time.Second,
 		hardforkTriggerPubKey:    providedHardforkPubKey,
@@ -110,16 +109,9 @@ func createMockMultikeyPeerAuthenticationSenderArgsSemiIntegrationTests(
 	args := argMultikeyPeerAuthenticationSender{
 		argBaseSender:    baseArgs,
 		nodesCoordinator: &shardingMocks.NodesCoordinatorStub{},
-		peerSignatureHandler: &mock.PeerSignatureHandlerStub{
-			VerifyPeerSignatureCalled: func(pk []byte, pid core.PeerID, signature []byte) error {
-				senderPubKey, err := keyGenForBLS.PublicKeyFromByteArray(pk)
-				if err != nil {
-					return err
-				}
-				return singleSigner.Verify(senderPubKey, pid.Bytes(), signature)
-			},
-			GetPeerSignatureCalled: func(privateKey crypto.PrivateKey, pid []byte) ([]byte, error) {
-				return singleSigner.Sign(privateKey, pid)
+		keysSigner: &cryptoMocks.KeysSignerStub{
+			SignCalled: func(publicKey []byte, message []byte) ([]byte, error) {
+				return singleSigner.Sign(keyMap[string(publicKey)], message)
 			},
 		},
 		hardforkTrigger:          &testscommon.HardforkTriggerStub{},
@@ -258,15 +250,15 @@ func TestNewMultikeyPeerAuthenticationSender(t *testing.T) {
 		assert.Nil(t, senderInstance)
 		assert.Equal(t, heartbeat.ErrNilNodesCoordinator, err)
 	})
-	t.Run("nil peer signature handler should error", func(t *testing.T) {
+	t.Run("nil keys signer should error", func(t *testing.T) {
 		t.Parallel()
 
 		args := createMockMultikeyPeerAuthenticationSenderArgs(createMockBaseArgs())
-		args.peerSignatureHandler = nil
+		args.keysSigner = nil
 		senderInstance, err := newMultikeyPeerAuthenticationSender(args)
 
 		assert.Nil(t, senderInstance)
-		assert.Equal(t, heartbeat.ErrNilPeerSignatureHandler, err)
+		assert.Equal(t, heartbeat.ErrNilKeysSigner, err)
 	})
 	t.Run("nil hardfork trigger should error", func(t *testing.T) {
 		t.Parallel()
@@ -712,7 +704,10 @@ func testSingleMessage(
 	assert.Equal(tb, correspondingPid.Pretty(), core.PeerID(recoveredMessage.Pid).Pretty())
 	assert.Equal(tb, correspondingPid, pid)
 
-	errVerify := args.peerSignatureHandler.VerifyPeerSignature(recoveredMessage.Pubkey, core.PeerID(recoveredMessage.Pid), recoveredMessage.Signature)
+	keyGenForBLS := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	senderPubKey, err := keyGenForBLS.PublicKeyFromByteArray(recoveredMessage.Pubkey)
+	assert.Nil(tb, err)
+	errVerify := singlesig.NewBlsSigner().Verify(senderPubKey, recoveredMessage.Pid, recoveredMessage.Signature)
 	assert.Nil(tb, errVerify)
 
 	messenger := args.mainMessenger.(*p2pmocks.MessengerStub)
@@ -21,6 +21,7 @@ type argPeerAuthenticationSender struct {
 
 type peerAuthenticationSender struct {
 	commonPeerAuthenticationSender
+	peerSignatureHandler     crypto.PeerSignatureHandler
 	redundancy               heartbeat.NodeRedundancyHandler
 	privKey                  crypto.PrivateKey
 	publicKey                crypto.PublicKey
@@ -40,10 +41,10 @@ func newPeerAuthenticationSender(args argPeerAuthenticationSender) (*peerAuthent
 		commonPeerAuthenticationSender: commonPeerAuthenticationSender{
 			baseSender:            createBaseSender(args.argBaseSender),
 			nodesCoordinator:      args.nodesCoordinator,
-			peerSignatureHandler:  args.peerSignatureHandler,
 			hardforkTrigger:       args.hardforkTrigger,
 			hardforkTriggerPubKey: args.hardforkTriggerPubKey,
 		},
+		peerSignatureHandler:     args.peerSignatureHandler,
 		redundancy:               redundancyHandler,
 		privKey:                  args.privKey,
 		publicKey:                args.privKey.GeneratePublic(),
@@ -120,7 +121,13 @@ func (sender *peerAuthenticationSender) execute() (error, bool) {
 		return err, false
 	}
 
-	data, isTriggered, msgTimestamp, err := sender.generateMessageBytes(pkBytes, sk, nil, sender.mainMessenger.ID().Bytes())
+	pidBytes := sender.mainMessenger.ID().Bytes()
+	pidSignature, err := sender.peerSignatureHandler.GetPeerSignature(sk, pidBytes)
+	if err != nil {
+		return err, false
+	}
+
+	data, isTriggered, msgTimestamp, err := sender.generateMessageBytes(pkBytes, pidSignature, nil, pidBytes)
 	if err != nil {
 		return err, isTriggered
 	}
@@ -12,6 +12,7 @@ type argPeerAuthenticationSenderFactory struct {
 	argBaseSender
 	nodesCoordinator         heartbeat.NodesCoordinator
 	peerSignatureHandler     crypto.PeerSignatureHandler
+	keysSigner               heartbeat.KeysSigner
 	hardforkTrigger          heartbeat.HardforkTrigger
 	hardforkTimeBetweenSends time.Duration
 	hardforkTriggerPubKey    []byte
@@ -47,6 +48,16 @@ func createRegularPeerAuthenticationSender(args argPeerAuthenticationSenderFacto
 }
 
 func createMultikeyPeerAuthenticationSender(args argPeerAuthenticationSenderFactory) (*multikeyPeerAuthenticationSender, error) {
-	argsSender := argMultikeyPeerAuthenticationSender(args)
+	argsSender := argMultikeyPeerAuthenticationSender{
+		argBaseSender:            args.argBaseSender,
+		nodesCoordinator:         args.nodesCoordinator,
+		keysSigner:               args.keysSigner,
+		hardforkTrigger:          args.hardforkTrigger,
+		hardforkTimeBetweenSends: args.hardforkTimeBetweenSends,
+		hardforkTriggerPubKey:    args.hardforkTriggerPubKey,
+		managedPeersHolder:       args.managedPeersHolder,
+		timeBetweenChecks:        args.timeBetweenChecks,
+		shardCoordinator:         args.shardCoordinator,
+	}
 	return newMultikeyPeerAuthenticationSender(argsSender)
 }
@@ -21,6 +21,7 @@ func createMockPeerAuthenticationSenderFactoryArgs() argPeerAuthenticationSender
 		argBaseSender:            createMockBaseArgs(),
 		nodesCoordinator:         &shardingMocks.NodesCoordinatorStub{},
 		peerSignatureHandler:     &cryptoMocks.PeerSignatureHandlerStub{},
+		keysSigner:               &cryptoMocks.KeysSignerStub{},
 		hardforkTrigger:          &testscommon.HardforkTriggerStub{},
 		hardforkTimeBetweenSends: time.Second,
 		hardforkTriggerPubKey:    providedHardforkPubKey,
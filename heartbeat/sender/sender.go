@@ -30,6 +30,7 @@ type ArgSender struct {
 	PeerSubType                                 core.P2PPeerSubType
 	CurrentBlockProvider                        heartbeat.CurrentBlockProvider
 	PeerSignatureHandler                        crypto.PeerSignatureHandler
+	KeysSigner                                  heartbeat.KeysSigner
 	PrivateKey                                  crypto.PrivateKey
 	RedundancyHandler                           heartbeat.NodeRedundancyHandler
 	NodesCoordinator                            heartbeat.NodesCoordinator
@@ -69,6 +70,7 @@ func NewSender(args ArgSender) (*sender, error) {
 		},
 		nodesCoordinator:         args.NodesCoordinator,
 		peerSignatureHandler:     args.PeerSignatureHandler,
+		keysSigner:               args.KeysSigner,
 		hardforkTrigger:          args.HardforkTrigger,
 		hardforkTimeBetweenSends: args.HardforkTimeBetweenSends,
 		hardforkTriggerPubKey:    args.HardforkTriggerPubKey,
@@ -142,7 +144,7 @@ func checkSenderArgs(args ArgSender) error {
 	mpasArgs := argMultikeyPeerAuthenticationSender{
 		argBaseSender:            basePeerAuthSenderArgs,
 		nodesCoordinator:         args.NodesCoordinator,
-		peerSignatureHandler:     args.PeerSignatureHandler,
+		keysSigner:               args.KeysSigner,
 		hardforkTrigger:          args.HardforkTrigger,
 		hardforkTimeBetweenSends: args.HardforkTimeBetweenSends,
 		hardforkTriggerPubKey:    args.HardforkTriggerPubKey,
@@ -39,6 +39,7 @@ func createMockSenderArgs() ArgSender {
 		PeerSubType:                                 core.RegularPeer,
 		CurrentBlockProvider:                        &mock.CurrentBlockProviderStub{},
 		PeerSignatureHandler:                        &cryptoMocks.PeerSignatureHandlerStub{},
+		KeysSigner:                                  &cryptoMocks.KeysSignerStub{},
 		PrivateKey:                                  &cryptoMocks.PrivateKeyStub{},
 		RedundancyHandler:                           &mock.RedundancyHandlerStub{},
 		NodesCoordinator:                            &shardingMocks.NodesCoordinatorStub{},
@@ -214,6 +215,16 @@ func TestNewSender(t *testing.T) {
 		assert.Nil(t, senderInstance)
 		assert.Equal(t, heartbeat.ErrNilPeerSignatureHandler, err)
 	})
+	t.Run("nil keys signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSenderArgs()
+		args.KeysSigner = nil
+		senderInstance, err := NewSender(args)
+
+		assert.Nil(t, senderInstance)
+		assert.Equal(t, heartbeat.ErrNilKeysSigner, err)
+	})
 	t.Run("nil private key should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -14,6 +14,7 @@ import (
 type ChainSimulator interface {
 	GenerateBlocks(numOfBlocks int) error
 	GenerateBlocksUntilEpochIsReached(targetEpoch int32) error
+	FastForwardUntilEpochIsReached(targetEpoch int32) error
 	AddValidatorKeys(validatorsPrivateKeys [][]byte) error
 	GetNodeHandler(shardID uint32) process.NodeHandler
 	SendTxAndGenerateBlockTilTxIsExecuted(txToSend *transaction.Transaction, maxNumOfBlockToGenerateWhenExecutingTx int) (*transaction.ApiTransactionResult, error)
@@ -24,4 +25,6 @@ type ChainSimulator interface {
 	GetAccount(address dtos.WalletAddress) (api.AccountResponse, error)
 	ForceResetValidatorStatisticsCache() error
 	GetValidatorPrivateKeys() []crypto.PrivateKey
+	Snapshot() (uint64, error)
+	RevertTo(snapshotID uint64) error
 }
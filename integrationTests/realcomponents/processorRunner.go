@@ -389,6 +389,7 @@ func (pr *ProcessorRunner) createProcessComponents(tb testing.TB) {
 		Marshalizer:              pr.CoreComponents.InternalMarshalizer(),
 		Store:                    pr.DataComponents.StorageService(),
 		Uint64ByteSliceConverter: pr.CoreComponents.Uint64ByteSliceConverter(),
+		Accounts:                 pr.StateComponents.AccountsAdapter(),
 	}
 	historyRepositoryFactory, err := dbLookupFactory.NewHistoryRepositoryFactory(historyRepoFactoryArgs)
 	require.Nil(tb, err)
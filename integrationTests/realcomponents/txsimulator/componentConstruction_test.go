@@ -64,7 +64,7 @@ func TestTransactionSimulationComponentConstructionOnMetachain(t *testing.T) {
 		Version:  1,
 	}
 
-	_, err = pr.ProcessComponents.APITransactionEvaluator().SimulateTransactionExecution(txForSimulation)
+	_, err = pr.ProcessComponents.APITransactionEvaluator().SimulateTransactionExecution(txForSimulation, nil, false)
 	assert.Nil(t, err)
 	assert.Equal(t, 0, pr.StateComponents.AccountsAdapter().JournalLen()) // state for processing should not be dirtied
 }
@@ -135,7 +135,7 @@ func TestTransactionSimulationComponentConstructionOnShard(t *testing.T) {
 		Version:  1,
 	}
 
-	_, err = pr.ProcessComponents.APITransactionEvaluator().SimulateTransactionExecution(txForSimulation)
+	_, err = pr.ProcessComponents.APITransactionEvaluator().SimulateTransactionExecution(txForSimulation, nil, false)
 	assert.Nil(t, err)
 	assert.Equal(t, 0, pr.StateComponents.AccountsAdapter().JournalLen()) // state for processing should not be dirtied
 }
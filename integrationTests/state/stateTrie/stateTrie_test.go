@@ -1072,6 +1072,7 @@ func createAccounts(
 		AccountFactory:       accCreator,
 		ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
 		LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
+		SnapshotFilesWriter:  &stateMock.SnapshotFilesWriterStub{},
 		StateStatsHandler:    statistics.NewStateStatistics(),
 	})
 	argsAccountsDB := state.ArgsAccountsDB{
@@ -2745,6 +2746,7 @@ func createAccountsDBTestSetup() *state.AccountsDB {
 		AccountFactory:       accCreator,
 		ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
 		LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
+		SnapshotFilesWriter:  &stateMock.SnapshotFilesWriterStub{},
 		StateStatsHandler:    statistics.NewStateStatistics(),
 	})
 
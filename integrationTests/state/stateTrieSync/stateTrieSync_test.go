@@ -608,6 +608,7 @@ func getUserAccountSyncerArgs(node *integrationTests.TestProcessorNode, version
 			RequestHandler:                    node.RequestHandler,
 			Timeout:                           common.TimeoutGettingTrieNodes,
 			Cacher:                            node.DataPool.TrieNodes(),
+			LeavesRangesCacher:                node.DataPool.TrieLeavesRanges(),
 			MaxTrieLevelInMemory:              200,
 			MaxHardCapForMissingNodes:         5000,
 			TrieSyncerVersion:                 version,
@@ -255,11 +255,12 @@ func (tcn *TestConsensusNode) initNode(args ArgsTestConsensusNode) {
 	}
 
 	argsKeysHolder := keysManagement.ArgsManagedPeersHolder{
-		KeyGenerator:          args.KeyGen,
-		P2PKeyGenerator:       args.P2PKeyGen,
-		MaxRoundsOfInactivity: 0,
-		PrefsConfig:           config.Preferences{},
-		P2PKeyConverter:       p2pFactory.NewP2PKeyConverter(),
+		KeyGenerator:           args.KeyGen,
+		P2PKeyGenerator:        args.P2PKeyGen,
+		MaxRoundsOfInactivity:  0,
+		PrefsConfig:            config.Preferences{},
+		P2PKeyConverter:        p2pFactory.NewP2PKeyConverter(),
+		RedundancyLeaseHandler: &testscommon.RedundancyLeaseHandlerStub{},
 	}
 	keysHolder, _ := keysManagement.NewManagedPeersHolder(argsKeysHolder)
 
@@ -278,11 +279,18 @@ func (tcn *TestConsensusNode) initNode(args ArgsTestConsensusNode) {
 	}
 	keysHandler, _ := keysManagement.NewKeysHandler(argsKeysHandler)
 
+	argsLocalKeysSigner := keysManagement.ArgsLocalKeysSigner{
+		PrivateKeysProvider:  keysHandler,
+		SingleSigner:         TestSingleBlsSigner,
+		MultiSignerContainer: multiSigContainer,
+	}
+	keysSigner, _ := keysManagement.NewLocalKeysSigner(argsLocalKeysSigner)
+
 	signingHandlerArgs := cryptoFactory.ArgsSigningHandler{
 		PubKeys:              []string{pubKeyString},
 		MultiSignerContainer: multiSigContainer,
 		KeyGenerator:         args.KeyGen,
-		KeysHandler:          keysHandler,
+		KeysSigner:           keysSigner,
 		SingleSigner:         TestSingleBlsSigner,
 	}
 	sigHandler, _ := cryptoFactory.NewSigningHandler(signingHandlerArgs)
@@ -302,6 +310,7 @@ func (tcn *TestConsensusNode) initNode(args ArgsTestConsensusNode) {
 	cryptoComponents.PeerSignHandler = peerSigHandler
 	cryptoComponents.SigHandler = sigHandler
 	cryptoComponents.KeysHandlerField = keysHandler
+	cryptoComponents.KeysSignerField = keysSigner
 
 	processComponents := GetDefaultProcessComponents()
 	processComponents.ForkDetect = forkDetector
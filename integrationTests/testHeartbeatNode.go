@@ -244,7 +244,8 @@ func NewTestHeartbeatNode(
 				RedundancyLevel: 0,
 			},
 		},
-		P2PKeyConverter: factory.NewP2PKeyConverter(),
+		P2PKeyConverter:        factory.NewP2PKeyConverter(),
+		RedundancyLeaseHandler: &testscommon.RedundancyLeaseHandlerStub{},
 	}
 	thn.ManagedPeersHolder, _ = keysManagement.NewManagedPeersHolder(argsKeysManagement)
 
@@ -471,6 +472,21 @@ func (thn *TestHeartbeatNode) initStorage() {
 	thn.Storage = CreateStore(thn.ShardCoordinator.NumberOfShards())
 }
 
+func (thn *TestHeartbeatNode) createKeysSigner() *cryptoMocks.KeysSignerStub {
+	singleSigner := singlesig.NewBlsSigner()
+
+	return &cryptoMocks.KeysSignerStub{
+		SignCalled: func(publicKey []byte, message []byte) ([]byte, error) {
+			privateKey, err := thn.ManagedPeersHolder.GetPrivateKey(publicKey)
+			if err != nil {
+				return nil, err
+			}
+
+			return singleSigner.Sign(privateKey, message)
+		},
+	}
+}
+
 func (thn *TestHeartbeatNode) initSender() {
 	identifierHeartbeat := common.HeartbeatV2Topic + thn.ShardCoordinator.CommunicationIdentifier(thn.ShardCoordinator.SelfId())
 	argsSender := sender.ArgSender{
@@ -486,6 +502,7 @@ func (thn *TestHeartbeatNode) initSender() {
 		PeerSubType:             core.RegularPeer,
 		CurrentBlockProvider:    &testscommon.ChainHandlerStub{},
 		PeerSignatureHandler:    thn.PeerSigHandler,
+		KeysSigner:              thn.createKeysSigner(),
 		PrivateKey:              thn.NodeKeys.MainKey.Sk,
 		RedundancyHandler:       &mock.RedundancyHandlerStub{},
 		NodesCoordinator:        thn.NodesCoordinator,
@@ -475,6 +475,7 @@ func CreateAccountsDBWithEnableEpochsHandler(
 		AccountFactory:       accountFactory,
 		ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
 		LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
+		SnapshotFilesWriter:  &testStorage.SnapshotFilesWriterStub{},
 		StateStatsHandler:    statistics.NewStateStatistics(),
 	})
 
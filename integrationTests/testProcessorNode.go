@@ -33,6 +33,7 @@ import (
 	mclsig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
 	nodeFactory "github.com/multiversx/mx-chain-go/cmd/node/factory"
 	"github.com/multiversx/mx-chain-go/common"
+	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
 	"github.com/multiversx/mx-chain-go/common/enablers"
 	"github.com/multiversx/mx-chain-go/common/errChan"
 	"github.com/multiversx/mx-chain-go/common/forking"
@@ -57,6 +58,7 @@ import (
 	"github.com/multiversx/mx-chain-go/genesis/parsing"
 	"github.com/multiversx/mx-chain-go/genesis/process/disabled"
 	"github.com/multiversx/mx-chain-go/integrationTests/mock"
+	"github.com/multiversx/mx-chain-go/keysManagement"
 	"github.com/multiversx/mx-chain-go/node"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/nodeDebugFactory"
@@ -89,6 +91,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks/counters"
 	"github.com/multiversx/mx-chain-go/process/smartContract/processProxy"
 	"github.com/multiversx/mx-chain-go/process/smartContract/scrCommon"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	processSync "github.com/multiversx/mx-chain-go/process/sync"
 	"github.com/multiversx/mx-chain-go/process/track"
 	"github.com/multiversx/mx-chain-go/process/transaction"
@@ -838,7 +841,7 @@ func (tpn *TestProcessorNode) initTestNodeWithArgs(args ArgTestProcessorNode) {
 		TestHasher,
 		tpn.MainMessenger,
 		tpn.ShardCoordinator,
-		tpn.OwnAccount.PeerSigHandler,
+		tpn.createKeysSigner(),
 		tpn.DataPool.Headers(),
 		tpn.MainInterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
@@ -899,6 +902,7 @@ func (tpn *TestProcessorNode) createFullSCQueryService(gasMap map[string]map[str
 		NilCompiledSCStore:       true,
 		GasSchedule:              gasSchedule,
 		Counter:                  counters.NewDisabledCounter(),
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
 	}
 
@@ -1062,7 +1066,7 @@ func (tpn *TestProcessorNode) InitializeProcessors(gasMap map[string]map[string]
 		TestHasher,
 		tpn.MainMessenger,
 		tpn.ShardCoordinator,
-		tpn.OwnAccount.PeerSigHandler,
+		tpn.createKeysSigner(),
 		tpn.DataPool.Headers(),
 		tpn.MainInterceptorsContainer,
 		&testscommon.AlarmSchedulerStub{},
@@ -1640,6 +1644,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		NilCompiledSCStore:       true,
 		GasSchedule:              gasSchedule,
 		Counter:                  counter,
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
 	}
 
@@ -1710,6 +1715,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		EnableEpochsHandler: tpn.EnableEpochsHandler,
 		VMOutputCacher:      txcache.NewDisabledCache(),
 		WasmVMChangeLocker:  tpn.WasmVMChangeLocker,
+		ExecutionTracer:     tracing.NewDisabledExecutionTracer(),
 	}
 
 	tpn.ScProcessor, _ = processProxy.NewTestSmartContractProcessorProxy(argsNewScProcessor, tpn.EpochNotifier)
@@ -1866,6 +1872,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors(gasMap map[string]map[stri
 		NilCompiledSCStore:       true,
 		GasSchedule:              gasSchedule,
 		Counter:                  counters.NewDisabledCounter(),
+		ExecutionTracer:          tracing.NewDisabledExecutionTracer(),
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
 	}
 
@@ -1989,6 +1996,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors(gasMap map[string]map[stri
 		EnableEpochsHandler: tpn.EnableEpochsHandler,
 		VMOutputCacher:      txcache.NewDisabledCache(),
 		WasmVMChangeLocker:  tpn.WasmVMChangeLocker,
+		ExecutionTracer:     tracing.NewDisabledExecutionTracer(),
 	}
 
 	tpn.ScProcessor, _ = processProxy.NewTestSmartContractProcessorProxy(argsNewScProcessor, tpn.EpochNotifier)
@@ -2144,6 +2152,20 @@ func (tpn *TestProcessorNode) addMockVm(blockchainHook vmcommon.BlockchainHook)
 	_ = tpn.VMContainer.Add(factory.InternalTestingVM, mockVM)
 }
 
+func (tpn *TestProcessorNode) createKeysSigner() cryptoCommon.KeysSigner {
+	argsLocalKeysSigner := keysManagement.ArgsLocalKeysSigner{
+		PrivateKeysProvider: testscommon.NewKeysHandlerSingleSignerMock(
+			tpn.NodeKeys.MainKey.Sk,
+			tpn.MainMessenger.ID(),
+		),
+		SingleSigner:         tpn.OwnAccount.SingleSigner,
+		MultiSignerContainer: cryptoMocks.NewMultiSignerContainerMock(tpn.MultiSigner),
+	}
+	keysSigner, _ := keysManagement.NewLocalKeysSigner(argsLocalKeysSigner)
+
+	return keysSigner
+}
+
 func (tpn *TestProcessorNode) initBlockProcessor() {
 	var err error
 
@@ -3352,19 +3374,22 @@ func GetDefaultDataComponents() *mock.DataComponentsStub {
 // GetDefaultCryptoComponents -
 func GetDefaultCryptoComponents() *mock.CryptoComponentsStub {
 	return &mock.CryptoComponentsStub{
-		PubKey:                  &mock.PublicKeyMock{},
-		PrivKey:                 &mock.PrivateKeyMock{},
-		PubKeyString:            "pubKey",
-		PubKeyBytes:             []byte("pubKey"),
-		BlockSig:                &mock.SignerMock{},
-		TxSig:                   &mock.SignerMock{},
-		MultiSigContainer:       cryptoMocks.NewMultiSignerContainerMock(TestMultiSig),
-		PeerSignHandler:         &mock.PeerSignatureHandler{},
-		BlKeyGen:                &mock.KeyGenMock{},
-		TxKeyGen:                &mock.KeyGenMock{},
-		MsgSigVerifier:          &testscommon.MessageSignVerifierMock{},
-		ManagedPeersHolderField: &testscommon.ManagedPeersHolderStub{},
-		KeysHandlerField:        &testscommon.KeysHandlerStub{},
+		PubKey:                      &mock.PublicKeyMock{},
+		PrivKey:                     &mock.PrivateKeyMock{},
+		PubKeyString:                "pubKey",
+		PubKeyBytes:                 []byte("pubKey"),
+		BlockSig:                    &mock.SignerMock{},
+		TxSig:                       &mock.SignerMock{},
+		MultiSigContainer:           cryptoMocks.NewMultiSignerContainerMock(TestMultiSig),
+		PeerSignHandler:             &mock.PeerSignatureHandler{},
+		BlKeyGen:                    &mock.KeyGenMock{},
+		TxKeyGen:                    &mock.KeyGenMock{},
+		MsgSigVerifier:              &testscommon.MessageSignVerifierMock{},
+		ManagedPeersHolderField:     &testscommon.ManagedPeersHolderStub{},
+		KeysHandlerField:            &testscommon.KeysHandlerStub{},
+		KeysSignerField:             &cryptoMocks.KeysSignerStub{},
+		ManagedKeysUpdaterField:     &testscommon.ManagedKeysUpdaterStub{},
+		RedundancyLeaseHandlerField: &testscommon.RedundancyLeaseHandlerStub{},
 	}
 }
 
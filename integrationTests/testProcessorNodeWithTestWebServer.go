@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/trieIterators/factory"
 	"github.com/multiversx/mx-chain-go/process/coordinator"
 	"github.com/multiversx/mx-chain-go/process/smartContract/builtInFunctions"
+	"github.com/multiversx/mx-chain-go/process/smartContract/tracing"
 	"github.com/multiversx/mx-chain-go/process/transactionEvaluator"
 	"github.com/multiversx/mx-chain-go/process/txstatus"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -185,7 +186,10 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	txSimulator, err := transactionEvaluator.NewTransactionSimulator(argSimulator)
 	log.LogIfError(err)
 
-	wrappedAccounts, err := transactionEvaluator.NewSimulationAccountsDB(tpn.AccntState)
+	wrappedAccounts, err := transactionEvaluator.NewSimulationAccountsDB(tpn.AccntState, &state.AccountsRepositoryStub{}, TestHasher)
+	log.LogIfError(err)
+
+	executionTracer, err := tracing.NewExecutionTracer(TestAddressPubkeyConverter)
 	log.LogIfError(err)
 
 	argsTransactionEvaluator := transactionEvaluator.ArgsApiTransactionEvaluator{
@@ -196,6 +200,8 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		ShardCoordinator:    tpn.ShardCoordinator,
 		EnableEpochsHandler: tpn.EnableEpochsHandler,
 		BlockChain:          tpn.BlockChain,
+		AddressConverter:    TestAddressPubkeyConverter,
+		Tracer:              executionTracer,
 	}
 	apiTransactionEvaluator, err := transactionEvaluator.NewAPITransactionEvaluator(argsTransactionEvaluator)
 	log.LogIfError(err)
@@ -280,6 +286,8 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		AccountsParser:           &genesisMocks.AccountsParserStub{},
 		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
 		ManagedPeersMonitor:      &testscommon.ManagedPeersMonitorStub{},
+		ManagedKeysUpdater:       &testscommon.ManagedKeysUpdaterStub{},
+		RedundancyLeaseHandler:   &testscommon.RedundancyLeaseHandlerStub{},
 		NodesCoordinator:         tpn.NodesCoordinator,
 	}
 
@@ -334,6 +342,11 @@ func createGroups(facade Facade) map[string]shared.GroupHandler {
 		groupsMap["proof"] = proofGroup
 	}
 
+	stateGroup, err := groups.NewStateGroup(facade)
+	if err == nil {
+		groupsMap["state"] = stateGroup
+	}
+
 	transactionGroup, err := groups.NewTransactionGroup(facade)
 	if err == nil {
 		groupsMap["transaction"] = transactionGroup
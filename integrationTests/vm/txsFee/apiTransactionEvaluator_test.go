@@ -47,7 +47,7 @@ func TestSCCallCostTransactionCost(t *testing.T) {
 
 	tx := vm.CreateTransaction(0, big.NewInt(0), sndAddr, scAddress, gasPrice, gasLimit, []byte("increment"))
 
-	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(418), res.GasUnits)
 }
@@ -67,7 +67,7 @@ func TestScDeployTransactionCost(t *testing.T) {
 	scCode := wasm.GetSCCode("../wasm/testdata/misc/fib_wasm/output/fib_wasm.wasm")
 	tx := vm.CreateTransaction(0, big.NewInt(0), sndAddr, vm.CreateEmptyAddress(), 0, 0, []byte(wasm.CreateDeployTxData(scCode)))
 
-	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(1960), res.GasUnits)
 }
@@ -98,7 +98,7 @@ func TestAsyncCallsTransactionCost(t *testing.T) {
 	secondSCAddress := utils.DoDeploySecond(t, testContext, pathToContract, ownerAccount, gasPrice, deployGasLimit, args, big.NewInt(50))
 
 	tx := vm.CreateTransaction(1, big.NewInt(0), senderAddr, secondSCAddress, 0, 0, []byte("doSomething"))
-	resWithCost, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	resWithCost, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(99984751), resWithCost.GasUnits)
 }
@@ -124,7 +124,7 @@ func TestBuiltInFunctionTransactionCost(t *testing.T) {
 
 	txData := []byte(core.BuiltInFunctionChangeOwnerAddress + "@" + hex.EncodeToString(newOwner))
 	tx := vm.CreateTransaction(1, big.NewInt(0), owner, scAddress, 0, 0, txData)
-	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(85), res.GasUnits)
 }
@@ -147,7 +147,7 @@ func TestESDTTransfer(t *testing.T) {
 	utils.CreateAccountWithESDTBalance(t, testContext.Accounts, sndAddr, egldBalance, token, 0, esdtBalance)
 
 	tx := utils.CreateESDTTransferTx(0, sndAddr, rcvAddr, token, big.NewInt(100), 0, 0)
-	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(36), res.GasUnits)
 }
@@ -192,7 +192,7 @@ func TestAsyncESDTTransfer(t *testing.T) {
 	tx := utils.CreateESDTTransferTx(0, sndAddr, firstSCAddress, token, big.NewInt(5000), 0, 0)
 	tx.Data = []byte(string(tx.Data) + "@" + hex.EncodeToString([]byte("transferToSecondContractHalf")))
 
-	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(34157), res.GasUnits)
 }
@@ -37,3 +37,27 @@ var ErrNilShardProvider = errors.New("nil shard provider")
 
 // ErrNilEpochProvider signals that a nil epoch provider has been provided
 var ErrNilEpochProvider = errors.New("nil epoch provider")
+
+// ErrNilPrivateKeysProvider signals that a nil private keys provider has been provided
+var ErrNilPrivateKeysProvider = errors.New("nil private keys provider")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilMultiSignerContainer signals that a nil multi-signer container has been provided
+var ErrNilMultiSignerContainer = errors.New("nil multi-signer container")
+
+// ErrNodeNotInMultiKeyMode signals that the node was not started in multikey mode
+var ErrNodeNotInMultiKeyMode = errors.New("node was not started in multikey mode")
+
+// ErrNilManagedKeysUpdater signals that a nil managed keys updater has been provided
+var ErrNilManagedKeysUpdater = errors.New("nil managed keys updater")
+
+// ErrNilKeysLoader signals that a nil keys loader has been provided
+var ErrNilKeysLoader = errors.New("nil keys loader")
+
+// ErrEmptyFilePath signals that an empty file path has been provided
+var ErrEmptyFilePath = errors.New("empty file path")
+
+// ErrNilRedundancyLeaseHandler signals that a nil redundancy lease handler has been provided
+var ErrNilRedundancyLeaseHandler = errors.New("nil redundancy lease handler")
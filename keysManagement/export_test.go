@@ -79,3 +79,8 @@ func (handler *keysHandler) PublicKeyBytes() []byte {
 func (handler *keysHandler) Pid() core.PeerID {
 	return handler.pid
 }
+
+// CheckFile -
+func (watcher *keysFileWatcher) CheckFile() {
+	watcher.checkFile()
+}
@@ -1,5 +1,7 @@
 package keysManagement
 
+import crypto "github.com/multiversx/mx-chain-crypto-go"
+
 // NodesCoordinator provides Validator methods needed for the peer processing
 type NodesCoordinator interface {
 	GetAllEligibleValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error)
@@ -18,3 +20,20 @@ type CurrentEpochProvider interface {
 	CurrentEpoch() uint32
 	IsInterfaceNil() bool
 }
+
+// PrivateKeysProvider defines a component able to provide the private key associated with a handled public key
+type PrivateKeysProvider interface {
+	GetHandledPrivateKey(pkBytes []byte) crypto.PrivateKey
+	IsInterfaceNil() bool
+}
+
+// KeysLoader defines a component able to load all the keys from a pem file
+type KeysLoader interface {
+	LoadAllKeys(path string) ([][]byte, []string, error)
+}
+
+// ManagedKeysSyncer defines a component able to replace the managed keys with the provided ones
+type ManagedKeysSyncer interface {
+	SyncManagedPrivateKeys(privateKeys [][]byte, publicKeys [][]byte) (int, int, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,175 @@
+package keysManagement
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const minPollingInterval = time.Second
+
+// ArgsKeysFileWatcher is the DTO used to create a new instance of keysFileWatcher
+type ArgsKeysFileWatcher struct {
+	FilePath          string
+	KeysLoader        KeysLoader
+	ManagedKeysSyncer ManagedKeysSyncer
+	PollingInterval   time.Duration
+}
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// keysFileWatcher polls the file holding the managed keys and syncs the managed keys whenever the file changes
+type keysFileWatcher struct {
+	filePath          string
+	keysLoader        KeysLoader
+	managedKeysSyncer ManagedKeysSyncer
+	pollingInterval   time.Duration
+	lastState         fileState
+	cancel            func()
+}
+
+// NewKeysFileWatcher creates a new keys file watcher and starts polling the provided file
+func NewKeysFileWatcher(args ArgsKeysFileWatcher) (*keysFileWatcher, error) {
+	err := checkArgsKeysFileWatcher(args)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher := &keysFileWatcher{
+		filePath:          args.FilePath,
+		keysLoader:        args.KeysLoader,
+		managedKeysSyncer: args.ManagedKeysSyncer,
+		pollingInterval:   args.PollingInterval,
+	}
+	// the keys from the current file were already loaded at startup
+	watcher.lastState, _ = watcher.getFileState()
+
+	var ctx context.Context
+	ctx, watcher.cancel = context.WithCancel(context.Background())
+	go watcher.processLoop(ctx)
+
+	log.Info("watching the managed keys file", "file", args.FilePath, "polling interval", args.PollingInterval)
+
+	return watcher, nil
+}
+
+func checkArgsKeysFileWatcher(args ArgsKeysFileWatcher) error {
+	if len(args.FilePath) == 0 {
+		return ErrEmptyFilePath
+	}
+	if args.KeysLoader == nil {
+		return ErrNilKeysLoader
+	}
+	if check.IfNil(args.ManagedKeysSyncer) {
+		return ErrNilManagedKeysUpdater
+	}
+	if args.PollingInterval < minPollingInterval {
+		return fmt.Errorf("%w for PollingInterval, minimum %v, got %v", ErrInvalidValue, minPollingInterval, args.PollingInterval)
+	}
+
+	return nil
+}
+
+func (watcher *keysFileWatcher) processLoop(ctx context.Context) {
+	timer := time.NewTimer(watcher.pollingInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("closing keysFileWatcher.processLoop go routine")
+			return
+		case <-timer.C:
+			watcher.checkFile()
+			timer.Reset(watcher.pollingInterval)
+		}
+	}
+}
+
+func (watcher *keysFileWatcher) checkFile() {
+	state, err := watcher.getFileState()
+	if err != nil {
+		// a missing or unreadable file is not considered an intent to remove all the managed keys
+		log.Debug("keysFileWatcher: can not read the managed keys file", "file", watcher.filePath, "error", err)
+		return
+	}
+	if state == watcher.lastState {
+		return
+	}
+
+	err = watcher.syncKeys()
+	if err != nil {
+		log.Error("keysFileWatcher: the managed keys were not updated", "file", watcher.filePath, "error", err)
+	}
+
+	// an invalid file is not processed again until it changes
+	watcher.lastState = state
+}
+
+func (watcher *keysFileWatcher) getFileState() (fileState, error) {
+	info, err := os.Stat(watcher.filePath)
+	if err != nil {
+		return fileState{}, err
+	}
+
+	return fileState{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+	}, nil
+}
+
+func (watcher *keysFileWatcher) syncKeys() error {
+	encodedPrivateKeys, encodedPublicKeys, err := watcher.keysLoader.LoadAllKeys(watcher.filePath)
+	if err != nil {
+		return err
+	}
+	if len(encodedPrivateKeys) != len(encodedPublicKeys) {
+		return fmt.Errorf("%w, mismatch number of private and public keys", ErrInvalidValue)
+	}
+
+	privateKeys := make([][]byte, 0, len(encodedPrivateKeys))
+	publicKeys := make([][]byte, 0, len(encodedPublicKeys))
+	for i, pkString := range encodedPublicKeys {
+		skBytes, errDecode := hex.DecodeString(string(encodedPrivateKeys[i]))
+		if errDecode != nil {
+			return fmt.Errorf("%w for encoded secret key, key index %d", errDecode, i)
+		}
+
+		pkBytes, errDecode := hex.DecodeString(pkString)
+		if errDecode != nil {
+			return fmt.Errorf("%w for encoded public key %s, key index %d", errDecode, pkString, i)
+		}
+
+		privateKeys = append(privateKeys, skBytes)
+		publicKeys = append(publicKeys, pkBytes)
+	}
+
+	numAdded, numRemoved, err := watcher.managedKeysSyncer.SyncManagedPrivateKeys(privateKeys, publicKeys)
+	if err != nil {
+		return err
+	}
+
+	log.Info("managed keys updated from file", "file", watcher.filePath,
+		"num keys in file", len(privateKeys), "num added", numAdded, "num removed", numRemoved)
+
+	return nil
+}
+
+// Close stops watching the file
+func (watcher *keysFileWatcher) Close() error {
+	watcher.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (watcher *keysFileWatcher) IsInterfaceNil() bool {
+	return watcher == nil
+}
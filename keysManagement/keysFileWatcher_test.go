@@ -0,0 +1,197 @@
+package keysManagement_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/factory/mock"
+	"github.com/multiversx/mx-chain-go/keysManagement"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsKeysFileWatcher(filePath string) keysManagement.ArgsKeysFileWatcher {
+	return keysManagement.ArgsKeysFileWatcher{
+		FilePath:          filePath,
+		KeysLoader:        &mock.KeyLoaderStub{},
+		ManagedKeysSyncer: &testscommon.ManagedKeysUpdaterStub{},
+		// large polling interval so the tests drive the file checks
+		PollingInterval: time.Hour,
+	}
+}
+
+func writeKeysFile(tb testing.TB, filePath string, content string) {
+	err := os.WriteFile(filePath, []byte(content), os.ModePerm)
+	require.Nil(tb, err)
+}
+
+func TestNewKeysFileWatcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		watcher, err := keysManagement.NewKeysFileWatcher(createMockArgsKeysFileWatcher(""))
+		assert.Equal(t, keysManagement.ErrEmptyFilePath, err)
+		assert.True(t, check.IfNil(watcher))
+	})
+	t.Run("nil keys loader should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsKeysFileWatcher("allValidatorsKeys.pem")
+		args.KeysLoader = nil
+		watcher, err := keysManagement.NewKeysFileWatcher(args)
+		assert.Equal(t, keysManagement.ErrNilKeysLoader, err)
+		assert.True(t, check.IfNil(watcher))
+	})
+	t.Run("nil managed keys syncer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsKeysFileWatcher("allValidatorsKeys.pem")
+		args.ManagedKeysSyncer = nil
+		watcher, err := keysManagement.NewKeysFileWatcher(args)
+		assert.Equal(t, keysManagement.ErrNilManagedKeysUpdater, err)
+		assert.True(t, check.IfNil(watcher))
+	})
+	t.Run("invalid polling interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsKeysFileWatcher("allValidatorsKeys.pem")
+		args.PollingInterval = time.Millisecond
+		watcher, err := keysManagement.NewKeysFileWatcher(args)
+		assert.ErrorIs(t, err, keysManagement.ErrInvalidValue)
+		assert.True(t, check.IfNil(watcher))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		watcher, err := keysManagement.NewKeysFileWatcher(createMockArgsKeysFileWatcher("allValidatorsKeys.pem"))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(watcher))
+		assert.Nil(t, watcher.Close())
+	})
+}
+
+func TestKeysFileWatcher_CheckFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unchanged file should not sync", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "allValidatorsKeys.pem")
+		writeKeysFile(t, filePath, "keys")
+
+		args := createMockArgsKeysFileWatcher(filePath)
+		args.ManagedKeysSyncer = &testscommon.ManagedKeysUpdaterStub{
+			SyncManagedPrivateKeysCalled: func(privateKeys [][]byte, publicKeys [][]byte) (int, int, error) {
+				assert.Fail(t, "should have not been called")
+				return 0, 0, nil
+			},
+		}
+		watcher, _ := keysManagement.NewKeysFileWatcher(args)
+		defer func() {
+			_ = watcher.Close()
+		}()
+
+		watcher.CheckFile()
+	})
+	t.Run("missing file should not sync", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "allValidatorsKeys.pem")
+		writeKeysFile(t, filePath, "keys")
+
+		args := createMockArgsKeysFileWatcher(filePath)
+		args.ManagedKeysSyncer = &testscommon.ManagedKeysUpdaterStub{
+			SyncManagedPrivateKeysCalled: func(privateKeys [][]byte, publicKeys [][]byte) (int, int, error) {
+				assert.Fail(t, "should have not been called")
+				return 0, 0, nil
+			},
+		}
+		watcher, _ := keysManagement.NewKeysFileWatcher(args)
+		defer func() {
+			_ = watcher.Close()
+		}()
+
+		err := os.Remove(filePath)
+		require.Nil(t, err)
+
+		watcher.CheckFile()
+	})
+	t.Run("invalid file should not sync and should not be processed again", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "allValidatorsKeys.pem")
+		writeKeysFile(t, filePath, "keys")
+
+		numLoads := 0
+		args := createMockArgsKeysFileWatcher(filePath)
+		args.KeysLoader = &mock.KeyLoaderStub{
+			LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				numLoads++
+				return nil, nil, errors.New("expected error")
+			},
+		}
+		args.ManagedKeysSyncer = &testscommon.ManagedKeysUpdaterStub{
+			SyncManagedPrivateKeysCalled: func(privateKeys [][]byte, publicKeys [][]byte) (int, int, error) {
+				assert.Fail(t, "should have not been called")
+				return 0, 0, nil
+			},
+		}
+		watcher, _ := keysManagement.NewKeysFileWatcher(args)
+		defer func() {
+			_ = watcher.Close()
+		}()
+
+		writeKeysFile(t, filePath, "invalid keys")
+		watcher.CheckFile()
+		watcher.CheckFile()
+		assert.Equal(t, 1, numLoads)
+	})
+	t.Run("changed file should sync the keys", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "allValidatorsKeys.pem")
+		writeKeysFile(t, filePath, "keys")
+
+		var syncedPrivateKeys, syncedPublicKeys [][]byte
+		args := createMockArgsKeysFileWatcher(filePath)
+		args.KeysLoader = &mock.KeyLoaderStub{
+			LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				assert.Equal(t, filePath, path)
+				encodedPrivateKeys := [][]byte{
+					[]byte(hex.EncodeToString(skBytes0)),
+					[]byte(hex.EncodeToString(skBytes1)),
+				}
+				encodedPublicKeys := []string{
+					hex.EncodeToString(pkBytes0),
+					hex.EncodeToString(pkBytes1),
+				}
+
+				return encodedPrivateKeys, encodedPublicKeys, nil
+			},
+		}
+		args.ManagedKeysSyncer = &testscommon.ManagedKeysUpdaterStub{
+			SyncManagedPrivateKeysCalled: func(privateKeys [][]byte, publicKeys [][]byte) (int, int, error) {
+				syncedPrivateKeys = privateKeys
+				syncedPublicKeys = publicKeys
+				return 1, 0, nil
+			},
+		}
+		watcher, _ := keysManagement.NewKeysFileWatcher(args)
+		defer func() {
+			_ = watcher.Close()
+		}()
+
+		writeKeysFile(t, filePath, "new keys")
+		watcher.CheckFile()
+		assert.Equal(t, [][]byte{skBytes0, skBytes1}, syncedPrivateKeys)
+		assert.Equal(t, [][]byte{pkBytes0, pkBytes1}, syncedPublicKeys)
+	})
+}
@@ -0,0 +1,88 @@
+package keysManagement
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
+)
+
+// ArgsLocalKeysSigner is the argument DTO struct for the NewLocalKeysSigner constructor function
+type ArgsLocalKeysSigner struct {
+	PrivateKeysProvider  PrivateKeysProvider
+	SingleSigner         crypto.SingleSigner
+	MultiSignerContainer cryptoCommon.MultiSignerContainer
+}
+
+// localKeysSigner is able to sign messages with the private keys held in the memory of the current process
+type localKeysSigner struct {
+	privateKeysProvider  PrivateKeysProvider
+	singleSigner         crypto.SingleSigner
+	multiSignerContainer cryptoCommon.MultiSignerContainer
+}
+
+// NewLocalKeysSigner will create a new instance of type localKeysSigner
+func NewLocalKeysSigner(args ArgsLocalKeysSigner) (*localKeysSigner, error) {
+	if check.IfNil(args.PrivateKeysProvider) {
+		return nil, ErrNilPrivateKeysProvider
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+	if check.IfNil(args.MultiSignerContainer) {
+		return nil, ErrNilMultiSignerContainer
+	}
+
+	return &localKeysSigner{
+		privateKeysProvider:  args.PrivateKeysProvider,
+		singleSigner:         args.SingleSigner,
+		multiSignerContainer: args.MultiSignerContainer,
+	}, nil
+}
+
+// Sign will sign the provided message with the private key associated with the provided public key
+func (signer *localKeysSigner) Sign(publicKey []byte, message []byte) ([]byte, error) {
+	privateKey, err := signer.getPrivateKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return signer.singleSigner.Sign(privateKey, message)
+}
+
+// CreateSignatureShare will create a signature share over the provided message with the private key associated with
+// the provided public key, using the multi-signer active in the provided epoch
+func (signer *localKeysSigner) CreateSignatureShare(publicKey []byte, message []byte, epoch uint32) ([]byte, error) {
+	privateKey, err := signer.getPrivateKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyBytes, err := privateKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	multiSigner, err := signer.multiSignerContainer.GetMultiSigner(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return multiSigner.CreateSignatureShare(privateKeyBytes, message)
+}
+
+func (signer *localKeysSigner) getPrivateKey(publicKey []byte) (crypto.PrivateKey, error) {
+	privateKey := signer.privateKeysProvider.GetHandledPrivateKey(publicKey)
+	if check.IfNil(privateKey) {
+		return nil, fmt.Errorf("%w for public key %s", ErrNilPrivateKey, hex.EncodeToString(publicKey))
+	}
+
+	return privateKey, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (signer *localKeysSigner) IsInterfaceNil() bool {
+	return signer == nil
+}
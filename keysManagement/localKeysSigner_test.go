@@ -0,0 +1,183 @@
+package keysManagement_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/keysManagement"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsLocalKeysSigner() keysManagement.ArgsLocalKeysSigner {
+	return keysManagement.ArgsLocalKeysSigner{
+		PrivateKeysProvider: &testscommon.KeysHandlerStub{
+			GetHandledPrivateKeyCalled: func(pkBytes []byte) crypto.PrivateKey {
+				return &cryptoMocks.PrivateKeyStub{
+					ToByteArrayStub: func() ([]byte, error) {
+						return testPrivateKeyBytes, nil
+					},
+				}
+			},
+		},
+		SingleSigner:         &cryptoMocks.SingleSignerStub{},
+		MultiSignerContainer: &cryptoMocks.MultiSignerContainerStub{},
+	}
+}
+
+func TestNewLocalKeysSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil private keys provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalKeysSigner()
+		args.PrivateKeysProvider = nil
+		signer, err := keysManagement.NewLocalKeysSigner(args)
+
+		assert.True(t, check.IfNil(signer))
+		assert.Equal(t, keysManagement.ErrNilPrivateKeysProvider, err)
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalKeysSigner()
+		args.SingleSigner = nil
+		signer, err := keysManagement.NewLocalKeysSigner(args)
+
+		assert.True(t, check.IfNil(signer))
+		assert.Equal(t, keysManagement.ErrNilSingleSigner, err)
+	})
+	t.Run("nil multi-signer container should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalKeysSigner()
+		args.MultiSignerContainer = nil
+		signer, err := keysManagement.NewLocalKeysSigner(args)
+
+		assert.True(t, check.IfNil(signer))
+		assert.Equal(t, keysManagement.ErrNilMultiSignerContainer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalKeysSigner()
+		signer, err := keysManagement.NewLocalKeysSigner(args)
+
+		assert.False(t, check.IfNil(signer))
+		assert.Nil(t, err)
+	})
+}
+
+func TestLocalKeysSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalKeysSigner()
+		args.PrivateKeysProvider = &testscommon.KeysHandlerStub{
+			GetHandledPrivateKeyCalled: func(pkBytes []byte) crypto.PrivateKey {
+				return nil
+			},
+		}
+		args.SingleSigner = &cryptoMocks.SingleSignerStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				assert.Fail(t, "should have not called Sign")
+				return nil, nil
+			},
+		}
+		signer, _ := keysManagement.NewLocalKeysSigner(args)
+
+		sig, err := signer.Sign(testPublicKeyBytes, []byte("message"))
+		assert.Nil(t, sig)
+		assert.ErrorIs(t, err, keysManagement.ErrNilPrivateKey)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedMessage := []byte("message")
+		expectedSig := []byte("signature")
+		args := createMockArgsLocalKeysSigner()
+		args.SingleSigner = &cryptoMocks.SingleSignerStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				skBytes, _ := private.ToByteArray()
+				assert.Equal(t, testPrivateKeyBytes, skBytes)
+				assert.Equal(t, providedMessage, msg)
+
+				return expectedSig, nil
+			},
+		}
+		signer, _ := keysManagement.NewLocalKeysSigner(args)
+
+		sig, err := signer.Sign(testPublicKeyBytes, providedMessage)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedSig, sig)
+	})
+}
+
+func TestLocalKeysSigner_CreateSignatureShare(t *testing.T) {
+	t.Parallel()
+
+	providedMessage := []byte("message")
+	providedEpoch := uint32(37)
+	t.Run("missing private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLocalKeysSigner()
+		args.PrivateKeysProvider = &testscommon.KeysHandlerStub{
+			GetHandledPrivateKeyCalled: func(pkBytes []byte) crypto.PrivateKey {
+				return nil
+			},
+		}
+		signer, _ := keysManagement.NewLocalKeysSigner(args)
+
+		sigShare, err := signer.CreateSignatureShare(testPublicKeyBytes, providedMessage, providedEpoch)
+		assert.Nil(t, sigShare)
+		assert.ErrorIs(t, err, keysManagement.ErrNilPrivateKey)
+	})
+	t.Run("get multi-signer errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsLocalKeysSigner()
+		args.MultiSignerContainer = &cryptoMocks.MultiSignerContainerStub{
+			GetMultiSignerCalled: func(epoch uint32) (crypto.MultiSigner, error) {
+				return nil, expectedErr
+			},
+		}
+		signer, _ := keysManagement.NewLocalKeysSigner(args)
+
+		sigShare, err := signer.CreateSignatureShare(testPublicKeyBytes, providedMessage, providedEpoch)
+		assert.Nil(t, sigShare)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSigShare := []byte("signature share")
+		args := createMockArgsLocalKeysSigner()
+		args.MultiSignerContainer = &cryptoMocks.MultiSignerContainerStub{
+			GetMultiSignerCalled: func(epoch uint32) (crypto.MultiSigner, error) {
+				assert.Equal(t, providedEpoch, epoch)
+
+				return &cryptoMocks.MultiSignerStub{
+					CreateSignatureShareCalled: func(privateKeyBytes []byte, message []byte) ([]byte, error) {
+						assert.Equal(t, testPrivateKeyBytes, privateKeyBytes)
+						assert.Equal(t, providedMessage, message)
+
+						return expectedSigShare, nil
+					},
+				}, nil
+			},
+		}
+		signer, _ := keysManagement.NewLocalKeysSigner(args)
+
+		sigShare, err := signer.CreateSignatureShare(testPublicKeyBytes, providedMessage, providedEpoch)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedSigShare, sigShare)
+	})
+}
@@ -0,0 +1,176 @@
+package keysManagement
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// ArgsManagedKeysUpdater is the DTO used to create a new instance of managedKeysUpdater
+type ArgsManagedKeysUpdater struct {
+	ManagedPeersHolder common.ManagedPeersHolder
+	KeyGenerator       crypto.KeyGenerator
+	UsesRemoteSigner   bool
+}
+
+// managedKeysUpdater adds or removes managed keys while the node is running. All the components using the managed
+// keys (the keys handler, the heartbeat and peer authentication senders and the consensus state) query the managed
+// peers holder on each use, so the changes are picked up without a restart
+type managedKeysUpdater struct {
+	mut                sync.Mutex
+	managedPeersHolder common.ManagedPeersHolder
+	keyGenerator       crypto.KeyGenerator
+	usesRemoteSigner   bool
+	isMultiKeyNode     bool
+}
+
+// NewManagedKeysUpdater returns a new instance of managedKeysUpdater
+func NewManagedKeysUpdater(args ArgsManagedKeysUpdater) (*managedKeysUpdater, error) {
+	if check.IfNil(args.ManagedPeersHolder) {
+		return nil, ErrNilManagedPeersHolder
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return nil, ErrNilKeyGenerator
+	}
+
+	return &managedKeysUpdater{
+		managedPeersHolder: args.ManagedPeersHolder,
+		keyGenerator:       args.KeyGenerator,
+		usesRemoteSigner:   args.UsesRemoteSigner,
+		// the heartbeat and peer authentication senders are chosen at startup, so keys can be added at runtime only
+		// if the node was started in multikey mode
+		isMultiKeyNode: args.ManagedPeersHolder.IsMultiKeyMode(),
+	}, nil
+}
+
+// AddManagedKey adds a new managed key, returning its public key bytes. The provided key bytes are the private key
+// bytes, or the public key bytes if the node uses a remote signer
+func (updater *managedKeysUpdater) AddManagedKey(keyBytes []byte) ([]byte, error) {
+	if !updater.isMultiKeyNode {
+		return nil, ErrNodeNotInMultiKeyMode
+	}
+
+	updater.mut.Lock()
+	defer updater.mut.Unlock()
+
+	if updater.usesRemoteSigner {
+		err := updater.managedPeersHolder.AddManagedPublicKey(keyBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Info("added managed public key", "public key", hex.EncodeToString(keyBytes))
+
+		return keyBytes, nil
+	}
+
+	pkBytes, err := updater.getPublicKeyBytes(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	err = updater.managedPeersHolder.AddManagedPeer(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("added managed key", "public key", hex.EncodeToString(pkBytes))
+
+	return pkBytes, nil
+}
+
+// RemoveManagedKey removes the provided managed public key
+func (updater *managedKeysUpdater) RemoveManagedKey(pkBytes []byte) error {
+	updater.mut.Lock()
+	defer updater.mut.Unlock()
+
+	err := updater.managedPeersHolder.RemoveManagedPeer(pkBytes)
+	if err != nil {
+		return err
+	}
+
+	log.Info("removed managed key", "public key", hex.EncodeToString(pkBytes))
+
+	return nil
+}
+
+// SyncManagedPrivateKeys replaces the managed keys with the provided key pairs: the missing keys are added and the
+// managed keys not provided are removed. Nothing is changed if a provided key pair is invalid.
+// It returns the number of added and removed keys
+func (updater *managedKeysUpdater) SyncManagedPrivateKeys(privateKeys [][]byte, publicKeys [][]byte) (int, int, error) {
+	if !updater.isMultiKeyNode {
+		return 0, 0, ErrNodeNotInMultiKeyMode
+	}
+	if updater.usesRemoteSigner {
+		return 0, 0, fmt.Errorf("%w, private keys can not be used along with a remote signer", ErrInvalidValue)
+	}
+	if len(privateKeys) != len(publicKeys) {
+		return 0, 0, fmt.Errorf("%w, mismatch number of private and public keys", ErrInvalidValue)
+	}
+
+	providedKeys := make(map[string][]byte, len(privateKeys))
+	for i, skBytes := range privateKeys {
+		pkBytes, err := updater.getPublicKeyBytes(skBytes)
+		if err != nil {
+			return 0, 0, fmt.Errorf("%w, key index %d", err, i)
+		}
+		if !bytes.Equal(pkBytes, publicKeys[i]) {
+			return 0, 0, fmt.Errorf("%w, public keys mismatch, read %s, generated %s, key index %d",
+				ErrInvalidKey, hex.EncodeToString(publicKeys[i]), hex.EncodeToString(pkBytes), i)
+		}
+
+		providedKeys[string(pkBytes)] = skBytes
+	}
+
+	updater.mut.Lock()
+	defer updater.mut.Unlock()
+
+	numRemoved := 0
+	for _, pkBytes := range updater.managedPeersHolder.GetLoadedKeysByCurrentNode() {
+		_, isProvided := providedKeys[string(pkBytes)]
+		if isProvided {
+			delete(providedKeys, string(pkBytes))
+			continue
+		}
+
+		err := updater.managedPeersHolder.RemoveManagedPeer(pkBytes)
+		if err != nil {
+			return 0, numRemoved, err
+		}
+
+		log.Info("removed managed key", "public key", hex.EncodeToString(pkBytes))
+		numRemoved++
+	}
+
+	numAdded := 0
+	for pk, skBytes := range providedKeys {
+		err := updater.managedPeersHolder.AddManagedPeer(skBytes)
+		if err != nil {
+			return numAdded, numRemoved, err
+		}
+
+		log.Info("added managed key", "public key", hex.EncodeToString([]byte(pk)))
+		numAdded++
+	}
+
+	return numAdded, numRemoved, nil
+}
+
+func (updater *managedKeysUpdater) getPublicKeyBytes(skBytes []byte) ([]byte, error) {
+	privateKey, err := updater.keyGenerator.PrivateKeyFromByteArray(skBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the private key", err)
+	}
+
+	return privateKey.GeneratePublic().ToByteArray()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (updater *managedKeysUpdater) IsInterfaceNil() bool {
+	return updater == nil
+}
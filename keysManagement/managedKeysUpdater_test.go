@@ -0,0 +1,253 @@
+package keysManagement_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/keysManagement"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsManagedKeysUpdater() keysManagement.ArgsManagedKeysUpdater {
+	return keysManagement.ArgsManagedKeysUpdater{
+		ManagedPeersHolder: &testscommon.ManagedPeersHolderStub{
+			IsMultiKeyModeCalled: func() bool {
+				return true
+			},
+		},
+		KeyGenerator:     createMockKeyGenerator(),
+		UsesRemoteSigner: false,
+	}
+}
+
+func TestNewManagedKeysUpdater(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil managed peers holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysUpdater()
+		args.ManagedPeersHolder = nil
+		updater, err := keysManagement.NewManagedKeysUpdater(args)
+		assert.Equal(t, keysManagement.ErrNilManagedPeersHolder, err)
+		assert.True(t, check.IfNil(updater))
+	})
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysUpdater()
+		args.KeyGenerator = nil
+		updater, err := keysManagement.NewManagedKeysUpdater(args)
+		assert.Equal(t, keysManagement.ErrNilKeyGenerator, err)
+		assert.True(t, check.IfNil(updater))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		updater, err := keysManagement.NewManagedKeysUpdater(createMockArgsManagedKeysUpdater())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(updater))
+	})
+}
+
+func TestManagedKeysUpdater_AddManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("node not in multikey mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysUpdater()
+		args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+			AddManagedPeerCalled: func(privateKeyBytes []byte) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		updater, _ := keysManagement.NewManagedKeysUpdater(args)
+
+		pkBytes, err := updater.AddManagedKey(skBytes0)
+		assert.Equal(t, keysManagement.ErrNodeNotInMultiKeyMode, err)
+		assert.Nil(t, pkBytes)
+	})
+	t.Run("holder error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsManagedKeysUpdater()
+		args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+			IsMultiKeyModeCalled: func() bool {
+				return true
+			},
+			AddManagedPeerCalled: func(privateKeyBytes []byte) error {
+				return expectedErr
+			},
+		}
+		updater, _ := keysManagement.NewManagedKeysUpdater(args)
+
+		pkBytes, err := updater.AddManagedKey(skBytes0)
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, pkBytes)
+	})
+	t.Run("should add the private key", func(t *testing.T) {
+		t.Parallel()
+
+		var addedKey []byte
+		args := createMockArgsManagedKeysUpdater()
+		args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+			IsMultiKeyModeCalled: func() bool {
+				return true
+			},
+			AddManagedPeerCalled: func(privateKeyBytes []byte) error {
+				addedKey = privateKeyBytes
+				return nil
+			},
+			AddManagedPublicKeyCalled: func(publicKeyBytes []byte) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		updater, _ := keysManagement.NewManagedKeysUpdater(args)
+
+		pkBytes, err := updater.AddManagedKey(skBytes0)
+		assert.Nil(t, err)
+		assert.Equal(t, pkBytes0, pkBytes)
+		assert.Equal(t, skBytes0, addedKey)
+	})
+	t.Run("should add the public key when using a remote signer", func(t *testing.T) {
+		t.Parallel()
+
+		var addedKey []byte
+		args := createMockArgsManagedKeysUpdater()
+		args.UsesRemoteSigner = true
+		args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+			IsMultiKeyModeCalled: func() bool {
+				return true
+			},
+			AddManagedPeerCalled: func(privateKeyBytes []byte) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+			AddManagedPublicKeyCalled: func(publicKeyBytes []byte) error {
+				addedKey = publicKeyBytes
+				return nil
+			},
+		}
+		updater, _ := keysManagement.NewManagedKeysUpdater(args)
+
+		pkBytes, err := updater.AddManagedKey(pkBytes0)
+		assert.Nil(t, err)
+		assert.Equal(t, pkBytes0, pkBytes)
+		assert.Equal(t, pkBytes0, addedKey)
+	})
+}
+
+func TestManagedKeysUpdater_RemoveManagedKey(t *testing.T) {
+	t.Parallel()
+
+	var removedKey []byte
+	args := createMockArgsManagedKeysUpdater()
+	args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+		RemoveManagedPeerCalled: func(pkBytes []byte) error {
+			removedKey = pkBytes
+			return nil
+		},
+	}
+	updater, _ := keysManagement.NewManagedKeysUpdater(args)
+
+	err := updater.RemoveManagedKey(pkBytes0)
+	assert.Nil(t, err)
+	assert.Equal(t, pkBytes0, removedKey)
+}
+
+func TestManagedKeysUpdater_SyncManagedPrivateKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("node not in multikey mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysUpdater()
+		args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{}
+		updater, _ := keysManagement.NewManagedKeysUpdater(args)
+
+		_, _, err := updater.SyncManagedPrivateKeys([][]byte{skBytes0}, [][]byte{pkBytes0})
+		assert.Equal(t, keysManagement.ErrNodeNotInMultiKeyMode, err)
+	})
+	t.Run("remote signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysUpdater()
+		args.UsesRemoteSigner = true
+		updater, _ := keysManagement.NewManagedKeysUpdater(args)
+
+		_, _, err := updater.SyncManagedPrivateKeys([][]byte{skBytes0}, [][]byte{pkBytes0})
+		assert.ErrorIs(t, err, keysManagement.ErrInvalidValue)
+	})
+	t.Run("mismatch number of keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		updater, _ := keysManagement.NewManagedKeysUpdater(createMockArgsManagedKeysUpdater())
+
+		_, _, err := updater.SyncManagedPrivateKeys([][]byte{skBytes0}, [][]byte{pkBytes0, pkBytes1})
+		assert.ErrorIs(t, err, keysManagement.ErrInvalidValue)
+	})
+	t.Run("mismatch public key should not change the managed keys", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysUpdater()
+		args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+			IsMultiKeyModeCalled: func() bool {
+				return true
+			},
+			GetLoadedKeysByCurrentNodeCalled: func() [][]byte {
+				return [][]byte{pkBytes1}
+			},
+			AddManagedPeerCalled: func(privateKeyBytes []byte) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+			RemoveManagedPeerCalled: func(pkBytes []byte) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		updater, _ := keysManagement.NewManagedKeysUpdater(args)
+
+		_, _, err := updater.SyncManagedPrivateKeys([][]byte{skBytes0}, [][]byte{pkBytes1})
+		assert.ErrorIs(t, err, keysManagement.ErrInvalidKey)
+	})
+	t.Run("should add the missing keys and remove the extra ones", func(t *testing.T) {
+		t.Parallel()
+
+		skBytes2 := []byte("private key 2")
+		pkBytes2 := []byte("public key 2")
+		addedKeys := make([][]byte, 0)
+		removedKeys := make([][]byte, 0)
+		args := createMockArgsManagedKeysUpdater()
+		args.ManagedPeersHolder = &testscommon.ManagedPeersHolderStub{
+			IsMultiKeyModeCalled: func() bool {
+				return true
+			},
+			GetLoadedKeysByCurrentNodeCalled: func() [][]byte {
+				return [][]byte{pkBytes0, pkBytes1}
+			},
+			AddManagedPeerCalled: func(privateKeyBytes []byte) error {
+				addedKeys = append(addedKeys, privateKeyBytes)
+				return nil
+			},
+			RemoveManagedPeerCalled: func(pkBytes []byte) error {
+				removedKeys = append(removedKeys, pkBytes)
+				return nil
+			},
+		}
+		updater, _ := keysManagement.NewManagedKeysUpdater(args)
+
+		numAdded, numRemoved, err := updater.SyncManagedPrivateKeys([][]byte{skBytes1, skBytes2}, [][]byte{pkBytes1, pkBytes2})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numAdded)
+		assert.Equal(t, 1, numRemoved)
+		assert.Equal(t, [][]byte{skBytes2}, addedKeys)
+		assert.Equal(t, [][]byte{pkBytes0}, removedKeys)
+	})
+}
@@ -12,9 +12,10 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/p2p"
-	"github.com/multiversx/mx-chain-go/redundancy/common"
+	redundancyCommon "github.com/multiversx/mx-chain-go/redundancy/common"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
@@ -38,15 +39,17 @@ type managedPeersHolder struct {
 	defaultName                 string
 	defaultIdentity             string
 	p2pKeyConverter             p2p.P2PKeyConverter
+	redundancyLeaseHandler      common.RedundancyLeaseHandler
 }
 
 // ArgsManagedPeersHolder represents the argument for the managed peers holder
 type ArgsManagedPeersHolder struct {
-	KeyGenerator          crypto.KeyGenerator
-	P2PKeyGenerator       crypto.KeyGenerator
-	MaxRoundsOfInactivity int
-	PrefsConfig           config.Preferences
-	P2PKeyConverter       p2p.P2PKeyConverter
+	KeyGenerator           crypto.KeyGenerator
+	P2PKeyGenerator        crypto.KeyGenerator
+	MaxRoundsOfInactivity  int
+	PrefsConfig            config.Preferences
+	P2PKeyConverter        p2p.P2PKeyConverter
+	RedundancyLeaseHandler common.RedundancyLeaseHandler
 }
 
 // NewManagedPeersHolder creates a new instance of a managed peers holder
@@ -61,11 +64,12 @@ func NewManagedPeersHolder(args ArgsManagedPeersHolder) (*managedPeersHolder, er
 		pids:                        make(map[core.PeerID]struct{}),
 		keyGenerator:                args.KeyGenerator,
 		p2pKeyGenerator:             args.P2PKeyGenerator,
-		isMainMachine:               common.IsMainNode(args.MaxRoundsOfInactivity),
+		isMainMachine:               redundancyCommon.IsMainNode(args.MaxRoundsOfInactivity),
 		maxRoundsOfInactivity:       args.MaxRoundsOfInactivity,
 		defaultName:                 args.PrefsConfig.Preferences.NodeDisplayName,
 		defaultIdentity:             args.PrefsConfig.Preferences.Identity,
 		p2pKeyConverter:             args.P2PKeyConverter,
+		redundancyLeaseHandler:      args.RedundancyLeaseHandler,
 		data:                        make(map[string]*peerInfo),
 	}
 
@@ -84,13 +88,16 @@ func checkManagedPeersHolderArgs(args ArgsManagedPeersHolder) error {
 	if check.IfNil(args.P2PKeyGenerator) {
 		return fmt.Errorf("%w for args.P2PKeyGenerator", ErrNilKeyGenerator)
 	}
-	err := common.CheckMaxRoundsOfInactivity(args.MaxRoundsOfInactivity)
+	err := redundancyCommon.CheckMaxRoundsOfInactivity(args.MaxRoundsOfInactivity)
 	if err != nil {
 		return err
 	}
 	if check.IfNil(args.P2PKeyConverter) {
 		return fmt.Errorf("%w for args.P2PKeyConverter", ErrNilP2PKeyConverter)
 	}
+	if check.IfNil(args.RedundancyLeaseHandler) {
+		return ErrNilRedundancyLeaseHandler
+	}
 
 	return nil
 }
@@ -145,6 +152,23 @@ func (holder *managedPeersHolder) AddManagedPeer(privateKeyBytes []byte) error {
 		return fmt.Errorf("%w for provided bytes %s", err, hex.EncodeToString(privateKeyBytes))
 	}
 
+	return holder.addManagedPeer(publicKeyBytes, privateKey)
+}
+
+// AddManagedPublicKey will try to add a new managed peer providing only the public key bytes. The signing operations
+// for such a key are expected to be done by an external signer, as the private key is not held by the node.
+// It errors if the public key is already contained by the struct
+// It will auto-generate some fields like the machineID and pid
+func (holder *managedPeersHolder) AddManagedPublicKey(publicKeyBytes []byte) error {
+	_, err := holder.keyGenerator.PublicKeyFromByteArray(publicKeyBytes)
+	if err != nil {
+		return fmt.Errorf("%w for provided public key %s", err, hex.EncodeToString(publicKeyBytes))
+	}
+
+	return holder.addManagedPeer(publicKeyBytes, nil)
+}
+
+func (holder *managedPeersHolder) addManagedPeer(publicKeyBytes []byte, privateKey crypto.PrivateKey) error {
 	p2pPrivateKey, p2pPublicKey := holder.p2pKeyGenerator.GeneratePair()
 
 	p2pPrivateKeyBytes, err := p2pPrivateKey.ToByteArray()
@@ -162,8 +186,8 @@ func (holder *managedPeersHolder) AddManagedPeer(privateKeyBytes []byte) error {
 
 	pInfo, found := holder.data[string(publicKeyBytes)]
 	if found && len(pInfo.pid.Bytes()) != 0 {
-		return fmt.Errorf("%w for provided bytes %s and generated public key %s",
-			ErrDuplicatedKey, hex.EncodeToString(privateKeyBytes), hex.EncodeToString(publicKeyBytes))
+		return fmt.Errorf("%w for public key %s",
+			ErrDuplicatedKey, hex.EncodeToString(publicKeyBytes))
 	}
 
 	pInfo, found = holder.providedIdentities[string(publicKeyBytes)]
@@ -176,7 +200,7 @@ func (holder *managedPeersHolder) AddManagedPeer(privateKeyBytes []byte) error {
 		holder.defaultPeerInfoCurrentIndex++
 	}
 
-	pInfo.handler = common.NewRedundancyHandler()
+	pInfo.handler = redundancyCommon.NewRedundancyHandler()
 	pInfo.pid = pid
 	pInfo.p2pPrivateKeyBytes = p2pPrivateKeyBytes
 	pInfo.privateKey = privateKey
@@ -188,7 +212,42 @@ func (holder *managedPeersHolder) AddManagedPeer(privateKeyBytes []byte) error {
 		"pid", pid.Pretty(),
 		"machine ID", pInfo.machineID,
 		"name", pInfo.nodeName,
-		"identity", pInfo.nodeIdentity)
+		"identity", pInfo.nodeIdentity,
+		"has private key", !check.IfNil(privateKey))
+
+	return nil
+}
+
+// RemoveManagedPeer removes the provided public key from the managed keys, along with its p2p identity.
+// If the key is added again, a new p2p identity will be generated for it
+// It errors if the public key is not contained by the struct
+func (holder *managedPeersHolder) RemoveManagedPeer(pkBytes []byte) error {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	pInfo, found := holder.data[string(pkBytes)]
+	if !found {
+		return fmt.Errorf("%w in RemoveManagedPeer for public key %s",
+			ErrMissingPublicKeyDefinition, hex.EncodeToString(pkBytes))
+	}
+
+	delete(holder.data, string(pkBytes))
+	delete(holder.pids, pInfo.pid)
+
+	_, isProvidedIdentity := holder.providedIdentities[string(pkBytes)]
+	if isProvidedIdentity {
+		// keep the provided name and identity, but drop the runtime data in case the key is added again
+		holder.providedIdentities[string(pkBytes)] = &peerInfo{
+			machineID:    pInfo.machineID,
+			nodeName:     pInfo.nodeName,
+			nodeIdentity: pInfo.nodeIdentity,
+		}
+	}
+
+	log.Debug("removed key definition",
+		"hex public key", hex.EncodeToString(pkBytes),
+		"pid", pInfo.pid.Pretty(),
+		"name", pInfo.nodeName)
 
 	return nil
 }
@@ -289,7 +348,7 @@ func (holder *managedPeersHolder) GetManagedKeysByCurrentNode() map[string]crypt
 
 	allManagedKeys := make(map[string]crypto.PrivateKey)
 	for pk, pInfo := range holder.data {
-		shouldAddToMap := pInfo.shouldActAsValidator(holder.maxRoundsOfInactivity)
+		shouldAddToMap := holder.shouldActAsValidator(pInfo)
 		if !shouldAddToMap {
 			continue
 		}
@@ -324,6 +383,16 @@ func (holder *managedPeersHolder) IsKeyManagedByCurrentNode(pkBytes []byte) bool
 		return false
 	}
 
+	return holder.shouldActAsValidator(pInfo)
+}
+
+// shouldActAsValidator returns true if the key should be used for signing. When the redundant instances are coordinated
+// through a lease, all the keys are managed only by the lease holder
+func (holder *managedPeersHolder) shouldActAsValidator(pInfo *peerInfo) bool {
+	if holder.redundancyLeaseHandler.IsEnabled() {
+		return holder.redundancyLeaseHandler.IsLeaseHolder()
+	}
+
 	return pInfo.shouldActAsValidator(holder.maxRoundsOfInactivity)
 }
 
@@ -394,9 +463,10 @@ func (holder *managedPeersHolder) IsMultiKeyMode() bool {
 
 // GetRedundancyStepInReason returns the reason if the current node stepped in as a redundancy node
 // Returns empty string if the current node is the main multikey machine, the machine is not running in multikey mode
-// or the machine is acting as a backup but the main machine is acting accordingly
+// or the machine is acting as a backup but the main machine is acting accordingly. The reason is not reported when the
+// redundant instances are coordinated through a lease, the lease state being reported instead
 func (holder *managedPeersHolder) GetRedundancyStepInReason() string {
-	if holder.isMainMachine {
+	if holder.isMainMachine || holder.redundancyLeaseHandler.IsEnabled() {
 		return ""
 	}
 
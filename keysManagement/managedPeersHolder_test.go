@@ -18,6 +18,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/keysManagement"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
 	"github.com/stretchr/testify/assert"
@@ -67,6 +68,7 @@ func createMockArgsManagedPeersHolder() keysManagement.ArgsManagedPeersHolder {
 				return pid, nil
 			},
 		},
+		RedundancyLeaseHandler: &testscommon.RedundancyLeaseHandlerStub{},
 	}
 }
 
@@ -127,6 +129,16 @@ func TestNewManagedPeersHolder(t *testing.T) {
 		assert.Contains(t, err.Error(), "for args.P2PKeyGenerator")
 		assert.True(t, check.IfNil(holder))
 	})
+	t.Run("nil redundancy lease handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedPeersHolder()
+		args.RedundancyLeaseHandler = nil
+		holder, err := keysManagement.NewManagedPeersHolder(args)
+
+		assert.Equal(t, keysManagement.ErrNilRedundancyLeaseHandler, err)
+		assert.True(t, check.IfNil(holder))
+	})
 	t.Run("invalid MaxRoundsWithoutReceivedMessages should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -351,6 +363,113 @@ func TestManagedPeersHolder_AddManagedPeer(t *testing.T) {
 	})
 }
 
+func TestManagedPeersHolder_AddManagedPublicKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsManagedPeersHolder()
+		args.KeyGenerator = &cryptoMocks.KeyGenStub{
+			PublicKeyFromByteArrayStub: func(b []byte) (crypto.PublicKey, error) {
+				return nil, expectedErr
+			},
+		}
+
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		err := holder.AddManagedPublicKey(pkBytes0)
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, holder.GetPeerInfo(pkBytes0))
+	})
+	t.Run("should error when trying to add the same pk", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedPeersHolder()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		err := holder.AddManagedPeer(skBytes0)
+		assert.Nil(t, err)
+
+		err = holder.AddManagedPublicKey(pkBytes0)
+		assert.ErrorIs(t, err, keysManagement.ErrDuplicatedKey)
+	})
+	t.Run("should work without holding the private key", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedPeersHolder()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		err := holder.AddManagedPublicKey(pkBytes0)
+		assert.Nil(t, err)
+
+		pInfo := holder.GetPeerInfo(pkBytes0)
+		assert.NotNil(t, pInfo)
+		assert.Equal(t, pid, pInfo.Pid())
+		assert.Equal(t, p2pPrivateKey, pInfo.P2pPrivateKeyBytes())
+		assert.Nil(t, pInfo.PrivateKey())
+		assert.Equal(t, defaultName+"-00", pInfo.NodeName())
+		assert.True(t, holder.IsKeyRegistered(pkBytes0))
+		assert.True(t, holder.IsMultiKeyMode())
+	})
+}
+
+func TestManagedPeersHolder_RemoveManagedPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing key should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		err := holder.RemoveManagedPeer(pkBytes0)
+		assert.ErrorIs(t, err, keysManagement.ErrMissingPublicKeyDefinition)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+		_ = holder.AddManagedPeer(skBytes0)
+		_ = holder.AddManagedPeer(skBytes1)
+
+		err := holder.RemoveManagedPeer(pkBytes0)
+		assert.Nil(t, err)
+		assert.Nil(t, holder.GetPeerInfo(pkBytes0))
+		assert.False(t, holder.IsKeyRegistered(pkBytes0))
+		assert.False(t, holder.IsKeyManagedByCurrentNode(pkBytes0))
+		assert.Equal(t, [][]byte{pkBytes1}, holder.GetLoadedKeysByCurrentNode())
+
+		// the key can be added again
+		err = holder.AddManagedPeer(skBytes0)
+		assert.Nil(t, err)
+		assert.True(t, holder.IsKeyRegistered(pkBytes0))
+	})
+	t.Run("should keep the provided name and identity", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedPeersHolder()
+		args.PrefsConfig.NamedIdentity = []config.NamedIdentity{
+			{
+				Identity: "identity",
+				NodeName: "node name",
+				BLSKeys:  []string{hex.EncodeToString(pkBytes0)},
+			},
+		}
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		_ = holder.AddManagedPeer(skBytes0)
+
+		err := holder.RemoveManagedPeer(pkBytes0)
+		assert.Nil(t, err)
+
+		err = holder.AddManagedPeer(skBytes0)
+		assert.Nil(t, err)
+
+		name, identity, err := holder.GetNameAndIdentity(pkBytes0)
+		assert.Nil(t, err)
+		assert.Equal(t, "node name-00", name)
+		assert.Equal(t, "identity", identity)
+	})
+}
+
 func TestManagedPeersHolder_GetPrivateKey(t *testing.T) {
 	t.Parallel()
 
@@ -750,6 +869,34 @@ func TestManagedPeersHolder_GetManagedKeysByCurrentNode(t *testing.T) {
 			testManagedKeys(t, result, pkBytes0)
 		})
 	})
+	t.Run("redundancy lease enabled should return all keys only on the lease holder", func(t *testing.T) {
+		isLeaseHolder := false
+		args := createMockArgsManagedPeersHolder()
+		args.MaxRoundsOfInactivity = 2
+		args.RedundancyLeaseHandler = &testscommon.RedundancyLeaseHandlerStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			IsLeaseHolderCalled: func() bool {
+				return isLeaseHolder
+			},
+		}
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		_ = holder.AddManagedPeer(skBytes0)
+		_ = holder.AddManagedPeer(skBytes1)
+
+		for i := 0; i < args.MaxRoundsOfInactivity+1; i++ {
+			holder.IncrementRoundsWithoutReceivedMessages(pkBytes0)
+		}
+		result := holder.GetManagedKeysByCurrentNode()
+		testManagedKeys(t, result)
+		assert.False(t, holder.IsKeyManagedByCurrentNode(pkBytes0))
+
+		isLeaseHolder = true
+		result = holder.GetManagedKeysByCurrentNode()
+		testManagedKeys(t, result, pkBytes0, pkBytes1)
+		assert.True(t, holder.IsKeyManagedByCurrentNode(pkBytes1))
+	})
 }
 
 func TestManagedPeersHolder_GetLoadedKeysByCurrentNode(t *testing.T) {
@@ -1015,6 +1162,22 @@ func TestManagedPeersHolder_GetRedundancyStepInReason(t *testing.T) {
 		expectedReason := fmt.Sprintf(keysManagement.RedundancyReasonForMultipleKeys, 2)
 		assert.Equal(t, expectedReason, holder.GetRedundancyStepInReason())
 	})
+	t.Run("redundancy lease enabled should not report a reason", func(t *testing.T) {
+		args := createMockArgsManagedPeersHolder()
+		args.MaxRoundsOfInactivity = 2
+		args.RedundancyLeaseHandler = &testscommon.RedundancyLeaseHandlerStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			IsLeaseHolderCalled: func() bool {
+				return true
+			},
+		}
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		_ = holder.AddManagedPeer(skBytes0)
+
+		assert.Empty(t, holder.GetRedundancyStepInReason())
+	})
 }
 
 func TestManagedPeersHolder_ParallelOperationsShouldNotPanic(t *testing.T) {
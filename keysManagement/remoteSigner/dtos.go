@@ -0,0 +1,41 @@
+package remoteSigner
+
+const (
+	// SignRoute is the route used to request a single signature
+	SignRoute = "/sign"
+	// SignatureShareRoute is the route used to request a multi-signature share
+	SignatureShareRoute = "/signature-share"
+	// PublicKeysRoute is the route used to fetch all the public keys handled by the remote signer
+	PublicKeysRoute = "/public-keys"
+)
+
+// SignRequest represents the request sent to the remote signer. All byte slices are hex encoded
+type SignRequest struct {
+	PublicKey string `json:"publicKey"`
+	Message   string `json:"message"`
+	Epoch     uint32 `json:"epoch,omitempty"`
+}
+
+// SignResponse represents the response sent by the remote signer. The signature is hex encoded
+type SignResponse struct {
+	Signature string `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// PublicKeysResponse represents the response containing all the hex encoded public keys handled by the remote signer
+type PublicKeysResponse struct {
+	PublicKeys []string `json:"publicKeys"`
+	Error      string   `json:"error,omitempty"`
+}
+
+type errorResponse interface {
+	getError() string
+}
+
+func (response *SignResponse) getError() string {
+	return response.Error
+}
+
+func (response *PublicKeysResponse) getError() string {
+	return response.Error
+}
@@ -0,0 +1,30 @@
+package remoteSigner
+
+import "errors"
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrEmptyBaseUrl signals that an empty base url has been provided
+var ErrEmptyBaseUrl = errors.New("empty base url")
+
+// ErrInsecureBaseUrl signals that a base url not using https was provided for a remote signer outside the loopback interface
+var ErrInsecureBaseUrl = errors.New("the remote signer base url should use https, unless the host is a loopback address")
+
+// ErrInvalidBaseUrlScheme signals that the provided base url uses neither http nor https
+var ErrInvalidBaseUrlScheme = errors.New("invalid base url scheme")
+
+// ErrInvalidCACertificate signals that no certificate could be read from the provided CA certificate file
+var ErrInvalidCACertificate = errors.New("invalid CA certificate")
+
+// ErrNilKeysSigner signals that a nil keys signer has been provided
+var ErrNilKeysSigner = errors.New("nil keys signer")
+
+// ErrNoPublicKeys signals that no public keys have been provided
+var ErrNoPublicKeys = errors.New("no public keys")
+
+// ErrPublicKeyNotHandled signals that the provided public key is not handled by the remote signer
+var ErrPublicKeyNotHandled = errors.New("public key not handled by the remote signer")
+
+// ErrRemoteSigner signals that the remote signer responded with an error
+var ErrRemoteSigner = errors.New("remote signer error")
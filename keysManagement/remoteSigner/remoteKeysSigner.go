@@ -0,0 +1,259 @@
+package remoteSigner
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	minRequestTimeoutSec = 1
+	contentTypeKey       = "Content-Type"
+	contentTypeValue     = "application/json"
+	httpScheme           = "http"
+	httpsScheme          = "https"
+	localhost            = "localhost"
+)
+
+var log = logger.GetOrCreate("keysManagement/remoteSigner")
+
+// ArgsRemoteKeysSigner defines the arguments needed to create a new remote keys signer. The base url must use https,
+// unless the remote signer runs on the loopback interface. The CA certificate file, if provided, replaces the system
+// certificate pool when verifying the remote signer, while the client certificate is presented to the remote signer
+type ArgsRemoteKeysSigner struct {
+	BaseUrl           string
+	RequestTimeoutSec int
+	UseAuthorization  bool
+	Username          string
+	Password          string
+	CACertFile        string
+	ClientCertFile    string
+	ClientKeyFile     string
+}
+
+// remoteKeysSigner is a keys signer that delegates all the signing operations to a remote signer over HTTP, so
+// that the node only needs to hold the public keys
+type remoteKeysSigner struct {
+	httpClient       *http.Client
+	baseUrl          string
+	useAuthorization bool
+	username         string
+	password         string
+}
+
+// NewRemoteKeysSigner creates a new instance of type remoteKeysSigner
+func NewRemoteKeysSigner(args ArgsRemoteKeysSigner) (*remoteKeysSigner, error) {
+	err := checkArgsRemoteKeysSigner(args)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := createTLSConfig(args)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := &http.Client{}
+	httpClient.Timeout = time.Duration(args.RequestTimeoutSec) * time.Second
+	httpClient.Transport = &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: tlsConfig,
+	}
+
+	return &remoteKeysSigner{
+		httpClient:       httpClient,
+		baseUrl:          strings.TrimSuffix(args.BaseUrl, "/"),
+		useAuthorization: args.UseAuthorization,
+		username:         args.Username,
+		password:         args.Password,
+	}, nil
+}
+
+func checkArgsRemoteKeysSigner(args ArgsRemoteKeysSigner) error {
+	if len(args.BaseUrl) == 0 {
+		return ErrEmptyBaseUrl
+	}
+	if args.RequestTimeoutSec < minRequestTimeoutSec {
+		return fmt.Errorf("%w for RequestTimeoutSec, provided: %d, minimum: %d", ErrInvalidValue, args.RequestTimeoutSec, minRequestTimeoutSec)
+	}
+	if len(args.ClientCertFile) == 0 != (len(args.ClientKeyFile) == 0) {
+		return fmt.Errorf("%w, both the client certificate and the client key files should be provided", ErrInvalidValue)
+	}
+
+	baseUrl, err := url.Parse(args.BaseUrl)
+	if err != nil {
+		return err
+	}
+
+	switch baseUrl.Scheme {
+	case httpsScheme:
+		return nil
+	case httpScheme:
+		if isLoopbackHost(baseUrl.Hostname()) {
+			return nil
+		}
+
+		return fmt.Errorf("%w, provided: %s", ErrInsecureBaseUrl, args.BaseUrl)
+	default:
+		return fmt.Errorf("%w, provided: %s", ErrInvalidBaseUrlScheme, args.BaseUrl)
+	}
+}
+
+func isLoopbackHost(host string) bool {
+	if host == localhost {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+func createTLSConfig(args ArgsRemoteKeysSigner) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if len(args.CACertFile) > 0 {
+		caCert, err := os.ReadFile(args.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("%w in file %s", ErrInvalidCACertificate, args.CACertFile)
+		}
+	}
+
+	if len(args.ClientCertFile) > 0 {
+		clientCert, err := tls.LoadX509KeyPair(args.ClientCertFile, args.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return tlsConfig, nil
+}
+
+// Sign requests the remote signer to sign the provided message with the private key associated with the provided public key
+func (signer *remoteKeysSigner) Sign(publicKey []byte, message []byte) ([]byte, error) {
+	request := &SignRequest{
+		PublicKey: hex.EncodeToString(publicKey),
+		Message:   hex.EncodeToString(message),
+	}
+
+	return signer.requestSignature(SignRoute, request)
+}
+
+// CreateSignatureShare requests the remote signer to create a signature share over the provided message with the
+// private key associated with the provided public key, using the multi-signer active in the provided epoch
+func (signer *remoteKeysSigner) CreateSignatureShare(publicKey []byte, message []byte, epoch uint32) ([]byte, error) {
+	request := &SignRequest{
+		PublicKey: hex.EncodeToString(publicKey),
+		Message:   hex.EncodeToString(message),
+		Epoch:     epoch,
+	}
+
+	return signer.requestSignature(SignatureShareRoute, request)
+}
+
+// GetPublicKeys returns all the public keys handled by the remote signer
+func (signer *remoteKeysSigner) GetPublicKeys() ([][]byte, error) {
+	response := &PublicKeysResponse{}
+	err := signer.doRequest(http.MethodGet, PublicKeysRoute, nil, response)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeys := make([][]byte, 0, len(response.PublicKeys))
+	for _, hexPublicKey := range response.PublicKeys {
+		publicKey, errDecode := hex.DecodeString(hexPublicKey)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for public key %s", errDecode, hexPublicKey)
+		}
+
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	return publicKeys, nil
+}
+
+func (signer *remoteKeysSigner) requestSignature(route string, request *SignRequest) ([]byte, error) {
+	response := &SignResponse{}
+	err := signer.doRequest(http.MethodPost, route, request, response)
+	if err != nil {
+		return nil, fmt.Errorf("%w for public key %s", err, request.PublicKey)
+	}
+
+	return hex.DecodeString(response.Signature)
+}
+
+func (signer *remoteKeysSigner) doRequest(method string, route string, payload interface{}, response errorResponse) error {
+	var body io.Reader
+	if payload != nil {
+		jsonData, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+
+		body = bytes.NewReader(jsonData)
+	}
+
+	req, err := http.NewRequest(method, signer.baseUrl+route, body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set(contentTypeKey, contentTypeValue)
+	if signer.useAuthorization {
+		req.SetBasicAuth(signer.username, signer.password)
+	}
+
+	resp, err := signer.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		bodyCloseErr := resp.Body.Close()
+		if bodyCloseErr != nil {
+			log.Warn("error while trying to close response body", "error", bodyCloseErr.Error())
+		}
+	}()
+
+	resBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(resBody, response)
+	if resp.StatusCode != http.StatusOK {
+		remoteError := response.getError()
+		if len(remoteError) == 0 {
+			remoteError = http.StatusText(resp.StatusCode)
+		}
+
+		return fmt.Errorf("%w, HTTP status code: %d, %s", ErrRemoteSigner, resp.StatusCode, remoteError)
+	}
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (signer *remoteKeysSigner) IsInterfaceNil() bool {
+	return signer == nil
+}
@@ -0,0 +1,361 @@
+package remoteSigner
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	providedPublicKey = []byte("public key")
+	providedMessage   = []byte("message")
+)
+
+func createMockArgsRemoteKeysSigner(baseUrl string) ArgsRemoteKeysSigner {
+	return ArgsRemoteKeysSigner{
+		BaseUrl:           baseUrl,
+		RequestTimeoutSec: 1,
+	}
+}
+
+func createTestServer(tb testing.TB, keysSigner *cryptoMocks.KeysSignerStub) *httptest.Server {
+	handler, err := NewSignerHandler(ArgsSignerHandler{
+		KeysSigner: keysSigner,
+		PublicKeys: [][]byte{providedPublicKey},
+	})
+	require.Nil(tb, err)
+
+	return httptest.NewServer(handler)
+}
+
+func TestNewRemoteKeysSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty base url should error", func(t *testing.T) {
+		t.Parallel()
+
+		signer, err := NewRemoteKeysSigner(createMockArgsRemoteKeysSigner(""))
+		assert.True(t, check.IfNil(signer))
+		assert.Equal(t, ErrEmptyBaseUrl, err)
+	})
+	t.Run("invalid request timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteKeysSigner("http://localhost:8080")
+		args.RequestTimeoutSec = 0
+		signer, err := NewRemoteKeysSigner(args)
+		assert.True(t, check.IfNil(signer))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+	})
+	t.Run("http base url outside the loopback interface should error", func(t *testing.T) {
+		t.Parallel()
+
+		signer, err := NewRemoteKeysSigner(createMockArgsRemoteKeysSigner("http://10.0.0.1:8080"))
+		assert.True(t, check.IfNil(signer))
+		assert.ErrorIs(t, err, ErrInsecureBaseUrl)
+	})
+	t.Run("invalid base url scheme should error", func(t *testing.T) {
+		t.Parallel()
+
+		signer, err := NewRemoteKeysSigner(createMockArgsRemoteKeysSigner("ftp://localhost:8080"))
+		assert.True(t, check.IfNil(signer))
+		assert.ErrorIs(t, err, ErrInvalidBaseUrlScheme)
+	})
+	t.Run("client certificate without the client key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteKeysSigner("https://signer:8080")
+		args.ClientCertFile = "client.crt"
+		signer, err := NewRemoteKeysSigner(args)
+		assert.True(t, check.IfNil(signer))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+	})
+	t.Run("missing CA certificate file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteKeysSigner("https://signer:8080")
+		args.CACertFile = filepath.Join(t.TempDir(), "missing.crt")
+		signer, err := NewRemoteKeysSigner(args)
+		assert.True(t, check.IfNil(signer))
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid CA certificate file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteKeysSigner("https://signer:8080")
+		args.CACertFile = filepath.Join(t.TempDir(), "ca.crt")
+		require.Nil(t, os.WriteFile(args.CACertFile, []byte("not a certificate"), 0600))
+		signer, err := NewRemoteKeysSigner(args)
+		assert.True(t, check.IfNil(signer))
+		assert.ErrorIs(t, err, ErrInvalidCACertificate)
+	})
+	t.Run("https base url should work", func(t *testing.T) {
+		t.Parallel()
+
+		signer, err := NewRemoteKeysSigner(createMockArgsRemoteKeysSigner("https://signer:8080"))
+		assert.False(t, check.IfNil(signer))
+		assert.Nil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		signer, err := NewRemoteKeysSigner(createMockArgsRemoteKeysSigner("http://localhost:8080/"))
+		assert.False(t, check.IfNil(signer))
+		assert.Nil(t, err)
+		assert.Equal(t, "http://localhost:8080", signer.baseUrl)
+	})
+}
+
+func TestRemoteKeysSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	t.Run("remote signer error should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createTestServer(t, &cryptoMocks.KeysSignerStub{
+			SignCalled: func(publicKey []byte, message []byte) ([]byte, error) {
+				return nil, errors.New("signing failed")
+			},
+		})
+		defer server.Close()
+
+		signer, _ := NewRemoteKeysSigner(createMockArgsRemoteKeysSigner(server.URL))
+		sig, err := signer.Sign(providedPublicKey, providedMessage)
+		assert.Nil(t, sig)
+		assert.ErrorIs(t, err, ErrRemoteSigner)
+		assert.Contains(t, err.Error(), "signing failed")
+	})
+	t.Run("unhandled public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createTestServer(t, &cryptoMocks.KeysSignerStub{
+			SignCalled: func(publicKey []byte, message []byte) ([]byte, error) {
+				assert.Fail(t, "should have not called Sign")
+				return nil, nil
+			},
+		})
+		defer server.Close()
+
+		signer, _ := NewRemoteKeysSigner(createMockArgsRemoteKeysSigner(server.URL))
+		sig, err := signer.Sign([]byte("other public key"), providedMessage)
+		assert.Nil(t, sig)
+		assert.ErrorIs(t, err, ErrRemoteSigner)
+		assert.Contains(t, err.Error(), ErrPublicKeyNotHandled.Error())
+	})
+	t.Run("unreachable remote signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createTestServer(t, &cryptoMocks.KeysSignerStub{})
+		server.Close()
+
+		signer, _ := NewRemoteKeysSigner(createMockArgsRemoteKeysSigner(server.URL))
+		sig, err := signer.Sign(providedPublicKey, providedMessage)
+		assert.Nil(t, sig)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSig := []byte("signature")
+		server := createTestServer(t, &cryptoMocks.KeysSignerStub{
+			SignCalled: func(publicKey []byte, message []byte) ([]byte, error) {
+				assert.Equal(t, providedPublicKey, publicKey)
+				assert.Equal(t, providedMessage, message)
+
+				return expectedSig, nil
+			},
+		})
+		defer server.Close()
+
+		signer, _ := NewRemoteKeysSigner(createMockArgsRemoteKeysSigner(server.URL))
+		sig, err := signer.Sign(providedPublicKey, providedMessage)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedSig, sig)
+	})
+}
+
+// writeSelfSignedCertificate writes a self-signed certificate and its key in the provided directory, returning the
+// certificate together with the paths of the written files
+func writeSelfSignedCertificate(tb testing.TB, dir string, name string) (*x509.Certificate, string, string) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(tb, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.Nil(tb, err)
+	cert, err := x509.ParseCertificate(certBytes)
+	require.Nil(tb, err)
+	keyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	require.Nil(tb, err)
+
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	require.Nil(tb, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes}), 0600))
+	require.Nil(tb, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600))
+
+	return cert, certFile, keyFile
+}
+
+func TestRemoteKeysSigner_TLS(t *testing.T) {
+	t.Parallel()
+
+	expectedSig := []byte("signature")
+	handler, err := NewSignerHandler(ArgsSignerHandler{
+		KeysSigner: &cryptoMocks.KeysSignerStub{
+			SignCalled: func(publicKey []byte, message []byte) ([]byte, error) {
+				return expectedSig, nil
+			},
+		},
+		PublicKeys: [][]byte{providedPublicKey},
+	})
+	require.Nil(t, err)
+
+	dir := t.TempDir()
+	clientCert, clientCertFile, clientKeyFile := writeSelfSignedCertificate(t, dir, "client")
+	server := httptest.NewUnstartedServer(handler)
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  x509.NewCertPool(),
+	}
+	server.TLS.ClientCAs.AddCert(clientCert)
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	caCertFile := filepath.Join(dir, "ca.crt")
+	serverCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.Nil(t, os.WriteFile(caCertFile, serverCert, 0600))
+
+	t.Run("unknown certificate authority should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteKeysSigner(server.URL)
+		args.ClientCertFile = clientCertFile
+		args.ClientKeyFile = clientKeyFile
+		signer, _ := NewRemoteKeysSigner(args)
+		sig, errSign := signer.Sign(providedPublicKey, providedMessage)
+		assert.Nil(t, sig)
+		assert.NotNil(t, errSign)
+	})
+	t.Run("missing client certificate should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteKeysSigner(server.URL)
+		args.CACertFile = caCertFile
+		signer, _ := NewRemoteKeysSigner(args)
+		sig, errSign := signer.Sign(providedPublicKey, providedMessage)
+		assert.Nil(t, sig)
+		assert.NotNil(t, errSign)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteKeysSigner(server.URL)
+		args.CACertFile = caCertFile
+		args.ClientCertFile = clientCertFile
+		args.ClientKeyFile = clientKeyFile
+		signer, errCreate := NewRemoteKeysSigner(args)
+		require.Nil(t, errCreate)
+
+		sig, errSign := signer.Sign(providedPublicKey, providedMessage)
+		assert.Nil(t, errSign)
+		assert.Equal(t, expectedSig, sig)
+	})
+}
+
+func TestRemoteKeysSigner_CreateSignatureShare(t *testing.T) {
+	t.Parallel()
+
+	expectedSigShare := []byte("signature share")
+	providedEpoch := uint32(37)
+	server := createTestServer(t, &cryptoMocks.KeysSignerStub{
+		CreateSignatureShareCalled: func(publicKey []byte, message []byte, epoch uint32) ([]byte, error) {
+			assert.Equal(t, providedPublicKey, publicKey)
+			assert.Equal(t, providedMessage, message)
+			assert.Equal(t, providedEpoch, epoch)
+
+			return expectedSigShare, nil
+		},
+	})
+	defer server.Close()
+
+	signer, _ := NewRemoteKeysSigner(createMockArgsRemoteKeysSigner(server.URL))
+	sigShare, err := signer.CreateSignatureShare(providedPublicKey, providedMessage, providedEpoch)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedSigShare, sigShare)
+}
+
+func TestRemoteKeysSigner_GetPublicKeys(t *testing.T) {
+	t.Parallel()
+
+	server := createTestServer(t, &cryptoMocks.KeysSignerStub{})
+	defer server.Close()
+
+	signer, _ := NewRemoteKeysSigner(createMockArgsRemoteKeysSigner(server.URL))
+	publicKeys, err := signer.GetPublicKeys()
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{providedPublicKey}, publicKeys)
+}
+
+func TestRemoteKeysSigner_Authorization(t *testing.T) {
+	t.Parallel()
+
+	handler, _ := NewSignerHandler(ArgsSignerHandler{
+		KeysSigner:       &cryptoMocks.KeysSignerStub{},
+		PublicKeys:       [][]byte{providedPublicKey},
+		UseAuthorization: true,
+		Username:         "user",
+		Password:         "pass",
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	t.Run("wrong credentials should error", func(t *testing.T) {
+		args := createMockArgsRemoteKeysSigner(server.URL)
+		args.UseAuthorization = true
+		args.Username = "user"
+		args.Password = "wrong"
+		signer, _ := NewRemoteKeysSigner(args)
+
+		publicKeys, err := signer.GetPublicKeys()
+		assert.Nil(t, publicKeys)
+		assert.ErrorIs(t, err, ErrRemoteSigner)
+		assert.Contains(t, err.Error(), http.StatusText(http.StatusUnauthorized))
+	})
+	t.Run("correct credentials should work", func(t *testing.T) {
+		args := createMockArgsRemoteKeysSigner(server.URL)
+		args.UseAuthorization = true
+		args.Username = "user"
+		args.Password = "pass"
+		signer, _ := NewRemoteKeysSigner(args)
+
+		publicKeys, err := signer.GetPublicKeys()
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{providedPublicKey}, publicKeys)
+	})
+}
@@ -0,0 +1,154 @@
+package remoteSigner
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
+)
+
+// ArgsSignerHandler defines the arguments needed to create a new signer handler
+type ArgsSignerHandler struct {
+	KeysSigner       cryptoCommon.KeysSigner
+	PublicKeys       [][]byte
+	UseAuthorization bool
+	Username         string
+	Password         string
+}
+
+// signerHandler is the HTTP handler exposing a keys signer to the nodes that use it as a remote signer
+type signerHandler struct {
+	mux              *http.ServeMux
+	keysSigner       cryptoCommon.KeysSigner
+	publicKeys       map[string]struct{}
+	hexPublicKeys    []string
+	useAuthorization bool
+	username         string
+	password         string
+}
+
+// NewSignerHandler creates a new instance of type signerHandler
+func NewSignerHandler(args ArgsSignerHandler) (*signerHandler, error) {
+	if check.IfNil(args.KeysSigner) {
+		return nil, ErrNilKeysSigner
+	}
+	if len(args.PublicKeys) == 0 {
+		return nil, ErrNoPublicKeys
+	}
+
+	handler := &signerHandler{
+		mux:              http.NewServeMux(),
+		keysSigner:       args.KeysSigner,
+		publicKeys:       make(map[string]struct{}, len(args.PublicKeys)),
+		hexPublicKeys:    make([]string, 0, len(args.PublicKeys)),
+		useAuthorization: args.UseAuthorization,
+		username:         args.Username,
+		password:         args.Password,
+	}
+	for _, publicKey := range args.PublicKeys {
+		handler.publicKeys[string(publicKey)] = struct{}{}
+		handler.hexPublicKeys = append(handler.hexPublicKeys, hex.EncodeToString(publicKey))
+	}
+
+	handler.mux.HandleFunc(SignRoute, handler.sign)
+	handler.mux.HandleFunc(SignatureShareRoute, handler.createSignatureShare)
+	handler.mux.HandleFunc(PublicKeysRoute, handler.getPublicKeys)
+
+	return handler, nil
+}
+
+// ServeHTTP dispatches the request to the handler whose route matches the request URL
+func (handler *signerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if handler.useAuthorization {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != handler.username || password != handler.password {
+			writeResponse(w, http.StatusUnauthorized, &SignResponse{Error: http.StatusText(http.StatusUnauthorized)})
+			return
+		}
+	}
+
+	handler.mux.ServeHTTP(w, r)
+}
+
+func (handler *signerHandler) sign(w http.ResponseWriter, r *http.Request) {
+	handler.handleSignRequest(w, r, func(request *SignRequest, publicKey []byte, message []byte) ([]byte, error) {
+		return handler.keysSigner.Sign(publicKey, message)
+	})
+}
+
+func (handler *signerHandler) createSignatureShare(w http.ResponseWriter, r *http.Request) {
+	handler.handleSignRequest(w, r, func(request *SignRequest, publicKey []byte, message []byte) ([]byte, error) {
+		return handler.keysSigner.CreateSignatureShare(publicKey, message, request.Epoch)
+	})
+}
+
+func (handler *signerHandler) handleSignRequest(
+	w http.ResponseWriter,
+	r *http.Request,
+	signHandler func(request *SignRequest, publicKey []byte, message []byte) ([]byte, error),
+) {
+	if r.Method != http.MethodPost {
+		writeResponse(w, http.StatusMethodNotAllowed, &SignResponse{Error: http.StatusText(http.StatusMethodNotAllowed)})
+		return
+	}
+
+	request := &SignRequest{}
+	err := json.NewDecoder(r.Body).Decode(request)
+	if err != nil {
+		writeResponse(w, http.StatusBadRequest, &SignResponse{Error: err.Error()})
+		return
+	}
+
+	publicKey, err := hex.DecodeString(request.PublicKey)
+	if err != nil {
+		writeResponse(w, http.StatusBadRequest, &SignResponse{Error: fmt.Sprintf("%s for public key", err.Error())})
+		return
+	}
+	message, err := hex.DecodeString(request.Message)
+	if err != nil {
+		writeResponse(w, http.StatusBadRequest, &SignResponse{Error: fmt.Sprintf("%s for message", err.Error())})
+		return
+	}
+
+	_, isHandled := handler.publicKeys[string(publicKey)]
+	if !isHandled {
+		writeResponse(w, http.StatusForbidden, &SignResponse{Error: ErrPublicKeyNotHandled.Error()})
+		return
+	}
+
+	signature, err := signHandler(request, publicKey, message)
+	if err != nil {
+		log.Debug("signerHandler: could not sign", "public key", request.PublicKey, "route", r.URL.Path, "error", err)
+		writeResponse(w, http.StatusInternalServerError, &SignResponse{Error: err.Error()})
+		return
+	}
+
+	writeResponse(w, http.StatusOK, &SignResponse{Signature: hex.EncodeToString(signature)})
+}
+
+func (handler *signerHandler) getPublicKeys(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeResponse(w, http.StatusMethodNotAllowed, &PublicKeysResponse{Error: http.StatusText(http.StatusMethodNotAllowed)})
+		return
+	}
+
+	writeResponse(w, http.StatusOK, &PublicKeysResponse{PublicKeys: handler.hexPublicKeys})
+}
+
+func writeResponse(w http.ResponseWriter, statusCode int, response interface{}) {
+	w.Header().Set(contentTypeKey, contentTypeValue)
+	w.WriteHeader(statusCode)
+
+	err := json.NewEncoder(w).Encode(response)
+	if err != nil {
+		log.Warn("signerHandler: could not write the response", "error", err)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *signerHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,103 @@
+package remoteSigner
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSignerHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil keys signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewSignerHandler(ArgsSignerHandler{
+			PublicKeys: [][]byte{providedPublicKey},
+		})
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilKeysSigner, err)
+	})
+	t.Run("no public keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewSignerHandler(ArgsSignerHandler{
+			KeysSigner: &cryptoMocks.KeysSignerStub{},
+		})
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNoPublicKeys, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewSignerHandler(ArgsSignerHandler{
+			KeysSigner: &cryptoMocks.KeysSignerStub{},
+			PublicKeys: [][]byte{providedPublicKey},
+		})
+		assert.False(t, check.IfNil(handler))
+		assert.Nil(t, err)
+	})
+}
+
+func TestSignerHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	handler, _ := NewSignerHandler(ArgsSignerHandler{
+		KeysSigner: &cryptoMocks.KeysSignerStub{
+			SignCalled: func(publicKey []byte, message []byte) ([]byte, error) {
+				assert.Fail(t, "should have not called Sign")
+				return nil, nil
+			},
+		},
+		PublicKeys: [][]byte{providedPublicKey},
+	})
+
+	t.Run("wrong method should error", func(t *testing.T) {
+		t.Parallel()
+
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, SignRoute, nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+
+		resp = httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, PublicKeysRoute, nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+	})
+	t.Run("invalid request body should error", func(t *testing.T) {
+		t.Parallel()
+
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, SignRoute, strings.NewReader("not a json")))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("invalid hex public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		resp := httptest.NewRecorder()
+		body := `{"publicKey":"not hex","message":"aa"}`
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, SignRoute, strings.NewReader(body)))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, resp.Body.String(), "for public key")
+	})
+	t.Run("invalid hex message should error", func(t *testing.T) {
+		t.Parallel()
+
+		resp := httptest.NewRecorder()
+		body := `{"publicKey":"aa","message":"not hex"}`
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, SignatureShareRoute, strings.NewReader(body)))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, resp.Body.String(), "for message")
+	})
+	t.Run("unknown route should error", func(t *testing.T) {
+		t.Parallel()
+
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/unknown", nil))
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+}
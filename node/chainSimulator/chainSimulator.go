@@ -19,6 +19,7 @@ import (
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/components"
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/configs"
@@ -28,7 +29,10 @@ import (
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
-const delaySendTxs = time.Millisecond
+const (
+	delaySendTxs                    = time.Millisecond
+	numOfBlocksToFinalizeEpochStart = 5
+)
 
 var log = logger.GetOrCreate("chainSimulator")
 
@@ -41,6 +45,7 @@ type transactionWithResult struct {
 // ArgsChainSimulator holds the arguments needed to create a new instance of simulator
 type ArgsChainSimulator struct {
 	BypassTxSignatureCheck   bool
+	EnableSnapshots          bool
 	TempDir                  string
 	PathToInitialConfig      string
 	NumOfShards              uint32
@@ -59,15 +64,20 @@ type ArgsChainSimulator struct {
 }
 
 type simulator struct {
-	chanStopNodeProcess    chan endProcess.ArgEndProcess
-	syncedBroadcastNetwork components.SyncedBroadcastNetworkHandler
-	handlers               []ChainHandler
-	initialWalletKeys      *dtos.InitialWalletKeys
-	initialStakedKeys      map[string]*dtos.BLSKey
-	validatorsPrivateKeys  []crypto.PrivateKey
-	nodes                  map[uint32]process.NodeHandler
-	numOfShards            uint32
-	mutex                  sync.RWMutex
+	chanStopNodeProcess                  chan endProcess.ArgEndProcess
+	syncedBroadcastNetwork               components.SyncedBroadcastNetworkHandler
+	handlers                             []ChainHandler
+	initialWalletKeys                    *dtos.InitialWalletKeys
+	initialStakedKeys                    map[string]*dtos.BLSKey
+	validatorsPrivateKeys                []crypto.PrivateKey
+	nodes                                map[uint32]process.NodeHandler
+	numOfShards                          uint32
+	roundsPerEpoch                       uint64
+	maxConsecutiveRoundsOfRatingDecrease uint64
+	enableSnapshots                      bool
+	snapshots                            map[uint64]*chainSnapshot
+	lastSnapshotID                       uint64
+	mutex                                sync.RWMutex
 }
 
 // NewChainSimulator will create a new instance of simulator
@@ -79,9 +89,11 @@ func NewChainSimulator(args ArgsChainSimulator) (*simulator, error) {
 		nodes:                  make(map[uint32]process.NodeHandler),
 		handlers:               make([]ChainHandler, 0, args.NumOfShards+1),
 		numOfShards:            args.NumOfShards,
+		enableSnapshots:        args.EnableSnapshots,
 		chanStopNodeProcess:    make(chan endProcess.ArgEndProcess),
 		mutex:                  sync.RWMutex{},
 		initialStakedKeys:      make(map[string]*dtos.BLSKey),
+		snapshots:              make(map[uint64]*chainSnapshot),
 	}
 
 	err := instance.createChainHandlers(args)
@@ -106,6 +118,7 @@ func (s *simulator) createChainHandlers(args ArgsChainSimulator) error {
 		AlterConfigsFunction:     args.AlterConfigsFunction,
 		NumNodesWaitingListShard: args.NumNodesWaitingListShard,
 		NumNodesWaitingListMeta:  args.NumNodesWaitingListMeta,
+		EnableSnapshots:          args.EnableSnapshots,
 	})
 	if err != nil {
 		return err
@@ -158,6 +171,8 @@ func (s *simulator) createChainHandlers(args ArgsChainSimulator) error {
 	}
 
 	s.initialWalletKeys = outputConfigs.InitialWallets
+	s.roundsPerEpoch = uint64(outputConfigs.Configs.GeneralConfig.EpochStartConfig.RoundsPerEpoch)
+	s.maxConsecutiveRoundsOfRatingDecrease = outputConfigs.Configs.GeneralConfig.GeneralSettings.MaxConsecutiveRoundsOfRatingDecrease
 	s.validatorsPrivateKeys = outputConfigs.ValidatorsPrivateKeys
 
 	log.Info("running the chain simulator with the following parameters",
@@ -237,6 +252,110 @@ func (s *simulator) GenerateBlocksUntilEpochIsReached(targetEpoch int32) error {
 	return fmt.Errorf("exceeded rounds to generate blocks")
 }
 
+// FastForwardUntilEpochIsReached will move the chain to the target epoch without producing a block for every round.
+// After an epoch change is finalized on all nodes, the remaining rounds of the epoch are skipped on all nodes as empty
+// rounds, so only the blocks needed to trigger and finalize each epoch change are produced. The end of epoch processing
+// (rewards, system smart contracts, nodes shuffling) is executed as usual by the metachain node and synced by the
+// shard nodes. Rounds are skipped only after the validators rating is no longer decreased for long periods without
+// blocks, otherwise the blocks are produced as in GenerateBlocksUntilEpochIsReached and a warning is logged
+func (s *simulator) FastForwardUntilEpochIsReached(targetEpoch int32) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	roundsSkippingWarned := false
+	maxNumberOfRounds := 10000
+	for idx := 0; idx < maxNumberOfRounds; idx++ {
+		if !s.isRoundsSkippingSupported() && !roundsSkippingWarned {
+			log.Warn("chain simulator cannot skip rounds while the validators rating is decreased for long periods without blocks, "+
+				"the blocks of all rounds will be produced until the flag is enabled",
+				"flag", common.StopDecreasingValidatorRatingWhenStuckFlag,
+				"current epoch", s.nodes[core.MetachainShardId].GetCoreComponents().EnableEpochsHandler().GetCurrentEpoch(),
+				"target epoch", targetEpoch)
+			roundsSkippingWarned = true
+		}
+
+		err := s.skipRoundsUntilEpochEnd()
+		if err != nil {
+			return err
+		}
+
+		s.incrementRoundOnAllValidators()
+		err = s.allNodesCreateBlocks()
+		if err != nil {
+			return err
+		}
+
+		epochReachedOnAllNodes, err := s.isTargetEpochReached(targetEpoch)
+		if err != nil {
+			return err
+		}
+
+		if epochReachedOnAllNodes {
+			return nil
+		}
+	}
+	return fmt.Errorf("exceeded rounds to generate blocks")
+}
+
+func (s *simulator) isRoundsSkippingSupported() bool {
+	enableEpochsHandler := s.nodes[core.MetachainShardId].GetCoreComponents().EnableEpochsHandler()
+
+	return enableEpochsHandler.IsFlagEnabled(common.StopDecreasingValidatorRatingWhenStuckFlag)
+}
+
+func (s *simulator) skipRoundsUntilEpochEnd() error {
+	if !s.isRoundsSkippingSupported() {
+		return nil
+	}
+
+	metachainNode := s.nodes[core.MetachainShardId]
+	enableEpochsHandler := metachainNode.GetCoreComponents().EnableEpochsHandler()
+
+	epochStartTrigger := metachainNode.GetProcessComponents().EpochStartTrigger()
+	if epochStartTrigger.IsEpochStart() {
+		return nil
+	}
+
+	metachainEpoch := enableEpochsHandler.GetCurrentEpoch()
+	for _, node := range s.nodes {
+		if node.GetCoreComponents().EnableEpochsHandler().GetCurrentEpoch() != metachainEpoch {
+			// the shards did not switch to the new epoch yet, the blocks that finalize the epoch start should be produced
+			return nil
+		}
+	}
+
+	currentRound := metachainNode.GetCoreComponents().RoundHandler().Index()
+	epochStartRound := int64(epochStartTrigger.EpochStartRound())
+	if currentRound < epochStartRound+numOfBlocksToFinalizeEpochStart {
+		return nil
+	}
+
+	// the metachain epoch start trigger fires on the first round after this one
+	lastRoundOfEpoch := epochStartRound + int64(s.roundsPerEpoch)
+	if currentRound >= lastRoundOfEpoch {
+		return nil
+	}
+
+	// the skipped rounds should not be accounted as missed blocks when computing the validators rating
+	newRound := lastRoundOfEpoch
+	if newRound-currentRound <= int64(s.maxConsecutiveRoundsOfRatingDecrease) {
+		newRound = currentRound + int64(s.maxConsecutiveRoundsOfRatingDecrease) + 1
+	}
+
+	for shardID, node := range s.nodes {
+		roundHandler, ok := node.GetCoreComponents().RoundHandler().(roundIndexSetter)
+		if !ok {
+			return fmt.Errorf("%w for the round handler of shard %d", errFastForwardNotSupported, shardID)
+		}
+
+		roundHandler.SetIndex(newRound)
+	}
+
+	log.Debug("chain simulator skipped rounds until the end of epoch", "epoch", metachainEpoch, "from round", currentRound, "to round", newRound)
+
+	return nil
+}
+
 // ForceResetValidatorStatisticsCache will force the reset of the cache used for the validators statistics endpoint
 func (s *simulator) ForceResetValidatorStatisticsCache() error {
 	metachainNode := s.GetNodeHandler(core.MetachainShardId)
@@ -482,6 +601,77 @@ func (s *simulator) RemoveAccounts(addresses []string) error {
 	return nil
 }
 
+// Snapshot will capture the current state of all the nodes (accounts tries, blockchain heads, data pools and round) and
+// will return an identifier that can be later used to revert the simulated chain to this point. Snapshots are available
+// only if the chain simulator was created with snapshots enabled
+func (s *simulator) Snapshot() (uint64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.enableSnapshots {
+		return 0, errSnapshotsDisabled
+	}
+
+	snapshot := &chainSnapshot{
+		nodes: make(map[uint32]*nodeSnapshot, len(s.nodes)),
+	}
+	for shardID, node := range s.nodes {
+		nodeSnap, err := createNodeSnapshot(node, s.numOfShards)
+		if err != nil {
+			return 0, fmt.Errorf("%w while creating the snapshot for shard %d", err, shardID)
+		}
+
+		snapshot.nodes[shardID] = nodeSnap
+	}
+
+	s.lastSnapshotID++
+	s.snapshots[s.lastSnapshotID] = snapshot
+
+	log.Info("created chain simulator snapshot", "id", s.lastSnapshotID)
+
+	return s.lastSnapshotID, nil
+}
+
+// RevertTo will revert all the nodes to the state captured by the snapshot with the provided identifier.
+// The snapshot is kept so the simulated chain can be reverted to it multiple times, while the snapshots taken after it
+// are dropped as the chain they captured is discarded. Reverting is possible only if the nodes are still in the epoch
+// in which the snapshot was taken. The blocks and transactions already saved in the
+// nodes' storage are not removed, so they can still be fetched through the API after the revert
+func (s *simulator) RevertTo(snapshotID uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	snapshot, found := s.snapshots[snapshotID]
+	if !found {
+		return fmt.Errorf("%w, id %d", errSnapshotNotFound, snapshotID)
+	}
+
+	for shardID, node := range s.nodes {
+		currentEpoch := node.GetProcessComponents().EpochStartTrigger().Epoch()
+		if currentEpoch != snapshot.nodes[shardID].epoch {
+			return fmt.Errorf("%w, shard %d, snapshot epoch %d, current epoch %d",
+				errSnapshotEpochMismatch, shardID, snapshot.nodes[shardID].epoch, currentEpoch)
+		}
+	}
+
+	for shardID, node := range s.nodes {
+		err := snapshot.nodes[shardID].restore(node)
+		if err != nil {
+			return fmt.Errorf("%w while reverting shard %d", err, shardID)
+		}
+	}
+
+	for id := range s.snapshots {
+		if id > snapshotID {
+			delete(s.snapshots, id)
+		}
+	}
+
+	log.Info("reverted chain simulator to snapshot", "id", snapshotID)
+
+	return nil
+}
+
 // SendTxAndGenerateBlockTilTxIsExecuted will send the provided transaction and generate block until the transaction is executed
 func (s *simulator) SendTxAndGenerateBlockTilTxIsExecuted(txToSend *transaction.Transaction, maxNumOfBlocksToGenerateWhenExecutingTx int) (*transaction.ApiTransactionResult, error) {
 	result, err := s.SendTxsAndGenerateBlocksTilAreExecuted([]*transaction.Transaction{txToSend}, maxNumOfBlocksToGenerateWhenExecutingTx)
@@ -9,6 +9,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	coreAPI "github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/components/api"
 	"github.com/multiversx/mx-chain-go/node/chainSimulator/configs"
@@ -148,6 +149,87 @@ func TestChainSimulator_GenerateBlocksAndEpochChangeShouldWork(t *testing.T) {
 	assert.True(t, numAccountsWithIncreasedBalances > 0)
 }
 
+func TestChainSimulator_FastForwardUntilEpochIsReached(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    20,
+	}
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: false,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch:         roundsPerEpoch,
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	facade, err := NewChainSimulatorFacade(chainSimulator)
+	require.Nil(t, err)
+
+	genesisBalances := make(map[string]*big.Int)
+	for _, stakeWallet := range chainSimulator.initialWalletKeys.StakeWallets {
+		initialAccount, errGet := facade.GetExistingAccountFromBech32AddressString(stakeWallet.Address.Bech32)
+		require.Nil(t, errGet)
+
+		genesisBalances[stakeWallet.Address.Bech32] = initialAccount.GetBalance()
+	}
+
+	time.Sleep(time.Second)
+
+	targetEpoch := int32(8)
+	err = chainSimulator.FastForwardUntilEpochIsReached(targetEpoch)
+	require.Nil(t, err)
+
+	metachainNode := chainSimulator.GetNodeHandler(core.MetachainShardId)
+	for shardID, node := range chainSimulator.nodes {
+		assert.Equal(t, uint32(targetEpoch), node.GetCoreComponents().EnableEpochsHandler().GetCurrentEpoch(), "shard %d", shardID)
+		assert.Equal(t, metachainNode.GetCoreComponents().RoundHandler().Index(), node.GetCoreComponents().RoundHandler().Index())
+	}
+
+	// the intermediate rounds should have been skipped
+	currentRound := uint64(metachainNode.GetCoreComponents().RoundHandler().Index())
+	currentNonce := metachainNode.GetChainHandler().GetCurrentBlockHeader().GetNonce()
+	assert.GreaterOrEqual(t, currentRound, uint64(targetEpoch)*roundsPerEpoch.Value)
+	assert.Less(t, currentNonce, currentRound/2)
+
+	// the end of epoch processing should have been executed
+	numAccountsWithIncreasedBalances := 0
+	for _, stakeWallet := range chainSimulator.initialWalletKeys.StakeWallets {
+		account, errGet := facade.GetExistingAccountFromBech32AddressString(stakeWallet.Address.Bech32)
+		require.Nil(t, errGet)
+
+		if account.GetBalance().Cmp(genesisBalances[stakeWallet.Address.Bech32]) > 0 {
+			numAccountsWithIncreasedBalances++
+		}
+	}
+	assert.True(t, numAccountsWithIncreasedBalances > 0)
+
+	err = chainSimulator.ForceResetValidatorStatisticsCache()
+	require.Nil(t, err)
+	for _, validatorStatistics := range metachainNode.GetProcessComponents().ValidatorsProvider().GetLatestValidators() {
+		assert.NotEqual(t, string(common.JailedList), validatorStatistics.ValidatorStatus)
+		assert.Zero(t, validatorStatistics.TotalNumLeaderFailure)
+	}
+
+	// the chain should continue normally after fast forwarding
+	err = chainSimulator.GenerateBlocks(5)
+	require.Nil(t, err)
+}
+
 func TestChainSimulator_SetState(t *testing.T) {
 	if testing.Short() {
 		t.Skip("this is not a short test")
@@ -542,6 +624,128 @@ func TestSimulator_SendTransactions(t *testing.T) {
 	})
 }
 
+func TestSimulator_SnapshotAndRevert(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    20,
+	}
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: false,
+		EnableSnapshots:        true,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch:         roundsPerEpoch,
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	err = chainSimulator.RevertTo(1)
+	require.ErrorIs(t, err, errSnapshotNotFound)
+
+	oneEgld := big.NewInt(1000000000000000000)
+	initialMinting := big.NewInt(0).Mul(oneEgld, big.NewInt(100))
+	transferValue := big.NewInt(0).Mul(oneEgld, big.NewInt(5))
+
+	wallet0, err := chainSimulator.GenerateAndMintWalletAddress(0, initialMinting)
+	require.Nil(t, err)
+
+	wallet1, err := chainSimulator.GenerateAndMintWalletAddress(1, initialMinting)
+	require.Nil(t, err)
+
+	err = chainSimulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	snapshotID, err := chainSimulator.Snapshot()
+	require.Nil(t, err)
+
+	metaNode := chainSimulator.GetNodeHandler(core.MetachainShardId)
+	nonceAtSnapshot := metaNode.GetChainHandler().GetCurrentBlockHeader().GetNonce()
+	roundAtSnapshot := metaNode.GetCoreComponents().RoundHandler().Index()
+
+	maxNumOfBlockToGenerateWhenExecutingTx := 15
+	for i := int64(1); i <= 2; i++ {
+		// each branch uses a different transaction as the transactions already executed are still found in the nodes' storage
+		value := big.NewInt(0).Mul(transferValue, big.NewInt(i))
+		tx := generateTransaction(wallet0.Bytes, 0, wallet1.Bytes, value, "", 50000)
+		_, err = chainSimulator.SendTxAndGenerateBlockTilTxIsExecuted(tx, maxNumOfBlockToGenerateWhenExecutingTx)
+		require.Nil(t, err)
+
+		account, errGet := chainSimulator.GetAccount(wallet1)
+		require.Nil(t, errGet)
+		assert.Equal(t, big.NewInt(0).Add(initialMinting, value).String(), account.Balance)
+
+		err = chainSimulator.RevertTo(snapshotID)
+		require.Nil(t, err)
+
+		assert.Equal(t, nonceAtSnapshot, metaNode.GetChainHandler().GetCurrentBlockHeader().GetNonce())
+		assert.Equal(t, roundAtSnapshot, metaNode.GetCoreComponents().RoundHandler().Index())
+
+		account, errGet = chainSimulator.GetAccount(wallet1)
+		require.Nil(t, errGet)
+		assert.Equal(t, initialMinting.String(), account.Balance)
+
+		account, errGet = chainSimulator.GetAccount(wallet0)
+		require.Nil(t, errGet)
+		assert.Equal(t, uint64(0), account.Nonce)
+	}
+
+	newerSnapshotID, err := chainSimulator.Snapshot()
+	require.Nil(t, err)
+
+	err = chainSimulator.RevertTo(snapshotID)
+	require.Nil(t, err)
+
+	err = chainSimulator.RevertTo(newerSnapshotID)
+	require.ErrorIs(t, err, errSnapshotNotFound)
+}
+
+func TestSimulator_SnapshotWhenSnapshotsAreDisabled(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	startTime := time.Now().Unix()
+	roundDurationInMillis := uint64(6000)
+	roundsPerEpoch := core.OptionalUint64{
+		HasValue: true,
+		Value:    20,
+	}
+	chainSimulator, err := NewChainSimulator(ArgsChainSimulator{
+		BypassTxSignatureCheck: false,
+		TempDir:                t.TempDir(),
+		PathToInitialConfig:    defaultPathToInitialConfig,
+		NumOfShards:            3,
+		GenesisTimestamp:       startTime,
+		RoundDurationInMillis:  roundDurationInMillis,
+		RoundsPerEpoch:         roundsPerEpoch,
+		ApiInterface:           api.NewNoApiInterface(),
+		MinNodesPerShard:       1,
+		MetaChainMinNodes:      1,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, chainSimulator)
+
+	defer chainSimulator.Close()
+
+	snapshotID, err := chainSimulator.Snapshot()
+	assert.ErrorIs(t, err, errSnapshotsDisabled)
+	assert.Zero(t, snapshotID)
+}
+
 func generateTransaction(sender []byte, nonce uint64, receiver []byte, value *big.Int, data string, gasLimit uint64) *transaction.Transaction {
 	minGasPrice := uint64(1000000000)
 	txVersion := uint32(1)
@@ -43,6 +43,9 @@ type cryptoComponentsHolder struct {
 	consensusSigningHandler       consensus.SigningHandler
 	managedPeersHolder            common.ManagedPeersHolder
 	keysHandler                   consensus.KeysHandler
+	keysSigner                    cryptoCommon.KeysSigner
+	managedKeysUpdater            common.ManagedKeysUpdater
+	redundancyLeaseHandler        common.RedundancyLeaseHandler
 	publicKeyBytes                []byte
 	publicKeyString               string
 	managedCryptoComponentsCloser io.Closer
@@ -106,6 +109,9 @@ func CreateCryptoComponents(args ArgsCryptoComponentsHolder) (*cryptoComponentsH
 	instance.consensusSigningHandler = managedCryptoComponents.ConsensusSigningHandler()
 	instance.managedPeersHolder = managedCryptoComponents.ManagedPeersHolder()
 	instance.keysHandler = managedCryptoComponents.KeysHandler()
+	instance.keysSigner = managedCryptoComponents.KeysSigner()
+	instance.managedKeysUpdater = managedCryptoComponents.ManagedKeysUpdater()
+	instance.redundancyLeaseHandler = managedCryptoComponents.RedundancyLeaseHandler()
 	instance.managedCryptoComponentsCloser = managedCryptoComponents
 
 	if args.BypassTxSignatureCheck {
@@ -219,6 +225,21 @@ func (c *cryptoComponentsHolder) KeysHandler() consensus.KeysHandler {
 	return c.keysHandler
 }
 
+// KeysSigner will return the keys signer
+func (c *cryptoComponentsHolder) KeysSigner() cryptoCommon.KeysSigner {
+	return c.keysSigner
+}
+
+// ManagedKeysUpdater will return the managed keys updater
+func (c *cryptoComponentsHolder) ManagedKeysUpdater() common.ManagedKeysUpdater {
+	return c.managedKeysUpdater
+}
+
+// RedundancyLeaseHandler will return the redundancy lease handler
+func (c *cryptoComponentsHolder) RedundancyLeaseHandler() common.RedundancyLeaseHandler {
+	return c.redundancyLeaseHandler
+}
+
 // Clone will clone the cryptoComponentsHolder
 func (c *cryptoComponentsHolder) Clone() interface{} {
 	return &cryptoComponentsHolder{
@@ -238,6 +259,9 @@ func (c *cryptoComponentsHolder) Clone() interface{} {
 		consensusSigningHandler:       c.ConsensusSigningHandler(),
 		managedPeersHolder:            c.ManagedPeersHolder(),
 		keysHandler:                   c.KeysHandler(),
+		keysSigner:                    c.KeysSigner(),
+		managedKeysUpdater:            c.ManagedKeysUpdater(),
+		redundancyLeaseHandler:        c.RedundancyLeaseHandler(),
 		publicKeyBytes:                c.PublicKeyBytes(),
 		publicKeyString:               c.PublicKeyString(),
 		managedCryptoComponentsCloser: c.managedCryptoComponentsCloser,
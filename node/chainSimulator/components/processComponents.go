@@ -146,6 +146,7 @@ func CreateProcessComponents(args ArgsProcessComponentsHolder) (*processComponen
 		Marshalizer:              args.CoreComponents.InternalMarshalizer(),
 		Store:                    args.DataComponents.StorageService(),
 		Uint64ByteSliceConverter: args.CoreComponents.Uint64ByteSliceConverter(),
+		Accounts:                 args.StateComponents.AccountsAdapter(),
 	}
 	historyRepositoryFactory, err := dbLookupFactory.NewHistoryRepositoryFactory(historyRepoFactoryArgs)
 	if err != nil {
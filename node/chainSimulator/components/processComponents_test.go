@@ -177,16 +177,17 @@ func createArgsProcessComponentsHolder() ArgsProcessComponentsHolder {
 			MultiSigContainer: &cryptoMocks.MultiSignerContainerMock{
 				MultiSigner: &cryptoMocks.MultisignerMock{},
 			},
-			PrivKey:                 &cryptoMocks.PrivateKeyStub{},
-			PubKey:                  &cryptoMocks.PublicKeyStub{},
-			PubKeyString:            "pub key string",
-			PubKeyBytes:             []byte("pub key bytes"),
-			TxKeyGen:                &cryptoMocks.KeyGenStub{},
-			TxSig:                   &cryptoMocks.SingleSignerStub{},
-			PeerSignHandler:         &cryptoMocks.PeerSignatureHandlerStub{},
-			MsgSigVerifier:          &testscommon.MessageSignVerifierMock{},
-			ManagedPeersHolderField: &testscommon.ManagedPeersHolderStub{},
-			KeysHandlerField:        &testscommon.KeysHandlerStub{},
+			PrivKey:                     &cryptoMocks.PrivateKeyStub{},
+			PubKey:                      &cryptoMocks.PublicKeyStub{},
+			PubKeyString:                "pub key string",
+			PubKeyBytes:                 []byte("pub key bytes"),
+			TxKeyGen:                    &cryptoMocks.KeyGenStub{},
+			TxSig:                       &cryptoMocks.SingleSignerStub{},
+			PeerSignHandler:             &cryptoMocks.PeerSignatureHandlerStub{},
+			MsgSigVerifier:              &testscommon.MessageSignVerifierMock{},
+			ManagedPeersHolderField:     &testscommon.ManagedPeersHolderStub{},
+			KeysHandlerField:            &testscommon.KeysHandlerStub{},
+			RedundancyLeaseHandlerField: &testscommon.RedundancyLeaseHandlerStub{},
 		},
 		NetworkComponents: &mock.NetworkComponentsStub{
 			Messenger:                        &p2pmocks.MessengerStub{},
@@ -36,6 +36,7 @@ func TestCreateStore(t *testing.T) {
 		dataRetriever.EpochByHashUnit,
 		dataRetriever.ResultsHashesByTxHashUnit,
 		dataRetriever.TrieEpochRootHashUnit,
+		dataRetriever.AccountsHistoryUnit,
 		dataRetriever.ShardHdrNonceHashDataUnit,
 		dataRetriever.UnitType(101), // shard 2
 	}
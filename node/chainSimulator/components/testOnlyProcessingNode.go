@@ -326,7 +326,7 @@ func (node *testOnlyProcessingNode) createBroadcastMessenger() error {
 		node.CoreComponentsHolder.Hasher(),
 		node.NetworkComponentsHolder.NetworkMessenger(),
 		node.ProcessComponentsHolder.ShardCoordinator(),
-		node.CryptoComponentsHolder.PeerSignatureHandler(),
+		node.CryptoComponentsHolder.KeysSigner(),
 		node.DataComponentsHolder.Datapool().Headers(),
 		node.ProcessComponentsHolder.InterceptorsContainer(),
 		node.CoreComponentsHolder.AlarmScheduler(),
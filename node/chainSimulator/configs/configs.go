@@ -51,6 +51,7 @@ type ArgsChainSimulatorConfigs struct {
 	RoundsPerEpoch           core.OptionalUint64
 	NumNodesWaitingListShard uint32
 	NumNodesWaitingListMeta  uint32
+	EnableSnapshots          bool
 	AlterConfigsFunction     func(cfg *config.Configs)
 }
 
@@ -110,6 +111,11 @@ func CreateChainSimulatorConfigs(args ArgsChainSimulatorConfigs) (*ArgsConfigsSi
 
 	// set compatible trie configs
 	configs.GeneralConfig.StateTriesConfig.SnapshotsEnabled = false
+	if args.EnableSnapshots {
+		// keep all the committed state so the chain simulator can revert to any of its previous snapshots
+		configs.GeneralConfig.StateTriesConfig.AccountsStatePruningEnabled = false
+		configs.GeneralConfig.StateTriesConfig.PeerStatePruningEnabled = false
+	}
 
 	// enable db lookup extension
 	configs.GeneralConfig.DbLookupExtensions.Enabled = true
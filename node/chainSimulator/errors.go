@@ -3,10 +3,15 @@ package chainSimulator
 import "errors"
 
 var (
-	errNilChainSimulator     = errors.New("nil chain simulator")
-	errNilMetachainNode      = errors.New("nil metachain node")
-	errShardSetupError       = errors.New("shard setup error")
-	errEmptySliceOfTxs       = errors.New("empty slice of transactions to send")
-	errNilTransaction        = errors.New("nil transaction")
-	errInvalidMaxNumOfBlocks = errors.New("invalid max number of blocks to generate")
+	errNilChainSimulator       = errors.New("nil chain simulator")
+	errNilMetachainNode        = errors.New("nil metachain node")
+	errShardSetupError         = errors.New("shard setup error")
+	errEmptySliceOfTxs         = errors.New("empty slice of transactions to send")
+	errNilTransaction          = errors.New("nil transaction")
+	errInvalidMaxNumOfBlocks   = errors.New("invalid max number of blocks to generate")
+	errSnapshotNotFound        = errors.New("snapshot not found")
+	errSnapshotNotSupported    = errors.New("snapshot not supported")
+	errSnapshotsDisabled       = errors.New("snapshots are not enabled")
+	errSnapshotEpochMismatch   = errors.New("cannot revert to a snapshot taken in a different epoch")
+	errFastForwardNotSupported = errors.New("fast forward not supported")
 )
@@ -0,0 +1,324 @@
+package chainSimulator
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/process"
+	processChain "github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+type roundIndexSetter interface {
+	SetIndex(index int64)
+}
+
+type notarizedHeadersRestorer interface {
+	RestoreNotarizedHeaders(selfNotarizedHeaders map[uint32]data.HeaderHandler, crossNotarizedHeaders map[uint32]data.HeaderHandler) error
+}
+
+type pooledData struct {
+	key     []byte
+	value   interface{}
+	cacheID string
+}
+
+type pooledHeader struct {
+	hash   []byte
+	header data.HeaderHandler
+}
+
+type nodeSnapshot struct {
+	epoch                     uint32
+	roundIndex                int64
+	userAccountsRootHash      []byte
+	peerAccountsRootHash      []byte
+	currentHeader             data.HeaderHandler
+	currentHeaderHash         []byte
+	currentRootHash           []byte
+	finalNonce                uint64
+	finalHeaderHash           []byte
+	finalRootHash             []byte
+	scheduledInfo             *processChain.ScheduledInfo
+	processedMiniBlocks       []bootstrapStorage.MiniBlocksInMeta
+	lastSelfNotarizedHeaders  map[uint32]data.HeaderHandler
+	lastCrossNotarizedHeaders map[uint32]data.HeaderHandler
+	transactions              []*pooledData
+	unsignedTransactions      []*pooledData
+	rewardTransactions        []*pooledData
+	validatorsInfo            []*pooledData
+	miniBlocks                []*pooledData
+	headers                   []*pooledHeader
+}
+
+type chainSnapshot struct {
+	nodes map[uint32]*nodeSnapshot
+}
+
+func createNodeSnapshot(node process.NodeHandler, numOfShards uint32) (*nodeSnapshot, error) {
+	stateComponents := node.GetStateComponents()
+	userAccountsRootHash, err := stateComponents.AccountsAdapter().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	peerAccountsRootHash, err := stateComponents.PeerAccounts().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	chainHandler := node.GetChainHandler()
+	finalNonce, finalHeaderHash, finalRootHash := chainHandler.GetFinalBlockInfo()
+
+	processComponents := node.GetProcessComponents()
+	scheduledTxsExecutionHandler := processComponents.ScheduledTxsExecutionHandler()
+	snapshot := &nodeSnapshot{
+		epoch:                processComponents.EpochStartTrigger().Epoch(),
+		roundIndex:           node.GetCoreComponents().RoundHandler().Index(),
+		userAccountsRootHash: userAccountsRootHash,
+		peerAccountsRootHash: peerAccountsRootHash,
+		currentHeader:        chainHandler.GetCurrentBlockHeader(),
+		currentHeaderHash:    chainHandler.GetCurrentBlockHeaderHash(),
+		currentRootHash:      chainHandler.GetCurrentBlockRootHash(),
+		finalNonce:           finalNonce,
+		finalHeaderHash:      finalHeaderHash,
+		finalRootHash:        finalRootHash,
+		scheduledInfo: &processChain.ScheduledInfo{
+			RootHash:        scheduledTxsExecutionHandler.GetScheduledRootHash(),
+			IntermediateTxs: scheduledTxsExecutionHandler.GetScheduledIntermediateTxs(),
+			GasAndFees:      scheduledTxsExecutionHandler.GetScheduledGasAndFees(),
+			MiniBlocks:      scheduledTxsExecutionHandler.GetScheduledMiniBlocks(),
+		},
+		processedMiniBlocks:       processComponents.ProcessedMiniBlocksTracker().ConvertProcessedMiniBlocksMapToSlice(),
+		lastSelfNotarizedHeaders:  make(map[uint32]data.HeaderHandler),
+		lastCrossNotarizedHeaders: make(map[uint32]data.HeaderHandler),
+	}
+
+	allShardIDs := getAllShardIDs(numOfShards)
+	blockTracker := processComponents.BlockTracker()
+	for _, shardID := range allShardIDs {
+		selfNotarizedHeader, _, errGet := blockTracker.GetLastSelfNotarizedHeader(shardID)
+		if errGet == nil {
+			snapshot.lastSelfNotarizedHeaders[shardID] = selfNotarizedHeader
+		}
+
+		crossNotarizedHeader, _, errGet := blockTracker.GetLastCrossNotarizedHeader(shardID)
+		if errGet == nil {
+			snapshot.lastCrossNotarizedHeaders[shardID] = crossNotarizedHeader
+		}
+	}
+
+	dataPool := node.GetDataComponents().Datapool()
+	cacheIDs := getAllCacheIDs(allShardIDs)
+	snapshot.transactions = getShardedPoolData(dataPool.Transactions(), cacheIDs)
+	snapshot.unsignedTransactions = getShardedPoolData(dataPool.UnsignedTransactions(), cacheIDs)
+	snapshot.rewardTransactions = getShardedPoolData(dataPool.RewardTransactions(), cacheIDs)
+	snapshot.validatorsInfo = getShardedPoolData(dataPool.ValidatorsInfo(), cacheIDs)
+	snapshot.miniBlocks = getCacherData(dataPool.MiniBlocks())
+	snapshot.headers = getPooledHeaders(dataPool.Headers(), allShardIDs)
+
+	return snapshot, nil
+}
+
+func (snapshot *nodeSnapshot) restore(node process.NodeHandler) error {
+	roundHandler, ok := node.GetCoreComponents().RoundHandler().(roundIndexSetter)
+	if !ok {
+		return fmt.Errorf("%w for the round handler of shard %d", errSnapshotNotSupported, node.GetShardCoordinator().SelfId())
+	}
+
+	processComponents := node.GetProcessComponents()
+	blockTracker, ok := processComponents.BlockTracker().(notarizedHeadersRestorer)
+	if !ok {
+		return fmt.Errorf("%w for the block tracker of shard %d", errSnapshotNotSupported, node.GetShardCoordinator().SelfId())
+	}
+
+	roundHandler.SetIndex(snapshot.roundIndex)
+	node.GetStatusCoreComponents().AppStatusHandler().SetUInt64Value(common.MetricCurrentRound, uint64(snapshot.roundIndex))
+
+	stateComponents := node.GetStateComponents()
+	err := stateComponents.AccountsAdapter().RecreateTrie(snapshot.userAccountsRootHash)
+	if err != nil {
+		return err
+	}
+
+	err = stateComponents.PeerAccounts().RecreateTrie(snapshot.peerAccountsRootHash)
+	if err != nil {
+		return err
+	}
+
+	chainHandler := node.GetChainHandler()
+	err = chainHandler.SetCurrentBlockHeaderAndRootHash(snapshot.currentHeader, snapshot.currentRootHash)
+	if err != nil {
+		return err
+	}
+	chainHandler.SetCurrentBlockHeaderHash(snapshot.currentHeaderHash)
+	chainHandler.SetFinalBlockInfo(snapshot.finalNonce, snapshot.finalHeaderHash, snapshot.finalRootHash)
+
+	processComponents.ScheduledTxsExecutionHandler().SetScheduledInfo(snapshot.scheduledInfo)
+
+	processedMiniBlocksTracker := processComponents.ProcessedMiniBlocksTracker()
+	for _, miniBlocksInMeta := range processedMiniBlocksTracker.ConvertProcessedMiniBlocksMapToSlice() {
+		processedMiniBlocksTracker.RemoveMetaBlockHash(miniBlocksInMeta.MetaHash)
+	}
+	processedMiniBlocksTracker.ConvertSliceToProcessedMiniBlocksMap(snapshot.processedMiniBlocks)
+
+	processComponents.ForkDetector().RestoreToGenesis()
+
+	// the block tracker should be restored before the headers pool so the re-added headers will be tracked again
+	err = blockTracker.RestoreNotarizedHeaders(snapshot.lastSelfNotarizedHeaders, snapshot.lastCrossNotarizedHeaders)
+	if err != nil {
+		return err
+	}
+
+	return snapshot.restorePools(node)
+}
+
+func (snapshot *nodeSnapshot) restorePools(node process.NodeHandler) error {
+	marshaller := node.GetCoreComponents().InternalMarshalizer()
+	dataPool := node.GetDataComponents().Datapool()
+
+	err := setShardedPoolData(dataPool.Transactions(), snapshot.transactions, marshaller)
+	if err != nil {
+		return err
+	}
+
+	err = setShardedPoolData(dataPool.UnsignedTransactions(), snapshot.unsignedTransactions, marshaller)
+	if err != nil {
+		return err
+	}
+
+	err = setShardedPoolData(dataPool.RewardTransactions(), snapshot.rewardTransactions, marshaller)
+	if err != nil {
+		return err
+	}
+
+	err = setShardedPoolData(dataPool.ValidatorsInfo(), snapshot.validatorsInfo, marshaller)
+	if err != nil {
+		return err
+	}
+
+	err = setCacherData(dataPool.MiniBlocks(), snapshot.miniBlocks, marshaller)
+	if err != nil {
+		return err
+	}
+
+	headersPool := dataPool.Headers()
+	headersPool.Clear()
+	for _, pooled := range snapshot.headers {
+		headersPool.AddHeader(pooled.hash, pooled.header)
+	}
+
+	dataPool.CurrentBlockTxs().Clean()
+
+	return nil
+}
+
+func getAllShardIDs(numOfShards uint32) []uint32 {
+	allShardIDs := make([]uint32, 0, numOfShards+1)
+	for shardID := uint32(0); shardID < numOfShards; shardID++ {
+		allShardIDs = append(allShardIDs, shardID)
+	}
+
+	return append(allShardIDs, core.MetachainShardId)
+}
+
+func getAllCacheIDs(allShardIDs []uint32) []string {
+	cacheIDs := make([]string, 0, len(allShardIDs)*len(allShardIDs))
+	for _, senderShardID := range allShardIDs {
+		for _, destinationShardID := range allShardIDs {
+			cacheIDs = append(cacheIDs, processChain.ShardCacherIdentifier(senderShardID, destinationShardID))
+		}
+	}
+
+	return cacheIDs
+}
+
+func getShardedPoolData(pool dataRetriever.ShardedDataCacherNotifier, cacheIDs []string) []*pooledData {
+	result := make([]*pooledData, 0)
+	for _, cacheID := range cacheIDs {
+		cacher := pool.ShardDataStore(cacheID)
+		if check.IfNil(cacher) {
+			continue
+		}
+
+		for _, pooled := range getCacherData(cacher) {
+			pooled.cacheID = cacheID
+			result = append(result, pooled)
+		}
+	}
+
+	return result
+}
+
+func getCacherData(cacher storage.Cacher) []*pooledData {
+	keys := cacher.Keys()
+	result := make([]*pooledData, 0, len(keys))
+	for _, key := range keys {
+		value, found := cacher.Peek(key)
+		if !found {
+			continue
+		}
+
+		result = append(result, &pooledData{
+			key:   key,
+			value: value,
+		})
+	}
+
+	return result
+}
+
+func getPooledHeaders(headersPool dataRetriever.HeadersPool, allShardIDs []uint32) []*pooledHeader {
+	result := make([]*pooledHeader, 0, headersPool.Len())
+	for _, shardID := range allShardIDs {
+		for _, nonce := range headersPool.Nonces(shardID) {
+			headers, hashes, err := headersPool.GetHeadersByNonceAndShardId(nonce, shardID)
+			if err != nil {
+				continue
+			}
+
+			for idx := range headers {
+				result = append(result, &pooledHeader{
+					hash:   hashes[idx],
+					header: headers[idx],
+				})
+			}
+		}
+	}
+
+	return result
+}
+
+func setShardedPoolData(pool dataRetriever.ShardedDataCacherNotifier, pooledSlice []*pooledData, marshaller marshal.Marshalizer) error {
+	pool.Clear()
+	for _, pooled := range pooledSlice {
+		buff, err := marshaller.Marshal(pooled.value)
+		if err != nil {
+			return err
+		}
+
+		pool.AddData(pooled.key, pooled.value, len(buff), pooled.cacheID)
+	}
+
+	return nil
+}
+
+func setCacherData(cacher storage.Cacher, pooledSlice []*pooledData, marshaller marshal.Marshalizer) error {
+	cacher.Clear()
+	for _, pooled := range pooledSlice {
+		buff, err := marshaller.Marshal(pooled.value)
+		if err != nil {
+			return err
+		}
+
+		_ = cacher.Put(pooled.key, pooled.value, len(buff))
+	}
+
+	return nil
+}
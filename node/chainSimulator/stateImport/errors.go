@@ -0,0 +1,24 @@
+package stateImport
+
+import "errors"
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilAddressConverter signals that a nil address converter has been provided
+var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrNilEnableEpochsHandler signals that a nil enable epochs handler has been provided
+var ErrNilEnableEpochsHandler = errors.New("nil enable epochs handler")
+
+// ErrNoDBPaths signals that no database path has been provided
+var ErrNoDBPaths = errors.New("no database paths provided")
+
+// ErrEmptyRootHash signals that an empty root hash has been provided
+var ErrEmptyRootHash = errors.New("empty root hash")
+
+// ErrShardNotFoundInExport signals that the requested shard was not found in the hardfork export
+var ErrShardNotFoundInExport = errors.New("shard not found in the hardfork export")
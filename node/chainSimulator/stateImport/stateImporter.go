@@ -0,0 +1,482 @@
+package stateImport
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	commonDisabled "github.com/multiversx/mx-chain-go/common/disabled"
+	"github.com/multiversx/mx-chain-go/common/errChan"
+	disabledStatistics "github.com/multiversx/mx-chain-go/common/statistics/disabled"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+	"github.com/multiversx/mx-chain-go/state"
+	disabledState "github.com/multiversx/mx-chain-go/state/disabled"
+	"github.com/multiversx/mx-chain-go/state/factory"
+	"github.com/multiversx/mx-chain-go/state/parsers"
+	"github.com/multiversx/mx-chain-go/state/storagePruningManager/disabled"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/readonly"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/update/genesis"
+	"github.com/multiversx/mx-chain-go/update/storing"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const maxTrieLevelInMemory = uint(5)
+
+var log = logger.GetOrCreate("chainSimulator/stateImport")
+
+var esdtKeyPrefix = []byte(core.ProtectedKeyPrefix + core.ESDTKeyIdentifier)
+
+// ArgsStateImporter holds the arguments needed to create a new state importer
+type ArgsStateImporter struct {
+	Marshaller          marshal.Marshalizer
+	Hasher              hashing.Hasher
+	AddressConverter    core.PubkeyConverter
+	EnableEpochsHandler common.EnableEpochsHandler
+}
+
+type stateImporter struct {
+	marshaller          marshal.Marshalizer
+	hasher              hashing.Hasher
+	addressConverter    core.PubkeyConverter
+	enableEpochsHandler common.EnableEpochsHandler
+	accountFactory      state.AccountFactory
+}
+
+// NewStateImporter creates a component able to read accounts from the storage of a real node and convert them
+// into chain simulator address states
+func NewStateImporter(args ArgsStateImporter) (*stateImporter, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, ErrNilAddressConverter
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, ErrNilEnableEpochsHandler
+	}
+
+	accountFactory, err := factory.NewAccountCreator(factory.ArgsAccountCreator{
+		Hasher:              args.Hasher,
+		Marshaller:          args.Marshaller,
+		EnableEpochsHandler: args.EnableEpochsHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateImporter{
+		marshaller:          args.Marshaller,
+		hasher:              args.Hasher,
+		addressConverter:    args.AddressConverter,
+		enableEpochsHandler: args.EnableEpochsHandler,
+		accountFactory:      accountFactory,
+	}, nil
+}
+
+// ImportFromTrieDB reads the accounts found at the provided root hash from the accounts trie databases of a node.
+// As a node keeps the trie nodes in one database for each epoch, all the needed databases paths should be provided.
+// If no address is provided, all the accounts from the trie are imported
+func (si *stateImporter) ImportFromTrieDB(dbConfig config.DBConfig, dbPaths []string, rootHash []byte, addresses [][]byte) ([]*dtos.AddressState, error) {
+	if len(dbPaths) == 0 {
+		return nil, ErrNoDBPaths
+	}
+	if len(rootHash) == 0 {
+		return nil, ErrEmptyRootHash
+	}
+
+	storer, err := createReadOnlyStorer(dbConfig, dbPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	trieStorageManager, err := si.createTrieStorageManager(storer)
+	if err != nil {
+		log.LogIfError(storer.Close())
+		return nil, err
+	}
+	defer func() {
+		log.LogIfError(trieStorageManager.Close())
+	}()
+
+	accountsAdapter, err := si.createAccountsAdapter(trieStorageManager)
+	if err != nil {
+		return nil, err
+	}
+
+	err = accountsAdapter.RecreateTrie(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return si.importAccounts(accountsAdapter, addresses)
+}
+
+// ImportFromHardforkExport reads the accounts of the provided shard from the files written by the hardfork export process.
+// If no address is provided, all the accounts of the shard are imported
+func (si *stateImporter) ImportFromHardforkExport(hardforkConfig config.HardforkConfig, exportFolder string, shardID uint32, addresses [][]byte) ([]*dtos.AddressState, error) {
+	keysStorer, err := storageFactory.CreateStorerInFolder(hardforkConfig.ImportKeysStorageConfig, exportFolder)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating keys storer", err)
+	}
+	defer func() {
+		log.LogIfError(keysStorer.Close())
+	}()
+
+	keysVals, err := storageFactory.CreateStorerInFolder(hardforkConfig.ImportStateStorageConfig, exportFolder)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating keys-values storer", err)
+	}
+	defer func() {
+		log.LogIfError(keysVals.Close())
+	}()
+
+	hardforkStorer, err := storing.NewHardforkStorer(storing.ArgHardforkStorer{
+		KeysStore:   keysStorer,
+		KeyValue:    keysVals,
+		Marshalizer: si.marshaller,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating hardfork storer", err)
+	}
+
+	userTrieStorageManager, err := si.createTrieStorageManager(database.NewMemDB())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		log.LogIfError(userTrieStorageManager.Close())
+	}()
+
+	peerTrieStorageManager, err := si.createTrieStorageManager(database.NewMemDB())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		log.LogIfError(peerTrieStorageManager.Close())
+	}()
+
+	importHandler, err := genesis.NewStateImport(genesis.ArgsNewStateImport{
+		Hasher:        si.hasher,
+		Marshalizer:   si.marshaller,
+		ShardID:       shardID,
+		StorageConfig: hardforkConfig.ImportStateStorageConfig,
+		TrieStorageManagers: map[string]common.StorageManager{
+			dataRetriever.UserAccountsUnit.String(): userTrieStorageManager,
+			dataRetriever.PeerAccountsUnit.String(): peerTrieStorageManager,
+		},
+		HardforkStorer:      hardforkStorer,
+		AddressConverter:    si.addressConverter,
+		EnableEpochsHandler: si.enableEpochsHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = importHandler.ImportAll()
+	if err != nil {
+		return nil, err
+	}
+
+	accountsAdapter := importHandler.GetAccountsDBForShard(shardID)
+	if check.IfNil(accountsAdapter) {
+		return nil, fmt.Errorf("%w, shard %d", ErrShardNotFoundInExport, shardID)
+	}
+
+	return si.importAccounts(accountsAdapter, addresses)
+}
+
+func (si *stateImporter) importAccounts(accountsAdapter state.AccountsAdapter, addresses [][]byte) ([]*dtos.AddressState, error) {
+	var err error
+	if len(addresses) == 0 {
+		addresses, err = si.getAllAddresses(accountsAdapter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	addressStates := make([]*dtos.AddressState, 0, len(addresses)+1)
+	esdtKeys := make(map[string]struct{})
+	systemAccountImported := false
+	for _, address := range addresses {
+		account, errGet := getUserAccount(accountsAdapter, address)
+		if errGet != nil {
+			return nil, fmt.Errorf("%w for address %s", errGet, hex.EncodeToString(address))
+		}
+
+		addressState, errConvert := si.convertAccount(accountsAdapter, account)
+		if errConvert != nil {
+			return nil, fmt.Errorf("%w for address %s", errConvert, hex.EncodeToString(address))
+		}
+
+		addressStates = append(addressStates, addressState)
+		systemAccountImported = systemAccountImported || bytes.Equal(address, core.SystemAccountAddress)
+		for key := range addressState.Keys {
+			if strings.HasPrefix(key, hex.EncodeToString(esdtKeyPrefix)) {
+				esdtKeys[key] = struct{}{}
+			}
+		}
+	}
+
+	if systemAccountImported || len(esdtKeys) == 0 {
+		return addressStates, nil
+	}
+
+	systemAccountState, err := si.getSystemAccountESDTMetadata(accountsAdapter, esdtKeys)
+	if err != nil {
+		return nil, err
+	}
+	if len(systemAccountState.Keys) > 0 {
+		addressStates = append(addressStates, systemAccountState)
+	}
+
+	return addressStates, nil
+}
+
+func (si *stateImporter) getAllAddresses(accountsAdapter state.AccountsAdapter) ([][]byte, error) {
+	rootHash, err := accountsAdapter.RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	leavesChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err = accountsAdapter.GetAllLeaves(leavesChannels, context.Background(), rootHash, parsers.NewMainTrieLeafParser())
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([][]byte, 0)
+	codeHashes := make(map[string]struct{})
+	for leaf := range leavesChannels.LeavesChan {
+		account, errCreate := si.accountFactory.CreateAccount(leaf.Key())
+		if errCreate != nil {
+			continue
+		}
+
+		errUnmarshal := si.marshaller.Unmarshal(account, leaf.Value())
+		if errUnmarshal != nil {
+			continue
+		}
+
+		keys = append(keys, leaf.Key())
+		userAccount, ok := account.(state.UserAccountHandler)
+		if ok && len(userAccount.GetCodeHash()) > 0 {
+			codeHashes[string(userAccount.GetCodeHash())] = struct{}{}
+		}
+	}
+
+	err = leavesChannels.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+
+	// the main trie also holds the smart contracts code under the code hash, so those leaves are not accounts
+	addresses := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		_, isCodeLeaf := codeHashes[string(key)]
+		if isCodeLeaf || len(key) != si.addressConverter.Len() {
+			continue
+		}
+
+		addresses = append(addresses, key)
+	}
+
+	return addresses, nil
+}
+
+func (si *stateImporter) getSystemAccountESDTMetadata(accountsAdapter state.AccountsAdapter, esdtKeys map[string]struct{}) (*dtos.AddressState, error) {
+	systemAccountAddress, err := si.addressConverter.Encode(core.SystemAccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	addressState := &dtos.AddressState{
+		Address: systemAccountAddress,
+		Keys:    make(map[string]string),
+	}
+
+	systemAccount, err := getUserAccount(accountsAdapter, core.SystemAccountAddress)
+	if err != nil {
+		log.Debug("system account not found, the ESDT metadata will not be imported", "error", err)
+		return addressState, nil
+	}
+
+	for key := range esdtKeys {
+		keyBytes, errDecode := hex.DecodeString(key)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+
+		value, _, errRetrieve := systemAccount.RetrieveValue(keyBytes)
+		if errRetrieve != nil || len(value) == 0 {
+			continue
+		}
+
+		addressState.Keys[key] = hex.EncodeToString(value)
+	}
+
+	return addressState, nil
+}
+
+func (si *stateImporter) convertAccount(accountsAdapter state.AccountsAdapter, account state.UserAccountHandler) (*dtos.AddressState, error) {
+	address, err := si.addressConverter.Encode(account.AddressBytes())
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := getAllKeys(account)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := account.GetNonce()
+	addressState := &dtos.AddressState{
+		Address: address,
+		Nonce:   &nonce,
+		Balance: account.GetBalance().String(),
+		Keys:    keys,
+	}
+
+	if len(account.GetCodeHash()) == 0 {
+		return addressState, nil
+	}
+
+	addressState.Code = hex.EncodeToString(accountsAdapter.GetCode(account.GetCodeHash()))
+	addressState.CodeMetadata = base64.StdEncoding.EncodeToString(account.GetCodeMetadata())
+	addressState.DeveloperRewards = account.GetDeveloperReward().String()
+	if len(account.GetOwnerAddress()) > 0 {
+		addressState.Owner, err = si.addressConverter.Encode(account.GetOwnerAddress())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return addressState, nil
+}
+
+func getAllKeys(account state.UserAccountHandler) (map[string]string, error) {
+	keys := make(map[string]string)
+	if common.IsEmptyTrie(account.GetRootHash()) {
+		return keys, nil
+	}
+
+	leavesChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err := account.GetAllLeaves(leavesChannels, context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	for leaf := range leavesChannels.LeavesChan {
+		keys[hex.EncodeToString(leaf.Key())] = hex.EncodeToString(leaf.Value())
+	}
+
+	err = leavesChannels.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func getUserAccount(accountsAdapter state.AccountsAdapter, address []byte) (state.UserAccountHandler, error) {
+	account, err := accountsAdapter.GetExistingAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, state.ErrWrongTypeAssertion
+	}
+
+	return userAccount, nil
+}
+
+func (si *stateImporter) createTrieStorageManager(storer common.BaseStorer) (common.StorageManager, error) {
+	args := trie.NewTrieStorageManagerArgs{
+		MainStorer:  storer,
+		Marshalizer: si.marshaller,
+		Hasher:      si.hasher,
+		GeneralConfig: config.TrieStorageManagerConfig{
+			SnapshotsGoroutineNum: 1,
+		},
+		IdleProvider:   commonDisabled.NewProcessStatusHandler(),
+		Identifier:     dataRetriever.UserAccountsUnit.String(),
+		StatsCollector: disabledStatistics.NewStateStatistics(),
+	}
+	options := trie.StorageManagerOptions{
+		PruningEnabled:   false,
+		SnapshotsEnabled: false,
+	}
+
+	return trie.CreateTrieStorageManager(args, options)
+}
+
+func (si *stateImporter) createAccountsAdapter(trieStorageManager common.StorageManager) (state.AccountsAdapter, error) {
+	mainTrie, err := trie.NewTrie(trieStorageManager, si.marshaller, si.hasher, si.enableEpochsHandler, maxTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.NewAccountsDB(state.ArgsAccountsDB{
+		Trie:                  mainTrie,
+		Hasher:                si.hasher,
+		Marshaller:            si.marshaller,
+		AccountFactory:        si.accountFactory,
+		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
+		AddressConverter:      si.addressConverter,
+		SnapshotsManager:      disabledState.NewDisabledSnapshotsManager(),
+	})
+}
+
+func createReadOnlyStorer(dbConfig config.DBConfig, dbPaths []string) (common.BaseStorer, error) {
+	persisterFactory, err := storageFactory.NewPersisterFactory(storageFactory.NewDBConfigHandler(dbConfig))
+	if err != nil {
+		return nil, err
+	}
+
+	persisters := make([]readonly.Source, 0, len(dbPaths))
+	for _, dbPath := range dbPaths {
+		persister, errCreate := persisterFactory.Create(dbPath)
+		if errCreate != nil {
+			closePersisters(persisters)
+			return nil, fmt.Errorf("%w while opening %s", errCreate, dbPath)
+		}
+
+		persisters = append(persisters, persister)
+	}
+
+	return readonly.NewMultiSourceStorer(persisters)
+}
+
+func closePersisters(persisters []readonly.Source) {
+	for _, persister := range persisters {
+		log.LogIfError(persister.Close())
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (si *stateImporter) IsInterfaceNil() bool {
+	return si == nil
+}
@@ -0,0 +1,321 @@
+package stateImport
+
+import (
+	"encoding/hex"
+	"math/big"
+	"path"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/state"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/multiversx/mx-chain-go/update/genesis"
+	"github.com/multiversx/mx-chain-go/update/storing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const addressLen = 32
+
+func createMockArgsStateImporter() ArgsStateImporter {
+	return ArgsStateImporter{
+		Marshaller:          &marshallerMock.MarshalizerMock{},
+		Hasher:              &hashingMocks.HasherMock{},
+		AddressConverter:    testscommon.NewPubkeyConverterMock(addressLen),
+		EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+	}
+}
+
+func createTestDBConfig() config.DBConfig {
+	return config.DBConfig{
+		Type:              string(storageunit.LvlDBSerial),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+}
+
+func createAddress(lastByte byte) []byte {
+	address := make([]byte, addressLen)
+	address[addressLen-1] = lastByte
+
+	return address
+}
+
+// writeAccounts saves the provided accounts in a new database found at dbPath and returns the resulted root hash
+func writeAccounts(t *testing.T, si *stateImporter, dbPath string, accounts map[string]func(account state.UserAccountHandler)) []byte {
+	persisterFactory, err := storageFactory.NewPersisterFactory(storageFactory.NewDBConfigHandler(createTestDBConfig()))
+	require.Nil(t, err)
+	persister, err := persisterFactory.Create(dbPath)
+	require.Nil(t, err)
+
+	trieStorageManager, err := si.createTrieStorageManager(persister)
+	require.Nil(t, err)
+	defer func() {
+		_ = trieStorageManager.Close()
+	}()
+
+	accountsAdapter, err := si.createAccountsAdapter(trieStorageManager)
+	require.Nil(t, err)
+
+	for address, setter := range accounts {
+		account, errLoad := accountsAdapter.LoadAccount([]byte(address))
+		require.Nil(t, errLoad)
+
+		userAccount := account.(state.UserAccountHandler)
+		setter(userAccount)
+		require.Nil(t, accountsAdapter.SaveAccount(userAccount))
+	}
+
+	rootHash, err := accountsAdapter.Commit()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func TestNewStateImporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateImporter()
+		args.Marshaller = nil
+		si, err := NewStateImporter(args)
+		assert.Nil(t, si)
+		assert.Equal(t, ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateImporter()
+		args.Hasher = nil
+		si, err := NewStateImporter(args)
+		assert.Nil(t, si)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateImporter()
+		args.AddressConverter = nil
+		si, err := NewStateImporter(args)
+		assert.Nil(t, si)
+		assert.Equal(t, ErrNilAddressConverter, err)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStateImporter()
+		args.EnableEpochsHandler = nil
+		si, err := NewStateImporter(args)
+		assert.Nil(t, si)
+		assert.Equal(t, ErrNilEnableEpochsHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		si, err := NewStateImporter(createMockArgsStateImporter())
+		assert.Nil(t, err)
+		assert.False(t, si.IsInterfaceNil())
+	})
+}
+
+func TestStateImporter_ImportFromTrieDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no db paths should error", func(t *testing.T) {
+		t.Parallel()
+
+		si, _ := NewStateImporter(createMockArgsStateImporter())
+		addressStates, err := si.ImportFromTrieDB(createTestDBConfig(), nil, []byte("root hash"), nil)
+		assert.Nil(t, addressStates)
+		assert.Equal(t, ErrNoDBPaths, err)
+	})
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		si, _ := NewStateImporter(createMockArgsStateImporter())
+		addressStates, err := si.ImportFromTrieDB(createTestDBConfig(), []string{t.TempDir()}, nil, nil)
+		assert.Nil(t, addressStates)
+		assert.Equal(t, ErrEmptyRootHash, err)
+	})
+	t.Run("should import accounts, data tries, code and ESDT metadata", func(t *testing.T) {
+		t.Parallel()
+
+		si, _ := NewStateImporter(createMockArgsStateImporter())
+		userAddress := createAddress(1)
+		contractAddress := createAddress(2)
+		esdtKey := append(esdtKeyPrefix, []byte("TKN-123456")...)
+		code := []byte("contract code")
+		dbPath := path.Join(t.TempDir(), "AccountsTrie")
+		rootHash := writeAccounts(t, si, dbPath, map[string]func(account state.UserAccountHandler){
+			string(userAddress): func(account state.UserAccountHandler) {
+				account.IncreaseNonce(7)
+				_ = account.AddToBalance(big.NewInt(1000))
+				_ = account.SaveKeyValue(esdtKey, []byte("token data"))
+			},
+			string(contractAddress): func(account state.UserAccountHandler) {
+				account.SetCode(code)
+				account.SetOwnerAddress(userAddress)
+				_ = account.SaveKeyValue([]byte("key"), []byte("value"))
+			},
+			string(core.SystemAccountAddress): func(account state.UserAccountHandler) {
+				_ = account.SaveKeyValue(esdtKey, []byte("token metadata"))
+			},
+		})
+
+		addressStates, err := si.ImportFromTrieDB(createTestDBConfig(), []string{dbPath}, rootHash, [][]byte{userAddress, contractAddress})
+		require.Nil(t, err)
+		require.Equal(t, 3, len(addressStates))
+
+		userState := addressStates[0]
+		assert.Equal(t, hex.EncodeToString(userAddress), userState.Address)
+		assert.Equal(t, uint64(7), *userState.Nonce)
+		assert.Equal(t, "1000", userState.Balance)
+		assert.Equal(t, hex.EncodeToString([]byte("token data")), userState.Keys[hex.EncodeToString(esdtKey)])
+
+		contractState := addressStates[1]
+		assert.Equal(t, hex.EncodeToString(code), contractState.Code)
+		assert.Equal(t, hex.EncodeToString(userAddress), contractState.Owner)
+		assert.Equal(t, hex.EncodeToString([]byte("value")), contractState.Keys[hex.EncodeToString([]byte("key"))])
+
+		systemAccountState := addressStates[2]
+		assert.Equal(t, hex.EncodeToString(core.SystemAccountAddress), systemAccountState.Address)
+		assert.Equal(t, map[string]string{
+			hex.EncodeToString(esdtKey): hex.EncodeToString([]byte("token metadata")),
+		}, systemAccountState.Keys)
+	})
+	t.Run("no address should import the whole trie", func(t *testing.T) {
+		t.Parallel()
+
+		si, _ := NewStateImporter(createMockArgsStateImporter())
+		dbPath := path.Join(t.TempDir(), "AccountsTrie")
+		rootHash := writeAccounts(t, si, dbPath, map[string]func(account state.UserAccountHandler){
+			string(createAddress(1)): func(account state.UserAccountHandler) {
+				_ = account.AddToBalance(big.NewInt(1))
+			},
+			string(createAddress(2)): func(account state.UserAccountHandler) {
+				account.SetCode([]byte("contract code"))
+			},
+		})
+
+		addressStates, err := si.ImportFromTrieDB(createTestDBConfig(), []string{dbPath}, rootHash, nil)
+		require.Nil(t, err)
+		assert.Equal(t, 2, len(addressStates))
+	})
+}
+
+func createTestHardforkConfig() config.HardforkConfig {
+	createStorageConfig := func(filePath string) config.StorageConfig {
+		dbConfig := createTestDBConfig()
+		dbConfig.FilePath = filePath
+
+		return config.StorageConfig{
+			Cache: config.CacheConfig{
+				Type:     "LRU",
+				Capacity: 100,
+				Shards:   1,
+			},
+			DB: dbConfig,
+		}
+	}
+
+	return config.HardforkConfig{
+		ImportKeysStorageConfig:  createStorageConfig("ImportHardForkKeys"),
+		ImportStateStorageConfig: createStorageConfig("ImportHardForkKeysVals"),
+	}
+}
+
+// writeHardforkExport writes the provided accounts as the user accounts trie of the provided shard, in the format of
+// the hardfork export process
+func writeHardforkExport(t *testing.T, si *stateImporter, hardforkConfig config.HardforkConfig, exportFolder string, shardID uint32, accounts []state.UserAccountHandler) {
+	keysStorer, err := storageFactory.CreateStorerInFolder(hardforkConfig.ImportKeysStorageConfig, exportFolder)
+	require.Nil(t, err)
+	keysVals, err := storageFactory.CreateStorerInFolder(hardforkConfig.ImportStateStorageConfig, exportFolder)
+	require.Nil(t, err)
+
+	hardforkStorer, err := storing.NewHardforkStorer(storing.ArgHardforkStorer{
+		KeysStore:   keysStorer,
+		KeyValue:    keysVals,
+		Marshalizer: si.marshaller,
+	})
+	require.Nil(t, err)
+
+	trieKey := genesis.CreateTrieIdentifier(shardID, genesis.UserAccount)
+	identifier := genesis.TrieIdentifier + "@" + trieKey
+	err = hardforkStorer.Write(identifier, []byte(genesis.CreateRootHashKey(trieKey)), []byte("original root hash"))
+	require.Nil(t, err)
+
+	for _, account := range accounts {
+		marshalledAccount, errMarshal := si.marshaller.Marshal(account)
+		require.Nil(t, errMarshal)
+
+		accountKey := genesis.CreateAccountKey(genesis.UserAccount, shardID, account.AddressBytes())
+		err = hardforkStorer.Write(identifier, []byte(accountKey), marshalledAccount)
+		require.Nil(t, err)
+	}
+
+	require.Nil(t, hardforkStorer.FinishedIdentifier(identifier))
+	require.Nil(t, hardforkStorer.Close())
+}
+
+func TestStateImporter_ImportFromHardforkExport(t *testing.T) {
+	t.Parallel()
+
+	createUserAccount := func(si *stateImporter, address []byte, nonce uint64, balance int64) state.UserAccountHandler {
+		account, err := si.accountFactory.CreateAccount(address)
+		require.Nil(t, err)
+
+		userAccount := account.(state.UserAccountHandler)
+		userAccount.IncreaseNonce(nonce)
+		_ = userAccount.AddToBalance(big.NewInt(balance))
+
+		return userAccount
+	}
+
+	t.Run("missing shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		si, _ := NewStateImporter(createMockArgsStateImporter())
+		hardforkConfig := createTestHardforkConfig()
+		exportFolder := t.TempDir()
+		writeHardforkExport(t, si, hardforkConfig, exportFolder, 0, []state.UserAccountHandler{
+			createUserAccount(si, createAddress(1), 1, 10),
+		})
+
+		addressStates, err := si.ImportFromHardforkExport(hardforkConfig, exportFolder, 1, nil)
+		assert.Nil(t, addressStates)
+		assert.ErrorIs(t, err, ErrShardNotFoundInExport)
+	})
+	t.Run("should import the accounts of the shard", func(t *testing.T) {
+		t.Parallel()
+
+		si, _ := NewStateImporter(createMockArgsStateImporter())
+		hardforkConfig := createTestHardforkConfig()
+		exportFolder := t.TempDir()
+		userAddress := createAddress(1)
+		writeHardforkExport(t, si, hardforkConfig, exportFolder, 0, []state.UserAccountHandler{
+			createUserAccount(si, userAddress, 7, 1000),
+			createUserAccount(si, createAddress(2), 1, 10),
+		})
+
+		addressStates, err := si.ImportFromHardforkExport(hardforkConfig, exportFolder, 0, [][]byte{userAddress})
+		require.Nil(t, err)
+		require.Equal(t, 1, len(addressStates))
+		assert.Equal(t, hex.EncodeToString(userAddress), addressStates[0].Address)
+		assert.Equal(t, uint64(7), *addressStates[0].Nonce)
+		assert.Equal(t, "1000", addressStates[0].Balance)
+
+		// the export storers are released, so the same export can be imported again
+		addressStates, err = si.ImportFromHardforkExport(hardforkConfig, exportFolder, 0, nil)
+		require.Nil(t, err)
+		assert.Equal(t, 2, len(addressStates))
+	})
+}
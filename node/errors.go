@@ -138,3 +138,12 @@ var ErrNilStatusHandler = errors.New("nil status handler")
 
 // ErrNilCreateTransactionArgs signals that create transaction args is nil
 var ErrNilCreateTransactionArgs = errors.New("nil args for create transaction")
+
+// ErrEmptyRootHash signals that an empty root hash was found
+var ErrEmptyRootHash = errors.New("empty root hash")
+
+// ErrTrieStatisticsJobInProgress signals that the statistics of another trie are still being collected
+var ErrTrieStatisticsJobInProgress = errors.New("the statistics of another trie are still being collected")
+
+// ErrInvalidNonceRange signals that the start of a block nonces range is greater than its end
+var ErrInvalidNonceRange = errors.New("invalid block nonces range, the start nonce is greater than the end nonce")
@@ -1,6 +1,8 @@
 package node
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -54,3 +56,8 @@ func (n *Node) GetPendingAndActiveGuardians(
 ) (activeGuardian *api.Guardian, pendingGuardian *api.Guardian, err error) {
 	return n.getPendingAndActiveGuardians(userAccount)
 }
+
+// SetFailedTrieStatisticsJobRetention -
+func (n *Node) SetFailedTrieStatisticsJobRetention(retention time.Duration) {
+	n.failedTrieStatisticsJobRetention = retention
+}
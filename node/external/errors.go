@@ -44,5 +44,23 @@ var ErrNilGasScheduler = errors.New("nil gas scheduler")
 // ErrNilManagedPeersMonitor signals that a nil managed peers monitor has been provided
 var ErrNilManagedPeersMonitor = errors.New("nil managed peers monitor")
 
+// ErrNilManagedKeysUpdater signals that a nil managed keys updater has been provided
+var ErrNilManagedKeysUpdater = errors.New("nil managed keys updater")
+
+// ErrNilRedundancyLeaseHandler signals that a nil redundancy lease handler has been provided
+var ErrNilRedundancyLeaseHandler = errors.New("nil redundancy lease handler")
+
+// ErrRedundancyLeaseNotEnabled signals that the redundant instances of the node are not coordinated through a lease
+var ErrRedundancyLeaseNotEnabled = errors.New("the redundancy lease is not enabled")
+
 // ErrNilNodesCoordinator signals a nil nodes coordinator has been provided
 var ErrNilNodesCoordinator = errors.New("nil nodes coordinator")
+
+// ErrTransactionCannotBeTraced signals that the requested transaction is not a regular transaction and cannot be traced
+var ErrTransactionCannotBeTraced = errors.New("transaction cannot be traced")
+
+// ErrTransactionNotExecuted signals that the requested transaction was not yet included in a block
+var ErrTransactionNotExecuted = errors.New("transaction not executed")
+
+// ErrWrongTypeAssertion signals that a wrong type assertion occurred
+var ErrWrongTypeAssertion = errors.New("wrong type assertion")
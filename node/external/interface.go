@@ -3,9 +3,11 @@ package external
 import (
 	"context"
 
+	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -24,6 +26,7 @@ type StatusMetricsHandler interface {
 	StatusMetricsMapWithoutP2P() (map[string]interface{}, error)
 	StatusP2pMetricsMap() (map[string]interface{}, error)
 	StatusMetricsWithoutP2PPrometheusString() (string, error)
+	StatusMetricsPrometheusString(debugCounters []*debug.InterceptorResolverCounters) (string, error)
 	EconomicsMetrics() (map[string]interface{}, error)
 	ConfigMetrics() (map[string]interface{}, error)
 	EnableEpochsMetrics() (map[string]interface{}, error)
@@ -35,8 +38,10 @@ type StatusMetricsHandler interface {
 
 // TransactionEvaluator defines the actions which should be handler by a transaction evaluator
 type TransactionEvaluator interface {
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
-	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransactionExecution(tx *transaction.Transaction, blockHeader data.HeaderHandler, rootHashHolder common.RootHashHolder) (*txSimData.SimulationResultsWithVMOutput, error)
+	ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -61,10 +66,12 @@ type DelegatedListHandler interface {
 // APITransactionHandler defines what an API transaction handler should be able to do
 type APITransactionHandler interface {
 	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolSenderDetails(sender string, senderAccountNonce uint64) (*common.TransactionsPoolSenderDetailsApiResponse, error)
+	GetTransactionsPoolStatistics() (*common.TransactionsPoolStatisticsApiResponse, error)
 	UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	PopulateComputedFields(tx *transaction.ApiTransactionResult)
 	UnmarshalReceipt(receiptBytes []byte) (*transaction.ApiReceipt, error)
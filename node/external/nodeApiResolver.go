@@ -3,14 +3,17 @@ package external
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/genesis"
 	"github.com/multiversx/mx-chain-go/node/external/blockAPI"
 	"github.com/multiversx/mx-chain-go/process"
@@ -40,6 +43,8 @@ type ArgNodeApiResolver struct {
 	AccountsParser           genesis.AccountsParser
 	GasScheduleNotifier      common.GasScheduleNotifierAPI
 	ManagedPeersMonitor      common.ManagedPeersMonitor
+	ManagedKeysUpdater       common.ManagedKeysUpdater
+	RedundancyLeaseHandler   common.RedundancyLeaseHandler
 	PublicKey                string
 	NodesCoordinator         nodesCoordinator.NodesCoordinator
 	StorageManagers          []common.StorageManager
@@ -61,6 +66,8 @@ type nodeApiResolver struct {
 	accountsParser           genesis.AccountsParser
 	gasScheduleNotifier      common.GasScheduleNotifierAPI
 	managedPeersMonitor      common.ManagedPeersMonitor
+	managedKeysUpdater       common.ManagedKeysUpdater
+	redundancyLeaseHandler   common.RedundancyLeaseHandler
 	publicKey                string
 	nodesCoordinator         nodesCoordinator.NodesCoordinator
 	storageManagers          []common.StorageManager
@@ -110,6 +117,12 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.ManagedPeersMonitor) {
 		return nil, ErrNilManagedPeersMonitor
 	}
+	if check.IfNil(arg.ManagedKeysUpdater) {
+		return nil, ErrNilManagedKeysUpdater
+	}
+	if check.IfNil(arg.RedundancyLeaseHandler) {
+		return nil, ErrNilRedundancyLeaseHandler
+	}
 	if check.IfNil(arg.NodesCoordinator) {
 		return nil, ErrNilNodesCoordinator
 	}
@@ -129,6 +142,8 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		accountsParser:           arg.AccountsParser,
 		gasScheduleNotifier:      arg.GasScheduleNotifier,
 		managedPeersMonitor:      arg.ManagedPeersMonitor,
+		managedKeysUpdater:       arg.ManagedKeysUpdater,
+		redundancyLeaseHandler:   arg.RedundancyLeaseHandler,
 		publicKey:                arg.PublicKey,
 		nodesCoordinator:         arg.NodesCoordinator,
 		storageManagers:          arg.StorageManagers,
@@ -146,13 +161,89 @@ func (nar *nodeApiResolver) StatusMetrics() StatusMetricsHandler {
 }
 
 // ComputeTransactionGasLimit will calculate how many gas a transaction will consume
-func (nar *nodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
-	return nar.apiTransactionEvaluator.ComputeTransactionGasLimit(tx)
+func (nar *nodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error) {
+	return nar.apiTransactionEvaluator.ComputeTransactionGasLimit(tx, stateOverrides)
 }
 
 // SimulateTransactionExecution will simulate the provided transaction and return the simulation results
-func (nar *nodeApiResolver) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
-	return nar.apiTransactionEvaluator.SimulateTransactionExecution(tx)
+func (nar *nodeApiResolver) SimulateTransactionExecution(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
+	return nar.apiTransactionEvaluator.SimulateTransactionExecution(tx, stateOverrides, withTrace)
+}
+
+// SimulateTransactionsBundleExecution will simulate the provided transactions in order and return the simulation results
+func (nar *nodeApiResolver) SimulateTransactionsBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	return nar.apiTransactionEvaluator.SimulateTransactionsBundleExecution(txs)
+}
+
+// TraceTransaction will re-execute the transaction with the given hash on the state of the block preceding the one
+// that included it and will return the execution results along with the execution trace
+func (nar *nodeApiResolver) TraceTransaction(txHash string) (*txSimData.SimulationResultsWithVMOutput, error) {
+	apiTx, err := nar.apiTransactionHandler.GetTransaction(txHash, false)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, ok := apiTx.Tx.(*transaction.Transaction)
+	if !ok {
+		return nil, ErrTransactionCannotBeTraced
+	}
+	if len(apiTx.BlockHash) == 0 {
+		return nil, ErrTransactionNotExecuted
+	}
+
+	blockHash, err := hex.DecodeString(apiTx.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	apiBlock, err := nar.apiBlockHandler.GetBlockByHash(blockHash, api.BlockQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := nar.getInternalHeader(apiBlock.Shard, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	prevBlockHash, err := hex.DecodeString(apiBlock.PrevBlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	prevBlock, err := nar.apiBlockHandler.GetBlockByHash(prevBlockHash, api.BlockQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	prevRootHash, err := hex.DecodeString(prevBlock.StateRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHashHolder := holders.NewRootHashHolder(prevRootHash, core.OptionalUint32{Value: prevBlock.Epoch, HasValue: true})
+
+	return nar.apiTransactionEvaluator.TraceTransactionExecution(tx, header, rootHashHolder)
+}
+
+func (nar *nodeApiResolver) getInternalHeader(shardID uint32, blockHash []byte) (data.HeaderHandler, error) {
+	var internalBlock interface{}
+	var err error
+	if shardID == core.MetachainShardId {
+		internalBlock, err = nar.apiInternalBlockHandler.GetInternalMetaBlockByHash(common.ApiOutputFormatJSON, blockHash)
+	} else {
+		internalBlock, err = nar.apiInternalBlockHandler.GetInternalShardBlockByHash(common.ApiOutputFormatJSON, blockHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	header, ok := internalBlock.(data.HeaderHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return header, nil
 }
 
 // Close closes all underlying components
@@ -190,8 +281,8 @@ func (nar *nodeApiResolver) GetTransaction(hash string, withResults bool) (*tran
 }
 
 // GetTransactionsPool will return a structure containing the transactions pool that is to be returned on API calls
-func (nar *nodeApiResolver) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
-	return nar.apiTransactionHandler.GetTransactionsPool(fields)
+func (nar *nodeApiResolver) GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsPool(fields, filter)
 }
 
 // GetTransactionsPoolForSender will return a structure containing the transactions for sender that is to be returned on API calls
@@ -209,6 +300,16 @@ func (nar *nodeApiResolver) GetTransactionsPoolNonceGapsForSender(sender string,
 	return nar.apiTransactionHandler.GetTransactionsPoolNonceGapsForSender(sender, senderAccountNonce)
 }
 
+// GetTransactionsPoolSenderDetails will return the transactions, the nonce gaps and the stuck transactions from pool for sender, that is to be returned on API calls
+func (nar *nodeApiResolver) GetTransactionsPoolSenderDetails(sender string, senderAccountNonce uint64) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsPoolSenderDetails(sender, senderAccountNonce)
+}
+
+// GetTransactionsPoolStatistics will return the statistics of the transactions pool that is to be returned on API calls
+func (nar *nodeApiResolver) GetTransactionsPoolStatistics() (*common.TransactionsPoolStatisticsApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsPoolStatistics()
+}
+
 // GetBlockByHash will return the block with the given hash and optionally with transactions
 func (nar *nodeApiResolver) GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error) {
 	decodedHash, err := hex.DecodeString(hash)
@@ -397,6 +498,41 @@ func (nar *nodeApiResolver) GetWaitingManagedKeys() ([]string, error) {
 	return nar.parseKeys(waitingKeys), nil
 }
 
+// AddManagedKey adds a managed key while the node is running. The provided key is the hex encoded private key, or the
+// hex encoded public key if the node uses a remote signer. It returns the public key of the added key
+func (nar *nodeApiResolver) AddManagedKey(key string) (string, error) {
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return "", fmt.Errorf("%w for the provided key", err)
+	}
+
+	pkBytes, err := nar.managedKeysUpdater.AddManagedKey(keyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return nar.validatorPubKeyConverter.SilentEncode(pkBytes, log), nil
+}
+
+// RemoveManagedKey removes a managed key while the node is running
+func (nar *nodeApiResolver) RemoveManagedKey(publicKey string) error {
+	pkBytes, err := nar.validatorPubKeyConverter.Decode(publicKey)
+	if err != nil {
+		return err
+	}
+
+	return nar.managedKeysUpdater.RemoveManagedKey(pkBytes)
+}
+
+// StepDownRedundancyLease releases the redundancy lease held by the node, so another instance can take over the signing
+func (nar *nodeApiResolver) StepDownRedundancyLease() error {
+	if !nar.redundancyLeaseHandler.IsEnabled() {
+		return ErrRedundancyLeaseNotEnabled
+	}
+
+	return nar.redundancyLeaseHandler.StepDown()
+}
+
 func (nar *nodeApiResolver) parseKeys(keys [][]byte) []string {
 	keysSlice := make([]string, len(keys))
 	for i, key := range keys {
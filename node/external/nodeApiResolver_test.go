@@ -9,7 +9,10 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	coreData "github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/genesis"
@@ -17,6 +20,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/mock"
 	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/genesisMocks"
@@ -44,6 +48,8 @@ func createMockArgs() external.ArgNodeApiResolver {
 		AccountsParser:           &genesisMocks.AccountsParserStub{},
 		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
 		ManagedPeersMonitor:      &testscommon.ManagedPeersMonitorStub{},
+		ManagedKeysUpdater:       &testscommon.ManagedKeysUpdaterStub{},
+		RedundancyLeaseHandler:   &testscommon.RedundancyLeaseHandlerStub{},
 		NodesCoordinator:         &shardingMocks.NodesCoordinatorStub{},
 	}
 }
@@ -136,6 +142,28 @@ func TestNewNodeApiResolver_NilNodesCoordinator(t *testing.T) {
 	assert.Equal(t, external.ErrNilNodesCoordinator, err)
 }
 
+func TestNewNodeApiResolver_NilManagedKeysUpdater(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.ManagedKeysUpdater = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilManagedKeysUpdater, err)
+}
+
+func TestNewNodeApiResolver_NilRedundancyLeaseHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.RedundancyLeaseHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilRedundancyLeaseHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -373,6 +401,140 @@ func TestNodeApiResolver_APITransactionHandler(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_TraceTransaction(t *testing.T) {
+	t.Parallel()
+
+	txHash := "aaaa"
+	blockHash := []byte("block hash")
+	prevBlockHash := []byte("prev block hash")
+	prevRootHash := []byte("prev root hash")
+	tx := &transaction.Transaction{Nonce: 7}
+	header := &block.Header{Nonce: 10}
+	createArgsForTrace := func() external.ArgNodeApiResolver {
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				require.Equal(t, txHash, hash)
+				return &transaction.ApiTransactionResult{
+					Tx:        tx,
+					BlockHash: hex.EncodeToString(blockHash),
+				}, nil
+			},
+		}
+		arg.APIBlockHandler = &mock.BlockAPIHandlerStub{
+			GetBlockByHashCalled: func(hash []byte, options api.BlockQueryOptions) (*api.Block, error) {
+				if bytes.Equal(hash, blockHash) {
+					return &api.Block{
+						Shard:         0,
+						Epoch:         3,
+						PrevBlockHash: hex.EncodeToString(prevBlockHash),
+					}, nil
+				}
+
+				require.Equal(t, prevBlockHash, hash)
+				return &api.Block{
+					Epoch:         2,
+					StateRootHash: hex.EncodeToString(prevRootHash),
+				}, nil
+			},
+		}
+		arg.APIInternalBlockHandler = &mock.InternalBlockApiHandlerStub{
+			GetInternalShardBlockByHashCalled: func(format common.ApiOutputFormat, hash []byte) (interface{}, error) {
+				require.Equal(t, blockHash, hash)
+				return header, nil
+			},
+		}
+
+		return arg
+	}
+
+	t.Run("get transaction error should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createArgsForTrace()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return nil, expectedErr
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(arg)
+
+		res, err := nar.TraceTransaction(txHash)
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("not a regular transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createArgsForTrace()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return &transaction.ApiTransactionResult{
+					Tx:        &smartContractResult.SmartContractResult{},
+					BlockHash: hex.EncodeToString(blockHash),
+				}, nil
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(arg)
+
+		res, err := nar.TraceTransaction(txHash)
+		require.Nil(t, res)
+		require.Equal(t, external.ErrTransactionCannotBeTraced, err)
+	})
+	t.Run("transaction not executed should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createArgsForTrace()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return &transaction.ApiTransactionResult{Tx: tx}, nil
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(arg)
+
+		res, err := nar.TraceTransaction(txHash)
+		require.Nil(t, res)
+		require.Equal(t, external.ErrTransactionNotExecuted, err)
+	})
+	t.Run("get internal block error should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createArgsForTrace()
+		arg.APIInternalBlockHandler = &mock.InternalBlockApiHandlerStub{
+			GetInternalShardBlockByHashCalled: func(format common.ApiOutputFormat, hash []byte) (interface{}, error) {
+				return nil, expectedErr
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(arg)
+
+		res, err := nar.TraceTransaction(txHash)
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should trace on the state of the previous block", func(t *testing.T) {
+		t.Parallel()
+
+		expectedResults := &txSimData.SimulationResultsWithVMOutput{
+			Trace: []*txSimData.ExecutionFrame{{Function: "function"}},
+		}
+		arg := createArgsForTrace()
+		arg.APITransactionEvaluator = &mock.TransactionCostEstimatorMock{
+			TraceTransactionExecutionCalled: func(providedTx *transaction.Transaction, blockHeader coreData.HeaderHandler, rootHashHolder common.RootHashHolder) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, tx, providedTx)
+				require.Equal(t, header, blockHeader)
+				require.Equal(t, prevRootHash, rootHashHolder.GetRootHash())
+				require.Equal(t, core.OptionalUint32{Value: 2, HasValue: true}, rootHashHolder.GetEpoch())
+				return expectedResults, nil
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(arg)
+
+		res, err := nar.TraceTransaction(txHash)
+		require.Nil(t, err)
+		require.Equal(t, expectedResults, res)
+	})
+}
+
 func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 	t.Parallel()
 
@@ -382,13 +544,13 @@ func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 		expectedErr := errors.New("expected error")
 		arg := createMockArgs()
 		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return nil, expectedErr
 			},
 		}
 
 		nar, _ := external.NewNodeApiResolver(arg)
-		res, err := nar.GetTransactionsPool("")
+		res, err := nar.GetTransactionsPool("", common.TransactionsPoolFilter{})
 		require.Nil(t, res)
 		require.Equal(t, expectedErr, err)
 	})
@@ -421,13 +583,13 @@ func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 		}
 		arg := createMockArgs()
 		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 				return expectedTxsPool, nil
 			},
 		}
 
 		nar, _ := external.NewNodeApiResolver(arg)
-		res, err := nar.GetTransactionsPool("")
+		res, err := nar.GetTransactionsPool("", common.TransactionsPoolFilter{})
 		require.NoError(t, err)
 		require.Equal(t, expectedTxsPool, res)
 	})
@@ -578,6 +740,52 @@ func TestNodeApiResolver_GetTransactionsPoolNonceGapsForSender(t *testing.T) {
 	})
 }
 
+func TestNodeApiResolver_GetTransactionsPoolSenderDetails(t *testing.T) {
+	t.Parallel()
+
+	expectedDetails := &common.TransactionsPoolSenderDetailsApiResponse{
+		Sender:       "alice",
+		AccountNonce: 7,
+	}
+	arg := createMockArgs()
+	arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		GetTransactionsPoolSenderDetailsCalled: func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+			require.Equal(t, "alice", sender)
+			require.Equal(t, uint64(7), senderAccountNonce)
+			return expectedDetails, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	res, err := nar.GetTransactionsPoolSenderDetails("alice", 7)
+	require.NoError(t, err)
+	require.Equal(t, expectedDetails, res)
+}
+
+func TestNodeApiResolver_GetTransactionsPoolStatistics(t *testing.T) {
+	t.Parallel()
+
+	expectedStatistics := &common.TransactionsPoolStatisticsApiResponse{
+		Caches: []common.TransactionsPoolCacheStatisticsApiResponse{
+			{
+				CacheID:         "0",
+				NumTransactions: 1,
+			},
+		},
+	}
+	arg := createMockArgs()
+	arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		GetTransactionsPoolStatisticsCalled: func() (*common.TransactionsPoolStatisticsApiResponse, error) {
+			return expectedStatistics, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	res, err := nar.GetTransactionsPoolStatistics()
+	require.NoError(t, err)
+	require.Equal(t, expectedStatistics, res)
+}
+
 func TestNodeApiResolver_GetGenesisNodesPubKeys(t *testing.T) {
 	t.Parallel()
 
@@ -935,6 +1143,156 @@ func TestNodeApiResolver_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 	})
 }
 
+func TestNodeApiResolver_AddManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ManagedKeysUpdater = &testscommon.ManagedKeysUpdaterStub{
+			AddManagedKeyCalled: func(keyBytes []byte) ([]byte, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		nar, err := external.NewNodeApiResolver(args)
+		require.NoError(t, err)
+
+		publicKey, err := nar.AddManagedKey("not hex")
+		require.Error(t, err)
+		require.Empty(t, publicKey)
+	})
+	t.Run("updater error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ManagedKeysUpdater = &testscommon.ManagedKeysUpdaterStub{
+			AddManagedKeyCalled: func(keyBytes []byte) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		nar, err := external.NewNodeApiResolver(args)
+		require.NoError(t, err)
+
+		publicKey, err := nar.AddManagedKey("abcd")
+		require.Equal(t, expectedErr, err)
+		require.Empty(t, publicKey)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedKey, _ := hex.DecodeString("abcd")
+		args := createMockArgs()
+		args.ManagedKeysUpdater = &testscommon.ManagedKeysUpdaterStub{
+			AddManagedKeyCalled: func(keyBytes []byte) ([]byte, error) {
+				require.Equal(t, providedKey, keyBytes)
+				return []byte("pk"), nil
+			},
+		}
+		args.ValidatorPubKeyConverter = &testscommon.PubkeyConverterStub{
+			SilentEncodeCalled: func(pkBytes []byte, log core.Logger) string {
+				return string(pkBytes)
+			},
+		}
+		nar, err := external.NewNodeApiResolver(args)
+		require.NoError(t, err)
+
+		publicKey, err := nar.AddManagedKey("abcd")
+		require.NoError(t, err)
+		require.Equal(t, "pk", publicKey)
+	})
+}
+
+func TestNodeApiResolver_RemoveManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ValidatorPubKeyConverter = &testscommon.PubkeyConverterStub{
+			DecodeCalled: func(humanReadable string) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		args.ManagedKeysUpdater = &testscommon.ManagedKeysUpdaterStub{
+			RemoveManagedKeyCalled: func(pkBytes []byte) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		nar, err := external.NewNodeApiResolver(args)
+		require.NoError(t, err)
+
+		err = nar.RemoveManagedKey("pk")
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockArgs()
+		args.ValidatorPubKeyConverter = &testscommon.PubkeyConverterStub{
+			DecodeCalled: func(humanReadable string) ([]byte, error) {
+				return []byte(humanReadable), nil
+			},
+		}
+		args.ManagedKeysUpdater = &testscommon.ManagedKeysUpdaterStub{
+			RemoveManagedKeyCalled: func(pkBytes []byte) error {
+				require.Equal(t, []byte("pk"), pkBytes)
+				wasCalled = true
+				return nil
+			},
+		}
+		nar, err := external.NewNodeApiResolver(args)
+		require.NoError(t, err)
+
+		err = nar.RemoveManagedKey("pk")
+		require.NoError(t, err)
+		require.True(t, wasCalled)
+	})
+}
+
+func TestNodeApiResolver_StepDownRedundancyLease(t *testing.T) {
+	t.Parallel()
+
+	t.Run("lease not enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.RedundancyLeaseHandler = &testscommon.RedundancyLeaseHandlerStub{
+			StepDownCalled: func() error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		nar, err := external.NewNodeApiResolver(args)
+		require.NoError(t, err)
+
+		err = nar.StepDownRedundancyLease()
+		require.Equal(t, external.ErrRedundancyLeaseNotEnabled, err)
+	})
+	t.Run("should step down", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.RedundancyLeaseHandler = &testscommon.RedundancyLeaseHandlerStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			StepDownCalled: func() error {
+				return expectedErr
+			},
+		}
+		nar, err := external.NewNodeApiResolver(args)
+		require.NoError(t, err)
+
+		err = nar.StepDownRedundancyLease()
+		require.Equal(t, expectedErr, err)
+	})
+}
+
 func TestNodeApiResolver_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
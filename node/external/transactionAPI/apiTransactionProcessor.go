@@ -159,22 +159,26 @@ func (atp *apiTransactionProcessor) populateComputedFieldIsRefund(tx *transactio
 }
 
 // GetTransactionsPool will return a structure containing the transactions pool fields that is to be returned on API calls
-func (atp *apiTransactionProcessor) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
+func (atp *apiTransactionProcessor) GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
+	requestedPoolFilter, err := newPoolFilter(filter, atp.addressPubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
 	transactions := &common.TransactionsPoolAPIResponse{}
 	requestedFieldsHandler := newFieldsHandler(fields)
 
-	var err error
-	transactions.RegularTransactions, err = atp.getRegularTransactionsFromPool(requestedFieldsHandler)
+	transactions.RegularTransactions, err = atp.getRegularTransactionsFromPool(requestedFieldsHandler, requestedPoolFilter)
 	if err != nil {
 		return nil, err
 	}
 
-	transactions.Rewards, err = atp.getRewardTransactionsFromPool(requestedFieldsHandler)
+	transactions.Rewards, err = atp.getRewardTransactionsFromPool(requestedFieldsHandler, requestedPoolFilter)
 	if err != nil {
 		return nil, err
 	}
 
-	transactions.SmartContractResults = atp.getUnsignedTransactionsFromPool(requestedFieldsHandler)
+	transactions.SmartContractResults = atp.getUnsignedTransactionsFromPool(requestedFieldsHandler, requestedPoolFilter)
 
 	return transactions, nil
 }
@@ -243,6 +247,59 @@ func (atp *apiTransactionProcessor) GetTransactionsPoolNonceGapsForSender(sender
 	}, nil
 }
 
+// GetTransactionsPoolSenderDetails will return the transactions from pool for sender, together with the nonce gaps and the
+// transactions which are stuck behind the first gap, that is to be returned on API calls
+func (atp *apiTransactionProcessor) GetTransactionsPoolSenderDetails(sender string, senderAccountNonce uint64) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+	senderAddr, err := atp.addressPubKeyConverter.Decode(sender)
+	if err != nil {
+		return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
+	}
+
+	senderShard := atp.shardCoordinator.ComputeId(senderAddr)
+	wrappedTxs := atp.fetchTxsForSender(string(senderAddr), senderShard)
+	nonceGaps := atp.computeNonceGaps(wrappedTxs, senderShard, senderAccountNonce)
+
+	requestedFieldsHandler := fieldsHandler{
+		HasNonce:    true,
+		HasReceiver: true,
+		HasGasLimit: true,
+		HasGasPrice: true,
+		HasData:     true,
+		HasValue:    true,
+	}
+	details := &common.TransactionsPoolSenderDetailsApiResponse{
+		Sender:            sender,
+		AccountNonce:      senderAccountNonce,
+		NumTransactions:   len(wrappedTxs),
+		Gaps:              nonceGaps,
+		StuckTransactions: make([]string, 0),
+		Transactions:      make([]common.Transaction, 0, len(wrappedTxs)),
+	}
+	for _, wrappedTx := range wrappedTxs {
+		details.Transactions = append(details.Transactions, atp.extractRequestedTxInfo(wrappedTx, requestedFieldsHandler))
+
+		if len(nonceGaps) > 0 && wrappedTx.Tx.GetNonce() > nonceGaps[0].To {
+			details.StuckTransactions = append(details.StuckTransactions, hex.EncodeToString(wrappedTx.TxHash))
+		}
+	}
+
+	return details, nil
+}
+
+// GetTransactionsPoolStatistics will return the number of transactions, the number of senders and the gas price
+// distribution for each cache of the regular transactions pool, together with the most recent evictions
+func (atp *apiTransactionProcessor) GetTransactionsPoolStatistics() (*common.TransactionsPoolStatisticsApiResponse, error) {
+	inspector, ok := atp.dataPool.Transactions().(transactionsPoolInspector)
+	if !ok {
+		return nil, ErrTransactionsPoolInspectionNotSupported
+	}
+
+	return &common.TransactionsPoolStatisticsApiResponse{
+		Caches:    collectCachesStatistics(inspector),
+		Evictions: inspector.GetEvictionHistory(),
+	}, nil
+}
+
 func (atp *apiTransactionProcessor) extractRequestedTxInfoFromObj(txObj interface{}, txType transaction.TxType, txHash []byte, requestedFieldsHandler fieldsHandler) common.Transaction {
 	txResult := atp.getApiResultFromObj(txObj, txType)
 
@@ -256,52 +313,52 @@ func (atp *apiTransactionProcessor) extractRequestedTxInfoFromObj(txObj interfac
 	return requestedTxInfo
 }
 
-func (atp *apiTransactionProcessor) getRegularTransactionsFromPool(requestedFieldsHandler fieldsHandler) ([]common.Transaction, error) {
+func (atp *apiTransactionProcessor) getRegularTransactionsFromPool(requestedFieldsHandler fieldsHandler, requestedPoolFilter *poolFilter) ([]common.Transaction, error) {
 	regularTxKeys := atp.dataPool.Transactions().Keys()
-	regularTxs := make([]common.Transaction, len(regularTxKeys))
-	for idx, key := range regularTxKeys {
+	regularTxs := make([]common.Transaction, 0, len(regularTxKeys))
+	for _, key := range regularTxKeys {
 		txObj, found := atp.getRegularTxObjFromDataPool(key)
-		if !found {
+		if !found || !requestedPoolFilter.matches(txObj) {
 			continue
 		}
 
 		tx := atp.extractRequestedTxInfoFromObj(txObj, transaction.TxTypeNormal, key, requestedFieldsHandler)
 
-		regularTxs[idx] = tx
+		regularTxs = append(regularTxs, tx)
 	}
 
 	return regularTxs, nil
 }
 
-func (atp *apiTransactionProcessor) getRewardTransactionsFromPool(requestedFieldsHandler fieldsHandler) ([]common.Transaction, error) {
+func (atp *apiTransactionProcessor) getRewardTransactionsFromPool(requestedFieldsHandler fieldsHandler, requestedPoolFilter *poolFilter) ([]common.Transaction, error) {
 	rewardTxKeys := atp.dataPool.RewardTransactions().Keys()
-	rewardTxs := make([]common.Transaction, len(rewardTxKeys))
-	for idx, key := range rewardTxKeys {
+	rewardTxs := make([]common.Transaction, 0, len(rewardTxKeys))
+	for _, key := range rewardTxKeys {
 		txObj, found := atp.getRewardTxObjFromDataPool(key)
-		if !found {
+		if !found || !requestedPoolFilter.matches(txObj) {
 			continue
 		}
 
 		tx := atp.extractRequestedTxInfoFromObj(txObj, transaction.TxTypeReward, key, requestedFieldsHandler)
 
-		rewardTxs[idx] = tx
+		rewardTxs = append(rewardTxs, tx)
 	}
 
 	return rewardTxs, nil
 }
 
-func (atp *apiTransactionProcessor) getUnsignedTransactionsFromPool(requestedFieldsHandler fieldsHandler) []common.Transaction {
+func (atp *apiTransactionProcessor) getUnsignedTransactionsFromPool(requestedFieldsHandler fieldsHandler, requestedPoolFilter *poolFilter) []common.Transaction {
 	unsignedTxKeys := atp.dataPool.UnsignedTransactions().Keys()
-	unsignedTxs := make([]common.Transaction, len(unsignedTxKeys))
-	for idx, key := range unsignedTxKeys {
+	unsignedTxs := make([]common.Transaction, 0, len(unsignedTxKeys))
+	for _, key := range unsignedTxKeys {
 		txObj, found := atp.getUnsignedTxObjFromDataPool(key)
-		if !found {
+		if !found || !requestedPoolFilter.matches(txObj) {
 			continue
 		}
 
 		tx := atp.extractRequestedTxInfoFromObj(txObj, transaction.TxTypeUnsigned, key, requestedFieldsHandler)
 
-		unsignedTxs[idx] = tx
+		unsignedTxs = append(unsignedTxs, tx)
 	}
 
 	return unsignedTxs
@@ -348,7 +405,7 @@ func (atp *apiTransactionProcessor) extractRequestedTxInfo(wrappedTx *txcache.Wr
 func (atp *apiTransactionProcessor) fetchTxsForSender(sender string, senderShard uint32) []*txcache.WrappedTransaction {
 	cacheId := process.ShardCacherIdentifier(senderShard, senderShard)
 	cache := atp.dataPool.Transactions().ShardDataStore(cacheId)
-	txCache, ok := cache.(*txcache.TxCache)
+	txCache, ok := cache.(transactionsPoolForSenderProvider)
 	if !ok {
 		log.Warn("fetchTxsForSender could not cast to TxCache")
 		return nil
@@ -375,8 +432,13 @@ func (atp *apiTransactionProcessor) fetchLastNonceForSender(sender string, sende
 
 func (atp *apiTransactionProcessor) extractNonceGaps(sender string, senderShard uint32, senderAccountNonce uint64) ([]common.NonceGapApiResponse, error) {
 	wrappedTxs := atp.fetchTxsForSender(sender, senderShard)
+
+	return atp.computeNonceGaps(wrappedTxs, senderShard, senderAccountNonce), nil
+}
+
+func (atp *apiTransactionProcessor) computeNonceGaps(wrappedTxs []*txcache.WrappedTransaction, senderShard uint32, senderAccountNonce uint64) []common.NonceGapApiResponse {
 	if len(wrappedTxs) == 0 {
-		return []common.NonceGapApiResponse{}, nil
+		return []common.NonceGapApiResponse{}
 	}
 
 	nonceGaps := make([]common.NonceGapApiResponse, 0)
@@ -396,7 +458,7 @@ func (atp *apiTransactionProcessor) extractNonceGaps(sender string, senderShard
 		}
 	}
 
-	return nonceGaps, nil
+	return nonceGaps
 }
 
 func (atp *apiTransactionProcessor) appendGapFromAccountNonceIfNeeded(
@@ -23,11 +23,13 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dataRetriever/txpool"
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	"github.com/multiversx/mx-chain-go/node/mock"
 	"github.com/multiversx/mx-chain-go/process"
 	processMocks "github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	dataRetrieverMock "github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
@@ -708,7 +710,7 @@ func TestApiTransactionProcessor_GetTransactionsPool(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, atp)
 
-	res, err := atp.GetTransactionsPool("")
+	res, err := atp.GetTransactionsPool("", common.TransactionsPoolFilter{})
 	require.NoError(t, err)
 
 	regularTxs := []common.Transaction{
@@ -744,6 +746,116 @@ func TestApiTransactionProcessor_GetTransactionsPool(t *testing.T) {
 	require.Equal(t, rewardTxs, res.Rewards)
 }
 
+func TestApiTransactionProcessor_GetTransactionsPoolWithFilter(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+
+	txHash0, txHash1, txHash2 := []byte("txHash0"), []byte("txHash1"), []byte("txHash2")
+	txsInPool := map[string]*transaction.Transaction{
+		string(txHash0): {RcvAddr: []byte("bob"), Data: []byte("claim@01")},
+		string(txHash1): {RcvAddr: []byte("bob"), Data: []byte("delegate")},
+		string(txHash2): {RcvAddr: []byte("carol"), Data: []byte("claim")},
+	}
+	args := createMockArgAPITransactionProcessor()
+	args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{
+				KeysCalled: func() [][]byte {
+					return [][]byte{txHash0, txHash1, txHash2}
+				},
+				SearchFirstDataCalled: func(key []byte) (value interface{}, ok bool) {
+					return txsInPool[string(key)], true
+				},
+			}
+		},
+		UnsignedTransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{
+				KeysCalled: func() [][]byte {
+					return [][]byte{[]byte("scrHash")}
+				},
+				SearchFirstDataCalled: func(key []byte) (value interface{}, ok bool) {
+					return &smartContractResult.SmartContractResult{RcvAddr: []byte("dave")}, true
+				},
+			}
+		},
+		RewardTransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{
+				KeysCalled: func() [][]byte {
+					return [][]byte{[]byte("rwdHash")}
+				},
+				SearchFirstDataCalled: func(key []byte) (value interface{}, ok bool) {
+					return &rewardTx.RewardTx{RcvAddr: []byte("bob")}, true
+				},
+			}
+		},
+	}
+	args.AddressPubKeyConverter = &testscommon.PubkeyConverterStub{
+		DecodeCalled: func(humanReadable string) ([]byte, error) {
+			if humanReadable == "invalid" {
+				return nil, expectedErr
+			}
+
+			return []byte(humanReadable), nil
+		},
+		EncodeCalled: func(pkBytes []byte) (string, error) {
+			return string(pkBytes), nil
+		},
+	}
+	args.ShardCoordinator = &processMocks.ShardCoordinatorStub{
+		NumberOfShardsCalled: func() uint32 {
+			return 1
+		},
+	}
+	atp, err := NewAPITransactionProcessor(args)
+	require.NoError(t, err)
+
+	extractHashes := func(txs []common.Transaction) []string {
+		hashes := make([]string, 0, len(txs))
+		for _, tx := range txs {
+			hashes = append(hashes, tx.TxFields[hashField].(string))
+		}
+
+		return hashes
+	}
+
+	t.Run("invalid receiver should error", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := atp.GetTransactionsPool("", common.TransactionsPoolFilter{Receiver: "invalid"})
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, expectedErr))
+		require.True(t, strings.Contains(err.Error(), ErrInvalidAddress.Error()))
+	})
+	t.Run("filter by receiver", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := atp.GetTransactionsPool("", common.TransactionsPoolFilter{Receiver: "bob"})
+		require.NoError(t, err)
+		require.Equal(t, []string{hex.EncodeToString(txHash0), hex.EncodeToString(txHash1)}, extractHashes(res.RegularTransactions))
+		require.Equal(t, []string{hex.EncodeToString([]byte("rwdHash"))}, extractHashes(res.Rewards))
+		require.Empty(t, res.SmartContractResults)
+	})
+	t.Run("filter by function", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := atp.GetTransactionsPool("", common.TransactionsPoolFilter{Function: "claim"})
+		require.NoError(t, err)
+		require.Equal(t, []string{hex.EncodeToString(txHash0), hex.EncodeToString(txHash2)}, extractHashes(res.RegularTransactions))
+		require.Empty(t, res.Rewards)
+		require.Empty(t, res.SmartContractResults)
+	})
+	t.Run("filter by receiver and function", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := atp.GetTransactionsPool("", common.TransactionsPoolFilter{Receiver: "bob", Function: "claim"})
+		require.NoError(t, err)
+		require.Equal(t, []string{hex.EncodeToString(txHash0)}, extractHashes(res.RegularTransactions))
+		require.Empty(t, res.Rewards)
+		require.Empty(t, res.SmartContractResults)
+	})
+}
+
 func createTx(hash []byte, sender string, nonce uint64) *txcache.WrappedTransaction {
 	tx := &transaction.Transaction{
 		SndAddr: []byte(sender),
@@ -998,6 +1110,194 @@ func TestApiTransactionProcessor_GetTransactionsPoolNonceGapsForSender(t *testin
 	}, res)
 }
 
+func TestApiTransactionProcessor_GetTransactionsPoolSenderDetails(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+
+	txHash1, txHash2, txHash3, txHash4 := []byte("txHash1"), []byte("txHash2"), []byte("txHash3"), []byte("txHash4")
+	sender := "alice"
+	txCacheIntraShard, _ := txcache.NewTxCache(txcache.ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  4,
+		NumBytesPerSenderThreshold: 1_048_576, // 1 MB
+		CountPerSenderThreshold:    math.MaxUint32,
+	}, &txcachemocks.TxGasHandlerMock{
+		MinimumGasMove:       1,
+		MinimumGasPrice:      1,
+		GasProcessingDivisor: 1,
+	})
+
+	accountNonce := uint64(20)
+	// expected nonce gap: 23-24, txHash3 and txHash4 being stuck behind it
+	txCacheIntraShard.AddTx(createTx(txHash1, sender, 20))
+	txCacheIntraShard.AddTx(createTx(txHash2, sender, 22))
+	txCacheIntraShard.AddTx(createTx(txHash3, sender, 21))
+	txCacheIntraShard.AddTx(createTx(txHash4, sender, 25))
+	txCacheIntraShard.AddTx(createTx([]byte("txHash5"), sender, 26))
+
+	args := createMockArgAPITransactionProcessor()
+	args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{
+				ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+					return txCacheIntraShard
+				},
+			}
+		},
+	}
+	args.AddressPubKeyConverter = &testscommon.PubkeyConverterStub{
+		DecodeCalled: func(humanReadable string) ([]byte, error) {
+			if humanReadable == "invalid" {
+				return nil, expectedErr
+			}
+
+			return []byte(humanReadable), nil
+		},
+		EncodeCalled: func(pkBytes []byte) (string, error) {
+			return string(pkBytes), nil
+		},
+	}
+	args.ShardCoordinator = &processMocks.ShardCoordinatorStub{
+		NumberOfShardsCalled: func() uint32 {
+			return 1
+		},
+	}
+	atp, err := NewAPITransactionProcessor(args)
+	require.NoError(t, err)
+
+	t.Run("invalid sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := atp.GetTransactionsPoolSenderDetails("invalid", accountNonce)
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("sender with nonce gap", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := atp.GetTransactionsPoolSenderDetails(sender, accountNonce)
+		require.NoError(t, err)
+		require.Equal(t, sender, res.Sender)
+		require.Equal(t, accountNonce, res.AccountNonce)
+		require.Equal(t, 5, res.NumTransactions)
+		require.Equal(t, []common.NonceGapApiResponse{{From: 23, To: 24}}, res.Gaps)
+		require.Equal(t, []string{hex.EncodeToString(txHash4), hex.EncodeToString([]byte("txHash5"))}, res.StuckTransactions)
+		require.Len(t, res.Transactions, 5)
+		expectedNonces := []uint64{20, 21, 22, 25, 26}
+		for idx, tx := range res.Transactions {
+			require.Equal(t, expectedNonces[idx], tx.TxFields[nonceField])
+		}
+	})
+	t.Run("sender with a gap from account nonce should have all transactions stuck", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := atp.GetTransactionsPoolSenderDetails(sender, 10)
+		require.NoError(t, err)
+		require.Equal(t, common.NonceGapApiResponse{From: 10, To: 19}, res.Gaps[0])
+		require.Len(t, res.StuckTransactions, 5)
+	})
+	t.Run("sender without transactions in pool", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := atp.GetTransactionsPoolSenderDetails("new-sender", 0)
+		require.NoError(t, err)
+		require.Equal(t, &common.TransactionsPoolSenderDetailsApiResponse{
+			Sender:            "new-sender",
+			Gaps:              []common.NonceGapApiResponse{},
+			StuckTransactions: []string{},
+			Transactions:      []common.Transaction{},
+		}, res)
+	})
+}
+
+func TestApiTransactionProcessor_GetTransactionsPoolStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("pool not supporting inspection should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return &testscommon.ShardedDataStub{}
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsPoolStatistics()
+		require.Nil(t, res)
+		require.Equal(t, ErrTransactionsPoolInspectionNotSupported, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
+			Config: storageunit.CacheConfig{
+				Capacity:             100,
+				SizePerSender:        10,
+				SizeInBytes:          409600,
+				SizeInBytesPerSender: 40960,
+				Shards:               1,
+			},
+			TxGasHandler: &txcachemocks.TxGasHandlerMock{
+				MinimumGasMove:       50000,
+				MinimumGasPrice:      200000000000,
+				GasProcessingDivisor: 100,
+			},
+			NumberOfShards: 2,
+			SelfShardID:    0,
+		})
+		require.NoError(t, err)
+
+		for i := 1; i <= 10; i++ {
+			tx := &transaction.Transaction{
+				SndAddr:  []byte(fmt.Sprintf("sender-%d", i%3)),
+				Nonce:    uint64(i),
+				GasPrice: uint64(i * 1000),
+			}
+			pool.AddData([]byte(fmt.Sprintf("hash-%d", i)), tx, 100, "0")
+		}
+		pool.AddData([]byte("hash-cross"), &transaction.Transaction{SndAddr: []byte("sender-x"), GasPrice: 5}, 100, "1_0")
+
+		args := createMockArgAPITransactionProcessor()
+		args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+			TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+				return pool
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		res, err := atp.GetTransactionsPoolStatistics()
+		require.NoError(t, err)
+		require.Empty(t, res.Evictions)
+		require.Equal(t, []common.TransactionsPoolCacheStatisticsApiResponse{
+			{
+				CacheID:         "0",
+				NumTransactions: 10,
+				NumSenders:      3,
+				GasPrice: common.GasPriceDistributionApiResponse{
+					Min:    1000,
+					Max:    10000,
+					Median: 4864, // lower bound of the histogram bucket holding 5000
+					P90:    8704, // lower bound of the histogram bucket holding 9000
+				},
+			},
+			{
+				CacheID:         "1_0",
+				NumTransactions: 1,
+				NumSenders:      1,
+				GasPrice: common.GasPriceDistributionApiResponse{
+					Min:    5,
+					Max:    5,
+					Median: 5,
+					P90:    5,
+				},
+			},
+		}, res.Caches)
+	})
+}
+
 func createAPITransactionProc(t *testing.T, epoch uint32, withDbLookupExt bool) (*apiTransactionProcessor, *genericMocks.ChainStorerMock, *dataRetrieverMock.PoolsHolderMock, *dblookupextMock.HistoryRepositoryStub) {
 	chainStorer := genericMocks.NewChainStorerMock(epoch)
 	dataPool := dataRetrieverMock.NewPoolsHolderMock()
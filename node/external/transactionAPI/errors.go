@@ -31,3 +31,6 @@ var ErrCannotRetrieveTransactions = errors.New("transactions cannot be retrieved
 
 // ErrInvalidAddress signals that the address is invalid
 var ErrInvalidAddress = errors.New("invalid address")
+
+// ErrTransactionsPoolInspectionNotSupported signals that the transactions pool does not support inspection
+var ErrTransactionsPoolInspectionNotSupported = errors.New("transactions pool inspection is not supported")
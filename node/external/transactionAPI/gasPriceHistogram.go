@@ -0,0 +1,99 @@
+package transactionAPI
+
+import (
+	"math/bits"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// gasPriceHistogramSubBucketBits is the number of bits, besides the most significant one, kept when bucketing a gas
+// price: each power of two is split in 2^gasPriceHistogramSubBucketBits buckets, so the lower bound of the bucket
+// holding a gas price is off by less than 1/16 of the gas price
+const gasPriceHistogramSubBucketBits = 4
+const gasPriceHistogramNumSubBuckets = 1 << gasPriceHistogramSubBucketBits
+const gasPriceHistogramNumExactBuckets = 2 * gasPriceHistogramNumSubBuckets
+const gasPriceHistogramNumBuckets = gasPriceHistogramNumExactBuckets + (64-gasPriceHistogramSubBucketBits-1)*gasPriceHistogramNumSubBuckets
+
+// gasPriceHistogram holds the distribution of a set of gas prices in a fixed number of logarithmic buckets, so that
+// its size does not depend on the number of gas prices added
+type gasPriceHistogram struct {
+	counts    [gasPriceHistogramNumBuckets]uint64
+	numValues uint64
+	min       uint64
+	max       uint64
+}
+
+func (histogram *gasPriceHistogram) add(gasPrice uint64) {
+	if histogram.numValues == 0 || gasPrice < histogram.min {
+		histogram.min = gasPrice
+	}
+	if gasPrice > histogram.max {
+		histogram.max = gasPrice
+	}
+
+	histogram.counts[gasPriceBucketIndex(gasPrice)]++
+	histogram.numValues++
+}
+
+func (histogram *gasPriceHistogram) toApiResponse() common.GasPriceDistributionApiResponse {
+	if histogram.numValues == 0 {
+		return common.GasPriceDistributionApiResponse{}
+	}
+
+	return common.GasPriceDistributionApiResponse{
+		Min:    histogram.min,
+		Max:    histogram.max,
+		Median: histogram.valueAtRank((histogram.numValues-1)/2 + 1),
+		P90:    histogram.valueAtRank(uint64(percentileIndex(int(histogram.numValues), percentile90)) + 1),
+	}
+}
+
+// valueAtRank returns the lower bound of the bucket holding the value with the given rank (starting from 1), limited
+// to the exact minimum and maximum values
+func (histogram *gasPriceHistogram) valueAtRank(rank uint64) uint64 {
+	cumulativeCount := uint64(0)
+	for index, count := range histogram.counts {
+		cumulativeCount += count
+		if cumulativeCount < rank {
+			continue
+		}
+
+		value := gasPriceBucketLowerBound(index)
+		if value < histogram.min {
+			return histogram.min
+		}
+		if value > histogram.max {
+			return histogram.max
+		}
+
+		return value
+	}
+
+	return histogram.max
+}
+
+// gasPriceBucketIndex returns the index of the bucket holding the gas price. Small gas prices have their own bucket,
+// while the larger ones share a bucket with the gas prices having the same most significant bits
+func gasPriceBucketIndex(gasPrice uint64) int {
+	shift := bits.Len64(gasPrice) - gasPriceHistogramSubBucketBits - 1
+	if shift <= 0 {
+		return int(gasPrice)
+	}
+
+	subBucket := int(gasPrice>>uint(shift)) - gasPriceHistogramNumSubBuckets
+
+	return gasPriceHistogramNumExactBuckets + (shift-1)*gasPriceHistogramNumSubBuckets + subBucket
+}
+
+// gasPriceBucketLowerBound returns the smallest gas price held by the bucket with the given index
+func gasPriceBucketLowerBound(index int) uint64 {
+	if index < gasPriceHistogramNumExactBuckets {
+		return uint64(index)
+	}
+
+	index -= gasPriceHistogramNumExactBuckets
+	shift := index/gasPriceHistogramNumSubBuckets + 1
+	mantissa := uint64(index%gasPriceHistogramNumSubBuckets + gasPriceHistogramNumSubBuckets)
+
+	return mantissa << uint(shift)
+}
@@ -0,0 +1,84 @@
+package transactionAPI
+
+import (
+	"math"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/stretchr/testify/require"
+)
+
+func createGasPriceHistogram(gasPrices ...uint64) *gasPriceHistogram {
+	histogram := &gasPriceHistogram{}
+	for _, gasPrice := range gasPrices {
+		histogram.add(gasPrice)
+	}
+
+	return histogram
+}
+
+func TestGasPriceHistogram_ToApiResponse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no gas prices", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, common.GasPriceDistributionApiResponse{}, createGasPriceHistogram().toApiResponse())
+	})
+	t.Run("one gas price", func(t *testing.T) {
+		t.Parallel()
+
+		expected := common.GasPriceDistributionApiResponse{
+			Min:    1000000000,
+			Max:    1000000000,
+			Median: 1000000000,
+			P90:    1000000000,
+		}
+		require.Equal(t, expected, createGasPriceHistogram(1000000000).toApiResponse())
+	})
+	t.Run("small gas prices are exact", func(t *testing.T) {
+		t.Parallel()
+
+		histogram := createGasPriceHistogram(20, 1, 19, 2, 18, 3, 17, 4, 16, 5, 15, 6, 14, 7, 13, 8, 12, 9, 11, 10)
+		expected := common.GasPriceDistributionApiResponse{
+			Min:    1,
+			Max:    20,
+			Median: 10,
+			P90:    18,
+		}
+		require.Equal(t, expected, histogram.toApiResponse())
+	})
+	t.Run("large gas prices are approximated", func(t *testing.T) {
+		t.Parallel()
+
+		histogram := &gasPriceHistogram{}
+		for i := uint64(1); i <= 100; i++ {
+			histogram.add(i * 1000000000)
+		}
+
+		response := histogram.toApiResponse()
+		require.Equal(t, uint64(1000000000), response.Min)
+		require.Equal(t, uint64(100000000000), response.Max)
+		require.LessOrEqual(t, response.Median, uint64(50000000000))
+		require.Greater(t, response.Median, uint64(50000000000)-uint64(50000000000)/gasPriceHistogramNumSubBuckets)
+		require.LessOrEqual(t, response.P90, uint64(90000000000))
+		require.Greater(t, response.P90, uint64(90000000000)-uint64(90000000000)/gasPriceHistogramNumSubBuckets)
+	})
+}
+
+func TestGasPriceBucketIndex(t *testing.T) {
+	t.Parallel()
+
+	previousIndex := -1
+	for _, gasPrice := range []uint64{0, 1, 31, 32, 34, 63, 64, 1000000000, math.MaxUint64} {
+		index := gasPriceBucketIndex(gasPrice)
+		require.Greater(t, index, previousIndex)
+		require.Less(t, index, gasPriceHistogramNumBuckets)
+		require.LessOrEqual(t, gasPriceBucketLowerBound(index), gasPrice)
+		require.Equal(t, index, gasPriceBucketIndex(gasPriceBucketLowerBound(index)))
+		previousIndex = index
+	}
+
+	require.Equal(t, gasPriceBucketIndex(32), gasPriceBucketIndex(33))
+	require.Equal(t, gasPriceHistogramNumBuckets-1, gasPriceBucketIndex(math.MaxUint64))
+}
@@ -4,6 +4,8 @@ import (
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
 	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
 )
 
@@ -30,3 +32,12 @@ type LogsFacade interface {
 type DataFieldParser interface {
 	Parse(dataField []byte, sender, receiver []byte, numOfShards uint32) *datafield.ResponseParseData
 }
+
+type transactionsPoolForSenderProvider interface {
+	GetTransactionsPoolForSender(sender string) []*txcache.WrappedTransaction
+}
+
+type transactionsPoolInspector interface {
+	ForEachTransactionInPool(handler func(cacheID string, tx *txcache.WrappedTransaction))
+	GetEvictionHistory() []common.TransactionsPoolEvictionRecord
+}
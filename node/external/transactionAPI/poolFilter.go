@@ -0,0 +1,64 @@
+package transactionAPI
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const functionArgumentsSeparator = "@"
+
+type poolFilter struct {
+	receiver []byte
+	function string
+}
+
+func newPoolFilter(filter common.TransactionsPoolFilter, addressPubKeyConverter core.PubkeyConverter) (*poolFilter, error) {
+	pf := &poolFilter{
+		function: strings.TrimSpace(filter.Function),
+	}
+
+	receiver := strings.TrimSpace(filter.Receiver)
+	if len(receiver) == 0 {
+		return pf, nil
+	}
+
+	var err error
+	pf.receiver, err = addressPubKeyConverter.Decode(receiver)
+	if err != nil {
+		return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
+	}
+
+	return pf, nil
+}
+
+func (pf *poolFilter) isEmpty() bool {
+	return len(pf.receiver) == 0 && len(pf.function) == 0
+}
+
+// matches returns true if the provided object is a transaction which satisfies all the set criteria
+func (pf *poolFilter) matches(txObj interface{}) bool {
+	if pf.isEmpty() {
+		return true
+	}
+
+	tx, ok := txObj.(data.TransactionHandler)
+	if !ok {
+		return false
+	}
+
+	if len(pf.receiver) > 0 && !bytes.Equal(pf.receiver, tx.GetRcvAddr()) {
+		return false
+	}
+
+	return len(pf.function) == 0 || pf.function == extractFunctionName(tx.GetData())
+}
+
+func extractFunctionName(txData []byte) string {
+	function, _, _ := strings.Cut(string(txData), functionArgumentsSeparator)
+	return function
+}
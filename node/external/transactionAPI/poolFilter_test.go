@@ -0,0 +1,106 @@
+package transactionAPI
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func createPubkeyConverterStubForFilter() *testscommon.PubkeyConverterStub {
+	return &testscommon.PubkeyConverterStub{
+		DecodeCalled: func(humanReadable string) ([]byte, error) {
+			if humanReadable == "invalid" {
+				return nil, errors.New("invalid address")
+			}
+
+			return []byte(humanReadable), nil
+		},
+	}
+}
+
+func TestNewPoolFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid receiver should error", func(t *testing.T) {
+		t.Parallel()
+
+		pf, err := newPoolFilter(common.TransactionsPoolFilter{Receiver: "invalid"}, createPubkeyConverterStubForFilter())
+		require.Nil(t, pf)
+		require.True(t, strings.Contains(err.Error(), ErrInvalidAddress.Error()))
+	})
+	t.Run("empty filter", func(t *testing.T) {
+		t.Parallel()
+
+		pf, err := newPoolFilter(common.TransactionsPoolFilter{Receiver: " ", Function: " "}, createPubkeyConverterStubForFilter())
+		require.NoError(t, err)
+		require.True(t, pf.isEmpty())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pf, err := newPoolFilter(common.TransactionsPoolFilter{Receiver: "bob", Function: "claim"}, createPubkeyConverterStubForFilter())
+		require.NoError(t, err)
+		require.False(t, pf.isEmpty())
+		require.Equal(t, []byte("bob"), pf.receiver)
+		require.Equal(t, "claim", pf.function)
+	})
+}
+
+func TestPoolFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		RcvAddr: []byte("bob"),
+		Data:    []byte("claim@01@02"),
+	}
+
+	t.Run("empty filter should match everything", func(t *testing.T) {
+		t.Parallel()
+
+		pf := &poolFilter{}
+		require.True(t, pf.matches(tx))
+		require.True(t, pf.matches("not a transaction"))
+	})
+	t.Run("not a transaction should not match", func(t *testing.T) {
+		t.Parallel()
+
+		pf := &poolFilter{function: "claim"}
+		require.False(t, pf.matches("not a transaction"))
+	})
+	t.Run("filter by receiver", func(t *testing.T) {
+		t.Parallel()
+
+		require.True(t, (&poolFilter{receiver: []byte("bob")}).matches(tx))
+		require.False(t, (&poolFilter{receiver: []byte("alice")}).matches(tx))
+		require.True(t, (&poolFilter{receiver: []byte("bob")}).matches(&rewardTx.RewardTx{RcvAddr: []byte("bob")}))
+	})
+	t.Run("filter by function", func(t *testing.T) {
+		t.Parallel()
+
+		require.True(t, (&poolFilter{function: "claim"}).matches(tx))
+		require.False(t, (&poolFilter{function: "claimRewards"}).matches(tx))
+		require.False(t, (&poolFilter{function: "claim"}).matches(&rewardTx.RewardTx{RcvAddr: []byte("bob")}))
+	})
+	t.Run("filter by receiver and function", func(t *testing.T) {
+		t.Parallel()
+
+		require.True(t, (&poolFilter{receiver: []byte("bob"), function: "claim"}).matches(tx))
+		require.False(t, (&poolFilter{receiver: []byte("alice"), function: "claim"}).matches(tx))
+		require.False(t, (&poolFilter{receiver: []byte("bob"), function: "delegate"}).matches(tx))
+	})
+}
+
+func TestExtractFunctionName(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "", extractFunctionName(nil))
+	require.Equal(t, "claim", extractFunctionName([]byte("claim")))
+	require.Equal(t, "claim", extractFunctionName([]byte("claim@01")))
+	require.Equal(t, "", extractFunctionName([]byte("@01")))
+}
@@ -0,0 +1,69 @@
+package transactionAPI
+
+import (
+	"sort"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage/txcache"
+)
+
+const percentile90 = 90
+
+type cacheStatisticsCollector struct {
+	cacheID   string
+	gasPrices *gasPriceHistogram
+	senders   map[string]struct{}
+}
+
+func newCacheStatisticsCollector(cacheID string) *cacheStatisticsCollector {
+	return &cacheStatisticsCollector{
+		cacheID:   cacheID,
+		gasPrices: &gasPriceHistogram{},
+		senders:   make(map[string]struct{}),
+	}
+}
+
+func (collector *cacheStatisticsCollector) add(wrappedTx *txcache.WrappedTransaction) {
+	collector.gasPrices.add(wrappedTx.Tx.GetGasPrice())
+	collector.senders[string(wrappedTx.Tx.GetSndAddr())] = struct{}{}
+}
+
+func (collector *cacheStatisticsCollector) toApiResponse() common.TransactionsPoolCacheStatisticsApiResponse {
+	return common.TransactionsPoolCacheStatisticsApiResponse{
+		CacheID:         collector.cacheID,
+		NumTransactions: int(collector.gasPrices.numValues),
+		NumSenders:      len(collector.senders),
+		GasPrice:        collector.gasPrices.toApiResponse(),
+	}
+}
+
+func collectCachesStatistics(inspector transactionsPoolInspector) []common.TransactionsPoolCacheStatisticsApiResponse {
+	collectors := make(map[string]*cacheStatisticsCollector)
+	inspector.ForEachTransactionInPool(func(cacheID string, wrappedTx *txcache.WrappedTransaction) {
+		collector, ok := collectors[cacheID]
+		if !ok {
+			collector = newCacheStatisticsCollector(cacheID)
+			collectors[cacheID] = collector
+		}
+
+		collector.add(wrappedTx)
+	})
+
+	caches := make([]common.TransactionsPoolCacheStatisticsApiResponse, 0, len(collectors))
+	for _, collector := range collectors {
+		caches = append(caches, collector.toApiResponse())
+	}
+
+	sort.Slice(caches, func(i, j int) bool {
+		return caches[i].CacheID < caches[j].CacheID
+	})
+
+	return caches
+}
+
+// percentileIndex returns the index of the given percentile in a sorted slice, using the nearest rank method
+func percentileIndex(numValues int, percentile int) int {
+	rank := (numValues*percentile + 99) / 100
+
+	return rank - 1
+}
@@ -0,0 +1,16 @@
+package transactionAPI
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentileIndex(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 0, percentileIndex(1, percentile90))
+	require.Equal(t, 8, percentileIndex(10, percentile90))
+	require.Equal(t, 9, percentileIndex(11, percentile90))
+	require.Equal(t, 89, percentileIndex(100, percentile90))
+}
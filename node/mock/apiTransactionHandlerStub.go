@@ -8,10 +8,12 @@ import (
 // TransactionAPIHandlerStub -
 type TransactionAPIHandlerStub struct {
 	GetTransactionCalled                        func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPoolCalled                   func(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolSenderDetailsCalled      func(sender string, senderAccountNonce uint64) (*common.TransactionsPoolSenderDetailsApiResponse, error)
+	GetTransactionsPoolStatisticsCalled         func() (*common.TransactionsPoolStatisticsApiResponse, error)
 	UnmarshalTransactionCalled                  func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	UnmarshalReceiptCalled                      func(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	PopulateComputedFieldsCalled                func(tx *transaction.ApiTransactionResult)
@@ -27,9 +29,9 @@ func (tas *TransactionAPIHandlerStub) GetTransaction(hash string, withResults bo
 }
 
 // GetTransactionsPool -
-func (tas *TransactionAPIHandlerStub) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
+func (tas *TransactionAPIHandlerStub) GetTransactionsPool(fields string, filter common.TransactionsPoolFilter) (*common.TransactionsPoolAPIResponse, error) {
 	if tas.GetTransactionsPoolCalled != nil {
-		return tas.GetTransactionsPoolCalled(fields)
+		return tas.GetTransactionsPoolCalled(fields, filter)
 	}
 
 	return nil, nil
@@ -62,6 +64,24 @@ func (tas *TransactionAPIHandlerStub) GetTransactionsPoolNonceGapsForSender(send
 	return nil, nil
 }
 
+// GetTransactionsPoolSenderDetails -
+func (tas *TransactionAPIHandlerStub) GetTransactionsPoolSenderDetails(sender string, senderAccountNonce uint64) (*common.TransactionsPoolSenderDetailsApiResponse, error) {
+	if tas.GetTransactionsPoolSenderDetailsCalled != nil {
+		return tas.GetTransactionsPoolSenderDetailsCalled(sender, senderAccountNonce)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolStatistics -
+func (tas *TransactionAPIHandlerStub) GetTransactionsPoolStatistics() (*common.TransactionsPoolStatisticsApiResponse, error) {
+	if tas.GetTransactionsPoolStatisticsCalled != nil {
+		return tas.GetTransactionsPoolStatisticsCalled()
+	}
+
+	return nil, nil
+}
+
 // UnmarshalTransaction -
 func (tas *TransactionAPIHandlerStub) UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error) {
 	if tas.UnmarshalTransactionCalled != nil {
@@ -1,28 +1,50 @@
 package mock
 
 import (
+	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 )
 
 // TransactionCostEstimatorMock  -
 type TransactionCostEstimatorMock struct {
-	ComputeTransactionGasLimitCalled   func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	SimulateTransactionExecutionCalled func(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error)
+	ComputeTransactionGasLimitCalled          func(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error)
+	SimulateTransactionExecutionCalled        func(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error)
+	SimulateTransactionsBundleExecutionCalled func(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error)
+	TraceTransactionExecutionCalled           func(tx *transaction.Transaction, blockHeader data.HeaderHandler, rootHashHolder common.RootHashHolder) (*txSimData.SimulationResultsWithVMOutput, error)
 }
 
 // ComputeTransactionGasLimit -
-func (tcem *TransactionCostEstimatorMock) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+func (tcem *TransactionCostEstimatorMock) ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error) {
 	if tcem.ComputeTransactionGasLimitCalled != nil {
-		return tcem.ComputeTransactionGasLimitCalled(tx)
+		return tcem.ComputeTransactionGasLimitCalled(tx, stateOverrides)
 	}
 	return &transaction.CostResponse{}, nil
 }
 
 // SimulateTransactionExecution -
-func (tcem *TransactionCostEstimatorMock) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+func (tcem *TransactionCostEstimatorMock) SimulateTransactionExecution(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride, withTrace bool) (*txSimData.SimulationResultsWithVMOutput, error) {
 	if tcem.SimulateTransactionExecutionCalled != nil {
-		return tcem.SimulateTransactionExecutionCalled(tx)
+		return tcem.SimulateTransactionExecutionCalled(tx, stateOverrides, withTrace)
+	}
+
+	return &txSimData.SimulationResultsWithVMOutput{}, nil
+}
+
+// SimulateTransactionsBundleExecution -
+func (tcem *TransactionCostEstimatorMock) SimulateTransactionsBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if tcem.SimulateTransactionsBundleExecutionCalled != nil {
+		return tcem.SimulateTransactionsBundleExecutionCalled(txs)
+	}
+
+	return &txSimData.BundleSimulationResults{}, nil
+}
+
+// TraceTransactionExecution -
+func (tcem *TransactionCostEstimatorMock) TraceTransactionExecution(tx *transaction.Transaction, blockHeader data.HeaderHandler, rootHashHolder common.RootHashHolder) (*txSimData.SimulationResultsWithVMOutput, error) {
+	if tcem.TraceTransactionExecutionCalled != nil {
+		return tcem.TraceTransactionExecutionCalled(tx, blockHeader, rootHashHolder)
 	}
 
 	return &txSimData.SimulationResultsWithVMOutput{}, nil
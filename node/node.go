@@ -32,11 +32,13 @@ import (
 	heartbeatData "github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/disabled"
 	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/node/nodeDebugFactory"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/dataValidators"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
 	procTx "github.com/multiversx/mx-chain-go/process/transaction"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/trie"
 	"github.com/multiversx/mx-chain-go/vm"
@@ -106,6 +108,10 @@ type Node struct {
 	closableComponents        []mainFactory.Closer
 	enableSignTxWithHashEpoch uint32
 	isInImportMode            bool
+
+	mutTrieStatisticsJob             syncGo.Mutex
+	trieStatisticsJob                *trieStatisticsJob
+	failedTrieStatisticsJobRetention time.Duration
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -122,7 +128,8 @@ func (n *Node) ApplyOptions(opts ...Option) error {
 // NewNode creates a new Node instance
 func NewNode(opts ...Option) (*Node, error) {
 	node := &Node{
-		queryHandlers: make(map[string]debug.QueryHandler),
+		queryHandlers:                    make(map[string]debug.QueryHandler),
+		failedTrieStatisticsJobRetention: failedTrieStatisticsJobRetention,
 	}
 
 	node.closableComponents = make([]mainFactory.Closer, 0)
@@ -331,6 +338,53 @@ func (n *Node) getKeys(userAccount state.UserAccountHandler, ctx context.Context
 	return mapToReturn, nil
 }
 
+// GetKeyValuePairsPage returns a page of the key-value pairs of the given account, starting with the pair found at the
+// provided cursor. Only the keys which start with the given prefix are returned
+func (n *Node) GetKeyValuePairsPage(
+	address string,
+	options api.AccountQueryOptions,
+	startKey []byte,
+	pageSize int,
+	keyPrefix []byte,
+	ctx context.Context,
+) (*common.KeyValuePairsPage, api.BlockInfo, error) {
+	userAccount, blockInfo, err := n.loadUserAccountHandlerByAddress(address, options)
+	if err != nil {
+		adaptedBlockInfo, isEmptyAccount := extractBlockInfoIfNewAccount(err)
+		if isEmptyAccount {
+			return &common.KeyValuePairsPage{Pairs: make([]common.KeyValuePair, 0)}, adaptedBlockInfo, nil
+		}
+
+		return nil, api.BlockInfo{}, err
+	}
+
+	if check.IfNil(userAccount.DataTrie()) {
+		return &common.KeyValuePairsPage{Pairs: make([]common.KeyValuePair, 0)}, api.BlockInfo{}, nil
+	}
+
+	leavesPage, err := userAccount.GetLeavesPage(ctx, startKey, pageSize, keyPrefix)
+	if err != nil {
+		if common.IsContextDone(ctx) {
+			return nil, api.BlockInfo{}, ErrTrieOperationsTimeout
+		}
+
+		return nil, api.BlockInfo{}, err
+	}
+
+	pairs := make([]common.KeyValuePair, 0, len(leavesPage.Leaves))
+	for _, leaf := range leavesPage.Leaves {
+		pairs = append(pairs, common.KeyValuePair{
+			Key:   hex.EncodeToString(leaf.Key()),
+			Value: hex.EncodeToString(leaf.Value()),
+		})
+	}
+
+	return &common.KeyValuePairsPage{
+		Pairs:   pairs,
+		NextKey: hex.EncodeToString(leavesPage.NextKey),
+	}, blockInfo, nil
+}
+
 // GetValueForKey will return the value for a key from a given account
 func (n *Node) GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	keyBytes, err := hex.DecodeString(key)
@@ -731,14 +785,20 @@ func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
 	return err
 }
 
-// ValidateTransactionForSimulation will validate a transaction for use in transaction simulation process
-func (n *Node) ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error {
+// ValidateTransactionForSimulation will validate a transaction for use in transaction simulation process. The nonce and
+// the balance of the sender are not checked against the current state if the sender is found in the provided state
+// overrides, as the simulation will be executed on top of the overridden account
+func (n *Node) ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool, stateOverrides map[string]*txSimData.AccountOverride) error {
 	disabledWhiteListHandler := disabled.NewDisabledWhiteListDataVerifier()
 	txValidator, intTx, err := n.commonTransactionValidation(tx, disabledWhiteListHandler, disabledWhiteListHandler, checkSignature)
 	if err != nil {
 		return err
 	}
 
+	if n.isSenderOverridden(tx, stateOverrides) {
+		return nil
+	}
+
 	err = txValidator.CheckTxValidity(intTx)
 	if errors.Is(err, process.ErrAccountNotFound) {
 		// we allow the broadcast of provided transaction even if that transaction is not targeted on the current shard
@@ -748,6 +808,24 @@ func (n *Node) ValidateTransactionForSimulation(tx *transaction.Transaction, che
 	return err
 }
 
+func (n *Node) isSenderOverridden(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) bool {
+	if len(stateOverrides) == 0 {
+		return false
+	}
+
+	sender, err := n.coreComponents.AddressPubKeyConverter().Encode(tx.SndAddr)
+	if err != nil {
+		return false
+	}
+
+	accountOverride, found := stateOverrides[sender]
+	if !found || accountOverride == nil {
+		return false
+	}
+
+	return len(accountOverride.Balance) > 0 || accountOverride.Nonce != nil
+}
+
 func (n *Node) commonTransactionValidation(
 	tx *transaction.Transaction,
 	whiteListerVerifiedTxs process.WhiteListHandler,
@@ -1012,33 +1090,43 @@ func (n *Node) getAccountInfo(address string, options api.AccountQueryOptions) (
 		}, err
 	}
 
+	accountResponse, err := n.createAccountResponse(address, account)
+	if err != nil {
+		return accountInfo{
+			accountResponse: api.AccountResponse{},
+			block:           api.BlockInfo{},
+			account:         nil,
+		}, err
+	}
+
+	return accountInfo{
+		accountResponse: accountResponse,
+		block:           blockInfo,
+		account:         account,
+	}, nil
+}
+
+func (n *Node) createAccountResponse(address string, account state.UserAccountHandler) (api.AccountResponse, error) {
 	ownerAddress := ""
 	if len(account.GetOwnerAddress()) > 0 {
+		var err error
 		addressPubkeyConverter := n.coreComponents.AddressPubKeyConverter()
 		ownerAddress, err = addressPubkeyConverter.Encode(account.GetOwnerAddress())
 		if err != nil {
-			return accountInfo{
-				accountResponse: api.AccountResponse{},
-				block:           api.BlockInfo{},
-				account:         nil,
-			}, err
+			return api.AccountResponse{}, err
 		}
 	}
 
-	return accountInfo{
-		accountResponse: api.AccountResponse{
-			Address:         address,
-			Nonce:           account.GetNonce(),
-			Balance:         account.GetBalance().String(),
-			Username:        string(account.GetUserName()),
-			CodeHash:        account.GetCodeHash(),
-			RootHash:        account.GetRootHash(),
-			CodeMetadata:    account.GetCodeMetadata(),
-			DeveloperReward: account.GetDeveloperReward().String(),
-			OwnerAddress:    ownerAddress,
-		},
-		block:   blockInfo,
-		account: account,
+	return api.AccountResponse{
+		Address:         address,
+		Nonce:           account.GetNonce(),
+		Balance:         account.GetBalance().String(),
+		Username:        string(account.GetUserName()),
+		CodeHash:        account.GetCodeHash(),
+		RootHash:        account.GetRootHash(),
+		CodeMetadata:    account.GetCodeMetadata(),
+		DeveloperReward: account.GetDeveloperReward().String(),
+		OwnerAddress:    ownerAddress,
 	}, nil
 }
 
@@ -1134,6 +1222,21 @@ func (n *Node) GetQueryHandler(name string) (debug.QueryHandler, error) {
 	return qh, nil
 }
 
+// GetInterceptorResolverDebugCounters returns the aggregated counters of the interceptor-resolver debugger, if existing
+func (n *Node) GetInterceptorResolverDebugCounters() []*debug.InterceptorResolverCounters {
+	qh, err := n.GetQueryHandler(nodeDebugFactory.InterceptorDebugger)
+	if err != nil {
+		return make([]*debug.InterceptorResolverCounters, 0)
+	}
+
+	countersHandler, ok := qh.(debug.InterceptorResolverCountersHandler)
+	if !ok {
+		return make([]*debug.InterceptorResolverCounters, 0)
+	}
+
+	return countersHandler.Counters()
+}
+
 // GetPeerInfo returns information about a peer id
 func (n *Node) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	peers := n.networkComponents.NetworkMessenger().Peers()
@@ -1329,6 +1432,8 @@ func (n *Node) createPidInfo(p core.PeerID) (core.QueryP2PPeerInfo, error) {
 
 // Close closes all underlying components
 func (n *Node) Close() error {
+	n.cancelTrieStatisticsJob()
+
 	for _, qh := range n.queryHandlers {
 		log.LogIfError(qh.Close())
 	}
@@ -1442,6 +1547,101 @@ func (n *Node) VerifyProof(rootHash string, address string, proof [][]byte) (boo
 	return mpv.VerifyProof(rootHashBytes, key, proof)
 }
 
+// GetMultiProof returns the Merkle proof which proves the inclusion or the exclusion of all the given keys
+func (n *Node) GetMultiProof(rootHash string, keys []string) (*common.GetMultiProofResponse, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	keysBytes, err := n.getKeysBytes(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getMultiProof(rootHashBytes, keysBytes)
+}
+
+// GetMultiProofDataTrie returns the Merkle proof for the given address, chained to a single Merkle proof which proves
+// the inclusion or the exclusion of all the given keys in the data trie of the account
+func (n *Node) GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetMultiProofDataTrieResponse, error) {
+	rootHashBytes, addressBytes, err := n.getRootHashAndAddressAsBytes(rootHash, address)
+	if err != nil {
+		return nil, err
+	}
+
+	keysBytes := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		keyBytes, errDecode := hex.DecodeString(key)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+
+		keysBytes = append(keysBytes, keyBytes)
+	}
+
+	mainProofResponse, err := n.getProof(rootHashBytes, addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, err := n.getUserAccountFromBytes(addressBytes, mainProofResponse.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([][]byte, 0, len(keysBytes))
+	dataTrieKeys := make([][]byte, 0, 2*len(keysBytes))
+	for _, keyBytes := range keysBytes {
+		value, _, errRetrieve := userAccount.RetrieveValue(keyBytes)
+		if errRetrieve != nil {
+			return nil, errRetrieve
+		}
+
+		values = append(values, value)
+		dataTrieKeys = append(dataTrieKeys, n.coreComponents.Hasher().Compute(string(keyBytes)), keyBytes)
+	}
+
+	dataTrieProofResponse := &common.GetMultiProofResponse{
+		Proof:  make([][]byte, 0),
+		Values: make([][]byte, len(dataTrieKeys)),
+	}
+	dataTrieRootHash := userAccount.GetRootHash()
+	if len(dataTrieRootHash) > 0 {
+		dataTrieProofResponse, err = n.getMultiProof(dataTrieRootHash, dataTrieKeys)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &common.GetMultiProofDataTrieResponse{
+		MainProof:     mainProofResponse,
+		DataTrieProof: dataTrieProofResponse,
+		DataTrieKeys:  dataTrieKeys,
+		Values:        values,
+	}, nil
+}
+
+// VerifyMultiProof verifies the given Merkle multi proof against the provided values of the given keys
+func (n *Node) VerifyMultiProof(rootHash string, keys []string, values [][]byte, proof [][]byte) (bool, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return false, err
+	}
+
+	keysBytes, err := n.getKeysBytes(keys)
+	if err != nil {
+		return false, err
+	}
+
+	mpv, err := trie.NewMerkleProofVerifier(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher())
+	if err != nil {
+		return false, err
+	}
+
+	return mpv.VerifyMultiProof(rootHashBytes, keysBytes, values, proof)
+}
+
 // IsDataTrieMigrated returns true if the data trie for the given address is migrated
 func (n *Node) IsDataTrieMigrated(address string, options api.AccountQueryOptions) (bool, error) {
 	accountHandler, _, err := n.loadUserAccountHandlerByAddress(address, options)
@@ -1472,16 +1672,11 @@ func (n *Node) getRootHashAndAddressAsBytes(rootHash string, address string) ([]
 }
 
 func (n *Node) getAccountRootHashAndVal(address []byte, accBytes []byte, key []byte) ([]byte, []byte, error) {
-	account, err := n.stateComponents.AccountsAdapterAPI().GetAccountFromBytes(address, accBytes)
+	userAccount, err := n.getUserAccountFromBytes(address, accBytes)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	userAccount, ok := account.(state.UserAccountHandler)
-	if !ok {
-		return nil, nil, fmt.Errorf("the address does not belong to a user account")
-	}
-
 	dataTrieRootHash := userAccount.GetRootHash()
 	if len(dataTrieRootHash) == 0 {
 		return nil, nil, fmt.Errorf("empty dataTrie rootHash")
@@ -1495,6 +1690,20 @@ func (n *Node) getAccountRootHashAndVal(address []byte, accBytes []byte, key []b
 	return dataTrieRootHash, retrievedVal, nil
 }
 
+func (n *Node) getUserAccountFromBytes(address []byte, accBytes []byte) (state.UserAccountHandler, error) {
+	account, err := n.stateComponents.AccountsAdapterAPI().GetAccountFromBytes(address, accBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, fmt.Errorf("the address does not belong to a user account")
+	}
+
+	return userAccount, nil
+}
+
 func (n *Node) getProof(rootHash []byte, key []byte) (*common.GetProofResponse, error) {
 	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(rootHash)
 	if err != nil {
@@ -1513,6 +1722,24 @@ func (n *Node) getProof(rootHash []byte, key []byte) (*common.GetProofResponse,
 	}, nil
 }
 
+func (n *Node) getMultiProof(rootHash []byte, keys [][]byte) (*common.GetMultiProofResponse, error) {
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	computedProof, values, err := tr.GetMultiProof(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.GetMultiProofResponse{
+		Proof:    computedProof,
+		Values:   values,
+		RootHash: hex.EncodeToString(rootHash),
+	}, nil
+}
+
 func (n *Node) getKeyBytes(key string) ([]byte, error) {
 	addressBytes, err := n.DecodeAddressPubkey(key)
 	if err == nil {
@@ -1522,6 +1749,20 @@ func (n *Node) getKeyBytes(key string) ([]byte, error) {
 	return hex.DecodeString(key)
 }
 
+func (n *Node) getKeysBytes(keys []string) ([][]byte, error) {
+	keysBytes := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		keyBytes, err := n.getKeyBytes(key)
+		if err != nil {
+			return nil, err
+		}
+
+		keysBytes = append(keysBytes, keyBytes)
+	}
+
+	return keysBytes, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (n *Node) IsInterfaceNil() bool {
 	return n == nil
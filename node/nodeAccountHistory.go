@@ -0,0 +1,48 @@
+package node
+
+import (
+	"encoding/hex"
+	"math"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// GetAccountHistory returns, newest first, a page of the balances and nonces recorded for the provided address in the
+// blocks with nonces between the provided bounds
+func (n *Node) GetAccountHistory(address string, options common.AccountHistoryQueryOptions) (*common.AccountHistoryAPIResponse, error) {
+	pubKey, err := n.decodeAddressToPubKey(address)
+	if err != nil {
+		return nil, err
+	}
+
+	toNonce := uint64(math.MaxUint64)
+	if options.ToNonce.HasValue {
+		toNonce = options.ToNonce.Value
+	}
+	if options.FromNonce > toNonce {
+		return nil, ErrInvalidNonceRange
+	}
+
+	page, err := n.processComponents.HistoryRepository().GetAccountHistory(pubKey, options.FromNonce, toNonce, options.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.AccountHistoryAPIResponse{
+		Entries: make([]*common.AccountHistoryEntryAPIResponse, 0, len(page.Entries)),
+		HasMore: page.HasMore,
+	}
+	for _, entry := range page.Entries {
+		response.Entries = append(response.Entries, &common.AccountHistoryEntryAPIResponse{
+			BlockNonce: entry.BlockNonce,
+			BlockHash:  hex.EncodeToString(entry.BlockHash),
+			Balance:    bigToString(entry.Balance),
+			Nonce:      entry.Nonce,
+		})
+	}
+	if page.HasMore && len(page.Entries) > 0 {
+		response.NextToNonce = page.Entries[len(page.Entries)-1].BlockNonce - 1
+	}
+
+	return response, nil
+}
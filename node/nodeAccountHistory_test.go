@@ -0,0 +1,115 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext/accountsHistory"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/dblookupext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetAccountHistory(t *testing.T) {
+	t.Parallel()
+
+	createNode := func(historyRepository *dblookupext.HistoryRepositoryStub) *node.Node {
+		processComponents := getDefaultProcessComponents()
+		processComponents.HistoryRepositoryInternal = historyRepository
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithProcessComponents(processComponents),
+		)
+
+		return n
+	}
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNode(&dblookupext.HistoryRepositoryStub{})
+		response, err := n.GetAccountHistory("invalid address", common.AccountHistoryQueryOptions{PageSize: 10})
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid nonce range should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNode(&dblookupext.HistoryRepositoryStub{})
+		response, err := n.GetAccountHistory(testscommon.TestAddressAlice, common.AccountHistoryQueryOptions{
+			FromNonce: 10,
+			ToNonce:   core.OptionalUint64{Value: 5, HasValue: true},
+			PageSize:  10,
+		})
+		assert.Nil(t, response)
+		assert.Equal(t, node.ErrInvalidNonceRange, err)
+	})
+	t.Run("history repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		n := createNode(&dblookupext.HistoryRepositoryStub{
+			GetAccountHistoryCalled: func(_ []byte, _ uint64, _ uint64, _ int) (*accountsHistory.AccountHistoryPage, error) {
+				return nil, expectedErr
+			},
+		})
+		response, err := n.GetAccountHistory(testscommon.TestAddressAlice, common.AccountHistoryQueryOptions{PageSize: 10})
+		assert.Nil(t, response)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("no upper bound should query up to the most recent entry", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNode(&dblookupext.HistoryRepositoryStub{
+			GetAccountHistoryCalled: func(address []byte, fromNonce uint64, toNonce uint64, maxEntries int) (*accountsHistory.AccountHistoryPage, error) {
+				assert.Equal(t, testscommon.TestPubKeyAlice, address)
+				assert.Equal(t, uint64(3), fromNonce)
+				assert.Equal(t, uint64(math.MaxUint64), toNonce)
+				assert.Equal(t, 10, maxEntries)
+				return &accountsHistory.AccountHistoryPage{}, nil
+			},
+		})
+		response, err := n.GetAccountHistory(testscommon.TestAddressAlice, common.AccountHistoryQueryOptions{FromNonce: 3, PageSize: 10})
+		require.Nil(t, err)
+		assert.Empty(t, response.Entries)
+		assert.False(t, response.HasMore)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNode(&dblookupext.HistoryRepositoryStub{
+			GetAccountHistoryCalled: func(_ []byte, _ uint64, toNonce uint64, _ int) (*accountsHistory.AccountHistoryPage, error) {
+				assert.Equal(t, uint64(20), toNonce)
+				return &accountsHistory.AccountHistoryPage{
+					Entries: []*accountsHistory.AccountHistoryEntry{
+						{BlockNonce: 20, BlockHash: []byte("hash20"), Balance: big.NewInt(100), Nonce: 3},
+						{BlockNonce: 15, BlockHash: []byte("hash15"), Nonce: 2},
+					},
+					HasMore: true,
+				}, nil
+			},
+		})
+		response, err := n.GetAccountHistory(testscommon.TestAddressAlice, common.AccountHistoryQueryOptions{
+			ToNonce:  core.OptionalUint64{Value: 20, HasValue: true},
+			PageSize: 2,
+		})
+		require.Nil(t, err)
+
+		expectedResponse := &common.AccountHistoryAPIResponse{
+			Entries: []*common.AccountHistoryEntryAPIResponse{
+				{BlockNonce: 20, BlockHash: hex.EncodeToString([]byte("hash20")), Balance: "100", Nonce: 3},
+				{BlockNonce: 15, BlockHash: hex.EncodeToString([]byte("hash15")), Balance: "0", Nonce: 2},
+			},
+			HasMore:     true,
+			NextToNonce: 14,
+		}
+		assert.Equal(t, expectedResponse, response)
+	})
+}
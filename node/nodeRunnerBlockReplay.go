@@ -0,0 +1,221 @@
+package node
+
+import (
+	"io"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/endProcess"
+	"github.com/multiversx/mx-chain-go/common/forking"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	mainFactory "github.com/multiversx/mx-chain-go/factory"
+	bootstrapComp "github.com/multiversx/mx-chain-go/factory/bootstrap"
+	"github.com/multiversx/mx-chain-go/process/block/replay"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+const blockReplayProcessingTimeout = 10 * time.Minute
+
+// replayStateComponents exposes the accounts recorder instead of the accounts adapter, so the block processor
+// records every account it saves while re-executing a block
+type replayStateComponents struct {
+	mainFactory.StateComponentsHandler
+	accountsRecorder state.AccountsAdapter
+}
+
+// AccountsAdapter returns the accounts recorder
+func (rsc *replayStateComponents) AccountsAdapter() state.AccountsAdapter {
+	return rsc.accountsRecorder
+}
+
+// ReplayBlock creates the node components on top of the existing node storage, without starting the consensus, the
+// synchronization or the API, and re-executes the block with the provided nonce on the state of its previous block
+func (nr *nodeRunner) ReplayBlock(nonce uint64) (*replay.ReplayResults, error) {
+	log.Debug("applying custom configs based on the current architecture")
+	ApplyArchCustomConfigs(nr.configs)
+
+	configs := nr.configs
+	closers := make([]io.Closer, 0)
+	defer func() {
+		closeBlockReplayComponents(closers)
+	}()
+
+	chanStopNodeProcess := make(chan endProcess.ArgEndProcess, 1)
+	log.Debug("creating core components")
+	managedCoreComponents, err := nr.CreateManagedCoreComponents(chanStopNodeProcess)
+	if err != nil {
+		return nil, err
+	}
+	closers = append(closers, managedCoreComponents)
+
+	log.Debug("creating status core components")
+	managedStatusCoreComponents, err := nr.CreateManagedStatusCoreComponents(managedCoreComponents)
+	if err != nil {
+		return nil, err
+	}
+	closers = append(closers, managedStatusCoreComponents)
+
+	log.Debug("creating crypto components")
+	managedCryptoComponents, err := nr.CreateManagedCryptoComponents(managedCoreComponents)
+	if err != nil {
+		return nil, err
+	}
+	closers = append(closers, managedCryptoComponents)
+
+	log.Debug("creating network components")
+	managedNetworkComponents, err := nr.CreateManagedNetworkComponents(managedCoreComponents, managedStatusCoreComponents, managedCryptoComponents)
+	if err != nil {
+		return nil, err
+	}
+	closers = append(closers, managedNetworkComponents)
+
+	log.Debug("creating bootstrap components")
+	managedBootstrapComponents, err := nr.CreateManagedBootstrapComponents(managedStatusCoreComponents, managedCoreComponents, managedCryptoComponents, managedNetworkComponents)
+	if err != nil {
+		return nil, err
+	}
+	closers = append(closers, managedBootstrapComponents)
+
+	log.Debug("creating data components")
+	managedDataComponents, err := nr.CreateManagedDataComponents(managedStatusCoreComponents, managedCoreComponents, managedBootstrapComponents, managedCryptoComponents)
+	if err != nil {
+		return nil, err
+	}
+	closers = append(closers, managedDataComponents)
+
+	log.Debug("creating state components")
+	managedStateComponents, err := nr.CreateManagedStateComponents(
+		managedCoreComponents,
+		managedDataComponents,
+		managedStatusCoreComponents,
+		managedCryptoComponents,
+	)
+	if err != nil {
+		return nil, err
+	}
+	closers = append(closers, managedStateComponents)
+
+	accountsRecorder, err := replay.NewAccountsRecorder(managedStateComponents.AccountsAdapter(), managedCoreComponents.AddressPubKeyConverter())
+	if err != nil {
+		return nil, err
+	}
+	stateComponents := &replayStateComponents{
+		StateComponentsHandler: managedStateComponents,
+		accountsRecorder:       accountsRecorder,
+	}
+
+	nodesShufflerOut, err := bootstrapComp.CreateNodesShuffleOut(
+		managedCoreComponents.GenesisNodesSetup(),
+		configs.GeneralConfig.EpochStartConfig,
+		managedCoreComponents.ChanStopNodeProcess(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	closers = append(closers, nodesShufflerOut)
+
+	bootstrapStorer, err := managedDataComponents.StorageService().GetStorer(dataRetriever.BootstrapUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("creating nodes coordinator")
+	nodesCoordinatorInstance, err := bootstrapComp.CreateNodesCoordinator(
+		nodesShufflerOut,
+		managedCoreComponents.GenesisNodesSetup(),
+		configs.PreferencesConfig.Preferences,
+		managedCoreComponents.EpochStartNotifierWithConfirm(),
+		managedCryptoComponents.PublicKey(),
+		managedCoreComponents.InternalMarshalizer(),
+		managedCoreComponents.Hasher(),
+		managedCoreComponents.Rater(),
+		bootstrapStorer,
+		managedCoreComponents.NodesShuffler(),
+		managedBootstrapComponents.ShardCoordinator().SelfId(),
+		managedBootstrapComponents.EpochBootstrapParams(),
+		managedBootstrapComponents.EpochBootstrapParams().Epoch(),
+		managedCoreComponents.ChanStopNodeProcess(),
+		managedCoreComponents.NodeTypeProvider(),
+		managedCoreComponents.EnableEpochsHandler(),
+		managedDataComponents.Datapool().CurrentEpochValidatorInfo(),
+		managedBootstrapComponents.NodesCoordinatorRegistryFactory(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("creating status components")
+	managedStatusComponents, err := nr.CreateManagedStatusComponents(
+		managedStatusCoreComponents,
+		managedCoreComponents,
+		managedNetworkComponents,
+		managedBootstrapComponents,
+		stateComponents,
+		nodesCoordinatorInstance,
+		configs.ImportDbConfig.IsImportDBMode,
+		managedCryptoComponents,
+		managedDataComponents,
+	)
+	if err != nil {
+		return nil, err
+	}
+	closers = append(closers, managedStatusComponents)
+
+	argsGasScheduleNotifier := forking.ArgsNewGasScheduleNotifier{
+		GasScheduleConfig:  configs.EpochConfig.GasSchedule,
+		ConfigDir:          configs.ConfigurationPathsHolder.GasScheduleDirectoryName,
+		EpochNotifier:      managedCoreComponents.EpochNotifier(),
+		WasmVMChangeLocker: managedCoreComponents.WasmVMChangeLocker(),
+	}
+	gasScheduleNotifier, err := forking.NewGasScheduleNotifier(argsGasScheduleNotifier)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("creating process components")
+	managedProcessComponents, err := nr.CreateManagedProcessComponents(
+		managedCoreComponents,
+		managedCryptoComponents,
+		managedNetworkComponents,
+		managedBootstrapComponents,
+		stateComponents,
+		managedDataComponents,
+		managedStatusComponents,
+		managedStatusCoreComponents,
+		gasScheduleNotifier,
+		nodesCoordinatorInstance,
+	)
+	if err != nil {
+		return nil, err
+	}
+	closers = append(closers, managedProcessComponents)
+
+	argsBlockReplayer := replay.ArgsBlockReplayer{
+		BlockProcessor:               managedProcessComponents.BlockProcessor(),
+		BlockTracker:                 managedProcessComponents.BlockTracker(),
+		BlockChain:                   managedDataComponents.Blockchain(),
+		ScheduledTxsExecutionHandler: managedProcessComponents.ScheduledTxsExecutionHandler(),
+		Accounts:                     accountsRecorder,
+		AccountsRepository:           managedStateComponents.AccountsRepository(),
+		StorageService:               managedDataComponents.StorageService(),
+		DataPool:                     managedDataComponents.Datapool(),
+		Marshaller:                   managedCoreComponents.InternalMarshalizer(),
+		Uint64Converter:              managedCoreComponents.Uint64ByteSliceConverter(),
+		ShardCoordinator:             managedBootstrapComponents.ShardCoordinator(),
+		AddressConverter:             managedCoreComponents.AddressPubKeyConverter(),
+		ProcessingTimeout:            blockReplayProcessingTimeout,
+	}
+	blockReplayer, err := replay.NewBlockReplayer(argsBlockReplayer)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("replaying block", "shard", managedBootstrapComponents.ShardCoordinator().SelfId(), "nonce", nonce)
+
+	return blockReplayer.ReplayBlock(nonce)
+}
+
+func closeBlockReplayComponents(closers []io.Closer) {
+	for i := len(closers) - 1; i >= 0; i-- {
+		log.LogIfError(closers[i].Close())
+	}
+}
@@ -0,0 +1,144 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/parsers"
+)
+
+// GetStateDiff returns a page of the accounts which were added, removed or modified between the blocks with the
+// provided nonces
+func (n *Node) GetStateDiff(options common.StateDiffQueryOptions, ctx context.Context) (*common.StateDiffAPIResponse, error) {
+	var fromAddress []byte
+	var err error
+	if len(options.FromAddress) > 0 {
+		fromAddress, err = n.decodeAddressToPubKey(options.FromAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fromOptions, err := n.addBlockCoordinatesToAccountQueryOptions(api.AccountQueryOptions{
+		BlockNonce: core.OptionalUint64{Value: options.FromNonce, HasValue: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	toOptions, err := n.addBlockCoordinatesToAccountQueryOptions(api.AccountQueryOptions{
+		BlockNonce: core.OptionalUint64{Value: options.ToNonce, HasValue: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accountsDiff, err := n.stateComponents.AccountsAdapterAPI().GetAccountsDiff(
+		ctx,
+		holders.NewRootHashHolder(fromOptions.BlockRootHash, fromOptions.HintEpoch),
+		holders.NewRootHashHolder(toOptions.BlockRootHash, toOptions.HintEpoch),
+		n.createDataTrieLeafParser,
+		state.AccountsDiffOptions{
+			FromAddress:    fromAddress,
+			MaxNumAccounts: options.PageSize,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+
+	response := &common.StateDiffAPIResponse{
+		FromBlock: queryOptionsToApiBlockInfo(fromOptions),
+		ToBlock:   queryOptionsToApiBlockInfo(toOptions),
+	}
+	if len(accountsDiff.NextAddress) > 0 {
+		response.NextAddress, err = n.coreComponents.AddressPubKeyConverter().Encode(accountsDiff.NextAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+	response.Added, err = n.accountDiffsToApiResponse(accountsDiff.Added)
+	if err != nil {
+		return nil, err
+	}
+	response.Removed, err = n.accountDiffsToApiResponse(accountsDiff.Removed)
+	if err != nil {
+		return nil, err
+	}
+	response.Modified, err = n.accountDiffsToApiResponse(accountsDiff.Modified)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (n *Node) createDataTrieLeafParser(address []byte) (common.TrieLeafParser, error) {
+	return parsers.NewDataTrieLeafParser(address, n.coreComponents.InternalMarshalizer(), n.coreComponents.EnableEpochsHandler())
+}
+
+func (n *Node) accountDiffsToApiResponse(accountDiffs []*state.AccountDiff) ([]*common.AccountDiffAPIResponse, error) {
+	apiAccountDiffs := make([]*common.AccountDiffAPIResponse, 0, len(accountDiffs))
+	for _, accountDiff := range accountDiffs {
+		apiAccountDiff, err := n.accountDiffToApiResponse(accountDiff)
+		if err != nil {
+			return nil, err
+		}
+
+		apiAccountDiffs = append(apiAccountDiffs, apiAccountDiff)
+	}
+
+	return apiAccountDiffs, nil
+}
+
+func (n *Node) accountDiffToApiResponse(accountDiff *state.AccountDiff) (*common.AccountDiffAPIResponse, error) {
+	address, err := n.coreComponents.AddressPubKeyConverter().Encode(accountDiff.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	apiAccountDiff := &common.AccountDiffAPIResponse{
+		Address:         address,
+		DataTrieChanges: make([]*common.DataTrieChangeAPIResponse, 0, len(accountDiff.DataTrieChanges)),
+	}
+	if !check.IfNil(accountDiff.OldAccount) {
+		oldAccount, errCreate := n.createAccountResponse(address, accountDiff.OldAccount)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+		apiAccountDiff.OldAccount = &oldAccount
+	}
+	if !check.IfNil(accountDiff.NewAccount) {
+		newAccount, errCreate := n.createAccountResponse(address, accountDiff.NewAccount)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+		apiAccountDiff.NewAccount = &newAccount
+	}
+
+	for _, dataTrieChange := range accountDiff.DataTrieChanges {
+		apiAccountDiff.DataTrieChanges = append(apiAccountDiff.DataTrieChanges, &common.DataTrieChangeAPIResponse{
+			Key:      hex.EncodeToString(dataTrieChange.Key),
+			OldValue: hex.EncodeToString(dataTrieChange.OldValue),
+			NewValue: hex.EncodeToString(dataTrieChange.NewValue),
+		})
+	}
+
+	return apiAccountDiff, nil
+}
+
+func queryOptionsToApiBlockInfo(options api.AccountQueryOptions) api.BlockInfo {
+	return api.BlockInfo{
+		Nonce:    options.BlockNonce.Value,
+		Hash:     hex.EncodeToString(options.BlockHash),
+		RootHash: hex.EncodeToString(options.BlockRootHash),
+	}
+}
@@ -0,0 +1,193 @@
+package node_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/dblookupext"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	mockState "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(7)
+	fromBlockHash := []byte("fromBlockHash")
+	toBlockHash := []byte("toBlockHash")
+	fromRootHash := []byte("fromRootHash")
+	toRootHash := []byte("toRootHash")
+
+	createNode := func(accountsAPI state.AccountsAdapter) *node.Node {
+		coreComponents := getDefaultCoreComponents()
+		coreComponents.EnableEpochsHandlerField = &enableEpochsHandlerMock.EnableEpochsHandlerStub{}
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = accountsAPI
+		dataComponents := getDefaultDataComponents()
+		processComponents := getDefaultProcessComponents()
+
+		chainStorerMock := genericMocks.NewChainStorerMock(epoch)
+		headers := map[uint64][]byte{
+			10: fromBlockHash,
+			11: toBlockHash,
+		}
+		rootHashes := map[uint64][]byte{
+			10: fromRootHash,
+			11: toRootHash,
+		}
+		for nonce, blockHash := range headers {
+			blockHeaderBytes, _ := coreComponents.InternalMarshalizer().Marshal(&block.Header{
+				Nonce:    nonce,
+				Epoch:    epoch,
+				RootHash: rootHashes[nonce],
+			})
+			_ = chainStorerMock.BlockHeaders.PutInEpoch(blockHash, blockHeaderBytes, epoch)
+			nonceAsStorerKey := coreComponents.Uint64ByteSliceConverter().ToByteSlice(nonce)
+			_ = chainStorerMock.ShardHdrNonce.PutInEpoch(nonceAsStorerKey, blockHash, epoch)
+		}
+		dataComponents.Store = chainStorerMock
+
+		processComponents.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+				return epoch, nil
+			},
+		}
+		processComponents.ScheduledTxsExecutionHandlerInternal = &testscommon.ScheduledTxsExecutionStub{
+			GetScheduledRootHashForHeaderWithEpochCalled: func(headerHash []byte, epoch uint32) ([]byte, error) {
+				return nil, errors.New("missing")
+			},
+		}
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(coreComponents),
+			node.WithStateComponents(stateComponents),
+			node.WithDataComponents(dataComponents),
+			node.WithProcessComponents(processComponents),
+		)
+
+		return n
+	}
+
+	t.Run("missing block should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNode(&mockState.AccountsStub{
+			GetAccountsDiffCalled: func(_ context.Context, _ common.RootHashHolder, _ common.RootHashHolder, _ state.DataTrieLeafParserCreator, _ state.AccountsDiffOptions) (*state.AccountsDiff, error) {
+				require.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		})
+
+		response, err := n.GetStateDiff(common.StateDiffQueryOptions{FromNonce: 10, ToNonce: 12}, context.Background())
+		require.NotNil(t, err)
+		require.Nil(t, response)
+	})
+	t.Run("accounts diff error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		n := createNode(&mockState.AccountsStub{
+			GetAccountsDiffCalled: func(_ context.Context, _ common.RootHashHolder, _ common.RootHashHolder, _ state.DataTrieLeafParserCreator, _ state.AccountsDiffOptions) (*state.AccountsDiff, error) {
+				return nil, expectedErr
+			},
+		})
+
+		response, err := n.GetStateDiff(common.StateDiffQueryOptions{FromNonce: 10, ToNonce: 11}, context.Background())
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, response)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		oldBob := createAcc(testscommon.TestPubKeyBob)
+		_ = oldBob.AddToBalance(big.NewInt(10))
+		newBob := createAcc(testscommon.TestPubKeyBob)
+		_ = newBob.AddToBalance(big.NewInt(20))
+		alice := createAcc(testscommon.TestPubKeyAlice)
+		_ = alice.AddToBalance(big.NewInt(5))
+
+		n := createNode(&mockState.AccountsStub{
+			GetAccountsDiffCalled: func(_ context.Context, oldRootHash common.RootHashHolder, newRootHash common.RootHashHolder, dataTrieLeafParserCreator state.DataTrieLeafParserCreator, options state.AccountsDiffOptions) (*state.AccountsDiff, error) {
+				require.Equal(t, testscommon.TestPubKeyAlice, options.FromAddress)
+				require.Equal(t, 2, options.MaxNumAccounts)
+				require.Equal(t, fromRootHash, oldRootHash.GetRootHash())
+				require.Equal(t, epoch, oldRootHash.GetEpoch().Value)
+				require.Equal(t, toRootHash, newRootHash.GetRootHash())
+				require.Equal(t, epoch, newRootHash.GetEpoch().Value)
+
+				dataTrieLeafParser, err := dataTrieLeafParserCreator(testscommon.TestPubKeyBob)
+				require.Nil(t, err)
+				require.NotNil(t, dataTrieLeafParser)
+
+				return &state.AccountsDiff{
+					Added: []*state.AccountDiff{
+						{
+							Address:    testscommon.TestPubKeyAlice,
+							NewAccount: alice,
+						},
+					},
+					Removed: make([]*state.AccountDiff, 0),
+					Modified: []*state.AccountDiff{
+						{
+							Address:    testscommon.TestPubKeyBob,
+							OldAccount: oldBob,
+							NewAccount: newBob,
+							DataTrieChanges: []*common.TrieLeafDiff{
+								{
+									Key:      []byte("key"),
+									OldValue: []byte("old"),
+									NewValue: []byte("new"),
+								},
+							},
+						},
+					},
+					NextAddress: testscommon.TestPubKeyBob,
+				}, nil
+			},
+		})
+
+		options := common.StateDiffQueryOptions{
+			FromNonce:   10,
+			ToNonce:     11,
+			FromAddress: testscommon.TestAddressAlice,
+			PageSize:    2,
+		}
+		response, err := n.GetStateDiff(options, context.Background())
+		require.Nil(t, err)
+		require.Equal(t, uint64(10), response.FromBlock.Nonce)
+		require.Equal(t, "66726f6d526f6f7448617368", response.FromBlock.RootHash)
+		require.Equal(t, uint64(11), response.ToBlock.Nonce)
+		require.Equal(t, "746f426c6f636b48617368", response.ToBlock.Hash)
+
+		require.Equal(t, 1, len(response.Added))
+		require.Equal(t, testscommon.TestAddressAlice, response.Added[0].Address)
+		require.Nil(t, response.Added[0].OldAccount)
+		require.Equal(t, "5", response.Added[0].NewAccount.Balance)
+		require.Equal(t, 0, len(response.Removed))
+		require.Equal(t, 1, len(response.Modified))
+		require.Equal(t, testscommon.TestAddressBob, response.Modified[0].Address)
+		require.Equal(t, "10", response.Modified[0].OldAccount.Balance)
+		require.Equal(t, "20", response.Modified[0].NewAccount.Balance)
+		expectedDataTrieChanges := []*common.DataTrieChangeAPIResponse{
+			{
+				Key:      "6b6579",
+				OldValue: "6f6c64",
+				NewValue: "6e6577",
+			},
+		}
+		require.Equal(t, expectedDataTrieChanges, response.Modified[0].DataTrieChanges)
+		require.Equal(t, testscommon.TestAddressBob, response.NextAddress)
+	})
+}
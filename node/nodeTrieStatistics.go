@@ -0,0 +1,162 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/trie/statistics"
+)
+
+// failedTrieStatisticsJobRetention is the duration for which a failed job is kept, so that its error can be fetched,
+// before being evicted in order to allow a new job for the same root hash
+const failedTrieStatisticsJobRetention = time.Minute
+
+// trieStatisticsJob holds the state of the statistics collected in background for a main trie and its data tries
+type trieStatisticsJob struct {
+	rootHash  []byte
+	collector common.TriesStatisticsCollector
+	startTime time.Time
+	cancel    context.CancelFunc
+
+	mutStatus sync.RWMutex
+	status    string
+	endTime   time.Time
+	err       error
+	report    *common.TriesStatisticsReport
+}
+
+func newTrieStatisticsJob(rootHash []byte, cancel context.CancelFunc) *trieStatisticsJob {
+	return &trieStatisticsJob{
+		rootHash:  rootHash,
+		collector: statistics.NewTrieStatisticsCollector(),
+		startTime: time.Now(),
+		cancel:    cancel,
+		status:    common.TrieStatisticsJobRunning,
+	}
+}
+
+func (job *trieStatisticsJob) finish(err error) {
+	job.mutStatus.Lock()
+	defer job.mutStatus.Unlock()
+
+	job.endTime = time.Now()
+	if err != nil {
+		job.status = common.TrieStatisticsJobFailed
+		job.err = err
+		return
+	}
+
+	job.status = common.TrieStatisticsJobFinished
+	job.report = job.collector.GetReport()
+}
+
+func (job *trieStatisticsJob) isRunning() bool {
+	job.mutStatus.RLock()
+	defer job.mutStatus.RUnlock()
+
+	return job.status == common.TrieStatisticsJobRunning
+}
+
+func (job *trieStatisticsJob) hasFailedFor(duration time.Duration) bool {
+	job.mutStatus.RLock()
+	defer job.mutStatus.RUnlock()
+
+	return job.status == common.TrieStatisticsJobFailed && time.Since(job.endTime) > duration
+}
+
+func (job *trieStatisticsJob) toApiResponse() *common.TrieStatisticsAPIResponse {
+	job.mutStatus.RLock()
+	defer job.mutStatus.RUnlock()
+
+	response := &common.TrieStatisticsAPIResponse{
+		RootHash:  hex.EncodeToString(job.rootHash),
+		Status:    job.status,
+		StartTime: job.startTime.Unix(),
+		NumTries:  job.collector.GetNumTries(),
+		NumNodes:  job.collector.GetNumNodes(),
+		Report:    job.report,
+	}
+	if !job.endTime.IsZero() {
+		response.EndTime = job.endTime.Unix()
+	}
+	if job.err != nil {
+		response.Error = job.err.Error()
+	}
+
+	return response
+}
+
+// GetTrieStatistics starts collecting, in background, the statistics of the main trie with the provided root hash and
+// of all its data tries. If the statistics for the same root hash were already requested, the state of the existing
+// job is returned, so the caller can poll until the report is ready. A failed job is kept only for a limited duration,
+// after which the statistics can be requested again. An empty root hash means the root hash of the current block.
+// Only one job can run at a time
+func (n *Node) GetTrieStatistics(rootHash string) (*common.TrieStatisticsAPIResponse, error) {
+	rootHashBytes, err := n.getRootHashForTrieStatistics(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	n.mutTrieStatisticsJob.Lock()
+	defer n.mutTrieStatisticsJob.Unlock()
+
+	job := n.trieStatisticsJob
+	if job != nil && job.hasFailedFor(n.failedTrieStatisticsJobRetention) {
+		job = nil
+		n.trieStatisticsJob = nil
+	}
+	if job != nil && string(job.rootHash) == string(rootHashBytes) {
+		return job.toApiResponse(), nil
+	}
+	if job != nil && job.isRunning() {
+		return nil, ErrTrieStatisticsJobInProgress
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	job = newTrieStatisticsJob(rootHashBytes, cancel)
+	n.trieStatisticsJob = job
+
+	go n.collectTrieStatistics(ctx, job)
+
+	return job.toApiResponse(), nil
+}
+
+func (n *Node) getRootHashForTrieStatistics(rootHash string) ([]byte, error) {
+	if len(rootHash) > 0 {
+		return hex.DecodeString(rootHash)
+	}
+
+	currentRootHash := n.dataComponents.Blockchain().GetCurrentBlockRootHash()
+	if len(currentRootHash) == 0 {
+		return nil, ErrEmptyRootHash
+	}
+
+	return currentRootHash, nil
+}
+
+func (n *Node) collectTrieStatistics(ctx context.Context, job *trieStatisticsJob) {
+	log.Info("started collecting the trie statistics", "root hash", job.rootHash)
+
+	err := n.stateComponents.AccountsAdapterAPI().CollectTriesStatistics(ctx, job.rootHash, job.collector)
+	job.finish(err)
+	job.cancel()
+
+	log.Info("finished collecting the trie statistics",
+		"root hash", job.rootHash,
+		"num tries", job.collector.GetNumTries(),
+		"num nodes", job.collector.GetNumNodes(),
+		"duration", time.Since(job.startTime),
+		"error", err)
+}
+
+func (n *Node) cancelTrieStatisticsJob() {
+	n.mutTrieStatisticsJob.Lock()
+	defer n.mutTrieStatisticsJob.Unlock()
+
+	if n.trieStatisticsJob != nil {
+		n.trieStatisticsJob.cancel()
+	}
+}
@@ -0,0 +1,178 @@
+package node_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	mockState "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/trie/statistics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetTrieStatistics(t *testing.T) {
+	t.Parallel()
+
+	createNode := func(accountsAPI state.AccountsAdapter, currentRootHash []byte) *node.Node {
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = accountsAPI
+		dataComponents := getDefaultDataComponents()
+		dataComponents.BlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockRootHashCalled: func() []byte {
+				return currentRootHash
+			},
+		}
+
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithDataComponents(dataComponents),
+		)
+
+		return n
+	}
+	waitForStatus := func(t *testing.T, n *node.Node, rootHash string, status string) *common.TrieStatisticsAPIResponse {
+		for i := 0; i < 100; i++ {
+			response, err := n.GetTrieStatistics(rootHash)
+			require.Nil(t, err)
+			if response.Status == status {
+				return response
+			}
+
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		require.Fail(t, "job did not reach the expected status "+status)
+		return nil
+	}
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNode(&mockState.AccountsStub{}, nil)
+		response, err := n.GetTrieStatistics("not a hex string")
+		assert.NotNil(t, err)
+		assert.Nil(t, response)
+	})
+	t.Run("empty current root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNode(&mockState.AccountsStub{}, nil)
+		response, err := n.GetTrieStatistics("")
+		assert.Equal(t, node.ErrEmptyRootHash, err)
+		assert.Nil(t, response)
+	})
+	t.Run("should collect the statistics in background", func(t *testing.T) {
+		t.Parallel()
+
+		rootHash := []byte("rootHash")
+		statsAdded := make(chan struct{})
+		releaseJob := make(chan struct{})
+		accountsAPI := &mockState.AccountsStub{
+			CollectTriesStatisticsCalled: func(ctx context.Context, providedRootHash []byte, collector common.TriesStatisticsCollector) error {
+				assert.Equal(t, rootHash, providedRootHash)
+
+				trieStats := statistics.NewTrieStatistics()
+				trieStats.AddBranchNode(0, 10)
+				trieStats.AddLeafNode(1, 20, 0)
+				collector.Add(trieStats, common.MainTrie)
+				close(statsAdded)
+				<-releaseJob
+
+				return nil
+			},
+		}
+		n := createNode(accountsAPI, rootHash)
+
+		response, err := n.GetTrieStatistics("")
+		require.Nil(t, err)
+		assert.Equal(t, hex.EncodeToString(rootHash), response.RootHash)
+		assert.Equal(t, common.TrieStatisticsJobRunning, response.Status)
+		assert.Nil(t, response.Report)
+
+		response, err = n.GetTrieStatistics(hex.EncodeToString([]byte("otherRootHash")))
+		assert.Equal(t, node.ErrTrieStatisticsJobInProgress, err)
+		assert.Nil(t, response)
+
+		<-statsAdded
+		response, err = n.GetTrieStatistics(hex.EncodeToString(rootHash))
+		require.Nil(t, err)
+		assert.Equal(t, common.TrieStatisticsJobRunning, response.Status)
+		assert.Equal(t, uint64(1), response.NumTries)
+		assert.Equal(t, uint64(2), response.NumNodes)
+
+		close(releaseJob)
+		response = waitForStatus(t, n, hex.EncodeToString(rootHash), common.TrieStatisticsJobFinished)
+		require.NotNil(t, response.Report)
+		assert.Equal(t, uint64(2), response.Report.NumNodes)
+		assert.Equal(t, uint64(30), response.Report.TotalSize)
+		assert.NotZero(t, response.EndTime)
+		assert.Empty(t, response.Error)
+	})
+	t.Run("failed job should be replaced by a new one", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		accountsAPI := &mockState.AccountsStub{
+			CollectTriesStatisticsCalled: func(ctx context.Context, rootHash []byte, collector common.TriesStatisticsCollector) error {
+				numCalls++
+				return expectedErr
+			},
+		}
+		n := createNode(accountsAPI, nil)
+
+		rootHash := hex.EncodeToString([]byte("rootHash"))
+		_, err := n.GetTrieStatistics(rootHash)
+		require.Nil(t, err)
+
+		response := waitForStatus(t, n, rootHash, common.TrieStatisticsJobFailed)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+		assert.Nil(t, response.Report)
+
+		otherRootHash := hex.EncodeToString([]byte("otherRootHash"))
+		response, err = n.GetTrieStatistics(otherRootHash)
+		require.Nil(t, err)
+		assert.Equal(t, otherRootHash, response.RootHash)
+
+		waitForStatus(t, n, otherRootHash, common.TrieStatisticsJobFailed)
+		assert.Equal(t, 2, numCalls)
+	})
+	t.Run("failed job should be evicted after its retention", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numCalls := uint32(0)
+		accountsAPI := &mockState.AccountsStub{
+			CollectTriesStatisticsCalled: func(ctx context.Context, rootHash []byte, collector common.TriesStatisticsCollector) error {
+				atomic.AddUint32(&numCalls, 1)
+				return expectedErr
+			},
+		}
+		n := createNode(accountsAPI, nil)
+		retention := 200 * time.Millisecond
+		n.SetFailedTrieStatisticsJobRetention(retention)
+
+		rootHash := hex.EncodeToString([]byte("rootHash"))
+		_, err := n.GetTrieStatistics(rootHash)
+		require.Nil(t, err)
+
+		response := waitForStatus(t, n, rootHash, common.TrieStatisticsJobFailed)
+		assert.Equal(t, expectedErr.Error(), response.Error)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+
+		time.Sleep(retention + 50*time.Millisecond)
+		_, err = n.GetTrieStatistics(rootHash)
+		require.Nil(t, err)
+
+		waitForStatus(t, n, rootHash, common.TrieStatisticsJobFailed)
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalls))
+	})
+}
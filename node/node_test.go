@@ -32,8 +32,11 @@ import (
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
+	"github.com/multiversx/mx-chain-go/debug"
+	debugHandler "github.com/multiversx/mx-chain-go/debug/handler"
 	"github.com/multiversx/mx-chain-go/factory"
 	factoryMock "github.com/multiversx/mx-chain-go/factory/mock"
 	heartbeatData "github.com/multiversx/mx-chain-go/heartbeat/data"
@@ -42,7 +45,9 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/mock"
 	nodeMockFactory "github.com/multiversx/mx-chain-go/node/mock/factory"
+	"github.com/multiversx/mx-chain-go/node/nodeDebugFactory"
 	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/state/accounts"
 	"github.com/multiversx/mx-chain-go/state/parsers"
@@ -623,6 +628,110 @@ func TestNode_GetKeyValuePairsContextShouldTimeout(t *testing.T) {
 	assert.Equal(t, node.ErrTrieOperationsTimeout, err)
 }
 
+func TestNode_GetKeyValuePairsPage(t *testing.T) {
+	t.Parallel()
+
+	createNodeWithDataTrie := func(dataTrie *trieMock.TrieStub) *node.Node {
+		dataTrie.RootCalled = func() ([]byte, error) {
+			return nil, nil
+		}
+		acc := createAcc([]byte("newaddress"))
+		acc.SetDataTrie(dataTrie)
+
+		accDB := &stateMock.AccountsStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options common.RootHashHolder) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				return acc, nil, nil
+			},
+			RecreateTrieCalled: func(rootHash []byte) error {
+				return nil
+			},
+		}
+
+		coreComponents := getDefaultCoreComponents()
+		coreComponents.IntMarsh = getMarshalizer()
+		coreComponents.VmMarsh = getMarshalizer()
+		coreComponents.Hash = getHasher()
+		coreComponents.AddrPubKeyConv = createMockPubkeyConverter()
+		stateComponents := getDefaultStateComponents()
+		args := state.ArgsAccountsRepository{
+			FinalStateAccountsWrapper:      accDB,
+			CurrentStateAccountsWrapper:    accDB,
+			HistoricalStateAccountsWrapper: accDB,
+		}
+		stateComponents.AccountsRepo, _ = state.NewAccountsRepository(args)
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(coreComponents),
+			node.WithStateComponents(stateComponents),
+			node.WithDataComponents(getDefaultDataComponents()),
+		)
+
+		return n
+	}
+
+	t.Run("get leaves page fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		n := createNodeWithDataTrie(&trieMock.TrieStub{
+			GetLeavesPageCalled: func(_ context.Context, _ []byte, _ []byte, _ int, _ []byte, _ common.TrieLeafParser) (*common.TrieLeavesPage, error) {
+				return nil, expectedErr
+			},
+		})
+
+		page, _, err := n.GetKeyValuePairsPage(createDummyHexAddress(64), api.AccountQueryOptions{}, nil, 10, nil, context.Background())
+		assert.Nil(t, page)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("closed context should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeWithDataTrie(&trieMock.TrieStub{
+			GetLeavesPageCalled: func(_ context.Context, _ []byte, _ []byte, _ int, _ []byte, _ common.TrieLeafParser) (*common.TrieLeavesPage, error) {
+				return nil, core.ErrContextClosing
+			},
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		page, _, err := n.GetKeyValuePairsPage(createDummyHexAddress(64), api.AccountQueryOptions{}, nil, 10, nil, ctx)
+		assert.Nil(t, page)
+		assert.Equal(t, node.ErrTrieOperationsTimeout, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		k1, v1 := []byte("key1"), []byte("value1")
+		k2, v2 := []byte("key2"), []byte("value2")
+		nextKey := []byte("next key")
+		n := createNodeWithDataTrie(&trieMock.TrieStub{
+			GetLeavesPageCalled: func(_ context.Context, _ []byte, startKey []byte, maxLeaves int, keyPrefix []byte, _ common.TrieLeafParser) (*common.TrieLeavesPage, error) {
+				assert.Equal(t, []byte("start key"), startKey)
+				assert.Equal(t, 2, maxLeaves)
+				assert.Equal(t, []byte("key"), keyPrefix)
+
+				return &common.TrieLeavesPage{
+					Leaves: []core.KeyValueHolder{
+						keyValStorage.NewKeyValStorage(k1, v1),
+						keyValStorage.NewKeyValStorage(k2, v2),
+					},
+					NextKey: nextKey,
+				}, nil
+			},
+		})
+
+		page, _, err := n.GetKeyValuePairsPage(createDummyHexAddress(64), api.AccountQueryOptions{}, []byte("start key"), 2, []byte("key"), context.Background())
+		assert.Nil(t, err)
+		expectedPairs := []common.KeyValuePair{
+			{Key: hex.EncodeToString(k1), Value: hex.EncodeToString(v1)},
+			{Key: hex.EncodeToString(k2), Value: hex.EncodeToString(v2)},
+		}
+		assert.Equal(t, expectedPairs, page.Pairs)
+		assert.Equal(t, hex.EncodeToString(nextKey), page.NextKey)
+	})
+}
+
 func TestNode_GetValueForKeyAccNotFoundShouldReturnEmpty(t *testing.T) {
 	t.Parallel()
 
@@ -3837,6 +3946,48 @@ func TestNode_GetQueryHandlerShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestNode_GetInterceptorResolverDebugCounters(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing debugger should return empty slice", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+
+		assert.Empty(t, n.GetInterceptorResolverDebugCounters())
+	})
+	t.Run("debugger not providing counters should return empty slice", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+		_ = n.AddQueryHandler(nodeDebugFactory.InterceptorDebugger, &mock.QueryHandlerStub{})
+
+		assert.Empty(t, n.GetInterceptorResolverDebugCounters())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+		interceptorDebugger, _ := debugHandler.NewInterceptorDebugHandler(config.InterceptorResolverDebugConfig{
+			Enabled:   true,
+			CacheSize: 100,
+		})
+		interceptorDebugger.LogRequestedData("topic", [][]byte{[]byte("hash")}, 1, 2)
+		_ = n.AddQueryHandler(nodeDebugFactory.InterceptorDebugger, interceptorDebugger)
+
+		expectedCounters := []*debug.InterceptorResolverCounters{
+			{
+				EventType:   "request",
+				Topic:       "topic",
+				NumEvents:   1,
+				NumReqIntra: 1,
+				NumReqCross: 2,
+			},
+		}
+		assert.Equal(t, expectedCounters, n.GetInterceptorResolverDebugCounters())
+	})
+}
+
 func TestNode_GetPeerInfoUnknownPeerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -3974,10 +4125,104 @@ func TestNode_ValidateTransactionForSimulation_CheckSignatureFalse(t *testing.T)
 		ChainID:   []byte(coreComponents.ChainID()),
 	}
 
-	err := n.ValidateTransactionForSimulation(tx, false)
+	err := n.ValidateTransactionForSimulation(tx, false, nil)
 	require.NoError(t, err)
 }
 
+func TestNode_ValidateTransactionForSimulation_SenderStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = getMarshalizer()
+	coreComponents.VmMarsh = getMarshalizer()
+	coreComponents.Hash = getHasher()
+	coreComponents.AddrPubKeyConv = testscommon.NewPubkeyConverterMock(3)
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			// the sender has a nonce higher than the one of the transaction
+			acc := createAcc(address)
+			acc.IncreaseNonce(20)
+			return acc, nil
+		},
+	}
+
+	bootstrapComponents := getDefaultBootstrapComponents()
+	bootstrapComponents.ShCoordinator = &mock.ShardCoordinatorMock{}
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.ShardCoord = bootstrapComponents.ShCoordinator
+	processComponents.WhiteListHandlerInternal = &testscommon.WhiteListHandlerStub{}
+	processComponents.WhiteListerVerifiedTxsInternal = &testscommon.WhiteListHandlerStub{}
+	processComponents.EpochTrigger = &mock.EpochStartTriggerStub{}
+
+	cryptoComponents := getDefaultCryptoComponents()
+	cryptoComponents.TxKeyGen = &mock.KeyGenMock{
+		PublicKeyFromByteArrayMock: func(b []byte) (crypto.PublicKey, error) {
+			return nil, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithProcessComponents(processComponents),
+		node.WithBootstrapComponents(bootstrapComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithCryptoComponents(cryptoComponents),
+	)
+
+	tx := &transaction.Transaction{
+		Nonce:     11,
+		Value:     big.NewInt(25),
+		RcvAddr:   []byte("rec"),
+		SndAddr:   []byte("snd"),
+		GasPrice:  6,
+		GasLimit:  12,
+		Data:      []byte(""),
+		Signature: []byte("sig1"),
+		ChainID:   []byte(coreComponents.ChainID()),
+	}
+	sender := hex.EncodeToString(tx.SndAddr)
+	nonce := uint64(11)
+
+	t.Run("no overrides should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := n.ValidateTransactionForSimulation(tx, false, nil)
+		require.True(t, errors.Is(err, process.ErrWrongTransaction))
+	})
+	t.Run("overrides for another address should error", func(t *testing.T) {
+		t.Parallel()
+
+		stateOverrides := map[string]*txSimData.AccountOverride{
+			hex.EncodeToString([]byte("rec")): {Nonce: &nonce},
+		}
+		err := n.ValidateTransactionForSimulation(tx, false, stateOverrides)
+		require.True(t, errors.Is(err, process.ErrWrongTransaction))
+	})
+	t.Run("sender override without nonce or balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		stateOverrides := map[string]*txSimData.AccountOverride{
+			sender: {Code: "aa"},
+		}
+		err := n.ValidateTransactionForSimulation(tx, false, stateOverrides)
+		require.True(t, errors.Is(err, process.ErrWrongTransaction))
+	})
+	t.Run("sender balance and nonce override should work", func(t *testing.T) {
+		t.Parallel()
+
+		stateOverrides := map[string]*txSimData.AccountOverride{
+			sender: {
+				Balance: "1000000",
+				Nonce:   &nonce,
+			},
+		}
+		err := n.ValidateTransactionForSimulation(tx, false, stateOverrides)
+		require.NoError(t, err)
+	})
+}
+
 func TestGetKeyValuePairs_CannotDecodeAddress(t *testing.T) {
 	t.Parallel()
 
@@ -4328,6 +4573,212 @@ func TestNode_VerifyProof(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestNode_GetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+		response, err := n.GetMultiProof("invalidRootHash", []string{"0123"})
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid key should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		response, err := n.GetMultiProof("deadbeef", []string{"0123", "key"})
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("get trie error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return nil, expectedErr
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		response, err := n.GetMultiProof("deadbeef", []string{"0123"})
+		assert.Nil(t, response)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		trieKeys := []string{"0123", "4567"}
+		values := [][]byte{[]byte("value"), nil}
+		proof := [][]byte{[]byte("valid"), []byte("proof")}
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return &trieMock.TrieStub{
+					GetMultiProofCalled: func(keys [][]byte) ([][]byte, [][]byte, error) {
+						require.Equal(t, len(trieKeys), len(keys))
+						for i := range keys {
+							assert.Equal(t, trieKeys[i], hex.EncodeToString(keys[i]))
+						}
+						return proof, values, nil
+					},
+				}, nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		rootHash := "deadbeef"
+		response, err := n.GetMultiProof(rootHash, trieKeys)
+		assert.Nil(t, err)
+		assert.Equal(t, proof, response.Proof)
+		assert.Equal(t, values, response.Values)
+		assert.Equal(t, rootHash, response.RootHash)
+	})
+}
+
+func TestNode_GetMultiProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+		response, err := n.GetMultiProofDataTrie("invalidRootHash", "0123", []string{"4567"})
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid key should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		response, err := n.GetMultiProofDataTrie("deadbeef", "0123", []string{"4567", "key"})
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		address := "0123"
+		keys := []string{"4567", "89ab"}
+		mainTrieProof := [][]byte{[]byte("valid"), []byte("proof"), []byte("mainTrie")}
+		dataTrieProof := [][]byte{[]byte("valid"), []byte("proof"), []byte("dataTrie")}
+		dataTrieValues := [][]byte{[]byte("trie value"), nil, nil, nil}
+		dataTrieRootHash := []byte("dataTrieRoot")
+		coreComponents := getDefaultCoreComponents()
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+				return &trieMock.TrieStub{
+					GetProofCalled: func(key []byte) ([][]byte, []byte, error) {
+						assert.Equal(t, address, hex.EncodeToString(key))
+						return mainTrieProof, []byte("account"), nil
+					},
+					GetMultiProofCalled: func(trieKeys [][]byte) ([][]byte, [][]byte, error) {
+						assert.Equal(t, dataTrieRootHash, rootHash)
+						require.Equal(t, 2*len(keys), len(trieKeys))
+						for i, key := range keys {
+							keyBytes, _ := hex.DecodeString(key)
+							assert.Equal(t, coreComponents.Hash.Compute(string(keyBytes)), trieKeys[2*i])
+							assert.Equal(t, keyBytes, trieKeys[2*i+1])
+						}
+						return dataTrieProof, dataTrieValues, nil
+					},
+				}, nil
+			},
+			GetAccountFromBytesCalled: func(_ []byte, _ []byte) (vmcommon.AccountHandler, error) {
+				acc := &stateMock.AccountWrapMock{}
+				acc.SetTrackableDataTrie(&trieMock.DataTrieTrackerStub{
+					RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+						if hex.EncodeToString(key) == keys[0] {
+							return []byte("value"), 0, nil
+						}
+						return nil, 0, nil
+					},
+				})
+				acc.SetRootHash(dataTrieRootHash)
+				return acc, nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(coreComponents),
+		)
+
+		response, err := n.GetMultiProofDataTrie("deadbeef", address, keys)
+		require.Nil(t, err)
+		assert.Equal(t, mainTrieProof, response.MainProof.Proof)
+		assert.Equal(t, dataTrieProof, response.DataTrieProof.Proof)
+		assert.Equal(t, dataTrieValues, response.DataTrieProof.Values)
+		assert.Equal(t, hex.EncodeToString(dataTrieRootHash), response.DataTrieProof.RootHash)
+		assert.Equal(t, [][]byte{[]byte("value"), nil}, response.Values)
+		assert.Equal(t, 4, len(response.DataTrieKeys))
+	})
+}
+
+func TestNode_VerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+		response, err := n.VerifyMultiProof("invalidRootHash", []string{"0123"}, [][]byte{nil}, [][]byte{})
+		assert.False(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid key should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		response, err := n.VerifyMultiProof("deadbeef", []string{"key"}, [][]byte{nil}, [][]byte{})
+		assert.False(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("wrong value should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := getDefaultCoreComponents()
+		coreComponents.Hash = sha256.NewSha256()
+		coreComponents.IntMarsh = &marshal.GogoProtoMarshalizer{}
+		n, _ := node.NewNode(
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithCoreComponents(coreComponents),
+		)
+
+		rootHash := "bc2e549d98c31ffe6e9419b933d03b37e84f74c42601412302799d277651a6d8"
+		address := "bf42213747697e9dec4211ef50ba6061b54729b53ba0c4994948cab478af8854"
+		p, _ := hex.DecodeString("0a41040508080f0a0807040b0a0c080409040909040c000a0b03050b09020704050b010600060a0b00050f0e010102040c0e0d090e07090607040703010202040f0b10124c1202000022206182d14320be95434f5508acad9478d3b6cf837bfce7ebfe47c2e860d1b98ca72a20bf42213747697e9dec4211ef50ba6061b54729b53ba0c4994948cab478af88543202000001")
+
+		response, err := n.VerifyMultiProof(rootHash, []string{address}, [][]byte{[]byte("wrong value")}, [][]byte{p})
+		assert.False(t, response)
+		assert.Nil(t, err)
+	})
+}
+
 func TestNode_IsDataTrieMigrated(t *testing.T) {
 	t.Parallel()
 
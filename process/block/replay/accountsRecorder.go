@@ -0,0 +1,132 @@
+package replay
+
+import (
+	"encoding/hex"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+type accountsRecorder struct {
+	state.AccountsAdapter
+	addressConverter core.PubkeyConverter
+
+	mutRecords       sync.RWMutex
+	recordedAccounts map[string]*AccountState
+	lastRootHash     []byte
+}
+
+// NewAccountsRecorder creates an accounts adapter wrapper that records the state of every saved or removed account
+func NewAccountsRecorder(accounts state.AccountsAdapter, addressConverter core.PubkeyConverter) (*accountsRecorder, error) {
+	if check.IfNil(accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(addressConverter) {
+		return nil, ErrNilAddressConverter
+	}
+
+	return &accountsRecorder{
+		AccountsAdapter:  accounts,
+		addressConverter: addressConverter,
+		recordedAccounts: make(map[string]*AccountState),
+	}, nil
+}
+
+// SaveAccount saves the account in the wrapped accounts adapter and records its new state
+func (recorder *accountsRecorder) SaveAccount(account vmcommon.AccountHandler) error {
+	err := recorder.AccountsAdapter.SaveAccount(account)
+	if err != nil {
+		return err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil
+	}
+
+	recorder.mutRecords.Lock()
+	recorder.recordedAccounts[string(account.AddressBytes())] = newAccountState(userAccount, recorder.addressConverter)
+	recorder.mutRecords.Unlock()
+
+	return nil
+}
+
+// RemoveAccount removes the account from the wrapped accounts adapter and records it as missing
+func (recorder *accountsRecorder) RemoveAccount(address []byte) error {
+	err := recorder.AccountsAdapter.RemoveAccount(address)
+	if err != nil {
+		return err
+	}
+
+	recorder.mutRecords.Lock()
+	recorder.recordedAccounts[string(address)] = nil
+	recorder.mutRecords.Unlock()
+
+	return nil
+}
+
+// RootHash returns the root hash of the wrapped accounts adapter and remembers it
+func (recorder *accountsRecorder) RootHash() ([]byte, error) {
+	rootHash, err := recorder.AccountsAdapter.RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	recorder.mutRecords.Lock()
+	recorder.lastRootHash = rootHash
+	recorder.mutRecords.Unlock()
+
+	return rootHash, nil
+}
+
+// RecordedAccounts returns the last recorded state of each saved or removed account. Removed accounts have a nil state
+func (recorder *accountsRecorder) RecordedAccounts() map[string]*AccountState {
+	recorder.mutRecords.RLock()
+	defer recorder.mutRecords.RUnlock()
+
+	recordedAccounts := make(map[string]*AccountState, len(recorder.recordedAccounts))
+	for address, accountState := range recorder.recordedAccounts {
+		recordedAccounts[address] = accountState
+	}
+
+	return recordedAccounts
+}
+
+// LastRootHash returns the last root hash computed by the wrapped accounts adapter
+func (recorder *accountsRecorder) LastRootHash() []byte {
+	recorder.mutRecords.RLock()
+	defer recorder.mutRecords.RUnlock()
+
+	return recorder.lastRootHash
+}
+
+// ResetRecords clears the recorded accounts and root hash
+func (recorder *accountsRecorder) ResetRecords() {
+	recorder.mutRecords.Lock()
+	recorder.recordedAccounts = make(map[string]*AccountState)
+	recorder.lastRootHash = nil
+	recorder.mutRecords.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (recorder *accountsRecorder) IsInterfaceNil() bool {
+	return recorder == nil
+}
+
+func newAccountState(account state.UserAccountHandler, addressConverter core.PubkeyConverter) *AccountState {
+	accountState := &AccountState{
+		Nonce:           account.GetNonce(),
+		Balance:         account.GetBalance().String(),
+		DeveloperReward: account.GetDeveloperReward().String(),
+		CodeHash:        hex.EncodeToString(account.GetCodeHash()),
+		RootHash:        hex.EncodeToString(account.GetRootHash()),
+	}
+	if len(account.GetOwnerAddress()) > 0 {
+		accountState.OwnerAddress = addressConverter.SilentEncode(account.GetOwnerAddress(), log)
+	}
+
+	return accountState
+}
@@ -0,0 +1,171 @@
+package replay
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/testscommon"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccountsRecorder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		recorder, err := NewAccountsRecorder(nil, testscommon.NewPubkeyConverterMock(32))
+		assert.Nil(t, recorder)
+		assert.Equal(t, ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		recorder, err := NewAccountsRecorder(&stateMock.AccountsStub{}, nil)
+		assert.Nil(t, recorder)
+		assert.Equal(t, ErrNilAddressConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		recorder, err := NewAccountsRecorder(&stateMock.AccountsStub{}, testscommon.NewPubkeyConverterMock(32))
+		assert.Nil(t, err)
+		assert.False(t, recorder.IsInterfaceNil())
+	})
+}
+
+func TestAccountsRecorder_SaveAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("save error should not record the account", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		accounts := &stateMock.AccountsStub{
+			SaveAccountCalled: func(account vmcommon.AccountHandler) error {
+				return expectedErr
+			},
+		}
+		recorder, _ := NewAccountsRecorder(accounts, testscommon.NewPubkeyConverterMock(32))
+
+		err := recorder.SaveAccount(stateMock.NewAccountWrapMock([]byte("address")))
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, recorder.RecordedAccounts())
+	})
+	t.Run("should record the last saved state of the account", func(t *testing.T) {
+		t.Parallel()
+
+		numSaveCalls := 0
+		accounts := &stateMock.AccountsStub{
+			SaveAccountCalled: func(account vmcommon.AccountHandler) error {
+				numSaveCalls++
+				return nil
+			},
+		}
+		recorder, _ := NewAccountsRecorder(accounts, testscommon.NewPubkeyConverterMock(32))
+
+		address := []byte("address")
+		account := stateMock.NewAccountWrapMock(address)
+		account.Balance = big.NewInt(10)
+		account.RootHash = []byte("root hash")
+		err := recorder.SaveAccount(account)
+		require.Nil(t, err)
+
+		account = stateMock.NewAccountWrapMock(address)
+		account.IncreaseNonce(2)
+		account.Balance = big.NewInt(7)
+		err = recorder.SaveAccount(account)
+		require.Nil(t, err)
+
+		recordedAccounts := recorder.RecordedAccounts()
+		require.Equal(t, 1, len(recordedAccounts))
+		assert.Equal(t, 2, numSaveCalls)
+		assert.Equal(t, uint64(2), recordedAccounts[string(address)].Nonce)
+		assert.Equal(t, "7", recordedAccounts[string(address)].Balance)
+		assert.Empty(t, recordedAccounts[string(address)].RootHash)
+	})
+	t.Run("non user account should not be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		recorder, _ := NewAccountsRecorder(&stateMock.AccountsStub{}, testscommon.NewPubkeyConverterMock(32))
+
+		err := recorder.SaveAccount(&stateMock.PeerAccountHandlerMock{})
+		assert.Nil(t, err)
+		assert.Empty(t, recorder.RecordedAccounts())
+	})
+}
+
+func TestAccountsRecorder_RemoveAccount(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	accounts := &stateMock.AccountsStub{
+		RemoveAccountCalled: func(addressContainer []byte) error {
+			assert.Equal(t, address, addressContainer)
+			return nil
+		},
+	}
+	recorder, _ := NewAccountsRecorder(accounts, testscommon.NewPubkeyConverterMock(32))
+	_ = recorder.SaveAccount(stateMock.NewAccountWrapMock(address))
+
+	err := recorder.RemoveAccount(address)
+	assert.Nil(t, err)
+
+	recordedAccounts := recorder.RecordedAccounts()
+	accountState, found := recordedAccounts[string(address)]
+	assert.True(t, found)
+	assert.Nil(t, accountState)
+}
+
+func TestAccountsRecorder_RootHashAndResetRecords(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	accounts := &stateMock.AccountsStub{
+		RootHashCalled: func() ([]byte, error) {
+			return rootHash, nil
+		},
+	}
+	recorder, _ := NewAccountsRecorder(accounts, testscommon.NewPubkeyConverterMock(32))
+	_ = recorder.SaveAccount(stateMock.NewAccountWrapMock([]byte("address")))
+
+	computedRootHash, err := recorder.RootHash()
+	assert.Nil(t, err)
+	assert.Equal(t, rootHash, computedRootHash)
+	assert.Equal(t, rootHash, recorder.LastRootHash())
+	assert.Equal(t, 1, len(recorder.RecordedAccounts()))
+
+	recorder.ResetRecords()
+	assert.Nil(t, recorder.LastRootHash())
+	assert.Empty(t, recorder.RecordedAccounts())
+}
+
+func TestNewAccountState(t *testing.T) {
+	t.Parallel()
+
+	account := &stateMock.UserAccountStub{
+		Balance:          big.NewInt(100),
+		DeveloperRewards: big.NewInt(3),
+		CodeHash:         []byte("code hash"),
+		Owner:            []byte("owner"),
+		GetRootHashCalled: func() []byte {
+			return []byte("root hash")
+		},
+	}
+
+	accountState := newAccountState(account, testscommon.NewPubkeyConverterMock(32))
+	expectedAccountState := &AccountState{
+		Nonce:           0,
+		Balance:         "100",
+		DeveloperReward: "3",
+		CodeHash:        hex.EncodeToString([]byte("code hash")),
+		RootHash:        hex.EncodeToString([]byte("root hash")),
+		OwnerAddress:    hex.EncodeToString([]byte("owner")),
+	}
+	assert.Equal(t, expectedAccountState, accountState)
+}
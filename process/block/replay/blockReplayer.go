@@ -0,0 +1,573 @@
+package replay
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("process/block/replay")
+
+// maxHeadersToLookBack bounds the search for the headers notarized before the replayed block
+const maxHeadersToLookBack = 1000
+
+// ArgsBlockReplayer holds the arguments needed to create a new block replayer
+type ArgsBlockReplayer struct {
+	BlockProcessor               process.BlockProcessor
+	BlockTracker                 process.BlockTracker
+	BlockChain                   data.ChainHandler
+	ScheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
+	Accounts                     AccountsRecorder
+	AccountsRepository           state.AccountsRepository
+	StorageService               dataRetriever.StorageService
+	DataPool                     dataRetriever.PoolsHolder
+	Marshaller                   marshal.Marshalizer
+	Uint64Converter              typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator             sharding.Coordinator
+	AddressConverter             core.PubkeyConverter
+	ProcessingTimeout            time.Duration
+}
+
+type blockReplayer struct {
+	blockProcessor               process.BlockProcessor
+	blockTracker                 process.BlockTracker
+	blockChain                   data.ChainHandler
+	scheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
+	accounts                     AccountsRecorder
+	accountsRepository           state.AccountsRepository
+	storageService               dataRetriever.StorageService
+	dataPool                     dataRetriever.PoolsHolder
+	marshaller                   marshal.Marshalizer
+	uint64Converter              typeConverters.Uint64ByteSliceConverter
+	shardCoordinator             sharding.Coordinator
+	addressConverter             core.PubkeyConverter
+	processingTimeout            time.Duration
+}
+
+// NewBlockReplayer creates a component able to re-execute a block stored by a node on top of the state of the
+// previous block and to report the accounts for which the computed state differs from the recorded one
+func NewBlockReplayer(args ArgsBlockReplayer) (*blockReplayer, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockReplayer{
+		blockProcessor:               args.BlockProcessor,
+		blockTracker:                 args.BlockTracker,
+		blockChain:                   args.BlockChain,
+		scheduledTxsExecutionHandler: args.ScheduledTxsExecutionHandler,
+		accounts:                     args.Accounts,
+		accountsRepository:           args.AccountsRepository,
+		storageService:               args.StorageService,
+		dataPool:                     args.DataPool,
+		marshaller:                   args.Marshaller,
+		uint64Converter:              args.Uint64Converter,
+		shardCoordinator:             args.ShardCoordinator,
+		addressConverter:             args.AddressConverter,
+		processingTimeout:            args.ProcessingTimeout,
+	}, nil
+}
+
+func checkArgs(args ArgsBlockReplayer) error {
+	if check.IfNil(args.BlockProcessor) {
+		return ErrNilBlockProcessor
+	}
+	if check.IfNil(args.BlockTracker) {
+		return ErrNilBlockTracker
+	}
+	if check.IfNil(args.BlockChain) {
+		return ErrNilBlockChain
+	}
+	if check.IfNil(args.ScheduledTxsExecutionHandler) {
+		return ErrNilScheduledTxsExecutionHandler
+	}
+	if check.IfNil(args.Accounts) {
+		return ErrNilAccountsRecorder
+	}
+	if check.IfNil(args.AccountsRepository) {
+		return ErrNilAccountsRepository
+	}
+	if check.IfNil(args.StorageService) {
+		return ErrNilStorageService
+	}
+	if check.IfNil(args.DataPool) {
+		return ErrNilDataPool
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return ErrNilUint64Converter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.AddressConverter) {
+		return ErrNilAddressConverter
+	}
+	if args.ProcessingTimeout <= 0 {
+		return ErrInvalidProcessingTimeout
+	}
+
+	return nil
+}
+
+// ReplayBlock re-executes the block with the provided nonce of the self shard on top of the state of the previous
+// block. The changes are never committed: the state is reverted once the computed root hash is known
+func (br *blockReplayer) ReplayBlock(nonce uint64) (*ReplayResults, error) {
+	if nonce == 0 {
+		return nil, ErrGenesisBlockCannotBeReplayed
+	}
+
+	shardID := br.shardCoordinator.SelfId()
+	header, headerHash, err := process.GetHeaderFromStorageWithNonce(nonce, shardID, br.storageService, br.uint64Converter, br.marshaller)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the block with nonce %d", err, nonce)
+	}
+
+	prevHeader, err := process.GetHeaderFromStorage(shardID, header.GetPrevHash(), br.marshaller, br.storageService)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the previous block", err)
+	}
+
+	body, err := br.getBlockBody(header)
+	if err != nil {
+		return nil, err
+	}
+
+	prevRootHash, err := br.restoreStateToBlock(prevHeader, header.GetPrevHash())
+	if err != nil {
+		return nil, err
+	}
+
+	err = br.prepareDataForProcessing(header, body)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("replaying block", "shard", shardID, "nonce", nonce, "hash", headerHash, "previous root hash", prevRootHash)
+
+	br.accounts.ResetRecords()
+	deadline := time.Now().Add(br.processingTimeout)
+	haveTime := func() time.Duration {
+		return time.Until(deadline)
+	}
+
+	results := &ReplayResults{
+		ShardID:          shardID,
+		Nonce:            nonce,
+		Hash:             hex.EncodeToString(headerHash),
+		ExpectedRootHash: hex.EncodeToString(header.GetRootHash()),
+	}
+
+	err = br.blockProcessor.ProcessBlock(header, body, haveTime)
+	if err == nil {
+		// the block processor leaves the changes uncommitted, they are dropped as the replay must not alter the state
+		br.blockProcessor.RevertCurrentBlock()
+		results.ComputedRootHash = results.ExpectedRootHash
+		results.RootHashMatches = true
+
+		return results, nil
+	}
+	if !errors.Is(err, process.ErrRootStateDoesNotMatch) {
+		return nil, fmt.Errorf("%w while processing the block", err)
+	}
+
+	results.ComputedRootHash = hex.EncodeToString(br.accounts.LastRootHash())
+	results.AccountsDiff, err = br.computeAccountsDiff(prevHeader, prevRootHash, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (br *blockReplayer) getBlockBody(header data.HeaderHandler) (*block.Body, error) {
+	miniBlocksStorer, err := br.storageService.GetStorer(dataRetriever.MiniBlockUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlockHeaders := header.GetMiniBlockHeaderHandlers()
+	body := &block.Body{
+		MiniBlocks: make([]*block.MiniBlock, 0, len(miniBlockHeaders)),
+	}
+	for _, miniBlockHeader := range miniBlockHeaders {
+		buff, errGet := miniBlocksStorer.GetFromEpoch(miniBlockHeader.GetHash(), header.GetEpoch())
+		if errGet != nil {
+			return nil, fmt.Errorf("%w while loading the mini block %s", errGet, hex.EncodeToString(miniBlockHeader.GetHash()))
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = br.marshaller.Unmarshal(miniBlock, buff)
+		if err != nil {
+			return nil, err
+		}
+
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+	}
+
+	return body, nil
+}
+
+// restoreStateToBlock brings the chain, the scheduled info and the accounts to the moment right after the provided
+// header was committed, the same way the storage bootstrapper does when a node restarts
+func (br *blockReplayer) restoreStateToBlock(header data.HeaderHandler, headerHash []byte) ([]byte, error) {
+	err := br.blockChain.SetCurrentBlockHeaderAndRootHash(header, header.GetRootHash())
+	if err != nil {
+		return nil, err
+	}
+	br.blockChain.SetCurrentBlockHeaderHash(headerHash)
+
+	err = br.scheduledTxsExecutionHandler.RollBackToBlock(headerHash)
+	if err != nil {
+		scheduledInfo := &process.ScheduledInfo{
+			RootHash:        header.GetRootHash(),
+			IntermediateTxs: make(map[block.Type][]data.TransactionHandler),
+			GasAndFees:      process.GetZeroGasAndFees(),
+			MiniBlocks:      make(block.MiniBlockSlice, 0),
+		}
+		br.scheduledTxsExecutionHandler.SetScheduledInfo(scheduledInfo)
+	}
+
+	rootHash := br.scheduledTxsExecutionHandler.GetScheduledRootHash()
+	err = br.blockProcessor.RevertStateToBlock(header, rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while restoring the state of the previous block", err)
+	}
+
+	err = br.restoreCrossNotarizedHeaders(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return rootHash, nil
+}
+
+func (br *blockReplayer) restoreCrossNotarizedHeaders(header data.HeaderHandler) error {
+	if br.shardCoordinator.SelfId() == core.MetachainShardId {
+		return br.restoreCrossNotarizedShardHeaders(header)
+	}
+
+	return br.restoreCrossNotarizedMetaHeader(header)
+}
+
+// restoreCrossNotarizedMetaHeader searches backwards, starting from the provided shard header, the last referenced
+// meta header and marks it as the last cross notarized one
+func (br *blockReplayer) restoreCrossNotarizedMetaHeader(header data.HeaderHandler) error {
+	for i := 0; i < maxHeadersToLookBack && header.GetNonce() > 0; i++ {
+		shardHeader, ok := header.(data.ShardHeaderHandler)
+		if !ok {
+			return process.ErrWrongTypeAssertion
+		}
+
+		var lastMetaHeader data.HeaderHandler
+		var lastMetaHeaderHash []byte
+		for _, metaHash := range shardHeader.GetMetaBlockHashes() {
+			metaHeader, err := process.GetMetaHeaderFromStorage(metaHash, br.marshaller, br.storageService)
+			if err != nil {
+				return err
+			}
+
+			if check.IfNil(lastMetaHeader) || metaHeader.GetNonce() > lastMetaHeader.GetNonce() {
+				lastMetaHeader, lastMetaHeaderHash = metaHeader, metaHash
+			}
+		}
+
+		if !check.IfNil(lastMetaHeader) {
+			br.blockTracker.AddCrossNotarizedHeader(core.MetachainShardId, lastMetaHeader, lastMetaHeaderHash)
+			return nil
+		}
+
+		var err error
+		header, err = process.GetShardHeaderFromStorage(header.GetPrevHash(), br.marshaller, br.storageService)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("no meta header referenced before the replayed block, keeping the genesis one as cross notarized")
+
+	return nil
+}
+
+// restoreCrossNotarizedShardHeaders searches backwards, starting from the provided meta header, the last notarized
+// header of each shard and marks them as the last cross notarized ones
+func (br *blockReplayer) restoreCrossNotarizedShardHeaders(header data.HeaderHandler) error {
+	numShards := br.shardCoordinator.NumberOfShards()
+	notarizedShards := make(map[uint32]struct{}, numShards)
+	for i := 0; i < maxHeadersToLookBack && header.GetNonce() > 0 && uint32(len(notarizedShards)) < numShards; i++ {
+		metaHeader, ok := header.(data.MetaHeaderHandler)
+		if !ok {
+			return process.ErrWrongTypeAssertion
+		}
+
+		lastShardInfo := make(map[uint32]data.ShardDataHandler)
+		for _, shardInfo := range metaHeader.GetShardInfoHandlers() {
+			_, alreadyNotarized := notarizedShards[shardInfo.GetShardID()]
+			if alreadyNotarized {
+				continue
+			}
+
+			lastInfo, found := lastShardInfo[shardInfo.GetShardID()]
+			if !found || shardInfo.GetNonce() > lastInfo.GetNonce() {
+				lastShardInfo[shardInfo.GetShardID()] = shardInfo
+			}
+		}
+
+		for shardID, shardInfo := range lastShardInfo {
+			shardHeader, err := process.GetShardHeaderFromStorage(shardInfo.GetHeaderHash(), br.marshaller, br.storageService)
+			if err != nil {
+				return err
+			}
+
+			br.blockTracker.AddCrossNotarizedHeader(shardID, shardHeader, shardInfo.GetHeaderHash())
+			notarizedShards[shardID] = struct{}{}
+		}
+
+		var err error
+		header, err = process.GetMetaHeaderFromStorage(header.GetPrevHash(), br.marshaller, br.storageService)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prepareDataForProcessing adds in the data pool the transactions and the headers needed by the block processor, as
+// they would have been received from the network
+func (br *blockReplayer) prepareDataForProcessing(header data.HeaderHandler, body *block.Body) error {
+	err := br.addTransactionsToPools(header, body)
+	if err != nil {
+		return err
+	}
+
+	if br.shardCoordinator.SelfId() == core.MetachainShardId {
+		return br.addShardHeadersToPool(header)
+	}
+
+	return br.addMetaHeadersToPool(header)
+}
+
+func (br *blockReplayer) addTransactionsToPools(header data.HeaderHandler, body *block.Body) error {
+	for _, miniBlock := range body.MiniBlocks {
+		unit, pool, createTx, ok := br.getTransactionsSource(miniBlock.Type)
+		if !ok {
+			log.Debug("skipping the transactions of the mini block", "type", miniBlock.Type.String())
+			continue
+		}
+
+		storer, err := br.storageService.GetStorer(unit)
+		if err != nil {
+			return err
+		}
+
+		cacheID := process.ShardCacherIdentifier(miniBlock.SenderShardID, miniBlock.ReceiverShardID)
+		for _, txHash := range miniBlock.TxHashes {
+			buff, errGet := storer.GetFromEpoch(txHash, header.GetEpoch())
+			if errGet != nil {
+				return fmt.Errorf("%w while loading the transaction %s", errGet, hex.EncodeToString(txHash))
+			}
+
+			tx := createTx()
+			err = br.marshaller.Unmarshal(tx, buff)
+			if err != nil {
+				return err
+			}
+
+			pool.AddData(txHash, tx, len(buff), cacheID)
+		}
+	}
+
+	return nil
+}
+
+func (br *blockReplayer) getTransactionsSource(miniBlockType block.Type) (dataRetriever.UnitType, dataRetriever.ShardedDataCacherNotifier, func() data.TransactionHandler, bool) {
+	switch miniBlockType {
+	case block.TxBlock, block.InvalidBlock:
+		return dataRetriever.TransactionUnit, br.dataPool.Transactions(), func() data.TransactionHandler {
+			return &transaction.Transaction{}
+		}, true
+	case block.SmartContractResultBlock:
+		return dataRetriever.UnsignedTransactionUnit, br.dataPool.UnsignedTransactions(), func() data.TransactionHandler {
+			return &smartContractResult.SmartContractResult{}
+		}, true
+	case block.RewardsBlock:
+		return dataRetriever.RewardTransactionUnit, br.dataPool.RewardTransactions(), func() data.TransactionHandler {
+			return &rewardTx.RewardTx{}
+		}, true
+	default:
+		return 0, nil, nil, false
+	}
+}
+
+func (br *blockReplayer) addMetaHeadersToPool(header data.HeaderHandler) error {
+	shardHeader, ok := header.(data.ShardHeaderHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	lastMetaNonce := uint64(0)
+	for _, metaHash := range shardHeader.GetMetaBlockHashes() {
+		metaHeader, err := process.GetMetaHeaderFromStorage(metaHash, br.marshaller, br.storageService)
+		if err != nil {
+			return err
+		}
+
+		br.dataPool.Headers().AddHeader(metaHash, metaHeader)
+		if metaHeader.GetNonce() > lastMetaNonce {
+			lastMetaNonce = metaHeader.GetNonce()
+		}
+	}
+	if lastMetaNonce == 0 {
+		return nil
+	}
+
+	br.addFinalityAttestingHeadersToPool(core.MetachainShardId, lastMetaNonce)
+
+	return nil
+}
+
+func (br *blockReplayer) addShardHeadersToPool(header data.HeaderHandler) error {
+	metaHeader, ok := header.(data.MetaHeaderHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	lastNonces := make(map[uint32]uint64)
+	for _, shardInfo := range metaHeader.GetShardInfoHandlers() {
+		shardHeader, err := process.GetShardHeaderFromStorage(shardInfo.GetHeaderHash(), br.marshaller, br.storageService)
+		if err != nil {
+			return err
+		}
+
+		br.dataPool.Headers().AddHeader(shardInfo.GetHeaderHash(), shardHeader)
+		if shardInfo.GetNonce() > lastNonces[shardInfo.GetShardID()] {
+			lastNonces[shardInfo.GetShardID()] = shardInfo.GetNonce()
+		}
+	}
+
+	shardIDs := make([]uint32, 0, len(lastNonces))
+	for shardID := range lastNonces {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	for _, shardID := range shardIDs {
+		br.addFinalityAttestingHeadersToPool(shardID, lastNonces[shardID])
+	}
+
+	return nil
+}
+
+// addFinalityAttestingHeadersToPool adds the headers built on top of the last referenced one, which prove its finality
+func (br *blockReplayer) addFinalityAttestingHeadersToPool(shardID uint32, lastNonce uint64) {
+	for nonce := lastNonce + 1; nonce <= lastNonce+process.BlockFinality; nonce++ {
+		header, hash, err := process.GetHeaderFromStorageWithNonce(nonce, shardID, br.storageService, br.uint64Converter, br.marshaller)
+		if err != nil {
+			log.Debug("finality attesting header not found in storage",
+				"shard", shardID,
+				"nonce", nonce,
+				"error", err,
+			)
+			return
+		}
+
+		br.dataPool.Headers().AddHeader(hash, header)
+	}
+}
+
+// computeAccountsDiff compares, for each account touched by the replayed block, the computed state with the one
+// recorded by the chain at the replayed block
+func (br *blockReplayer) computeAccountsDiff(
+	prevHeader data.HeaderHandler,
+	prevRootHash []byte,
+	header data.HeaderHandler,
+) (map[string]*AccountDiff, error) {
+	beforeOptions := api.AccountQueryOptions{
+		BlockRootHash: prevRootHash,
+		HintEpoch:     core.OptionalUint32{Value: prevHeader.GetEpoch(), HasValue: true},
+	}
+	expectedOptions := api.AccountQueryOptions{
+		BlockRootHash: header.GetRootHash(),
+		HintEpoch:     core.OptionalUint32{Value: header.GetEpoch(), HasValue: true},
+	}
+
+	accountsDiff := make(map[string]*AccountDiff)
+	for address, computed := range br.accounts.RecordedAccounts() {
+		expected, err := br.getAccountState([]byte(address), expectedOptions)
+		if err != nil {
+			return nil, err
+		}
+		if isSameAccountState(computed, expected) {
+			continue
+		}
+
+		before, err := br.getAccountState([]byte(address), beforeOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		accountsDiff[br.addressConverter.SilentEncode([]byte(address), log)] = &AccountDiff{
+			Before:   before,
+			Computed: computed,
+			Expected: expected,
+		}
+	}
+
+	return accountsDiff, nil
+}
+
+func (br *blockReplayer) getAccountState(address []byte, options api.AccountQueryOptions) (*AccountState, error) {
+	account, _, err := br.accountsRepository.GetAccountWithBlockInfo(address, options)
+	if err != nil {
+		errAccountNotFound := &state.ErrAccountNotFoundAtBlock{}
+		if errors.As(err, &errAccountNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	return newAccountState(userAccount, br.addressConverter), nil
+}
+
+func isSameAccountState(first *AccountState, second *AccountState) bool {
+	if first == nil || second == nil {
+		return first == second
+	}
+
+	return *first == *second
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (br *blockReplayer) IsInterfaceNil() bool {
+	return br == nil
+}
@@ -0,0 +1,484 @@
+package replay
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	dataRetrieverMock "github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	prevRootHash     = []byte("previous root hash")
+	expectedRootHash = []byte("expected root hash")
+	computedRootHash = []byte("computed root hash")
+)
+
+type storedBlocks struct {
+	storage    *genericMocks.ChainStorerMock
+	prevHeader *block.Header
+	prevHash   []byte
+	header     *block.Header
+	hash       []byte
+	metaHash   []byte
+	txHash     []byte
+}
+
+func createStoredBlocks(t *testing.T) *storedBlocks {
+	marshaller := &marshallerMock.MarshalizerMock{}
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	storage := genericMocks.NewChainStorerMock(0)
+
+	putObject := func(storer *genericMocks.StorerMock, key []byte, obj interface{}) {
+		buff, err := marshaller.Marshal(obj)
+		require.Nil(t, err)
+		require.Nil(t, storer.Put(key, buff))
+	}
+
+	prevMetaHash := []byte("previous meta hash")
+	putObject(storage.Metablocks, prevMetaHash, &block.MetaBlock{Nonce: 4})
+	metaHash := []byte("meta hash")
+	putObject(storage.Metablocks, metaHash, &block.MetaBlock{Nonce: 5, PrevHash: prevMetaHash})
+	attestingMetaHash := []byte("attesting meta hash")
+	putObject(storage.Metablocks, attestingMetaHash, &block.MetaBlock{Nonce: 6, PrevHash: metaHash})
+	require.Nil(t, storage.MetaHdrNonce.Put(converter.ToByteSlice(6), attestingMetaHash))
+
+	txHash := []byte("tx hash")
+	putObject(storage.Transactions, txHash, &transaction.Transaction{Nonce: 7, Value: big.NewInt(1)})
+	miniBlockHash := []byte("mini block hash")
+	putObject(storage.Miniblocks, miniBlockHash, &block.MiniBlock{
+		TxHashes:        [][]byte{txHash},
+		ReceiverShardID: 0,
+		SenderShardID:   0,
+		Type:            block.TxBlock,
+	})
+
+	prevHash := []byte("previous hash")
+	prevHeader := &block.Header{
+		Nonce:           9,
+		RootHash:        prevRootHash,
+		MetaBlockHashes: [][]byte{prevMetaHash},
+	}
+	putObject(storage.BlockHeaders, prevHash, prevHeader)
+
+	hash := []byte("hash")
+	header := &block.Header{
+		Nonce:            10,
+		PrevHash:         prevHash,
+		RootHash:         expectedRootHash,
+		MiniBlockHeaders: []block.MiniBlockHeader{{Hash: miniBlockHash, TxCount: 1}},
+		MetaBlockHashes:  [][]byte{metaHash},
+	}
+	putObject(storage.BlockHeaders, hash, header)
+	require.Nil(t, storage.ShardHdrNonce.Put(converter.ToByteSlice(10), hash))
+
+	return &storedBlocks{
+		storage:    storage,
+		prevHeader: prevHeader,
+		prevHash:   prevHash,
+		header:     header,
+		hash:       hash,
+		metaHash:   prevMetaHash,
+		txHash:     txHash,
+	}
+}
+
+func createMockArgsBlockReplayer() ArgsBlockReplayer {
+	accounts, _ := NewAccountsRecorder(&stateMock.AccountsStub{}, testscommon.NewPubkeyConverterMock(32))
+
+	return ArgsBlockReplayer{
+		BlockProcessor: &testscommon.BlockProcessorStub{},
+		BlockTracker: &mock.BlockTrackerMock{
+			AddCrossNotarizedHeaderCalled: func(shardID uint32, crossNotarizedHeader data.HeaderHandler, crossNotarizedHeaderHash []byte) {},
+		},
+		BlockChain:                   &testscommon.ChainHandlerStub{},
+		ScheduledTxsExecutionHandler: &testscommon.ScheduledTxsExecutionStub{},
+		Accounts:                     accounts,
+		AccountsRepository:           &stateMock.AccountsRepositoryStub{},
+		StorageService:               genericMocks.NewChainStorerMock(0),
+		DataPool:                     dataRetrieverMock.NewPoolsHolderMock(),
+		Marshaller:                   &marshallerMock.MarshalizerMock{},
+		Uint64Converter:              uint64ByteSlice.NewBigEndianConverter(),
+		ShardCoordinator:             testscommon.NewMultiShardsCoordinatorMock(2),
+		AddressConverter:             testscommon.NewPubkeyConverterMock(32),
+		ProcessingTimeout:            time.Second,
+	}
+}
+
+func TestNewBlockReplayer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		argsFunc    func() ArgsBlockReplayer
+		expectedErr error
+	}{
+		{
+			name: "nil block processor",
+			argsFunc: func() ArgsBlockReplayer {
+				args := createMockArgsBlockReplayer()
+				args.BlockProcessor = nil
+				return args
+			},
+			expectedErr: ErrNilBlockProcessor,
+		},
+		{
+			name: "nil block tracker",
+			argsFunc: func() ArgsBlockReplayer {
+				args := createMockArgsBlockReplayer()
+				args.BlockTracker = nil
+				return args
+			},
+			expectedErr: ErrNilBlockTracker,
+		},
+		{
+			name: "nil block chain",
+			argsFunc: func() ArgsBlockReplayer {
+				args := createMockArgsBlockReplayer()
+				args.BlockChain = nil
+				return args
+			},
+			expectedErr: ErrNilBlockChain,
+		},
+		{
+			name: "nil scheduled txs execution handler",
+			argsFunc: func() ArgsBlockReplayer {
+				args := createMockArgsBlockReplayer()
+				args.ScheduledTxsExecutionHandler = nil
+				return args
+			},
+			expectedErr: ErrNilScheduledTxsExecutionHandler,
+		},
+		{
+			name: "nil accounts recorder",
+			argsFunc: func() ArgsBlockReplayer {
+				args := createMockArgsBlockReplayer()
+				args.Accounts = nil
+				return args
+			},
+			expectedErr: ErrNilAccountsRecorder,
+		},
+		{
+			name: "nil accounts repository",
+			argsFunc: func() ArgsBlockReplayer {
+				args := createMockArgsBlockReplayer()
+				args.AccountsRepository = nil
+				return args
+			},
+			expectedErr: ErrNilAccountsRepository,
+		},
+		{
+			name: "nil storage service",
+			argsFunc: func() ArgsBlockReplayer {
+				args := createMockArgsBlockReplayer()
+				args.StorageService = nil
+				return args
+			},
+			expectedErr: ErrNilStorageService,
+		},
+		{
+			name: "nil data pool",
+			argsFunc: func() ArgsBlockReplayer {
+				args := createMockArgsBlockReplayer()
+				args.DataPool = nil
+				return args
+			},
+			expectedErr: ErrNilDataPool,
+		},
+		{
+			name: "nil marshaller",
+			argsFunc: func() ArgsBlockReplayer {
+				args := createMockArgsBlockReplayer()
+				args.Marshaller = nil
+				return args
+			},
+			expectedErr: ErrNilMarshaller,
+		},
+		{
+			name: "nil uint64 converter",
+			argsFunc: func() ArgsBlockReplayer {
+				args := createMockArgsBlockReplayer()
+				args.Uint64Converter = nil
+				return args
+			},
+			expectedErr: ErrNilUint64Converter,
+		},
+		{
+			name: "nil shard coordinator",
+			argsFunc: func() ArgsBlockReplayer {
+				args := createMockArgsBlockReplayer()
+				args.ShardCoordinator = nil
+				return args
+			},
+			expectedErr: ErrNilShardCoordinator,
+		},
+		{
+			name: "nil address converter",
+			argsFunc: func() ArgsBlockReplayer {
+				args := createMockArgsBlockReplayer()
+				args.AddressConverter = nil
+				return args
+			},
+			expectedErr: ErrNilAddressConverter,
+		},
+		{
+			name: "invalid processing timeout",
+			argsFunc: func() ArgsBlockReplayer {
+				args := createMockArgsBlockReplayer()
+				args.ProcessingTimeout = 0
+				return args
+			},
+			expectedErr: ErrInvalidProcessingTimeout,
+		},
+		{
+			name: "should work",
+			argsFunc: func() ArgsBlockReplayer {
+				return createMockArgsBlockReplayer()
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replayer, err := NewBlockReplayer(tt.argsFunc())
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedErr == nil, replayer != nil)
+		})
+	}
+}
+
+func TestBlockReplayer_ReplayBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("genesis block should error", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, _ := NewBlockReplayer(createMockArgsBlockReplayer())
+
+		results, err := replayer.ReplayBlock(0)
+		assert.Nil(t, results)
+		assert.Equal(t, ErrGenesisBlockCannotBeReplayed, err)
+	})
+	t.Run("missing block should error", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, _ := NewBlockReplayer(createMockArgsBlockReplayer())
+
+		results, err := replayer.ReplayBlock(10)
+		assert.Nil(t, results)
+		assert.True(t, errors.Is(err, process.ErrMissingHashForHeaderNonce))
+	})
+	t.Run("processing error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsBlockReplayer()
+		args.StorageService = createStoredBlocks(t).storage
+		args.BlockProcessor = &testscommon.BlockProcessorStub{
+			ProcessBlockCalled: func(header data.HeaderHandler, body data.BodyHandler, haveTime func() time.Duration) error {
+				return expectedErr
+			},
+		}
+		replayer, _ := NewBlockReplayer(args)
+
+		results, err := replayer.ReplayBlock(10)
+		assert.Nil(t, results)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("matching root hash should revert the processed block", func(t *testing.T) {
+		t.Parallel()
+
+		blocks := createStoredBlocks(t)
+		args := createMockArgsBlockReplayer()
+		args.StorageService = blocks.storage
+
+		var currentHeader data.HeaderHandler
+		var currentHeaderHash []byte
+		args.BlockChain = &testscommon.ChainHandlerStub{
+			SetCurrentBlockHeaderAndRootHashCalled: func(header data.HeaderHandler, rootHash []byte) error {
+				currentHeader = header
+				return nil
+			},
+			SetCurrentBlockHeaderHashCalled: func(hash []byte) {
+				currentHeaderHash = hash
+			},
+		}
+
+		scheduledRootHash := []byte("scheduled root hash")
+		args.ScheduledTxsExecutionHandler = &testscommon.ScheduledTxsExecutionStub{
+			RollBackToBlockCalled: func(headerHash []byte) error {
+				assert.Equal(t, blocks.prevHash, headerHash)
+				return nil
+			},
+			GetScheduledRootHashCalled: func() []byte {
+				return scheduledRootHash
+			},
+		}
+
+		crossNotarizedHeaders := make(map[string]uint32)
+		args.BlockTracker = &mock.BlockTrackerMock{
+			AddCrossNotarizedHeaderCalled: func(shardID uint32, crossNotarizedHeader data.HeaderHandler, crossNotarizedHeaderHash []byte) {
+				crossNotarizedHeaders[string(crossNotarizedHeaderHash)] = shardID
+			},
+		}
+
+		dataPool := dataRetrieverMock.NewPoolsHolderMock()
+		args.DataPool = dataPool
+
+		stateRevertedToBlock := false
+		currentBlockReverted := false
+		args.BlockProcessor = &testscommon.BlockProcessorStub{
+			RevertStateToBlockCalled: func(header data.HeaderHandler, rootHash []byte) error {
+				stateRevertedToBlock = true
+				assert.Equal(t, blocks.prevHeader.Nonce, header.GetNonce())
+				assert.Equal(t, scheduledRootHash, rootHash)
+				return nil
+			},
+			ProcessBlockCalled: func(header data.HeaderHandler, body data.BodyHandler, haveTime func() time.Duration) error {
+				assert.Equal(t, blocks.header.Nonce, header.GetNonce())
+				assert.Equal(t, 1, len(body.(*block.Body).MiniBlocks))
+				assert.True(t, haveTime() > 0)
+
+				_, found := dataPool.Transactions().SearchFirstData(blocks.txHash)
+				assert.True(t, found)
+				_, err := dataPool.Headers().GetHeaderByHash(blocks.header.MetaBlockHashes[0])
+				assert.Nil(t, err)
+				_, err = dataPool.Headers().GetHeaderByHash([]byte("attesting meta hash"))
+				assert.Nil(t, err)
+
+				return nil
+			},
+			RevertCurrentBlockCalled: func() {
+				currentBlockReverted = true
+			},
+		}
+		replayer, _ := NewBlockReplayer(args)
+
+		results, err := replayer.ReplayBlock(10)
+		require.Nil(t, err)
+
+		expectedResults := &ReplayResults{
+			ShardID:          0,
+			Nonce:            10,
+			Hash:             hex.EncodeToString(blocks.hash),
+			ExpectedRootHash: hex.EncodeToString(expectedRootHash),
+			ComputedRootHash: hex.EncodeToString(expectedRootHash),
+			RootHashMatches:  true,
+		}
+		assert.Equal(t, expectedResults, results)
+		assert.True(t, stateRevertedToBlock)
+		assert.True(t, currentBlockReverted)
+		assert.Equal(t, blocks.prevHeader.Nonce, currentHeader.GetNonce())
+		assert.Equal(t, blocks.prevHash, currentHeaderHash)
+		assert.Equal(t, map[string]uint32{string(blocks.metaHash): core.MetachainShardId}, crossNotarizedHeaders)
+	})
+	t.Run("root hash mismatch should report the accounts differences", func(t *testing.T) {
+		t.Parallel()
+
+		blocks := createStoredBlocks(t)
+		args := createMockArgsBlockReplayer()
+		args.StorageService = blocks.storage
+		args.ScheduledTxsExecutionHandler = &testscommon.ScheduledTxsExecutionStub{
+			RollBackToBlockCalled: func(headerHash []byte) error {
+				return errors.New("no scheduled info")
+			},
+			SetScheduledInfoCalled: func(scheduledInfo *process.ScheduledInfo) {
+				assert.Equal(t, prevRootHash, scheduledInfo.RootHash)
+			},
+			GetScheduledRootHashCalled: func() []byte {
+				return prevRootHash
+			},
+		}
+
+		divergentAddress := []byte("divergent address")
+		matchingAddress := []byte("matching address")
+		createdAddress := []byte("created address")
+		accountsAdapter := &stateMock.AccountsStub{
+			SaveAccountCalled: func(account vmcommon.AccountHandler) error {
+				return nil
+			},
+			RootHashCalled: func() ([]byte, error) {
+				return computedRootHash, nil
+			},
+		}
+		recorder, _ := NewAccountsRecorder(accountsAdapter, testscommon.NewPubkeyConverterMock(32))
+		args.Accounts = recorder
+
+		createAccount := func(address []byte, nonce uint64, balance int64) *stateMock.AccountWrapMock {
+			account := stateMock.NewAccountWrapMock(address)
+			account.IncreaseNonce(nonce)
+			account.Balance = big.NewInt(balance)
+			return account
+		}
+		args.AccountsRepository = &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				isBefore := string(options.BlockRootHash) == string(prevRootHash)
+				switch string(address) {
+				case string(divergentAddress):
+					if isBefore {
+						return createAccount(address, 1, 100), nil, nil
+					}
+					return createAccount(address, 2, 90), nil, nil
+				case string(matchingAddress):
+					return createAccount(address, 3, 50), nil, nil
+				default:
+					return nil, nil, state.NewErrAccountNotFoundAtBlock(nil)
+				}
+			},
+		}
+		args.BlockProcessor = &testscommon.BlockProcessorStub{
+			ProcessBlockCalled: func(header data.HeaderHandler, body data.BodyHandler, haveTime func() time.Duration) error {
+				_ = recorder.SaveAccount(createAccount(divergentAddress, 2, 80))
+				_ = recorder.SaveAccount(createAccount(matchingAddress, 3, 50))
+				_ = recorder.SaveAccount(createAccount(createdAddress, 0, 10))
+				_, _ = recorder.RootHash()
+
+				return process.ErrRootStateDoesNotMatch
+			},
+			RevertCurrentBlockCalled: func() {
+				assert.Fail(t, "should have not been called")
+			},
+		}
+		replayer, _ := NewBlockReplayer(args)
+
+		results, err := replayer.ReplayBlock(10)
+		require.Nil(t, err)
+
+		assert.False(t, results.RootHashMatches)
+		assert.Equal(t, hex.EncodeToString(expectedRootHash), results.ExpectedRootHash)
+		assert.Equal(t, hex.EncodeToString(computedRootHash), results.ComputedRootHash)
+		require.Equal(t, 2, len(results.AccountsDiff))
+
+		divergentDiff := results.AccountsDiff[hex.EncodeToString(divergentAddress)]
+		require.NotNil(t, divergentDiff)
+		assert.Equal(t, "100", divergentDiff.Before.Balance)
+		assert.Equal(t, "80", divergentDiff.Computed.Balance)
+		assert.Equal(t, "90", divergentDiff.Expected.Balance)
+
+		createdDiff := results.AccountsDiff[hex.EncodeToString(createdAddress)]
+		require.NotNil(t, createdDiff)
+		assert.Nil(t, createdDiff.Before)
+		assert.Equal(t, "10", createdDiff.Computed.Balance)
+		assert.Nil(t, createdDiff.Expected)
+	})
+}
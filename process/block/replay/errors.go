@@ -0,0 +1,48 @@
+package replay
+
+import "errors"
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilAccountsRecorder signals that a nil accounts recorder has been provided
+var ErrNilAccountsRecorder = errors.New("nil accounts recorder")
+
+// ErrNilAccountsRepository signals that a nil accounts repository has been provided
+var ErrNilAccountsRepository = errors.New("nil accounts repository")
+
+// ErrNilBlockProcessor signals that a nil block processor has been provided
+var ErrNilBlockProcessor = errors.New("nil block processor")
+
+// ErrNilBlockTracker signals that a nil block tracker has been provided
+var ErrNilBlockTracker = errors.New("nil block tracker")
+
+// ErrNilBlockChain signals that a nil block chain has been provided
+var ErrNilBlockChain = errors.New("nil block chain")
+
+// ErrNilScheduledTxsExecutionHandler signals that a nil scheduled txs execution handler has been provided
+var ErrNilScheduledTxsExecutionHandler = errors.New("nil scheduled txs execution handler")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilDataPool signals that a nil data pool has been provided
+var ErrNilDataPool = errors.New("nil data pool")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilUint64Converter signals that a nil uint64 converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 converter")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator has been provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilAddressConverter signals that a nil address converter has been provided
+var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrGenesisBlockCannotBeReplayed signals that the genesis block was requested to be replayed
+var ErrGenesisBlockCannotBeReplayed = errors.New("the genesis block cannot be replayed")
+
+// ErrInvalidProcessingTimeout signals that an invalid processing timeout has been provided
+var ErrInvalidProcessingTimeout = errors.New("invalid processing timeout")
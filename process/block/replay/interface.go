@@ -0,0 +1,14 @@
+package replay
+
+import (
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+// AccountsRecorder defines an accounts adapter that remembers the accounts saved while processing a block, along with
+// the last computed root hash, so they can be inspected after the block processor reverted its changes
+type AccountsRecorder interface {
+	state.AccountsAdapter
+	RecordedAccounts() map[string]*AccountState
+	LastRootHash() []byte
+	ResetRecords()
+}
@@ -0,0 +1,30 @@
+package replay
+
+// ReplayResults holds the outcome of replaying a block
+type ReplayResults struct {
+	ShardID          uint32                  `json:"shardID"`
+	Nonce            uint64                  `json:"nonce"`
+	Hash             string                  `json:"hash"`
+	ExpectedRootHash string                  `json:"expectedRootHash"`
+	ComputedRootHash string                  `json:"computedRootHash"`
+	RootHashMatches  bool                    `json:"rootHashMatches"`
+	AccountsDiff     map[string]*AccountDiff `json:"accountsDiff,omitempty"`
+}
+
+// AccountDiff holds the state of an account before the replayed block, as computed by the replay and as recorded by
+// the chain. A nil state means the account did not exist
+type AccountDiff struct {
+	Before   *AccountState `json:"before"`
+	Computed *AccountState `json:"computed"`
+	Expected *AccountState `json:"expected"`
+}
+
+// AccountState holds the fields of an account that are compared when replaying a block
+type AccountState struct {
+	Nonce           uint64 `json:"nonce"`
+	Balance         string `json:"balance"`
+	DeveloperReward string `json:"developerReward"`
+	CodeHash        string `json:"codeHash,omitempty"`
+	RootHash        string `json:"rootHash,omitempty"`
+	OwnerAddress    string `json:"ownerAddress,omitempty"`
+}
@@ -65,6 +65,18 @@ func (transactionType TransactionType) String() string {
 	}
 }
 
+// ExecutionFrameType specifies the type of the execution frames recorded by an execution tracer
+type ExecutionFrameType string
+
+const (
+	// SCCallFrame defines the frame of a smart contract call executed by a VM
+	SCCallFrame ExecutionFrameType = "scCall"
+	// SCDeployFrame defines the frame of a smart contract deployment executed by a VM
+	SCDeployFrame ExecutionFrameType = "scDeploy"
+	// BuiltInFunctionFrame defines the frame of a built-in function execution
+	BuiltInFunctionFrame ExecutionFrameType = "builtInFunction"
+)
+
 // BlockFinality defines the block finality which is used in meta-chain/shards (the real finality in shards is given
 // by meta-chain)
 const BlockFinality = 1
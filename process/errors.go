@@ -173,6 +173,9 @@ var ErrNilHeadersDataPool = errors.New("nil headers data pool")
 // ErrNilCacher signals that a nil cache has been provided
 var ErrNilCacher = errors.New("nil cacher")
 
+// ErrNilExecutionTracer signals that a nil execution tracer has been provided
+var ErrNilExecutionTracer = errors.New("nil execution tracer")
+
 // ErrNilRcvAddr signals that an operation has been attempted to or with a nil receiver address
 var ErrNilRcvAddr = errors.New("nil receiver address")
 
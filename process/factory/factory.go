@@ -17,6 +17,8 @@ const (
 	MetachainBlocksTopic = "metachainBlocks"
 	// AccountTrieNodesTopic is used for sharing state trie nodes
 	AccountTrieNodesTopic = "accountTrieNodes"
+	// AccountTrieLeavesRangeTopic is used for sharing ranges of state trie leaves
+	AccountTrieLeavesRangeTopic = "accountTrieLeavesRange"
 	// ValidatorTrieNodesTopic is used for sharding validator state trie nodes
 	ValidatorTrieNodesTopic = "validatorTrieNodes"
 )
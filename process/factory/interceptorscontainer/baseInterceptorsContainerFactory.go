@@ -616,6 +616,38 @@ func (bicf *baseInterceptorsContainerFactory) createOneTrieNodesInterceptor(topi
 	return bicf.createTopicAndAssignHandler(topic, interceptor, true)
 }
 
+func (bicf *baseInterceptorsContainerFactory) createOneTrieLeavesRangeInterceptor(topic string) (process.Interceptor, error) {
+	leavesRangesProcessor, err := processor.NewTrieNodesInterceptorProcessor(bicf.dataPool.TrieLeavesRanges())
+	if err != nil {
+		return nil, err
+	}
+
+	leavesRangesFactory, err := interceptorFactory.NewInterceptedTrieLeavesRangeDataFactory(bicf.argInterceptorFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	internalMarshaller := bicf.argInterceptorFactory.CoreComponents.InternalMarshalizer()
+	interceptor, err := interceptors.NewMultiDataInterceptor(
+		interceptors.ArgMultiDataInterceptor{
+			Topic:                topic,
+			Marshalizer:          internalMarshaller,
+			DataFactory:          leavesRangesFactory,
+			Processor:            leavesRangesProcessor,
+			Throttler:            bicf.globalThrottler,
+			AntifloodHandler:     bicf.antifloodHandler,
+			WhiteListRequest:     bicf.whiteListHandler,
+			CurrentPeerId:        bicf.mainMessenger.ID(),
+			PreferredPeersHolder: bicf.preferredPeersHolder,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return bicf.createTopicAndAssignHandler(topic, interceptor, true)
+}
+
 func (bicf *baseInterceptorsContainerFactory) generateUnsignedTxsInterceptors() error {
 	shardC := bicf.shardCoordinator
 
@@ -218,6 +218,15 @@ func (micf *metaInterceptorsContainerFactory) AddShardTrieNodeInterceptors(conta
 
 		keys = append(keys, identifierTrieNodes)
 		trieInterceptors = append(trieInterceptors, interceptor)
+
+		identifierLeavesRange := factory.AccountTrieLeavesRangeTopic + shardC.CommunicationIdentifier(i)
+		interceptor, err = micf.createOneTrieLeavesRangeInterceptor(identifierLeavesRange)
+		if err != nil {
+			return err
+		}
+
+		keys = append(keys, identifierLeavesRange)
+		trieInterceptors = append(trieInterceptors, interceptor)
 	}
 
 	return container.AddMultiple(keys, trieInterceptors)
@@ -302,6 +311,15 @@ func (micf *metaInterceptorsContainerFactory) generateTrieNodesInterceptors() er
 	keys = append(keys, identifierTrieNodes)
 	trieInterceptors = append(trieInterceptors, interceptor)
 
+	identifierLeavesRange := factory.AccountTrieLeavesRangeTopic + core.CommunicationIdentifierBetweenShards(core.MetachainShardId, core.MetachainShardId)
+	interceptor, err = micf.createOneTrieLeavesRangeInterceptor(identifierLeavesRange)
+	if err != nil {
+		return err
+	}
+
+	keys = append(keys, identifierLeavesRange)
+	trieInterceptors = append(trieInterceptors, interceptor)
+
 	return micf.addInterceptorsToContainers(keys, trieInterceptors)
 }
 
@@ -600,11 +600,12 @@ func TestMetaInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 		numInterceptorsUnsignedTxsForMetachain := noOfShards + 1
 		numInterceptorsRewardsTxsForMetachain := noOfShards
 		numInterceptorsTrieNodes := 2
+		numInterceptorsTrieLeavesRanges := 1
 		numInterceptorsPeerAuthForMetachain := 1
 		numInterceptorsHeartbeatForMetachain := 1
 		numInterceptorsShardValidatorInfoForMetachain := 1
 		numInterceptorValidatorInfo := 1
-		totalInterceptors := numInterceptorsMetablock + numInterceptorsShardHeadersForMetachain + numInterceptorsTrieNodes +
+		totalInterceptors := numInterceptorsMetablock + numInterceptorsShardHeadersForMetachain + numInterceptorsTrieNodes + numInterceptorsTrieLeavesRanges +
 			numInterceptorsTransactionsForMetachain + numInterceptorsUnsignedTxsForMetachain + numInterceptorsMiniBlocksForMetachain +
 			numInterceptorsRewardsTxsForMetachain + numInterceptorsPeerAuthForMetachain + numInterceptorsHeartbeatForMetachain +
 			numInterceptorsShardValidatorInfoForMetachain + numInterceptorValidatorInfo
@@ -615,7 +616,7 @@ func TestMetaInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 
 		err = icf.AddShardTrieNodeInterceptors(mainContainer)
 		assert.Nil(t, err)
-		assert.Equal(t, totalInterceptors+noOfShards, mainContainer.Len())
+		assert.Equal(t, totalInterceptors+2*noOfShards, mainContainer.Len())
 	})
 	t.Run("full archive mode", func(t *testing.T) {
 		t.Parallel()
@@ -650,11 +651,12 @@ func TestMetaInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 		numInterceptorsUnsignedTxsForMetachain := noOfShards + 1
 		numInterceptorsRewardsTxsForMetachain := noOfShards
 		numInterceptorsTrieNodes := 2
+		numInterceptorsTrieLeavesRanges := 1
 		numInterceptorsPeerAuthForMetachain := 1
 		numInterceptorsHeartbeatForMetachain := 1
 		numInterceptorsShardValidatorInfoForMetachain := 1
 		numInterceptorValidatorInfo := 1
-		totalInterceptors := numInterceptorsMetablock + numInterceptorsShardHeadersForMetachain + numInterceptorsTrieNodes +
+		totalInterceptors := numInterceptorsMetablock + numInterceptorsShardHeadersForMetachain + numInterceptorsTrieNodes + numInterceptorsTrieLeavesRanges +
 			numInterceptorsTransactionsForMetachain + numInterceptorsUnsignedTxsForMetachain + numInterceptorsMiniBlocksForMetachain +
 			numInterceptorsRewardsTxsForMetachain + numInterceptorsPeerAuthForMetachain + numInterceptorsHeartbeatForMetachain +
 			numInterceptorsShardValidatorInfoForMetachain + numInterceptorValidatorInfo
@@ -665,11 +667,11 @@ func TestMetaInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 
 		err = icf.AddShardTrieNodeInterceptors(mainContainer)
 		assert.Nil(t, err)
-		assert.Equal(t, totalInterceptors+noOfShards, mainContainer.Len())
+		assert.Equal(t, totalInterceptors+2*noOfShards, mainContainer.Len())
 
 		err = icf.AddShardTrieNodeInterceptors(fullArchiveContainer)
 		assert.Nil(t, err)
-		assert.Equal(t, totalInterceptors-1+noOfShards, fullArchiveContainer.Len())
+		assert.Equal(t, totalInterceptors-1+2*noOfShards, fullArchiveContainer.Len())
 	})
 }
 
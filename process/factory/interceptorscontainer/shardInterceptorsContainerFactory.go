@@ -212,6 +212,15 @@ func (sicf *shardInterceptorsContainerFactory) generateTrieNodesInterceptors() e
 	keys = append(keys, identifierTrieNodes)
 	interceptorsSlice = append(interceptorsSlice, interceptor)
 
+	identifierLeavesRange := factory.AccountTrieLeavesRangeTopic + shardC.CommunicationIdentifier(core.MetachainShardId)
+	interceptor, err = sicf.createOneTrieLeavesRangeInterceptor(identifierLeavesRange)
+	if err != nil {
+		return err
+	}
+
+	keys = append(keys, identifierLeavesRange)
+	interceptorsSlice = append(interceptorsSlice, interceptor)
+
 	return sicf.addInterceptorsToContainers(keys, interceptorsSlice)
 }
 
@@ -0,0 +1,50 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+var _ process.InterceptedDataFactory = (*interceptedTrieLeavesRangeDataFactory)(nil)
+
+type interceptedTrieLeavesRangeDataFactory struct {
+	marshaller marshal.Marshalizer
+	hasher     hashing.Hasher
+}
+
+// NewInterceptedTrieLeavesRangeDataFactory creates an instance of interceptedTrieLeavesRangeDataFactory
+func NewInterceptedTrieLeavesRangeDataFactory(
+	argument *ArgInterceptedDataFactory,
+) (*interceptedTrieLeavesRangeDataFactory, error) {
+
+	if argument == nil {
+		return nil, process.ErrNilArgumentStruct
+	}
+	if check.IfNil(argument.CoreComponents) {
+		return nil, process.ErrNilCoreComponentsHolder
+	}
+	if check.IfNil(argument.CoreComponents.InternalMarshalizer()) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(argument.CoreComponents.Hasher()) {
+		return nil, process.ErrNilHasher
+	}
+
+	return &interceptedTrieLeavesRangeDataFactory{
+		marshaller: argument.CoreComponents.InternalMarshalizer(),
+		hasher:     argument.CoreComponents.Hasher(),
+	}, nil
+}
+
+// Create creates instances of InterceptedData by unmarshalling provided buffer
+func (itlrdf *interceptedTrieLeavesRangeDataFactory) Create(buff []byte) (process.InterceptedData, error) {
+	return trie.NewInterceptedLeavesRange(buff, itlrdf.marshaller, itlrdf.hasher)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (itlrdf *interceptedTrieLeavesRangeDataFactory) IsInterfaceNil() bool {
+	return itlrdf == nil
+}
@@ -0,0 +1,56 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInterceptedTrieLeavesRangeDataFactory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		itlr, err := NewInterceptedTrieLeavesRangeDataFactory(nil)
+		assert.Nil(t, itlr)
+		assert.Equal(t, process.ErrNilArgumentStruct, err)
+	})
+	t.Run("nil internal marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents, cryptoComponents := createMockComponentHolders()
+		coreComponents.IntMarsh = nil
+		arg := createMockArgument(coreComponents, cryptoComponents)
+
+		itlr, err := NewInterceptedTrieLeavesRangeDataFactory(arg)
+		assert.Nil(t, itlr)
+		assert.Equal(t, process.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents, cryptoComponents := createMockComponentHolders()
+		coreComponents.Hash = nil
+		arg := createMockArgument(coreComponents, cryptoComponents)
+
+		itlr, err := NewInterceptedTrieLeavesRangeDataFactory(arg)
+		assert.Nil(t, itlr)
+		assert.Equal(t, process.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents, cryptoComponents := createMockComponentHolders()
+		arg := createMockArgument(coreComponents, cryptoComponents)
+
+		itlr, err := NewInterceptedTrieLeavesRangeDataFactory(arg)
+		assert.Nil(t, err)
+		assert.False(t, itlr.IsInterfaceNil())
+
+		interceptedData, err := itlr.Create(nil)
+		assert.Nil(t, interceptedData)
+		assert.NotNil(t, err)
+	})
+}
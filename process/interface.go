@@ -592,6 +592,7 @@ type RequestHandler interface {
 	RequestMiniBlock(destShardID uint32, miniblockHash []byte)
 	RequestMiniBlocks(destShardID uint32, miniblocksHashes [][]byte)
 	RequestTrieNodes(destShardID uint32, hashes [][]byte, topic string)
+	RequestTrieLeavesRange(destShardID uint32, rootHash []byte, startKey []byte, topic string)
 	RequestStartOfEpochMetaBlock(epoch uint32)
 	RequestInterval() time.Duration
 	SetNumPeersToQuery(key string, intra int, cross int) error
@@ -1358,3 +1359,11 @@ type SentSignaturesTracker interface {
 	ResetCountersForManagedBlockSigner(signerPk []byte)
 	IsInterfaceNil() bool
 }
+
+// ExecutionTracer defines a component able to record the call tree of smart contract calls and built-in functions
+type ExecutionTracer interface {
+	EnterCall(frameType ExecutionFrameType, input *vmcommon.ContractCallInput)
+	ExitCall(vmOutput *vmcommon.VMOutput, err error)
+	TraceStorageRead(address []byte, key []byte, value []byte)
+	IsInterfaceNil() bool
+}
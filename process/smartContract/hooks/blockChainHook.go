@@ -64,6 +64,7 @@ type ArgBlockChainHook struct {
 	GasSchedule              core.GasScheduleNotifier
 	Counter                  BlockChainHookCounter
 	MissingTrieNodesNotifier common.MissingTrieNodesNotifier
+	ExecutionTracer          process.ExecutionTracer
 }
 
 // BlockChainHookImpl is a wrapper over AccountsAdapter that satisfy vmcommon.BlockchainHook interface
@@ -81,6 +82,7 @@ type BlockChainHookImpl struct {
 	globalSettingsHandler vmcommon.ESDTGlobalSettingsHandler
 	enableEpochsHandler   common.EnableEpochsHandler
 	counter               BlockChainHookCounter
+	executionTracer       process.ExecutionTracer
 
 	mutCurrentHdr sync.RWMutex
 	currentHdr    data.HeaderHandler
@@ -126,6 +128,7 @@ func NewBlockChainHookImpl(
 		gasSchedule:              args.GasSchedule,
 		counter:                  args.Counter,
 		missingTrieNodesNotifier: args.MissingTrieNodesNotifier,
+		executionTracer:          args.ExecutionTracer,
 	}
 
 	err = blockChainHookImpl.makeCompiledSCStorage()
@@ -217,6 +220,9 @@ func checkForNil(args ArgBlockChainHook) error {
 	if check.IfNil(args.MissingTrieNodesNotifier) {
 		return ErrNilMissingTrieNodesNotifier
 	}
+	if check.IfNil(args.ExecutionTracer) {
+		return process.ErrNilExecutionTracer
+	}
 	return nil
 }
 
@@ -266,6 +272,7 @@ func (bh *BlockChainHookImpl) GetStorageData(accountAddress []byte, index []byte
 
 	userAcc, err := bh.GetUserAccount(accountAddress)
 	if err == state.ErrAccNotFound {
+		bh.executionTracer.TraceStorageRead(accountAddress, index, nil)
 		return make([]byte, 0), 0, nil
 	}
 	if err != nil {
@@ -286,6 +293,7 @@ func (bh *BlockChainHookImpl) GetStorageData(accountAddress []byte, index []byte
 		bh.syncIfMissingDataTrieNode(err)
 	}
 	log.Trace("GetStorageData ", messages...)
+	bh.executionTracer.TraceStorageRead(accountAddress, index, value)
 
 	// returning nil here ensures backwards compatibility as the error wasn't taken into account by the previous versions
 	// of the vm. Now, the VM take into account this error so the processMaxReadsCounters call can stop the execution of the contract
@@ -475,6 +483,14 @@ func (bh *BlockChainHookImpl) ProcessBuiltInFunction(input *vmcommon.ContractCal
 		return nil, process.ErrNilVmInput
 	}
 
+	bh.executionTracer.EnterCall(process.BuiltInFunctionFrame, input)
+	vmOutput, err := bh.processBuiltInFunction(input)
+	bh.executionTracer.ExitCall(vmOutput, err)
+
+	return vmOutput, err
+}
+
+func (bh *BlockChainHookImpl) processBuiltInFunction(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
 	function, err := bh.builtInFunctions.Get(input.Function)
 	if err != nil {
 		return nil, err
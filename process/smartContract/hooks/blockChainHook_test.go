@@ -68,6 +68,7 @@ func createMockBlockChainHookArgs() hooks.ArgBlockChainHook {
 		},
 		GasSchedule:              testscommon.NewGasScheduleNotifierMock(make(map[string]map[string]uint64)),
 		Counter:                  &testscommon.BlockChainHookCounterStub{},
+		ExecutionTracer:          &testscommon.ExecutionTracerStub{},
 		MissingTrieNodesNotifier: &testscommon.MissingTrieNodesNotifierStub{},
 	}
 	return arguments
@@ -231,6 +232,14 @@ func TestNewBlockChainHookImpl(t *testing.T) {
 			},
 			expectedErr: hooks.ErrNilMissingTrieNodesNotifier,
 		},
+		{
+			args: func() hooks.ArgBlockChainHook {
+				args := createMockBlockChainHookArgs()
+				args.ExecutionTracer = nil
+				return args
+			},
+			expectedErr: process.ErrNilExecutionTracer,
+		},
 		{
 			args: func() hooks.ArgBlockChainHook {
 				return createMockBlockChainHookArgs()
@@ -449,6 +458,66 @@ func TestBlockChainHookImpl_GetStorageData(t *testing.T) {
 		assert.Equal(t, expectedErr, err)
 		assert.Nil(t, value)
 	})
+	t.Run("should trace the storage read", func(t *testing.T) {
+		t.Parallel()
+
+		address := []byte("address")
+		variableIdentifier := []byte("variable")
+		variableValue := []byte("value")
+		accnt := stateMock.NewAccountWrapMock(nil)
+		_ = accnt.SaveKeyValue(variableIdentifier, variableValue)
+
+		var tracedAddress, tracedKey, tracedValue []byte
+		args := createMockBlockChainHookArgs()
+		args.ExecutionTracer = &testscommon.ExecutionTracerStub{
+			TraceStorageReadCalled: func(address []byte, key []byte, value []byte) {
+				tracedAddress = address
+				tracedKey = key
+				tracedValue = value
+			},
+		}
+		args.Accounts = &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(address []byte) (handler vmcommon.AccountHandler, e error) {
+				return accnt, nil
+			},
+		}
+		bh, _ := hooks.NewBlockChainHookImpl(args)
+
+		value, _, err := bh.GetStorageData(address, variableIdentifier)
+
+		assert.Nil(t, err)
+		assert.Equal(t, variableValue, value)
+		assert.Equal(t, address, tracedAddress)
+		assert.Equal(t, variableIdentifier, tracedKey)
+		assert.Equal(t, variableValue, tracedValue)
+	})
+	t.Run("should trace the storage read of a missing account", func(t *testing.T) {
+		t.Parallel()
+
+		address := []byte("address")
+		variableIdentifier := []byte("variable")
+
+		storageReadTraced := false
+		args := createMockBlockChainHookArgs()
+		args.ExecutionTracer = &testscommon.ExecutionTracerStub{
+			TraceStorageReadCalled: func(address []byte, key []byte, value []byte) {
+				storageReadTraced = true
+				assert.Nil(t, value)
+			},
+		}
+		args.Accounts = &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(address []byte) (handler vmcommon.AccountHandler, e error) {
+				return nil, state.ErrAccNotFound
+			},
+		}
+		bh, _ := hooks.NewBlockChainHookImpl(args)
+
+		value, _, err := bh.GetStorageData(address, variableIdentifier)
+
+		assert.Nil(t, err)
+		assert.Equal(t, make([]byte, 0), value)
+		assert.True(t, storageReadTraced)
+	})
 	t.Run("should work before counters activation", func(t *testing.T) {
 		t.Parallel()
 
@@ -1842,6 +1911,44 @@ func TestBlockChainHookImpl_ProcessBuiltInFunction(t *testing.T) {
 		require.Equal(t, &vmcommon.VMOutput{}, output)
 		assert.False(t, counterProcessedCalled)
 	})
+	t.Run("should trace the built in function call", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockBlockChainHookArgs()
+		args.BuiltInFunctions = builtInFunctionsContainer
+		args.Accounts = &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+				return stateMock.NewAccountWrapMock(addrSender), nil
+			},
+			SaveAccountCalled: func(account vmcommon.AccountHandler) error {
+				return nil
+			},
+		}
+
+		input := createContractCallInput(funcName, addrSender, addrSender)
+		enterCallCalled := false
+		exitCallCalled := false
+		args.ExecutionTracer = &testscommon.ExecutionTracerStub{
+			EnterCallCalled: func(frameType process.ExecutionFrameType, callInput *vmcommon.ContractCallInput) {
+				enterCallCalled = true
+				require.Equal(t, process.BuiltInFunctionFrame, frameType)
+				require.Equal(t, input, callInput)
+			},
+			ExitCallCalled: func(vmOutput *vmcommon.VMOutput, err error) {
+				exitCallCalled = true
+				require.Nil(t, err)
+				require.Equal(t, &vmcommon.VMOutput{}, vmOutput)
+			},
+		}
+
+		bh, _ := hooks.NewBlockChainHookImpl(args)
+		output, err := bh.ProcessBuiltInFunction(input)
+
+		require.Nil(t, err)
+		require.Equal(t, &vmcommon.VMOutput{}, output)
+		require.True(t, enterCallCalled)
+		require.True(t, exitCallCalled)
+	})
 }
 
 func TestBlockChainHookImpl_GetESDTToken(t *testing.T) {
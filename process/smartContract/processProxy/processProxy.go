@@ -73,6 +73,7 @@ func NewSmartContractProcessorProxy(args scrCommon.ArgsNewSmartContractProcessor
 			VMOutputCacher:      args.VMOutputCacher,
 			WasmVMChangeLocker:  args.WasmVMChangeLocker,
 			IsGenesisProcessing: args.IsGenesisProcessing,
+			ExecutionTracer:     args.ExecutionTracer,
 		},
 	}
 	if check.IfNil(epochNotifier) {
@@ -51,6 +51,7 @@ func NewTestSmartContractProcessorProxy(args scrCommon.ArgsNewSmartContractProce
 			VMOutputCacher:      args.VMOutputCacher,
 			WasmVMChangeLocker:  args.WasmVMChangeLocker,
 			IsGenesisProcessing: args.IsGenesisProcessing,
+			ExecutionTracer:     args.ExecutionTracer,
 		},
 	}
 
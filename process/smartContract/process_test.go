@@ -119,6 +119,7 @@ func createMockSmartContractProcessorArguments() scrCommon.ArgsNewSmartContractP
 		EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.SCDeployFlag),
 		WasmVMChangeLocker:  &sync.RWMutex{},
 		VMOutputCacher:      txcache.NewDisabledCache(),
+		ExecutionTracer:     &testscommon.ExecutionTracerStub{},
 	}
 }
 
@@ -147,6 +148,17 @@ func TestNewSmartContractProcessorNilVMOutputCacher(t *testing.T) {
 	require.Equal(t, process.ErrNilCacher, err)
 }
 
+func TestNewSmartContractProcessorNilExecutionTracer(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ExecutionTracer = nil
+	sc, err := NewSmartContractProcessor(arguments)
+
+	require.Nil(t, sc)
+	require.Equal(t, process.ErrNilExecutionTracer, err)
+}
+
 func TestNewSmartContractProcessorNilBuiltInFunctions(t *testing.T) {
 	t.Parallel()
 
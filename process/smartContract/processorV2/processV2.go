@@ -86,6 +86,7 @@ type scProcessor struct {
 	mutGasLock          sync.RWMutex
 	txLogsProcessor     process.TransactionLogProcessor
 	vmOutputCacher      storage.Cacher
+	executionTracer     process.ExecutionTracer
 	isGenesisProcessing bool
 
 	executableCheckers    map[string]scrCommon.ExecutableChecker
@@ -176,6 +177,9 @@ func NewSmartContractProcessorV2(args scrCommon.ArgsNewSmartContractProcessor) (
 	if check.IfNil(args.VMOutputCacher) {
 		return nil, process.ErrNilCacher
 	}
+	if check.IfNil(args.ExecutionTracer) {
+		return nil, process.ErrNilExecutionTracer
+	}
 	if check.IfNil(args.BuiltInFunctions) {
 		return nil, process.ErrNilBuiltInFunction
 	}
@@ -203,6 +207,7 @@ func NewSmartContractProcessorV2(args scrCommon.ArgsNewSmartContractProcessor) (
 		isGenesisProcessing: args.IsGenesisProcessing,
 		arwenChangeLocker:   args.WasmVMChangeLocker,
 		vmOutputCacher:      args.VMOutputCacher,
+		executionTracer:     args.ExecutionTracer,
 		enableEpochsHandler: args.EnableEpochsHandler,
 		storePerByte:        baseOperationCost["StorePerByte"],
 		persistPerByte:      baseOperationCost["PersistPerByte"],
@@ -422,7 +427,9 @@ func (sc *scProcessor) executeSmartContractCall(
 	defer sc.printBlockchainHookCounters(tx)
 
 	var vmOutput *vmcommon.VMOutput
+	sc.executionTracer.EnterCall(process.SCCallFrame, vmInput)
 	vmOutput, err = vmExec.RunSmartContractCall(vmInput)
+	sc.executionTracer.ExitCall(vmOutput, err)
 
 	sc.arwenChangeLocker.RUnlock()
 	if err != nil {
@@ -1816,7 +1823,12 @@ func (sc *scProcessor) doDeploySmartContract(
 		return vmcommon.UserError, nil
 	}
 
+	sc.executionTracer.EnterCall(process.SCDeployFrame, &vmcommon.ContractCallInput{
+		VMInput:  vmInput.VMInput,
+		Function: core.SCDeployInitFunctionName,
+	})
 	vmOutput, err = vmExec.RunSmartContractCreate(vmInput)
+	sc.executionTracer.ExitCall(vmOutput, err)
 	sc.arwenChangeLocker.RUnlock()
 	if err != nil {
 		log.Debug("VM error", "error", err.Error())
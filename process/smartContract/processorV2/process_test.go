@@ -132,6 +132,7 @@ func createMockSmartContractProcessorArguments() scrCommon.ArgsNewSmartContractP
 		GasSchedule:        testscommon.NewGasScheduleNotifierMock(gasSchedule),
 		WasmVMChangeLocker: &sync.RWMutex{},
 		VMOutputCacher:     txcache.NewDisabledCache(),
+		ExecutionTracer:    &testscommon.ExecutionTracerStub{},
 	}
 }
 
@@ -158,6 +159,17 @@ func TestNewSmartContractProcessorNilVMOutputCacher(t *testing.T) {
 	require.Equal(t, process.ErrNilCacher, err)
 }
 
+func TestNewSmartContractProcessorNilExecutionTracer(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.ExecutionTracer = nil
+	sc, err := NewSmartContractProcessorV2(arguments)
+
+	require.Nil(t, sc)
+	require.Equal(t, process.ErrNilExecutionTracer, err)
+}
+
 func TestNewSmartContractProcessorNilBuiltInFunctions(t *testing.T) {
 	t.Parallel()
 
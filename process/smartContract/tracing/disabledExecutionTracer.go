@@ -0,0 +1,29 @@
+package tracing
+
+import (
+	"github.com/multiversx/mx-chain-go/process"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+type disabledExecutionTracer struct {
+}
+
+// NewDisabledExecutionTracer will create a new instance of type disabledExecutionTracer
+func NewDisabledExecutionTracer() *disabledExecutionTracer {
+	return &disabledExecutionTracer{}
+}
+
+// EnterCall does nothing
+func (tracer *disabledExecutionTracer) EnterCall(_ process.ExecutionFrameType, _ *vmcommon.ContractCallInput) {
+}
+
+// ExitCall does nothing
+func (tracer *disabledExecutionTracer) ExitCall(_ *vmcommon.VMOutput, _ error) {}
+
+// TraceStorageRead does nothing
+func (tracer *disabledExecutionTracer) TraceStorageRead(_ []byte, _ []byte, _ []byte) {}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracer *disabledExecutionTracer) IsInterfaceNil() bool {
+	return tracer == nil
+}
@@ -0,0 +1,30 @@
+package tracing
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledExecutionTracer_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	tracer := NewDisabledExecutionTracer()
+	assert.False(t, check.IfNil(tracer))
+	tracer.EnterCall(process.SCCallFrame, &vmcommon.ContractCallInput{})
+	tracer.TraceStorageRead([]byte("address"), []byte("key"), []byte("value"))
+	tracer.ExitCall(&vmcommon.VMOutput{}, errors.New("error"))
+	tracer.ExitCall(nil, nil)
+}
@@ -0,0 +1,335 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	vmData "github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+var log = logger.GetOrCreate("process/smartContract/tracing")
+
+// numTopicsPerTransferredToken is the number of topics describing each token in an ESDT transfer log entry: the token
+// identifier, the nonce and the value. The last topic of the entry holds the receiver of the transfer
+const numTopicsPerTransferredToken = 3
+
+var esdtTransferIdentifiers = map[string]struct{}{
+	core.BuiltInFunctionESDTTransfer:         {},
+	core.BuiltInFunctionESDTNFTTransfer:      {},
+	core.BuiltInFunctionMultiESDTNFTTransfer: {},
+}
+
+// transferValueOnlyIdentifier is the identifier of the log entries written by the VM for each call between contracts.
+// The topics of such an entry hold the transferred value and the receiver, while its data holds the execution type,
+// the called function and its arguments
+const transferValueOnlyIdentifier = "transferValueOnly"
+
+// nestedCallTypes maps the execution types written by the VM in the transferValueOnly log entries of the calls
+// between contracts to their call types. Simple value transfers and back transfers do not execute any code, so they
+// are not listed
+var nestedCallTypes = map[string]vmData.CallType{
+	"ExecuteOnDestContext": vmData.DirectCall,
+	"ExecuteOnSameContext": vmData.DirectCall,
+	"TransferAndExecute":   vmData.ESDTTransferAndExecute,
+	"AsyncCall":            vmData.AsynchronousCall,
+	"AsyncCallback":        vmData.AsynchronousCallBack,
+}
+
+type executionTracer struct {
+	addressConverter core.PubkeyConverter
+
+	mutTrace  sync.Mutex
+	isTracing bool
+	frames    []*txSimData.ExecutionFrame
+	stack     []*txSimData.ExecutionFrame
+}
+
+// NewExecutionTracer creates a new execution tracer which records the call tree of the executions done between the
+// Start and Stop calls
+func NewExecutionTracer(addressConverter core.PubkeyConverter) (*executionTracer, error) {
+	if check.IfNil(addressConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+
+	return &executionTracer{
+		addressConverter: addressConverter,
+	}, nil
+}
+
+// Start discards any previously recorded frame and starts recording the following executions
+func (et *executionTracer) Start() {
+	et.mutTrace.Lock()
+	defer et.mutTrace.Unlock()
+
+	et.isTracing = true
+	et.frames = make([]*txSimData.ExecutionFrame, 0)
+	et.stack = make([]*txSimData.ExecutionFrame, 0)
+}
+
+// Stop stops the recording and returns the root frames recorded since the Start call
+func (et *executionTracer) Stop() []*txSimData.ExecutionFrame {
+	et.mutTrace.Lock()
+	defer et.mutTrace.Unlock()
+
+	frames := et.frames
+	et.isTracing = false
+	et.frames = nil
+	et.stack = nil
+
+	return frames
+}
+
+// EnterCall opens a new frame, as a child of the frame currently being executed, if any
+func (et *executionTracer) EnterCall(frameType process.ExecutionFrameType, input *vmcommon.ContractCallInput) {
+	if input == nil {
+		return
+	}
+
+	et.mutTrace.Lock()
+	defer et.mutTrace.Unlock()
+
+	if !et.isTracing {
+		return
+	}
+
+	frame := &txSimData.ExecutionFrame{
+		Type:        string(frameType),
+		CallType:    input.CallType.ToString(),
+		Caller:      et.encodeAddress(input.CallerAddr),
+		Receiver:    et.encodeAddress(input.RecipientAddr),
+		Function:    input.Function,
+		Value:       bigIntToString(input.CallValue),
+		GasProvided: input.GasProvided,
+	}
+
+	parent := et.currentFrame()
+	if parent == nil {
+		et.frames = append(et.frames, frame)
+	} else {
+		parent.Calls = append(parent.Calls, frame)
+	}
+	et.stack = append(et.stack, frame)
+}
+
+// ExitCall closes the frame currently being executed, completing it with the gas used, the written storage and the
+// ESDT transfers found in the provided output
+func (et *executionTracer) ExitCall(vmOutput *vmcommon.VMOutput, err error) {
+	et.mutTrace.Lock()
+	defer et.mutTrace.Unlock()
+
+	frame := et.currentFrame()
+	if frame == nil {
+		return
+	}
+	et.stack = et.stack[:len(et.stack)-1]
+
+	if err != nil {
+		frame.Error = err.Error()
+	}
+	if vmOutput == nil {
+		return
+	}
+
+	frame.ReturnCode = vmOutput.ReturnCode.String()
+	frame.ReturnMessage = vmOutput.ReturnMessage
+	if vmOutput.GasRemaining < frame.GasProvided {
+		frame.GasUsed = frame.GasProvided - vmOutput.GasRemaining
+	}
+
+	switch process.ExecutionFrameType(frame.Type) {
+	case process.BuiltInFunctionFrame:
+		// the logs of a smart contract call already contain the ones of the built-in functions it called, which
+		// have their own frames, so the ESDT transfers are only extracted for built-in function frames
+		frame.ESDTTransfers = et.extractESDTTransfers(vmOutput.Logs)
+	case process.SCDeployFrame:
+		if len(frame.Receiver) == 0 {
+			frame.Receiver = et.getDeployedContractAddress(vmOutput)
+		}
+		frame.StorageWrites = et.extractStorageWrites(vmOutput)
+		frame.Calls = append(frame.Calls, et.extractNestedCalls(frame, vmOutput.Logs)...)
+	default:
+		frame.StorageWrites = et.extractStorageWrites(vmOutput)
+		frame.Calls = append(frame.Calls, et.extractNestedCalls(frame, vmOutput.Logs)...)
+	}
+}
+
+// TraceStorageRead records the read storage key in the frame currently being executed
+func (et *executionTracer) TraceStorageRead(address []byte, key []byte, value []byte) {
+	et.mutTrace.Lock()
+	defer et.mutTrace.Unlock()
+
+	frame := et.currentFrame()
+	if frame == nil {
+		return
+	}
+
+	frame.StorageReads = append(frame.StorageReads, &txSimData.StorageAccess{
+		Address: et.encodeAddress(address),
+		Key:     hex.EncodeToString(key),
+		Value:   hex.EncodeToString(value),
+	})
+}
+
+func (et *executionTracer) currentFrame() *txSimData.ExecutionFrame {
+	if !et.isTracing || len(et.stack) == 0 {
+		return nil
+	}
+
+	return et.stack[len(et.stack)-1]
+}
+
+func (et *executionTracer) extractStorageWrites(vmOutput *vmcommon.VMOutput) []*txSimData.StorageAccess {
+	storageWrites := make([]*txSimData.StorageAccess, 0)
+	for _, outputAccount := range sortedOutputAccounts(vmOutput) {
+		// the storage updates are kept in a map, so the keys are sorted in order to always produce the same trace
+		keys := make([]string, 0, len(outputAccount.StorageUpdates))
+		for key, storageUpdate := range outputAccount.StorageUpdates {
+			if storageUpdate.Written {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			storageWrites = append(storageWrites, &txSimData.StorageAccess{
+				Address: et.encodeAddress(outputAccount.Address),
+				Key:     hex.EncodeToString([]byte(key)),
+				Value:   hex.EncodeToString(outputAccount.StorageUpdates[key].Data),
+			})
+		}
+	}
+
+	return storageWrites
+}
+
+// extractNestedCalls builds the frames of the calls between contracts done inside the VM while executing the provided
+// frame, as found in its logs. The VM does not report when a call returns, so a nested call is attached to the most
+// recent call still open whose receiver is the caller of the nested call. The frames built from logs only hold the
+// details of the calls, as the VM does not report the gas used and the storage accessed by each of them. The calls
+// sent to other shards appear as asynchronous calls without nested calls
+func (et *executionTracer) extractNestedCalls(frame *txSimData.ExecutionFrame, logs []*vmcommon.LogEntry) []*txSimData.ExecutionFrame {
+	nestedCalls := make([]*txSimData.ExecutionFrame, 0)
+	openCalls := []*txSimData.ExecutionFrame{frame}
+	for _, entry := range logs {
+		nestedCall, ok := et.createNestedCallFrame(entry)
+		if !ok {
+			continue
+		}
+
+		for len(openCalls) > 1 && openCalls[len(openCalls)-1].Receiver != nestedCall.Caller {
+			openCalls = openCalls[:len(openCalls)-1]
+		}
+
+		parent := openCalls[len(openCalls)-1]
+		if parent == frame {
+			nestedCalls = append(nestedCalls, nestedCall)
+		} else {
+			parent.Calls = append(parent.Calls, nestedCall)
+		}
+		openCalls = append(openCalls, nestedCall)
+	}
+
+	return nestedCalls
+}
+
+func (et *executionTracer) createNestedCallFrame(entry *vmcommon.LogEntry) (*txSimData.ExecutionFrame, bool) {
+	if string(entry.Identifier) != transferValueOnlyIdentifier || len(entry.Topics) < 2 || len(entry.Data) < 2 {
+		return nil, false
+	}
+
+	callType, isNestedCall := nestedCallTypes[string(entry.Data[0])]
+	if !isNestedCall || len(entry.Data[1]) == 0 {
+		return nil, false
+	}
+
+	return &txSimData.ExecutionFrame{
+		Type:     string(process.SCCallFrame),
+		CallType: callType.ToString(),
+		Caller:   et.encodeAddress(entry.Address),
+		Receiver: et.encodeAddress(entry.Topics[1]),
+		Function: string(entry.Data[1]),
+		Value:    big.NewInt(0).SetBytes(entry.Topics[0]).String(),
+	}, true
+}
+
+func (et *executionTracer) getDeployedContractAddress(vmOutput *vmcommon.VMOutput) string {
+	for _, outputAccount := range sortedOutputAccounts(vmOutput) {
+		if len(outputAccount.Code) > 0 {
+			return et.encodeAddress(outputAccount.Address)
+		}
+	}
+
+	return ""
+}
+
+func (et *executionTracer) extractESDTTransfers(logs []*vmcommon.LogEntry) []*txSimData.ESDTTransfer {
+	transfers := make([]*txSimData.ESDTTransfer, 0)
+	for _, entry := range logs {
+		_, isTransfer := esdtTransferIdentifiers[string(entry.Identifier)]
+		if !isTransfer {
+			continue
+		}
+
+		numTopics := len(entry.Topics)
+		if numTopics <= numTopicsPerTransferredToken || (numTopics-1)%numTopicsPerTransferredToken != 0 {
+			continue
+		}
+
+		receiver := et.encodeAddress(entry.Topics[numTopics-1])
+		for i := 0; i < numTopics-1; i += numTopicsPerTransferredToken {
+			transfers = append(transfers, &txSimData.ESDTTransfer{
+				Sender:   et.encodeAddress(entry.Address),
+				Receiver: receiver,
+				Token:    string(entry.Topics[i]),
+				Nonce:    big.NewInt(0).SetBytes(entry.Topics[i+1]).Uint64(),
+				Value:    big.NewInt(0).SetBytes(entry.Topics[i+2]).String(),
+			})
+		}
+	}
+
+	return transfers
+}
+
+func (et *executionTracer) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return et.addressConverter.SilentEncode(address, log)
+}
+
+func sortedOutputAccounts(vmOutput *vmcommon.VMOutput) []*vmcommon.OutputAccount {
+	addresses := make([]string, 0, len(vmOutput.OutputAccounts))
+	for address := range vmOutput.OutputAccounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	outputAccounts := make([]*vmcommon.OutputAccount, 0, len(addresses))
+	for _, address := range addresses {
+		outputAccounts = append(outputAccounts, vmOutput.OutputAccounts[address])
+	}
+
+	return outputAccounts
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (et *executionTracer) IsInterfaceNil() bool {
+	return et == nil
+}
@@ -0,0 +1,306 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	vmData "github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	sender   = []byte("sender")
+	contract = []byte("contract")
+	receiver = []byte("receiver")
+)
+
+func createCallInput(caller []byte, recipient []byte, function string, gasProvided uint64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			CallType:    vmData.DirectCall,
+			GasProvided: gasProvided,
+		},
+		RecipientAddr: recipient,
+		Function:      function,
+	}
+}
+
+func TestNewExecutionTracer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, err := NewExecutionTracer(nil)
+		assert.Nil(t, tracer)
+		assert.Equal(t, process.ErrNilPubkeyConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, err := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32))
+		assert.Nil(t, err)
+		assert.False(t, tracer.IsInterfaceNil())
+	})
+}
+
+func TestExecutionTracer_ShouldNotRecordWhenNotStarted(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32))
+	tracer.EnterCall(process.SCCallFrame, createCallInput(sender, contract, "function", 100))
+	tracer.TraceStorageRead(contract, []byte("key"), []byte("value"))
+	tracer.ExitCall(&vmcommon.VMOutput{}, nil)
+
+	tracer.Start()
+	assert.Empty(t, tracer.Stop())
+}
+
+func TestExecutionTracer_ShouldRecordTheCallTree(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32))
+	tracer.Start()
+
+	tracer.EnterCall(process.SCCallFrame, createCallInput(sender, contract, "function", 1000))
+	tracer.TraceStorageRead(contract, []byte("key"), []byte("value"))
+
+	tracer.EnterCall(process.BuiltInFunctionFrame, createCallInput(contract, receiver, core.BuiltInFunctionMultiESDTNFTTransfer, 300))
+	tracer.ExitCall(&vmcommon.VMOutput{
+		ReturnCode:   vmcommon.Ok,
+		GasRemaining: 100,
+		Logs: []*vmcommon.LogEntry{
+			{
+				Identifier: []byte(core.BuiltInFunctionMultiESDTNFTTransfer),
+				Address:    contract,
+				Topics: [][]byte{
+					[]byte("TKN-123456"), nil, big.NewInt(10).Bytes(),
+					[]byte("NFT-123456"), big.NewInt(2).Bytes(), big.NewInt(1).Bytes(),
+					receiver,
+				},
+			},
+			{
+				Identifier: []byte("writeLog"),
+				Address:    contract,
+			},
+		},
+	}, nil)
+
+	tracer.EnterCall(process.BuiltInFunctionFrame, createCallInput(contract, receiver, "invalidFunction", 100))
+	tracer.ExitCall(nil, errors.New("function not found"))
+
+	tracer.ExitCall(&vmcommon.VMOutput{
+		ReturnCode:    vmcommon.UserError,
+		ReturnMessage: "user error",
+		GasRemaining:  400,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(contract): {
+				Address: contract,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"key2": {Offset: []byte("key2"), Data: []byte("value2"), Written: true},
+					"key1": {Offset: []byte("key1"), Data: []byte("value1"), Written: true},
+					"key":  {Offset: []byte("key"), Data: []byte("value"), Written: false},
+				},
+			},
+		},
+		Logs: []*vmcommon.LogEntry{
+			{
+				Identifier: []byte(core.BuiltInFunctionESDTTransfer),
+				Address:    contract,
+				Topics:     [][]byte{[]byte("TKN-123456"), nil, big.NewInt(10).Bytes(), receiver},
+			},
+		},
+	}, nil)
+
+	frames := tracer.Stop()
+	require.Equal(t, 1, len(frames))
+
+	expectedCall := &txSimData.ExecutionFrame{
+		Type:          string(process.SCCallFrame),
+		CallType:      vmData.DirectCall.ToString(),
+		Caller:        hex.EncodeToString(sender),
+		Receiver:      hex.EncodeToString(contract),
+		Function:      "function",
+		Value:         "0",
+		GasProvided:   1000,
+		GasUsed:       600,
+		ReturnCode:    vmcommon.UserError.String(),
+		ReturnMessage: "user error",
+		StorageReads: []*txSimData.StorageAccess{
+			{
+				Address: hex.EncodeToString(contract),
+				Key:     hex.EncodeToString([]byte("key")),
+				Value:   hex.EncodeToString([]byte("value")),
+			},
+		},
+		StorageWrites: []*txSimData.StorageAccess{
+			{
+				Address: hex.EncodeToString(contract),
+				Key:     hex.EncodeToString([]byte("key1")),
+				Value:   hex.EncodeToString([]byte("value1")),
+			},
+			{
+				Address: hex.EncodeToString(contract),
+				Key:     hex.EncodeToString([]byte("key2")),
+				Value:   hex.EncodeToString([]byte("value2")),
+			},
+		},
+		Calls: []*txSimData.ExecutionFrame{
+			{
+				Type:        string(process.BuiltInFunctionFrame),
+				CallType:    vmData.DirectCall.ToString(),
+				Caller:      hex.EncodeToString(contract),
+				Receiver:    hex.EncodeToString(receiver),
+				Function:    core.BuiltInFunctionMultiESDTNFTTransfer,
+				Value:       "0",
+				GasProvided: 300,
+				GasUsed:     200,
+				ReturnCode:  vmcommon.Ok.String(),
+				ESDTTransfers: []*txSimData.ESDTTransfer{
+					{
+						Sender:   hex.EncodeToString(contract),
+						Receiver: hex.EncodeToString(receiver),
+						Token:    "TKN-123456",
+						Value:    "10",
+					},
+					{
+						Sender:   hex.EncodeToString(contract),
+						Receiver: hex.EncodeToString(receiver),
+						Token:    "NFT-123456",
+						Nonce:    2,
+						Value:    "1",
+					},
+				},
+			},
+			{
+				Type:        string(process.BuiltInFunctionFrame),
+				CallType:    vmData.DirectCall.ToString(),
+				Caller:      hex.EncodeToString(contract),
+				Receiver:    hex.EncodeToString(receiver),
+				Function:    "invalidFunction",
+				Value:       "0",
+				GasProvided: 100,
+				Error:       "function not found",
+			},
+		},
+	}
+	assert.Equal(t, expectedCall, frames[0])
+}
+
+func createCallLogEntry(executionType string, caller []byte, recipient []byte, value int64, function string) *vmcommon.LogEntry {
+	return &vmcommon.LogEntry{
+		Identifier: []byte(transferValueOnlyIdentifier),
+		Address:    caller,
+		Topics:     [][]byte{big.NewInt(value).Bytes(), recipient},
+		Data:       vmcommon.FormatLogDataForCall(executionType, function, [][]byte{[]byte("arg")}),
+	}
+}
+
+func TestExecutionTracer_ShouldRecordTheNestedCallsFoundInLogs(t *testing.T) {
+	t.Parallel()
+
+	secondContract := []byte("second contract")
+	thirdContract := []byte("third contract")
+
+	tracer, _ := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32))
+	tracer.Start()
+
+	tracer.EnterCall(process.SCCallFrame, createCallInput(sender, contract, "function", 1000))
+	tracer.ExitCall(&vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		Logs: []*vmcommon.LogEntry{
+			createCallLogEntry("DirectCall", sender, contract, 0, "function"),
+			createCallLogEntry("ExecuteOnDestContext", contract, secondContract, 5, "syncCall"),
+			createCallLogEntry("ExecuteOnDestContext", secondContract, thirdContract, 0, "nestedSyncCall"),
+			{
+				Identifier: []byte(transferValueOnlyIdentifier),
+				Address:    thirdContract,
+				Topics:     [][]byte{big.NewInt(1).Bytes(), receiver},
+				Data:       [][]byte{[]byte("DirectCall"), []byte("")},
+			},
+			createCallLogEntry("AsyncCall", contract, thirdContract, 0, "asyncCall"),
+			createCallLogEntry("AsyncCallback", thirdContract, contract, 0, "callBack"),
+			createCallLogEntry("BackTransfer", thirdContract, contract, 3, "ignored"),
+			{Identifier: []byte("writeLog"), Address: contract},
+		},
+	}, nil)
+
+	frames := tracer.Stop()
+	require.Equal(t, 1, len(frames))
+	calls := frames[0].Calls
+	require.Equal(t, 2, len(calls))
+
+	assert.Equal(t, &txSimData.ExecutionFrame{
+		Type:     string(process.SCCallFrame),
+		CallType: vmData.DirectCallStr,
+		Caller:   hex.EncodeToString(contract),
+		Receiver: hex.EncodeToString(secondContract),
+		Function: "syncCall",
+		Value:    "5",
+		Calls: []*txSimData.ExecutionFrame{
+			{
+				Type:     string(process.SCCallFrame),
+				CallType: vmData.DirectCallStr,
+				Caller:   hex.EncodeToString(secondContract),
+				Receiver: hex.EncodeToString(thirdContract),
+				Function: "nestedSyncCall",
+				Value:    "0",
+			},
+		},
+	}, calls[0])
+
+	assert.Equal(t, vmData.AsynchronousCallStr, calls[1].CallType)
+	assert.Equal(t, "asyncCall", calls[1].Function)
+	require.Equal(t, 1, len(calls[1].Calls))
+	assert.Equal(t, vmData.AsynchronousCallBackStr, calls[1].Calls[0].CallType)
+	assert.Equal(t, "callBack", calls[1].Calls[0].Function)
+	assert.Equal(t, hex.EncodeToString(contract), calls[1].Calls[0].Receiver)
+}
+
+func TestExecutionTracer_DeployFrameShouldContainTheNewContractAddress(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32))
+	tracer.Start()
+
+	tracer.EnterCall(process.SCDeployFrame, createCallInput(sender, nil, core.SCDeployInitFunctionName, 1000))
+	tracer.ExitCall(&vmcommon.VMOutput{
+		ReturnCode: vmcommon.Ok,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(sender):   {Address: sender},
+			string(contract): {Address: contract, Code: []byte("code")},
+		},
+	}, nil)
+
+	frames := tracer.Stop()
+	require.Equal(t, 1, len(frames))
+	assert.Equal(t, hex.EncodeToString(contract), frames[0].Receiver)
+	assert.Equal(t, uint64(1000), frames[0].GasUsed)
+}
+
+func TestExecutionTracer_StartShouldDiscardThePreviousFrames(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewExecutionTracer(testscommon.NewPubkeyConverterMock(32))
+	tracer.Start()
+	tracer.EnterCall(process.SCCallFrame, createCallInput(sender, contract, "function", 1000))
+
+	tracer.Start()
+	tracer.EnterCall(process.BuiltInFunctionFrame, createCallInput(sender, receiver, core.BuiltInFunctionESDTTransfer, 100))
+	tracer.ExitCall(&vmcommon.VMOutput{}, nil)
+
+	frames := tracer.Stop()
+	require.Equal(t, 1, len(frames))
+	assert.Equal(t, core.BuiltInFunctionESDTTransfer, frames[0].Function)
+	assert.Nil(t, tracer.Stop())
+}
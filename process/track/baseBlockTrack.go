@@ -745,6 +745,27 @@ func (bbt *baseBlockTrack) RestoreToGenesis() {
 	bbt.restoreTrackedHeadersToGenesis()
 }
 
+// RestoreNotarizedHeaders sets the provided headers as the only self and cross notarized headers and removes all the
+// tracked headers
+func (bbt *baseBlockTrack) RestoreNotarizedHeaders(
+	selfNotarizedHeaders map[uint32]data.HeaderHandler,
+	crossNotarizedHeaders map[uint32]data.HeaderHandler,
+) error {
+	err := bbt.crossNotarizer.InitNotarizedHeaders(crossNotarizedHeaders)
+	if err != nil {
+		return err
+	}
+
+	err = bbt.selfNotarizer.InitNotarizedHeaders(selfNotarizedHeaders)
+	if err != nil {
+		return err
+	}
+
+	bbt.restoreTrackedHeadersToGenesis()
+
+	return nil
+}
+
 func (bbt *baseBlockTrack) restoreTrackedHeadersToGenesis() {
 	bbt.mutHeaders.Lock()
 	bbt.headers = make(map[uint32]map[uint64][]*HeaderInfo)
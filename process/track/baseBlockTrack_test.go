@@ -2097,6 +2097,62 @@ func TestRestoreToGenesis_ShouldWork(t *testing.T) {
 	assert.Equal(t, shardArguments.StartHeaders[header.GetShardID()], lastSelfNotarizedHeader)
 }
 
+func TestRestoreNotarizedHeaders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil cross notarized headers should error", func(t *testing.T) {
+		t.Parallel()
+
+		shardArguments := CreateShardTrackerMockArguments()
+		sbt, _ := track.NewShardBlockTrack(shardArguments)
+
+		err := sbt.RestoreNotarizedHeaders(shardArguments.StartHeaders, nil)
+		assert.Equal(t, process.ErrNotarizedHeadersSliceIsNil, err)
+	})
+	t.Run("nil self notarized headers should error", func(t *testing.T) {
+		t.Parallel()
+
+		shardArguments := CreateShardTrackerMockArguments()
+		sbt, _ := track.NewShardBlockTrack(shardArguments)
+
+		err := sbt.RestoreNotarizedHeaders(nil, shardArguments.StartHeaders)
+		assert.Equal(t, process.ErrNotarizedHeadersSliceIsNil, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		shardArguments := CreateShardTrackerMockArguments()
+		sbt, _ := track.NewShardBlockTrack(shardArguments)
+
+		metaBlock1 := &block.MetaBlock{Nonce: 1}
+		metaBlock2 := &block.MetaBlock{Nonce: 2}
+		sbt.AddCrossNotarizedHeader(core.MetachainShardId, metaBlock1, []byte("meta hash 1"))
+		sbt.AddCrossNotarizedHeader(core.MetachainShardId, metaBlock2, []byte("meta hash 2"))
+		sbt.AddTrackedHeader(metaBlock2, []byte("meta hash 2"))
+
+		selfShardID := shardArguments.ShardCoordinator.SelfId()
+		header1 := &block.Header{ShardID: selfShardID, Nonce: 1}
+		header2 := &block.Header{ShardID: selfShardID, Nonce: 2}
+		sbt.AddSelfNotarizedHeader(core.MetachainShardId, header1, []byte("hash 1"))
+		sbt.AddSelfNotarizedHeader(core.MetachainShardId, header2, []byte("hash 2"))
+
+		err := sbt.RestoreNotarizedHeaders(
+			map[uint32]data.HeaderHandler{core.MetachainShardId: header1},
+			map[uint32]data.HeaderHandler{core.MetachainShardId: metaBlock1},
+		)
+		require.Nil(t, err)
+
+		trackedHeaders, _ := sbt.GetTrackedHeaders(core.MetachainShardId)
+		assert.Zero(t, len(trackedHeaders))
+
+		lastCrossNotarizedHeader, _, _ := sbt.GetLastCrossNotarizedHeader(core.MetachainShardId)
+		assert.Equal(t, metaBlock1, lastCrossNotarizedHeader)
+
+		lastSelfNotarizedHeader, _, _ := sbt.GetLastSelfNotarizedHeader(core.MetachainShardId)
+		assert.Equal(t, header1, lastSelfNotarizedHeader)
+	})
+}
+
 func TestCheckTrackerNilParameters_ShouldErrNilHasher(t *testing.T) {
 	t.Parallel()
 
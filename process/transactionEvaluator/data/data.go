@@ -9,4 +9,72 @@ import (
 type SimulationResultsWithVMOutput struct {
 	transaction.SimulationResults
 	VMOutput *vmcommon.VMOutput `json:"-"`
+	Trace    []*ExecutionFrame  `json:"trace,omitempty"`
+}
+
+// BundleSimulationResults is the data transfer object which will hold the results of simulating an ordered list of
+// transactions, each one being executed on top of the state changes produced by the previous ones
+type BundleSimulationResults struct {
+	Results      []*SimulationResultsWithVMOutput `json:"results"`
+	AccountsDiff map[string]*AccountDiff          `json:"accountsDiff"`
+}
+
+// AccountDiff holds the changes suffered by an account after the execution of a transactions bundle
+type AccountDiff struct {
+	NonceBefore    uint64                  `json:"nonceBefore"`
+	NonceAfter     uint64                  `json:"nonceAfter"`
+	BalanceBefore  string                  `json:"balanceBefore"`
+	BalanceAfter   string                  `json:"balanceAfter"`
+	StorageUpdates map[string]*StorageDiff `json:"storageUpdates,omitempty"`
+}
+
+// StorageDiff holds the hex encoded values of a storage key before and after the execution of a transactions bundle
+type StorageDiff struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// AccountOverride holds the values that will replace the ones of an account before simulating the execution of a
+// transaction. Only the provided fields are overridden; code, storage keys and values are hex encoded
+type AccountOverride struct {
+	Balance string            `json:"balance,omitempty"`
+	Nonce   *uint64           `json:"nonce,omitempty"`
+	Code    string            `json:"code,omitempty"`
+	Storage map[string]string `json:"storage,omitempty"`
+}
+
+// ExecutionFrame holds the details of a smart contract call, deployment or built-in function execution recorded while
+// tracing a transaction. The frames started during the execution of another frame are found in Calls
+type ExecutionFrame struct {
+	Type          string            `json:"type"`
+	CallType      string            `json:"callType"`
+	Caller        string            `json:"caller"`
+	Receiver      string            `json:"receiver"`
+	Function      string            `json:"function,omitempty"`
+	Value         string            `json:"value"`
+	GasProvided   uint64            `json:"gasProvided"`
+	GasUsed       uint64            `json:"gasUsed"`
+	ReturnCode    string            `json:"returnCode"`
+	ReturnMessage string            `json:"returnMessage,omitempty"`
+	Error         string            `json:"error,omitempty"`
+	StorageReads  []*StorageAccess  `json:"storageReads,omitempty"`
+	StorageWrites []*StorageAccess  `json:"storageWrites,omitempty"`
+	ESDTTransfers []*ESDTTransfer   `json:"esdtTransfers,omitempty"`
+	Calls         []*ExecutionFrame `json:"calls,omitempty"`
+}
+
+// StorageAccess holds a storage key of an account and its hex encoded value, as read or written during an execution frame
+type StorageAccess struct {
+	Address string `json:"address"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
+
+// ESDTTransfer holds the details of an ESDT transfer done during an execution frame
+type ESDTTransfer struct {
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Token    string `json:"token"`
+	Nonce    uint64 `json:"nonce,omitempty"`
+	Value    string `json:"value"`
 }
@@ -28,3 +28,18 @@ var ErrNilHasher = errors.New("nil hasher provided")
 
 // ErrNilDataFieldParser signals that a nil data field parser has been provided
 var ErrNilDataFieldParser = errors.New("nil data field parser")
+
+// ErrEmptyTransactionsBundle signals that an empty transactions bundle has been provided
+var ErrEmptyTransactionsBundle = errors.New("empty transactions bundle")
+
+// ErrInvalidBalanceOverride signals that an invalid balance override has been provided
+var ErrInvalidBalanceOverride = errors.New("invalid balance override")
+
+// ErrNilAccountsRepository signals that a nil accounts repository has been provided
+var ErrNilAccountsRepository = errors.New("nil accounts repository")
+
+// ErrNilRootHashHolder signals that a nil root hash holder has been provided
+var ErrNilRootHashHolder = errors.New("nil root hash holder")
+
+// ErrNilTraceRecorder signals that a nil trace recorder has been provided
+var ErrNilTraceRecorder = errors.New("nil trace recorder")
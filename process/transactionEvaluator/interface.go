@@ -2,6 +2,7 @@ package transactionEvaluator
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
 )
@@ -17,3 +18,11 @@ type TransactionProcessor interface {
 type DataFieldParser interface {
 	Parse(dataField []byte, sender, receiver []byte, numOfShards uint32) *datafield.ResponseParseData
 }
+
+// TraceRecorder defines a component able to record the execution trace of the transactions processed between the Start
+// and Stop calls
+type TraceRecorder interface {
+	Start()
+	Stop() []*txSimData.ExecutionFrame
+	IsInterfaceNil() bool
+}
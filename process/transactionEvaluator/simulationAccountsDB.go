@@ -2,31 +2,57 @@ package transactionEvaluator
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
+type accountWithNewCode interface {
+	HasNewCode() bool
+	GetCode() []byte
+	SetCodeHash(codeHash []byte)
+}
+
 // simulationAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled
 type simulationAccountsDB struct {
-	mutex            sync.RWMutex
-	cachedAccounts   map[string]vmcommon.AccountHandler
-	originalAccounts state.AccountsAdapter
+	mutex              sync.RWMutex
+	cachedAccounts     map[string]vmcommon.AccountHandler
+	cachedCodes        map[string][]byte
+	originalAccounts   state.AccountsAdapter
+	accountsRepository state.AccountsRepository
+	historicalOptions  *api.AccountQueryOptions
+	hasher             hashing.Hasher
 }
 
 // NewSimulationAccountsDB returns a new instance of simulationAccountsDB
-func NewSimulationAccountsDB(accountsDB state.AccountsAdapter) (*simulationAccountsDB, error) {
+func NewSimulationAccountsDB(
+	accountsDB state.AccountsAdapter,
+	accountsRepository state.AccountsRepository,
+	hasher hashing.Hasher,
+) (*simulationAccountsDB, error) {
 	if check.IfNil(accountsDB) {
 		return nil, ErrNilAccountsAdapter
 	}
+	if check.IfNil(accountsRepository) {
+		return nil, ErrNilAccountsRepository
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
 
 	return &simulationAccountsDB{
-		mutex:            sync.RWMutex{},
-		cachedAccounts:   make(map[string]vmcommon.AccountHandler),
-		originalAccounts: accountsDB,
+		mutex:              sync.RWMutex{},
+		cachedAccounts:     make(map[string]vmcommon.AccountHandler),
+		cachedCodes:        make(map[string][]byte),
+		originalAccounts:   accountsDB,
+		accountsRepository: accountsRepository,
+		hasher:             hasher,
 	}, nil
 }
 
@@ -42,6 +68,24 @@ func (r *simulationAccountsDB) StartSnapshotIfNeeded() error {
 
 // GetCode returns the code for the given account
 func (r *simulationAccountsDB) GetCode(codeHash []byte) []byte {
+	r.mutex.RLock()
+	code, found := r.cachedCodes[string(codeHash)]
+	r.mutex.RUnlock()
+	if found {
+		return code
+	}
+
+	options, isHistorical := r.getHistoricalOptions()
+	if isHistorical {
+		code, _, err := r.accountsRepository.GetCodeWithBlockInfo(codeHash, options)
+		if err != nil {
+			log.Debug("simulationAccountsDB.GetCode on historical state", "error", err)
+			return nil
+		}
+
+		return code
+	}
+
 	return r.originalAccounts.GetCode(codeHash)
 }
 
@@ -52,7 +96,7 @@ func (r *simulationAccountsDB) GetExistingAccount(address []byte) (vmcommon.Acco
 		return cachedAccount, nil
 	}
 
-	account, err := r.originalAccounts.GetExistingAccount(address)
+	account, err := r.getExistingAccount(address)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +118,7 @@ func (r *simulationAccountsDB) LoadAccount(address []byte) (vmcommon.AccountHand
 		return cachedAccount, nil
 	}
 
-	account, err := r.originalAccounts.LoadAccount(address)
+	account, err := r.loadAccount(address)
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +134,7 @@ func (r *simulationAccountsDB) SaveAccount(account vmcommon.AccountHandler) erro
 		return nil
 	}
 
+	r.addCodeToCache(account)
 	r.addToCache(account)
 
 	return nil
@@ -125,8 +170,22 @@ func (r *simulationAccountsDB) RecreateTrie(_ []byte) error {
 	return nil
 }
 
-// RecreateTrieFromEpoch won't do anything as write operations are disabled on this component
-func (r *simulationAccountsDB) RecreateTrieFromEpoch(_ common.RootHashHolder) error {
+// RecreateTrieFromEpoch will make the following reads, until the next CleanCache call, be done on the historical state
+// described by the provided root hash holder. Nothing is written, as write operations are disabled on this component
+func (r *simulationAccountsDB) RecreateTrieFromEpoch(options common.RootHashHolder) error {
+	if check.IfNil(options) {
+		return ErrNilRootHashHolder
+	}
+
+	r.mutex.Lock()
+	r.cachedAccounts = make(map[string]vmcommon.AccountHandler)
+	r.cachedCodes = make(map[string][]byte)
+	r.historicalOptions = &api.AccountQueryOptions{
+		BlockRootHash: options.GetRootHash(),
+		HintEpoch:     options.GetEpoch(),
+	}
+	r.mutex.Unlock()
+
 	return nil
 }
 
@@ -162,6 +221,22 @@ func (r *simulationAccountsDB) GetTrie(_ []byte) (common.Trie, error) {
 	return nil, nil
 }
 
+// GetAccountsDiff will call the original accounts' function with the same name
+func (r *simulationAccountsDB) GetAccountsDiff(
+	ctx context.Context,
+	oldRootHash common.RootHashHolder,
+	newRootHash common.RootHashHolder,
+	dataTrieLeafParserCreator state.DataTrieLeafParserCreator,
+	options state.AccountsDiffOptions,
+) (*state.AccountsDiff, error) {
+	return r.originalAccounts.GetAccountsDiff(ctx, oldRootHash, newRootHash, dataTrieLeafParserCreator, options)
+}
+
+// CollectTriesStatistics will call the original accounts' function with the same name
+func (r *simulationAccountsDB) CollectTriesStatistics(ctx context.Context, rootHash []byte, collector common.TriesStatisticsCollector) error {
+	return r.originalAccounts.CollectTriesStatistics(ctx, rootHash, collector)
+}
+
 // CommitInEpoch will do nothing for this implementation
 func (r *simulationAccountsDB) CommitInEpoch(_ uint32, _ uint32) ([]byte, error) {
 	return nil, nil
@@ -182,10 +257,72 @@ func (r *simulationAccountsDB) IsInterfaceNil() bool {
 	return r == nil
 }
 
-// CleanCache will clean the internal map with the cached accounts
+// CleanCache will clean the internal map with the cached accounts and will switch back to the current state
 func (r *simulationAccountsDB) CleanCache() {
 	r.mutex.Lock()
 	r.cachedAccounts = make(map[string]vmcommon.AccountHandler)
+	r.cachedCodes = make(map[string][]byte)
+	r.historicalOptions = nil
+	r.mutex.Unlock()
+}
+
+func (r *simulationAccountsDB) getExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	options, isHistorical := r.getHistoricalOptions()
+	if !isHistorical {
+		return r.originalAccounts.GetExistingAccount(address)
+	}
+
+	account, _, err := r.accountsRepository.GetAccountWithBlockInfo(address, options)
+	if isAccountNotFoundAtBlock(err) {
+		return nil, state.ErrAccNotFound
+	}
+
+	return account, err
+}
+
+func (r *simulationAccountsDB) loadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	options, isHistorical := r.getHistoricalOptions()
+	if !isHistorical {
+		return r.originalAccounts.LoadAccount(address)
+	}
+
+	account, _, err := r.accountsRepository.GetAccountWithBlockInfo(address, options)
+	if isAccountNotFoundAtBlock(err) {
+		return r.originalAccounts.GetAccountFromBytes(address, nil)
+	}
+
+	return account, err
+}
+
+func (r *simulationAccountsDB) getHistoricalOptions() (api.AccountQueryOptions, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if r.historicalOptions == nil {
+		return api.AccountQueryOptions{}, false
+	}
+
+	return *r.historicalOptions, true
+}
+
+func isAccountNotFoundAtBlock(err error) bool {
+	errAccountNotFound := &state.ErrAccountNotFoundAtBlock{}
+	return errors.As(err, &errAccountNotFound)
+}
+
+// addCodeToCache keeps the code set on the account, if any, as the original accounts db would have done on save
+func (r *simulationAccountsDB) addCodeToCache(account vmcommon.AccountHandler) {
+	codeAccount, ok := account.(accountWithNewCode)
+	if !ok || !codeAccount.HasNewCode() {
+		return
+	}
+
+	code := codeAccount.GetCode()
+	codeHash := r.hasher.Compute(string(code))
+	codeAccount.SetCodeHash(codeHash)
+
+	r.mutex.Lock()
+	r.cachedCodes[string(codeHash)] = code
 	r.mutex.Unlock()
 }
 
@@ -1,15 +1,19 @@
 package transactionEvaluator
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/state/parsers"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/require"
@@ -18,15 +22,31 @@ import (
 func TestNewReadOnlyAccountsDB_NilOriginalAccountsDBShouldErr(t *testing.T) {
 	t.Parallel()
 
-	simAccountsDB, err := NewSimulationAccountsDB(nil)
+	simAccountsDB, err := NewSimulationAccountsDB(nil, &stateMock.AccountsRepositoryStub{}, &hashingMocks.HasherMock{})
 	require.True(t, check.IfNil(simAccountsDB))
 	require.Equal(t, ErrNilAccountsAdapter, err)
 }
 
+func TestNewReadOnlyAccountsDB_NilAccountsRepositoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	simAccountsDB, err := NewSimulationAccountsDB(&stateMock.AccountsStub{}, nil, &hashingMocks.HasherMock{})
+	require.True(t, check.IfNil(simAccountsDB))
+	require.Equal(t, ErrNilAccountsRepository, err)
+}
+
+func TestNewReadOnlyAccountsDB_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	simAccountsDB, err := NewSimulationAccountsDB(&stateMock.AccountsStub{}, &stateMock.AccountsRepositoryStub{}, nil)
+	require.True(t, check.IfNil(simAccountsDB))
+	require.Equal(t, ErrNilHasher, err)
+}
+
 func TestNewReadOnlyAccountsDB(t *testing.T) {
 	t.Parallel()
 
-	simAccountsDB, err := NewSimulationAccountsDB(&stateMock.AccountsStub{})
+	simAccountsDB, err := NewSimulationAccountsDB(&stateMock.AccountsStub{}, &stateMock.AccountsRepositoryStub{}, &hashingMocks.HasherMock{})
 	require.False(t, check.IfNil(simAccountsDB))
 	require.NoError(t, err)
 }
@@ -71,7 +91,7 @@ func TestReadOnlyAccountsDB_WriteOperationsShouldNotCalled(t *testing.T) {
 		},
 	}
 
-	simAccountsDB, _ := NewSimulationAccountsDB(accDb)
+	simAccountsDB, _ := NewSimulationAccountsDB(accDb, &stateMock.AccountsRepositoryStub{}, &hashingMocks.HasherMock{})
 	require.NotNil(t, simAccountsDB)
 
 	err := simAccountsDB.SaveAccount(nil)
@@ -124,7 +144,7 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 		},
 	}
 
-	simAccountsDB, _ := NewSimulationAccountsDB(accDb)
+	simAccountsDB, _ := NewSimulationAccountsDB(accDb, &stateMock.AccountsRepositoryStub{}, &hashingMocks.HasherMock{})
 	require.NotNil(t, simAccountsDB)
 
 	actualAcc, err := simAccountsDB.GetExistingAccount(nil)
@@ -155,3 +175,101 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	err = allLeaves.ErrChan.ReadFromChanNonBlocking()
 	require.NoError(t, err)
 }
+
+func TestReadOnlyAccountsDB_SaveAccountWithNewCodeShouldCacheTheCode(t *testing.T) {
+	t.Parallel()
+
+	originalCode := []byte("original code")
+	accDb := &stateMock.AccountsStub{
+		GetCodeCalled: func(_ []byte) []byte {
+			return originalCode
+		},
+	}
+	hasher := &hashingMocks.HasherMock{}
+	simAccountsDB, _ := NewSimulationAccountsDB(accDb, &stateMock.AccountsRepositoryStub{}, hasher)
+
+	newCode := []byte("new code")
+	account := stateMock.NewAccountWrapMock([]byte("address"))
+	account.SetCode(newCode)
+	err := simAccountsDB.SaveAccount(account)
+	require.NoError(t, err)
+
+	expectedCodeHash := hasher.Compute(string(newCode))
+	require.Equal(t, expectedCodeHash, account.GetCodeHash())
+	require.Equal(t, newCode, simAccountsDB.GetCode(expectedCodeHash))
+	require.Equal(t, originalCode, simAccountsDB.GetCode([]byte("other code hash")))
+
+	simAccountsDB.CleanCache()
+	require.Equal(t, originalCode, simAccountsDB.GetCode(expectedCodeHash))
+}
+
+func TestReadOnlyAccountsDB_RecreateTrieFromEpochShouldReadFromTheHistoricalState(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	epoch := core.OptionalUint32{Value: 3, HasValue: true}
+	currentAccount := stateMock.NewAccountWrapMock([]byte("current"))
+	historicalAccount := stateMock.NewAccountWrapMock([]byte("historical"))
+	currentCode := []byte("current code")
+	historicalCode := []byte("historical code")
+	missingAddress := []byte("missing")
+	accDb := &stateMock.AccountsStub{
+		LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			return currentAccount, nil
+		},
+		GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			return currentAccount, nil
+		},
+		GetCodeCalled: func(_ []byte) []byte {
+			return currentCode
+		},
+		GetAccountFromBytesCalled: func(address []byte, _ []byte) (vmcommon.AccountHandler, error) {
+			return stateMock.NewAccountWrapMock(address), nil
+		},
+	}
+	checkOptions := func(options api.AccountQueryOptions) {
+		require.Equal(t, rootHash, options.BlockRootHash)
+		require.Equal(t, epoch, options.HintEpoch)
+	}
+	repository := &stateMock.AccountsRepositoryStub{
+		GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+			checkOptions(options)
+			if bytes.Equal(address, missingAddress) {
+				return nil, nil, state.NewErrAccountNotFoundAtBlock(holders.NewBlockInfo(nil, 0, rootHash))
+			}
+
+			return historicalAccount, nil, nil
+		},
+		GetCodeWithBlockInfoCalled: func(_ []byte, options api.AccountQueryOptions) ([]byte, common.BlockInfo, error) {
+			checkOptions(options)
+			return historicalCode, nil, nil
+		},
+	}
+	simAccountsDB, _ := NewSimulationAccountsDB(accDb, repository, &hashingMocks.HasherMock{})
+
+	err := simAccountsDB.RecreateTrieFromEpoch(nil)
+	require.Equal(t, ErrNilRootHashHolder, err)
+
+	err = simAccountsDB.RecreateTrieFromEpoch(holders.NewRootHashHolder(rootHash, epoch))
+	require.NoError(t, err)
+
+	account, err := simAccountsDB.LoadAccount([]byte("address"))
+	require.NoError(t, err)
+	require.Equal(t, historicalAccount, account)
+	require.Equal(t, historicalCode, simAccountsDB.GetCode([]byte("code hash")))
+
+	account, err = simAccountsDB.GetExistingAccount(missingAddress)
+	require.Nil(t, account)
+	require.Equal(t, state.ErrAccNotFound, err)
+
+	account, err = simAccountsDB.LoadAccount(missingAddress)
+	require.NoError(t, err)
+	require.Equal(t, missingAddress, account.AddressBytes())
+
+	simAccountsDB.CleanCache()
+
+	account, err = simAccountsDB.LoadAccount([]byte("address"))
+	require.NoError(t, err)
+	require.Equal(t, currentAccount, account)
+	require.Equal(t, currentCode, simAccountsDB.GetCode([]byte("code hash")))
+}
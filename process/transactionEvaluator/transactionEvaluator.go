@@ -1,6 +1,8 @@
 package transactionEvaluator
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -34,16 +36,20 @@ type ArgsApiTransactionEvaluator struct {
 	ShardCoordinator    sharding.Coordinator
 	EnableEpochsHandler common.EnableEpochsHandler
 	BlockChain          data.ChainHandler
+	AddressConverter    core.PubkeyConverter
+	Tracer              TraceRecorder
 }
 
 type apiTransactionEvaluator struct {
 	accounts            state.AccountsAdapterWithClean
+	addressConverter    core.PubkeyConverter
 	shardCoordinator    sharding.Coordinator
 	txTypeHandler       process.TxTypeHandler
 	feeHandler          process.FeeHandler
 	txSimulator         facade.TransactionSimulatorProcessor
 	enableEpochsHandler common.EnableEpochsHandler
 	blockChain          data.ChainHandler
+	tracer              TraceRecorder
 	mutExecution        sync.RWMutex
 }
 
@@ -70,6 +76,12 @@ func NewAPITransactionEvaluator(args ArgsApiTransactionEvaluator) (*apiTransacti
 	if check.IfNil(args.BlockChain) {
 		return nil, process.ErrNilBlockChain
 	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.Tracer) {
+		return nil, ErrNilTraceRecorder
+	}
 	err := core.CheckHandlerCompatibility(args.EnableEpochsHandler, []core.EnableEpochFlag{
 		common.CleanUpInformativeSCRsFlag,
 	})
@@ -85,32 +97,231 @@ func NewAPITransactionEvaluator(args ArgsApiTransactionEvaluator) (*apiTransacti
 		shardCoordinator:    args.ShardCoordinator,
 		enableEpochsHandler: args.EnableEpochsHandler,
 		blockChain:          args.BlockChain,
+		addressConverter:    args.AddressConverter,
+		tracer:              args.Tracer,
 	}
 
 	return tce, nil
 }
 
-// SimulateTransactionExecution will simulate a transaction's execution and will return the results
-func (ate *apiTransactionEvaluator) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResultsWithVMOutput, error) {
+// SimulateTransactionExecution will simulate a transaction's execution and will return the results. The provided state
+// overrides, keyed by address, are applied on top of the current accounts state before the execution. If requested, the
+// results will also contain the execution trace
+func (ate *apiTransactionEvaluator) SimulateTransactionExecution(
+	tx *transaction.Transaction,
+	stateOverrides map[string]*txSimData.AccountOverride,
+	withTrace bool,
+) (*txSimData.SimulationResultsWithVMOutput, error) {
 	ate.mutExecution.Lock()
 	defer func() {
 		ate.accounts.CleanCache()
 		ate.mutExecution.Unlock()
 	}()
 
+	err := ate.applyStateOverrides(stateOverrides)
+	if err != nil {
+		return nil, err
+	}
+
 	currentHeader := ate.getCurrentBlockHeader()
+	if !withTrace {
+		return ate.txSimulator.ProcessTx(tx, currentHeader)
+	}
 
-	return ate.txSimulator.ProcessTx(tx, currentHeader)
+	return ate.processTxWithTrace(tx, currentHeader)
 }
 
-// ComputeTransactionGasLimit will calculate how many gas units a transaction will consume
-func (ate *apiTransactionEvaluator) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+// TraceTransactionExecution will re-execute the provided transaction on the state described by the root hash holder
+// and will return the results along with the execution trace. The state is the one at the end of the block preceding
+// the transaction's block, so the changes done by the transactions executed earlier in the same block are not visible
+func (ate *apiTransactionEvaluator) TraceTransactionExecution(
+	tx *transaction.Transaction,
+	blockHeader data.HeaderHandler,
+	rootHashHolder common.RootHashHolder,
+) (*txSimData.SimulationResultsWithVMOutput, error) {
 	ate.mutExecution.Lock()
 	defer func() {
 		ate.accounts.CleanCache()
 		ate.mutExecution.Unlock()
 	}()
 
+	err := ate.accounts.RecreateTrieFromEpoch(rootHashHolder)
+	if err != nil {
+		return nil, err
+	}
+
+	return ate.processTxWithTrace(tx, blockHeader)
+}
+
+func (ate *apiTransactionEvaluator) processTxWithTrace(tx *transaction.Transaction, blockHeader data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+	ate.tracer.Start()
+	results, err := ate.txSimulator.ProcessTx(tx, blockHeader)
+	trace := ate.tracer.Stop()
+	if err != nil {
+		return nil, err
+	}
+
+	results.Trace = trace
+
+	return results, nil
+}
+
+// SimulateTransactionsBundleExecution will simulate the execution of the provided transactions in the given order,
+// each transaction seeing the state changes produced by the previous ones. The results of each transaction are returned
+// along with the differences between the initial and the final state of the touched accounts
+func (ate *apiTransactionEvaluator) SimulateTransactionsBundleExecution(txs []*transaction.Transaction) (*txSimData.BundleSimulationResults, error) {
+	if len(txs) == 0 {
+		return nil, ErrEmptyTransactionsBundle
+	}
+
+	ate.mutExecution.Lock()
+	defer func() {
+		ate.accounts.CleanCache()
+		ate.mutExecution.Unlock()
+	}()
+
+	currentHeader := ate.getCurrentBlockHeader()
+	results := make([]*txSimData.SimulationResultsWithVMOutput, 0, len(txs))
+	touchedStorage := make(map[string]map[string]struct{})
+	for _, tx := range txs {
+		res, err := ate.txSimulator.ProcessTx(tx, currentHeader)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, res)
+		addTouchedAccounts(touchedStorage, tx, res.VMOutput)
+	}
+
+	accountsDiff, err := ate.computeAccountsDiff(touchedStorage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txSimData.BundleSimulationResults{
+		Results:      results,
+		AccountsDiff: accountsDiff,
+	}, nil
+}
+
+func addTouchedAccounts(touchedStorage map[string]map[string]struct{}, tx *transaction.Transaction, vmOutput *vmcommon.VMOutput) {
+	addTouchedAccount(touchedStorage, tx.SndAddr)
+	addTouchedAccount(touchedStorage, tx.RcvAddr)
+	if vmOutput == nil {
+		return
+	}
+
+	for _, outputAccount := range vmOutput.OutputAccounts {
+		storageKeys := addTouchedAccount(touchedStorage, outputAccount.Address)
+		for key := range outputAccount.StorageUpdates {
+			storageKeys[key] = struct{}{}
+		}
+	}
+}
+
+func addTouchedAccount(touchedStorage map[string]map[string]struct{}, address []byte) map[string]struct{} {
+	storageKeys, found := touchedStorage[string(address)]
+	if !found {
+		storageKeys = make(map[string]struct{})
+		touchedStorage[string(address)] = storageKeys
+	}
+
+	return storageKeys
+}
+
+// computeAccountsDiff compares the accounts modified by the simulation with the ones from the original state, which
+// become visible again after the simulation cache is cleaned
+func (ate *apiTransactionEvaluator) computeAccountsDiff(touchedStorage map[string]map[string]struct{}) (map[string]*txSimData.AccountDiff, error) {
+	accountsAfter := make(map[string]state.UserAccountHandler, len(touchedStorage))
+	for address := range touchedStorage {
+		if ate.shardCoordinator.ComputeId([]byte(address)) != ate.shardCoordinator.SelfId() {
+			continue
+		}
+
+		account, err := ate.loadUserAccount([]byte(address))
+		if err != nil {
+			return nil, err
+		}
+
+		accountsAfter[address] = account
+	}
+
+	ate.accounts.CleanCache()
+
+	accountsDiff := make(map[string]*txSimData.AccountDiff, len(accountsAfter))
+	for address, accountAfter := range accountsAfter {
+		accountBefore, err := ate.loadUserAccount([]byte(address))
+		if err != nil {
+			return nil, err
+		}
+
+		accountDiff := &txSimData.AccountDiff{
+			NonceBefore:    accountBefore.GetNonce(),
+			NonceAfter:     accountAfter.GetNonce(),
+			BalanceBefore:  accountBefore.GetBalance().String(),
+			BalanceAfter:   accountAfter.GetBalance().String(),
+			StorageUpdates: computeStorageDiff(accountBefore, accountAfter, touchedStorage[address]),
+		}
+
+		isUnchanged := accountDiff.NonceBefore == accountDiff.NonceAfter &&
+			accountDiff.BalanceBefore == accountDiff.BalanceAfter &&
+			len(accountDiff.StorageUpdates) == 0
+		if isUnchanged {
+			continue
+		}
+
+		accountsDiff[ate.addressConverter.SilentEncode([]byte(address), log)] = accountDiff
+	}
+
+	return accountsDiff, nil
+}
+
+func computeStorageDiff(accountBefore state.UserAccountHandler, accountAfter state.UserAccountHandler, storageKeys map[string]struct{}) map[string]*txSimData.StorageDiff {
+	storageDiff := make(map[string]*txSimData.StorageDiff)
+	for key := range storageKeys {
+		valueBefore, _, _ := accountBefore.RetrieveValue([]byte(key))
+		valueAfter, _, _ := accountAfter.RetrieveValue([]byte(key))
+		if bytes.Equal(valueBefore, valueAfter) {
+			continue
+		}
+
+		storageDiff[hex.EncodeToString([]byte(key))] = &txSimData.StorageDiff{
+			Before: hex.EncodeToString(valueBefore),
+			After:  hex.EncodeToString(valueAfter),
+		}
+	}
+
+	return storageDiff
+}
+
+func (ate *apiTransactionEvaluator) loadUserAccount(address []byte) (state.UserAccountHandler, error) {
+	accountHandler, err := ate.accounts.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	account, ok := accountHandler.(state.UserAccountHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	return account, nil
+}
+
+// ComputeTransactionGasLimit will calculate how many gas units a transaction will consume. The provided state overrides,
+// keyed by address, are applied on top of the current accounts state before the computation
+func (ate *apiTransactionEvaluator) ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides map[string]*txSimData.AccountOverride) (*transaction.CostResponse, error) {
+	ate.mutExecution.Lock()
+	defer func() {
+		ate.accounts.CleanCache()
+		ate.mutExecution.Unlock()
+	}()
+
+	err := ate.applyStateOverrides(stateOverrides)
+	if err != nil {
+		return nil, err
+	}
+
 	txTypeOnSender, txTypeOnDestination := ate.txTypeHandler.ComputeTransactionType(tx)
 	if txTypeOnSender == process.MoveBalance && txTypeOnDestination == process.MoveBalance {
 		return ate.computeMoveBalanceCost(tx), nil
@@ -133,6 +344,83 @@ func (ate *apiTransactionEvaluator) ComputeTransactionGasLimit(tx *transaction.T
 	}
 }
 
+func (ate *apiTransactionEvaluator) applyStateOverrides(stateOverrides map[string]*txSimData.AccountOverride) error {
+	for address, accountOverride := range stateOverrides {
+		err := ate.applyAccountOverride(address, accountOverride)
+		if err != nil {
+			return fmt.Errorf("%w while applying the state override for address %s", err, address)
+		}
+	}
+
+	return nil
+}
+
+func (ate *apiTransactionEvaluator) applyAccountOverride(address string, accountOverride *txSimData.AccountOverride) error {
+	if accountOverride == nil {
+		return nil
+	}
+
+	addressBytes, err := ate.addressConverter.Decode(address)
+	if err != nil {
+		return err
+	}
+
+	account, err := ate.loadUserAccount(addressBytes)
+	if err != nil {
+		return err
+	}
+
+	if len(accountOverride.Balance) > 0 {
+		balance, ok := big.NewInt(0).SetString(accountOverride.Balance, 10)
+		if !ok || balance.Sign() < 0 {
+			return ErrInvalidBalanceOverride
+		}
+
+		err = account.SubFromBalance(account.GetBalance())
+		if err != nil {
+			return err
+		}
+
+		err = account.AddToBalance(balance)
+		if err != nil {
+			return err
+		}
+	}
+
+	if accountOverride.Nonce != nil {
+		// the nonce can only be increased, so the difference is added in modular arithmetic in order to reach any value
+		account.IncreaseNonce(*accountOverride.Nonce - account.GetNonce())
+	}
+
+	if len(accountOverride.Code) > 0 {
+		code, errDecode := hex.DecodeString(accountOverride.Code)
+		if errDecode != nil {
+			return errDecode
+		}
+
+		account.SetCode(code)
+	}
+
+	for key, value := range accountOverride.Storage {
+		keyBytes, errDecode := hex.DecodeString(key)
+		if errDecode != nil {
+			return errDecode
+		}
+
+		valueBytes, errDecode := hex.DecodeString(value)
+		if errDecode != nil {
+			return errDecode
+		}
+
+		err = account.SaveKeyValue(keyBytes, valueBytes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ate.accounts.SaveAccount(account)
+}
+
 func (ate *apiTransactionEvaluator) computeMoveBalanceCost(tx *transaction.Transaction) *transaction.CostResponse {
 	gasUnits := ate.feeHandler.ComputeGasLimit(tx)
 
@@ -1,6 +1,7 @@
 package transactionEvaluator
 
 import (
+	"encoding/hex"
 	"errors"
 	"math"
 	"math/big"
@@ -12,12 +13,16 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/require"
@@ -32,6 +37,8 @@ func createArgs() ArgsApiTransactionEvaluator {
 		ShardCoordinator:    &mock.ShardCoordinatorStub{},
 		EnableEpochsHandler: &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
 		BlockChain:          &testscommon.ChainHandlerMock{},
+		AddressConverter:    &testscommon.PubkeyConverterStub{},
+		Tracer:              &testscommon.ExecutionTracerStub{},
 	}
 }
 
@@ -55,6 +62,26 @@ func TestTransactionEvaluator_NilBlockChain(t *testing.T) {
 	require.Equal(t, process.ErrNilBlockChain, err)
 }
 
+func TestTransactionEvaluator_NilAddressConverterShouldErr(t *testing.T) {
+	t.Parallel()
+	args := createArgs()
+	args.AddressConverter = nil
+	tce, err := NewAPITransactionEvaluator(args)
+
+	require.Nil(t, tce)
+	require.Equal(t, ErrNilPubkeyConverter, err)
+}
+
+func TestTransactionEvaluator_NilTracerShouldErr(t *testing.T) {
+	t.Parallel()
+	args := createArgs()
+	args.Tracer = nil
+	tce, err := NewAPITransactionEvaluator(args)
+
+	require.Nil(t, tce)
+	require.Equal(t, ErrNilTraceRecorder, err)
+}
+
 func TestTransactionEvaluator_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -140,7 +167,7 @@ func TestComputeTransactionGasLimit_MoveBalance(t *testing.T) {
 	require.Nil(t, err)
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, consumedGasUnits, cost.GasUnits)
 }
@@ -178,7 +205,7 @@ func TestComputeTransactionGasLimit_MoveBalanceInvalidNonceShouldStillComputeCos
 	tce, _ := NewAPITransactionEvaluator(args)
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, consumedGasUnits, cost.GasUnits)
 }
@@ -214,7 +241,7 @@ func TestComputeTransactionGasLimit_BuiltInFunction(t *testing.T) {
 	tce, _ := NewAPITransactionEvaluator(args)
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, consumedGasUnits, cost.GasUnits)
 }
@@ -245,7 +272,7 @@ func TestComputeTransactionGasLimit_BuiltInFunctionShouldErr(t *testing.T) {
 	tce, _ := NewAPITransactionEvaluator(args)
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, localErr.Error(), cost.ReturnMessage)
 }
@@ -276,7 +303,7 @@ func TestComputeTransactionGasLimit_NilVMOutput(t *testing.T) {
 	require.Nil(t, err)
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, process.ErrNilVMOutput.Error(), cost.ReturnMessage)
 }
@@ -311,7 +338,7 @@ func TestComputeTransactionGasLimit_RetCodeNotOk(t *testing.T) {
 	tce, _ := NewAPITransactionEvaluator(args)
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.True(t, strings.Contains(cost.ReturnMessage, vmcommon.UserError.String()))
 }
@@ -328,7 +355,7 @@ func TestTransactionEvaluator_RelayedTxShouldErr(t *testing.T) {
 	tce, _ := NewAPITransactionEvaluator(args)
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, "cannot compute cost of the relayed transaction", cost.ReturnMessage)
 }
@@ -371,11 +398,376 @@ func TestApiTransactionEvaluator_SimulateTransactionExecution(t *testing.T) {
 
 	tx := &transaction.Transaction{}
 
-	_, err = tce.SimulateTransactionExecution(tx)
+	_, err = tce.SimulateTransactionExecution(tx, nil, false)
 	require.Nil(t, err)
 	require.True(t, called)
 }
 
+func TestApiTransactionEvaluator_SimulateTransactionExecutionWithTrace(t *testing.T) {
+	t.Parallel()
+
+	expectedTrace := []*txSimData.ExecutionFrame{{Function: "function"}}
+	t.Run("without trace should not start the tracer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+		args.Tracer = &testscommon.ExecutionTracerStub{
+			StartCalled: func() {
+				require.Fail(t, "should have not started the tracer")
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		res, err := tce.SimulateTransactionExecution(&transaction.Transaction{}, nil, false)
+		require.Nil(t, err)
+		require.Nil(t, res.Trace)
+	})
+	t.Run("with trace should return the recorded trace", func(t *testing.T) {
+		t.Parallel()
+
+		isTracing := false
+		args := createArgs()
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.True(t, isTracing)
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+		args.Tracer = &testscommon.ExecutionTracerStub{
+			StartCalled: func() {
+				isTracing = true
+			},
+			StopCalled: func() []*txSimData.ExecutionFrame {
+				isTracing = false
+				return expectedTrace
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		res, err := tce.SimulateTransactionExecution(&transaction.Transaction{}, nil, true)
+		require.Nil(t, err)
+		require.False(t, isTracing)
+		require.Equal(t, expectedTrace, res.Trace)
+	})
+	t.Run("with trace should stop the tracer on error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		stopCalled := false
+		args := createArgs()
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				return nil, expectedErr
+			},
+		}
+		args.Tracer = &testscommon.ExecutionTracerStub{
+			StopCalled: func() []*txSimData.ExecutionFrame {
+				stopCalled = true
+				return expectedTrace
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		res, err := tce.SimulateTransactionExecution(&transaction.Transaction{}, nil, true)
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+		require.True(t, stopCalled)
+	})
+}
+
+func TestApiTransactionEvaluator_TraceTransactionExecution(t *testing.T) {
+	t.Parallel()
+
+	rootHashHolder := holders.NewRootHashHolder([]byte("root hash"), core.OptionalUint32{Value: 2, HasValue: true})
+	blockHeader := &block.Header{Nonce: 37}
+	t.Run("recreate trie error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgs()
+		args.Accounts = &stateMock.AccountsStub{
+			RecreateTrieFromEpochCalled: func(options common.RootHashHolder) error {
+				return expectedErr
+			},
+		}
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Fail(t, "should have not processed the transaction")
+				return nil, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		res, err := tce.TraceTransactionExecution(&transaction.Transaction{}, blockHeader, rootHashHolder)
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should execute on the historical state with the provided header", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTrace := []*txSimData.ExecutionFrame{{Function: "function"}}
+		recreatedRootHash := make([]byte, 0)
+		cleanCacheCalled := false
+		args := createArgs()
+		args.Accounts = &stateMock.AccountsStub{
+			RecreateTrieFromEpochCalled: func(options common.RootHashHolder) error {
+				recreatedRootHash = options.GetRootHash()
+				return nil
+			},
+			CleanCacheCalled: func() {
+				cleanCacheCalled = true
+			},
+		}
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, header data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				require.Equal(t, blockHeader, header)
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+		args.Tracer = &testscommon.ExecutionTracerStub{
+			StopCalled: func() []*txSimData.ExecutionFrame {
+				return expectedTrace
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		res, err := tce.TraceTransactionExecution(&transaction.Transaction{}, blockHeader, rootHashHolder)
+		require.Nil(t, err)
+		require.Equal(t, expectedTrace, res.Trace)
+		require.Equal(t, rootHashHolder.GetRootHash(), recreatedRootHash)
+		require.True(t, cleanCacheCalled)
+	})
+}
+
+func TestApiTransactionEvaluator_StateOverrides(t *testing.T) {
+	t.Parallel()
+
+	address := []byte("address")
+	createArgsWithSimulationAccounts := func() (ArgsApiTransactionEvaluator, *simulationAccountsDB) {
+		originalAccounts := &stateMock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				account := stateMock.NewAccountWrapMock(address)
+				account.Balance = big.NewInt(100)
+				account.IncreaseNonce(10)
+				return account, nil
+			},
+		}
+		simulationAccounts, _ := NewSimulationAccountsDB(originalAccounts, &stateMock.AccountsRepositoryStub{}, &hashingMocks.HasherMock{})
+
+		args := createArgs()
+		args.Accounts = simulationAccounts
+		args.AddressConverter = testscommon.NewPubkeyConverterMock(len(address))
+
+		return args, simulationAccounts
+	}
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args, _ := createArgsWithSimulationAccounts()
+		args.AddressConverter = &testscommon.PubkeyConverterStub{
+			DecodeCalled: func(humanReadable string) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		stateOverrides := map[string]*txSimData.AccountOverride{
+			"invalid address": {Balance: "1"},
+		}
+		res, err := tce.SimulateTransactionExecution(&transaction.Transaction{}, stateOverrides, false)
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("invalid balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createArgsWithSimulationAccounts()
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		stateOverrides := map[string]*txSimData.AccountOverride{
+			hex.EncodeToString(address): {Balance: "-1"},
+		}
+		res, err := tce.ComputeTransactionGasLimit(&transaction.Transaction{}, stateOverrides)
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, ErrInvalidBalanceOverride))
+	})
+	t.Run("invalid storage key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createArgsWithSimulationAccounts()
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		stateOverrides := map[string]*txSimData.AccountOverride{
+			hex.EncodeToString(address): {Storage: map[string]string{"not hex": "01"}},
+		}
+		res, err := tce.SimulateTransactionExecution(&transaction.Transaction{}, stateOverrides, false)
+		require.Nil(t, res)
+		require.NotNil(t, err)
+	})
+	t.Run("should apply the overrides before the execution", func(t *testing.T) {
+		t.Parallel()
+
+		args, simulationAccounts := createArgsWithSimulationAccounts()
+		newNonce := uint64(3)
+		code := []byte("code")
+		processTxCalled := false
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				processTxCalled = true
+				accountHandler, _ := simulationAccounts.LoadAccount(address)
+				account := accountHandler.(state.UserAccountHandler)
+				require.Equal(t, big.NewInt(5000), account.GetBalance())
+				require.Equal(t, newNonce, account.GetNonce())
+				require.Equal(t, code, simulationAccounts.GetCode(account.GetCodeHash()))
+
+				value, _, err := account.RetrieveValue([]byte("key"))
+				require.Nil(t, err)
+				require.Equal(t, []byte("value"), value)
+
+				return &txSimData.SimulationResultsWithVMOutput{}, nil
+			},
+		}
+		tce, _ := NewAPITransactionEvaluator(args)
+
+		stateOverrides := map[string]*txSimData.AccountOverride{
+			hex.EncodeToString(address): {
+				Balance: "5000",
+				Nonce:   &newNonce,
+				Code:    hex.EncodeToString(code),
+				Storage: map[string]string{
+					hex.EncodeToString([]byte("key")): hex.EncodeToString([]byte("value")),
+				},
+			},
+		}
+		_, err := tce.SimulateTransactionExecution(&transaction.Transaction{}, stateOverrides, false)
+		require.Nil(t, err)
+		require.True(t, processTxCalled)
+
+		accountHandler, _ := simulationAccounts.LoadAccount(address)
+		require.Equal(t, big.NewInt(100), accountHandler.(state.UserAccountHandler).GetBalance())
+	})
+}
+
+func TestApiTransactionEvaluator_SimulateTransactionsBundleExecution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty bundle should error", func(t *testing.T) {
+		t.Parallel()
+
+		tce, _ := NewAPITransactionEvaluator(createArgs())
+		results, err := tce.SimulateTransactionsBundleExecution(nil)
+		require.Nil(t, results)
+		require.Equal(t, ErrEmptyTransactionsBundle, err)
+	})
+	t.Run("simulator error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgs()
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(_ *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				return nil, expectedErr
+			},
+		}
+
+		tce, _ := NewAPITransactionEvaluator(args)
+		results, err := tce.SimulateTransactionsBundleExecution([]*transaction.Transaction{{}})
+		require.Nil(t, results)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should chain the state changes and compute the accounts diff", func(t *testing.T) {
+		t.Parallel()
+
+		sender := []byte("sender")
+		contract := []byte("contract")
+		untouched := []byte("untouched")
+		originalAccounts := &stateMock.AccountsStub{
+			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				account := stateMock.NewAccountWrapMock(address)
+				account.Balance = big.NewInt(100)
+				account.IncreaseNonce(5)
+				return account, nil
+			},
+		}
+		simulationAccounts, _ := NewSimulationAccountsDB(originalAccounts, &stateMock.AccountsRepositoryStub{}, &hashingMocks.HasherMock{})
+
+		args := createArgs()
+		args.Accounts = simulationAccounts
+		args.AddressConverter = testscommon.NewPubkeyConverterMock(len(sender))
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, _ data.HeaderHandler) (*txSimData.SimulationResultsWithVMOutput, error) {
+				senderAccount, _ := simulationAccounts.LoadAccount(tx.SndAddr)
+				userAccount := senderAccount.(state.UserAccountHandler)
+				require.Equal(t, tx.Nonce, userAccount.GetNonce())
+				userAccount.IncreaseNonce(1)
+				_ = userAccount.SubFromBalance(big.NewInt(10))
+				_ = simulationAccounts.SaveAccount(userAccount)
+
+				contractAccount, _ := simulationAccounts.LoadAccount(contract)
+				_ = contractAccount.(state.UserAccountHandler).SaveKeyValue([]byte("key"), []byte{byte(tx.Nonce)})
+				_ = simulationAccounts.SaveAccount(contractAccount)
+
+				_, _ = simulationAccounts.LoadAccount(untouched)
+
+				return &txSimData.SimulationResultsWithVMOutput{
+					SimulationResults: transaction.SimulationResults{
+						Status: transaction.TxStatusSuccess,
+					},
+					VMOutput: &vmcommon.VMOutput{
+						OutputAccounts: map[string]*vmcommon.OutputAccount{
+							string(contract): {
+								Address: contract,
+								StorageUpdates: map[string]*vmcommon.StorageUpdate{
+									"key": {Offset: []byte("key"), Data: []byte{byte(tx.Nonce)}},
+								},
+							},
+						},
+					},
+				}, nil
+			},
+		}
+
+		tce, _ := NewAPITransactionEvaluator(args)
+		txs := []*transaction.Transaction{
+			{Nonce: 5, SndAddr: sender, RcvAddr: contract},
+			{Nonce: 6, SndAddr: sender, RcvAddr: contract},
+		}
+		results, err := tce.SimulateTransactionsBundleExecution(txs)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(results.Results))
+
+		expectedAccountsDiff := map[string]*txSimData.AccountDiff{
+			hex.EncodeToString(sender): {
+				NonceBefore:    5,
+				NonceAfter:     7,
+				BalanceBefore:  "100",
+				BalanceAfter:   "80",
+				StorageUpdates: map[string]*txSimData.StorageDiff{},
+			},
+			hex.EncodeToString(contract): {
+				NonceBefore:   5,
+				NonceAfter:    5,
+				BalanceBefore: "100",
+				BalanceAfter:  "100",
+				StorageUpdates: map[string]*txSimData.StorageDiff{
+					hex.EncodeToString([]byte("key")): {
+						Before: "",
+						After:  "06",
+					},
+				},
+			},
+		}
+		require.Equal(t, expectedAccountsDiff, results.AccountsDiff)
+	})
+}
+
 func TestApiTransactionEvaluator_ComputeTransactionGasLimit(t *testing.T) {
 	t.Parallel()
 
@@ -403,7 +795,7 @@ func TestApiTransactionEvaluator_ComputeTransactionGasLimit(t *testing.T) {
 
 	tx := &transaction.Transaction{}
 
-	_, err = tce.ComputeTransactionGasLimit(tx)
+	_, err = tce.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.True(t, called)
 }
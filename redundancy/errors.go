@@ -9,3 +9,9 @@ var ErrNilMessenger = errors.New("nil messenger")
 
 // ErrNilObserverPrivateKey signals that a nil observer private key has been provided
 var ErrNilObserverPrivateKey = errors.New("nil observer private key")
+
+// ErrNilRedundancyLeaseHandler signals that a nil redundancy lease handler has been provided
+var ErrNilRedundancyLeaseHandler = errors.New("nil redundancy lease handler")
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
@@ -0,0 +1,41 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-go/common"
+
+type leaseHandler struct {
+}
+
+// NewLeaseHandler creates a disabled lease handler, used when the redundant instances are not coordinated through a lease
+func NewLeaseHandler() *leaseHandler {
+	return &leaseHandler{}
+}
+
+// IsEnabled returns false
+func (handler *leaseHandler) IsEnabled() bool {
+	return false
+}
+
+// IsLeaseHolder returns false
+func (handler *leaseHandler) IsLeaseHolder() bool {
+	return false
+}
+
+// StepDown returns nil
+func (handler *leaseHandler) StepDown() error {
+	return nil
+}
+
+// State returns an empty state
+func (handler *leaseHandler) State() common.RedundancyLeaseState {
+	return common.RedundancyLeaseState{}
+}
+
+// Close returns nil
+func (handler *leaseHandler) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *leaseHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
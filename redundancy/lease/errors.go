@@ -0,0 +1,18 @@
+package lease
+
+import "errors"
+
+// ErrEmptyFilePath signals that an empty lease file path has been provided
+var ErrEmptyFilePath = errors.New("empty lease file path")
+
+// ErrEmptyInstanceID signals that an empty instance identifier has been provided
+var ErrEmptyInstanceID = errors.New("empty instance ID")
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNotLeaseHolder signals that the current instance does not hold the lease
+var ErrNotLeaseHolder = errors.New("the current instance does not hold the redundancy lease")
+
+// ErrLeaseFileLocked signals that the lock of the lease file could not be taken
+var ErrLeaseFileLocked = errors.New("can not lock the lease file")
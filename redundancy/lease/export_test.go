@@ -0,0 +1,18 @@
+package lease
+
+import "time"
+
+// SetTimeHandler -
+func (handler *leaseHandler) SetTimeHandler(getTimeHandler func() time.Time) {
+	handler.mut.Lock()
+	handler.getTimeHandler = getTimeHandler
+	handler.mut.Unlock()
+}
+
+// UpdateLease -
+func (handler *leaseHandler) UpdateLease() {
+	handler.updateLease()
+}
+
+// LeaseHandlerForTests -
+type LeaseHandlerForTests = *leaseHandler
@@ -0,0 +1,54 @@
+package lease
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+	"time"
+)
+
+const (
+	lockFileSuffix      = ".lock"
+	maxLockAttempts     = 10
+	delayBetweenLocking = 50 * time.Millisecond
+)
+
+// leaseFileLock is an exclusive advisory lock, shared by all the redundant instances, taken on a file placed next to
+// the lease file. The lease file itself can not be locked as it is replaced on each write
+type leaseFileLock struct {
+	file *os.File
+}
+
+// lockLeaseFile takes the exclusive lock for the provided lease file, so the read-then-write operations of the
+// redundant instances on the lease file do not interleave
+func lockLeaseFile(filePath string) (*leaseFileLock, error) {
+	file, err := os.OpenFile(filePath+lockFileSuffix, os.O_CREATE|os.O_RDWR, leaseFilePermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < maxLockAttempts; i++ {
+		err = syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+		if err == nil {
+			return &leaseFileLock{file: file}, nil
+		}
+		if err != syscall.EWOULDBLOCK {
+			break
+		}
+
+		time.Sleep(delayBetweenLocking)
+	}
+
+	_ = file.Close()
+
+	return nil, fmt.Errorf("%w: %v", ErrLeaseFileLocked, err)
+}
+
+func (lock *leaseFileLock) unlock() {
+	err := syscall.Flock(int(lock.file.Fd()), syscall.LOCK_UN)
+	if err != nil {
+		log.Warn("leaseFileLock: can not release the lock", "file", lock.file.Name(), "error", err)
+	}
+
+	_ = lock.file.Close()
+}
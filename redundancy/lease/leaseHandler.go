@@ -0,0 +1,344 @@
+package lease
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/common"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("redundancy/lease")
+
+const (
+	minRenewInterval         = time.Second
+	minRenewIntervalsInLease = 3
+	leaseFilePermissions     = 0644
+)
+
+// leaseRecord is the content of the lease file shared by all the redundant instances
+type leaseRecord struct {
+	Holder    string `json:"holder"`
+	Term      uint64 `json:"term"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+// ArgsLeaseHandler is the DTO used to create a new instance of leaseHandler
+type ArgsLeaseHandler struct {
+	FilePath      string
+	InstanceID    string
+	LeaseDuration time.Duration
+	RenewInterval time.Duration
+}
+
+// leaseHandler coordinates the redundant instances of a node through a lease stored in a file shared by all of them.
+// Each read-then-write of the lease file is done while holding an exclusive lock on a file placed next to it. An
+// instance acquires the lease only if the lease was released or it expired and becomes the lease holder only after
+// it reads back its own record on the next check, so two instances racing for the lease can not both consider
+// themselves holders. The holder renews the lease on each check and stops acting as holder one renew interval before
+// the lease expires, while the other instances wait for the lease to expire, so at most one instance signs at any time
+type leaseHandler struct {
+	filePath       string
+	instanceID     string
+	leaseDuration  time.Duration
+	renewInterval  time.Duration
+	getTimeHandler func() time.Time
+	cancel         func()
+
+	mut              sync.RWMutex
+	lastRecord       leaseRecord
+	isHolder         bool
+	validUntil       time.Time
+	pendingTerm      uint64
+	steppedDownUntil time.Time
+}
+
+// NewLeaseHandler creates a new lease handler and starts competing for the lease
+func NewLeaseHandler(args ArgsLeaseHandler) (*leaseHandler, error) {
+	err := checkArgsLeaseHandler(args)
+	if err != nil {
+		return nil, err
+	}
+
+	handler := &leaseHandler{
+		filePath:       args.FilePath,
+		instanceID:     args.InstanceID,
+		leaseDuration:  args.LeaseDuration,
+		renewInterval:  args.RenewInterval,
+		getTimeHandler: time.Now,
+	}
+	handler.updateLease()
+
+	var ctx context.Context
+	ctx, handler.cancel = context.WithCancel(context.Background())
+	go handler.processLoop(ctx)
+
+	log.Info("redundancy lease enabled", "instance", args.InstanceID, "file", args.FilePath,
+		"lease duration", args.LeaseDuration, "renew interval", args.RenewInterval)
+
+	return handler, nil
+}
+
+func checkArgsLeaseHandler(args ArgsLeaseHandler) error {
+	if len(args.FilePath) == 0 {
+		return ErrEmptyFilePath
+	}
+	if len(args.InstanceID) == 0 {
+		return ErrEmptyInstanceID
+	}
+	if args.RenewInterval < minRenewInterval {
+		return fmt.Errorf("%w for RenewInterval, minimum %v, got %v", ErrInvalidValue, minRenewInterval, args.RenewInterval)
+	}
+	minLeaseDuration := args.RenewInterval * minRenewIntervalsInLease
+	if args.LeaseDuration < minLeaseDuration {
+		return fmt.Errorf("%w for LeaseDuration, minimum %v, got %v", ErrInvalidValue, minLeaseDuration, args.LeaseDuration)
+	}
+
+	return nil
+}
+
+func (handler *leaseHandler) processLoop(ctx context.Context) {
+	timer := time.NewTimer(handler.renewInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("closing leaseHandler.processLoop go routine")
+			return
+		case <-timer.C:
+			handler.updateLease()
+			timer.Reset(handler.renewInterval)
+		}
+	}
+}
+
+func (handler *leaseHandler) updateLease() {
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	lock, err := lockLeaseFile(handler.filePath)
+	if err != nil {
+		// the lease held so far, if any, is not renewed and will expire by itself
+		log.Warn("leaseHandler: can not lock the lease file", "file", handler.filePath, "error", err)
+		return
+	}
+	defer lock.unlock()
+
+	record, err := handler.readRecord()
+	if err != nil {
+		// the lease held so far, if any, is not renewed and will expire by itself
+		log.Warn("leaseHandler: can not read the lease file", "file", handler.filePath, "error", err)
+		return
+	}
+	handler.lastRecord = record
+
+	now := handler.getTimeHandler()
+	isOwnRecord := record.Holder == handler.instanceID
+	if isOwnRecord && handler.isLeaseValid(now) {
+		handler.renew(record, now)
+		return
+	}
+	isAcquisitionConfirmed := isOwnRecord && handler.pendingTerm > 0 && handler.pendingTerm == record.Term
+	if isAcquisitionConfirmed {
+		handler.pendingTerm = 0
+		handler.renew(record, now)
+		log.Info("acquired the redundancy lease", "instance", handler.instanceID, "term", record.Term)
+		return
+	}
+
+	handler.setStandby(record)
+
+	isLeaseAvailable := len(record.Holder) == 0 || now.UnixMilli() >= record.ExpiresAt
+	if !isLeaseAvailable {
+		return
+	}
+	if now.Before(handler.steppedDownUntil) {
+		return
+	}
+
+	handler.acquire(record, now)
+}
+
+func (handler *leaseHandler) renew(record leaseRecord, now time.Time) {
+	newRecord := leaseRecord{
+		Holder:    handler.instanceID,
+		Term:      record.Term,
+		ExpiresAt: now.Add(handler.leaseDuration).UnixMilli(),
+	}
+	err := handler.writeRecord(newRecord)
+	if err != nil {
+		// the lease is not extended, the current validity ends as computed on the last renewal
+		log.Warn("leaseHandler: can not renew the lease", "file", handler.filePath, "error", err)
+		return
+	}
+
+	handler.lastRecord = newRecord
+	handler.isHolder = true
+	handler.validUntil = now.Add(handler.leaseDuration - handler.renewInterval)
+}
+
+func (handler *leaseHandler) acquire(record leaseRecord, now time.Time) {
+	newRecord := leaseRecord{
+		Holder:    handler.instanceID,
+		Term:      record.Term + 1,
+		ExpiresAt: now.Add(handler.leaseDuration).UnixMilli(),
+	}
+	err := handler.writeRecord(newRecord)
+	if err != nil {
+		log.Warn("leaseHandler: can not acquire the lease", "file", handler.filePath, "error", err)
+		return
+	}
+
+	handler.lastRecord = newRecord
+	handler.pendingTerm = newRecord.Term
+
+	log.Debug("leaseHandler: trying to acquire the redundancy lease", "instance", handler.instanceID,
+		"term", newRecord.Term, "previous holder", record.Holder)
+}
+
+func (handler *leaseHandler) setStandby(record leaseRecord) {
+	if handler.isHolder {
+		log.Warn("lost the redundancy lease", "instance", handler.instanceID, "current holder", record.Holder,
+			"term", record.Term)
+	}
+
+	handler.isHolder = false
+	handler.pendingTerm = 0
+}
+
+func (handler *leaseHandler) isLeaseValid(now time.Time) bool {
+	return handler.isHolder && now.Before(handler.validUntil)
+}
+
+func (handler *leaseHandler) readRecord() (leaseRecord, error) {
+	record := leaseRecord{}
+	buff, err := os.ReadFile(handler.filePath)
+	if os.IsNotExist(err) {
+		return record, nil
+	}
+	if err != nil {
+		return record, err
+	}
+	if len(buff) == 0 {
+		return record, nil
+	}
+
+	err = json.Unmarshal(buff, &record)
+
+	return record, err
+}
+
+func (handler *leaseHandler) writeRecord(record leaseRecord) error {
+	buff, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	// the record is written in a temporary file, then moved, so the other instances never read a partial record
+	tempFilePath := fmt.Sprintf("%s.%s.tmp", handler.filePath, handler.instanceID)
+	err = os.WriteFile(tempFilePath, buff, leaseFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, handler.filePath)
+}
+
+// IsEnabled returns true
+func (handler *leaseHandler) IsEnabled() bool {
+	return true
+}
+
+// IsLeaseHolder returns true if the current instance holds the lease and should sign with the validator keys
+func (handler *leaseHandler) IsLeaseHolder() bool {
+	handler.mut.RLock()
+	defer handler.mut.RUnlock()
+
+	return handler.isLeaseValid(handler.getTimeHandler())
+}
+
+// StepDown releases the lease held by the current instance, so another instance can take over. The current instance
+// will not try to acquire the lease again for a lease duration
+func (handler *leaseHandler) StepDown() error {
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	if !handler.isHolder && handler.pendingTerm == 0 {
+		return ErrNotLeaseHolder
+	}
+
+	return handler.release()
+}
+
+func (handler *leaseHandler) release() error {
+	now := handler.getTimeHandler()
+	handler.isHolder = false
+	handler.pendingTerm = 0
+	handler.steppedDownUntil = now.Add(handler.leaseDuration)
+
+	lock, err := lockLeaseFile(handler.filePath)
+	if err != nil {
+		return err
+	}
+	defer lock.unlock()
+
+	record, err := handler.readRecord()
+	if err != nil {
+		return err
+	}
+	if record.Holder != handler.instanceID {
+		return nil
+	}
+
+	releasedRecord := leaseRecord{
+		Holder:    "",
+		Term:      record.Term,
+		ExpiresAt: 0,
+	}
+	err = handler.writeRecord(releasedRecord)
+	if err != nil {
+		return err
+	}
+
+	handler.lastRecord = releasedRecord
+	log.Info("released the redundancy lease", "instance", handler.instanceID, "term", record.Term)
+
+	return nil
+}
+
+// State returns the state of the lease as seen by the current instance
+func (handler *leaseHandler) State() common.RedundancyLeaseState {
+	handler.mut.RLock()
+	defer handler.mut.RUnlock()
+
+	return common.RedundancyLeaseState{
+		InstanceID:    handler.instanceID,
+		Holder:        handler.lastRecord.Holder,
+		Term:          handler.lastRecord.Term,
+		IsLeaseHolder: handler.isLeaseValid(handler.getTimeHandler()),
+	}
+}
+
+// Close stops competing for the lease and releases it if it is held by the current instance
+func (handler *leaseHandler) Close() error {
+	handler.cancel()
+
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	if !handler.isHolder && handler.pendingTerm == 0 {
+		return nil
+	}
+
+	return handler.release()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *leaseHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
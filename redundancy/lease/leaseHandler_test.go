@@ -0,0 +1,315 @@
+package lease_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/redundancy/lease"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// large values so the tests drive the lease updates
+const (
+	testRenewInterval = time.Hour
+	testLeaseDuration = 3 * time.Hour
+)
+
+type testClock struct {
+	mut sync.RWMutex
+	now time.Time
+}
+
+func newTestClock() *testClock {
+	return &testClock{
+		now: time.Now(),
+	}
+}
+
+func (clock *testClock) Now() time.Time {
+	clock.mut.RLock()
+	defer clock.mut.RUnlock()
+
+	return clock.now
+}
+
+func (clock *testClock) Advance(duration time.Duration) {
+	clock.mut.Lock()
+	clock.now = clock.now.Add(duration)
+	clock.mut.Unlock()
+}
+
+func createMockArgsLeaseHandler(filePath string, instanceID string) lease.ArgsLeaseHandler {
+	return lease.ArgsLeaseHandler{
+		FilePath:      filePath,
+		InstanceID:    instanceID,
+		LeaseDuration: testLeaseDuration,
+		RenewInterval: testRenewInterval,
+	}
+}
+
+func createLeaseHandler(t *testing.T, filePath string, instanceID string, clock *testClock) lease.LeaseHandlerForTests {
+	handler, err := lease.NewLeaseHandler(createMockArgsLeaseHandler(filePath, instanceID))
+	require.Nil(t, err)
+	handler.SetTimeHandler(clock.Now)
+	t.Cleanup(func() {
+		_ = handler.Close()
+	})
+
+	return handler
+}
+
+func TestNewLeaseHandler(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), "redundancy.lease")
+
+	t.Run("empty file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := lease.NewLeaseHandler(createMockArgsLeaseHandler("", "instance"))
+		assert.Equal(t, lease.ErrEmptyFilePath, err)
+		assert.True(t, check.IfNil(handler))
+	})
+	t.Run("empty instance ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := lease.NewLeaseHandler(createMockArgsLeaseHandler(filePath, ""))
+		assert.Equal(t, lease.ErrEmptyInstanceID, err)
+		assert.True(t, check.IfNil(handler))
+	})
+	t.Run("invalid renew interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLeaseHandler(filePath, "instance")
+		args.RenewInterval = time.Millisecond
+		handler, err := lease.NewLeaseHandler(args)
+		assert.ErrorIs(t, err, lease.ErrInvalidValue)
+		assert.True(t, check.IfNil(handler))
+	})
+	t.Run("lease duration too small should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLeaseHandler(filePath, "instance")
+		args.LeaseDuration = 2 * args.RenewInterval
+		handler, err := lease.NewLeaseHandler(args)
+		assert.ErrorIs(t, err, lease.ErrInvalidValue)
+		assert.True(t, check.IfNil(handler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := lease.NewLeaseHandler(createMockArgsLeaseHandler(filePath, "instance"))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(handler))
+		assert.True(t, handler.IsEnabled())
+		assert.Nil(t, handler.Close())
+	})
+}
+
+func TestLeaseHandler_Acquire(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should become holder only after the acquisition is confirmed", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "redundancy.lease")
+		clock := newTestClock()
+		handler := createLeaseHandler(t, filePath, "instance-a", clock)
+
+		// the constructor wrote the acquisition record
+		assert.False(t, handler.IsLeaseHolder())
+		assert.Equal(t, "instance-a", handler.State().Holder)
+		assert.Equal(t, uint64(1), handler.State().Term)
+
+		handler.UpdateLease()
+		assert.True(t, handler.IsLeaseHolder())
+		assert.True(t, handler.State().IsLeaseHolder)
+		assert.Equal(t, "instance-a", handler.State().InstanceID)
+	})
+	t.Run("second instance should not acquire a held lease", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "redundancy.lease")
+		clock := newTestClock()
+		handlerA := createLeaseHandler(t, filePath, "instance-a", clock)
+		handlerB := createLeaseHandler(t, filePath, "instance-b", clock)
+
+		handlerA.UpdateLease()
+		handlerB.UpdateLease()
+		assert.True(t, handlerA.IsLeaseHolder())
+		assert.False(t, handlerB.IsLeaseHolder())
+		assert.Equal(t, "instance-a", handlerB.State().Holder)
+	})
+	t.Run("overwritten acquisition should not become holder", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "redundancy.lease")
+		clock := newTestClock()
+		handler := createLeaseHandler(t, filePath, "instance-a", clock)
+
+		// another instance racing for the same term wrote its record last
+		expiresAt := clock.Now().Add(testLeaseDuration).UnixMilli()
+		content := fmt.Sprintf(`{"holder":"instance-b","term":1,"expiresAt":%d}`, expiresAt)
+		err := os.WriteFile(filePath, []byte(content), os.ModePerm)
+		require.Nil(t, err)
+
+		handler.UpdateLease()
+		assert.False(t, handler.IsLeaseHolder())
+		assert.Equal(t, "instance-b", handler.State().Holder)
+	})
+	t.Run("locked lease file should not acquire", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "redundancy.lease")
+		lockFile, err := os.OpenFile(filePath+".lock", os.O_CREATE|os.O_RDWR, os.ModePerm)
+		require.Nil(t, err)
+		defer func() {
+			_ = lockFile.Close()
+		}()
+		err = syscall.Flock(int(lockFile.Fd()), syscall.LOCK_EX)
+		require.Nil(t, err)
+
+		clock := newTestClock()
+		handler := createLeaseHandler(t, filePath, "instance-a", clock)
+		handler.UpdateLease()
+		assert.False(t, handler.IsLeaseHolder())
+		assert.Empty(t, handler.State().Holder)
+		assert.True(t, errors.Is(handler.StepDown(), lease.ErrNotLeaseHolder))
+
+		// another instance releasing the lock allows the acquisition
+		err = syscall.Flock(int(lockFile.Fd()), syscall.LOCK_UN)
+		require.Nil(t, err)
+		handler.UpdateLease()
+		handler.UpdateLease()
+		assert.True(t, handler.IsLeaseHolder())
+	})
+	t.Run("corrupted lease file should not acquire", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "redundancy.lease")
+		err := os.WriteFile(filePath, []byte("not a lease"), os.ModePerm)
+		require.Nil(t, err)
+
+		clock := newTestClock()
+		handler := createLeaseHandler(t, filePath, "instance-a", clock)
+		handler.UpdateLease()
+		assert.False(t, handler.IsLeaseHolder())
+	})
+}
+
+func TestLeaseHandler_Expiration(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), "redundancy.lease")
+	clock := newTestClock()
+	handlerA := createLeaseHandler(t, filePath, "instance-a", clock)
+	handlerA.UpdateLease()
+	require.True(t, handlerA.IsLeaseHolder())
+
+	handlerB := createLeaseHandler(t, filePath, "instance-b", clock)
+
+	// instance A stops renewing the lease
+	clock.Advance(testLeaseDuration - testRenewInterval)
+	assert.False(t, handlerA.IsLeaseHolder(), "the holder should stop signing before the lease expires")
+	handlerB.UpdateLease()
+	assert.False(t, handlerB.IsLeaseHolder())
+
+	clock.Advance(testRenewInterval)
+	handlerB.UpdateLease()
+	assert.False(t, handlerB.IsLeaseHolder())
+	handlerB.UpdateLease()
+	assert.True(t, handlerB.IsLeaseHolder())
+	assert.Equal(t, uint64(2), handlerB.State().Term)
+
+	// instance A comes back and finds out it lost the lease
+	handlerA.UpdateLease()
+	assert.False(t, handlerA.IsLeaseHolder())
+	assert.Equal(t, "instance-b", handlerA.State().Holder)
+}
+
+func TestLeaseHandler_Renew(t *testing.T) {
+	t.Parallel()
+
+	filePath := filepath.Join(t.TempDir(), "redundancy.lease")
+	clock := newTestClock()
+	handlerA := createLeaseHandler(t, filePath, "instance-a", clock)
+	handlerA.UpdateLease()
+	handlerB := createLeaseHandler(t, filePath, "instance-b", clock)
+
+	for i := 0; i < 10; i++ {
+		clock.Advance(testRenewInterval)
+		handlerA.UpdateLease()
+		handlerB.UpdateLease()
+		assert.True(t, handlerA.IsLeaseHolder())
+		assert.False(t, handlerB.IsLeaseHolder())
+	}
+	assert.Equal(t, uint64(1), handlerA.State().Term)
+}
+
+func TestLeaseHandler_StepDown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "redundancy.lease")
+		clock := newTestClock()
+		handlerA := createLeaseHandler(t, filePath, "instance-a", clock)
+		handlerA.UpdateLease()
+		handlerB := createLeaseHandler(t, filePath, "instance-b", clock)
+
+		err := handlerB.StepDown()
+		assert.Equal(t, lease.ErrNotLeaseHolder, err)
+		assert.True(t, handlerA.IsLeaseHolder())
+	})
+	t.Run("should hand over the lease", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "redundancy.lease")
+		clock := newTestClock()
+		handlerA := createLeaseHandler(t, filePath, "instance-a", clock)
+		handlerA.UpdateLease()
+		handlerB := createLeaseHandler(t, filePath, "instance-b", clock)
+
+		err := handlerA.StepDown()
+		assert.Nil(t, err)
+		assert.False(t, handlerA.IsLeaseHolder())
+
+		// the instance that stepped down does not acquire the released lease again
+		handlerA.UpdateLease()
+		assert.Empty(t, handlerA.State().Holder)
+
+		handlerB.UpdateLease()
+		handlerB.UpdateLease()
+		assert.True(t, handlerB.IsLeaseHolder())
+		assert.Equal(t, uint64(2), handlerB.State().Term)
+
+		handlerA.UpdateLease()
+		assert.False(t, handlerA.IsLeaseHolder())
+		assert.Equal(t, "instance-b", handlerA.State().Holder)
+	})
+	t.Run("close should release the lease", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "redundancy.lease")
+		clock := newTestClock()
+		handlerA := createLeaseHandler(t, filePath, "instance-a", clock)
+		handlerA.UpdateLease()
+		handlerB := createLeaseHandler(t, filePath, "instance-b", clock)
+
+		err := handlerA.Close()
+		assert.Nil(t, err)
+
+		handlerB.UpdateLease()
+		handlerB.UpdateLease()
+		assert.True(t, handlerB.IsLeaseHolder())
+	})
+}
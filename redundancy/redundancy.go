@@ -1,12 +1,14 @@
 package redundancy
 
 import (
+	"strconv"
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-crypto-go"
-	"github.com/multiversx/mx-chain-go/redundancy/common"
+	"github.com/multiversx/mx-chain-go/common"
+	redundancyCommon "github.com/multiversx/mx-chain-go/redundancy/common"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
@@ -26,13 +28,17 @@ type nodeRedundancy struct {
 	maxRoundsOfInactivity int
 	messenger             P2PMessenger
 	observerPrivateKey    crypto.PrivateKey
+	leaseHandler          common.RedundancyLeaseHandler
+	appStatusHandler      core.AppStatusHandler
 }
 
 // ArgNodeRedundancy represents the DTO structure used by the nodeRedundancy's constructor
 type ArgNodeRedundancy struct {
-	MaxRoundsOfInactivity int
-	Messenger             P2PMessenger
-	ObserverPrivateKey    crypto.PrivateKey
+	MaxRoundsOfInactivity  int
+	Messenger              P2PMessenger
+	ObserverPrivateKey     crypto.PrivateKey
+	RedundancyLeaseHandler common.RedundancyLeaseHandler
+	AppStatusHandler       core.AppStatusHandler
 }
 
 // NewNodeRedundancy creates a node redundancy object which implements NodeRedundancyHandler interface
@@ -43,28 +49,46 @@ func NewNodeRedundancy(arg ArgNodeRedundancy) (*nodeRedundancy, error) {
 	if check.IfNil(arg.ObserverPrivateKey) {
 		return nil, ErrNilObserverPrivateKey
 	}
-	err := common.CheckMaxRoundsOfInactivity(arg.MaxRoundsOfInactivity)
+	if check.IfNil(arg.RedundancyLeaseHandler) {
+		return nil, ErrNilRedundancyLeaseHandler
+	}
+	if check.IfNil(arg.AppStatusHandler) {
+		return nil, ErrNilAppStatusHandler
+	}
+	err := redundancyCommon.CheckMaxRoundsOfInactivity(arg.MaxRoundsOfInactivity)
 	if err != nil {
 		return nil, err
 	}
 
 	nr := &nodeRedundancy{
-		handler:               common.NewRedundancyHandler(),
+		handler:               redundancyCommon.NewRedundancyHandler(),
 		maxRoundsOfInactivity: arg.MaxRoundsOfInactivity,
 		messenger:             arg.Messenger,
 		observerPrivateKey:    arg.ObserverPrivateKey,
+		leaseHandler:          arg.RedundancyLeaseHandler,
+		appStatusHandler:      arg.AppStatusHandler,
 	}
 
 	return nr, nil
 }
 
-// IsRedundancyNode returns true if the current instance is used as a redundancy node
+// IsRedundancyNode returns true if the current instance is used as a redundancy node. All the instances coordinated
+// through a redundancy lease are redundancy nodes
 func (nr *nodeRedundancy) IsRedundancyNode() bool {
-	return !common.IsMainNode(nr.maxRoundsOfInactivity)
+	if nr.leaseHandler.IsEnabled() {
+		return true
+	}
+
+	return !redundancyCommon.IsMainNode(nr.maxRoundsOfInactivity)
 }
 
-// IsMainMachineActive returns true if the main or lower level redundancy machines are active
+// IsMainMachineActive returns true if the main or lower level redundancy machines are active. When the redundancy
+// lease is enabled, another instance is considered active as long as the current instance does not hold the lease
 func (nr *nodeRedundancy) IsMainMachineActive() bool {
+	if nr.leaseHandler.IsEnabled() {
+		return !nr.leaseHandler.IsLeaseHolder()
+	}
+
 	nr.mutNodeRedundancy.RLock()
 	defer nr.mutNodeRedundancy.RUnlock()
 
@@ -73,6 +97,11 @@ func (nr *nodeRedundancy) IsMainMachineActive() bool {
 
 // AdjustInactivityIfNeeded increments rounds of inactivity for main or lower level redundancy machines if needed
 func (nr *nodeRedundancy) AdjustInactivityIfNeeded(selfPubKey string, consensusPubKeys []string, roundIndex int64) {
+	if nr.leaseHandler.IsEnabled() {
+		nr.saveLeaseMetrics()
+		return
+	}
+
 	nr.mutNodeRedundancy.Lock()
 	defer nr.mutNodeRedundancy.Unlock()
 
@@ -102,6 +131,9 @@ func (nr *nodeRedundancy) AdjustInactivityIfNeeded(selfPubKey string, consensusP
 
 // ResetInactivityIfNeeded resets rounds of inactivity for main or lower level redundancy machines if needed
 func (nr *nodeRedundancy) ResetInactivityIfNeeded(selfPubKey string, consensusMsgPubKey string, consensusMsgPeerID core.PeerID) {
+	if nr.leaseHandler.IsEnabled() {
+		return
+	}
 	if selfPubKey != consensusMsgPubKey {
 		return
 	}
@@ -114,6 +146,14 @@ func (nr *nodeRedundancy) ResetInactivityIfNeeded(selfPubKey string, consensusMs
 	nr.mutNodeRedundancy.Unlock()
 }
 
+func (nr *nodeRedundancy) saveLeaseMetrics() {
+	state := nr.leaseHandler.State()
+	nr.appStatusHandler.SetStringValue(common.MetricRedundancyLeaseInstanceID, state.InstanceID)
+	nr.appStatusHandler.SetStringValue(common.MetricRedundancyLeaseHolder, state.Holder)
+	nr.appStatusHandler.SetUInt64Value(common.MetricRedundancyLeaseTerm, state.Term)
+	nr.appStatusHandler.SetStringValue(common.MetricRedundancyLeaseIsHolder, strconv.FormatBool(state.IsLeaseHolder))
+}
+
 // ObserverPrivateKey returns the stored private key by this instance. This key will be used whenever a new key,
 // different from the main key is required. Example: sending anonymous heartbeat messages while the node is in backup mode.
 func (nr *nodeRedundancy) ObserverPrivateKey() crypto.PrivateKey {
@@ -4,20 +4,47 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/redundancy"
 	"github.com/multiversx/mx-chain-go/redundancy/mock"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 )
 
 func createMockArguments(maxRoundsOfInactivity int) redundancy.ArgNodeRedundancy {
 	return redundancy.ArgNodeRedundancy{
-		MaxRoundsOfInactivity: maxRoundsOfInactivity,
-		Messenger:             &p2pmocks.MessengerStub{},
-		ObserverPrivateKey:    &mock.PrivateKeyStub{},
+		MaxRoundsOfInactivity:  maxRoundsOfInactivity,
+		Messenger:              &p2pmocks.MessengerStub{},
+		ObserverPrivateKey:     &mock.PrivateKeyStub{},
+		RedundancyLeaseHandler: &testscommon.RedundancyLeaseHandlerStub{},
+		AppStatusHandler:       &statusHandler.AppStatusHandlerStub{},
 	}
 }
 
+func createMockArgumentsWithLease(isLeaseHolder *bool) redundancy.ArgNodeRedundancy {
+	arg := createMockArguments(0)
+	arg.RedundancyLeaseHandler = &testscommon.RedundancyLeaseHandlerStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+		IsLeaseHolderCalled: func() bool {
+			return *isLeaseHolder
+		},
+		StateCalled: func() common.RedundancyLeaseState {
+			return common.RedundancyLeaseState{
+				InstanceID:    "instance-a",
+				Holder:        "instance-b",
+				Term:          3,
+				IsLeaseHolder: *isLeaseHolder,
+			}
+		},
+	}
+
+	return arg
+}
+
 func TestNewNodeRedundancy_ShouldErrNilMessenger(t *testing.T) {
 	t.Parallel()
 
@@ -40,6 +67,28 @@ func TestNewNodeRedundancy_ShouldErrNilObserverPrivateKey(t *testing.T) {
 	assert.Equal(t, redundancy.ErrNilObserverPrivateKey, err)
 }
 
+func TestNewNodeRedundancy_ShouldErrNilRedundancyLeaseHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments(0)
+	arg.RedundancyLeaseHandler = nil
+	nr, err := redundancy.NewNodeRedundancy(arg)
+
+	assert.Nil(t, nr)
+	assert.Equal(t, redundancy.ErrNilRedundancyLeaseHandler, err)
+}
+
+func TestNewNodeRedundancy_ShouldErrNilAppStatusHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments(0)
+	arg.AppStatusHandler = nil
+	nr, err := redundancy.NewNodeRedundancy(arg)
+
+	assert.Nil(t, nr)
+	assert.Equal(t, redundancy.ErrNilAppStatusHandler, err)
+}
+
 func TestNewNodeRedundancy_ShouldErrIfMaxRoundsOfInactivityIsInvalid(t *testing.T) {
 	t.Parallel()
 
@@ -101,6 +150,11 @@ func TestIsRedundancyNode_ShouldWork(t *testing.T) {
 	arg = createMockArguments(2)
 	nr, _ = redundancy.NewNodeRedundancy(arg)
 	assert.True(t, nr.IsRedundancyNode())
+
+	isLeaseHolder := true
+	arg = createMockArgumentsWithLease(&isLeaseHolder)
+	nr, _ = redundancy.NewNodeRedundancy(arg)
+	assert.True(t, nr.IsRedundancyNode())
 }
 
 func TestIsMainMachineActive_ShouldWork(t *testing.T) {
@@ -144,6 +198,17 @@ func TestIsMainMachineActive_ShouldWork(t *testing.T) {
 		nr.SetRoundsOfInactivity(0)
 		assert.True(t, nr.IsMainMachineActive())
 	})
+	t.Run("redundancy lease enabled", func(t *testing.T) {
+		t.Parallel()
+
+		isLeaseHolder := false
+		arg := createMockArgumentsWithLease(&isLeaseHolder)
+		nr, _ := redundancy.NewNodeRedundancy(arg)
+		assert.True(t, nr.IsMainMachineActive())
+
+		isLeaseHolder = true
+		assert.False(t, nr.IsMainMachineActive())
+	})
 }
 
 func TestAdjustInactivityIfNeeded_ShouldReturnWhenGivenRoundIndexWasAlreadyChecked(t *testing.T) {
@@ -268,3 +333,48 @@ func TestNodeRedundancy_ObserverPrivateKey(t *testing.T) {
 
 	assert.True(t, nr.ObserverPrivateKey() == arg.ObserverPrivateKey) //pointer testing
 }
+
+func TestNodeRedundancy_RedundancyLeaseEnabled(t *testing.T) {
+	t.Parallel()
+
+	t.Run("adjust inactivity should only save the lease metrics", func(t *testing.T) {
+		t.Parallel()
+
+		isLeaseHolder := true
+		arg := createMockArgumentsWithLease(&isLeaseHolder)
+		savedMetrics := make(map[string]interface{})
+		arg.AppStatusHandler = &statusHandler.AppStatusHandlerStub{
+			SetStringValueHandler: func(key string, value string) {
+				savedMetrics[key] = value
+			},
+			SetUInt64ValueHandler: func(key string, value uint64) {
+				savedMetrics[key] = value
+			},
+		}
+		nr, _ := redundancy.NewNodeRedundancy(arg)
+
+		selfPubKey := "1"
+		nr.AdjustInactivityIfNeeded(selfPubKey, []string{selfPubKey}, 1)
+		assert.Equal(t, 0, nr.GetRoundsOfInactivity())
+
+		expectedMetrics := map[string]interface{}{
+			common.MetricRedundancyLeaseInstanceID: "instance-a",
+			common.MetricRedundancyLeaseHolder:     "instance-b",
+			common.MetricRedundancyLeaseTerm:       uint64(3),
+			common.MetricRedundancyLeaseIsHolder:   "true",
+		}
+		assert.Equal(t, expectedMetrics, savedMetrics)
+	})
+	t.Run("reset inactivity should not change the rounds of inactivity", func(t *testing.T) {
+		t.Parallel()
+
+		isLeaseHolder := false
+		arg := createMockArgumentsWithLease(&isLeaseHolder)
+		nr, _ := redundancy.NewNodeRedundancy(arg)
+
+		selfPubKey := "1"
+		nr.SetRoundsOfInactivity(3)
+		nr.ResetInactivityIfNeeded(selfPubKey, selfPubKey, "another pid")
+		assert.Equal(t, 3, nr.GetRoundsOfInactivity())
+	})
+}
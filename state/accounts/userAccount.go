@@ -179,6 +179,21 @@ func (a *userAccount) GetAllLeaves(
 	return dt.GetAllLeavesOnChannel(leavesChannels, ctx, rootHash, keyBuilder.NewKeyBuilder(), a.dataTrieLeafParser)
 }
 
+// GetLeavesPage returns a page of the account's data trie leaves, starting with the leaf having the given trie key
+func (a *userAccount) GetLeavesPage(ctx context.Context, startKey []byte, maxLeaves int, keyPrefix []byte) (*common.TrieLeavesPage, error) {
+	dt := a.dataTrieInteractor.DataTrie()
+	if check.IfNil(dt) {
+		return nil, errors.ErrNilTrie
+	}
+
+	rootHash, err := dt.RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	return dt.GetLeavesPage(ctx, rootHash, startKey, maxLeaves, keyPrefix, a.dataTrieLeafParser)
+}
+
 // IsDataTrieMigrated returns true if the data trie is migrated to the latest version
 func (a *userAccount) IsDataTrieMigrated() (bool, error) {
 	dt := a.dataTrieInteractor.DataTrie()
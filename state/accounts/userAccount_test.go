@@ -274,6 +274,70 @@ func TestUserAccount_GetAllLeaves(t *testing.T) {
 	})
 }
 
+func TestUserAccount_GetLeavesPage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil data trie should err", func(t *testing.T) {
+		t.Parallel()
+
+		acc, _ := accounts.NewUserAccount([]byte("address"), &testTrie.DataTrieTrackerStub{}, &testTrie.TrieLeafParserStub{})
+
+		page, err := acc.GetLeavesPage(context.Background(), nil, 10, nil)
+		assert.Nil(t, page)
+		assert.Equal(t, errors.ErrNilTrie, err)
+	})
+	t.Run("can not retrieve root hash should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := fmt.Errorf("root error")
+		dtt := &testTrie.DataTrieTrackerStub{
+			DataTrieCalled: func() common.Trie {
+				return &testTrie.TrieStub{
+					RootCalled: func() ([]byte, error) {
+						return nil, expectedErr
+					},
+				}
+			},
+		}
+		acc, _ := accounts.NewUserAccount([]byte("address"), dtt, &testTrie.TrieLeafParserStub{})
+
+		page, err := acc.GetLeavesPage(context.Background(), nil, 10, nil)
+		assert.Nil(t, page)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should call GetLeavesPage from trie", func(t *testing.T) {
+		t.Parallel()
+
+		rootHash := []byte("root hash")
+		expectedPage := &common.TrieLeavesPage{
+			NextKey: []byte("next key"),
+		}
+		tlp := &testTrie.TrieLeafParserStub{}
+		dtt := &testTrie.DataTrieTrackerStub{
+			DataTrieCalled: func() common.Trie {
+				return &testTrie.TrieStub{
+					RootCalled: func() ([]byte, error) {
+						return rootHash, nil
+					},
+					GetLeavesPageCalled: func(_ context.Context, providedRootHash []byte, startKey []byte, maxLeaves int, keyPrefix []byte, trieLeafParser common.TrieLeafParser) (*common.TrieLeavesPage, error) {
+						assert.Equal(t, rootHash, providedRootHash)
+						assert.Equal(t, []byte("start key"), startKey)
+						assert.Equal(t, 10, maxLeaves)
+						assert.Equal(t, []byte("prefix"), keyPrefix)
+						assert.Equal(t, tlp, trieLeafParser)
+						return expectedPage, nil
+					},
+				}
+			},
+		}
+		acc, _ := accounts.NewUserAccount([]byte("address"), dtt, tlp)
+
+		page, err := acc.GetLeavesPage(context.Background(), []byte("start key"), 10, []byte("prefix"))
+		assert.Nil(t, err)
+		assert.Equal(t, expectedPage, page)
+	})
+}
+
 func TestUserAccount_IsDataTrieMigrated(t *testing.T) {
 	t.Parallel()
 
@@ -7,7 +7,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"runtime/debug"
-	"strings"
+	"sort"
 	"sync"
 	"time"
 
@@ -80,8 +80,10 @@ type AccountsDB struct {
 	obsoleteDataTrieHashes map[string][][]byte
 	snapshotsManger        SnapshotsManager
 
+	numDataTriesCommitWorkers int
+
 	lastRootHash []byte
-	dataTries    common.TriesHolder
+	dataTries    *dataTriesHolder
 	entries      []JournalEntry
 
 	mutOp                sync.RWMutex
@@ -102,6 +104,9 @@ type ArgsAccountsDB struct {
 	StoragePruningManager StoragePruningManager
 	AddressConverter      core.PubkeyConverter
 	SnapshotsManager      SnapshotsManager
+	// NumDataTriesCommitWorkers is the maximum number of data tries hashed and committed concurrently. If lower than 2,
+	// the data tries are committed one after another
+	NumDataTriesCommitWorkers uint32
 }
 
 // NewAccountsDB creates a new account manager
@@ -128,8 +133,9 @@ func createAccountsDb(args ArgsAccountsDB) *AccountsDB {
 		loadCodeMeasurements: &loadingMeasurements{
 			identifier: "load code",
 		},
-		addressConverter: args.AddressConverter,
-		snapshotsManger:  args.SnapshotsManager,
+		addressConverter:          args.AddressConverter,
+		snapshotsManger:           args.SnapshotsManager,
+		numDataTriesCommitWorkers: int(args.NumDataTriesCommitWorkers),
 	}
 }
 
@@ -823,19 +829,16 @@ func (adb *AccountsDB) commit() ([]byte, error) {
 	oldHashes := make(common.ModifiedHashes)
 	newHashes := make(common.ModifiedHashes)
 	// Step 1. commit all data tries
-	dataTries := adb.dataTries.GetAll()
-	for i := 0; i < len(dataTries); i++ {
-		err := adb.commitTrie(dataTries[i], oldHashes, newHashes)
-		if err != nil {
-			return nil, err
-		}
+	err := adb.commitDataTries(adb.dataTries.GetAllTries(), oldHashes, newHashes)
+	if err != nil {
+		return nil, err
 	}
 	adb.dataTries.Reset()
 
 	oldRoot := adb.mainTrie.GetOldRoot()
 
 	// Step 2. commit main trie
-	err := adb.commitTrie(adb.mainTrie, oldHashes, newHashes)
+	err = adb.commitTrie(adb.mainTrie, oldHashes, newHashes)
 	if err != nil {
 		return nil, err
 	}
@@ -880,6 +883,73 @@ func (adb *AccountsDB) markForEviction(
 	return adb.storagePruningManager.MarkForEviction(oldRoot, newRoot, oldHashes, newHashes)
 }
 
+// commitDataTries commits the dirty data tries using a bounded number of workers. The data tries are independent, the
+// root hashes being already saved in the accounts, so only the hashing and the writes in the storage overlap. The
+// hashes of each trie are collected separately and merged afterwards in the sorted order of the addresses, so the
+// eviction waiting list receives the same hashes and the same error is returned no matter the order in which the
+// tries finished
+func (adb *AccountsDB) commitDataTries(dataTries map[string]common.Trie, oldHashes common.ModifiedHashes, newHashes common.ModifiedHashes) error {
+	addresses := make([]string, 0, len(dataTries))
+	for address := range dataTries {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	numWorkers := adb.numDataTriesCommitWorkers
+	if numWorkers > len(addresses) {
+		numWorkers = len(addresses)
+	}
+	if numWorkers < 2 {
+		for _, address := range addresses {
+			err := adb.commitTrie(dataTries[address], oldHashes, newHashes)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	oldHashesPerTrie := make([]common.ModifiedHashes, len(addresses))
+	newHashesPerTrie := make([]common.ModifiedHashes, len(addresses))
+	errs := make([]error, len(addresses))
+	indexes := make(chan int, len(addresses))
+	for i := range addresses {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg := sync.WaitGroup{}
+	wg.Add(numWorkers)
+	for w := 0; w < numWorkers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				oldHashesPerTrie[i] = make(common.ModifiedHashes)
+				newHashesPerTrie[i] = make(common.ModifiedHashes)
+				errs[i] = adb.commitTrie(dataTries[addresses[i]], oldHashesPerTrie[i], newHashesPerTrie[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := range addresses {
+		if errs[i] != nil {
+			return errs[i]
+		}
+
+		for hash := range oldHashesPerTrie[i] {
+			oldHashes[hash] = struct{}{}
+		}
+		for hash := range newHashesPerTrie[i] {
+			newHashes[hash] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
 func (adb *AccountsDB) commitTrie(tr common.Trie, oldHashes common.ModifiedHashes, newHashes common.ModifiedHashes) error {
 	if adb.mainTrie.GetStorageManager().IsPruningEnabled() {
 		oldTrieHashes := tr.GetObsoleteHashes()
@@ -1115,64 +1185,163 @@ func (adb *AccountsDB) GetAllLeaves(leavesChannels *common.TrieIteratorChannels,
 	return adb.getMainTrie().GetAllLeavesOnChannel(leavesChannels, ctx, rootHash, keyBuilder.NewKeyBuilder(), trieLeafParser)
 }
 
-// Close will handle the closing of the underlying components
-func (adb *AccountsDB) Close() error {
-	adb.mutOp.Lock()
-	defer adb.mutOp.Unlock()
-
-	_ = adb.mainTrie.Close()
-	return adb.storagePruningManager.Close()
-}
-
-// GetStatsForRootHash will get trie statistics for the given rootHash
-func (adb *AccountsDB) GetStatsForRootHash(rootHash []byte) (common.TriesStatisticsCollector, error) {
-	stats := statistics.NewTrieStatisticsCollector()
-	mainTrie := adb.getMainTrie()
-
-	tr, ok := mainTrie.(common.TrieStats)
-	if !ok {
-		return nil, fmt.Errorf("invalid trie, type is %T", mainTrie)
+// GetAccountsDiff returns the accounts which were added, removed or modified between the two provided root hashes.
+// The data trie changes are computed only for the accounts having different data trie root hashes. The leaves diff is
+// produced in the increasing order of the addresses, so the accounts before the start address are skipped without
+// computing their data trie changes, and the iteration stops once the maximum number of accounts is reached
+func (adb *AccountsDB) GetAccountsDiff(
+	ctx context.Context,
+	oldRootHash common.RootHashHolder,
+	newRootHash common.RootHashHolder,
+	dataTrieLeafParserCreator DataTrieLeafParserCreator,
+	options AccountsDiffOptions,
+) (*AccountsDiff, error) {
+	if check.IfNil(oldRootHash) || check.IfNil(newRootHash) {
+		return nil, ErrNilRootHashHolder
+	}
+	if dataTrieLeafParserCreator == nil && !options.SkipDataTrieChanges {
+		return nil, ErrNilDataTrieLeafParserCreator
 	}
 
-	collectStats(tr, stats, rootHash, "", common.MainTrie)
-
-	iteratorChannels := &common.TrieIteratorChannels{
-		LeavesChan: make(chan core.KeyValueHolder, leavesChannelSize),
-		ErrChan:    errChan.NewErrChanWrapper(),
+	mainTrie := adb.getMainTrie()
+	oldTrie, err := mainTrie.RecreateFromEpoch(oldRootHash)
+	if err != nil {
+		return nil, err
 	}
-	err := mainTrie.GetAllLeavesOnChannel(
-		iteratorChannels,
-		context.Background(),
-		rootHash,
-		keyBuilder.NewKeyBuilder(),
-		parsers.NewMainTrieLeafParser(),
-	)
+	newTrie, err := mainTrie.RecreateFromEpoch(newRootHash)
 	if err != nil {
 		return nil, err
 	}
 
-	for leaf := range iteratorChannels.LeavesChan {
-		userAccount, skipAccount, err := getUserAccountFromBytes(adb.accountFactory, adb.marshaller, leaf.Key(), leaf.Value())
-		if err != nil {
-			return nil, err
+	accountsDiff := &AccountsDiff{
+		Added:    make([]*AccountDiff, 0),
+		Removed:  make([]*AccountDiff, 0),
+		Modified: make([]*AccountDiff, 0),
+	}
+	numAccounts := 0
+	err = oldTrie.GetLeavesDiff(ctx, newTrie, parsers.NewMainTrieLeafParser(), func(leafDiff *common.TrieLeafDiff) error {
+		if bytes.Compare(leafDiff.Key, options.FromAddress) < 0 {
+			return nil
 		}
-		if skipAccount {
-			continue
+		if options.MaxNumAccounts > 0 && numAccounts == options.MaxNumAccounts {
+			accountsDiff.NextAddress = leafDiff.Key
+			return errMaxNumAccountsReached
 		}
 
-		if common.IsEmptyTrie(userAccount.GetRootHash()) {
-			continue
+		accountDiff, skipAccount, errCreate := adb.createAccountDiff(ctx, leafDiff, oldRootHash, newRootHash, dataTrieLeafParserCreator, options)
+		if errCreate != nil {
+			return errCreate
+		}
+		if skipAccount {
+			return nil
 		}
 
-		accountAddress, err := adb.addressConverter.Encode(userAccount.AddressBytes())
-		if err != nil {
-			return nil, err
+		switch {
+		case check.IfNil(accountDiff.OldAccount):
+			accountsDiff.Added = append(accountsDiff.Added, accountDiff)
+		case check.IfNil(accountDiff.NewAccount):
+			accountsDiff.Removed = append(accountsDiff.Removed, accountDiff)
+		default:
+			accountsDiff.Modified = append(accountsDiff.Modified, accountDiff)
 		}
+		numAccounts++
+
+		return nil
+	})
+	if err != nil && err != errMaxNumAccountsReached {
+		return nil, err
+	}
+
+	return accountsDiff, nil
+}
+
+func (adb *AccountsDB) createAccountDiff(
+	ctx context.Context,
+	leafDiff *common.TrieLeafDiff,
+	oldRootHash common.RootHashHolder,
+	newRootHash common.RootHashHolder,
+	dataTrieLeafParserCreator DataTrieLeafParserCreator,
+	options AccountsDiffOptions,
+) (*AccountDiff, bool, error) {
+	oldAccount, skipAccount, err := adb.getUserAccountFromLeafValue(leafDiff.Key, leafDiff.OldValue)
+	if err != nil || skipAccount {
+		return nil, skipAccount, err
+	}
+	newAccount, skipAccount, err := adb.getUserAccountFromLeafValue(leafDiff.Key, leafDiff.NewValue)
+	if err != nil || skipAccount {
+		return nil, skipAccount, err
+	}
+
+	accountDiff := &AccountDiff{
+		Address:         leafDiff.Key,
+		OldAccount:      oldAccount,
+		NewAccount:      newAccount,
+		DataTrieChanges: make([]*common.TrieLeafDiff, 0),
+	}
+
+	oldDataTrieRootHash := getDataTrieRootHash(oldAccount)
+	newDataTrieRootHash := getDataTrieRootHash(newAccount)
+	if options.SkipDataTrieChanges || bytes.Equal(oldDataTrieRootHash, newDataTrieRootHash) {
+		return accountDiff, false, nil
+	}
 
-		collectStats(tr, stats, userAccount.GetRootHash(), accountAddress, common.DataTrie)
+	dataTrieLeafParser, err := dataTrieLeafParserCreator(leafDiff.Key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	mainTrie := adb.getMainTrie()
+	oldDataTrie, err := mainTrie.RecreateFromEpoch(holders.NewRootHashHolder(oldDataTrieRootHash, oldRootHash.GetEpoch()))
+	if err != nil {
+		return nil, false, err
+	}
+	newDataTrie, err := mainTrie.RecreateFromEpoch(holders.NewRootHashHolder(newDataTrieRootHash, newRootHash.GetEpoch()))
+	if err != nil {
+		return nil, false, err
 	}
 
-	err = iteratorChannels.ErrChan.ReadFromChanNonBlocking()
+	err = oldDataTrie.GetLeavesDiff(ctx, newDataTrie, dataTrieLeafParser, func(dataTrieLeafDiff *common.TrieLeafDiff) error {
+		accountDiff.DataTrieChanges = append(accountDiff.DataTrieChanges, dataTrieLeafDiff)
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return accountDiff, false, nil
+}
+
+func (adb *AccountsDB) getUserAccountFromLeafValue(address []byte, value []byte) (UserAccountHandler, bool, error) {
+	if len(value) == 0 {
+		return nil, false, nil
+	}
+
+	return getUserAccountFromBytes(adb.accountFactory, adb.marshaller, address, value)
+}
+
+func getDataTrieRootHash(account UserAccountHandler) []byte {
+	if check.IfNil(account) {
+		return nil
+	}
+
+	return account.GetRootHash()
+}
+
+// Close will handle the closing of the underlying components
+func (adb *AccountsDB) Close() error {
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	_ = adb.snapshotsManger.Close()
+	_ = adb.mainTrie.Close()
+	return adb.storagePruningManager.Close()
+}
+
+// GetStatsForRootHash will get trie statistics for the given rootHash. The tries whose statistics can not be computed
+// are logged and skipped
+func (adb *AccountsDB) GetStatsForRootHash(rootHash []byte) (common.TriesStatisticsCollector, error) {
+	stats := statistics.NewTrieStatisticsCollector()
+	err := CollectTriesStatistics(context.Background(), adb.createArgsCollectTriesStatistics(rootHash, stats, true))
 	if err != nil {
 		return nil, err
 	}
@@ -1180,21 +1349,26 @@ func (adb *AccountsDB) GetStatsForRootHash(rootHash []byte) (common.TriesStatist
 	return stats, nil
 }
 
-func collectStats(
-	tr common.TrieStats,
-	stats common.TriesStatisticsCollector,
+// CollectTriesStatistics adds in the provided collector the statistics of the main trie with the given root hash and
+// of all its data tries
+func (adb *AccountsDB) CollectTriesStatistics(ctx context.Context, rootHash []byte, collector common.TriesStatisticsCollector) error {
+	return CollectTriesStatistics(ctx, adb.createArgsCollectTriesStatistics(rootHash, collector, false))
+}
+
+func (adb *AccountsDB) createArgsCollectTriesStatistics(
 	rootHash []byte,
-	address string,
-	trieType common.TrieType,
-) {
-	trieStats, err := tr.GetTrieStats(address, rootHash)
-	if err != nil {
-		log.Error(err.Error())
-		return
+	collector common.TriesStatisticsCollector,
+	continueOnTrieErrors bool,
+) ArgsCollectTriesStatistics {
+	return ArgsCollectTriesStatistics{
+		MainTrie:             adb.getMainTrie(),
+		RootHash:             rootHash,
+		AccountFactory:       adb.accountFactory,
+		Marshaller:           adb.marshaller,
+		AddressConverter:     adb.addressConverter,
+		Collector:            collector,
+		ContinueOnTrieErrors: continueOnTrieErrors,
 	}
-	stats.Add(trieStats, trieType)
-
-	log.Debug(strings.Join(trieStats.ToString(), " "))
 }
 
 // IsSnapshotInProgress returns true if there is a snapshot in progress
@@ -232,6 +232,32 @@ func (accountsDB *accountsDBApi) GetTrie(rootHash []byte) (common.Trie, error) {
 	return accountsDB.innerAccountsAdapter.GetTrie(rootHash)
 }
 
+// GetAccountsDiff will call the inner accountsAdapter method after trying to recreate the trie
+func (accountsDB *accountsDBApi) GetAccountsDiff(
+	ctx context.Context,
+	oldRootHash common.RootHashHolder,
+	newRootHash common.RootHashHolder,
+	dataTrieLeafParserCreator DataTrieLeafParserCreator,
+	options AccountsDiffOptions,
+) (*AccountsDiff, error) {
+	_, err := accountsDB.recreateTrieIfNecessary()
+	if err != nil {
+		return nil, err
+	}
+
+	return accountsDB.innerAccountsAdapter.GetAccountsDiff(ctx, oldRootHash, newRootHash, dataTrieLeafParserCreator, options)
+}
+
+// CollectTriesStatistics will call the inner accountsAdapter method after trying to recreate the trie
+func (accountsDB *accountsDBApi) CollectTriesStatistics(ctx context.Context, rootHash []byte, collector common.TriesStatisticsCollector) error {
+	_, err := accountsDB.recreateTrieIfNecessary()
+	if err != nil {
+		return err
+	}
+
+	return accountsDB.innerAccountsAdapter.CollectTriesStatistics(ctx, rootHash, collector)
+}
+
 // GetStackDebugFirstEntry will call the inner accountsAdapter method
 func (accountsDB *accountsDBApi) GetStackDebugFirstEntry() []byte {
 	return accountsDB.innerAccountsAdapter.GetStackDebugFirstEntry()
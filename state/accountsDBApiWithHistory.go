@@ -135,6 +135,30 @@ func (accountsDB *accountsDBApiWithHistory) GetTrie(_ []byte) (common.Trie, erro
 	return nil, ErrFunctionalityNotImplemented
 }
 
+// GetAccountsDiff will call the inner accountsAdapter method. The provided root hashes are recreated in separate tries,
+// so the state of the inner accountsAdapter is not altered
+func (accountsDB *accountsDBApiWithHistory) GetAccountsDiff(
+	ctx context.Context,
+	oldRootHash common.RootHashHolder,
+	newRootHash common.RootHashHolder,
+	dataTrieLeafParserCreator DataTrieLeafParserCreator,
+	options AccountsDiffOptions,
+) (*AccountsDiff, error) {
+	accountsDB.mutRecreateAndGet.RLock()
+	defer accountsDB.mutRecreateAndGet.RUnlock()
+
+	return accountsDB.innerAccountsAdapter.GetAccountsDiff(ctx, oldRootHash, newRootHash, dataTrieLeafParserCreator, options)
+}
+
+// CollectTriesStatistics will call the inner accountsAdapter method. The statistics are collected on tries recreated
+// from the provided root hash, so the state of the inner accountsAdapter is not altered
+func (accountsDB *accountsDBApiWithHistory) CollectTriesStatistics(ctx context.Context, rootHash []byte, collector common.TriesStatisticsCollector) error {
+	accountsDB.mutRecreateAndGet.RLock()
+	defer accountsDB.mutRecreateAndGet.RUnlock()
+
+	return accountsDB.innerAccountsAdapter.CollectTriesStatistics(ctx, rootHash, collector)
+}
+
 // GetStackDebugFirstEntry returns nil
 func (accountsDB *accountsDBApiWithHistory) GetStackDebugFirstEntry() []byte {
 	return nil
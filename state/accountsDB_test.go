@@ -6,6 +6,7 @@ import (
 	"crypto/rand"
 	"errors"
 	"fmt"
+	"math/big"
 	mathRand "math/rand"
 	"strings"
 	"sync"
@@ -41,6 +42,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
 	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
 	"github.com/multiversx/mx-chain-go/trie"
+	trieStatistics "github.com/multiversx/mx-chain-go/trie/statistics"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-common-go/dataTrieMigrator"
 	"github.com/stretchr/testify/assert"
@@ -65,6 +67,7 @@ func createMockAccountsDBArgs() state.ArgsAccountsDB {
 		AccountFactory:       accCreator,
 		ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
 		LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
+		SnapshotFilesWriter:  &stateMock.SnapshotFilesWriterStub{},
 		StateStatsHandler:    statistics.NewStateStatistics(),
 	})
 
@@ -157,6 +160,7 @@ func getDefaultStateComponents(
 		AccountFactory:       accCreator,
 		ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
 		LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
+		SnapshotFilesWriter:  &stateMock.SnapshotFilesWriterStub{},
 		StateStatsHandler:    statistics.NewStateStatistics(),
 	})
 
@@ -2210,6 +2214,178 @@ func TestAccountsDB_GetTrie(t *testing.T) {
 	}
 }
 
+func TestAccountsDB_GetAccountsDiff(t *testing.T) {
+	t.Parallel()
+
+	enableEpochsHandler := &enableEpochsHandlerMock.EnableEpochsHandlerStub{}
+	dataTrieLeafParserCreator := func(address []byte) (common.TrieLeafParser, error) {
+		return parsers.NewDataTrieLeafParser(address, &marshallerMock.MarshalizerMock{}, enableEpochsHandler)
+	}
+
+	t.Run("nil root hash holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, adb := getDefaultTrieAndAccountsDb()
+		rootHashHolder := holders.NewRootHashHolderAsEmpty()
+
+		accountsDiff, err := adb.GetAccountsDiff(context.Background(), nil, rootHashHolder, dataTrieLeafParserCreator, state.AccountsDiffOptions{})
+		assert.Nil(t, accountsDiff)
+		assert.Equal(t, state.ErrNilRootHashHolder, err)
+
+		accountsDiff, err = adb.GetAccountsDiff(context.Background(), rootHashHolder, nil, dataTrieLeafParserCreator, state.AccountsDiffOptions{})
+		assert.Nil(t, accountsDiff)
+		assert.Equal(t, state.ErrNilRootHashHolder, err)
+	})
+	t.Run("nil data trie leaf parser creator should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, adb := getDefaultTrieAndAccountsDb()
+		rootHashHolder := holders.NewRootHashHolderAsEmpty()
+
+		accountsDiff, err := adb.GetAccountsDiff(context.Background(), rootHashHolder, rootHashHolder, nil, state.AccountsDiffOptions{})
+		assert.Nil(t, accountsDiff)
+		assert.Equal(t, state.ErrNilDataTrieLeafParserCreator, err)
+	})
+	t.Run("recreate trie error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockAccountsDBArgs()
+		args.Trie = &trieMock.TrieStub{
+			RecreateFromEpochCalled: func(options common.RootHashHolder) (common.Trie, error) {
+				return nil, expectedErr
+			},
+		}
+		adb, _ := state.NewAccountsDB(args)
+		rootHashHolder := holders.NewRootHashHolder([]byte("root hash"), core.OptionalUint32{})
+
+		accountsDiff, err := adb.GetAccountsDiff(context.Background(), rootHashHolder, rootHashHolder, dataTrieLeafParserCreator, state.AccountsDiffOptions{})
+		assert.Nil(t, accountsDiff)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should return the added, removed and modified accounts", func(t *testing.T) {
+		t.Parallel()
+
+		_, adb := getDefaultTrieAndAccountsDb()
+		modifiedAddress := []byte("modified address")
+		removedAddress := []byte("removed address")
+		addedAddress := []byte("added address")
+
+		acc, _ := adb.LoadAccount(modifiedAddress)
+		userAcc := acc.(state.UserAccountHandler)
+		_ = userAcc.AddToBalance(big.NewInt(10))
+		_ = userAcc.SaveKeyValue([]byte("key1"), []byte("value1"))
+		_ = userAcc.SaveKeyValue([]byte("key2"), []byte("value2"))
+		_ = adb.SaveAccount(userAcc)
+		acc, _ = adb.LoadAccount(removedAddress)
+		_ = adb.SaveAccount(acc)
+		oldRootHash, _ := adb.Commit()
+
+		acc, _ = adb.LoadAccount(modifiedAddress)
+		userAcc = acc.(state.UserAccountHandler)
+		_ = userAcc.AddToBalance(big.NewInt(5))
+		_ = userAcc.SaveKeyValue([]byte("key1"), []byte("new value1"))
+		_ = userAcc.SaveKeyValue([]byte("key3"), []byte("value3"))
+		_ = adb.SaveAccount(userAcc)
+		_ = adb.RemoveAccount(removedAddress)
+		acc, _ = adb.LoadAccount(addedAddress)
+		_ = adb.SaveAccount(acc)
+		newRootHash, _ := adb.Commit()
+
+		accountsDiff, err := adb.GetAccountsDiff(
+			context.Background(),
+			holders.NewRootHashHolder(oldRootHash, core.OptionalUint32{}),
+			holders.NewRootHashHolder(newRootHash, core.OptionalUint32{}),
+			dataTrieLeafParserCreator,
+			state.AccountsDiffOptions{},
+		)
+		require.Nil(t, err)
+
+		require.Equal(t, 1, len(accountsDiff.Added))
+		assert.Equal(t, addedAddress, accountsDiff.Added[0].Address)
+		assert.Nil(t, accountsDiff.Added[0].OldAccount)
+		assert.Equal(t, addedAddress, accountsDiff.Added[0].NewAccount.AddressBytes())
+
+		require.Equal(t, 1, len(accountsDiff.Removed))
+		assert.Equal(t, removedAddress, accountsDiff.Removed[0].Address)
+		assert.Equal(t, removedAddress, accountsDiff.Removed[0].OldAccount.AddressBytes())
+		assert.Nil(t, accountsDiff.Removed[0].NewAccount)
+
+		require.Equal(t, 1, len(accountsDiff.Modified))
+		modifiedAccount := accountsDiff.Modified[0]
+		assert.Equal(t, modifiedAddress, modifiedAccount.Address)
+		assert.Equal(t, big.NewInt(10), modifiedAccount.OldAccount.GetBalance())
+		assert.Equal(t, big.NewInt(15), modifiedAccount.NewAccount.GetBalance())
+		expectedDataTrieChanges := []*common.TrieLeafDiff{
+			{Key: []byte("key1"), OldValue: []byte("value1"), NewValue: []byte("new value1")},
+			{Key: []byte("key3"), NewValue: []byte("value3")},
+		}
+		assert.ElementsMatch(t, expectedDataTrieChanges, modifiedAccount.DataTrieChanges)
+		assert.Nil(t, accountsDiff.NextAddress)
+	})
+	t.Run("skip data trie changes should return only the accounts", func(t *testing.T) {
+		t.Parallel()
+
+		_, adb := getDefaultTrieAndAccountsDb()
+		address := []byte("address")
+		acc, _ := adb.LoadAccount(address)
+		userAcc := acc.(state.UserAccountHandler)
+		_ = userAcc.SaveKeyValue([]byte("key1"), []byte("value1"))
+		_ = adb.SaveAccount(userAcc)
+		oldRootHash, _ := adb.Commit()
+
+		acc, _ = adb.LoadAccount(address)
+		userAcc = acc.(state.UserAccountHandler)
+		_ = userAcc.SaveKeyValue([]byte("key1"), []byte("new value1"))
+		_ = adb.SaveAccount(userAcc)
+		newRootHash, _ := adb.Commit()
+
+		accountsDiff, err := adb.GetAccountsDiff(
+			context.Background(),
+			holders.NewRootHashHolder(oldRootHash, core.OptionalUint32{}),
+			holders.NewRootHashHolder(newRootHash, core.OptionalUint32{}),
+			nil,
+			state.AccountsDiffOptions{SkipDataTrieChanges: true},
+		)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(accountsDiff.Modified))
+		assert.Equal(t, address, accountsDiff.Modified[0].Address)
+		assert.Empty(t, accountsDiff.Modified[0].DataTrieChanges)
+	})
+	t.Run("should paginate the accounts in address order", func(t *testing.T) {
+		t.Parallel()
+
+		_, adb := getDefaultTrieAndAccountsDb()
+		oldRootHash, _ := adb.Commit()
+
+		addresses := [][]byte{[]byte("address a"), []byte("address b"), []byte("address c")}
+		for _, address := range addresses {
+			acc, _ := adb.LoadAccount(address)
+			_ = adb.SaveAccount(acc)
+		}
+		newRootHash, _ := adb.Commit()
+
+		oldRootHashHolder := holders.NewRootHashHolder(oldRootHash, core.OptionalUint32{})
+		newRootHashHolder := holders.NewRootHashHolder(newRootHash, core.OptionalUint32{})
+		options := state.AccountsDiffOptions{
+			MaxNumAccounts: 2,
+		}
+		accountsDiff, err := adb.GetAccountsDiff(context.Background(), oldRootHashHolder, newRootHashHolder, dataTrieLeafParserCreator, options)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(accountsDiff.Added))
+		assert.Equal(t, addresses[0], accountsDiff.Added[0].Address)
+		assert.Equal(t, addresses[1], accountsDiff.Added[1].Address)
+		assert.Equal(t, addresses[2], accountsDiff.NextAddress)
+
+		options.FromAddress = accountsDiff.NextAddress
+		accountsDiff, err = adb.GetAccountsDiff(context.Background(), oldRootHashHolder, newRootHashHolder, dataTrieLeafParserCreator, options)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(accountsDiff.Added))
+		assert.Equal(t, addresses[2], accountsDiff.Added[0].Address)
+		assert.Nil(t, accountsDiff.NextAddress)
+	})
+}
+
 func TestAccountsDB_Close(t *testing.T) {
 	t.Parallel()
 
@@ -2459,6 +2635,7 @@ func TestAccountsDB_SetSyncerAndStartSnapshotIfNeeded(t *testing.T) {
 			AccountFactory:       args.AccountFactory,
 			ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
 			LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
+			SnapshotFilesWriter:  &stateMock.SnapshotFilesWriterStub{},
 			StateStatsHandler:    statistics.NewStateStatistics(),
 		})
 		args.Trie = trieStub
@@ -2630,6 +2807,83 @@ func TestAccountsDB_PrintStatsForRootHash(t *testing.T) {
 	stats.Print()
 }
 
+func TestAccountsDB_CollectTriesStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should collect the main trie and the data tries", func(t *testing.T) {
+		t.Parallel()
+
+		_, adb := getDefaultTrieAndAccountsDb()
+		numAccounts := 50
+		accountsAddresses := generateAccounts(t, numAccounts, adb)
+		numAccountsWithDataTries := 20
+		for i := 0; i < numAccountsWithDataTries; i++ {
+			acc, _ := adb.LoadAccount(accountsAddresses[i])
+			userAcc := acc.(state.UserAccountHandler)
+			_ = userAcc.SaveKeyValue(generateRandomByteArray(32), generateRandomByteArray(32))
+			_ = adb.SaveAccount(acc)
+		}
+		rootHash, _ := adb.Commit()
+
+		collector := trieStatistics.NewTrieStatisticsCollector()
+		err := adb.CollectTriesStatistics(context.Background(), rootHash, collector)
+		require.Nil(t, err)
+
+		report := collector.GetReport()
+		assert.Equal(t, uint64(1), report.NumTriesByType[common.MainTrie])
+		assert.Equal(t, uint64(numAccountsWithDataTries), report.NumTriesByType[common.DataTrie])
+		assert.Equal(t, uint64(numAccounts), report.StatsByType[common.MainTrie].LeafNodes.NumNodes)
+		assert.Equal(t, uint64(numAccountsWithDataTries), report.StatsByType[common.DataTrie].LeafNodes.NumNodes)
+		assert.Equal(t, 10, len(report.LargestDataTries))
+		assert.NotEmpty(t, report.LargestDataTries[0].Address)
+	})
+	t.Run("closed context should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, adb := getDefaultTrieAndAccountsDb()
+		accountsAddresses := generateAccounts(t, 100, adb)
+		addDataTries(accountsAddresses, adb)
+		rootHash, _ := adb.Commit()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := adb.CollectTriesStatistics(ctx, rootHash, trieStatistics.NewTrieStatisticsCollector())
+		assert.Equal(t, core.ErrContextClosing, err)
+	})
+	t.Run("nil collector should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, adb := getDefaultTrieAndAccountsDb()
+		rootHash, _ := adb.RootHash()
+
+		err := adb.CollectTriesStatistics(context.Background(), rootHash, nil)
+		assert.Equal(t, state.ErrNilTriesStatisticsCollector, err)
+	})
+	t.Run("missing data trie should error, while GetStatsForRootHash should skip it", func(t *testing.T) {
+		t.Parallel()
+
+		memDb := testscommon.NewMemDbMock()
+		_, adb := getDefaultTrieAndAccountsDbWithCustomDB(memDb)
+		numAccounts := 10
+		accountsAddresses := generateAccounts(t, numAccounts, adb)
+		addDataTries(accountsAddresses, adb)
+		rootHash, _ := adb.Commit()
+
+		acc, _ := adb.LoadAccount(accountsAddresses[0])
+		err := memDb.Remove(acc.(state.UserAccountHandler).GetRootHash())
+		require.Nil(t, err)
+
+		err = adb.CollectTriesStatistics(context.Background(), rootHash, trieStatistics.NewTrieStatisticsCollector())
+		assert.NotNil(t, err)
+
+		stats, err := adb.GetStatsForRootHash(rootHash)
+		require.Nil(t, err)
+		report := stats.GetReport()
+		assert.Equal(t, uint64(1), report.NumTriesByType[common.MainTrie])
+		assert.Equal(t, uint64(numAccounts-1), report.NumTriesByType[common.DataTrie])
+	})
+}
+
 func TestAccountsDB_SyncMissingSnapshotNodes(t *testing.T) {
 	t.Parallel()
 
@@ -3070,3 +3324,72 @@ func testAccountLoadInParallel(
 
 	wg.Wait()
 }
+
+func TestAccountsDB_CommitDataTriesConcurrently(t *testing.T) {
+	t.Parallel()
+
+	type commitResult struct {
+		rootHash  []byte
+		oldHashes common.ModifiedHashes
+		newHashes common.ModifiedHashes
+	}
+
+	numAccounts := 50
+	commitAccounts := func(numWorkers uint32) []commitResult {
+		marshaller := &marshallerMock.MarshalizerMock{}
+		hasher := &hashingMocks.HasherMock{}
+		enableEpochsHandler := enableEpochsHandlerMock.NewEnableEpochsHandlerStub()
+		tsm, _ := trie.NewTrieStorageManager(storage.GetStorageManagerArgs())
+		tr, _ := trie.NewTrie(tsm, marshaller, hasher, enableEpochsHandler, uint(5))
+
+		results := make([]commitResult, 0)
+		spm := &stateMock.StoragePruningManagerStub{
+			MarkForEvictionCalled: func(_ []byte, newRoot []byte, oldHashes common.ModifiedHashes, newHashes common.ModifiedHashes) error {
+				results = append(results, commitResult{
+					rootHash:  newRoot,
+					oldHashes: oldHashes,
+					newHashes: newHashes,
+				})
+				return nil
+			},
+		}
+		argsAccountsDB := createMockAccountsDBArgs()
+		argsAccountsDB.Trie = tr
+		argsAccountsDB.Hasher = hasher
+		argsAccountsDB.Marshaller = marshaller
+		argsAccountsDB.AccountFactory, _ = factory.NewAccountCreator(factory.ArgsAccountCreator{
+			Hasher:              hasher,
+			Marshaller:          marshaller,
+			EnableEpochsHandler: enableEpochsHandler,
+		})
+		argsAccountsDB.StoragePruningManager = spm
+		argsAccountsDB.NumDataTriesCommitWorkers = numWorkers
+		adb, _ := state.NewAccountsDB(argsAccountsDB)
+
+		for round := 0; round < 2; round++ {
+			for i := 0; i < numAccounts; i++ {
+				acc, err := adb.LoadAccount([]byte(fmt.Sprintf("address%d", i)))
+				require.Nil(t, err)
+				userAcc := acc.(state.UserAccountHandler)
+				for j := 0; j < 5+round; j++ {
+					err = userAcc.SaveKeyValue([]byte(fmt.Sprintf("key%d", j)), []byte(fmt.Sprintf("value%d%d", j, round)))
+					require.Nil(t, err)
+				}
+				err = adb.SaveAccount(userAcc)
+				require.Nil(t, err)
+			}
+
+			_, err := adb.Commit()
+			require.Nil(t, err)
+		}
+
+		return results
+	}
+
+	sequentialResults := commitAccounts(0)
+	concurrentResults := commitAccounts(8)
+	require.Equal(t, 2, len(sequentialResults))
+	require.Equal(t, sequentialResults, concurrentResults)
+	assert.NotEqual(t, 0, len(concurrentResults[1].oldHashes))
+	assert.True(t, len(concurrentResults[0].newHashes) > numAccounts)
+}
@@ -0,0 +1,33 @@
+package state
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// DataTrieLeafParserCreator creates the leaf parser used for the data trie of the given address
+type DataTrieLeafParserCreator func(address []byte) (common.TrieLeafParser, error)
+
+// AccountsDiffOptions holds the range of the accounts to be compared. The accounts are compared in the increasing order
+// of their addresses, starting with FromAddress. If MaxNumAccounts is 0, all the accounts which differ are returned.
+// If SkipDataTrieChanges is set, only the accounts are compared, without computing the changed data trie keys
+type AccountsDiffOptions struct {
+	FromAddress         []byte
+	MaxNumAccounts      int
+	SkipDataTrieChanges bool
+}
+
+// AccountsDiff holds the accounts which differ between two states. NextAddress is the address to continue from if the
+// maximum number of accounts was reached, being empty if there are no more accounts
+type AccountsDiff struct {
+	Added       []*AccountDiff
+	Removed     []*AccountDiff
+	Modified    []*AccountDiff
+	NextAddress []byte
+}
+
+// AccountDiff holds the old and the new version of an account, together with the changed data trie keys.
+// The old account is nil for an added account, while the new account is nil for a removed one
+type AccountDiff struct {
+	Address         []byte
+	OldAccount      UserAccountHandler
+	NewAccount      UserAccountHandler
+	DataTrieChanges []*common.TrieLeafDiff
+}
@@ -37,6 +37,18 @@ func (ddth *disabledDataTrieHandler) GetAllLeavesOnChannel(
 	return nil
 }
 
+// GetLeavesPage returns an empty page
+func (ddth *disabledDataTrieHandler) GetLeavesPage(
+	_ context.Context,
+	_ []byte,
+	_ []byte,
+	_ int,
+	_ []byte,
+	_ common.TrieLeafParser,
+) (*common.TrieLeavesPage, error) {
+	return &common.TrieLeavesPage{}, nil
+}
+
 // IsMigratedToLatestVersion returns true
 func (ddth *disabledDataTrieHandler) IsMigratedToLatestVersion() (bool, error) {
 	return true, nil
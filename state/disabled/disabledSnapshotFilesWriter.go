@@ -0,0 +1,26 @@
+package disabled
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+type disabledSnapshotFilesWriter struct {
+}
+
+// NewDisabledSnapshotFilesWriter creates a new disabled snapshot files writer
+func NewDisabledSnapshotFilesWriter() state.SnapshotFilesWriter {
+	return &disabledSnapshotFilesWriter{}
+}
+
+// WriteSnapshot returns nil for this implementation
+func (d *disabledSnapshotFilesWriter) WriteSnapshot(_ context.Context, _ []byte, _ uint32, _ common.StorageManager) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledSnapshotFilesWriter) IsInterfaceNil() bool {
+	return d == nil
+}
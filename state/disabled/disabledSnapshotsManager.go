@@ -32,6 +32,11 @@ func (d *disabledSnapshotsManger) SetSyncer(_ state.AccountsDBSyncer) error {
 	return nil
 }
 
+// Close returns nil for this implementation
+func (d *disabledSnapshotsManger) Close() error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (d *disabledSnapshotsManger) IsInterfaceNil() bool {
 	return d == nil
@@ -154,6 +154,9 @@ var ErrNilStatsHandler = errors.New("nil stats handler")
 // ErrNilLastSnapshotMarker signals that a nil last snapshot marker has been given
 var ErrNilLastSnapshotMarker = errors.New("nil last snapshot marker")
 
+// ErrNilSnapshotFilesWriter signals that a nil snapshot files writer has been given
+var ErrNilSnapshotFilesWriter = errors.New("nil snapshot files writer")
+
 // ErrNilSnapshotsManager signals that a nil snapshots manager has been given
 var ErrNilSnapshotsManager = errors.New("nil snapshots manager")
 
@@ -165,3 +168,12 @@ var ErrValidatorsDifferentShards = errors.New("validators are not in the same sh
 
 // ErrValidatorNotFound signals that a validator was not found
 var ErrValidatorNotFound = errors.New("validator not found")
+
+// ErrNilDataTrieLeafParserCreator signals that a nil data trie leaf parser creator was provided
+var ErrNilDataTrieLeafParserCreator = errors.New("nil data trie leaf parser creator")
+
+// ErrNilTriesStatisticsCollector signals that a nil tries statistics collector was provided
+var ErrNilTriesStatisticsCollector = errors.New("nil tries statistics collector")
+
+// errMaxNumAccountsReached signals that the maximum number of accounts of an accounts diff was reached
+var errMaxNumAccountsReached = errors.New("maximum number of accounts reached")
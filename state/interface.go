@@ -88,6 +88,8 @@ type AccountsAdapter interface {
 	GetAllLeaves(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, trieLeafParser common.TrieLeafParser) error
 	RecreateAllTries(rootHash []byte) (map[string]common.Trie, error)
 	GetTrie(rootHash []byte) (common.Trie, error)
+	GetAccountsDiff(ctx context.Context, oldRootHash common.RootHashHolder, newRootHash common.RootHashHolder, dataTrieLeafParserCreator DataTrieLeafParserCreator, options AccountsDiffOptions) (*AccountsDiff, error)
+	CollectTriesStatistics(ctx context.Context, rootHash []byte, collector common.TriesStatisticsCollector) error
 	GetStackDebugFirstEntry() []byte
 	SetSyncer(syncer AccountsDBSyncer) error
 	StartSnapshotIfNeeded() error
@@ -101,6 +103,7 @@ type SnapshotsManager interface {
 	StartSnapshotAfterRestartIfNeeded(trieStorageManager common.StorageManager) error
 	IsSnapshotInProgress() bool
 	SetSyncer(syncer AccountsDBSyncer) error
+	Close() error
 	IsInterfaceNil() bool
 }
 
@@ -249,6 +252,7 @@ type UserAccountHandler interface {
 	GetUserName() []byte
 	IsGuarded() bool
 	GetAllLeaves(leavesChannels *common.TrieIteratorChannels, ctx context.Context) error
+	GetLeavesPage(ctx context.Context, startKey []byte, maxLeaves int, keyPrefix []byte) (*common.TrieLeavesPage, error)
 	vmcommon.AccountHandler
 }
 
@@ -284,6 +288,12 @@ type LastSnapshotMarker interface {
 	IsInterfaceNil() bool
 }
 
+// SnapshotFilesWriter writes the state found at a root hash in portable snapshot files
+type SnapshotFilesWriter interface {
+	WriteSnapshot(ctx context.Context, rootHash []byte, epoch uint32, trieStorageManager common.StorageManager) error
+	IsInterfaceNil() bool
+}
+
 // ShardValidatorsInfoMapHandler shall be used to manage operations inside
 // a <shardID, []ValidatorInfoHandler> map in a concurrent-safe way.
 type ShardValidatorsInfoMapHandler interface {
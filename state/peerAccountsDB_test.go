@@ -113,6 +113,7 @@ func TestNewPeerAccountsDB_SnapshotState(t *testing.T) {
 		AccountFactory:       args.AccountFactory,
 		ChannelsProvider:     iteratorChannelsProvider.NewPeerStateIteratorChannelsProvider(),
 		LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
+		SnapshotFilesWriter:  &testState.SnapshotFilesWriterStub{},
 		StateStatsHandler:    statistics.NewStateStatistics(),
 	})
 	args.SnapshotsManager = snapshotsManager
@@ -382,6 +383,7 @@ func TestPeerAccountsDB_SetSyncerAndStartSnapshotIfNeededMarksActiveDB(t *testin
 			AccountFactory:       args.AccountFactory,
 			ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
 			LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
+			SnapshotFilesWriter:  &testState.SnapshotFilesWriterStub{},
 			StateStatsHandler:    statistics.NewStateStatistics(),
 		})
 		args.Trie = trieStub
@@ -0,0 +1,81 @@
+package snapshotFiles
+
+import (
+	"encoding/hex"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+)
+
+// chunkWriter groups the encoded trie nodes in chunk files having at most the configured size. A single node larger
+// than the maximum size is written in its own chunk
+type chunkWriter struct {
+	directory    string
+	maxChunkSize uint64
+	marshaller   marshal.Marshalizer
+	hasher       hashing.Hasher
+
+	currentNodes [][]byte
+	currentSize  uint64
+	chunks       []ChunkInfo
+}
+
+func newChunkWriter(directory string, maxChunkSize uint64, marshaller marshal.Marshalizer, hasher hashing.Hasher) *chunkWriter {
+	return &chunkWriter{
+		directory:    directory,
+		maxChunkSize: maxChunkSize,
+		marshaller:   marshaller,
+		hasher:       hasher,
+		currentNodes: make([][]byte, 0),
+		chunks:       make([]ChunkInfo, 0),
+	}
+}
+
+func (cw *chunkWriter) addNode(encodedNode []byte) error {
+	nodeSize := uint64(len(encodedNode))
+	if len(cw.currentNodes) > 0 && cw.currentSize+nodeSize > cw.maxChunkSize {
+		err := cw.flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.currentNodes = append(cw.currentNodes, encodedNode)
+	cw.currentSize += nodeSize
+
+	return nil
+}
+
+func (cw *chunkWriter) flush() error {
+	if len(cw.currentNodes) == 0 {
+		return nil
+	}
+
+	buff, err := cw.marshaller.Marshal(&batch.Batch{Data: cw.currentNodes})
+	if err != nil {
+		return err
+	}
+
+	chunkHash := hex.EncodeToString(cw.hasher.Compute(string(buff)))
+	err = os.WriteFile(getChunkFilePath(cw.directory, chunkHash), buff, core.FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+
+	cw.chunks = append(cw.chunks, ChunkInfo{
+		Hash:     chunkHash,
+		NumNodes: uint64(len(cw.currentNodes)),
+		Size:     uint64(len(buff)),
+	})
+	cw.currentNodes = make([][]byte, 0)
+	cw.currentSize = 0
+
+	return nil
+}
+
+func (cw *chunkWriter) getChunks() []ChunkInfo {
+	return cw.chunks
+}
@@ -0,0 +1,51 @@
+package snapshotFiles
+
+import "errors"
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilEnableEpochsHandler signals that a nil enable epochs handler has been provided
+var ErrNilEnableEpochsHandler = errors.New("nil enable epochs handler")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilTrieStorageManager signals that a nil trie storage manager has been provided
+var ErrNilTrieStorageManager = errors.New("nil trie storage manager")
+
+// ErrNilStorageMarker signals that a nil storage marker has been provided
+var ErrNilStorageMarker = errors.New("nil storage marker")
+
+// ErrEmptyDirectory signals that an empty directory has been provided
+var ErrEmptyDirectory = errors.New("empty directory")
+
+// ErrInvalidMaxChunkSize signals that an invalid maximum chunk size has been provided
+var ErrInvalidMaxChunkSize = errors.New("invalid max chunk size")
+
+// ErrEmptyRootHash signals that an empty root hash has been provided
+var ErrEmptyRootHash = errors.New("empty root hash")
+
+// ErrSnapshotNotFound signals that no snapshot files were found for the requested root hash
+var ErrSnapshotNotFound = errors.New("state snapshot not found")
+
+// ErrUnsupportedManifestVersion signals that the manifest version is not supported
+var ErrUnsupportedManifestVersion = errors.New("unsupported manifest version")
+
+// ErrRootHashMismatch signals that the manifest root hash does not match the requested one
+var ErrRootHashMismatch = errors.New("root hash mismatch")
+
+// ErrUntrustedPublicKey signals that the manifest was signed by a public key which is not trusted
+var ErrUntrustedPublicKey = errors.New("untrusted public key")
+
+// ErrChunkHashMismatch signals that the content of a chunk file does not match its hash
+var ErrChunkHashMismatch = errors.New("chunk hash mismatch")
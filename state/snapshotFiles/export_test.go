@@ -0,0 +1,8 @@
+package snapshotFiles
+
+import "github.com/multiversx/mx-chain-core-go/hashing"
+
+// ComputeDigest -
+func (m *Manifest) ComputeDigest(hasher hashing.Hasher) []byte {
+	return m.computeDigest(hasher)
+}
@@ -0,0 +1,89 @@
+package snapshotFiles
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+)
+
+const (
+	manifestVersion    = uint32(2)
+	manifestFileName   = "manifest.json"
+	chunkFileExtension = ".chunk"
+	tempDirSuffix      = ".tmp"
+
+	// manifestSigningDomain prefixes the signed digest, so a manifest signature can not be replayed as a signature
+	// for any other kind of message signed with the same key, and the other way around
+	manifestSigningDomain = "MultiversX state snapshot manifest"
+)
+
+// ChunkInfo holds the details of a chunk file, the file being named after the hash of its content
+type ChunkInfo struct {
+	Hash     string `json:"hash"`
+	NumNodes uint64 `json:"numNodes"`
+	Size     uint64 `json:"size"`
+}
+
+// Manifest describes a state snapshot. The signature is computed over the domain separated manifest digest, which covers the
+// epoch start root hash and all the chunk hashes
+type Manifest struct {
+	Version      uint32      `json:"version"`
+	Epoch        uint32      `json:"epoch"`
+	RootHash     string      `json:"rootHash"`
+	NumDataTries uint64      `json:"numDataTries"`
+	Chunks       []ChunkInfo `json:"chunks"`
+	PublicKey    string      `json:"publicKey"`
+	Signature    string      `json:"signature"`
+}
+
+func (m *Manifest) computeDigest(hasher hashing.Hasher) []byte {
+	buff := []byte(manifestSigningDomain)
+	buff = binary.BigEndian.AppendUint32(buff, m.Version)
+	buff = binary.BigEndian.AppendUint32(buff, m.Epoch)
+	buff = append(buff, m.RootHash...)
+	buff = binary.BigEndian.AppendUint64(buff, m.NumDataTries)
+	for _, chunk := range m.Chunks {
+		buff = append(buff, chunk.Hash...)
+		buff = binary.BigEndian.AppendUint64(buff, chunk.NumNodes)
+		buff = binary.BigEndian.AppendUint64(buff, chunk.Size)
+	}
+
+	return hasher.Compute(string(buff))
+}
+
+func getSnapshotDirectory(baseDirectory string, rootHash []byte) string {
+	return filepath.Join(baseDirectory, hex.EncodeToString(rootHash))
+}
+
+func getChunkFilePath(snapshotDirectory string, chunkHash string) string {
+	return filepath.Join(snapshotDirectory, chunkHash+chunkFileExtension)
+}
+
+func saveManifest(snapshotDirectory string, manifest *Manifest) error {
+	buff, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(snapshotDirectory, manifestFileName), buff, core.FileModeUserReadWrite)
+}
+
+func loadManifest(snapshotDirectory string) (*Manifest, error) {
+	buff, err := os.ReadFile(filepath.Join(snapshotDirectory, manifestFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	err = json.Unmarshal(buff, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
@@ -0,0 +1,236 @@
+package snapshotFiles
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+// ArgsSnapshotFilesImporter holds the arguments needed for creating a new snapshot files importer
+type ArgsSnapshotFilesImporter struct {
+	Config               config.StateSnapshotFilesConfig
+	Marshaller           marshal.Marshalizer
+	Hasher               hashing.Hasher
+	EnableEpochsHandler  common.EnableEpochsHandler
+	MaxTrieLevelInMemory uint
+	SingleSigner         crypto.SingleSigner
+	KeyGenerator         crypto.KeyGenerator
+}
+
+type snapshotFilesImporter struct {
+	directory            string
+	trustedPublicKeys    map[string]struct{}
+	marshaller           marshal.Marshalizer
+	hasher               hashing.Hasher
+	enableEpochsHandler  common.EnableEpochsHandler
+	maxTrieLevelInMemory uint
+	singleSigner         crypto.SingleSigner
+	keyGenerator         crypto.KeyGenerator
+}
+
+// NewSnapshotFilesImporter creates a component able to import the user accounts state from portable snapshot files
+func NewSnapshotFilesImporter(args ArgsSnapshotFilesImporter) (*snapshotFilesImporter, error) {
+	err := checkImporterArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	trustedPublicKeys := make(map[string]struct{}, len(args.Config.TrustedPublicKeys))
+	for _, publicKey := range args.Config.TrustedPublicKeys {
+		trustedPublicKeys[publicKey] = struct{}{}
+	}
+
+	return &snapshotFilesImporter{
+		directory:            args.Config.Directory,
+		trustedPublicKeys:    trustedPublicKeys,
+		marshaller:           args.Marshaller,
+		hasher:               args.Hasher,
+		enableEpochsHandler:  args.EnableEpochsHandler,
+		maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+		singleSigner:         args.SingleSigner,
+		keyGenerator:         args.KeyGenerator,
+	}, nil
+}
+
+func checkImporterArgs(args ArgsSnapshotFilesImporter) error {
+	if len(args.Config.Directory) == 0 {
+		return ErrEmptyDirectory
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return ErrNilEnableEpochsHandler
+	}
+	if check.IfNil(args.SingleSigner) {
+		return ErrNilSingleSigner
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return ErrNilKeyGenerator
+	}
+
+	return nil
+}
+
+// ImportSnapshot imports the snapshot written for the given root hash into the provided trie storage manager. Each
+// chunk is checked against its hash and each trie node is stored under its computed hash. After all the nodes are
+// stored, the main trie and the data tries are fully traversed starting from the root hash, so that the state is
+// marked as synced only if it is complete
+func (i *snapshotFilesImporter) ImportSnapshot(
+	rootHash []byte,
+	trieStorageManager common.StorageManager,
+	storageMarker common.StorageMarker,
+) error {
+	if check.IfNil(trieStorageManager) {
+		return ErrNilTrieStorageManager
+	}
+	if check.IfNil(storageMarker) {
+		return ErrNilStorageMarker
+	}
+	if common.IsEmptyTrie(rootHash) {
+		return ErrEmptyRootHash
+	}
+
+	sw := core.NewStopWatch()
+	sw.Start("ImportSnapshot")
+
+	snapshotDirectory := getSnapshotDirectory(i.directory, rootHash)
+	manifest, err := loadManifest(snapshotDirectory)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w for root hash %s", ErrSnapshotNotFound, hex.EncodeToString(rootHash))
+	}
+	if err != nil {
+		return err
+	}
+
+	err = i.checkManifest(manifest, rootHash)
+	if err != nil {
+		return err
+	}
+
+	for _, chunk := range manifest.Chunks {
+		err = i.importChunk(snapshotDirectory, chunk, trieStorageManager)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = i.checkStateIsComplete(rootHash, trieStorageManager)
+	if err != nil {
+		return err
+	}
+
+	storageMarker.MarkStorerAsSyncedAndActive(trieStorageManager)
+
+	sw.Stop("ImportSnapshot")
+	logArguments := []interface{}{"rootHash", rootHash, "epoch", manifest.Epoch, "num chunks", len(manifest.Chunks), "num data tries", manifest.NumDataTries}
+	logArguments = append(logArguments, sw.GetMeasurements()...)
+	log.Info("state snapshot files imported", logArguments...)
+
+	return nil
+}
+
+func (i *snapshotFilesImporter) checkManifest(manifest *Manifest, rootHash []byte) error {
+	if manifest.Version != manifestVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedManifestVersion, manifest.Version)
+	}
+	if manifest.RootHash != hex.EncodeToString(rootHash) {
+		return fmt.Errorf("%w: manifest root hash %s, expected %s", ErrRootHashMismatch, manifest.RootHash, hex.EncodeToString(rootHash))
+	}
+
+	if len(i.trustedPublicKeys) > 0 {
+		_, isTrusted := i.trustedPublicKeys[manifest.PublicKey]
+		if !isTrusted {
+			return fmt.Errorf("%w: %s", ErrUntrustedPublicKey, manifest.PublicKey)
+		}
+	}
+
+	publicKeyBytes, err := hex.DecodeString(manifest.PublicKey)
+	if err != nil {
+		return err
+	}
+	publicKey, err := i.keyGenerator.PublicKeyFromByteArray(publicKeyBytes)
+	if err != nil {
+		return err
+	}
+	signature, err := hex.DecodeString(manifest.Signature)
+	if err != nil {
+		return err
+	}
+
+	return i.singleSigner.Verify(publicKey, manifest.computeDigest(i.hasher), signature)
+}
+
+func (i *snapshotFilesImporter) importChunk(snapshotDirectory string, chunk ChunkInfo, trieStorageManager common.StorageManager) error {
+	buff, err := os.ReadFile(getChunkFilePath(snapshotDirectory, chunk.Hash))
+	if err != nil {
+		return err
+	}
+
+	computedHash := hex.EncodeToString(i.hasher.Compute(string(buff)))
+	if computedHash != chunk.Hash {
+		return fmt.Errorf("%w: chunk %s, computed hash %s", ErrChunkHashMismatch, chunk.Hash, computedHash)
+	}
+
+	b := &batch.Batch{}
+	err = i.marshaller.Unmarshal(b, buff)
+	if err != nil {
+		return err
+	}
+
+	for _, encodedNode := range b.Data {
+		err = trieStorageManager.Put(i.hasher.Compute(string(encodedNode)), encodedNode)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *snapshotFilesImporter) checkStateIsComplete(rootHash []byte, trieStorageManager common.StorageManager) error {
+	tr, err := trie.NewTrie(trieStorageManager, i.marshaller, i.hasher, i.enableEpochsHandler, i.maxTrieLevelInMemory)
+	if err != nil {
+		return err
+	}
+
+	mainTrie, err := tr.Recreate(rootHash)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	skipNode := func(_ []byte) error {
+		return nil
+	}
+	err = trie.WalkTrieNodes(ctx, mainTrie, skipNode)
+	if err != nil {
+		return err
+	}
+
+	_, err = trie.WalkDataTries(ctx, mainTrie, rootHash, i.marshaller, func(dataTrie common.Trie) error {
+		return trie.WalkTrieNodes(ctx, dataTrie, skipNode)
+	})
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (i *snapshotFilesImporter) IsInterfaceNil() bool {
+	return i == nil
+}
@@ -0,0 +1,290 @@
+package snapshotFiles_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/snapshotFiles"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/trie/storageMarker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type storageMarkerStub struct {
+	numCalls int
+}
+
+func (stub *storageMarkerStub) MarkStorerAsSyncedAndActive(_ common.StorageManager) {
+	stub.numCalls++
+}
+
+func (stub *storageMarkerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createMockImporterArgs(directory string) snapshotFiles.ArgsSnapshotFilesImporter {
+	return snapshotFiles.ArgsSnapshotFilesImporter{
+		Config: config.StateSnapshotFilesConfig{
+			ImportEnabled: true,
+			Directory:     directory,
+		},
+		Marshaller:           testMarshaller,
+		Hasher:               testHasher,
+		EnableEpochsHandler:  &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+		MaxTrieLevelInMemory: 5,
+		SingleSigner:         testSigner,
+		KeyGenerator:         testKeyGen,
+	}
+}
+
+func writeTestSnapshot(t *testing.T, directory string, numAccounts int) ([]byte, map[string]map[string]string, string) {
+	tsm := createStorageManager(t)
+	rootHash, leaves := createState(t, tsm, numAccounts)
+
+	args := createMockWriterArgs(directory)
+	args.Config.MaxChunkSizeInBytes = 500
+	w, _ := snapshotFiles.NewSnapshotFilesWriter(args)
+	err := w.WriteSnapshot(context.Background(), rootHash, 7, tsm)
+	require.Nil(t, err)
+
+	publicKey, _ := args.PrivateKey.GeneratePublic().ToByteArray()
+
+	return rootHash, leaves, hex.EncodeToString(publicKey)
+}
+
+func saveTestManifest(t *testing.T, directory string, rootHash []byte, manifest *snapshotFiles.Manifest) {
+	buff, err := json.Marshal(manifest)
+	require.Nil(t, err)
+
+	err = os.WriteFile(filepath.Join(directory, hex.EncodeToString(rootHash), "manifest.json"), buff, 0600)
+	require.Nil(t, err)
+}
+
+func signTestManifest(t *testing.T, manifest *snapshotFiles.Manifest, privateKey crypto.PrivateKey) []byte {
+	signature, err := testSigner.Sign(privateKey, manifest.ComputeDigest(testHasher))
+	require.Nil(t, err)
+
+	return signature
+}
+
+func TestNewSnapshotFilesImporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, err := snapshotFiles.NewSnapshotFilesImporter(createMockImporterArgs(""))
+		assert.Nil(t, importer)
+		assert.Equal(t, snapshotFiles.ErrEmptyDirectory, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockImporterArgs(t.TempDir())
+		args.Marshaller = nil
+		importer, err := snapshotFiles.NewSnapshotFilesImporter(args)
+		assert.Nil(t, importer)
+		assert.Equal(t, snapshotFiles.ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockImporterArgs(t.TempDir())
+		args.Hasher = nil
+		importer, err := snapshotFiles.NewSnapshotFilesImporter(args)
+		assert.Nil(t, importer)
+		assert.Equal(t, snapshotFiles.ErrNilHasher, err)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockImporterArgs(t.TempDir())
+		args.EnableEpochsHandler = nil
+		importer, err := snapshotFiles.NewSnapshotFilesImporter(args)
+		assert.Nil(t, importer)
+		assert.Equal(t, snapshotFiles.ErrNilEnableEpochsHandler, err)
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockImporterArgs(t.TempDir())
+		args.SingleSigner = nil
+		importer, err := snapshotFiles.NewSnapshotFilesImporter(args)
+		assert.Nil(t, importer)
+		assert.Equal(t, snapshotFiles.ErrNilSingleSigner, err)
+	})
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockImporterArgs(t.TempDir())
+		args.KeyGenerator = nil
+		importer, err := snapshotFiles.NewSnapshotFilesImporter(args)
+		assert.Nil(t, importer)
+		assert.Equal(t, snapshotFiles.ErrNilKeyGenerator, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		importer, err := snapshotFiles.NewSnapshotFilesImporter(createMockImporterArgs(t.TempDir()))
+		assert.Nil(t, err)
+		assert.False(t, importer.IsInterfaceNil())
+	})
+}
+
+func TestSnapshotFilesImporter_ImportSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trie storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, _ := snapshotFiles.NewSnapshotFilesImporter(createMockImporterArgs(t.TempDir()))
+		err := importer.ImportSnapshot([]byte("root hash"), nil, storageMarker.NewDisabledStorageMarker())
+		assert.Equal(t, snapshotFiles.ErrNilTrieStorageManager, err)
+	})
+	t.Run("nil storage marker should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, _ := snapshotFiles.NewSnapshotFilesImporter(createMockImporterArgs(t.TempDir()))
+		err := importer.ImportSnapshot([]byte("root hash"), createStorageManager(t), nil)
+		assert.Equal(t, snapshotFiles.ErrNilStorageMarker, err)
+	})
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, _ := snapshotFiles.NewSnapshotFilesImporter(createMockImporterArgs(t.TempDir()))
+		err := importer.ImportSnapshot(nil, createStorageManager(t), storageMarker.NewDisabledStorageMarker())
+		assert.Equal(t, snapshotFiles.ErrEmptyRootHash, err)
+	})
+	t.Run("missing snapshot should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, _ := snapshotFiles.NewSnapshotFilesImporter(createMockImporterArgs(t.TempDir()))
+		err := importer.ImportSnapshot([]byte("root hash"), createStorageManager(t), storageMarker.NewDisabledStorageMarker())
+		assert.True(t, errors.Is(err, snapshotFiles.ErrSnapshotNotFound))
+	})
+	t.Run("manifest for another root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		rootHash, _, _ := writeTestSnapshot(t, directory, 5)
+		otherRootHash := []byte("other root hash")
+		err := os.Rename(filepath.Join(directory, hex.EncodeToString(rootHash)), filepath.Join(directory, hex.EncodeToString(otherRootHash)))
+		require.Nil(t, err)
+
+		importer, _ := snapshotFiles.NewSnapshotFilesImporter(createMockImporterArgs(directory))
+		err = importer.ImportSnapshot(otherRootHash, createStorageManager(t), storageMarker.NewDisabledStorageMarker())
+		assert.True(t, errors.Is(err, snapshotFiles.ErrRootHashMismatch))
+	})
+	t.Run("untrusted public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		rootHash, _, _ := writeTestSnapshot(t, directory, 5)
+		_, otherPublicKey := createKeys()
+
+		args := createMockImporterArgs(directory)
+		args.Config.TrustedPublicKeys = []string{otherPublicKey}
+		importer, _ := snapshotFiles.NewSnapshotFilesImporter(args)
+		err := importer.ImportSnapshot(rootHash, createStorageManager(t), storageMarker.NewDisabledStorageMarker())
+		assert.True(t, errors.Is(err, snapshotFiles.ErrUntrustedPublicKey))
+	})
+	t.Run("altered manifest should error", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		rootHash, _, _ := writeTestSnapshot(t, directory, 5)
+		manifest := readManifest(t, directory, rootHash)
+		manifest.Chunks = manifest.Chunks[1:]
+		saveTestManifest(t, directory, rootHash, manifest)
+
+		importer, _ := snapshotFiles.NewSnapshotFilesImporter(createMockImporterArgs(directory))
+		err := importer.ImportSnapshot(rootHash, createStorageManager(t), storageMarker.NewDisabledStorageMarker())
+		assert.NotNil(t, err)
+	})
+	t.Run("altered chunk should error", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		rootHash, _, _ := writeTestSnapshot(t, directory, 5)
+		manifest := readManifest(t, directory, rootHash)
+		chunkPath := filepath.Join(directory, manifest.RootHash, manifest.Chunks[0].Hash+".chunk")
+		buff, _ := os.ReadFile(chunkPath)
+		buff[len(buff)/2]++
+		_ = os.WriteFile(chunkPath, buff, 0600)
+
+		sm := &storageMarkerStub{}
+		importer, _ := snapshotFiles.NewSnapshotFilesImporter(createMockImporterArgs(directory))
+		err := importer.ImportSnapshot(rootHash, createStorageManager(t), sm)
+		assert.True(t, errors.Is(err, snapshotFiles.ErrChunkHashMismatch))
+		assert.Equal(t, 0, sm.numCalls)
+	})
+	t.Run("missing trie nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		rootHash, _, _ := writeTestSnapshot(t, directory, 11)
+
+		// a manifest signed by its writer can still describe an incomplete state, which must be rejected
+		manifest := readManifest(t, directory, rootHash)
+		require.True(t, len(manifest.Chunks) > 1)
+		privateKey, publicKey := createKeys()
+		manifest.Chunks = manifest.Chunks[:len(manifest.Chunks)-1]
+		manifest.PublicKey = publicKey
+		manifest.Signature = hex.EncodeToString(signTestManifest(t, manifest, privateKey))
+		saveTestManifest(t, directory, rootHash, manifest)
+
+		sm := &storageMarkerStub{}
+		importer, _ := snapshotFiles.NewSnapshotFilesImporter(createMockImporterArgs(directory))
+		err := importer.ImportSnapshot(rootHash, createStorageManager(t), sm)
+		assert.NotNil(t, err)
+		assert.Equal(t, 0, sm.numCalls)
+	})
+	t.Run("should import the main trie and the data tries", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		rootHash, leaves, publicKey := writeTestSnapshot(t, directory, 11)
+
+		args := createMockImporterArgs(directory)
+		args.Config.TrustedPublicKeys = []string{publicKey}
+		importer, _ := snapshotFiles.NewSnapshotFilesImporter(args)
+
+		sm := &storageMarkerStub{}
+		tsm := createStorageManager(t)
+		err := importer.ImportSnapshot(rootHash, tsm, sm)
+		require.Nil(t, err)
+		assert.Equal(t, 1, sm.numCalls)
+
+		mainTrie, err := createTrie(t, tsm).Recreate(rootHash)
+		require.Nil(t, err)
+		for address, dataTrieLeaves := range leaves {
+			accountBytes, _, errGet := mainTrie.Get([]byte(address))
+			require.Nil(t, errGet)
+
+			accountData := &accounts.UserAccountData{}
+			require.Nil(t, testMarshaller.Unmarshal(accountData, accountBytes))
+			if len(dataTrieLeaves) == 0 {
+				assert.Empty(t, accountData.RootHash)
+				continue
+			}
+
+			dataTrie, errRecreate := mainTrie.Recreate(accountData.RootHash)
+			require.Nil(t, errRecreate)
+			for key, value := range dataTrieLeaves {
+				recovered, _, errGetData := dataTrie.Get([]byte(key))
+				require.Nil(t, errGetData)
+				assert.Equal(t, value, string(recovered))
+			}
+		}
+	})
+}
@@ -0,0 +1,283 @@
+package snapshotFiles
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/trie"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("state/snapshotFiles")
+
+// ArgsSnapshotFilesWriter holds the arguments needed for creating a new snapshot files writer
+type ArgsSnapshotFilesWriter struct {
+	Config               config.StateSnapshotFilesConfig
+	Marshaller           marshal.Marshalizer
+	Hasher               hashing.Hasher
+	EnableEpochsHandler  common.EnableEpochsHandler
+	MaxTrieLevelInMemory uint
+	SingleSigner         crypto.SingleSigner
+	PrivateKey           crypto.PrivateKey
+}
+
+type snapshotFilesWriter struct {
+	directory            string
+	maxChunkSize         uint64
+	numSnapshotsToKeep   uint32
+	marshaller           marshal.Marshalizer
+	hasher               hashing.Hasher
+	enableEpochsHandler  common.EnableEpochsHandler
+	maxTrieLevelInMemory uint
+	singleSigner         crypto.SingleSigner
+	privateKey           crypto.PrivateKey
+	publicKey            []byte
+	mutWrite             sync.Mutex
+}
+
+// NewSnapshotFilesWriter creates a component able to write the user accounts state found at a root hash in portable
+// snapshot files
+func NewSnapshotFilesWriter(args ArgsSnapshotFilesWriter) (*snapshotFilesWriter, error) {
+	err := checkWriterArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := args.PrivateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshotFilesWriter{
+		directory:            args.Config.Directory,
+		maxChunkSize:         args.Config.MaxChunkSizeInBytes,
+		numSnapshotsToKeep:   args.Config.NumSnapshotsToKeep,
+		marshaller:           args.Marshaller,
+		hasher:               args.Hasher,
+		enableEpochsHandler:  args.EnableEpochsHandler,
+		maxTrieLevelInMemory: args.MaxTrieLevelInMemory,
+		singleSigner:         args.SingleSigner,
+		privateKey:           args.PrivateKey,
+		publicKey:            publicKey,
+	}, nil
+}
+
+func checkWriterArgs(args ArgsSnapshotFilesWriter) error {
+	if len(args.Config.Directory) == 0 {
+		return ErrEmptyDirectory
+	}
+	if args.Config.MaxChunkSizeInBytes == 0 {
+		return ErrInvalidMaxChunkSize
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return ErrNilEnableEpochsHandler
+	}
+	if check.IfNil(args.SingleSigner) {
+		return ErrNilSingleSigner
+	}
+	if check.IfNil(args.PrivateKey) {
+		return ErrNilPrivateKey
+	}
+
+	return nil
+}
+
+// WriteSnapshot writes the main trie and the data tries found at the given root hash in chunk files, together with
+// the signed manifest. The files are written in a temporary directory which is renamed only after all of them are
+// written, so an incomplete snapshot is never found under the root hash directory. The writing stops if the context
+// is done
+func (w *snapshotFilesWriter) WriteSnapshot(ctx context.Context, rootHash []byte, epoch uint32, trieStorageManager common.StorageManager) error {
+	if check.IfNil(trieStorageManager) {
+		return ErrNilTrieStorageManager
+	}
+	if common.IsEmptyTrie(rootHash) {
+		return ErrEmptyRootHash
+	}
+
+	w.mutWrite.Lock()
+	defer w.mutWrite.Unlock()
+
+	trieStorageManager.EnterPruningBufferingMode()
+	defer trieStorageManager.ExitPruningBufferingMode()
+
+	sw := core.NewStopWatch()
+	sw.Start("WriteSnapshot")
+
+	snapshotDirectory := getSnapshotDirectory(w.directory, rootHash)
+	tempDirectory := snapshotDirectory + tempDirSuffix
+	err := recreateDirectory(tempDirectory)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := w.writeChunks(ctx, rootHash, tempDirectory, trieStorageManager)
+	if err != nil {
+		_ = os.RemoveAll(tempDirectory)
+		return err
+	}
+
+	manifest.Epoch = epoch
+	err = w.signManifest(manifest)
+	if err != nil {
+		_ = os.RemoveAll(tempDirectory)
+		return err
+	}
+
+	err = saveManifest(tempDirectory, manifest)
+	if err != nil {
+		_ = os.RemoveAll(tempDirectory)
+		return err
+	}
+
+	err = os.RemoveAll(snapshotDirectory)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(tempDirectory, snapshotDirectory)
+	if err != nil {
+		return err
+	}
+
+	w.removeOldSnapshots()
+
+	sw.Stop("WriteSnapshot")
+	logArguments := []interface{}{"rootHash", rootHash, "epoch", epoch, "num chunks", len(manifest.Chunks), "num data tries", manifest.NumDataTries}
+	logArguments = append(logArguments, sw.GetMeasurements()...)
+	log.Info("state snapshot files written", logArguments...)
+
+	return nil
+}
+
+func (w *snapshotFilesWriter) writeChunks(ctx context.Context, rootHash []byte, directory string, trieStorageManager common.StorageManager) (*Manifest, error) {
+	tr, err := trie.NewTrie(trieStorageManager, w.marshaller, w.hasher, w.enableEpochsHandler, w.maxTrieLevelInMemory)
+	if err != nil {
+		return nil, err
+	}
+
+	mainTrie, err := tr.Recreate(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	cw := newChunkWriter(directory, w.maxChunkSize, w.marshaller, w.hasher)
+	err = trie.WalkTrieNodes(ctx, mainTrie, cw.addNode)
+	if err != nil {
+		return nil, err
+	}
+
+	numDataTries, err := trie.WalkDataTries(ctx, mainTrie, rootHash, w.marshaller, func(dataTrie common.Trie) error {
+		return trie.WalkTrieNodes(ctx, dataTrie, cw.addNode)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = cw.flush()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Manifest{
+		Version:      manifestVersion,
+		RootHash:     hex.EncodeToString(rootHash),
+		NumDataTries: numDataTries,
+		Chunks:       cw.getChunks(),
+	}, nil
+}
+
+func (w *snapshotFilesWriter) signManifest(manifest *Manifest) error {
+	signature, err := w.singleSigner.Sign(w.privateKey, manifest.computeDigest(w.hasher))
+	if err != nil {
+		return err
+	}
+
+	manifest.PublicKey = hex.EncodeToString(w.publicKey)
+	manifest.Signature = hex.EncodeToString(signature)
+
+	return nil
+}
+
+// removeOldSnapshots keeps only the configured number of snapshots, the most recent ones being kept. All the
+// snapshots are kept if the configured number is 0
+func (w *snapshotFilesWriter) removeOldSnapshots() {
+	if w.numSnapshotsToKeep == 0 {
+		return
+	}
+
+	entries, err := os.ReadDir(w.directory)
+	if err != nil {
+		log.Warn("could not read the state snapshots directory", "directory", w.directory, "error", err)
+		return
+	}
+
+	type snapshotEntry struct {
+		directory string
+		epoch     uint32
+	}
+	snapshots := make([]snapshotEntry, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasSuffix(entry.Name(), tempDirSuffix) {
+			continue
+		}
+
+		directory := filepath.Join(w.directory, entry.Name())
+		manifest, errLoad := loadManifest(directory)
+		if errLoad != nil {
+			continue
+		}
+
+		snapshots = append(snapshots, snapshotEntry{
+			directory: directory,
+			epoch:     manifest.Epoch,
+		})
+	}
+
+	if len(snapshots) <= int(w.numSnapshotsToKeep) {
+		return
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].epoch > snapshots[j].epoch
+	})
+	for _, snapshot := range snapshots[w.numSnapshotsToKeep:] {
+		err = os.RemoveAll(snapshot.directory)
+		if err != nil {
+			log.Warn("could not remove old state snapshot", "directory", snapshot.directory, "error", err)
+			continue
+		}
+
+		log.Debug("removed old state snapshot", "directory", snapshot.directory, "epoch", snapshot.epoch)
+	}
+}
+
+func recreateDirectory(directory string) error {
+	err := os.RemoveAll(directory)
+	if err != nil {
+		return err
+	}
+
+	return os.MkdirAll(directory, os.ModePerm)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (w *snapshotFilesWriter) IsInterfaceNil() bool {
+	return w == nil
+}
@@ -0,0 +1,310 @@
+package snapshotFiles_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/snapshotFiles"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testMarshaller = &marshallerMock.MarshalizerMock{}
+	testHasher     = &hashingMocks.HasherMock{}
+	testKeyGen     = signing.NewKeyGenerator(ed25519.NewEd25519())
+	testSigner     = &singlesig.Ed25519Signer{}
+)
+
+func createStorageManager(t *testing.T) common.StorageManager {
+	tsm, err := trie.NewTrieStorageManager(storage.GetStorageManagerArgs())
+	require.Nil(t, err)
+
+	return tsm
+}
+
+func createTrie(t *testing.T, tsm common.StorageManager) common.Trie {
+	tr, err := trie.NewTrie(tsm, testMarshaller, testHasher, &enableEpochsHandlerMock.EnableEpochsHandlerStub{}, 5)
+	require.Nil(t, err)
+
+	return tr
+}
+
+// createState creates a main trie holding the given number of accounts. Each account with an odd index has a data
+// trie, the last two accounts sharing the same data trie. It returns the main trie root hash and all the leaves
+func createState(t *testing.T, tsm common.StorageManager, numAccounts int) ([]byte, map[string]map[string]string) {
+	mainTrie := createTrie(t, tsm)
+	leaves := make(map[string]map[string]string)
+
+	var lastDataTrieRootHash []byte
+	for i := 0; i < numAccounts; i++ {
+		address := []byte(fmt.Sprintf("address%025d", i))
+		accountData := &accounts.UserAccountData{
+			Address: address,
+			Nonce:   uint64(i),
+		}
+
+		dataTrieLeaves := make(map[string]string)
+		if i%2 == 1 {
+			dataTrie := createTrie(t, tsm)
+			for j := 0; j < 10; j++ {
+				key := fmt.Sprintf("key%d", j)
+				value := fmt.Sprintf("value%d_%d", i, j)
+				require.Nil(t, dataTrie.Update([]byte(key), []byte(value)))
+				dataTrieLeaves[key] = value
+			}
+			require.Nil(t, dataTrie.Commit())
+
+			accountData.RootHash, _ = dataTrie.RootHash()
+			lastDataTrieRootHash = accountData.RootHash
+		}
+		if i == numAccounts-1 && i%2 == 0 {
+			accountData.RootHash = lastDataTrieRootHash
+			dataTrieLeaves = leaves[string([]byte(fmt.Sprintf("address%025d", i-1)))]
+		}
+		leaves[string(address)] = dataTrieLeaves
+
+		accountBytes, err := testMarshaller.Marshal(accountData)
+		require.Nil(t, err)
+		require.Nil(t, mainTrie.Update(address, accountBytes))
+	}
+	require.Nil(t, mainTrie.Commit())
+
+	rootHash, err := mainTrie.RootHash()
+	require.Nil(t, err)
+
+	return rootHash, leaves
+}
+
+func createKeys() (crypto.PrivateKey, string) {
+	privateKey, publicKey := testKeyGen.GeneratePair()
+	publicKeyBytes, _ := publicKey.ToByteArray()
+
+	return privateKey, hex.EncodeToString(publicKeyBytes)
+}
+
+func createMockWriterArgs(directory string) snapshotFiles.ArgsSnapshotFilesWriter {
+	privateKey, _ := createKeys()
+
+	return snapshotFiles.ArgsSnapshotFilesWriter{
+		Config: config.StateSnapshotFilesConfig{
+			WriteEnabled:        true,
+			Directory:           directory,
+			MaxChunkSizeInBytes: 1024 * 1024,
+			NumSnapshotsToKeep:  2,
+		},
+		Marshaller:           testMarshaller,
+		Hasher:               testHasher,
+		EnableEpochsHandler:  &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+		MaxTrieLevelInMemory: 5,
+		SingleSigner:         testSigner,
+		PrivateKey:           privateKey,
+	}
+}
+
+func readManifest(t *testing.T, directory string, rootHash []byte) *snapshotFiles.Manifest {
+	buff, err := os.ReadFile(filepath.Join(directory, hex.EncodeToString(rootHash), "manifest.json"))
+	require.Nil(t, err)
+
+	manifest := &snapshotFiles.Manifest{}
+	require.Nil(t, json.Unmarshal(buff, manifest))
+
+	return manifest
+}
+
+func TestNewSnapshotFilesWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWriterArgs("")
+		w, err := snapshotFiles.NewSnapshotFilesWriter(args)
+		assert.Nil(t, w)
+		assert.Equal(t, snapshotFiles.ErrEmptyDirectory, err)
+	})
+	t.Run("invalid max chunk size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWriterArgs(t.TempDir())
+		args.Config.MaxChunkSizeInBytes = 0
+		w, err := snapshotFiles.NewSnapshotFilesWriter(args)
+		assert.Nil(t, w)
+		assert.Equal(t, snapshotFiles.ErrInvalidMaxChunkSize, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWriterArgs(t.TempDir())
+		args.Marshaller = nil
+		w, err := snapshotFiles.NewSnapshotFilesWriter(args)
+		assert.Nil(t, w)
+		assert.Equal(t, snapshotFiles.ErrNilMarshaller, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWriterArgs(t.TempDir())
+		args.Hasher = nil
+		w, err := snapshotFiles.NewSnapshotFilesWriter(args)
+		assert.Nil(t, w)
+		assert.Equal(t, snapshotFiles.ErrNilHasher, err)
+	})
+	t.Run("nil enable epochs handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWriterArgs(t.TempDir())
+		args.EnableEpochsHandler = nil
+		w, err := snapshotFiles.NewSnapshotFilesWriter(args)
+		assert.Nil(t, w)
+		assert.Equal(t, snapshotFiles.ErrNilEnableEpochsHandler, err)
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWriterArgs(t.TempDir())
+		args.SingleSigner = nil
+		w, err := snapshotFiles.NewSnapshotFilesWriter(args)
+		assert.Nil(t, w)
+		assert.Equal(t, snapshotFiles.ErrNilSingleSigner, err)
+	})
+	t.Run("nil private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWriterArgs(t.TempDir())
+		args.PrivateKey = nil
+		w, err := snapshotFiles.NewSnapshotFilesWriter(args)
+		assert.Nil(t, w)
+		assert.Equal(t, snapshotFiles.ErrNilPrivateKey, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		w, err := snapshotFiles.NewSnapshotFilesWriter(createMockWriterArgs(t.TempDir()))
+		assert.Nil(t, err)
+		assert.False(t, w.IsInterfaceNil())
+	})
+}
+
+func TestSnapshotFilesWriter_WriteSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trie storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		w, _ := snapshotFiles.NewSnapshotFilesWriter(createMockWriterArgs(t.TempDir()))
+		err := w.WriteSnapshot(context.Background(), []byte("root hash"), 1, nil)
+		assert.Equal(t, snapshotFiles.ErrNilTrieStorageManager, err)
+	})
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		w, _ := snapshotFiles.NewSnapshotFilesWriter(createMockWriterArgs(t.TempDir()))
+		err := w.WriteSnapshot(context.Background(), common.EmptyTrieHash, 1, createStorageManager(t))
+		assert.Equal(t, snapshotFiles.ErrEmptyRootHash, err)
+	})
+	t.Run("missing root node should error and should not leave files behind", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		w, _ := snapshotFiles.NewSnapshotFilesWriter(createMockWriterArgs(directory))
+		err := w.WriteSnapshot(context.Background(), []byte("missing root hash"), 1, createStorageManager(t))
+		assert.NotNil(t, err)
+
+		entries, _ := os.ReadDir(directory)
+		assert.Empty(t, entries)
+	})
+	t.Run("closed context should error and should not leave files behind", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		tsm := createStorageManager(t)
+		rootHash, _ := createState(t, tsm, 11)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		w, _ := snapshotFiles.NewSnapshotFilesWriter(createMockWriterArgs(directory))
+		err := w.WriteSnapshot(ctx, rootHash, 7, tsm)
+		assert.Equal(t, core.ErrContextClosing, err)
+
+		entries, _ := os.ReadDir(directory)
+		assert.Empty(t, entries)
+	})
+	t.Run("should write the chunks and the signed manifest", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		tsm := createStorageManager(t)
+		rootHash, _ := createState(t, tsm, 11)
+
+		args := createMockWriterArgs(directory)
+		args.Config.MaxChunkSizeInBytes = 500
+		w, _ := snapshotFiles.NewSnapshotFilesWriter(args)
+		err := w.WriteSnapshot(context.Background(), rootHash, 7, tsm)
+		require.Nil(t, err)
+
+		manifest := readManifest(t, directory, rootHash)
+		assert.Equal(t, uint32(2), manifest.Version)
+		assert.Equal(t, uint32(7), manifest.Epoch)
+		assert.Equal(t, hex.EncodeToString(rootHash), manifest.RootHash)
+		assert.Equal(t, uint64(5), manifest.NumDataTries)
+		assert.True(t, len(manifest.Chunks) > 1)
+
+		publicKey, _ := args.PrivateKey.GeneratePublic().ToByteArray()
+		assert.Equal(t, hex.EncodeToString(publicKey), manifest.PublicKey)
+		assert.NotEmpty(t, manifest.Signature)
+
+		for _, chunk := range manifest.Chunks {
+			buff, errRead := os.ReadFile(filepath.Join(directory, manifest.RootHash, chunk.Hash+".chunk"))
+			require.Nil(t, errRead)
+			assert.Equal(t, chunk.Hash, hex.EncodeToString(testHasher.Compute(string(buff))))
+			assert.Equal(t, chunk.Size, uint64(len(buff)))
+		}
+	})
+	t.Run("should keep only the most recent snapshots", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		tsm := createStorageManager(t)
+
+		args := createMockWriterArgs(directory)
+		args.Config.NumSnapshotsToKeep = 2
+		w, _ := snapshotFiles.NewSnapshotFilesWriter(args)
+
+		rootHashes := make([][]byte, 0)
+		for i := 1; i <= 3; i++ {
+			rootHash, _ := createState(t, tsm, i)
+			rootHashes = append(rootHashes, rootHash)
+
+			err := w.WriteSnapshot(context.Background(), rootHash, uint32(i), tsm)
+			require.Nil(t, err)
+		}
+
+		entries, _ := os.ReadDir(directory)
+		require.Equal(t, 2, len(entries))
+		_, err := os.Stat(filepath.Join(directory, hex.EncodeToString(rootHashes[0])))
+		assert.True(t, os.IsNotExist(err))
+		_, err = os.Stat(filepath.Join(directory, hex.EncodeToString(rootHashes[1])))
+		assert.Nil(t, err)
+		_, err = os.Stat(filepath.Join(directory, hex.EncodeToString(rootHashes[2])))
+		assert.Nil(t, err)
+	})
+}
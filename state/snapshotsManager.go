@@ -2,6 +2,7 @@ package state
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"sync"
 
@@ -26,6 +27,7 @@ type ArgsNewSnapshotsManager struct {
 	ChannelsProvider         IteratorChannelsProvider
 	StateStatsHandler        StateStatsHandler
 	LastSnapshotMarker       LastSnapshotMarker
+	SnapshotFilesWriter      SnapshotFilesWriter
 }
 
 type snapshotsManager struct {
@@ -36,6 +38,7 @@ type snapshotsManager struct {
 
 	stateMetrics         StateMetrics
 	lastSnapshotMarker   LastSnapshotMarker
+	snapshotFilesWriter  SnapshotFilesWriter
 	marshaller           marshal.Marshalizer
 	addressConverter     core.PubkeyConverter
 	trieSyncer           AccountsDBSyncer
@@ -44,6 +47,10 @@ type snapshotsManager struct {
 	accountFactory       AccountFactory
 	stateStatsHandler    StateStatsHandler
 	mutex                sync.RWMutex
+
+	filesWriterWg     sync.WaitGroup
+	filesWriterCtx    context.Context
+	cancelFilesWriter func()
 }
 
 // NewSnapshotsManager creates a new snapshots manager
@@ -72,6 +79,11 @@ func NewSnapshotsManager(args ArgsNewSnapshotsManager) (*snapshotsManager, error
 	if check.IfNil(args.LastSnapshotMarker) {
 		return nil, ErrNilLastSnapshotMarker
 	}
+	if check.IfNil(args.SnapshotFilesWriter) {
+		return nil, ErrNilSnapshotFilesWriter
+	}
+
+	filesWriterCtx, cancelFilesWriter := context.WithCancel(context.Background())
 
 	return &snapshotsManager{
 		isSnapshotInProgress:     atomic.Flag{},
@@ -88,6 +100,9 @@ func NewSnapshotsManager(args ArgsNewSnapshotsManager) (*snapshotsManager, error
 		accountFactory:           args.AccountFactory,
 		stateStatsHandler:        args.StateStatsHandler,
 		lastSnapshotMarker:       args.LastSnapshotMarker,
+		snapshotFilesWriter:      args.SnapshotFilesWriter,
+		filesWriterCtx:           filesWriterCtx,
+		cancelFilesWriter:        cancelFilesWriter,
 	}, nil
 }
 
@@ -341,9 +356,16 @@ func (sm *snapshotsManager) processSnapshotCompletion(
 	rootHash []byte,
 	epoch uint32,
 ) {
+	// the pruning stays buffered from the end of the snapshot until the snapshot files are written, so the nodes of
+	// the snapshotted root hash can not be pruned by the next epochs before being written
+	trieStorageManager.EnterPruningBufferingMode()
 	sm.finishSnapshotOperation(rootHash, stats, missingNodesCh, sm.stateMetrics.GetSnapshotMessage(), trieStorageManager)
 
+	isWritingSnapshotFiles := false
 	defer func() {
+		if !isWritingSnapshotFiles {
+			trieStorageManager.ExitPruningBufferingMode()
+		}
 		sm.isSnapshotInProgress.Reset()
 		sm.stateMetrics.UpdateMetricsOnSnapshotCompletion(stats)
 		sm.printStorageStatistics()
@@ -364,6 +386,22 @@ func (sm *snapshotsManager) processSnapshotCompletion(
 	log.Debug("set activeDB in epoch", "epoch", epoch)
 	errPut := trieStorageManager.PutInEpochWithoutCache([]byte(common.ActiveDBKey), []byte(common.ActiveDBVal), epoch)
 	handleLoggingWhenError("error while putting active DB value into main storer", errPut)
+
+	isWritingSnapshotFiles = true
+	sm.filesWriterWg.Add(1)
+	go sm.writeSnapshotFiles(rootHash, epoch, trieStorageManager)
+}
+
+// writeSnapshotFiles is called on its own go routine, so the snapshot completion does not wait for the files to be
+// written. The go routine is tracked, so Close cancels it and waits for it to finish
+func (sm *snapshotsManager) writeSnapshotFiles(rootHash []byte, epoch uint32, trieStorageManager common.StorageManager) {
+	defer func() {
+		trieStorageManager.ExitPruningBufferingMode()
+		sm.filesWriterWg.Done()
+	}()
+
+	err := sm.snapshotFilesWriter.WriteSnapshot(sm.filesWriterCtx, rootHash, epoch, trieStorageManager)
+	handleLoggingWhenError("error while writing the state snapshot files", err, "rootHash", rootHash, "epoch", epoch)
 }
 
 func (sm *snapshotsManager) printStorageStatistics() {
@@ -403,6 +441,14 @@ func (sm *snapshotsManager) waitForCompletionIfAppropriate(stats common.Snapshot
 	stats.WaitForSnapshotsToFinish()
 }
 
+// Close cancels the snapshot files writing in progress, if any, and waits for it to finish
+func (sm *snapshotsManager) Close() error {
+	sm.cancelFilesWriter()
+	sm.filesWriterWg.Wait()
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sm *snapshotsManager) IsInterfaceNil() bool {
 	return sm == nil
@@ -1,6 +1,7 @@
 package state_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -19,6 +20,7 @@ import (
 	stateTest "github.com/multiversx/mx-chain-go/testscommon/state"
 	"github.com/multiversx/mx-chain-go/testscommon/storageManager"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func getDefaultSnapshotManagerArgs() state.ArgsNewSnapshotsManager {
@@ -33,6 +35,7 @@ func getDefaultSnapshotManagerArgs() state.ArgsNewSnapshotsManager {
 		ChannelsProvider:         iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
 		StateStatsHandler:        disabled.NewStateStatistics(),
 		LastSnapshotMarker:       lastSnapshotMarker.NewLastSnapshotMarker(),
+		SnapshotFilesWriter:      &stateTest.SnapshotFilesWriterStub{},
 	}
 }
 
@@ -119,6 +122,16 @@ func TestNewSnapshotsManager(t *testing.T) {
 		assert.Nil(t, sm)
 		assert.Equal(t, state.ErrNilLastSnapshotMarker, err)
 	})
+	t.Run("nil snapshot files writer", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultSnapshotManagerArgs()
+		args.SnapshotFilesWriter = nil
+
+		sm, err := state.NewSnapshotsManager(args)
+		assert.Nil(t, sm)
+		assert.Equal(t, state.ErrNilSnapshotFilesWriter, err)
+	})
 	t.Run("ok", func(t *testing.T) {
 		t.Parallel()
 
@@ -512,9 +525,20 @@ func TestSnapshotsManager_SnapshotState(t *testing.T) {
 
 		putInEpochWithoutCacheCalled := false
 		removeFromAllActiveEpochsCalled := false
+		writeSnapshotCalled := make(chan struct{})
+		releaseWriteSnapshot := make(chan struct{})
 
 		args := getDefaultSnapshotManagerArgs()
 		args.ChannelsProvider = iteratorChannelsProvider.NewUserStateIteratorChannelsProvider()
+		args.SnapshotFilesWriter = &stateTest.SnapshotFilesWriterStub{
+			WriteSnapshotCalled: func(_ context.Context, rh []byte, e uint32, _ common.StorageManager) error {
+				assert.Equal(t, rootHash, rh)
+				assert.Equal(t, epoch, e)
+				close(writeSnapshotCalled)
+				<-releaseWriteSnapshot
+				return nil
+			},
+		}
 		sm, _ := state.NewSnapshotsManager(args)
 		_ = sm.SetSyncer(&mock.AccountsDBSyncerStub{})
 		tsm := &storageManager.StorageManagerStub{
@@ -550,5 +574,79 @@ func TestSnapshotsManager_SnapshotState(t *testing.T) {
 
 		assert.True(t, putInEpochWithoutCacheCalled)
 		assert.True(t, removeFromAllActiveEpochsCalled)
+		select {
+		case <-writeSnapshotCalled:
+		case <-time.After(time.Second):
+			assert.Fail(t, "timeout while waiting for the snapshot files to be written")
+		}
+		// the snapshot completed without waiting for the snapshot files to be written
+		close(releaseWriteSnapshot)
 	})
 }
+
+func TestSnapshotsManager_Close(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("rootHash")
+	epoch := uint32(5)
+	writeSnapshotCalled := make(chan struct{})
+	writeSnapshotFinished := atomic.Flag{}
+
+	args := getDefaultSnapshotManagerArgs()
+	args.ChannelsProvider = iteratorChannelsProvider.NewUserStateIteratorChannelsProvider()
+	args.SnapshotFilesWriter = &stateTest.SnapshotFilesWriterStub{
+		WriteSnapshotCalled: func(ctx context.Context, _ []byte, _ uint32, _ common.StorageManager) error {
+			close(writeSnapshotCalled)
+			<-ctx.Done()
+			writeSnapshotFinished.SetValue(true)
+			return ctx.Err()
+		},
+	}
+	sm, _ := state.NewSnapshotsManager(args)
+	_ = sm.SetSyncer(&mock.AccountsDBSyncerStub{})
+
+	mutPruningBuffering := sync.Mutex{}
+	numPruningBufferingOps := 0
+	tsm := &storageManager.StorageManagerStub{
+		GetLatestStorageEpochCalled: func() (uint32, error) {
+			return epoch, nil
+		},
+		ShouldTakeSnapshotCalled: func() bool {
+			return true
+		},
+		TakeSnapshotCalled: func(_ string, _ []byte, _ []byte, channels *common.TrieIteratorChannels, _ chan []byte, stats common.SnapshotStatisticsHandler, _ uint32) {
+			stats.SnapshotFinished()
+			close(channels.LeavesChan)
+		},
+		EnterPruningBufferingModeCalled: func() {
+			mutPruningBuffering.Lock()
+			numPruningBufferingOps++
+			mutPruningBuffering.Unlock()
+		},
+		ExitPruningBufferingModeCalled: func() {
+			mutPruningBuffering.Lock()
+			numPruningBufferingOps--
+			mutPruningBuffering.Unlock()
+		},
+	}
+
+	sm.SnapshotState(rootHash, epoch, tsm)
+	select {
+	case <-writeSnapshotCalled:
+	case <-time.After(time.Second):
+		require.Fail(t, "timeout while waiting for the snapshot files writing to start")
+	}
+
+	// the pruning is still buffered while the snapshot files are written
+	mutPruningBuffering.Lock()
+	assert.Equal(t, 1, numPruningBufferingOps)
+	mutPruningBuffering.Unlock()
+
+	err := sm.Close()
+	assert.Nil(t, err)
+	assert.True(t, writeSnapshotFinished.IsSet())
+
+	mutPruningBuffering.Lock()
+	assert.Equal(t, 0, numPruningBufferingOps)
+	mutPruningBuffering.Unlock()
+}
@@ -54,6 +54,7 @@ func getDefaultTrieAndAccountsDbAndStoragePruningManager() (common.Trie, *state.
 		AccountFactory:       accCreator,
 		ChannelsProvider:     iteratorChannelsProvider.NewUserStateIteratorChannelsProvider(),
 		LastSnapshotMarker:   lastSnapshotMarker.NewLastSnapshotMarker(),
+		SnapshotFilesWriter:  &testStorage.SnapshotFilesWriterStub{},
 		StateStatsHandler:    statistics.NewStateStatistics(),
 	})
 
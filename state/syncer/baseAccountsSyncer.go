@@ -27,6 +27,7 @@ type baseAccountsSyncer struct {
 	timeoutHandler                    trie.TimeoutHandler
 	shardId                           uint32
 	cacher                            storage.Cacher
+	leavesRangesCacher                storage.Cacher
 	maxTrieLevelInMemory              uint
 	name                              string
 	maxHardCapForMissingNodes         int
@@ -50,6 +51,7 @@ type ArgsNewBaseAccountsSyncer struct {
 	RequestHandler                    trie.RequestHandler
 	Timeout                           time.Duration
 	Cacher                            storage.Cacher
+	LeavesRangesCacher                storage.Cacher
 	UserAccountsSyncStatisticsHandler common.SizeSyncStatisticsHandler
 	AppStatusHandler                  core.AppStatusHandler
 	EnableEpochsHandler               common.EnableEpochsHandler
@@ -94,6 +96,7 @@ func checkArgs(args ArgsNewBaseAccountsSyncer) error {
 func (b *baseAccountsSyncer) syncMainTrie(
 	rootHash []byte,
 	trieTopic string,
+	leavesRangeTopic string,
 	ctx context.Context,
 	leavesChan chan core.KeyValueHolder,
 ) error {
@@ -110,6 +113,8 @@ func (b *baseAccountsSyncer) syncMainTrie(
 		Hasher:                    b.hasher,
 		ShardId:                   b.shardId,
 		Topic:                     trieTopic,
+		LeavesRangeTopic:          b.getLeavesRangeTopic(leavesRangeTopic),
+		InterceptedLeavesRanges:   b.leavesRangesCacher,
 		TrieSyncStatistics:        b.userAccountsSyncStatisticsHandler,
 		TimeoutHandler:            b.timeoutHandler,
 		MaxHardCapForMissingNodes: b.maxHardCapForMissingNodes,
@@ -133,6 +138,16 @@ func (b *baseAccountsSyncer) syncMainTrie(
 	return nil
 }
 
+// getLeavesRangeTopic returns the given topic only if the intercepted leaves ranges can be received, otherwise the
+// tries will be synced node by node
+func (b *baseAccountsSyncer) getLeavesRangeTopic(leavesRangeTopic string) string {
+	if check.IfNil(b.leavesRangesCacher) {
+		return ""
+	}
+
+	return leavesRangeTopic
+}
+
 func (b *baseAccountsSyncer) printStatisticsAndUpdateMetrics(ctx context.Context) {
 	lastDataReceived := uint64(0)
 	peakDataReceived := uint64(0)
@@ -85,6 +85,7 @@ func NewUserAccountsSyncer(args ArgsNewUserAccountsSyncer) (*userAccountsSyncer,
 		timeoutHandler:                    timeoutHandler,
 		shardId:                           args.ShardId,
 		cacher:                            args.Cacher,
+		leavesRangesCacher:                args.LeavesRangesCacher,
 		maxTrieLevelInMemory:              args.MaxTrieLevelInMemory,
 		name:                              fmt.Sprintf("user accounts for shard %s", core.GetShardIDString(args.ShardId)),
 		maxHardCapForMissingNodes:         args.MaxHardCapForMissingNodes,
@@ -133,7 +134,7 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker common.
 	wgSyncMainTrie.Add(1)
 
 	go func() {
-		err := u.syncMainTrie(rootHash, factory.AccountTrieNodesTopic, ctx, leavesChannels.LeavesChan)
+		err := u.syncMainTrie(rootHash, factory.AccountTrieNodesTopic, factory.AccountTrieLeavesRangeTopic, ctx, leavesChannels.LeavesChan)
 		if err != nil {
 			leavesChannels.ErrChan.WriteInChanNonBlocking(err)
 		}
@@ -196,6 +197,8 @@ func (u *userAccountsSyncer) createAndStartSyncer(
 		Hasher:                    u.hasher,
 		ShardId:                   u.shardId,
 		Topic:                     factory.AccountTrieNodesTopic,
+		LeavesRangeTopic:          u.getLeavesRangeTopic(factory.AccountTrieLeavesRangeTopic),
+		InterceptedLeavesRanges:   u.leavesRangesCacher,
 		TrieSyncStatistics:        u.userAccountsSyncStatisticsHandler,
 		TimeoutHandler:            u.timeoutHandler,
 		MaxHardCapForMissingNodes: u.maxHardCapForMissingNodes,
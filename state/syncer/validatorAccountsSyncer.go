@@ -43,6 +43,7 @@ func NewValidatorAccountsSyncer(args ArgsNewValidatorAccountsSyncer) (*validator
 		timeoutHandler:                    timeoutHandler,
 		shardId:                           core.MetachainShardId,
 		cacher:                            args.Cacher,
+		leavesRangesCacher:                args.LeavesRangesCacher,
 		maxTrieLevelInMemory:              args.MaxTrieLevelInMemory,
 		name:                              "peer accounts",
 		maxHardCapForMissingNodes:         args.MaxHardCapForMissingNodes,
@@ -83,6 +84,7 @@ func (v *validatorAccountsSyncer) SyncAccounts(rootHash []byte, storageMarker co
 	err := v.syncMainTrie(
 		rootHash,
 		factory.ValidatorTrieNodesTopic,
+		"", // leaves ranges are not requested for validator accounts
 		ctx,
 		nil, // not used for validator accounts syncer
 	)
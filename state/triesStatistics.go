@@ -0,0 +1,161 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/state/parsers"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+)
+
+// ArgsCollectTriesStatistics holds the arguments needed to collect the statistics of a main trie and of its data tries
+type ArgsCollectTriesStatistics struct {
+	MainTrie         common.Trie
+	RootHash         []byte
+	AccountFactory   AccountFactory
+	Marshaller       marshal.Marshalizer
+	AddressConverter core.PubkeyConverter
+	Collector        common.TriesStatisticsCollector
+	// ContinueOnTrieErrors, if set, logs the errors of the tries whose statistics can not be computed and continues
+	// with the next tries, the statistics of each trie being logged as well. Otherwise, the first error is returned
+	ContinueOnTrieErrors bool
+}
+
+// CollectTriesStatistics adds in the provided collector the statistics of the main trie with the given root hash and
+// the statistics of all the data tries of its accounts. The collector can be queried for progress while the
+// statistics are collected
+func CollectTriesStatistics(ctx context.Context, args ArgsCollectTriesStatistics) error {
+	err := checkArgsCollectTriesStatistics(args)
+	if err != nil {
+		return err
+	}
+
+	tr, ok := args.MainTrie.(common.TrieStats)
+	if !ok {
+		return fmt.Errorf("invalid trie, type is %T", args.MainTrie)
+	}
+
+	err = addTrieStats(tr, args, args.RootHash, "", common.MainTrie)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	iteratorChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, leavesChannelSize),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err = args.MainTrie.GetAllLeavesOnChannel(
+		iteratorChannels,
+		ctx,
+		args.RootHash,
+		keyBuilder.NewKeyBuilder(),
+		parsers.NewMainTrieLeafParser(),
+	)
+	if err != nil {
+		return err
+	}
+
+	for leaf := range iteratorChannels.LeavesChan {
+		err = addDataTrieStats(ctx, tr, args, leaf)
+		if err != nil {
+			cancel()
+			drainLeavesChannel(iteratorChannels.LeavesChan)
+			return err
+		}
+	}
+
+	err = iteratorChannels.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return err
+	}
+	if common.IsContextDone(ctx) {
+		return core.ErrContextClosing
+	}
+
+	return nil
+}
+
+func checkArgsCollectTriesStatistics(args ArgsCollectTriesStatistics) error {
+	if check.IfNil(args.MainTrie) {
+		return ErrNilTrie
+	}
+	if check.IfNil(args.AccountFactory) {
+		return ErrNilAccountFactory
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.AddressConverter) {
+		return ErrNilAddressConverter
+	}
+	if args.Collector == nil {
+		return ErrNilTriesStatisticsCollector
+	}
+
+	return nil
+}
+
+func addDataTrieStats(ctx context.Context, tr common.TrieStats, args ArgsCollectTriesStatistics, leaf core.KeyValueHolder) error {
+	if common.IsContextDone(ctx) {
+		return core.ErrContextClosing
+	}
+
+	userAccount, skipAccount, err := getUserAccountFromBytes(args.AccountFactory, args.Marshaller, leaf.Key(), leaf.Value())
+	if err != nil {
+		return err
+	}
+	if skipAccount {
+		return nil
+	}
+	if common.IsEmptyTrie(userAccount.GetRootHash()) {
+		return nil
+	}
+
+	accountAddress, err := args.AddressConverter.Encode(userAccount.AddressBytes())
+	if err != nil {
+		return err
+	}
+
+	return addTrieStats(tr, args, userAccount.GetRootHash(), accountAddress, common.DataTrie)
+}
+
+func addTrieStats(
+	tr common.TrieStats,
+	args ArgsCollectTriesStatistics,
+	rootHash []byte,
+	address string,
+	trieType common.TrieType,
+) error {
+	trieStats, err := tr.GetTrieStats(address, rootHash)
+	if err != nil && args.ContinueOnTrieErrors {
+		log.Debug("could not get the trie statistics", "address", address, "rootHash", rootHash, "error", err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	args.Collector.Add(trieStats, trieType)
+
+	if args.ContinueOnTrieErrors {
+		log.Debug(strings.Join(trieStats.ToString(), " "))
+		return nil
+	}
+
+	log.Trace(strings.Join(trieStats.ToString(), " "))
+
+	return nil
+}
+
+func drainLeavesChannel(leavesChan chan core.KeyValueHolder) {
+	for range leavesChan {
+	}
+}
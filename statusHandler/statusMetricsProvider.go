@@ -2,12 +2,63 @@ package statusHandler
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/debug"
 )
 
+const (
+	prometheusGaugeType   = "gauge"
+	prometheusCounterType = "counter"
+
+	prometheusEventTypeLabel = "event_type"
+	prometheusTopicLabel     = "topic"
+
+	interceptorResolverMetricsPrefix = "erd_debug_interceptor_resolver_"
+)
+
+// prometheusCounterMetrics holds the metrics which only increase during the lifetime of the node
+var prometheusCounterMetrics = map[string]struct{}{
+	common.MetricCountLeader:                  {},
+	common.MetricCountConsensus:               {},
+	common.MetricCountAcceptedBlocks:          {},
+	common.MetricCountConsensusAcceptedBlocks: {},
+	common.MetricNumProcessedTxs:              {},
+	common.MetricTrieSyncNumReceivedBytes:     {},
+	common.MetricTrieSyncNumProcessedNodes:    {},
+}
+
+type interceptorResolverMetric struct {
+	name     string
+	getValue func(counters *debug.InterceptorResolverCounters) int
+}
+
+var interceptorResolverMetrics = []interceptorResolverMetric{
+	{
+		name:     interceptorResolverMetricsPrefix + "num_events",
+		getValue: func(counters *debug.InterceptorResolverCounters) int { return counters.NumEvents },
+	},
+	{
+		name:     interceptorResolverMetricsPrefix + "num_requests_intra",
+		getValue: func(counters *debug.InterceptorResolverCounters) int { return counters.NumReqIntra },
+	},
+	{
+		name:     interceptorResolverMetricsPrefix + "num_requests_cross",
+		getValue: func(counters *debug.InterceptorResolverCounters) int { return counters.NumReqCross },
+	},
+	{
+		name:     interceptorResolverMetricsPrefix + "num_received",
+		getValue: func(counters *debug.InterceptorResolverCounters) int { return counters.NumReceived },
+	},
+	{
+		name:     interceptorResolverMetricsPrefix + "num_processed",
+		getValue: func(counters *debug.InterceptorResolverCounters) int { return counters.NumProcessed },
+	},
+}
+
 // statusMetrics will handle displaying at /node/details all metrics already collected for other status handlers
 type statusMetrics struct {
 	uint64Metrics       map[string]uint64
@@ -202,17 +253,127 @@ func (sm *statusMetrics) addPrometheusMetricToStringBuilder(builder *strings.Bui
 		return
 	}
 
-	if key == common.MetricNoncesPassedInCurrentEpoch {
-		sm.mutUint64Operations.RLock()
-		value = computeDelta(sm.uint64Metrics[common.MetricNonce], sm.uint64Metrics[common.MetricNonceAtEpochStart])
-		sm.mutUint64Operations.RUnlock()
+	value = sm.computeMetricValueAtCallTime(key, value)
+	builder.WriteString(fmt.Sprintf("%s{%s=\"%d\"} %v\n", key, common.MetricShardId, shardID, value))
+}
+
+func (sm *statusMetrics) computeMetricValueAtCallTime(key string, value interface{}) interface{} {
+	sm.mutUint64Operations.RLock()
+	defer sm.mutUint64Operations.RUnlock()
+
+	switch key {
+	case common.MetricNoncesPassedInCurrentEpoch:
+		return computeDelta(sm.uint64Metrics[common.MetricNonce], sm.uint64Metrics[common.MetricNonceAtEpochStart])
+	case common.MetricRoundsPassedInCurrentEpoch:
+		return computeDelta(sm.uint64Metrics[common.MetricCurrentRound], sm.uint64Metrics[common.MetricRoundAtEpochStart])
+	default:
+		return value
+	}
+}
+
+// StatusMetricsPrometheusString returns all the numeric metrics, including the p2p ones, and the provided
+// interceptor-resolver debug counters in the prometheus text exposition format. Each metric is typed as a gauge or
+// a counter and is labeled with the shard, the epoch and the peer type of the node
+func (sm *statusMetrics) StatusMetricsPrometheusString(debugCounters []*debug.InterceptorResolverCounters) (string, error) {
+	metrics := sm.getMetricsWithKeyFilterMutexProtected(func(_ string) bool {
+		return true
+	})
+
+	keys := make([]string, 0, len(metrics))
+	for key, value := range metrics {
+		switch value.(type) {
+		case int64, uint64:
+			keys = append(keys, key)
+		}
 	}
-	if key == common.MetricRoundsPassedInCurrentEpoch {
-		sm.mutUint64Operations.RLock()
-		value = computeDelta(sm.uint64Metrics[common.MetricCurrentRound], sm.uint64Metrics[common.MetricRoundAtEpochStart])
-		sm.mutUint64Operations.RUnlock()
+	sort.Strings(keys)
+
+	labels := sm.getPrometheusLabels()
+	stringBuilder := strings.Builder{}
+	for _, key := range keys {
+		name := sanitizePrometheusMetricName(key)
+		value := sm.computeMetricValueAtCallTime(key, metrics[key])
+
+		writePrometheusType(&stringBuilder, name, getPrometheusMetricType(key))
+		writePrometheusSample(&stringBuilder, name, labels, value)
 	}
-	builder.WriteString(fmt.Sprintf("%s{%s=\"%d\"} %v\n", key, common.MetricShardId, shardID, value))
+
+	addInterceptorResolverCountersToStringBuilder(&stringBuilder, labels, debugCounters)
+
+	return stringBuilder.String(), nil
+}
+
+func (sm *statusMetrics) getPrometheusLabels() string {
+	sm.mutUint64Operations.RLock()
+	shardID := sm.uint64Metrics[common.MetricShardId]
+	epoch := sm.uint64Metrics[common.MetricEpochNumber]
+	sm.mutUint64Operations.RUnlock()
+
+	sm.mutStringOperations.RLock()
+	peerType := sm.stringMetrics[common.MetricPeerType]
+	sm.mutStringOperations.RUnlock()
+
+	return fmt.Sprintf("%s=\"%d\",%s=\"%d\",%s=\"%s\"",
+		common.MetricShardId, shardID,
+		common.MetricEpochNumber, epoch,
+		common.MetricPeerType, escapePrometheusLabelValue(peerType),
+	)
+}
+
+func addInterceptorResolverCountersToStringBuilder(
+	builder *strings.Builder,
+	labels string,
+	debugCounters []*debug.InterceptorResolverCounters,
+) {
+	if len(debugCounters) == 0 {
+		return
+	}
+
+	for _, metric := range interceptorResolverMetrics {
+		writePrometheusType(builder, metric.name, prometheusGaugeType)
+		for _, counters := range debugCounters {
+			counterLabels := fmt.Sprintf("%s,%s=\"%s\",%s=\"%s\"",
+				labels,
+				prometheusEventTypeLabel, escapePrometheusLabelValue(counters.EventType),
+				prometheusTopicLabel, escapePrometheusLabelValue(counters.Topic),
+			)
+			writePrometheusSample(builder, metric.name, counterLabels, metric.getValue(counters))
+		}
+	}
+}
+
+func getPrometheusMetricType(key string) string {
+	_, isCounter := prometheusCounterMetrics[key]
+	if isCounter {
+		return prometheusCounterType
+	}
+
+	return prometheusGaugeType
+}
+
+func writePrometheusType(builder *strings.Builder, name string, metricType string) {
+	builder.WriteString(fmt.Sprintf("# TYPE %s %s\n", name, metricType))
+}
+
+func writePrometheusSample(builder *strings.Builder, name string, labels string, value interface{}) {
+	builder.WriteString(fmt.Sprintf("%s{%s} %v\n", name, labels, value))
+}
+
+// sanitizePrometheusMetricName replaces the characters not allowed in a prometheus metric name with underscores
+func sanitizePrometheusMetricName(name string) string {
+	return strings.Map(func(r rune) rune {
+		isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		isDigit := r >= '0' && r <= '9'
+		if isLetter || isDigit || r == '_' || r == ':' {
+			return r
+		}
+
+		return '_'
+	}, name)
+}
+
+func escapePrometheusLabelValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
 }
 
 // EconomicsMetrics returns the economics related metrics
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/statusHandler"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -166,6 +167,101 @@ func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldComputeRound
 	assert.Contains(t, strRes, `erd_nonces_passed_in_current_epoch{erd_shard_id="2"} 38`)
 }
 
+func TestStatusMetrics_StatusMetricsPrometheusString(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should type and label all numeric metrics", func(t *testing.T) {
+		t.Parallel()
+
+		sm := statusHandler.NewStatusMetrics()
+		sm.SetUInt64Value(common.MetricShardId, 1)
+		sm.SetUInt64Value(common.MetricEpochNumber, 7)
+		sm.SetStringValue(common.MetricPeerType, "eligible")
+		sm.SetUInt64Value(common.MetricNonce, 138)
+		sm.SetInt64Value("test-key", -5)
+		sm.SetUInt64Value(common.MetricCountConsensus, 10)
+		sm.SetUInt64Value(common.MetricTrieSyncNumProcessedNodes, 1000)
+		sm.SetUInt64Value(common.MetricP2PPeakNumReceiverPeers+"_output", 3)
+		sm.SetStringValue(common.MetricAppVersion, "v1.0.0")
+
+		strRes, err := sm.StatusMetricsPrometheusString(nil)
+		require.Nil(t, err)
+
+		labels := `{erd_shard_id="1",erd_epoch_number="7",erd_peer_type="eligible"}`
+		assert.Contains(t, strRes, "# TYPE erd_nonce gauge\nerd_nonce"+labels+" 138\n")
+		assert.Contains(t, strRes, "# TYPE test_key gauge\ntest_key"+labels+" -5\n")
+		assert.Contains(t, strRes, "# TYPE erd_count_consensus counter\nerd_count_consensus"+labels+" 10\n")
+		assert.Contains(t, strRes, "# TYPE erd_trie_sync_num_nodes_processed counter\nerd_trie_sync_num_nodes_processed"+labels+" 1000\n")
+		assert.Contains(t, strRes, "# TYPE erd_p2p_peak_num_receiver_peers_output gauge\nerd_p2p_peak_num_receiver_peers_output"+labels+" 3\n")
+		assert.NotContains(t, strRes, common.MetricAppVersion)
+		assert.NotContains(t, strRes, "erd_debug_interceptor_resolver")
+	})
+	t.Run("should compute rounds and nonces passed in epoch", func(t *testing.T) {
+		t.Parallel()
+
+		sm := statusHandler.NewStatusMetrics()
+		sm.SetUInt64Value(common.MetricRoundsPassedInCurrentEpoch, 0)
+		sm.SetUInt64Value(common.MetricNoncesPassedInCurrentEpoch, 0)
+		sm.SetUInt64Value(common.MetricRoundAtEpochStart, 100)
+		sm.SetUInt64Value(common.MetricCurrentRound, 137)
+		sm.SetUInt64Value(common.MetricNonceAtEpochStart, 100)
+		sm.SetUInt64Value(common.MetricNonce, 138)
+
+		strRes, _ := sm.StatusMetricsPrometheusString(nil)
+
+		labels := `{erd_shard_id="0",erd_epoch_number="0",erd_peer_type=""}`
+		assert.Contains(t, strRes, "erd_rounds_passed_in_current_epoch"+labels+" 37\n")
+		assert.Contains(t, strRes, "erd_nonces_passed_in_current_epoch"+labels+" 38\n")
+	})
+	t.Run("should add the interceptor-resolver debug counters", func(t *testing.T) {
+		t.Parallel()
+
+		sm := statusHandler.NewStatusMetrics()
+		sm.SetUInt64Value(common.MetricShardId, 2)
+		debugCounters := []*debug.InterceptorResolverCounters{
+			{
+				EventType:    "request",
+				Topic:        "transactions_2",
+				NumEvents:    2,
+				NumReqIntra:  3,
+				NumReqCross:  4,
+				NumReceived:  5,
+				NumProcessed: 6,
+			},
+			{
+				EventType:   "resolve",
+				Topic:       "shardBlocks_2_META",
+				NumEvents:   1,
+				NumReceived: 7,
+			},
+		}
+
+		strRes, _ := sm.StatusMetricsPrometheusString(debugCounters)
+
+		requestLabels := `{erd_shard_id="2",erd_epoch_number="0",erd_peer_type="",event_type="request",topic="transactions_2"}`
+		resolveLabels := `{erd_shard_id="2",erd_epoch_number="0",erd_peer_type="",event_type="resolve",topic="shardBlocks_2_META"}`
+		assert.Contains(t, strRes, "# TYPE erd_debug_interceptor_resolver_num_events gauge\n"+
+			"erd_debug_interceptor_resolver_num_events"+requestLabels+" 2\n"+
+			"erd_debug_interceptor_resolver_num_events"+resolveLabels+" 1\n")
+		assert.Contains(t, strRes, "erd_debug_interceptor_resolver_num_requests_intra"+requestLabels+" 3\n")
+		assert.Contains(t, strRes, "erd_debug_interceptor_resolver_num_requests_cross"+requestLabels+" 4\n")
+		assert.Contains(t, strRes, "erd_debug_interceptor_resolver_num_received"+requestLabels+" 5\n")
+		assert.Contains(t, strRes, "erd_debug_interceptor_resolver_num_processed"+requestLabels+" 6\n")
+		assert.Contains(t, strRes, "erd_debug_interceptor_resolver_num_received"+resolveLabels+" 7\n")
+	})
+	t.Run("should escape label values", func(t *testing.T) {
+		t.Parallel()
+
+		sm := statusHandler.NewStatusMetrics()
+		sm.SetStringValue(common.MetricPeerType, `a "quoted" \ value`)
+		sm.SetUInt64Value(common.MetricNonce, 1)
+
+		strRes, _ := sm.StatusMetricsPrometheusString(nil)
+
+		assert.Contains(t, strRes, `erd_peer_type="a \"quoted\" \\ value"`)
+	})
+}
+
 func TestStatusMetrics_NetworkConfig(t *testing.T) {
 	t.Parallel()
 
@@ -575,7 +671,7 @@ func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 
 	for i := 0; i < numIterations; i++ {
 		go func(idx int) {
-			switch idx % 14 {
+			switch idx % 15 {
 			case 0:
 				sm.AddUint64("test", uint64(idx))
 			case 1:
@@ -604,6 +700,8 @@ func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 				_, _ = sm.StatusP2pMetricsMap()
 			case 13:
 				_, _ = sm.BootstrapMetrics()
+			case 14:
+				_, _ = sm.StatusMetricsPrometheusString(nil)
 			}
 			wg.Done()
 		}(i)
@@ -2,6 +2,7 @@ package database
 
 import (
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/pebbledb"
 	"github.com/multiversx/mx-chain-storage-go/leveldb"
 	"github.com/multiversx/mx-chain-storage-go/memorydb"
 	"github.com/multiversx/mx-chain-storage-go/sharded"
@@ -33,6 +34,12 @@ func NewSerialDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFi
 	return leveldb.NewSerialDB(path, batchDelaySeconds, maxBatchSize, maxOpenFiles)
 }
 
+// NewPebbleDB is a constructor for the pebble persister
+// It creates the files in the location given as parameter
+func NewPebbleDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (s *pebbledb.DB, err error) {
+	return pebbledb.NewDB(path, batchDelaySeconds, maxBatchSize, maxOpenFiles)
+}
+
 // NewShardIDProvider is a constructor for shard id provider
 func NewShardIDProvider(numShards int32) (storage.ShardIDProvider, error) {
 	return sharded.NewShardIDProvider(numShards)
@@ -82,6 +82,9 @@ var ErrNotSupportedCacheType = storageErrors.ErrNotSupportedCacheType
 // ErrDBIsClosed is raised when the DB is closed
 var ErrDBIsClosed = storageErrors.ErrDBIsClosed
 
+// ErrInvalidNumOpenFiles is raised when the max num of open files is less than 1
+var ErrInvalidNumOpenFiles = storageErrors.ErrInvalidNumOpenFiles
+
 // ErrEpochKeepIsLowerThanNumActive signals that num epochs to keep is lower than num active epochs
 var ErrEpochKeepIsLowerThanNumActive = errors.New("num epochs to keep is lower than num active epochs")
 
@@ -115,6 +118,9 @@ var ErrNilBootstrapDataProvider = errors.New("nil bootstrap data provider")
 // ErrNilDirectoryReader signals that a nil directory reader has been provided
 var ErrNilDirectoryReader = errors.New("nil directory reader")
 
+// ErrReadOnlyStorage signals that a write operation was attempted on a read only storage
+var ErrReadOnlyStorage = errors.New("read only storage")
+
 // IsNotFoundInStorageErr returns whether an error is a "not found in storage" error.
 // Currently, "item not found" storage errors are untyped (thus not distinguishable from others). E.g. see "pruningStorer.go".
 // As a workaround, we test the error message for a match.
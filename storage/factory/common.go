@@ -1,7 +1,10 @@
 package factory
 
 import (
+	"path"
+
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 )
 
@@ -27,3 +30,21 @@ func GetDBFromConfig(cfg config.DBConfig) storageunit.DBConfig {
 		MaxOpenFiles:      cfg.MaxOpenFiles,
 	}
 }
+
+// CreateStorerInFolder will create a storage unit from the provided config, its database being placed under the provided folder
+func CreateStorerInFolder(storageConfig config.StorageConfig, folder string) (storage.Storer, error) {
+	dbConfig := GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = path.Join(folder, storageConfig.DB.FilePath)
+
+	dbConfigHandler := NewDBConfigHandler(storageConfig.DB)
+	persisterFactory, err := NewPersisterFactory(dbConfigHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageunit.NewStorageUnitFromConf(
+		GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+		persisterFactory,
+	)
+}
@@ -1,11 +1,13 @@
 package factory
 
 import (
+	"path"
 	"testing"
 
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetCacherFromConfig(t *testing.T) {
@@ -45,3 +47,30 @@ func TestGetDBFromConfig(t *testing.T) {
 		MaxOpenFiles:      cfg.MaxOpenFiles,
 	}, storageDBConfig)
 }
+
+func TestCreateStorerInFolder(t *testing.T) {
+	t.Parallel()
+
+	storageConfig := config.StorageConfig{
+		Cache: config.CacheConfig{
+			Type:     "LRU",
+			Capacity: 10,
+			Shards:   1,
+		},
+		DB: config.DBConfig{
+			FilePath:          "Storer",
+			Type:              string(storageunit.LvlDBSerial),
+			MaxBatchSize:      1,
+			BatchDelaySeconds: 1,
+			MaxOpenFiles:      10,
+		},
+	}
+	folder := t.TempDir()
+
+	storer, err := CreateStorerInFolder(storageConfig, folder)
+	require.Nil(t, err)
+	require.Nil(t, storer.Put([]byte("key"), []byte("value")))
+	require.Nil(t, storer.Close())
+
+	assert.DirExists(t, path.Join(folder, storageConfig.DB.FilePath))
+}
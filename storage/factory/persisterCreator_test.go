@@ -123,6 +123,22 @@ func TestPersisterCreator_CreateBasePersister(t *testing.T) {
 
 		assert.True(t, strings.Contains(fmt.Sprintf("%T", p), "*memorydb.DB"))
 	})
+
+	t.Run("pebbledb", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := createDefaultBasePersisterConfig()
+		dbConfig.Type = string(storageunit.PebbleDB)
+		pc := factory.NewPersisterCreator(dbConfig)
+
+		dir := t.TempDir()
+		p, err := pc.CreateBasePersister(dir)
+		require.NotNil(t, p)
+		require.Nil(t, err)
+		_ = p.Close()
+
+		assert.True(t, strings.Contains(fmt.Sprintf("%T", p), "*pebbledb.DB"))
+	})
 }
 
 func TestPersisterCreator_CreateShardIDProvider(t *testing.T) {
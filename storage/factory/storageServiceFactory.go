@@ -596,9 +596,44 @@ func (psf *StorageServiceFactory) setUpDbLookupExtensions(chainStorer *dataRetri
 
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
+	err = psf.setUpAccountsHistoryStorer(chainStorer, shardID)
+	if err != nil {
+		return err
+	}
+
 	return psf.setUpEsdtSuppliesStorer(chainStorer, shardID)
 }
 
+func (psf *StorageServiceFactory) setUpAccountsHistoryStorer(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
+	if !psf.generalConfig.DbLookupExtensions.AccountsHistoryEnabled {
+		return nil
+	}
+
+	// Create the accountsHistory (STATIC) storer
+	accountsHistoryConfig := psf.generalConfig.DbLookupExtensions.AccountsHistoryStorageConfig
+	accountsHistoryDbConfig := GetDBFromConfig(accountsHistoryConfig.DB)
+	accountsHistoryDbConfig.FilePath = psf.pathManager.PathForStatic(shardIDStr, accountsHistoryConfig.DB.FilePath)
+	accountsHistoryCacherConfig := GetCacherFromConfig(accountsHistoryConfig.Cache)
+
+	dbConfigHandlerInstance := NewDBConfigHandler(accountsHistoryConfig.DB)
+	accountsHistoryPersisterCreator, err := NewPersisterFactory(dbConfigHandlerInstance)
+	if err != nil {
+		return err
+	}
+
+	accountsHistoryUnit, err := storageunit.NewStorageUnitFromConf(
+		accountsHistoryCacherConfig,
+		accountsHistoryDbConfig,
+		accountsHistoryPersisterCreator,
+	)
+	if err != nil {
+		return fmt.Errorf("%w for DbLookupExtensions.AccountsHistoryStorageConfig", err)
+	}
+
+	chainStorer.AddStorer(dataRetriever.AccountsHistoryUnit, accountsHistoryUnit)
+	return nil
+}
+
 func (psf *StorageServiceFactory) setUpEsdtSuppliesStorer(chainStorer *dataRetriever.ChainStorer, shardIDStr string) error {
 	esdtSuppliesUnit, err := psf.createEsdtSuppliesUnit(shardIDStr)
 	if err != nil {
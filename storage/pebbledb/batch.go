@@ -0,0 +1,106 @@
+package pebbledb
+
+import (
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+var _ storage.Batcher = (*batch)(nil)
+
+// batch holds the pending writes until they are committed in a single pebble batch. As a key is either put or
+// removed, the last operation done on a key is the only one which has to be written
+type batch struct {
+	cachedData  map[string][]byte
+	removedData map[string]struct{}
+	mutBatch    sync.RWMutex
+}
+
+// newBatch creates a batch
+func newBatch() *batch {
+	return &batch{
+		cachedData:  make(map[string][]byte),
+		removedData: make(map[string]struct{}),
+	}
+}
+
+// Put inserts one entry - key, value pair - into the batch
+func (b *batch) Put(key []byte, val []byte) error {
+	b.mutBatch.Lock()
+	b.cachedData[string(key)] = val
+	delete(b.removedData, string(key))
+	b.mutBatch.Unlock()
+
+	return nil
+}
+
+// Delete deletes the entry for the provided key from the batch
+func (b *batch) Delete(key []byte) error {
+	b.mutBatch.Lock()
+	b.removedData[string(key)] = struct{}{}
+	delete(b.cachedData, string(key))
+	b.mutBatch.Unlock()
+
+	return nil
+}
+
+// Reset clears the contents of the batch
+func (b *batch) Reset() {
+	b.mutBatch.Lock()
+	b.cachedData = make(map[string][]byte)
+	b.removedData = make(map[string]struct{})
+	b.mutBatch.Unlock()
+}
+
+// Get returns the value
+func (b *batch) Get(key []byte) []byte {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return b.cachedData[string(key)]
+}
+
+// IsRemoved returns true if the key is marked for removal
+func (b *batch) IsRemoved(key []byte) bool {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	_, found := b.removedData[string(key)]
+
+	return found
+}
+
+// writeTo adds all the pending operations to the provided pebble batch
+func (b *batch) writeTo(pebbleBatch *pebble.Batch) error {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	for key, val := range b.cachedData {
+		err := pebbleBatch.Set([]byte(key), val, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	for key := range b.removedData {
+		err := pebbleBatch.Delete([]byte(key), nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *batch) isEmpty() bool {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return len(b.cachedData) == 0 && len(b.removedData) == 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *batch) IsInterfaceNil() bool {
+	return b == nil
+}
@@ -0,0 +1,327 @@
+package pebbledb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var _ storage.Persister = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+var log = logger.GetOrCreate("storage/pebbledb")
+
+// loggingDBCounter this variable should be used only used in logging prints
+var loggingDBCounter = uint32(0)
+
+// DB holds a pointer to the pebble database and the path to where it is stored
+type DB struct {
+	mutDb             sync.RWMutex
+	db                *pebble.DB
+	path              string
+	maxBatchSize      int
+	batchDelaySeconds int
+	sizeBatch         int
+	batch             *batch
+	mutBatch          sync.RWMutex
+	cancel            context.CancelFunc
+}
+
+// NewDB is a constructor for the pebble persister
+// It creates the files in the location given as parameter
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (*DB, error) {
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+
+	sw := core.NewStopWatch()
+	sw.Start("NewDB")
+
+	err := os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &pebble.Options{
+		MaxOpenFiles: maxOpenFiles,
+		Logger:       &pebbleLogger{path: path},
+	}
+
+	db, err := pebble.Open(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dbStore := &DB{
+		db:                db,
+		path:              path,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		batch:             newBatch(),
+		cancel:            cancel,
+	}
+
+	go dbStore.batchTimeoutHandle(ctx)
+
+	runtime.SetFinalizer(dbStore, func(db *DB) {
+		_ = db.Close()
+	})
+
+	crtCounter := atomic.AddUint32(&loggingDBCounter, 1)
+	sw.Stop("NewDB")
+
+	logArguments := []interface{}{"path", path, "created pointer", fmt.Sprintf("%p", db), "global db counter", crtCounter}
+	logArguments = append(logArguments, sw.GetMeasurements()...)
+	log.Debug("opened pebble db persister", logArguments...)
+
+	return dbStore, nil
+}
+
+func (s *DB) batchTimeoutHandle(ctx context.Context) {
+	interval := time.Duration(s.batchDelaySeconds) * time.Second
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(interval)
+
+		select {
+		case <-timer.C:
+			s.mutBatch.Lock()
+			err := s.putBatch(s.batch)
+			if err != nil {
+				log.Warn("pebble putBatch", "error", err.Error())
+				s.mutBatch.Unlock()
+				continue
+			}
+
+			s.batch.Reset()
+			s.sizeBatch = 0
+			s.mutBatch.Unlock()
+		case <-ctx.Done():
+			log.Debug("closing the timed batch handler", "path", s.path)
+			return
+		}
+	}
+}
+
+func (s *DB) updateBatchWithIncrement() error {
+	s.mutBatch.Lock()
+	defer s.mutBatch.Unlock()
+
+	s.sizeBatch++
+	if s.sizeBatch < s.maxBatchSize {
+		return nil
+	}
+
+	err := s.putBatch(s.batch)
+	if err != nil {
+		log.Warn("pebble putBatch", "error", err.Error())
+		return err
+	}
+
+	s.batch.Reset()
+	s.sizeBatch = 0
+
+	return nil
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	s.mutBatch.RLock()
+	err := s.batch.Put(key, val)
+	s.mutBatch.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	return s.updateBatchWithIncrement()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	if s.batch.IsRemoved(key) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	data := s.batch.Get(key)
+	if data != nil {
+		return data, nil
+	}
+
+	value, closer, err := s.db.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the returned value is valid only until the closer is closed
+	data = make([]byte, len(value))
+	copy(data, value)
+
+	return data, closer.Close()
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	_, err := s.Get(key)
+	return err
+}
+
+// putBatch writes the Batch data into the database
+func (s *DB) putBatch(b *batch) error {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return storage.ErrDBIsClosed
+	}
+	if b.isEmpty() {
+		return nil
+	}
+
+	pebbleBatch := s.db.NewBatch()
+	defer func() {
+		_ = pebbleBatch.Close()
+	}()
+
+	err := b.writeTo(pebbleBatch)
+	if err != nil {
+		return err
+	}
+
+	return pebbleBatch.Commit(pebble.Sync)
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return
+	}
+
+	iterator, err := s.db.NewIter(nil)
+	if err != nil {
+		log.Warn("pebble RangeKeys", "path", s.path, "error", err.Error())
+		return
+	}
+
+	for iterator.First(); iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		clonedKey := make([]byte, len(key))
+		copy(clonedKey, key)
+
+		val := iterator.Value()
+		clonedVal := make([]byte, len(val))
+		copy(clonedVal, val)
+
+		shouldContinue := handler(clonedKey, clonedVal)
+		if !shouldContinue {
+			break
+		}
+	}
+
+	err = iterator.Close()
+	if err != nil {
+		log.Warn("pebble RangeKeys", "path", s.path, "error", err.Error())
+	}
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	s.mutBatch.Lock()
+	_ = s.putBatch(s.batch)
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		return db.Close()
+	}
+
+	return nil
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	s.mutBatch.Lock()
+	_ = s.batch.Delete(key)
+	s.mutBatch.Unlock()
+
+	return s.updateBatchWithIncrement()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	s.mutBatch.Lock()
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		err := db.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+func (s *DB) makeDbPointerNilReturningLast() *pebble.DB {
+	s.mutDb.Lock()
+	defer s.mutDb.Unlock()
+
+	if s.db != nil {
+		crtCounter := atomic.AddUint32(&loggingDBCounter, ^uint32(0)) // subtract 1
+		log.Debug("makeDbPointerNilReturningLast", "path", s.path, "nilled pointer", fmt.Sprintf("%p", s.db), "global db counter", crtCounter)
+	}
+
+	db := s.db
+	s.db = nil
+
+	return db
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,371 @@
+package pebbledb_test
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/pebbledb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPebbleDB(t *testing.T, batchDelaySeconds int, maxBatchSize int) *pebbledb.DB {
+	db, err := pebbledb.NewDB(t.TempDir(), batchDelaySeconds, maxBatchSize, 10)
+	require.Nil(t, err)
+
+	return db
+}
+
+func TestNewDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max open files should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := pebbledb.NewDB(t.TempDir(), 1, 1, 0)
+		assert.Nil(t, db)
+		assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+	})
+	t.Run("double open should error", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		db, err := pebbledb.NewDB(dir, 10, 1, 10)
+		require.Nil(t, err)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		_, err = pebbledb.NewDB(dir, 10, 1, 10)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := pebbledb.NewDB(t.TempDir(), 10, 1, 10)
+		assert.Nil(t, err)
+		assert.False(t, db.IsInterfaceNil())
+		_ = db.Close()
+	})
+}
+
+func TestDB_PutGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("value in batch should be found before writing the batch", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDB(t, 10, 100)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		key, val := []byte("key"), []byte("value")
+		err := db.Put(key, val)
+		require.Nil(t, err)
+
+		recovered, err := db.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, val, recovered)
+		assert.Nil(t, db.Has(key))
+	})
+	t.Run("value should be found after writing the batch", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDB(t, 10, 1)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		key, val := []byte("key"), []byte("value")
+		err := db.Put(key, val)
+		require.Nil(t, err)
+
+		recovered, err := db.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, val, recovered)
+		assert.Nil(t, db.Has(key))
+	})
+	t.Run("batch should be written after the delay", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		db, err := pebbledb.NewDB(dir, 1, 100, 10)
+		require.Nil(t, err)
+
+		key, val := []byte("key"), []byte("value")
+		err = db.Put(key, val)
+		require.Nil(t, err)
+
+		time.Sleep(time.Second * 2)
+
+		numKeys := 0
+		db.RangeKeys(func(_ []byte, _ []byte) bool {
+			numKeys++
+			return true
+		})
+		assert.Equal(t, 1, numKeys)
+		_ = db.Close()
+	})
+	t.Run("missing key should error", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDB(t, 10, 1)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		recovered, err := db.Get([]byte("missing key"))
+		assert.Nil(t, recovered)
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+		assert.Equal(t, storage.ErrKeyNotFound, db.Has([]byte("missing key")))
+	})
+	t.Run("values should persist after reopening", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		db, err := pebbledb.NewDB(dir, 10, 100, 10)
+		require.Nil(t, err)
+
+		key, val := []byte("key"), []byte("value")
+		err = db.Put(key, val)
+		require.Nil(t, err)
+		err = db.Close()
+		require.Nil(t, err)
+
+		db, err = pebbledb.NewDB(dir, 10, 100, 10)
+		require.Nil(t, err)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		recovered, err := db.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, val, recovered)
+	})
+}
+
+func TestDB_Remove(t *testing.T) {
+	t.Parallel()
+
+	t.Run("remove before writing the batch", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDB(t, 10, 100)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		key := []byte("key")
+		_ = db.Put(key, []byte("value"))
+		err := db.Remove(key)
+		assert.Nil(t, err)
+
+		recovered, err := db.Get(key)
+		assert.Nil(t, recovered)
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+	})
+	t.Run("remove after writing the batch", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDB(t, 10, 1)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		key := []byte("key")
+		_ = db.Put(key, []byte("value"))
+		err := db.Remove(key)
+		assert.Nil(t, err)
+
+		recovered, err := db.Get(key)
+		assert.Nil(t, recovered)
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+	})
+	t.Run("put after remove should work", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDB(t, 10, 100)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		key, val := []byte("key"), []byte("value")
+		_ = db.Remove(key)
+		_ = db.Put(key, val)
+
+		recovered, err := db.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, val, recovered)
+	})
+}
+
+func TestDB_RangeKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil handler should not panic", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDB(t, 10, 1)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		assert.NotPanics(t, func() {
+			db.RangeKeys(nil)
+		})
+	})
+	t.Run("should iterate all the keys", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDB(t, 10, 1)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		keysVals := map[string][]byte{
+			"key1": []byte("value1"),
+			"key2": []byte("value2"),
+			"key3": []byte("value3"),
+		}
+		for key, val := range keysVals {
+			_ = db.Put([]byte(key), val)
+		}
+
+		recovered := make(map[string][]byte)
+		db.RangeKeys(func(key []byte, value []byte) bool {
+			recovered[string(key)] = value
+			return true
+		})
+		assert.Equal(t, keysVals, recovered)
+	})
+	t.Run("should stop when the handler returns false", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDB(t, 10, 1)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		for i := 0; i < 10; i++ {
+			_ = db.Put([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+		}
+
+		numKeys := 0
+		db.RangeKeys(func(_ []byte, _ []byte) bool {
+			numKeys++
+			return numKeys < 3
+		})
+		assert.Equal(t, 3, numKeys)
+	})
+}
+
+func TestDB_MethodCallsAfterCloseOrDestroy(t *testing.T) {
+	t.Parallel()
+
+	testMethodCalls := func(t *testing.T, db *pebbledb.DB) {
+		assert.NotPanics(t, func() {
+			_, err := db.Get([]byte("key"))
+			assert.Equal(t, storage.ErrDBIsClosed, err)
+
+			err = db.Has([]byte("key"))
+			assert.Equal(t, storage.ErrDBIsClosed, err)
+
+			err = db.Put([]byte("key"), []byte("value"))
+			assert.Equal(t, storage.ErrDBIsClosed, err)
+
+			err = db.Remove([]byte("key"))
+			assert.Equal(t, storage.ErrDBIsClosed, err)
+
+			db.RangeKeys(func(_ []byte, _ []byte) bool {
+				assert.Fail(t, "should have not been called")
+				return true
+			})
+
+			err = db.Close()
+			assert.Nil(t, err)
+		})
+	}
+
+	t.Run("close", func(t *testing.T) {
+		t.Parallel()
+
+		db := createPebbleDB(t, 10, 1)
+		err := db.Close()
+		require.Nil(t, err)
+
+		testMethodCalls(t, db)
+	})
+	t.Run("destroy", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		db, err := pebbledb.NewDB(dir, 10, 1, 10)
+		require.Nil(t, err)
+
+		err = db.Destroy()
+		require.Nil(t, err)
+
+		_, err = os.Stat(dir)
+		assert.True(t, os.IsNotExist(err))
+
+		testMethodCalls(t, db)
+	})
+}
+
+func TestDB_DestroyClosed(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	db, err := pebbledb.NewDB(dir, 10, 1, 10)
+	require.Nil(t, err)
+
+	err = db.Close()
+	require.Nil(t, err)
+
+	err = db.DestroyClosed()
+	assert.Nil(t, err)
+
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDB_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDB(t, 1, 5)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	numOperations := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			key := []byte(fmt.Sprintf("key%d", idx%100))
+			switch idx % 5 {
+			case 0:
+				_ = db.Put(key, []byte("value"))
+			case 1:
+				_, _ = db.Get(key)
+			case 2:
+				_ = db.Has(key)
+			case 3:
+				_ = db.Remove(key)
+			case 4:
+				db.RangeKeys(func(_ []byte, _ []byte) bool {
+					return false
+				})
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
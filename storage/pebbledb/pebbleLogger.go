@@ -0,0 +1,23 @@
+package pebbledb
+
+import (
+	"fmt"
+)
+
+// pebbleLogger redirects the messages of the pebble engine to the node's logger
+type pebbleLogger struct {
+	path string
+}
+
+// Infof logs an information message of the pebble engine
+func (pl *pebbleLogger) Infof(format string, args ...interface{}) {
+	log.Trace("pebble", "path", pl.path, "message", fmt.Sprintf(format, args...))
+}
+
+// Fatalf logs a fatal message of the pebble engine. As the pebble engine can not continue after such an error, the
+// process is stopped
+func (pl *pebbleLogger) Fatalf(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	log.Error("pebble", "path", pl.path, "message", message)
+	panic(message)
+}
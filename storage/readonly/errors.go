@@ -0,0 +1,6 @@
+package readonly
+
+import "errors"
+
+// ErrNilSource signals that a nil source has been provided
+var ErrNilSource = errors.New("nil source")
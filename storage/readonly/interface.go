@@ -0,0 +1,8 @@
+package readonly
+
+// Source defines a source of key-value pairs read by the multi source storer. A storage.Persister is a valid source
+type Source interface {
+	Get(key []byte) ([]byte, error)
+	Close() error
+	IsInterfaceNil() bool
+}
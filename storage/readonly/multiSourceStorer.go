@@ -0,0 +1,69 @@
+package readonly
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+// multiSourceStorer reads the values of a storage spread across several sources, such as the databases of a unit
+// written in different epochs. The sources are never altered
+type multiSourceStorer struct {
+	sources []Source
+}
+
+// NewMultiSourceStorer creates a read only storer searching the provided sources, in the given order
+func NewMultiSourceStorer(sources []Source) (*multiSourceStorer, error) {
+	for _, source := range sources {
+		if check.IfNil(source) {
+			return nil, ErrNilSource
+		}
+	}
+
+	return &multiSourceStorer{
+		sources: sources,
+	}, nil
+}
+
+// Get returns the value of the key from the first source holding it
+func (storer *multiSourceStorer) Get(key []byte) ([]byte, error) {
+	err := storage.ErrKeyNotFound
+	for _, source := range storer.sources {
+		value, errGet := source.Get(key)
+		if errGet == nil && value != nil {
+			return value, nil
+		}
+		if errGet != nil && !storage.IsNotFoundInStorageErr(errGet) {
+			err = errGet
+		}
+	}
+
+	return nil, err
+}
+
+// Put returns ErrReadOnlyStorage as the sources should not be altered
+func (storer *multiSourceStorer) Put(_, _ []byte) error {
+	return storage.ErrReadOnlyStorage
+}
+
+// Remove returns ErrReadOnlyStorage as the sources should not be altered
+func (storer *multiSourceStorer) Remove(_ []byte) error {
+	return storage.ErrReadOnlyStorage
+}
+
+// Close closes all the sources
+func (storer *multiSourceStorer) Close() error {
+	var lastErr error
+	for _, source := range storer.sources {
+		err := source.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (storer *multiSourceStorer) IsInterfaceNil() bool {
+	return storer == nil
+}
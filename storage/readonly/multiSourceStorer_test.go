@@ -0,0 +1,124 @@
+package readonly
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/mock"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMultiSourceStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil source should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewMultiSourceStorer([]Source{testscommon.NewMemDbMock(), nil})
+		assert.Equal(t, ErrNilSource, err)
+		assert.Nil(t, storer)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewMultiSourceStorer([]Source{testscommon.NewMemDbMock()})
+		assert.Nil(t, err)
+		assert.False(t, storer.IsInterfaceNil())
+	})
+}
+
+func TestMultiSourceStorer_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return the value from the first source holding the key", func(t *testing.T) {
+		t.Parallel()
+
+		firstSource := testscommon.NewMemDbMock()
+		secondSource := testscommon.NewMemDbMock()
+		thirdSource := testscommon.NewMemDbMock()
+		_ = secondSource.Put([]byte("key"), []byte("value"))
+		_ = thirdSource.Put([]byte("key"), []byte("older value"))
+		storer, _ := NewMultiSourceStorer([]Source{firstSource, secondSource, thirdSource})
+
+		value, err := storer.Get([]byte("key"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value"), value)
+	})
+	t.Run("missing key should return key not found", func(t *testing.T) {
+		t.Parallel()
+
+		storer, _ := NewMultiSourceStorer([]Source{testscommon.NewMemDbMock(), testscommon.NewMemDbMock()})
+
+		value, err := storer.Get([]byte("missing key"))
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+		assert.Nil(t, value)
+	})
+	t.Run("no source should return key not found", func(t *testing.T) {
+		t.Parallel()
+
+		storer, _ := NewMultiSourceStorer(nil)
+
+		value, err := storer.Get([]byte("key"))
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+		assert.Nil(t, value)
+	})
+	t.Run("source error should be returned if the key is not found", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		failingSource := &mock.PersisterStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		storer, _ := NewMultiSourceStorer([]Source{failingSource, testscommon.NewMemDbMock()})
+
+		value, err := storer.Get([]byte("key"))
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, value)
+	})
+}
+
+func TestMultiSourceStorer_PutAndRemoveShouldError(t *testing.T) {
+	t.Parallel()
+
+	source := testscommon.NewMemDbMock()
+	_ = source.Put([]byte("key"), []byte("value"))
+	storer, _ := NewMultiSourceStorer([]Source{source})
+
+	assert.Equal(t, storage.ErrReadOnlyStorage, storer.Put([]byte("key"), []byte("new value")))
+	assert.Equal(t, storage.ErrReadOnlyStorage, storer.Remove([]byte("key")))
+
+	value, err := source.Get([]byte("key"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestMultiSourceStorer_CloseShouldCloseAllSources(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numClosed := 0
+	sources := []Source{
+		&mock.PersisterStub{
+			CloseCalled: func() error {
+				numClosed++
+				return expectedErr
+			},
+		},
+		&mock.PersisterStub{
+			CloseCalled: func() error {
+				numClosed++
+				return nil
+			},
+		},
+	}
+	storer, _ := NewMultiSourceStorer(sources)
+
+	err := storer.Close()
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 2, numClosed)
+}
@@ -0,0 +1,15 @@
+package txcache
+
+const estimatedNumOfSweepableSendersPerSelection = 100
+
+const senderGracePeriodLowerBound = 2
+
+const senderGracePeriodUpperBound = 2
+
+const numEvictedTxsToDisplay = 3
+
+// EvictionReasonCapacity is the reason reported when transactions are evicted because the cache is full
+const EvictionReasonCapacity = "capacity"
+
+// EvictionReasonSenderLimit is the reason reported when transactions are evicted because their sender has too many
+const EvictionReasonSenderLimit = "senderLimit"
@@ -0,0 +1,6 @@
+package txcache
+
+import "errors"
+
+// ErrNilEvictionHandler signals that a nil eviction handler has been provided
+var ErrNilEvictionHandler = errors.New("nil eviction handler")
@@ -0,0 +1,8 @@
+package maps
+
+// BucketSortedMapItem defines an item of the bucket sorted map
+type BucketSortedMapItem interface {
+	GetKey() string
+	GetScoreChunk() *MapChunk
+	SetScoreChunk(*MapChunk)
+}
@@ -0,0 +1,127 @@
+package chainSimulator
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/node/chainSimulator/dtos"
+)
+
+// SimulatorHandlerStub -
+type SimulatorHandlerStub struct {
+	GenerateBlocksCalled                        func(numOfBlocks int) error
+	GenerateBlocksUntilEpochIsReachedCalled     func(targetEpoch int32) error
+	FastForwardUntilEpochIsReachedCalled        func(targetEpoch int32) error
+	SetStateMultipleCalled                      func(stateSlice []*dtos.AddressState) error
+	AddValidatorKeysCalled                      func(validatorsPrivateKeys [][]byte) error
+	GenerateAndMintWalletAddressCalled          func(targetShardID uint32, value *big.Int) (dtos.WalletAddress, error)
+	SendTxAndGenerateBlockTilTxIsExecutedCalled func(txToSend *transaction.Transaction, maxNumOfBlocksToGenerateWhenExecutingTx int) (*transaction.ApiTransactionResult, error)
+	GetInitialWalletKeysCalled                  func() *dtos.InitialWalletKeys
+	GetRestAPIInterfacesCalled                  func() map[uint32]string
+	SnapshotCalled                              func() (uint64, error)
+	RevertToCalled                              func(snapshotID uint64) error
+}
+
+// GenerateBlocks -
+func (stub *SimulatorHandlerStub) GenerateBlocks(numOfBlocks int) error {
+	if stub.GenerateBlocksCalled != nil {
+		return stub.GenerateBlocksCalled(numOfBlocks)
+	}
+
+	return nil
+}
+
+// GenerateBlocksUntilEpochIsReached -
+func (stub *SimulatorHandlerStub) GenerateBlocksUntilEpochIsReached(targetEpoch int32) error {
+	if stub.GenerateBlocksUntilEpochIsReachedCalled != nil {
+		return stub.GenerateBlocksUntilEpochIsReachedCalled(targetEpoch)
+	}
+
+	return nil
+}
+
+// FastForwardUntilEpochIsReached -
+func (stub *SimulatorHandlerStub) FastForwardUntilEpochIsReached(targetEpoch int32) error {
+	if stub.FastForwardUntilEpochIsReachedCalled != nil {
+		return stub.FastForwardUntilEpochIsReachedCalled(targetEpoch)
+	}
+
+	return nil
+}
+
+// SetStateMultiple -
+func (stub *SimulatorHandlerStub) SetStateMultiple(stateSlice []*dtos.AddressState) error {
+	if stub.SetStateMultipleCalled != nil {
+		return stub.SetStateMultipleCalled(stateSlice)
+	}
+
+	return nil
+}
+
+// AddValidatorKeys -
+func (stub *SimulatorHandlerStub) AddValidatorKeys(validatorsPrivateKeys [][]byte) error {
+	if stub.AddValidatorKeysCalled != nil {
+		return stub.AddValidatorKeysCalled(validatorsPrivateKeys)
+	}
+
+	return nil
+}
+
+// GenerateAndMintWalletAddress -
+func (stub *SimulatorHandlerStub) GenerateAndMintWalletAddress(targetShardID uint32, value *big.Int) (dtos.WalletAddress, error) {
+	if stub.GenerateAndMintWalletAddressCalled != nil {
+		return stub.GenerateAndMintWalletAddressCalled(targetShardID, value)
+	}
+
+	return dtos.WalletAddress{}, nil
+}
+
+// SendTxAndGenerateBlockTilTxIsExecuted -
+func (stub *SimulatorHandlerStub) SendTxAndGenerateBlockTilTxIsExecuted(txToSend *transaction.Transaction, maxNumOfBlocksToGenerateWhenExecutingTx int) (*transaction.ApiTransactionResult, error) {
+	if stub.SendTxAndGenerateBlockTilTxIsExecutedCalled != nil {
+		return stub.SendTxAndGenerateBlockTilTxIsExecutedCalled(txToSend, maxNumOfBlocksToGenerateWhenExecutingTx)
+	}
+
+	return &transaction.ApiTransactionResult{}, nil
+}
+
+// GetInitialWalletKeys -
+func (stub *SimulatorHandlerStub) GetInitialWalletKeys() *dtos.InitialWalletKeys {
+	if stub.GetInitialWalletKeysCalled != nil {
+		return stub.GetInitialWalletKeysCalled()
+	}
+
+	return &dtos.InitialWalletKeys{}
+}
+
+// GetRestAPIInterfaces -
+func (stub *SimulatorHandlerStub) GetRestAPIInterfaces() map[uint32]string {
+	if stub.GetRestAPIInterfacesCalled != nil {
+		return stub.GetRestAPIInterfacesCalled()
+	}
+
+	return make(map[uint32]string)
+}
+
+// Snapshot -
+func (stub *SimulatorHandlerStub) Snapshot() (uint64, error) {
+	if stub.SnapshotCalled != nil {
+		return stub.SnapshotCalled()
+	}
+
+	return 0, nil
+}
+
+// RevertTo -
+func (stub *SimulatorHandlerStub) RevertTo(snapshotID uint64) error {
+	if stub.RevertToCalled != nil {
+		return stub.RevertToCalled(snapshotID)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *SimulatorHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
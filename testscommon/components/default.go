@@ -63,23 +63,26 @@ func GetDefaultCoreComponents() *mock.CoreComponentsMock {
 // GetDefaultCryptoComponents -
 func GetDefaultCryptoComponents() *mock.CryptoComponentsMock {
 	return &mock.CryptoComponentsMock{
-		PubKey:                  &mock.PublicKeyMock{},
-		PrivKey:                 &mock.PrivateKeyStub{},
-		P2pPubKey:               &mock.PublicKeyMock{},
-		P2pPrivKey:              mock.NewP2pPrivateKeyMock(),
-		P2pSig:                  &mock.SinglesignMock{},
-		PubKeyString:            "pubKey",
-		PubKeyBytes:             []byte("pubKey"),
-		BlockSig:                &mock.SinglesignMock{},
-		TxSig:                   &mock.SinglesignMock{},
-		MultiSigContainer:       cryptoMocks.NewMultiSignerContainerMock(&cryptoMocks.MultisignerMock{}),
-		PeerSignHandler:         &mock.PeerSignatureHandler{},
-		BlKeyGen:                &mock.KeyGenMock{},
-		TxKeyGen:                &mock.KeyGenMock{},
-		P2PKeyGen:               &mock.KeyGenMock{},
-		MsgSigVerifier:          &testscommon.MessageSignVerifierMock{},
-		SigHandler:              &consensus.SigningHandlerStub{},
-		ManagedPeersHolderField: &testscommon.ManagedPeersHolderStub{},
+		PubKey:                      &mock.PublicKeyMock{},
+		PrivKey:                     &mock.PrivateKeyStub{},
+		P2pPubKey:                   &mock.PublicKeyMock{},
+		P2pPrivKey:                  mock.NewP2pPrivateKeyMock(),
+		P2pSig:                      &mock.SinglesignMock{},
+		PubKeyString:                "pubKey",
+		PubKeyBytes:                 []byte("pubKey"),
+		BlockSig:                    &mock.SinglesignMock{},
+		TxSig:                       &mock.SinglesignMock{},
+		MultiSigContainer:           cryptoMocks.NewMultiSignerContainerMock(&cryptoMocks.MultisignerMock{}),
+		PeerSignHandler:             &mock.PeerSignatureHandler{},
+		BlKeyGen:                    &mock.KeyGenMock{},
+		TxKeyGen:                    &mock.KeyGenMock{},
+		P2PKeyGen:                   &mock.KeyGenMock{},
+		MsgSigVerifier:              &testscommon.MessageSignVerifierMock{},
+		SigHandler:                  &consensus.SigningHandlerStub{},
+		ManagedPeersHolderField:     &testscommon.ManagedPeersHolderStub{},
+		KeysSignerField:             &cryptoMocks.KeysSignerStub{},
+		ManagedKeysUpdaterField:     &testscommon.ManagedKeysUpdaterStub{},
+		RedundancyLeaseHandlerField: &testscommon.RedundancyLeaseHandlerStub{},
 	}
 }
 
@@ -0,0 +1,28 @@
+package cryptoMocks
+
+// KeysSignerStub -
+type KeysSignerStub struct {
+	SignCalled                 func(publicKey []byte, message []byte) ([]byte, error)
+	CreateSignatureShareCalled func(publicKey []byte, message []byte, epoch uint32) ([]byte, error)
+}
+
+// Sign -
+func (stub *KeysSignerStub) Sign(publicKey []byte, message []byte) ([]byte, error) {
+	if stub.SignCalled != nil {
+		return stub.SignCalled(publicKey, message)
+	}
+	return nil, nil
+}
+
+// CreateSignatureShare -
+func (stub *KeysSignerStub) CreateSignatureShare(publicKey []byte, message []byte, epoch uint32) ([]byte, error) {
+	if stub.CreateSignatureShareCalled != nil {
+		return stub.CreateSignatureShareCalled(publicKey, message, epoch)
+	}
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *KeysSignerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
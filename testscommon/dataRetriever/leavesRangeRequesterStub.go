@@ -0,0 +1,20 @@
+package dataRetriever
+
+// LeavesRangeRequesterStub -
+type LeavesRangeRequesterStub struct {
+	RequesterStub
+	RequestLeavesRangeCalled func(rootHash []byte, startKey []byte) error
+}
+
+// RequestLeavesRange -
+func (stub *LeavesRangeRequesterStub) RequestLeavesRange(rootHash []byte, startKey []byte) error {
+	if stub.RequestLeavesRangeCalled != nil {
+		return stub.RequestLeavesRangeCalled(rootHash, startKey)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *LeavesRangeRequesterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -117,6 +117,9 @@ func CreatePoolsHolder(numShards uint32, selfShard uint32) dataRetriever.PoolsHo
 	trieNodesChunks, err := storageunit.NewCache(cacherConfig)
 	panicIfError("CreatePoolsHolder", err)
 
+	trieLeavesRanges, err := storageunit.NewCache(cacherConfig)
+	panicIfError("CreatePoolsHolder", err)
+
 	cacherConfig = storageunit.CacheConfig{Capacity: 50000, Type: storageunit.LRUCache}
 	smartContracts, err := storageunit.NewCache(cacherConfig)
 	panicIfError("CreatePoolsHolder", err)
@@ -149,6 +152,7 @@ func CreatePoolsHolder(numShards uint32, selfShard uint32) dataRetriever.PoolsHo
 		PeerChangesBlocks:         peerChangeBlockBody,
 		TrieNodes:                 adaptedTrieNodesStorage,
 		TrieNodesChunks:           trieNodesChunks,
+		TrieLeavesRanges:          trieLeavesRanges,
 		CurrentBlockTransactions:  currentBlockTransactions,
 		CurrentEpochValidatorInfo: currentEpochValidatorInfo,
 		SmartContracts:            smartContracts,
@@ -201,6 +205,9 @@ func CreatePoolsHolderWithTxPool(txPool dataRetriever.ShardedDataCacherNotifier)
 	trieNodesChunks, err := storageunit.NewCache(cacherConfig)
 	panicIfError("CreatePoolsHolderWithTxPool", err)
 
+	trieLeavesRanges, err := storageunit.NewCache(cacherConfig)
+	panicIfError("CreatePoolsHolderWithTxPool", err)
+
 	cacherConfig = storageunit.CacheConfig{Capacity: 50000, Type: storageunit.LRUCache}
 	smartContracts, err := storageunit.NewCache(cacherConfig)
 	panicIfError("CreatePoolsHolderWithTxPool", err)
@@ -233,6 +240,7 @@ func CreatePoolsHolderWithTxPool(txPool dataRetriever.ShardedDataCacherNotifier)
 		PeerChangesBlocks:         peerChangeBlockBody,
 		TrieNodes:                 trieNodes,
 		TrieNodesChunks:           trieNodesChunks,
+		TrieLeavesRanges:          trieLeavesRanges,
 		CurrentBlockTransactions:  currentBlockTransactions,
 		CurrentEpochValidatorInfo: currentEpochValidatorInfo,
 		SmartContracts:            smartContracts,
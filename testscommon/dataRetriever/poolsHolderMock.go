@@ -27,6 +27,7 @@ type PoolsHolderMock struct {
 	peerChangesBlocks      storage.Cacher
 	trieNodes              storage.Cacher
 	trieNodesChunks        storage.Cacher
+	trieLeavesRanges       storage.Cacher
 	smartContracts         storage.Cacher
 	currBlockTxs           dataRetriever.TransactionCacher
 	currEpochValidatorInfo dataRetriever.ValidatorInfoCacher
@@ -91,6 +92,9 @@ func NewPoolsHolderMock() *PoolsHolderMock {
 	holder.trieNodesChunks, err = storageunit.NewCache(storageunit.CacheConfig{Type: storageunit.SizeLRUCache, Capacity: 900000, Shards: 1, SizeInBytes: 314572800})
 	panicIfError("NewPoolsHolderMock", err)
 
+	holder.trieLeavesRanges, err = storageunit.NewCache(storageunit.CacheConfig{Type: storageunit.SizeLRUCache, Capacity: 1000, Shards: 1, SizeInBytes: 52428800})
+	panicIfError("NewPoolsHolderMock", err)
+
 	holder.smartContracts, err = storageunit.NewCache(storageunit.CacheConfig{Type: storageunit.LRUCache, Capacity: 10000, Shards: 1, SizeInBytes: 0})
 	panicIfError("NewPoolsHolderMock", err)
 
@@ -178,6 +182,11 @@ func (holder *PoolsHolderMock) TrieNodesChunks() storage.Cacher {
 	return holder.trieNodesChunks
 }
 
+// TrieLeavesRanges -
+func (holder *PoolsHolderMock) TrieLeavesRanges() storage.Cacher {
+	return holder.trieLeavesRanges
+}
+
 // SmartContracts -
 func (holder *PoolsHolderMock) SmartContracts() storage.Cacher {
 	return holder.smartContracts
@@ -18,6 +18,7 @@ type PoolsHolderStub struct {
 	CurrEpochValidatorInfoCalled func() dataRetriever.ValidatorInfoCacher
 	TrieNodesCalled              func() storage.Cacher
 	TrieNodesChunksCalled        func() storage.Cacher
+	TrieLeavesRangesCalled       func() storage.Cacher
 	PeerChangesBlocksCalled      func() storage.Cacher
 	SmartContractsCalled         func() storage.Cacher
 	PeerAuthenticationsCalled    func() storage.Cacher
@@ -121,6 +122,15 @@ func (holder *PoolsHolderStub) TrieNodesChunks() storage.Cacher {
 	return testscommon.NewCacherStub()
 }
 
+// TrieLeavesRanges -
+func (holder *PoolsHolderStub) TrieLeavesRanges() storage.Cacher {
+	if holder.TrieLeavesRangesCalled != nil {
+		return holder.TrieLeavesRangesCalled()
+	}
+
+	return testscommon.NewCacherStub()
+}
+
 // PeerChangesBlocks -
 func (holder *PoolsHolderStub) PeerChangesBlocks() storage.Cacher {
 	if holder.PeerChangesBlocksCalled != nil {
@@ -6,6 +6,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/dblookupext/accountsHistory"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 )
 
@@ -17,7 +18,9 @@ type HistoryRepositoryStub struct {
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
 	GetESDTSupplyCalled                func(token string) (*esdtSupply.SupplyESDT, error)
+	GetAccountHistoryCalled            func(address []byte, fromNonce uint64, toNonce uint64, maxEntries int) (*accountsHistory.AccountHistoryPage, error)
 	IsEnabledCalled                    func() bool
+	CloseCalled                        func() error
 }
 
 // RecordBlock -
@@ -89,6 +92,24 @@ func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*esdtSupply.Supply
 	return nil, nil
 }
 
+// GetAccountHistory -
+func (hp *HistoryRepositoryStub) GetAccountHistory(address []byte, fromNonce uint64, toNonce uint64, maxEntries int) (*accountsHistory.AccountHistoryPage, error) {
+	if hp.GetAccountHistoryCalled != nil {
+		return hp.GetAccountHistoryCalled(address, fromNonce, toNonce, maxEntries)
+	}
+
+	return nil, nil
+}
+
+// Close -
+func (hp *HistoryRepositoryStub) Close() error {
+	if hp.CloseCalled != nil {
+		return hp.CloseCalled()
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
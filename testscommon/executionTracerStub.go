@@ -0,0 +1,58 @@
+package testscommon
+
+import (
+	"github.com/multiversx/mx-chain-go/process"
+	txSimData "github.com/multiversx/mx-chain-go/process/transactionEvaluator/data"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// ExecutionTracerStub -
+type ExecutionTracerStub struct {
+	EnterCallCalled        func(frameType process.ExecutionFrameType, input *vmcommon.ContractCallInput)
+	ExitCallCalled         func(vmOutput *vmcommon.VMOutput, err error)
+	TraceStorageReadCalled func(address []byte, key []byte, value []byte)
+	StartCalled            func()
+	StopCalled             func() []*txSimData.ExecutionFrame
+}
+
+// EnterCall -
+func (stub *ExecutionTracerStub) EnterCall(frameType process.ExecutionFrameType, input *vmcommon.ContractCallInput) {
+	if stub.EnterCallCalled != nil {
+		stub.EnterCallCalled(frameType, input)
+	}
+}
+
+// ExitCall -
+func (stub *ExecutionTracerStub) ExitCall(vmOutput *vmcommon.VMOutput, err error) {
+	if stub.ExitCallCalled != nil {
+		stub.ExitCallCalled(vmOutput, err)
+	}
+}
+
+// TraceStorageRead -
+func (stub *ExecutionTracerStub) TraceStorageRead(address []byte, key []byte, value []byte) {
+	if stub.TraceStorageReadCalled != nil {
+		stub.TraceStorageReadCalled(address, key, value)
+	}
+}
+
+// Start -
+func (stub *ExecutionTracerStub) Start() {
+	if stub.StartCalled != nil {
+		stub.StartCalled()
+	}
+}
+
+// Stop -
+func (stub *ExecutionTracerStub) Stop() []*txSimData.ExecutionFrame {
+	if stub.StopCalled != nil {
+		return stub.StopCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *ExecutionTracerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
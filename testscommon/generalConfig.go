@@ -156,8 +156,9 @@ func GetGeneralConfig() config.Config {
 			Capacity:    10,
 			SizeInBytes: 10000,
 		},
-		TrieNodesChunksDataPool: getLRUCacheConfig(),
-		SmartContractDataPool:   getLRUCacheConfig(),
+		TrieNodesChunksDataPool:  getLRUCacheConfig(),
+		TrieLeavesRangesDataPool: getLRUCacheConfig(),
+		SmartContractDataPool:    getLRUCacheConfig(),
 		TxStorage: config.StorageConfig{
 			Cache: getLRUCacheConfig(),
 			DB: config.DBConfig{
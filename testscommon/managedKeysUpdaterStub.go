@@ -0,0 +1,37 @@
+package testscommon
+
+// ManagedKeysUpdaterStub -
+type ManagedKeysUpdaterStub struct {
+	AddManagedKeyCalled          func(keyBytes []byte) ([]byte, error)
+	RemoveManagedKeyCalled       func(pkBytes []byte) error
+	SyncManagedPrivateKeysCalled func(privateKeys [][]byte, publicKeys [][]byte) (int, int, error)
+}
+
+// AddManagedKey -
+func (stub *ManagedKeysUpdaterStub) AddManagedKey(keyBytes []byte) ([]byte, error) {
+	if stub.AddManagedKeyCalled != nil {
+		return stub.AddManagedKeyCalled(keyBytes)
+	}
+	return nil, nil
+}
+
+// RemoveManagedKey -
+func (stub *ManagedKeysUpdaterStub) RemoveManagedKey(pkBytes []byte) error {
+	if stub.RemoveManagedKeyCalled != nil {
+		return stub.RemoveManagedKeyCalled(pkBytes)
+	}
+	return nil
+}
+
+// SyncManagedPrivateKeys -
+func (stub *ManagedKeysUpdaterStub) SyncManagedPrivateKeys(privateKeys [][]byte, publicKeys [][]byte) (int, int, error) {
+	if stub.SyncManagedPrivateKeysCalled != nil {
+		return stub.SyncManagedPrivateKeysCalled(privateKeys, publicKeys)
+	}
+	return 0, 0, nil
+}
+
+// IsInterfaceNil -
+func (stub *ManagedKeysUpdaterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
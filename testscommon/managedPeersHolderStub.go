@@ -10,6 +10,8 @@ import (
 // ManagedPeersHolderStub -
 type ManagedPeersHolderStub struct {
 	AddManagedPeerCalled                         func(privateKeyBytes []byte) error
+	AddManagedPublicKeyCalled                    func(publicKeyBytes []byte) error
+	RemoveManagedPeerCalled                      func(pkBytes []byte) error
 	GetPrivateKeyCalled                          func(pkBytes []byte) (crypto.PrivateKey, error)
 	GetP2PIdentityCalled                         func(pkBytes []byte) ([]byte, core.PeerID, error)
 	GetMachineIDCalled                           func(pkBytes []byte) (string, error)
@@ -37,6 +39,22 @@ func (stub *ManagedPeersHolderStub) AddManagedPeer(privateKeyBytes []byte) error
 	return nil
 }
 
+// AddManagedPublicKey -
+func (stub *ManagedPeersHolderStub) AddManagedPublicKey(publicKeyBytes []byte) error {
+	if stub.AddManagedPublicKeyCalled != nil {
+		return stub.AddManagedPublicKeyCalled(publicKeyBytes)
+	}
+	return nil
+}
+
+// RemoveManagedPeer -
+func (stub *ManagedPeersHolderStub) RemoveManagedPeer(pkBytes []byte) error {
+	if stub.RemoveManagedPeerCalled != nil {
+		return stub.RemoveManagedPeerCalled(pkBytes)
+	}
+	return nil
+}
+
 // GetPrivateKey -
 func (stub *ManagedPeersHolderStub) GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error) {
 	if stub.GetPrivateKeyCalled != nil {
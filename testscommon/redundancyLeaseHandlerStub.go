@@ -0,0 +1,57 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// RedundancyLeaseHandlerStub -
+type RedundancyLeaseHandlerStub struct {
+	IsEnabledCalled     func() bool
+	IsLeaseHolderCalled func() bool
+	StepDownCalled      func() error
+	StateCalled         func() common.RedundancyLeaseState
+	CloseCalled         func() error
+}
+
+// IsEnabled -
+func (stub *RedundancyLeaseHandlerStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+	return false
+}
+
+// IsLeaseHolder -
+func (stub *RedundancyLeaseHandlerStub) IsLeaseHolder() bool {
+	if stub.IsLeaseHolderCalled != nil {
+		return stub.IsLeaseHolderCalled()
+	}
+	return false
+}
+
+// StepDown -
+func (stub *RedundancyLeaseHandlerStub) StepDown() error {
+	if stub.StepDownCalled != nil {
+		return stub.StepDownCalled()
+	}
+	return nil
+}
+
+// State -
+func (stub *RedundancyLeaseHandlerStub) State() common.RedundancyLeaseState {
+	if stub.StateCalled != nil {
+		return stub.StateCalled()
+	}
+	return common.RedundancyLeaseState{}
+}
+
+// Close -
+func (stub *RedundancyLeaseHandlerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *RedundancyLeaseHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -14,6 +14,7 @@ type RequestHandlerStub struct {
 	RequestMiniBlockHandlerCalled            func(destShardID uint32, miniblockHash []byte)
 	RequestMiniBlocksHandlerCalled           func(destShardID uint32, miniblocksHashes [][]byte)
 	RequestTrieNodesCalled                   func(destShardID uint32, hashes [][]byte, topic string)
+	RequestTrieLeavesRangeCalled             func(destShardID uint32, rootHash []byte, startKey []byte, topic string)
 	RequestStartOfEpochMetaBlockCalled       func(epoch uint32)
 	SetNumPeersToQueryCalled                 func(key string, intra int, cross int) error
 	GetNumPeersToQueryCalled                 func(key string) (int, int, error)
@@ -139,6 +140,14 @@ func (rhs *RequestHandlerStub) RequestTrieNodes(destShardID uint32, hashes [][]b
 	rhs.RequestTrieNodesCalled(destShardID, hashes, topic)
 }
 
+// RequestTrieLeavesRange -
+func (rhs *RequestHandlerStub) RequestTrieLeavesRange(destShardID uint32, rootHash []byte, startKey []byte, topic string) {
+	if rhs.RequestTrieLeavesRangeCalled == nil {
+		return
+	}
+	rhs.RequestTrieLeavesRangeCalled(destShardID, rootHash, startKey, topic)
+}
+
 // CreateTrieNodeIdentifier -
 func (rhs *RequestHandlerStub) CreateTrieNodeIdentifier(requestHash []byte, chunkIndex uint32) []byte {
 	if rhs.CreateTrieNodeIdentifierCalled != nil {
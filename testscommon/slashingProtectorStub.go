@@ -0,0 +1,20 @@
+package testscommon
+
+// SlashingProtectorStub -
+type SlashingProtectorStub struct {
+	CheckAndRecordSignatureCalled func(publicKey []byte, shardID uint32, round uint64, headerHash []byte) error
+}
+
+// CheckAndRecordSignature -
+func (stub *SlashingProtectorStub) CheckAndRecordSignature(publicKey []byte, shardID uint32, round uint64, headerHash []byte) error {
+	if stub.CheckAndRecordSignatureCalled != nil {
+		return stub.CheckAndRecordSignatureCalled(publicKey, shardID, round, headerHash)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *SlashingProtectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -232,3 +232,8 @@ func (awm *AccountWrapMock) IsGuarded() bool {
 func (awm *AccountWrapMock) GetAllLeaves(_ *common.TrieIteratorChannels, _ context.Context) error {
 	return nil
 }
+
+// GetLeavesPage -
+func (awm *AccountWrapMock) GetLeavesPage(_ context.Context, _ []byte, _ int, _ []byte) (*common.TrieLeavesPage, error) {
+	return &common.TrieLeavesPage{}, nil
+}
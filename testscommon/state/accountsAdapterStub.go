@@ -33,16 +33,22 @@ type AccountsStub struct {
 	RecreateAllTriesCalled        func(rootHash []byte) (map[string]common.Trie, error)
 	GetCodeCalled                 func([]byte) []byte
 	GetTrieCalled                 func([]byte) (common.Trie, error)
+	GetAccountsDiffCalled         func(ctx context.Context, oldRootHash common.RootHashHolder, newRootHash common.RootHashHolder, dataTrieLeafParserCreator state.DataTrieLeafParserCreator, options state.AccountsDiffOptions) (*state.AccountsDiff, error)
+	CollectTriesStatisticsCalled  func(ctx context.Context, rootHash []byte, collector common.TriesStatisticsCollector) error
 	GetStackDebugFirstEntryCalled func() []byte
 	GetAccountWithBlockInfoCalled func(address []byte, options common.RootHashHolder) (vmcommon.AccountHandler, common.BlockInfo, error)
 	GetCodeWithBlockInfoCalled    func(codeHash []byte, options common.RootHashHolder) ([]byte, common.BlockInfo, error)
 	CloseCalled                   func() error
 	SetSyncerCalled               func(syncer state.AccountsDBSyncer) error
 	StartSnapshotIfNeededCalled   func() error
+	CleanCacheCalled              func()
 }
 
 // CleanCache -
 func (as *AccountsStub) CleanCache() {
+	if as.CleanCacheCalled != nil {
+		as.CleanCacheCalled()
+	}
 }
 
 // SetSyncer -
@@ -72,6 +78,30 @@ func (as *AccountsStub) GetTrie(codeHash []byte) (common.Trie, error) {
 	return nil, nil
 }
 
+// GetAccountsDiff -
+func (as *AccountsStub) GetAccountsDiff(
+	ctx context.Context,
+	oldRootHash common.RootHashHolder,
+	newRootHash common.RootHashHolder,
+	dataTrieLeafParserCreator state.DataTrieLeafParserCreator,
+	options state.AccountsDiffOptions,
+) (*state.AccountsDiff, error) {
+	if as.GetAccountsDiffCalled != nil {
+		return as.GetAccountsDiffCalled(ctx, oldRootHash, newRootHash, dataTrieLeafParserCreator, options)
+	}
+
+	return nil, nil
+}
+
+// CollectTriesStatistics -
+func (as *AccountsStub) CollectTriesStatistics(ctx context.Context, rootHash []byte, collector common.TriesStatisticsCollector) error {
+	if as.CollectTriesStatisticsCalled != nil {
+		return as.CollectTriesStatisticsCalled(ctx, rootHash, collector)
+	}
+
+	return nil
+}
+
 // GetCode -
 func (as *AccountsStub) GetCode(codeHash []byte) []byte {
 	if as.GetCodeCalled != nil {
@@ -0,0 +1,26 @@
+package state
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// SnapshotFilesWriterStub -
+type SnapshotFilesWriterStub struct {
+	WriteSnapshotCalled func(ctx context.Context, rootHash []byte, epoch uint32, trieStorageManager common.StorageManager) error
+}
+
+// WriteSnapshot -
+func (stub *SnapshotFilesWriterStub) WriteSnapshot(ctx context.Context, rootHash []byte, epoch uint32, trieStorageManager common.StorageManager) error {
+	if stub.WriteSnapshotCalled != nil {
+		return stub.WriteSnapshotCalled(ctx, rootHash, epoch, trieStorageManager)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *SnapshotFilesWriterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
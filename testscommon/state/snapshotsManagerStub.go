@@ -11,6 +11,7 @@ type SnapshotsManagerStub struct {
 	StartSnapshotAfterRestartIfNeededCalled func(trieStorageManager common.StorageManager) error
 	IsSnapshotInProgressCalled              func() bool
 	SetSyncerCalled                         func(syncer state.AccountsDBSyncer) error
+	CloseCalled                             func() error
 }
 
 // SnapshotState -
@@ -44,6 +45,14 @@ func (s *SnapshotsManagerStub) SetSyncer(syncer state.AccountsDBSyncer) error {
 	return nil
 }
 
+// Close -
+func (s *SnapshotsManagerStub) Close() error {
+	if s.CloseCalled != nil {
+		return s.CloseCalled()
+	}
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (s *SnapshotsManagerStub) IsInterfaceNil() bool {
 	return s == nil
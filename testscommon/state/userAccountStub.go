@@ -31,6 +31,7 @@ type UserAccountStub struct {
 	SetDataTrieCalled        func(dataTrie common.Trie)
 	GetRootHashCalled        func() []byte
 	SaveKeyValueCalled       func(key []byte, value []byte) error
+	GetLeavesPageCalled      func(ctx context.Context, startKey []byte, maxLeaves int, keyPrefix []byte) (*common.TrieLeavesPage, error)
 }
 
 // HasNewCode -
@@ -210,3 +211,12 @@ func (u *UserAccountStub) AccountDataHandler() vmcommon.AccountDataHandler {
 func (u *UserAccountStub) GetAllLeaves(_ *common.TrieIteratorChannels, _ context.Context) error {
 	return nil
 }
+
+// GetLeavesPage -
+func (u *UserAccountStub) GetLeavesPage(ctx context.Context, startKey []byte, maxLeaves int, keyPrefix []byte) (*common.TrieLeavesPage, error) {
+	if u.GetLeavesPageCalled != nil {
+		return u.GetLeavesPageCalled(ctx, startKey, maxLeaves, keyPrefix)
+	}
+
+	return &common.TrieLeavesPage{}, nil
+}
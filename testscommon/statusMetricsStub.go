@@ -1,5 +1,7 @@
 package testscommon
 
+import "github.com/multiversx/mx-chain-go/debug"
+
 // StatusMetricsStub -
 type StatusMetricsStub struct {
 	StatusMetricsMapWithoutP2PCalled              func() (map[string]interface{}, error)
@@ -10,6 +12,7 @@ type StatusMetricsStub struct {
 	EnableEpochsMetricsCalled                     func() (map[string]interface{}, error)
 	RatingsMetricsCalled                          func() (map[string]interface{}, error)
 	StatusMetricsWithoutP2PPrometheusStringCalled func() (string, error)
+	StatusMetricsPrometheusStringCalled           func(debugCounters []*debug.InterceptorResolverCounters) (string, error)
 	BootstrapMetricsCalled                        func() (map[string]interface{}, error)
 }
 
@@ -22,6 +25,15 @@ func (sms *StatusMetricsStub) StatusMetricsWithoutP2PPrometheusString() (string,
 	return "metric 10", nil
 }
 
+// StatusMetricsPrometheusString -
+func (sms *StatusMetricsStub) StatusMetricsPrometheusString(debugCounters []*debug.InterceptorResolverCounters) (string, error) {
+	if sms.StatusMetricsPrometheusStringCalled != nil {
+		return sms.StatusMetricsPrometheusStringCalled(debugCounters)
+	}
+
+	return "metric 10", nil
+}
+
 // ConfigMetrics -
 func (sms *StatusMetricsStub) ConfigMetrics() (map[string]interface{}, error) {
 	if sms.ConfigMetricsCalled != nil {
@@ -24,10 +24,15 @@ type TrieStub struct {
 	GetObsoleteHashesCalled         func() [][]byte
 	AppendToOldHashesCalled         func([][]byte)
 	GetSerializedNodesCalled        func([]byte, uint64) ([][]byte, uint64, error)
+	GetSerializedLeavesRangeCalled  func(rootHash []byte, startKey []byte, maxBuffToSend uint64) ([]byte, error)
 	GetAllHashesCalled              func() ([][]byte, error)
 	GetAllLeavesOnChannelCalled     func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, keyBuilder common.KeyBuilder, trieLeafParser common.TrieLeafParser) error
+	GetLeavesPageCalled             func(ctx context.Context, rootHash []byte, startKey []byte, maxLeaves int, keyPrefix []byte, trieLeafParser common.TrieLeafParser) (*common.TrieLeavesPage, error)
+	GetLeavesDiffCalled             func(ctx context.Context, newTrie common.Trie, trieLeafParser common.TrieLeafParser, handler func(leafDiff *common.TrieLeafDiff) error) error
 	GetProofCalled                  func(key []byte) ([][]byte, []byte, error)
 	VerifyProofCalled               func(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	GetMultiProofCalled             func(keys [][]byte) ([][]byte, [][]byte, error)
+	VerifyMultiProofCalled          func(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error)
 	GetStorageManagerCalled         func() common.StorageManager
 	GetSerializedNodeCalled         func(bytes []byte) ([]byte, error)
 	GetOldRootCalled                func() []byte
@@ -63,6 +68,24 @@ func (ts *TrieStub) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bo
 	return false, nil
 }
 
+// GetMultiProof -
+func (ts *TrieStub) GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error) {
+	if ts.GetMultiProofCalled != nil {
+		return ts.GetMultiProofCalled(keys)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyMultiProof -
+func (ts *TrieStub) VerifyMultiProof(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error) {
+	if ts.VerifyMultiProofCalled != nil {
+		return ts.VerifyMultiProofCalled(rootHash, keys, values, proof)
+	}
+
+	return false, nil
+}
+
 // GetAllLeavesOnChannel -
 func (ts *TrieStub) GetAllLeavesOnChannel(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, keyBuilder common.KeyBuilder, trieLeafParser common.TrieLeafParser) error {
 	if ts.GetAllLeavesOnChannelCalled != nil {
@@ -72,6 +95,24 @@ func (ts *TrieStub) GetAllLeavesOnChannel(leavesChannels *common.TrieIteratorCha
 	return nil
 }
 
+// GetLeavesPage -
+func (ts *TrieStub) GetLeavesPage(ctx context.Context, rootHash []byte, startKey []byte, maxLeaves int, keyPrefix []byte, trieLeafParser common.TrieLeafParser) (*common.TrieLeavesPage, error) {
+	if ts.GetLeavesPageCalled != nil {
+		return ts.GetLeavesPageCalled(ctx, rootHash, startKey, maxLeaves, keyPrefix, trieLeafParser)
+	}
+
+	return &common.TrieLeavesPage{}, nil
+}
+
+// GetLeavesDiff -
+func (ts *TrieStub) GetLeavesDiff(ctx context.Context, newTrie common.Trie, trieLeafParser common.TrieLeafParser, handler func(leafDiff *common.TrieLeafDiff) error) error {
+	if ts.GetLeavesDiffCalled != nil {
+		return ts.GetLeavesDiffCalled(ctx, newTrie, trieLeafParser, handler)
+	}
+
+	return nil
+}
+
 // Get -
 func (ts *TrieStub) Get(key []byte) ([]byte, uint32, error) {
 	if ts.GetCalled != nil {
@@ -180,6 +221,14 @@ func (ts *TrieStub) GetSerializedNodes(hash []byte, maxBuffToSend uint64) ([][]b
 	return nil, 0, nil
 }
 
+// GetSerializedLeavesRange -
+func (ts *TrieStub) GetSerializedLeavesRange(rootHash []byte, startKey []byte, maxBuffToSend uint64) ([]byte, error) {
+	if ts.GetSerializedLeavesRangeCalled != nil {
+		return ts.GetSerializedLeavesRangeCalled(rootHash, startKey, maxBuffToSend)
+	}
+	return nil, nil
+}
+
 // GetDirtyHashes -
 func (ts *TrieStub) GetDirtyHashes() (common.ModifiedHashes, error) {
 	return nil, nil
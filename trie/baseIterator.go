@@ -7,7 +7,9 @@ import (
 
 type baseIterator struct {
 	currentNode node
+	currentKey  []byte
 	nextNodes   []node
+	nextKeys    [][]byte
 	db          common.TrieStorageInteractor
 }
 
@@ -23,14 +25,16 @@ func newBaseIterator(trie common.Trie) (*baseIterator, error) {
 	}
 
 	trieStorage := trie.GetStorageManager()
-	nextNodes, err := pmt.root.getChildren(trieStorage)
+	nextNodes, nextKeys, err := getChildrenWithKeys(pmt.root, make([]byte, 0), trieStorage)
 	if err != nil {
 		return nil, err
 	}
 
 	return &baseIterator{
 		currentNode: pmt.root,
+		currentKey:  make([]byte, 0),
 		nextNodes:   nextNodes,
+		nextKeys:    nextKeys,
 		db:          trieStorage,
 	}, nil
 }
@@ -41,16 +45,17 @@ func (it *baseIterator) HasNext() bool {
 }
 
 // next moves the iterator to the next node
-func (it *baseIterator) next() ([]node, error) {
+func (it *baseIterator) next() ([]node, [][]byte, error) {
 	n := it.nextNodes[0]
 
 	err := n.isEmptyOrNil()
 	if err != nil {
-		return nil, ErrNilNode
+		return nil, nil, ErrNilNode
 	}
 
 	it.currentNode = n
-	return it.currentNode.getChildren(it.db)
+	it.currentKey = it.nextKeys[0]
+	return getChildrenWithKeys(it.currentNode, it.currentKey, it.db)
 }
 
 // MarshalizedNode marshalizes the current node, and then returns the serialized node
@@ -72,3 +77,34 @@ func (it *baseIterator) GetHash() ([]byte, error) {
 
 	return it.currentNode.getHash(), nil
 }
+
+// getChildrenWithKeys returns the children of the given node together with the hex key of the path leading to each
+// child, the key of the given node being the provided one
+func getChildrenWithKeys(n node, key []byte, db common.TrieStorageInteractor) ([]node, [][]byte, error) {
+	children, err := n.getChildren(db)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	childrenKeys := make([][]byte, 0, len(children))
+	switch typedNode := n.(type) {
+	case *branchNode:
+		for i := range typedNode.children {
+			if typedNode.children[i] == nil {
+				continue
+			}
+
+			childrenKeys = append(childrenKeys, concatKeys(key, []byte{byte(i)}))
+		}
+	case *extensionNode:
+		childrenKeys = append(childrenKeys, concatKeys(key, typedNode.Key))
+	}
+
+	return children, childrenKeys, nil
+}
+
+func concatKeys(key []byte, keyPart []byte) []byte {
+	newKey := make([]byte, 0, len(key)+len(keyPart))
+	newKey = append(newKey, key...)
+	return append(newKey, keyPart...)
+}
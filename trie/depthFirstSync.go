@@ -29,6 +29,7 @@ type depthFirstTrieSyncer struct {
 	timeoutHandler            TimeoutHandler
 	maxHardCapForMissingNodes int
 	checkNodesOnDisk          bool
+	checkMissingNodesOnDisk   bool
 	nodes                     *trieNodesHandler
 	requestedHashes           map[string]*request
 	leavesChan                chan core.KeyValueHolder
@@ -142,7 +143,7 @@ func (d *depthFirstTrieSyncer) requestMissingNodes() {
 			continue
 		}
 
-		n, errGet := d.getNodeFromCache([]byte(hash))
+		n, errGet := d.getMissingNode([]byte(hash))
 		if errGet == nil {
 			d.nodes.processMissingHashWasFound(n, hash)
 			delete(d.requestedHashes, hash)
@@ -199,7 +200,7 @@ func (d *depthFirstTrieSyncer) processMissingHashes() {
 			continue
 		}
 
-		n, err := d.getNodeFromCache([]byte(hash))
+		n, err := d.getMissingNode([]byte(hash))
 		if err != nil {
 			continue
 		}
@@ -289,6 +290,15 @@ func (d *depthFirstTrieSyncer) getNode(hash []byte) (node, error) {
 	return d.getNodeFromCache(hash)
 }
 
+// getMissingNode looks for a previously missing node only in the intercepted nodes cache, unless the syncer heals a
+// trie whose nodes were already written in the storage by other means, e.g. a leaves range sync
+func (d *depthFirstTrieSyncer) getMissingNode(hash []byte) (node, error) {
+	if d.checkMissingNodesOnDisk {
+		return d.getNode(hash)
+	}
+	return d.getNodeFromCache(hash)
+}
+
 func (d *depthFirstTrieSyncer) getNodeFromCache(hash []byte) (node, error) {
 	return getNodeFromCache(
 		hash,
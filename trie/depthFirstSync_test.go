@@ -209,3 +209,51 @@ func TestDepthFirstTrieSyncer_StartSyncingPartiallyFilledTrieShouldWork(t *testi
 		require.Equal(t, keyVal, val)
 	}
 }
+
+func TestDepthFirstTrieSyncer_GetMissingNode(t *testing.T) {
+	t.Parallel()
+
+	trSource, memUnitSource := createInMemoryTrie()
+	addDataToTrie(10, trSource)
+	_ = trSource.Commit()
+	rootHash, _ := trSource.RootHash()
+
+	createSyncerWithRootOnDisk := func(checkNodesOnDisk bool) *depthFirstTrieSyncer {
+		arg := createMockArgument(time.Minute)
+		arg.CheckNodesOnDisk = checkNodesOnDisk
+		encodedRoot, err := memUnitSource.Get(rootHash)
+		require.Nil(t, err)
+		_ = arg.DB.Put(rootHash, encodedRoot)
+
+		d, _ := NewDepthFirstTrieSyncer(arg)
+		return d
+	}
+
+	t.Run("node on disk should not be found if missing nodes are checked only in cache", func(t *testing.T) {
+		t.Parallel()
+
+		d := createSyncerWithRootOnDisk(true)
+
+		_, err := d.getMissingNode(rootHash)
+		assert.Equal(t, ErrNodeNotFound, err)
+	})
+	t.Run("node on disk should be found if missing nodes are checked on disk", func(t *testing.T) {
+		t.Parallel()
+
+		d := createSyncerWithRootOnDisk(true)
+		d.checkMissingNodesOnDisk = true
+
+		n, err := d.getMissingNode(rootHash)
+		require.Nil(t, err)
+		assert.Equal(t, rootHash, n.getHash())
+	})
+	t.Run("node on disk should not be found if nodes are not checked on disk", func(t *testing.T) {
+		t.Parallel()
+
+		d := createSyncerWithRootOnDisk(false)
+		d.checkMissingNodesOnDisk = true
+
+		_, err := d.getMissingNode(rootHash)
+		assert.Equal(t, ErrNodeNotFound, err)
+	})
+}
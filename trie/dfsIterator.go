@@ -1,9 +1,12 @@
 package trie
 
-import "github.com/multiversx/mx-chain-go/common"
+import (
+	"github.com/multiversx/mx-chain-go/common"
+)
 
 type dfsIterator struct {
 	*baseIterator
+	startKey []byte
 }
 
 // NewDFSIterator creates a new depth first traversal iterator
@@ -18,13 +21,63 @@ func NewDFSIterator(trie common.Trie) (*dfsIterator, error) {
 	}, nil
 }
 
+// NewDFSIteratorFromKey creates a new depth first traversal iterator which resumes the iteration from the given key.
+// The subtrees holding only keys placed before the start key in the traversal order are not loaded at all
+func NewDFSIteratorFromKey(trie common.Trie, startKey []byte) (*dfsIterator, error) {
+	it, err := NewDFSIterator(trie)
+	if err != nil {
+		return nil, err
+	}
+
+	it.startKey = startKeyToHex(startKey)
+	it.nextNodes, it.nextKeys = it.removeNodesBeforeStartKey(it.nextNodes, it.nextKeys)
+
+	return it, nil
+}
+
 // Next moves the iterator to the next node
 func (it *dfsIterator) Next() error {
-	nextChildren, err := it.next()
+	nextChildren, nextChildrenKeys, err := it.next()
 	if err != nil {
 		return err
 	}
 
+	nextChildren, nextChildrenKeys = it.removeNodesBeforeStartKey(nextChildren, nextChildrenKeys)
 	it.nextNodes = append(nextChildren, it.nextNodes[1:]...)
+	it.nextKeys = append(nextChildrenKeys, it.nextKeys[1:]...)
 	return nil
 }
+
+func (it *dfsIterator) removeNodesBeforeStartKey(nodes []node, keys [][]byte) ([]node, [][]byte) {
+	if len(it.startKey) == 0 {
+		return nodes, keys
+	}
+
+	// the nodes are sorted by their keys, so only a prefix of the slices can be placed before the start key
+	numNodesToRemove := 0
+	for numNodesToRemove < len(keys) && isSubtreeBeforeKey(keys[numNodesToRemove], it.startKey) {
+		numNodesToRemove++
+	}
+
+	return nodes[numNodesToRemove:], keys[numNodesToRemove:]
+}
+
+func startKeyToHex(startKey []byte) []byte {
+	if len(startKey) == 0 {
+		return nil
+	}
+
+	return keyBytesToHex(startKey)
+}
+
+// isSubtreeBeforeKey returns true if all the keys of the subtree found at the given path are placed before the
+// provided hex key in the traversal order
+func isSubtreeBeforeKey(path []byte, hexKey []byte) bool {
+	for i := 0; i < len(path) && i < len(hexKey); i++ {
+		if path[i] != hexKey[i] {
+			return path[i] < hexKey[i]
+		}
+	}
+
+	return false
+}
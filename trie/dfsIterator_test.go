@@ -39,3 +39,39 @@ func TestDFSIterator_Next(t *testing.T) {
 		assert.Nil(t, err)
 	}
 }
+
+func TestNewDFSIteratorFromKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		it, err := trie.NewDFSIteratorFromKey(nil, []byte("dog"))
+		assert.Equal(t, trie.ErrNilTrie, err)
+		assert.Nil(t, it)
+	})
+	t.Run("should skip the subtrees placed before the start key", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+
+		numNodesFromStart := 0
+		it, _ := trie.NewDFSIterator(tr)
+		for it.HasNext() {
+			err := it.Next()
+			assert.Nil(t, err)
+			numNodesFromStart++
+		}
+
+		numNodesFromKey := 0
+		it, err := trie.NewDFSIteratorFromKey(tr, []byte("dog"))
+		assert.Nil(t, err)
+		for it.HasNext() {
+			err = it.Next()
+			assert.Nil(t, err)
+			numNodesFromKey++
+		}
+
+		assert.Less(t, numNodesFromKey, numNodesFromStart)
+	})
+}
@@ -0,0 +1,375 @@
+package trie
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+)
+
+// nodeRef references a trie node which might not be loaded from the storage yet
+type nodeRef struct {
+	hash []byte
+	n    node
+}
+
+type diffIterator struct {
+	oldRoot        *nodeRef
+	newRoot        *nodeRef
+	oldDb          common.TrieStorageInteractor
+	newDb          common.TrieStorageInteractor
+	marshaller     marshal.Marshalizer
+	hasher         hashing.Hasher
+	trieLeafParser common.TrieLeafParser
+}
+
+// NewDiffIterator creates an iterator over the leaves which differ between two tries. The tries are walked in
+// parallel and the subtrees having the same hash in both tries are skipped without being loaded from the storage
+func NewDiffIterator(oldTrie common.Trie, newTrie common.Trie, trieLeafParser common.TrieLeafParser) (*diffIterator, error) {
+	if check.IfNil(oldTrie) || check.IfNil(newTrie) {
+		return nil, ErrNilTrie
+	}
+	if check.IfNil(trieLeafParser) {
+		return nil, ErrNilTrieLeafParser
+	}
+
+	oldPmt, ok := oldTrie.(*patriciaMerkleTrie)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+	newPmt, ok := newTrie.(*patriciaMerkleTrie)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return &diffIterator{
+		oldRoot:        oldPmt.getRootRef(),
+		newRoot:        newPmt.getRootRef(),
+		oldDb:          oldPmt.GetStorageManager(),
+		newDb:          newPmt.GetStorageManager(),
+		marshaller:     oldPmt.marshalizer,
+		hasher:         oldPmt.hasher,
+		trieLeafParser: trieLeafParser,
+	}, nil
+}
+
+// Iterate calls the handler for each leaf which was added, removed or modified between the old and the new trie
+func (it *diffIterator) Iterate(ctx context.Context, handler func(leafDiff *common.TrieLeafDiff) error) error {
+	if handler == nil {
+		return ErrNilLeafDiffHandler
+	}
+
+	return it.diffNodes(ctx, it.oldRoot, it.newRoot, keyBuilder.NewKeyBuilder(), handler)
+}
+
+func (it *diffIterator) diffNodes(
+	ctx context.Context,
+	oldRef *nodeRef,
+	newRef *nodeRef,
+	kb common.KeyBuilder,
+	handler func(leafDiff *common.TrieLeafDiff) error,
+) error {
+	if common.IsContextDone(ctx) {
+		return core.ErrContextClosing
+	}
+	if oldRef == nil && newRef == nil {
+		return nil
+	}
+	if haveSameHash(oldRef, newRef) {
+		return nil
+	}
+
+	oldNode, err := it.loadNode(oldRef, it.oldDb)
+	if err != nil {
+		return err
+	}
+	newNode, err := it.loadNode(newRef, it.newDb)
+	if err != nil {
+		return err
+	}
+
+	_, isOldLeaf := oldNode.(*leafNode)
+	_, isNewLeaf := newNode.(*leafNode)
+	if oldNode == nil || newNode == nil || isOldLeaf || isNewLeaf {
+		return it.diffLeaves(ctx, oldNode, newNode, kb, handler)
+	}
+
+	oldEn, isOldExtension := oldNode.(*extensionNode)
+	newEn, isNewExtension := newNode.(*extensionNode)
+	if isOldExtension && isNewExtension && bytes.Equal(oldEn.Key, newEn.Key) {
+		kb.BuildKey(oldEn.Key)
+		return it.diffNodes(ctx, getChildRef(oldEn.child, oldEn.EncodedChild), getChildRef(newEn.child, newEn.EncodedChild), kb, handler)
+	}
+
+	oldChildren, err := it.getChildrenRefs(oldNode)
+	if err != nil {
+		return err
+	}
+	newChildren, err := it.getChildrenRefs(newNode)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < nrOfChildren; i++ {
+		clonedKeyBuilder := kb.Clone()
+		clonedKeyBuilder.BuildKey([]byte{byte(i)})
+		err = it.diffNodes(ctx, oldChildren[i], newChildren[i], clonedKeyBuilder, handler)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (it *diffIterator) loadNode(ref *nodeRef, db common.TrieStorageInteractor) (node, error) {
+	if ref == nil {
+		return nil, nil
+	}
+	if ref.n != nil {
+		return ref.n, nil
+	}
+
+	n, err := getNodeFromDBAndDecode(ref.hash, db, it.marshaller, it.hasher)
+	if err != nil {
+		return nil, err
+	}
+	n.setGivenHash(ref.hash)
+
+	return n, nil
+}
+
+// getChildrenRefs returns the children of a branch node. An extension node is seen as a branch node having a single
+// child, so that it can be compared with a branch node or with an extension node having a different key
+func (it *diffIterator) getChildrenRefs(n node) ([]*nodeRef, error) {
+	children := make([]*nodeRef, nrOfChildren)
+
+	switch typedNode := n.(type) {
+	case *branchNode:
+		for i := range typedNode.children {
+			children[i] = getChildRef(typedNode.children[i], typedNode.EncodedChildren[i])
+		}
+	case *extensionNode:
+		if len(typedNode.Key) == 0 || childPosOutOfRange(typedNode.Key[0]) {
+			return nil, ErrInvalidNode
+		}
+
+		pos := typedNode.Key[0]
+		if len(typedNode.Key) == 1 {
+			children[pos] = getChildRef(typedNode.child, typedNode.EncodedChild)
+			break
+		}
+
+		children[pos] = &nodeRef{
+			n: &extensionNode{
+				CollapsedEn: CollapsedEn{
+					Key:          typedNode.Key[1:],
+					EncodedChild: typedNode.EncodedChild,
+					ChildVersion: typedNode.ChildVersion,
+				},
+				child: typedNode.child,
+				baseNode: &baseNode{
+					marsh:  it.marshaller,
+					hasher: it.hasher,
+				},
+			},
+		}
+	default:
+		return nil, ErrInvalidNode
+	}
+
+	return children, nil
+}
+
+// diffLeaves compares the subtrees by their leaves. It is used when at least one of the subtrees is a leaf or is
+// missing, so the subtrees can not be compared node by node anymore. The leaves are streamed to the handler while
+// walking the subtrees, so a subtree is never held in memory
+func (it *diffIterator) diffLeaves(
+	ctx context.Context,
+	oldNode node,
+	newNode node,
+	kb common.KeyBuilder,
+	handler func(leafDiff *common.TrieLeafDiff) error,
+) error {
+	if oldNode == nil {
+		return it.walkLeaves(ctx, newNode, it.newDb, kb.Clone(), func(leaf core.KeyValueHolder) error {
+			return handler(&common.TrieLeafDiff{Key: leaf.Key(), NewValue: leaf.Value()})
+		})
+	}
+	if newNode == nil {
+		return it.walkLeaves(ctx, oldNode, it.oldDb, kb.Clone(), func(leaf core.KeyValueHolder) error {
+			return handler(&common.TrieLeafDiff{Key: leaf.Key(), OldValue: leaf.Value()})
+		})
+	}
+
+	_, isOldLeaf := oldNode.(*leafNode)
+	if isOldLeaf {
+		return it.diffLoneLeaf(ctx, oldNode, it.oldDb, newNode, it.newDb, kb, true, handler)
+	}
+
+	return it.diffLoneLeaf(ctx, newNode, it.newDb, oldNode, it.oldDb, kb, false, handler)
+}
+
+// diffLoneLeaf compares a leaf with the subtree found at the same position in the other trie. The subtree is walked
+// once and each of its leaves is compared with the lone leaf, which is reported after the subtree leaves if it was
+// not found in the subtree
+func (it *diffIterator) diffLoneLeaf(
+	ctx context.Context,
+	loneNode node,
+	loneDb common.TrieStorageInteractor,
+	otherNode node,
+	otherDb common.TrieStorageInteractor,
+	kb common.KeyBuilder,
+	isLoneLeafOld bool,
+	handler func(leafDiff *common.TrieLeafDiff) error,
+) error {
+	var loneLeaf core.KeyValueHolder
+	err := it.walkLeaves(ctx, loneNode, loneDb, kb.Clone(), func(leaf core.KeyValueHolder) error {
+		loneLeaf = leaf
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	createLeafDiff := func(key []byte, loneValue []byte, otherValue []byte) *common.TrieLeafDiff {
+		if isLoneLeafOld {
+			return &common.TrieLeafDiff{Key: key, OldValue: loneValue, NewValue: otherValue}
+		}
+
+		return &common.TrieLeafDiff{Key: key, OldValue: otherValue, NewValue: loneValue}
+	}
+
+	isLoneLeafFound := false
+	err = it.walkLeaves(ctx, otherNode, otherDb, kb.Clone(), func(leaf core.KeyValueHolder) error {
+		if !bytes.Equal(leaf.Key(), loneLeaf.Key()) {
+			return handler(createLeafDiff(leaf.Key(), nil, leaf.Value()))
+		}
+
+		isLoneLeafFound = true
+		if bytes.Equal(leaf.Value(), loneLeaf.Value()) {
+			return nil
+		}
+
+		return handler(createLeafDiff(leaf.Key(), loneLeaf.Value(), leaf.Value()))
+	})
+	if err != nil {
+		return err
+	}
+	if isLoneLeafFound {
+		return nil
+	}
+
+	return handler(createLeafDiff(loneLeaf.Key(), loneLeaf.Value(), nil))
+}
+
+func (it *diffIterator) walkLeaves(
+	ctx context.Context,
+	n node,
+	db common.TrieStorageInteractor,
+	kb common.KeyBuilder,
+	leafHandler func(leaf core.KeyValueHolder) error,
+) error {
+	if common.IsContextDone(ctx) {
+		return core.ErrContextClosing
+	}
+
+	switch typedNode := n.(type) {
+	case nil:
+		return nil
+	case *leafNode:
+		kb.BuildKey(typedNode.Key)
+		key, err := kb.GetKey()
+		if err != nil {
+			return err
+		}
+
+		version, err := typedNode.getVersion()
+		if err != nil {
+			return err
+		}
+
+		leaf, err := it.trieLeafParser.ParseLeaf(key, typedNode.Value, version)
+		if err != nil {
+			return err
+		}
+
+		return leafHandler(leaf)
+	case *extensionNode:
+		child, err := it.loadNode(getChildRef(typedNode.child, typedNode.EncodedChild), db)
+		if err != nil {
+			return err
+		}
+
+		kb.BuildKey(typedNode.Key)
+		return it.walkLeaves(ctx, child, db, kb, leafHandler)
+	case *branchNode:
+		for i := range typedNode.children {
+			child, err := it.loadNode(getChildRef(typedNode.children[i], typedNode.EncodedChildren[i]), db)
+			if err != nil {
+				return err
+			}
+			if child == nil {
+				continue
+			}
+
+			clonedKeyBuilder := kb.Clone()
+			clonedKeyBuilder.BuildKey([]byte{byte(i)})
+			err = it.walkLeaves(ctx, child, db, clonedKeyBuilder, leafHandler)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return ErrInvalidNode
+	}
+}
+
+// getChildRef returns the reference of a child. The hash of a dirty child is not known, as the encoded hash kept by
+// its parent is not updated until the trie is committed
+func getChildRef(child node, encodedChild []byte) *nodeRef {
+	if !check.IfNil(child) {
+		return &nodeRef{
+			hash: getNodeHashIfNotDirty(child),
+			n:    child,
+		}
+	}
+	if len(encodedChild) == 0 {
+		return nil
+	}
+
+	return &nodeRef{
+		hash: encodedChild,
+	}
+}
+
+func getNodeHashIfNotDirty(n node) []byte {
+	if n.isDirty() {
+		return nil
+	}
+
+	return n.getHash()
+}
+
+func haveSameHash(oldRef *nodeRef, newRef *nodeRef) bool {
+	if oldRef == nil || newRef == nil {
+		return false
+	}
+	if len(oldRef.hash) == 0 {
+		return false
+	}
+
+	return bytes.Equal(oldRef.hash, newRef.hash)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (it *diffIterator) IsInterfaceNil() bool {
+	return it == nil
+}
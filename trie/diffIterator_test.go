@@ -0,0 +1,258 @@
+package trie_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state/parsers"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type getCounterStorageManager struct {
+	common.StorageManager
+	numGets uint32
+}
+
+func (storage *getCounterStorageManager) Get(key []byte) ([]byte, error) {
+	atomic.AddUint32(&storage.numGets, 1)
+	return storage.StorageManager.Get(key)
+}
+
+func collectLeavesDiff(t *testing.T, oldTrie common.Trie, newTrie common.Trie) map[string]*common.TrieLeafDiff {
+	diffs := make(map[string]*common.TrieLeafDiff)
+	err := oldTrie.GetLeavesDiff(context.Background(), newTrie, parsers.NewMainTrieLeafParser(), func(leafDiff *common.TrieLeafDiff) error {
+		diffs[string(leafDiff.Key)] = leafDiff
+		return nil
+	})
+	require.Nil(t, err)
+
+	return diffs
+}
+
+func TestNewDiffIterator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil old trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		it, err := trie.NewDiffIterator(nil, emptyTrie(), parsers.NewMainTrieLeafParser())
+		assert.Nil(t, it)
+		assert.Equal(t, trie.ErrNilTrie, err)
+	})
+	t.Run("nil new trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		it, err := trie.NewDiffIterator(emptyTrie(), nil, parsers.NewMainTrieLeafParser())
+		assert.Nil(t, it)
+		assert.Equal(t, trie.ErrNilTrie, err)
+	})
+	t.Run("nil trie leaf parser should error", func(t *testing.T) {
+		t.Parallel()
+
+		it, err := trie.NewDiffIterator(emptyTrie(), emptyTrie(), nil)
+		assert.Nil(t, it)
+		assert.Equal(t, trie.ErrNilTrieLeafParser, err)
+	})
+	t.Run("wrong trie type should error", func(t *testing.T) {
+		t.Parallel()
+
+		it, err := trie.NewDiffIterator(emptyTrie(), &trieMock.TrieStub{}, parsers.NewMainTrieLeafParser())
+		assert.Nil(t, it)
+		assert.Equal(t, trie.ErrWrongTypeAssertion, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		it, err := trie.NewDiffIterator(emptyTrie(), emptyTrie(), parsers.NewMainTrieLeafParser())
+		assert.Nil(t, err)
+		assert.False(t, it.IsInterfaceNil())
+	})
+}
+
+func TestDiffIterator_Iterate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		it, _ := trie.NewDiffIterator(initTrie(), emptyTrie(), parsers.NewMainTrieLeafParser())
+		err := it.Iterate(context.Background(), nil)
+		assert.Equal(t, trie.ErrNilLeafDiffHandler, err)
+	})
+	t.Run("closed context should error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		it, _ := trie.NewDiffIterator(initTrie(), emptyTrie(), parsers.NewMainTrieLeafParser())
+		err := it.Iterate(ctx, func(_ *common.TrieLeafDiff) error {
+			return nil
+		})
+		assert.Equal(t, core.ErrContextClosing, err)
+	})
+	t.Run("handler error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := fmt.Errorf("expected error")
+		it, _ := trie.NewDiffIterator(initTrie(), emptyTrie(), parsers.NewMainTrieLeafParser())
+		err := it.Iterate(context.Background(), func(_ *common.TrieLeafDiff) error {
+			return expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestPatriciaMerkleTrie_GetLeavesDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty tries should not report differences", func(t *testing.T) {
+		t.Parallel()
+
+		diffs := collectLeavesDiff(t, emptyTrie(), emptyTrie())
+		assert.Equal(t, 0, len(diffs))
+	})
+	t.Run("identical tries should not report differences", func(t *testing.T) {
+		t.Parallel()
+
+		diffs := collectLeavesDiff(t, initTrie(), initTrie())
+		assert.Equal(t, 0, len(diffs))
+	})
+	t.Run("empty old trie should report all leaves as added", func(t *testing.T) {
+		t.Parallel()
+
+		diffs := collectLeavesDiff(t, emptyTrie(), initTrie())
+		require.Equal(t, 3, len(diffs))
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("doe"), NewValue: []byte("reindeer")}, diffs["doe"])
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("dog"), NewValue: []byte("puppy")}, diffs["dog"])
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("ddog"), NewValue: []byte("cat")}, diffs["ddog"])
+	})
+	t.Run("empty new trie should report all leaves as removed", func(t *testing.T) {
+		t.Parallel()
+
+		diffs := collectLeavesDiff(t, initTrie(), emptyTrie())
+		require.Equal(t, 3, len(diffs))
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("doe"), OldValue: []byte("reindeer")}, diffs["doe"])
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("dog"), OldValue: []byte("puppy")}, diffs["dog"])
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("ddog"), OldValue: []byte("cat")}, diffs["ddog"])
+	})
+	t.Run("added, removed and modified leaves should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		oldTrie := initTrie()
+		newTrie := initTrie()
+		_ = newTrie.Update([]byte("doe"), []byte("deer"))
+		_ = newTrie.Delete([]byte("ddog"))
+		_ = newTrie.Update([]byte("dodge"), []byte("car"))
+
+		diffs := collectLeavesDiff(t, oldTrie, newTrie)
+		require.Equal(t, 3, len(diffs))
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("doe"), OldValue: []byte("reindeer"), NewValue: []byte("deer")}, diffs["doe"])
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("ddog"), OldValue: []byte("cat")}, diffs["ddog"])
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("dodge"), NewValue: []byte("car")}, diffs["dodge"])
+	})
+	t.Run("restructured tries should only report the changed leaves", func(t *testing.T) {
+		t.Parallel()
+
+		oldTrie := emptyTrie()
+		_ = oldTrie.Update([]byte("doe"), []byte("reindeer"))
+		_ = oldTrie.Update([]byte("dog"), []byte("puppy"))
+
+		newTrie := emptyTrie()
+		_ = newTrie.Update([]byte("doe"), []byte("reindeer"))
+		_ = newTrie.Update([]byte("dog"), []byte("puppy"))
+		_ = newTrie.Update([]byte("cat"), []byte("kitten"))
+
+		diffs := collectLeavesDiff(t, oldTrie, newTrie)
+		require.Equal(t, 1, len(diffs))
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("cat"), NewValue: []byte("kitten")}, diffs["cat"])
+
+		diffs = collectLeavesDiff(t, newTrie, oldTrie)
+		require.Equal(t, 1, len(diffs))
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("cat"), OldValue: []byte("kitten")}, diffs["cat"])
+	})
+	t.Run("leaf compared with a subtree should report the changed leaves", func(t *testing.T) {
+		t.Parallel()
+
+		singleLeafTrie := emptyTrie()
+		_ = singleLeafTrie.Update([]byte("doe"), []byte("deer"))
+
+		diffs := collectLeavesDiff(t, singleLeafTrie, initTrie())
+		require.Equal(t, 3, len(diffs))
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("doe"), OldValue: []byte("deer"), NewValue: []byte("reindeer")}, diffs["doe"])
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("dog"), NewValue: []byte("puppy")}, diffs["dog"])
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("ddog"), NewValue: []byte("cat")}, diffs["ddog"])
+
+		diffs = collectLeavesDiff(t, initTrie(), singleLeafTrie)
+		require.Equal(t, 3, len(diffs))
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("doe"), OldValue: []byte("reindeer"), NewValue: []byte("deer")}, diffs["doe"])
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("dog"), OldValue: []byte("puppy")}, diffs["dog"])
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("ddog"), OldValue: []byte("cat")}, diffs["ddog"])
+
+		singleLeafTrie = emptyTrie()
+		_ = singleLeafTrie.Update([]byte("cat"), []byte("kitten"))
+
+		diffs = collectLeavesDiff(t, singleLeafTrie, initTrie())
+		require.Equal(t, 4, len(diffs))
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("cat"), OldValue: []byte("kitten")}, diffs["cat"])
+		assert.Equal(t, &common.TrieLeafDiff{Key: []byte("doe"), NewValue: []byte("reindeer")}, diffs["doe"])
+	})
+	t.Run("committed tries should be compared", func(t *testing.T) {
+		t.Parallel()
+
+		tr, values := initTrieMultipleValues(100)
+		_ = tr.Commit()
+		oldRootHash, _ := tr.RootHash()
+
+		_ = tr.Update(values[10], []byte("new value"))
+		_ = tr.Delete(values[20])
+		_ = tr.Commit()
+		newRootHash, _ := tr.RootHash()
+
+		oldTrie, _ := tr.Recreate(oldRootHash)
+		newTrie, _ := tr.Recreate(newRootHash)
+		diffs := collectLeavesDiff(t, oldTrie, newTrie)
+		require.Equal(t, 2, len(diffs))
+		assert.Equal(t, &common.TrieLeafDiff{Key: values[10], OldValue: values[10], NewValue: []byte("new value")}, diffs[string(values[10])])
+		assert.Equal(t, &common.TrieLeafDiff{Key: values[20], OldValue: values[20]}, diffs[string(values[20])])
+	})
+	t.Run("identical subtrees should not be loaded from the storage", func(t *testing.T) {
+		t.Parallel()
+
+		storage, marshaller, hasher, enableEpochsHandler, maxTrieLevelInMemory := getDefaultTrieParameters()
+		counterStorage := &getCounterStorageManager{
+			StorageManager: storage,
+		}
+		tr, _ := trie.NewTrie(counterStorage, marshaller, hasher, enableEpochsHandler, maxTrieLevelInMemory)
+
+		numLeaves := 1000
+		keys := make([][]byte, 0, numLeaves)
+		for i := 0; i < numLeaves; i++ {
+			key := hasher.Compute(fmt.Sprint(i))
+			keys = append(keys, key)
+			_ = tr.Update(key, key)
+		}
+		_ = tr.Commit()
+		oldRootHash, _ := tr.RootHash()
+
+		_ = tr.Update(keys[0], []byte("new value"))
+		_ = tr.Commit()
+		newRootHash, _ := tr.RootHash()
+
+		oldTrie, _ := tr.Recreate(oldRootHash)
+		newTrie, _ := tr.Recreate(newRootHash)
+		atomic.StoreUint32(&counterStorage.numGets, 0)
+
+		diffs := collectLeavesDiff(t, oldTrie, newTrie)
+		require.Equal(t, 1, len(diffs))
+		assert.Equal(t, []byte("new value"), diffs[string(keys[0])].NewValue)
+		assert.Less(t, atomic.LoadUint32(&counterStorage.numGets), uint32(20))
+	})
+}
@@ -123,3 +123,18 @@ var ErrNilTrieLeafParser = errors.New("nil trie leaf parser")
 
 // ErrInvalidNodeVersion signals that an invalid node version has been provided
 var ErrInvalidNodeVersion = errors.New("invalid node version provided")
+
+// ErrNilLeafDiffHandler signals that a nil leaf diff handler has been provided
+var ErrNilLeafDiffHandler = errors.New("nil leaf diff handler")
+
+// ErrInvalidMaxLeaves signals that an invalid maximum number of leaves has been provided
+var ErrInvalidMaxLeaves = errors.New("invalid maximum number of leaves")
+
+// ErrKeysAndValuesLengthMismatch signals that the number of keys differs from the number of values
+var ErrKeysAndValuesLengthMismatch = errors.New("keys and values length mismatch")
+
+// ErrInvalidLeavesRange signals that the received leaves range does not match its boundary proof
+var ErrInvalidLeavesRange = errors.New("invalid leaves range")
+
+// ErrLeavesRangeNotReceived signals that a requested leaves range has not been received
+var ErrLeavesRangeNotReceived = errors.New("leaves range not received")
@@ -1,6 +1,7 @@
 package trie
 
 import (
+	"context"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/marshal"
@@ -111,3 +112,16 @@ func GetDefaultTrieStorageManagerParameters() NewTrieStorageManagerArgs {
 		StatsCollector: statistics.NewStateStatistics(),
 	}
 }
+
+// GetLeavesPageWithMaxVisitedNodes -
+func GetLeavesPageWithMaxVisitedNodes(
+	tr common.Trie,
+	rootHash []byte,
+	startKey []byte,
+	maxLeaves int,
+	keyPrefix []byte,
+	trieLeafParser common.TrieLeafParser,
+	maxVisitedNodes int,
+) (*common.TrieLeavesPage, error) {
+	return tr.(*patriciaMerkleTrie).getLeavesPage(context.Background(), rootHash, startKey, maxLeaves, keyPrefix, trieLeafParser, maxVisitedNodes)
+}
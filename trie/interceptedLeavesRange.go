@@ -0,0 +1,144 @@
+package trie
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/process"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var _ process.InterceptedData = (*InterceptedLeavesRange)(nil)
+
+// InterceptedLeavesRange implements intercepted data interface and is used when trie leaves ranges are intercepted
+type InterceptedLeavesRange struct {
+	leavesRange   *LeavesRange
+	marshalizer   marshal.Marshalizer
+	hasher        hashing.Hasher
+	hash          []byte
+	sizeInBytes   int
+	mutex         sync.RWMutex
+	hasMoreLeaves bool
+	verifiedNodes [][]byte
+	isVerified    bool
+}
+
+// NewInterceptedLeavesRange creates a new instance of InterceptedLeavesRange
+func NewInterceptedLeavesRange(
+	buff []byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*InterceptedLeavesRange, error) {
+	if len(buff) == 0 {
+		return nil, ErrValueTooShort
+	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	leavesRange := &LeavesRange{}
+	err := marshalizer.Unmarshal(leavesRange, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InterceptedLeavesRange{
+		leavesRange: leavesRange,
+		marshalizer: marshalizer,
+		hasher:      hasher,
+		hash:        computeLeavesRangeKey(hasher, leavesRange.RootHash, leavesRange.StartKey),
+		sizeInBytes: len(buff),
+	}, nil
+}
+
+// CheckValidity checks the leaves range against its boundary proof
+func (inLr *InterceptedLeavesRange) CheckValidity() error {
+	hasMoreLeaves, verifiedNodes, err := verifyLeavesRange(inLr.leavesRange, inLr.marshalizer, inLr.hasher)
+	if err != nil {
+		return err
+	}
+
+	inLr.mutex.Lock()
+	inLr.hasMoreLeaves = hasMoreLeaves
+	inLr.verifiedNodes = verifiedNodes
+	inLr.isVerified = true
+	inLr.mutex.Unlock()
+
+	return nil
+}
+
+// IsForCurrentShard checks if the intercepted data is for the current shard
+func (inLr *InterceptedLeavesRange) IsForCurrentShard() bool {
+	return true
+}
+
+// Hash returns the key of the intercepted leaves range, computed from the root hash and the start key
+func (inLr *InterceptedLeavesRange) Hash() []byte {
+	return inLr.hash
+}
+
+// RootHash returns the root hash of the trie the leaves range belongs to
+func (inLr *InterceptedLeavesRange) RootHash() []byte {
+	return inLr.leavesRange.RootHash
+}
+
+// Keys returns the keys of the leaves held by the range
+func (inLr *InterceptedLeavesRange) Keys() [][]byte {
+	return inLr.leavesRange.Keys
+}
+
+// HasMoreLeaves returns true if the trie holds more leaves after the ones held by the range
+func (inLr *InterceptedLeavesRange) HasMoreLeaves() bool {
+	inLr.mutex.RLock()
+	defer inLr.mutex.RUnlock()
+
+	return inLr.hasMoreLeaves
+}
+
+// VerifiedNodes returns the encoded trie nodes proven by the leaves range. It returns ErrInvalidLeavesRange if the
+// range was not checked yet
+func (inLr *InterceptedLeavesRange) VerifiedNodes() ([][]byte, error) {
+	inLr.mutex.RLock()
+	defer inLr.mutex.RUnlock()
+
+	if !inLr.isVerified {
+		return nil, ErrInvalidLeavesRange
+	}
+
+	return inLr.verifiedNodes, nil
+}
+
+// Type returns the type of this intercepted data
+func (inLr *InterceptedLeavesRange) Type() string {
+	return "intercepted trie leaves range"
+}
+
+// String returns the leaves range's most important fields as string
+func (inLr *InterceptedLeavesRange) String() string {
+	return fmt.Sprintf("root hash=%s, start key=%s, num leaves=%d",
+		logger.DisplayByteSlice(inLr.leavesRange.RootHash),
+		logger.DisplayByteSlice(inLr.leavesRange.StartKey),
+		len(inLr.leavesRange.Keys),
+	)
+}
+
+// SizeInBytes returns the size in bytes of the serialized leaves range
+func (inLr *InterceptedLeavesRange) SizeInBytes() int {
+	return inLr.sizeInBytes
+}
+
+// Identifiers returns the identifiers used in requests
+func (inLr *InterceptedLeavesRange) Identifiers() [][]byte {
+	return [][]byte{inLr.leavesRange.RootHash}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (inLr *InterceptedLeavesRange) IsInterfaceNil() bool {
+	return inLr == nil
+}
@@ -70,6 +70,7 @@ type snapshotNode interface {
 // RequestHandler defines the methods through which request to data can be made
 type RequestHandler interface {
 	RequestTrieNodes(destShardID uint32, hashes [][]byte, topic string)
+	RequestTrieLeavesRange(destShardID uint32, rootHash []byte, startKey []byte, topic string)
 	RequestInterval() time.Duration
 	IsInterfaceNil() bool
 }
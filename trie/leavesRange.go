@@ -0,0 +1,123 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. leavesRange.proto
+package trie
+
+import (
+	"bytes"
+
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+)
+
+// GetSerializedLeavesRange returns the serialized range of leaves of the trie found at the given root hash which are
+// placed after the given start key, in the traversal order of the trie. The range holds at least one leaf, if there
+// is one after the start key, and the next leaves are added only while their size fits in the provided buffer size.
+// The range also holds the proof of its boundaries, so the receiver can check that no leaf was left out
+func (tr *patriciaMerkleTrie) GetSerializedLeavesRange(rootHash []byte, startKey []byte, maxBuffToSend uint64) ([]byte, error) {
+	newTrie, err := tr.recreate(rootHash, tr.trieStorage)
+	if err != nil {
+		return nil, err
+	}
+
+	tr.trieStorage.EnterPruningBufferingMode()
+	defer tr.trieStorage.ExitPruningBufferingMode()
+
+	leavesRange := &LeavesRange{
+		RootHash: rootHash,
+		StartKey: startKey,
+	}
+	if newTrie.root != nil {
+		err = newTrie.addLeavesToRange(leavesRange, maxBuffToSend)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	boundaryKeys := make([][]byte, 0, 2)
+	if len(startKey) > 0 {
+		boundaryKeys = append(boundaryKeys, startKey)
+	}
+	if len(leavesRange.Keys) > 0 {
+		boundaryKeys = append(boundaryKeys, leavesRange.Keys[len(leavesRange.Keys)-1])
+	}
+
+	leavesRange.Proof, _, err = newTrie.GetMultiProof(boundaryKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return tr.marshalizer.Marshal(leavesRange)
+}
+
+func (tr *patriciaMerkleTrie) addLeavesToRange(leavesRange *LeavesRange, maxBuffToSend uint64) error {
+	it, err := NewDFSIteratorFromKey(tr, leavesRange.StartKey)
+	if err != nil {
+		return err
+	}
+
+	hexStartKey := startKeyToHex(leavesRange.StartKey)
+	rangeSize := uint64(0)
+	for {
+		ln, isLeaf := it.currentNode.(*leafNode)
+		if isLeaf {
+			hexKey := concatKeys(it.currentKey, ln.Key)
+			leafSize := uint64(len(hexKey)/2 + len(ln.Value))
+			isRangeFull := len(leavesRange.Keys) > 0 && rangeSize+leafSize > maxBuffToSend
+			if isRangeFull {
+				return nil
+			}
+
+			if isKeyAfterStartKey(hexKey, hexStartKey) {
+				err = addLeafToRange(leavesRange, hexKey, ln)
+				if err != nil {
+					return err
+				}
+				rangeSize += leafSize
+			}
+		}
+
+		if !it.HasNext() {
+			return nil
+		}
+
+		err = it.Next()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func addLeafToRange(leavesRange *LeavesRange, hexKey []byte, ln *leafNode) error {
+	kb := keyBuilder.NewKeyBuilder()
+	kb.BuildKey(hexKey)
+	key, err := kb.GetKey()
+	if err != nil {
+		return err
+	}
+
+	version, err := ln.getVersion()
+	if err != nil {
+		return err
+	}
+
+	leavesRange.Keys = append(leavesRange.Keys, key)
+	leavesRange.Values = append(leavesRange.Values, ln.Value)
+	leavesRange.Versions = append(leavesRange.Versions, uint32(version))
+
+	return nil
+}
+
+// isKeyAfterStartKey returns true if the given hex key is placed after the hex start key in the traversal order.
+// All the keys are placed after an empty start key
+func isKeyAfterStartKey(hexKey []byte, hexStartKey []byte) bool {
+	if len(hexStartKey) == 0 {
+		return true
+	}
+
+	return bytes.Compare(hexKey, hexStartKey) > 0
+}
+
+// computeLeavesRangeKey returns the key under which the leaves range found at the given root hash, after the given
+// start key, is kept while syncing
+func computeLeavesRangeKey(hasher hashing.Hasher, rootHash []byte, startKey []byte) []byte {
+	return hasher.Compute(string(concatKeys(rootHash, startKey)))
+}
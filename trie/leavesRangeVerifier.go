@@ -0,0 +1,273 @@
+package trie
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type rangeLeaf struct {
+	hexKey   []byte
+	value    []byte
+	version  core.TrieNodeVersion
+	verified bool
+}
+
+// leavesRangeVerifier checks a leaves range against its boundary proof. The subtrees which hold keys placed both inside
+// and outside the range are found in the proof, while the subtrees holding only keys placed inside the range are
+// rebuilt from the range leaves and their hashes are checked against the hashes referenced by the proof nodes
+type leavesRangeVerifier struct {
+	marshalizer   marshal.Marshalizer
+	hasher        hashing.Hasher
+	proofNodes    map[string][]byte
+	leaves        []*rangeLeaf
+	leftBound     []byte
+	rightBound    []byte
+	hasMoreLeaves bool
+	encodedNodes  [][]byte
+}
+
+// verifyLeavesRange checks that the given range holds all the leaves of the trie which are placed after the range
+// start key, up to the last leaf of the range. It returns true if the trie holds more leaves after the range, together
+// with the encoded trie nodes which were proven by the range
+func verifyLeavesRange(leavesRange *LeavesRange, marshalizer marshal.Marshalizer, hasher hashing.Hasher) (bool, [][]byte, error) {
+	v, err := newLeavesRangeVerifier(leavesRange, marshalizer, hasher)
+	if err != nil {
+		return false, nil, err
+	}
+
+	err = v.verifyChild(leavesRange.RootHash, make([]byte, 0))
+	if err != nil {
+		return false, nil, err
+	}
+
+	for _, leaf := range v.leaves {
+		if !leaf.verified {
+			return false, nil, fmt.Errorf("%w: leaf %x is not part of the trie", ErrInvalidLeavesRange, leaf.hexKey)
+		}
+	}
+
+	return v.hasMoreLeaves, v.encodedNodes, nil
+}
+
+func newLeavesRangeVerifier(leavesRange *LeavesRange, marshalizer marshal.Marshalizer, hasher hashing.Hasher) (*leavesRangeVerifier, error) {
+	numLeaves := len(leavesRange.Keys)
+	if len(leavesRange.Values) != numLeaves || len(leavesRange.Versions) != numLeaves {
+		return nil, fmt.Errorf("%w: keys, values and versions length mismatch", ErrInvalidLeavesRange)
+	}
+	if common.IsEmptyTrie(leavesRange.RootHash) {
+		return nil, fmt.Errorf("%w: empty root hash", ErrInvalidLeavesRange)
+	}
+
+	v := &leavesRangeVerifier{
+		marshalizer:  marshalizer,
+		hasher:       hasher,
+		proofNodes:   make(map[string][]byte, len(leavesRange.Proof)),
+		leaves:       make([]*rangeLeaf, 0, numLeaves),
+		leftBound:    startKeyToHex(leavesRange.StartKey),
+		encodedNodes: make([][]byte, 0),
+	}
+
+	for _, encodedNode := range leavesRange.Proof {
+		v.proofNodes[string(hasher.Compute(string(encodedNode)))] = encodedNode
+	}
+
+	previousKey := v.leftBound
+	for i := 0; i < numLeaves; i++ {
+		hexKey := keyBytesToHex(leavesRange.Keys[i])
+		if !isKeyAfterStartKey(hexKey, previousKey) {
+			return nil, fmt.Errorf("%w: leaf %x is not placed after the previous one", ErrInvalidLeavesRange, hexKey)
+		}
+		if len(leavesRange.Values[i]) == 0 {
+			return nil, fmt.Errorf("%w: empty value for leaf %x", ErrInvalidLeavesRange, hexKey)
+		}
+
+		v.leaves = append(v.leaves, &rangeLeaf{
+			hexKey:  hexKey,
+			value:   leavesRange.Values[i],
+			version: core.TrieNodeVersion(leavesRange.Versions[i]),
+		})
+		previousKey = hexKey
+	}
+	if numLeaves > 0 {
+		v.rightBound = v.leaves[numLeaves-1].hexKey
+	}
+
+	return v, nil
+}
+
+// verifyChild checks the subtree found at the given path, referenced by the given hash
+func (v *leavesRangeVerifier) verifyChild(hash []byte, path []byte) error {
+	if v.isSubtreeBeforeRange(path) {
+		return nil
+	}
+	if v.isSubtreeAfterRange(path) {
+		v.hasMoreLeaves = true
+		return nil
+	}
+	if v.isSubtreeOnBoundary(path) {
+		return v.verifyProofNode(hash, path)
+	}
+
+	return v.verifySubtreeInsideRange(hash, path)
+}
+
+func (v *leavesRangeVerifier) isSubtreeBeforeRange(path []byte) bool {
+	return len(v.leftBound) > 0 && isSubtreeBeforeKey(path, v.leftBound)
+}
+
+func (v *leavesRangeVerifier) isSubtreeAfterRange(path []byte) bool {
+	return len(v.rightBound) > 0 && isSubtreeBeforeKey(v.rightBound, path)
+}
+
+func (v *leavesRangeVerifier) isSubtreeOnBoundary(path []byte) bool {
+	return isPathPrefixOfKey(path, v.leftBound) || isPathPrefixOfKey(path, v.rightBound)
+}
+
+func isPathPrefixOfKey(path []byte, hexKey []byte) bool {
+	return len(hexKey) > 0 && bytes.HasPrefix(hexKey, path)
+}
+
+func (v *leavesRangeVerifier) verifyProofNode(hash []byte, path []byte) error {
+	encodedNode, ok := v.proofNodes[string(hash)]
+	if !ok {
+		return fmt.Errorf("%w: missing proof node %x", ErrInvalidLeavesRange, hash)
+	}
+
+	n, err := decodeNode(encodedNode, v.marshalizer, v.hasher)
+	if err != nil {
+		return err
+	}
+	v.encodedNodes = append(v.encodedNodes, encodedNode)
+
+	switch typedNode := n.(type) {
+	case *leafNode:
+		return v.verifyBoundaryLeaf(typedNode, concatKeys(path, typedNode.Key))
+	case *extensionNode:
+		if len(typedNode.Key) == 0 {
+			return ErrInvalidNode
+		}
+
+		return v.verifyChild(typedNode.EncodedChild, concatKeys(path, typedNode.Key))
+	case *branchNode:
+		for i, childHash := range typedNode.EncodedChildren {
+			if len(childHash) == 0 {
+				continue
+			}
+
+			err = v.verifyChild(childHash, concatKeys(path, []byte{byte(i)}))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return ErrInvalidNode
+	}
+}
+
+func (v *leavesRangeVerifier) verifyBoundaryLeaf(ln *leafNode, hexKey []byte) error {
+	if !isKeyAfterStartKey(hexKey, v.leftBound) {
+		return nil
+	}
+	if len(v.rightBound) == 0 {
+		return fmt.Errorf("%w: missing leaf %x", ErrInvalidLeavesRange, hexKey)
+	}
+	if bytes.Compare(hexKey, v.rightBound) > 0 {
+		v.hasMoreLeaves = true
+		return nil
+	}
+
+	for _, leaf := range v.leaves {
+		if !bytes.Equal(leaf.hexKey, hexKey) {
+			continue
+		}
+
+		isSameLeaf := bytes.Equal(leaf.value, ln.Value) && uint32(leaf.version) == ln.Version
+		if !isSameLeaf {
+			return fmt.Errorf("%w: leaf %x differs from the proven one", ErrInvalidLeavesRange, hexKey)
+		}
+
+		leaf.verified = true
+		return nil
+	}
+
+	return fmt.Errorf("%w: missing leaf %x", ErrInvalidLeavesRange, hexKey)
+}
+
+// verifySubtreeInsideRange rebuilds the subtree found at the given path from the range leaves having the path as
+// prefix, and checks its hash against the given hash
+func (v *leavesRangeVerifier) verifySubtreeInsideRange(hash []byte, path []byte) error {
+	var root node
+	var err error
+	for _, leaf := range v.leaves {
+		if !bytes.HasPrefix(leaf.hexKey, path) {
+			continue
+		}
+
+		root, err = v.insertLeaf(root, leaf, path)
+		if err != nil {
+			return err
+		}
+		leaf.verified = true
+	}
+
+	if root == nil {
+		return fmt.Errorf("%w: missing leaves for subtree %x", ErrInvalidLeavesRange, path)
+	}
+
+	err = root.setHash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(root.getHash(), hash) {
+		return fmt.Errorf("%w: subtree %x hash mismatch", ErrInvalidLeavesRange, path)
+	}
+
+	v.encodedNodes, err = appendEncodedNodes(root, v.encodedNodes)
+
+	return err
+}
+
+func (v *leavesRangeVerifier) insertLeaf(root node, leaf *rangeLeaf, path []byte) (node, error) {
+	newData := core.TrieData{
+		Key:     leaf.hexKey[len(path):],
+		Value:   leaf.value,
+		Version: leaf.version,
+	}
+	if root == nil {
+		return newLeafNode(newData, v.marshalizer, v.hasher)
+	}
+
+	newRoot, _, err := root.insert(newData, nil)
+
+	return newRoot, err
+}
+
+// appendEncodedNodes appends the encoded nodes of the given subtree, which is kept in memory and has the hashes set
+func appendEncodedNodes(n node, encodedNodes [][]byte) ([][]byte, error) {
+	encodedNode, err := n.getEncodedNode()
+	if err != nil {
+		return nil, err
+	}
+	encodedNodes = append(encodedNodes, encodedNode)
+
+	children, err := n.getChildren(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, child := range children {
+		encodedNodes, err = appendEncodedNodes(child, encodedNodes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return encodedNodes, nil
+}
@@ -0,0 +1,269 @@
+package trie_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getVerifiedLeavesRange(t *testing.T, tr common.Trie, rootHash []byte, startKey []byte, maxBuffToSend uint64) *trie.InterceptedLeavesRange {
+	_, marshaller, hasher, _, _ := getDefaultTrieParameters()
+
+	buff, err := tr.GetSerializedLeavesRange(rootHash, startKey, maxBuffToSend)
+	require.Nil(t, err)
+
+	interceptedRange, err := trie.NewInterceptedLeavesRange(buff, marshaller, hasher)
+	require.Nil(t, err)
+	require.Nil(t, interceptedRange.CheckValidity())
+
+	return interceptedRange
+}
+
+func tamperLeavesRange(t *testing.T, buff []byte, handler func(leavesRange *trie.LeavesRange)) []byte {
+	_, marshaller, _, _, _ := getDefaultTrieParameters()
+
+	leavesRange := &trie.LeavesRange{}
+	require.Nil(t, marshaller.Unmarshal(leavesRange, buff))
+	handler(leavesRange)
+
+	tamperedBuff, err := marshaller.Marshal(leavesRange)
+	require.Nil(t, err)
+
+	return tamperedBuff
+}
+
+func TestNewInterceptedLeavesRange(t *testing.T) {
+	t.Parallel()
+
+	_, marshaller, hasher, _, _ := getDefaultTrieParameters()
+
+	t.Run("empty buffer should error", func(t *testing.T) {
+		t.Parallel()
+
+		interceptedRange, err := trie.NewInterceptedLeavesRange(nil, marshaller, hasher)
+		assert.True(t, check.IfNil(interceptedRange))
+		assert.Equal(t, trie.ErrValueTooShort, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		interceptedRange, err := trie.NewInterceptedLeavesRange([]byte("buff"), nil, hasher)
+		assert.True(t, check.IfNil(interceptedRange))
+		assert.Equal(t, trie.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		interceptedRange, err := trie.NewInterceptedLeavesRange([]byte("buff"), marshaller, nil)
+		assert.True(t, check.IfNil(interceptedRange))
+		assert.Equal(t, trie.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		buff, err := tr.GetSerializedLeavesRange(rootHash, nil, 1000)
+		require.Nil(t, err)
+
+		interceptedRange, err := trie.NewInterceptedLeavesRange(buff, marshaller, hasher)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(interceptedRange))
+		assert.Equal(t, [][]byte{rootHash}, interceptedRange.Identifiers())
+		assert.Equal(t, rootHash, interceptedRange.RootHash())
+		assert.Equal(t, len(buff), interceptedRange.SizeInBytes())
+		assert.True(t, interceptedRange.IsForCurrentShard())
+
+		_, err = interceptedRange.VerifiedNodes()
+		assert.Equal(t, trie.ErrInvalidLeavesRange, err)
+	})
+}
+
+func TestPatriciaMerkleTrie_GetSerializedLeavesRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		buff, err := tr.GetSerializedLeavesRange([]byte("missing root hash"), nil, 1000)
+		assert.NotNil(t, err)
+		assert.Nil(t, buff)
+	})
+	t.Run("all the leaves fit in a single range", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		interceptedRange := getVerifiedLeavesRange(t, tr, rootHash, nil, 1000)
+		assert.Equal(t, 3, len(interceptedRange.Keys()))
+		assert.False(t, interceptedRange.HasMoreLeaves())
+
+		verifiedNodes, err := interceptedRange.VerifiedNodes()
+		assert.Nil(t, err)
+		allHashes, _ := tr.GetAllHashes()
+		assert.Equal(t, len(allHashes), len(verifiedNodes))
+	})
+	t.Run("a range holds at least one leaf", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		interceptedRange := getVerifiedLeavesRange(t, tr, rootHash, nil, 1)
+		assert.Equal(t, 1, len(interceptedRange.Keys()))
+		assert.True(t, interceptedRange.HasMoreLeaves())
+	})
+	t.Run("consecutive ranges should hold all the leaves", func(t *testing.T) {
+		t.Parallel()
+
+		numLeaves := 500
+		tr, values := initTrieMultipleValues(numLeaves)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		receivedKeys := make(map[string]struct{})
+		numRanges := 0
+		var startKey []byte
+		for {
+			interceptedRange := getVerifiedLeavesRange(t, tr, rootHash, startKey, 2000)
+			numRanges++
+
+			keys := interceptedRange.Keys()
+			for _, key := range keys {
+				_, found := receivedKeys[string(key)]
+				require.False(t, found)
+				receivedKeys[string(key)] = struct{}{}
+			}
+
+			if !interceptedRange.HasMoreLeaves() {
+				break
+			}
+			startKey = keys[len(keys)-1]
+		}
+
+		assert.True(t, numRanges > 1)
+		assert.Equal(t, numLeaves, len(receivedKeys))
+		for _, value := range values {
+			_, found := receivedKeys[string(value)]
+			assert.True(t, found)
+		}
+	})
+	t.Run("no leaves after the start key", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		interceptedRange := getVerifiedLeavesRange(t, tr, rootHash, nil, 1000)
+		keys := interceptedRange.Keys()
+
+		interceptedRange = getVerifiedLeavesRange(t, tr, rootHash, keys[len(keys)-1], 1000)
+		assert.Equal(t, 0, len(interceptedRange.Keys()))
+		assert.False(t, interceptedRange.HasMoreLeaves())
+	})
+}
+
+func TestInterceptedLeavesRange_CheckValidity(t *testing.T) {
+	t.Parallel()
+
+	numLeaves := 200
+	tr, _ := initTrieMultipleValues(numLeaves)
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+	_, marshaller, hasher, _, _ := getDefaultTrieParameters()
+
+	firstRange := getVerifiedLeavesRange(t, tr, rootHash, nil, 1000)
+	startKey := firstRange.Keys()[len(firstRange.Keys())-1]
+	buff, err := tr.GetSerializedLeavesRange(rootHash, startKey, 1000)
+	require.Nil(t, err)
+
+	testTamperedRange := func(t *testing.T, handler func(leavesRange *trie.LeavesRange)) {
+		tamperedBuff := tamperLeavesRange(t, buff, handler)
+		interceptedRange, errNew := trie.NewInterceptedLeavesRange(tamperedBuff, marshaller, hasher)
+		require.Nil(t, errNew)
+
+		errCheck := interceptedRange.CheckValidity()
+		assert.True(t, errors.Is(errCheck, trie.ErrInvalidLeavesRange))
+	}
+
+	t.Run("changed value should error", func(t *testing.T) {
+		t.Parallel()
+
+		testTamperedRange(t, func(leavesRange *trie.LeavesRange) {
+			leavesRange.Values[1] = []byte("changed value")
+		})
+	})
+	t.Run("missing leaf inside the range should error", func(t *testing.T) {
+		t.Parallel()
+
+		testTamperedRange(t, func(leavesRange *trie.LeavesRange) {
+			leavesRange.Keys = append(leavesRange.Keys[:1], leavesRange.Keys[2:]...)
+			leavesRange.Values = append(leavesRange.Values[:1], leavesRange.Values[2:]...)
+			leavesRange.Versions = append(leavesRange.Versions[:1], leavesRange.Versions[2:]...)
+		})
+	})
+	t.Run("missing first leaf should error", func(t *testing.T) {
+		t.Parallel()
+
+		testTamperedRange(t, func(leavesRange *trie.LeavesRange) {
+			leavesRange.Keys = leavesRange.Keys[1:]
+			leavesRange.Values = leavesRange.Values[1:]
+			leavesRange.Versions = leavesRange.Versions[1:]
+		})
+	})
+	t.Run("changed start key should error", func(t *testing.T) {
+		t.Parallel()
+
+		testTamperedRange(t, func(leavesRange *trie.LeavesRange) {
+			leavesRange.StartKey = firstRange.Keys()[0]
+		})
+	})
+	t.Run("unordered leaves should error", func(t *testing.T) {
+		t.Parallel()
+
+		testTamperedRange(t, func(leavesRange *trie.LeavesRange) {
+			leavesRange.Keys[0], leavesRange.Keys[1] = leavesRange.Keys[1], leavesRange.Keys[0]
+			leavesRange.Values[0], leavesRange.Values[1] = leavesRange.Values[1], leavesRange.Values[0]
+		})
+	})
+	t.Run("missing proof should error", func(t *testing.T) {
+		t.Parallel()
+
+		testTamperedRange(t, func(leavesRange *trie.LeavesRange) {
+			leavesRange.Proof = nil
+		})
+	})
+	t.Run("length mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		testTamperedRange(t, func(leavesRange *trie.LeavesRange) {
+			leavesRange.Versions = leavesRange.Versions[1:]
+		})
+	})
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		testTamperedRange(t, func(leavesRange *trie.LeavesRange) {
+			leavesRange.RootHash = nil
+		})
+	})
+	t.Run("untampered range should work", func(t *testing.T) {
+		t.Parallel()
+
+		interceptedRange, errNew := trie.NewInterceptedLeavesRange(buff, marshaller, hasher)
+		require.Nil(t, errNew)
+		assert.Nil(t, interceptedRange.CheckValidity())
+		assert.True(t, interceptedRange.HasMoreLeaves())
+	})
+}
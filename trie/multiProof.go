@@ -0,0 +1,150 @@
+package trie
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// GetMultiProof returns the encoded nodes which prove the inclusion or the exclusion of each of the provided keys,
+// together with the value of each key. A node shared by the paths of multiple keys is added only once to the proof.
+// The value of a key missing from the trie is nil
+func (tr *patriciaMerkleTrie) GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	proof := make([][]byte, 0)
+	values := make([][]byte, len(keys))
+	if tr.root == nil {
+		return proof, values, nil
+	}
+
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	addedNodes := make(map[string]struct{})
+	for i, key := range keys {
+		values[i], proof, err = tr.addProofNodesForKey(key, proof, addedNodes)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return proof, values, nil
+}
+
+func (tr *patriciaMerkleTrie) addProofNodesForKey(key []byte, proof [][]byte, addedNodes map[string]struct{}) ([]byte, [][]byte, error) {
+	hexKey := keyBytesToHex(key)
+	currentNode := tr.root
+
+	for {
+		encodedNode, err := currentNode.getEncodedNode()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		_, isAdded := addedNodes[string(encodedNode)]
+		if !isAdded {
+			addedNodes[string(encodedNode)] = struct{}{}
+			proof = append(proof, encodedNode)
+		}
+
+		var nextNode node
+		nextNode, hexKey, err = currentNode.getNext(hexKey, tr.trieStorage)
+		if errors.Is(err, ErrNodeNotFound) {
+			// the path diverges at the current node, so the collected nodes prove that the key is missing
+			return nil, proof, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if nextNode == nil {
+			return currentNode.getValue(), proof, nil
+		}
+		currentNode = nextNode
+	}
+}
+
+// VerifyMultiProof verifies that the given multi proof proves the provided value for each key. A nil or empty
+// value means that the proof must show that the key is missing from the trie
+func (tr *patriciaMerkleTrie) VerifyMultiProof(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error) {
+	if len(keys) != len(values) {
+		return false, ErrKeysAndValuesLengthMismatch
+	}
+
+	proofNodes := make(map[string][]byte, len(proof))
+	for _, encodedNode := range proof {
+		proofNodes[string(tr.hasher.Compute(string(encodedNode)))] = encodedNode
+	}
+
+	for i, key := range keys {
+		value, isProven, err := tr.getValueFromProofNodes(rootHash, key, proofNodes)
+		if err != nil {
+			return false, err
+		}
+		if !isProven || !bytes.Equal(value, values[i]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (tr *patriciaMerkleTrie) getValueFromProofNodes(rootHash []byte, key []byte, proofNodes map[string][]byte) ([]byte, bool, error) {
+	if common.IsEmptyTrie(rootHash) {
+		return nil, true, nil
+	}
+
+	hexKey := keyBytesToHex(key)
+	wantHash := rootHash
+	for {
+		encodedNode, ok := proofNodes[string(wantHash)]
+		if !ok {
+			return nil, false, nil
+		}
+
+		n, err := decodeNode(encodedNode, tr.marshalizer, tr.hasher)
+		if err != nil {
+			return nil, false, err
+		}
+
+		switch typedNode := n.(type) {
+		case *leafNode:
+			if bytes.Equal(typedNode.Key, hexKey) {
+				return typedNode.Value, true, nil
+			}
+
+			return nil, true, nil
+		case *extensionNode:
+			if len(typedNode.Key) == 0 {
+				return nil, false, ErrInvalidNode
+			}
+			if len(hexKey) < len(typedNode.Key) || !bytes.Equal(typedNode.Key, hexKey[:len(typedNode.Key)]) {
+				return nil, true, nil
+			}
+
+			wantHash = typedNode.EncodedChild
+			hexKey = hexKey[len(typedNode.Key):]
+		case *branchNode:
+			if len(hexKey) == 0 {
+				return nil, false, ErrValueTooShort
+			}
+			childPos := hexKey[firstByte]
+			if childPosOutOfRange(childPos) || int(childPos) >= len(typedNode.EncodedChildren) {
+				return nil, false, ErrChildPosOutOfRange
+			}
+
+			wantHash = typedNode.EncodedChildren[childPos]
+			hexKey = hexKey[1:]
+			if len(wantHash) == 0 {
+				return nil, true, nil
+			}
+		default:
+			return nil, false, ErrInvalidNode
+		}
+	}
+}
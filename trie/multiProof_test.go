@@ -0,0 +1,142 @@
+package trie_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatriciaMerkleTrie_GetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty trie should prove all keys are missing", func(t *testing.T) {
+		t.Parallel()
+
+		tr := emptyTrie()
+		keys := [][]byte{[]byte("dog"), []byte("doe")}
+
+		proof, values, err := tr.GetMultiProof(keys)
+		require.Nil(t, err)
+		assert.Equal(t, 0, len(proof))
+		assert.Equal(t, [][]byte{nil, nil}, values)
+
+		ok, err := tr.VerifyMultiProof(emptyTrieHash, keys, values, proof)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+
+		ok, err = tr.VerifyMultiProof(emptyTrieHash, keys, [][]byte{[]byte("puppy"), nil}, proof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("should prove existing and missing keys", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		rootHash, _ := tr.RootHash()
+		keys := [][]byte{[]byte("doe"), []byte("dog"), []byte("ddog"), []byte("cat"), []byte("dogs")}
+
+		proof, values, err := tr.GetMultiProof(keys)
+		require.Nil(t, err)
+		expectedValues := [][]byte{[]byte("reindeer"), []byte("puppy"), []byte("cat"), nil, nil}
+		assert.Equal(t, expectedValues, values)
+
+		ok, err := tr.VerifyMultiProof(rootHash, keys, values, proof)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+	})
+	t.Run("shared nodes should be added only once", func(t *testing.T) {
+		t.Parallel()
+
+		tr, keys := initTrieMultipleValues(1000)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		provenKeys := keys[:50]
+
+		numNodesInSingleProofs := 0
+		for _, key := range provenKeys {
+			singleProof, _, err := tr.GetProof(key)
+			require.Nil(t, err)
+			numNodesInSingleProofs += len(singleProof)
+		}
+
+		proof, values, err := tr.GetMultiProof(provenKeys)
+		require.Nil(t, err)
+		assert.Less(t, len(proof), numNodesInSingleProofs)
+		assert.Equal(t, provenKeys, values)
+
+		ok, err := tr.VerifyMultiProof(rootHash, provenKeys, values, proof)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+	})
+}
+
+func TestPatriciaMerkleTrie_VerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.RootHash()
+	keys := [][]byte{[]byte("doe"), []byte("cat")}
+	proof, values, _ := tr.GetMultiProof(keys)
+
+	t.Run("keys and values length mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		ok, err := tr.VerifyMultiProof(rootHash, keys, values[:1], proof)
+		assert.Equal(t, trie.ErrKeysAndValuesLengthMismatch, err)
+		assert.False(t, ok)
+	})
+	t.Run("wrong value should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		ok, err := tr.VerifyMultiProof(rootHash, keys, [][]byte{[]byte("deer"), nil}, proof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("missing key claimed as existing should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		ok, err := tr.VerifyMultiProof(rootHash, keys, [][]byte{[]byte("reindeer"), []byte("kitten")}, proof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("existing key claimed as missing should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		ok, err := tr.VerifyMultiProof(rootHash, keys, [][]byte{nil, nil}, proof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("incomplete proof should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		ok, err := tr.VerifyMultiProof(rootHash, keys, values, proof[:len(proof)-1])
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("different root hash should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		ok, err := tr.VerifyMultiProof([]byte("different root hash"), keys, values, proof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("key not covered by the proof should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		ok, err := tr.VerifyMultiProof(rootHash, [][]byte{[]byte("ddog")}, [][]byte{[]byte("cat")}, proof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("merkle proof verifier should work", func(t *testing.T) {
+		t.Parallel()
+
+		_, marshaller, hasher, _, _ := getDefaultTrieParameters()
+		mpv, _ := trie.NewMerkleProofVerifier(marshaller, hasher)
+
+		ok, err := mpv.VerifyMultiProof(rootHash, keys, values, proof)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+	})
+}
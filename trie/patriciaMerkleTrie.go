@@ -32,6 +32,10 @@ const (
 
 const rootDepthLevel = 0
 
+// maxVisitedNodesPerLeavesPage bounds the work done for a page of leaves, as the leaves filtered by the key prefix
+// can not be skipped: the keys of the migrated data tries are hashed, so the keys having the same prefix are not adjacent
+const maxVisitedNodesPerLeavesPage = 50000
+
 type patriciaMerkleTrie struct {
 	root node
 
@@ -526,6 +530,180 @@ func (tr *patriciaMerkleTrie) GetAllLeavesOnChannel(
 	return nil
 }
 
+// GetLeavesPage returns at most maxLeaves leaves of the trie found at the given root hash, in the traversal order of
+// the trie, starting with the leaf having the given trie key. Only the leaves whose keys start with the given key
+// prefix are returned. The page also holds the trie key of the first leaf of the next page, if there is one. A page
+// might hold fewer leaves, while still having a next key, if too many trie nodes were visited for it
+func (tr *patriciaMerkleTrie) GetLeavesPage(
+	ctx context.Context,
+	rootHash []byte,
+	startKey []byte,
+	maxLeaves int,
+	keyPrefix []byte,
+	trieLeafParser common.TrieLeafParser,
+) (*common.TrieLeavesPage, error) {
+	return tr.getLeavesPage(ctx, rootHash, startKey, maxLeaves, keyPrefix, trieLeafParser, maxVisitedNodesPerLeavesPage)
+}
+
+func (tr *patriciaMerkleTrie) getLeavesPage(
+	ctx context.Context,
+	rootHash []byte,
+	startKey []byte,
+	maxLeaves int,
+	keyPrefix []byte,
+	trieLeafParser common.TrieLeafParser,
+	maxVisitedNodes int,
+) (*common.TrieLeavesPage, error) {
+	if maxLeaves <= 0 {
+		return nil, ErrInvalidMaxLeaves
+	}
+	if check.IfNil(trieLeafParser) {
+		return nil, ErrNilTrieLeafParser
+	}
+
+	newTrie, err := tr.recreate(rootHash, tr.trieStorage)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &common.TrieLeavesPage{
+		Leaves: make([]core.KeyValueHolder, 0, maxLeaves),
+	}
+	if check.IfNil(newTrie) || newTrie.root == nil {
+		return page, nil
+	}
+
+	tr.trieStorage.EnterPruningBufferingMode()
+	defer tr.trieStorage.ExitPruningBufferingMode()
+
+	it, err := NewDFSIteratorFromKey(newTrie, startKey)
+	if err != nil {
+		return nil, err
+	}
+
+	hexStartKey := startKeyToHex(startKey)
+	numVisitedNodes := 0
+	for {
+		if common.IsContextDone(ctx) {
+			return nil, core.ErrContextClosing
+		}
+
+		numVisitedNodes++
+		pageLimits := leavesPageLimits{
+			maxLeaves:             maxLeaves,
+			isVisitedNodesReached: numVisitedNodes > maxVisitedNodes,
+		}
+		isPageFull, errAdd := addLeafToPage(page, it, hexStartKey, pageLimits, keyPrefix, trieLeafParser)
+		if errAdd != nil {
+			return nil, errAdd
+		}
+		if isPageFull || !it.HasNext() {
+			return page, nil
+		}
+
+		err = it.Next()
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+type leavesPageLimits struct {
+	maxLeaves             int
+	isVisitedNodesReached bool
+}
+
+// addLeafToPage adds the current node of the iterator to the page, if it is a leaf which should be part of the page.
+// It returns true if the page is full or if the maximum number of visited nodes was reached, case in which the key of
+// the current leaf is set as the next key of the page
+func addLeafToPage(
+	page *common.TrieLeavesPage,
+	it *dfsIterator,
+	hexStartKey []byte,
+	limits leavesPageLimits,
+	keyPrefix []byte,
+	trieLeafParser common.TrieLeafParser,
+) (bool, error) {
+	ln, ok := it.currentNode.(*leafNode)
+	if !ok {
+		return false, nil
+	}
+
+	hexKey := concatKeys(it.currentKey, ln.Key)
+	if isSubtreeBeforeKey(hexKey, hexStartKey) {
+		return false, nil
+	}
+
+	kb := keyBuilder.NewKeyBuilder()
+	kb.BuildKey(hexKey)
+	trieKey, err := kb.GetKey()
+	if err != nil {
+		return false, err
+	}
+	if limits.isVisitedNodesReached {
+		page.NextKey = trieKey
+		return true, nil
+	}
+
+	version, err := ln.getVersion()
+	if err != nil {
+		return false, err
+	}
+
+	leaf, err := trieLeafParser.ParseLeaf(trieKey, ln.Value, version)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.HasPrefix(leaf.Key(), keyPrefix) {
+		return false, nil
+	}
+
+	if len(page.Leaves) == limits.maxLeaves {
+		page.NextKey = trieKey
+		return true, nil
+	}
+
+	page.Leaves = append(page.Leaves, leaf)
+	return false, nil
+}
+
+// GetLeavesDiff calls the handler for each leaf which differs between this trie and the provided new trie.
+// The subtrees having the same hash in both tries are skipped
+func (tr *patriciaMerkleTrie) GetLeavesDiff(
+	ctx context.Context,
+	newTrie common.Trie,
+	trieLeafParser common.TrieLeafParser,
+	handler func(leafDiff *common.TrieLeafDiff) error,
+) error {
+	diffIterator, err := NewDiffIterator(tr, newTrie, trieLeafParser)
+	if err != nil {
+		return err
+	}
+
+	tr.trieStorage.EnterPruningBufferingMode()
+	defer tr.trieStorage.ExitPruningBufferingMode()
+
+	newTrieStorage := newTrie.GetStorageManager()
+	newTrieStorage.EnterPruningBufferingMode()
+	defer newTrieStorage.ExitPruningBufferingMode()
+
+	return diffIterator.Iterate(ctx, handler)
+}
+
+func (tr *patriciaMerkleTrie) getRootRef() *nodeRef {
+	tr.mutOperation.RLock()
+	defer tr.mutOperation.RUnlock()
+
+	if check.IfNil(tr.root) {
+		return nil
+	}
+
+	return &nodeRef{
+		hash: getNodeHashIfNotDirty(tr.root),
+		n:    tr.root,
+	}
+}
+
 // GetAllHashes returns all the hashes from the trie
 func (tr *patriciaMerkleTrie) GetAllHashes() ([][]byte, error) {
 	tr.mutOperation.Lock()
@@ -733,6 +733,159 @@ func TestPatriciaMerkleTrie_GetAllLeavesOnChannel(t *testing.T) {
 	})
 }
 
+func TestPatriciaMerkleTrie_GetLeavesPage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max leaves", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+
+		page, err := tr.GetLeavesPage(context.Background(), []byte{}, nil, 0, nil, parsers.NewMainTrieLeafParser())
+		assert.Nil(t, page)
+		assert.Equal(t, trie.ErrInvalidMaxLeaves, err)
+	})
+	t.Run("nil trieLeafParser", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+
+		page, err := tr.GetLeavesPage(context.Background(), []byte{}, nil, 10, nil, nil)
+		assert.Nil(t, page)
+		assert.Equal(t, trie.ErrNilTrieLeafParser, err)
+	})
+	t.Run("empty trie", func(t *testing.T) {
+		t.Parallel()
+
+		tr := emptyTrie()
+
+		page, err := tr.GetLeavesPage(context.Background(), []byte{}, nil, 10, nil, parsers.NewMainTrieLeafParser())
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(page.Leaves))
+		assert.Nil(t, page.NextKey)
+	})
+	t.Run("closed context", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		page, err := tr.GetLeavesPage(ctx, rootHash, nil, 10, nil, parsers.NewMainTrieLeafParser())
+		assert.Nil(t, page)
+		assert.Equal(t, core.ErrContextClosing, err)
+	})
+	t.Run("should return all the leaves page by page", func(t *testing.T) {
+		t.Parallel()
+
+		numLeaves := 100
+		tr, values := initTrieMultipleValues(numLeaves)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		allLeavesPage, err := tr.GetLeavesPage(context.Background(), rootHash, nil, numLeaves, nil, parsers.NewMainTrieLeafParser())
+		require.Nil(t, err)
+		require.Equal(t, numLeaves, len(allLeavesPage.Leaves))
+		assert.Nil(t, allLeavesPage.NextKey)
+
+		recovered := make([][]byte, 0, numLeaves)
+		var startKey []byte
+		numPages := 0
+		for {
+			page, errGet := tr.GetLeavesPage(context.Background(), rootHash, startKey, 7, nil, parsers.NewMainTrieLeafParser())
+			require.Nil(t, errGet)
+			require.LessOrEqual(t, len(page.Leaves), 7)
+
+			for _, leaf := range page.Leaves {
+				recovered = append(recovered, leaf.Key())
+			}
+			numPages++
+
+			if len(page.NextKey) == 0 {
+				break
+			}
+			startKey = page.NextKey
+		}
+
+		assert.Equal(t, 15, numPages)
+		require.Equal(t, numLeaves, len(recovered))
+		for i, leaf := range allLeavesPage.Leaves {
+			assert.Equal(t, leaf.Key(), recovered[i])
+		}
+		for _, value := range values {
+			assert.Contains(t, recovered, value)
+		}
+	})
+	t.Run("should filter by key prefix", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		page, err := tr.GetLeavesPage(context.Background(), rootHash, nil, 1, []byte("do"), parsers.NewMainTrieLeafParser())
+		require.Nil(t, err)
+		require.Equal(t, 1, len(page.Leaves))
+		require.NotNil(t, page.NextKey)
+
+		recovered := map[string][]byte{
+			string(page.Leaves[0].Key()): page.Leaves[0].Value(),
+		}
+
+		page, err = tr.GetLeavesPage(context.Background(), rootHash, page.NextKey, 1, []byte("do"), parsers.NewMainTrieLeafParser())
+		require.Nil(t, err)
+		require.Equal(t, 1, len(page.Leaves))
+		assert.Nil(t, page.NextKey)
+		recovered[string(page.Leaves[0].Key())] = page.Leaves[0].Value()
+
+		expectedLeaves := map[string][]byte{
+			"doe": []byte("reindeer"),
+			"dog": []byte("puppy"),
+		}
+		assert.Equal(t, expectedLeaves, recovered)
+	})
+	t.Run("should stop the page when too many nodes were visited", func(t *testing.T) {
+		t.Parallel()
+
+		numLeaves := 100
+		tr, _ := initTrieMultipleValues(numLeaves)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		page, err := trie.GetLeavesPageWithMaxVisitedNodes(tr, rootHash, nil, numLeaves, []byte("missing prefix"), parsers.NewMainTrieLeafParser(), 20)
+		require.Nil(t, err)
+		assert.Equal(t, 0, len(page.Leaves))
+		assert.NotNil(t, page.NextKey)
+
+		allLeavesPage, err := tr.GetLeavesPage(context.Background(), rootHash, nil, numLeaves, nil, parsers.NewMainTrieLeafParser())
+		require.Nil(t, err)
+
+		recovered := make([][]byte, 0, numLeaves)
+		var startKey []byte
+		for {
+			page, err = trie.GetLeavesPageWithMaxVisitedNodes(tr, rootHash, startKey, numLeaves, nil, parsers.NewMainTrieLeafParser(), 20)
+			require.Nil(t, err)
+			require.Less(t, len(page.Leaves), numLeaves)
+
+			for _, leaf := range page.Leaves {
+				recovered = append(recovered, leaf.Key())
+			}
+			if len(page.NextKey) == 0 {
+				break
+			}
+			startKey = page.NextKey
+		}
+
+		require.Equal(t, numLeaves, len(recovered))
+		for i, leaf := range allLeavesPage.Leaves {
+			assert.Equal(t, leaf.Key(), recovered[i])
+		}
+	})
+}
+
 func TestPatriciaMerkleTree_Prove(t *testing.T) {
 	t.Parallel()
 
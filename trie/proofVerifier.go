@@ -31,3 +31,8 @@ func NewMerkleProofVerifier(marshalizer marshal.Marshalizer, hasher hashing.Hash
 func (mpv *merkleProofVerifier) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
 	return mpv.trie.VerifyProof(rootHash, key, proof)
 }
+
+// VerifyMultiProof verifies that the given multi proof proves the provided value for each key
+func (mpv *merkleProofVerifier) VerifyMultiProof(rootHash []byte, keys [][]byte, values [][]byte, proof [][]byte) (bool, error) {
+	return mpv.trie.VerifyMultiProof(rootHash, keys, values, proof)
+}
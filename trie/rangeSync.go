@@ -0,0 +1,227 @@
+package trie
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const maxNumLeavesRangeRequests = 3
+
+// rangeTrieSyncer syncs the trie by requesting contiguous ranges of leaves, together with their boundary proofs. The
+// trie nodes proven by each range are stored, and the trie is then healed node by node with the depth-first algorithm,
+// which requests only the nodes that are still missing
+type rangeTrieSyncer struct {
+	shardId                 uint32
+	leavesRangeTopic        string
+	waitTimeBetweenChecks   time.Duration
+	reRequestInterval       time.Duration
+	hasher                  hashing.Hasher
+	db                      common.TrieStorageInteractor
+	requestHandler          RequestHandler
+	interceptedLeavesRanges storage.Cacher
+	timeoutHandler          TimeoutHandler
+	healer                  *depthFirstTrieSyncer
+	mutOperation            sync.Mutex
+	mutStatistics           sync.RWMutex
+	duration                time.Duration
+}
+
+// NewRangeTrieSyncer creates a new instance of trieSyncer that uses leaves ranges and heals the trie with the
+// depth-first algorithm. If no leaves range topic is provided, the trie is synced only node by node
+func NewRangeTrieSyncer(arg ArgTrieSyncer) (*rangeTrieSyncer, error) {
+	err := checkArguments(arg)
+	if err != nil {
+		return nil, err
+	}
+	if len(arg.LeavesRangeTopic) > 0 && check.IfNil(arg.InterceptedLeavesRanges) {
+		return nil, data.ErrNilCacher
+	}
+
+	stsm, err := NewSyncTrieStorageManager(arg.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	healerArg := arg
+	healerArg.CheckNodesOnDisk = true
+	healer, err := NewDepthFirstTrieSyncer(healerArg)
+	if err != nil {
+		return nil, err
+	}
+	healer.checkMissingNodesOnDisk = true
+
+	return &rangeTrieSyncer{
+		shardId:                 arg.ShardId,
+		leavesRangeTopic:        arg.LeavesRangeTopic,
+		waitTimeBetweenChecks:   time.Millisecond * 100,
+		reRequestInterval:       time.Duration(deltaReRequest),
+		hasher:                  arg.Hasher,
+		db:                      stsm,
+		requestHandler:          arg.RequestHandler,
+		interceptedLeavesRanges: arg.InterceptedLeavesRanges,
+		timeoutHandler:          arg.TimeoutHandler,
+		healer:                  healer,
+	}, nil
+}
+
+// StartSyncing completes the trie, asking for leaves ranges and then for the missing trie nodes on the network. All
+// concurrent calls will be serialized
+func (r *rangeTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
+	if common.IsEmptyTrie(rootHash) {
+		return nil
+	}
+	if ctx == nil {
+		return ErrNilContext
+	}
+
+	r.mutOperation.Lock()
+	defer r.mutOperation.Unlock()
+
+	timeStart := time.Now()
+	defer func() {
+		r.setSyncDuration(time.Since(timeStart))
+	}()
+
+	if len(r.leavesRangeTopic) > 0 {
+		err := r.syncLeavesRanges(rootHash, ctx)
+		if err == core.ErrContextClosing || err == ErrTrieSyncTimeout {
+			return err
+		}
+		if err != nil {
+			log.Debug("leaves ranges sync failed, the trie will be synced node by node",
+				"root hash", rootHash, "error", err)
+		}
+	}
+
+	return r.healer.StartSyncing(rootHash, ctx)
+}
+
+func (r *rangeTrieSyncer) syncLeavesRanges(rootHash []byte, ctx context.Context) error {
+	var startKey []byte
+	for {
+		leavesRange, err := r.getLeavesRange(rootHash, startKey, ctx)
+		if err != nil {
+			return err
+		}
+
+		err = r.storeVerifiedNodes(leavesRange)
+		if err != nil {
+			return err
+		}
+
+		keys := leavesRange.Keys()
+		if !leavesRange.HasMoreLeaves() || len(keys) == 0 {
+			return nil
+		}
+
+		startKey = keys[len(keys)-1]
+	}
+}
+
+func (r *rangeTrieSyncer) getLeavesRange(rootHash []byte, startKey []byte, ctx context.Context) (*InterceptedLeavesRange, error) {
+	key := computeLeavesRangeKey(r.hasher, rootHash, startKey)
+
+	numRequests := 0
+	lastRequestTime := time.Time{}
+	for {
+		if r.timeoutHandler.IsTimeout() {
+			return nil, ErrTrieSyncTimeout
+		}
+
+		leavesRange, ok := r.getLeavesRangeFromCache(key)
+		if ok {
+			return leavesRange, nil
+		}
+
+		if time.Since(lastRequestTime) > r.reRequestInterval {
+			if numRequests >= maxNumLeavesRangeRequests {
+				return nil, ErrLeavesRangeNotReceived
+			}
+
+			r.requestHandler.RequestTrieLeavesRange(r.shardId, rootHash, startKey, r.leavesRangeTopic)
+			numRequests++
+			lastRequestTime = time.Now()
+		}
+
+		select {
+		case <-time.After(r.waitTimeBetweenChecks):
+			continue
+		case <-ctx.Done():
+			return nil, core.ErrContextClosing
+		}
+	}
+}
+
+func (r *rangeTrieSyncer) getLeavesRangeFromCache(key []byte) (*InterceptedLeavesRange, bool) {
+	val, ok := r.interceptedLeavesRanges.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	r.interceptedLeavesRanges.Remove(key)
+	leavesRange, ok := val.(*InterceptedLeavesRange)
+	if !ok {
+		return nil, false
+	}
+
+	return leavesRange, true
+}
+
+func (r *rangeTrieSyncer) storeVerifiedNodes(leavesRange *InterceptedLeavesRange) error {
+	encodedNodes, err := leavesRange.VerifiedNodes()
+	if err != nil {
+		return err
+	}
+
+	for _, encodedNode := range encodedNodes {
+		err = r.db.Put(r.hasher.Compute(string(encodedNode)), encodedNode)
+		if err != nil {
+			return err
+		}
+	}
+	r.timeoutHandler.ResetWatchdog()
+
+	return nil
+}
+
+func (r *rangeTrieSyncer) setSyncDuration(duration time.Duration) {
+	r.mutStatistics.Lock()
+	r.duration = duration
+	r.mutStatistics.Unlock()
+}
+
+// NumLeaves returns the total number of leaves for the provided trie
+func (r *rangeTrieSyncer) NumLeaves() uint64 {
+	return r.healer.NumLeaves()
+}
+
+// NumBytes returns the total number of bytes for the provided trie
+func (r *rangeTrieSyncer) NumBytes() uint64 {
+	return r.healer.NumBytes()
+}
+
+// NumTrieNodes returns the total number of trie nodes for the provided trie
+func (r *rangeTrieSyncer) NumTrieNodes() uint64 {
+	return r.healer.NumTrieNodes()
+}
+
+// Duration returns the total sync duration, including the leaves ranges sync and the healing
+func (r *rangeTrieSyncer) Duration() time.Duration {
+	r.mutStatistics.RLock()
+	defer r.mutStatistics.RUnlock()
+
+	return r.duration
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (r *rangeTrieSyncer) IsInterfaceNil() bool {
+	return r == nil
+}
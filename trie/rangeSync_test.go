@@ -0,0 +1,224 @@
+package trie
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLeavesRangeRequester(
+	completeTrie common.Trie,
+	interceptedLeavesRanges storage.Cacher,
+	maxBuffToSend uint64,
+	numRequests *uint32,
+) func(destShardID uint32, rootHash []byte, startKey []byte, topic string) {
+	return func(destShardID uint32, rootHash []byte, startKey []byte, topic string) {
+		atomic.AddUint32(numRequests, 1)
+
+		buff, err := completeTrie.GetSerializedLeavesRange(rootHash, startKey, maxBuffToSend)
+		if err != nil {
+			return
+		}
+
+		leavesRange, err := NewInterceptedLeavesRange(buff, marshalizer, hasherMock)
+		if err != nil {
+			return
+		}
+
+		err = leavesRange.CheckValidity()
+		if err != nil {
+			return
+		}
+
+		interceptedLeavesRanges.Put(leavesRange.Hash(), leavesRange, 0)
+	}
+}
+
+func createMockRangeSyncArgument(timeout time.Duration) ArgTrieSyncer {
+	arg := createMockArgument(timeout)
+	arg.LeavesRangeTopic = "leavesRangeTopic"
+	arg.InterceptedLeavesRanges = testscommon.NewCacherMock()
+
+	return arg
+}
+
+func checkSyncedTrie(t *testing.T, arg ArgTrieSyncer, rootHash []byte, numKeysValues int) {
+	tsm, _ := arg.DB.(*trieStorageManager)
+	db, _ := tsm.mainStorer.(storage.Persister)
+	tr, _ := createInMemoryTrieFromDB(db)
+	tr, _ = tr.Recreate(rootHash)
+	require.False(t, check.IfNil(tr))
+
+	for i := 0; i < numKeysValues; i++ {
+		keyVal := hasherMock.Compute(fmt.Sprintf("%d", i))
+		val, _, err := tr.Get(keyVal)
+		require.Nil(t, err)
+		require.Equal(t, keyVal, val)
+	}
+}
+
+func TestNewRangeTrieSyncer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockRangeSyncArgument(time.Minute)
+		arg.RequestHandler = nil
+
+		r, err := NewRangeTrieSyncer(arg)
+		assert.True(t, check.IfNil(r))
+		assert.Equal(t, ErrNilRequestHandler, err)
+	})
+	t.Run("nil intercepted leaves ranges should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockRangeSyncArgument(time.Minute)
+		arg.InterceptedLeavesRanges = nil
+
+		r, err := NewRangeTrieSyncer(arg)
+		assert.True(t, check.IfNil(r))
+		assert.Equal(t, data.ErrNilCacher, err)
+	})
+	t.Run("nil intercepted leaves ranges without topic should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgument(time.Minute)
+
+		r, err := NewRangeTrieSyncer(arg)
+		assert.False(t, check.IfNil(r))
+		assert.Nil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		r, err := NewRangeTrieSyncer(createMockRangeSyncArgument(time.Minute))
+		assert.False(t, check.IfNil(r))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRangeTrieSyncer_StartSyncing(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty root hash should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		r, _ := NewRangeTrieSyncer(createMockRangeSyncArgument(time.Minute))
+
+		assert.Nil(t, r.StartSyncing(nil, context.Background()))
+		assert.Nil(t, r.StartSyncing(common.EmptyTrieHash, context.Background()))
+	})
+	t.Run("nil context should error", func(t *testing.T) {
+		t.Parallel()
+
+		r, _ := NewRangeTrieSyncer(createMockRangeSyncArgument(time.Minute))
+		err := r.StartSyncing([]byte("root hash"), nil)
+
+		assert.Equal(t, ErrNilContext, err)
+	})
+	t.Run("no leaves range received should time out", func(t *testing.T) {
+		t.Parallel()
+
+		trSource, _ := createInMemoryTrie()
+		addDataToTrie(10, trSource)
+		_ = trSource.Commit()
+		rootHash, _ := trSource.RootHash()
+
+		r, _ := NewRangeTrieSyncer(createMockRangeSyncArgument(time.Second))
+		err := r.StartSyncing(rootHash, context.Background())
+
+		assert.Equal(t, ErrTrieSyncTimeout, err)
+	})
+	t.Run("closed context should error", func(t *testing.T) {
+		t.Parallel()
+
+		trSource, _ := createInMemoryTrie()
+		addDataToTrie(10, trSource)
+		_ = trSource.Commit()
+		rootHash, _ := trSource.RootHash()
+
+		r, _ := NewRangeTrieSyncer(createMockRangeSyncArgument(time.Minute))
+		ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second)
+		defer cancelFunc()
+
+		err := r.StartSyncing(rootHash, ctx)
+		assert.Equal(t, core.ErrContextClosing, err)
+	})
+	t.Run("leaves ranges should sync the whole trie", func(t *testing.T) {
+		t.Parallel()
+
+		numKeysValues := 200
+		trSource, _ := createInMemoryTrie()
+		addDataToTrie(numKeysValues, trSource)
+		_ = trSource.Commit()
+		rootHash, _ := trSource.RootHash()
+
+		numRangeRequests := uint32(0)
+		numNodesRequests := uint32(0)
+		arg := createMockRangeSyncArgument(time.Minute)
+		arg.LeavesChan = make(chan core.KeyValueHolder, numKeysValues)
+		arg.RequestHandler = &testscommon.RequestHandlerStub{
+			RequestTrieLeavesRangeCalled: createLeavesRangeRequester(trSource, arg.InterceptedLeavesRanges, 2000, &numRangeRequests),
+			RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+				atomic.AddUint32(&numNodesRequests, 1)
+			},
+		}
+
+		r, _ := NewRangeTrieSyncer(arg)
+		ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancelFunc()
+
+		err := r.StartSyncing(rootHash, ctx)
+		require.Nil(t, err)
+
+		checkSyncedTrie(t, arg, rootHash, numKeysValues)
+		assert.True(t, atomic.LoadUint32(&numRangeRequests) > 1)
+		assert.Equal(t, uint32(0), atomic.LoadUint32(&numNodesRequests))
+		assert.Equal(t, uint64(numKeysValues), r.NumLeaves())
+		assert.True(t, r.NumTrieNodes() > r.NumLeaves())
+		assert.True(t, r.Duration() > 0)
+	})
+	t.Run("missing leaves ranges should be healed node by node", func(t *testing.T) {
+		t.Parallel()
+
+		numKeysValues := 100
+		trSource, _ := createInMemoryTrie()
+		addDataToTrie(numKeysValues, trSource)
+		_ = trSource.Commit()
+		rootHash, _ := trSource.RootHash()
+
+		numRangeRequests := uint32(0)
+		arg := createMockRangeSyncArgument(time.Minute)
+		arg.LeavesChan = make(chan core.KeyValueHolder, numKeysValues)
+		requestHandler := createRequesterResolver(trSource, arg.InterceptedNodes, nil).(*testscommon.RequestHandlerStub)
+		requestHandler.RequestTrieLeavesRangeCalled = func(destShardID uint32, rootHash []byte, startKey []byte, topic string) {
+			atomic.AddUint32(&numRangeRequests, 1)
+		}
+		arg.RequestHandler = requestHandler
+
+		r, _ := NewRangeTrieSyncer(arg)
+		r.waitTimeBetweenChecks = time.Millisecond
+		r.reRequestInterval = time.Millisecond * 10
+		ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*30)
+		defer cancelFunc()
+
+		err := r.StartSyncing(rootHash, ctx)
+		require.Nil(t, err)
+
+		checkSyncedTrie(t, arg, rootHash, numKeysValues)
+		assert.Equal(t, uint32(maxNumLeavesRangeRequests), atomic.LoadUint32(&numRangeRequests))
+		assert.Equal(t, uint64(numKeysValues), r.NumLeaves())
+	})
+}
@@ -19,6 +19,7 @@ type trieStatistics struct {
 	extensionNodes *nodesStatistics
 	leafNodes      *nodesStatistics
 	migrationStats map[core.TrieNodeVersion]uint64
+	nodesPerDepth  map[uint32]uint64
 
 	mutex sync.RWMutex
 }
@@ -47,6 +48,7 @@ func NewTrieStatistics() *trieStatistics {
 			numNodes:  0,
 		},
 		migrationStats: make(map[core.TrieNodeVersion]uint64),
+		nodesPerDepth:  make(map[uint32]uint64),
 	}
 }
 
@@ -87,6 +89,7 @@ func (ts *trieStatistics) AddAccountInfo(address string, rootHash []byte) {
 func (ts *trieStatistics) collectNodeStatistics(level int, size uint64, nodeStats *nodesStatistics) {
 	nodeStats.numNodes++
 	nodeStats.nodesSize += size
+	ts.nodesPerDepth[uint32(level)]++
 
 	if uint32(level) > ts.maxTrieDepth {
 		ts.maxTrieDepth = uint32(level)
@@ -186,6 +189,35 @@ func (ts *trieStatistics) GetLeavesMigrationStats() map[core.TrieNodeVersion]uin
 	return migrationStatsMap
 }
 
+// GetNodesPerDepth will return the number of nodes found on each depth level
+func (ts *trieStatistics) GetNodesPerDepth() map[uint32]uint64 {
+	ts.mutex.RLock()
+	defer ts.mutex.RUnlock()
+
+	nodesPerDepthMap := make(map[uint32]uint64)
+	for depth, numNodes := range ts.nodesPerDepth {
+		nodesPerDepthMap[depth] = numNodes
+	}
+
+	return nodesPerDepthMap
+}
+
+// GetAddress will return the address of the account which holds the trie
+func (ts *trieStatistics) GetAddress() string {
+	ts.mutex.RLock()
+	defer ts.mutex.RUnlock()
+
+	return ts.address
+}
+
+// GetRootHash will return the root hash of the trie
+func (ts *trieStatistics) GetRootHash() []byte {
+	ts.mutex.RLock()
+	defer ts.mutex.RUnlock()
+
+	return ts.rootHash
+}
+
 // MergeTriesStatistics will merge the given statistics with the current statistics
 func (ts *trieStatistics) MergeTriesStatistics(statsToBeMerged common.TrieStatisticsHandler) {
 	ts.mutex.Lock()
@@ -207,6 +239,10 @@ func (ts *trieStatistics) MergeTriesStatistics(statsToBeMerged common.TrieStatis
 	for version, numLeaves := range statsToBeMerged.GetLeavesMigrationStats() {
 		ts.migrationStats[version] += numLeaves
 	}
+
+	for depth, numNodes := range statsToBeMerged.GetNodesPerDepth() {
+		ts.nodesPerDepth[depth] += numNodes
+	}
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
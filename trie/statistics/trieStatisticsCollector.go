@@ -1,6 +1,7 @@
 package statistics
 
 import (
+	"encoding/hex"
 	"fmt"
 	"sort"
 	"strconv"
@@ -18,10 +19,13 @@ var log = logger.GetOrCreate("trieStatistics")
 const numTriesToPrint = 10
 
 type trieStatisticsCollector struct {
-	trieStatsByType map[common.TrieType]common.TrieStatisticsHandler
-	triesBySize     []common.TrieStatisticsHandler
-	triesByDepth    []common.TrieStatisticsHandler
-	numTriesByType  map[common.TrieType]uint64
+	trieStatsByType         map[common.TrieType]common.TrieStatisticsHandler
+	triesBySize             []common.TrieStatisticsHandler
+	triesByDepth            []common.TrieStatisticsHandler
+	dataTriesBySize         []common.TrieStatisticsHandler
+	numTriesByType          map[common.TrieType]uint64
+	numMigratedDataTries    uint64
+	numNotMigratedDataTries uint64
 
 	mutex sync.RWMutex
 }
@@ -32,6 +36,7 @@ func NewTrieStatisticsCollector() *trieStatisticsCollector {
 		trieStatsByType: make(map[common.TrieType]common.TrieStatisticsHandler),
 		triesBySize:     make([]common.TrieStatisticsHandler, numTriesToPrint),
 		triesByDepth:    make([]common.TrieStatisticsHandler, numTriesToPrint),
+		dataTriesBySize: make([]common.TrieStatisticsHandler, numTriesToPrint),
 		numTriesByType:  make(map[common.TrieType]uint64),
 	}
 }
@@ -56,6 +61,20 @@ func (tsc *trieStatisticsCollector) Add(trieStats common.TrieStatisticsHandler,
 
 	insertInSortedArray(tsc.triesBySize, trieStats, isLessSize)
 	insertInSortedArray(tsc.triesByDepth, trieStats, isLessDeep)
+
+	if trieType == common.DataTrie {
+		insertInSortedArray(tsc.dataTriesBySize, trieStats, isLessSize)
+		tsc.addDataTrieVersion(trieStats)
+	}
+}
+
+func (tsc *trieStatisticsCollector) addDataTrieVersion(trieStats common.TrieStatisticsHandler) {
+	if trieStats.GetLeavesMigrationStats()[core.NotSpecified] == 0 {
+		tsc.numMigratedDataTries++
+		return
+	}
+
+	tsc.numNotMigratedDataTries++
 }
 
 // Print will print all the collected statistics
@@ -155,6 +174,87 @@ func (tsc *trieStatisticsCollector) GetNumNodes() uint64 {
 	return totalNumNodes
 }
 
+// GetNumTries returns the number of tries added so far
+func (tsc *trieStatisticsCollector) GetNumTries() uint64 {
+	tsc.mutex.RLock()
+	defer tsc.mutex.RUnlock()
+
+	numTries := uint64(0)
+	for _, numTriesOfType := range tsc.numTriesByType {
+		numTries += numTriesOfType
+	}
+
+	return numTries
+}
+
+// GetReport returns all the collected statistics
+func (tsc *trieStatisticsCollector) GetReport() *common.TriesStatisticsReport {
+	tsc.mutex.RLock()
+	defer tsc.mutex.RUnlock()
+
+	report := &common.TriesStatisticsReport{
+		NumTriesByType:   make(map[common.TrieType]uint64),
+		StatsByType:      make(map[common.TrieType]*common.TrieTypeStatistics),
+		LargestDataTries: make([]*common.TrieSummary, 0, numTriesToPrint),
+		DataTriesVersion: &common.DataTriesVersionStatistics{
+			NumMigrated:    tsc.numMigratedDataTries,
+			NumNotMigrated: tsc.numNotMigratedDataTries,
+		},
+	}
+
+	for trieType, numTries := range tsc.numTriesByType {
+		report.NumTriesByType[trieType] = numTries
+	}
+
+	for trieType, stats := range tsc.trieStatsByType {
+		report.NumNodes += stats.GetTotalNumNodes()
+		report.TotalSize += stats.GetTotalNodesSize()
+		report.StatsByType[trieType] = getTrieTypeStatistics(stats)
+	}
+
+	for _, stats := range tsc.dataTriesBySize {
+		if check.IfNil(stats) {
+			continue
+		}
+
+		report.LargestDataTries = append(report.LargestDataTries, &common.TrieSummary{
+			Address:  stats.GetAddress(),
+			RootHash: hex.EncodeToString(stats.GetRootHash()),
+			NumNodes: stats.GetTotalNumNodes(),
+			Size:     stats.GetTotalNodesSize(),
+			MaxDepth: stats.GetMaxTrieDepth(),
+		})
+	}
+
+	return report
+}
+
+func getTrieTypeStatistics(stats common.TrieStatisticsHandler) *common.TrieTypeStatistics {
+	trieTypeStats := &common.TrieTypeStatistics{
+		BranchNodes: common.NodesStatistics{
+			NumNodes: stats.GetNumBranchNodes(),
+			Size:     stats.GetBranchNodesSize(),
+		},
+		ExtensionNodes: common.NodesStatistics{
+			NumNodes: stats.GetNumExtensionNodes(),
+			Size:     stats.GetExtensionNodesSize(),
+		},
+		LeafNodes: common.NodesStatistics{
+			NumNodes: stats.GetNumLeafNodes(),
+			Size:     stats.GetLeafNodesSize(),
+		},
+		MaxDepth:        stats.GetMaxTrieDepth(),
+		NodesPerDepth:   stats.GetNodesPerDepth(),
+		LeavesByVersion: make(map[string]uint64),
+	}
+
+	for version, numLeaves := range stats.GetLeavesMigrationStats() {
+		trieTypeStats.LeavesByVersion[version.String()] = numLeaves
+	}
+
+	return trieTypeStats
+}
+
 func getOrderedTries(tries []common.TrieStatisticsHandler) string {
 	triesStats := make([]string, 0)
 	for i := 0; i < len(tries); i++ {
@@ -1,13 +1,16 @@
 package statistics
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/rand"
 	"sort"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSnapshotStatistics_Add(t *testing.T) {
@@ -72,3 +75,58 @@ func TestGetNumTriesByTypeString(t *testing.T) {
 	expectedRes := fmt.Sprintf("%v: %v, %v: %v", common.DataTrie, numDataTries, common.MainTrie, numMainTries)
 	assert.Equal(t, expectedRes, numTriesByTypeString)
 }
+
+func TestTrieStatisticsCollector_GetReport(t *testing.T) {
+	t.Parallel()
+
+	tsc := NewTrieStatisticsCollector()
+
+	mainTrieStats := NewTrieStatistics()
+	mainTrieStats.AddBranchNode(0, 100)
+	mainTrieStats.AddLeafNode(1, 50, core.NotSpecified)
+	mainTrieStats.AddLeafNode(1, 50, core.NotSpecified)
+	mainTrieStats.AddAccountInfo("", []byte("mainRootHash"))
+	tsc.Add(mainTrieStats, common.MainTrie)
+
+	numDataTries := numTriesToPrint + 5
+	for i := 0; i < numDataTries; i++ {
+		dataTrieStats := NewTrieStatistics()
+		dataTrieStats.AddExtensionNode(0, 10)
+		dataTrieStats.AddLeafNode(1, uint64(i+1), core.TrieNodeVersion(i%2))
+		dataTrieStats.AddAccountInfo(fmt.Sprintf("address%d", i), []byte(fmt.Sprintf("rootHash%d", i)))
+		tsc.Add(dataTrieStats, common.DataTrie)
+	}
+
+	assert.Equal(t, uint64(numDataTries+1), tsc.GetNumTries())
+
+	report := tsc.GetReport()
+	assert.Equal(t, uint64(3+2*numDataTries), report.NumNodes)
+	assert.Equal(t, tsc.GetNumNodes(), report.NumNodes)
+	assert.Equal(t, uint64(1), report.NumTriesByType[common.MainTrie])
+	assert.Equal(t, uint64(numDataTries), report.NumTriesByType[common.DataTrie])
+
+	mainTrieReport := report.StatsByType[common.MainTrie]
+	assert.Equal(t, common.NodesStatistics{NumNodes: 1, Size: 100}, mainTrieReport.BranchNodes)
+	assert.Equal(t, common.NodesStatistics{NumNodes: 2, Size: 100}, mainTrieReport.LeafNodes)
+	assert.Equal(t, uint32(1), mainTrieReport.MaxDepth)
+	assert.Equal(t, map[uint32]uint64{0: 1, 1: 2}, mainTrieReport.NodesPerDepth)
+	assert.Equal(t, map[string]uint64{core.NotSpecifiedString: 2}, mainTrieReport.LeavesByVersion)
+
+	dataTrieReport := report.StatsByType[common.DataTrie]
+	assert.Equal(t, uint64(numDataTries), dataTrieReport.ExtensionNodes.NumNodes)
+	assert.Equal(t, uint64(numDataTries), dataTrieReport.LeafNodes.NumNodes)
+	assert.Equal(t, uint64(numDataTries/2), dataTrieReport.LeavesByVersion[core.AutoBalanceEnabledString])
+
+	assert.Equal(t, uint64(numDataTries/2), report.DataTriesVersion.NumMigrated)
+	assert.Equal(t, uint64(numDataTries-numDataTries/2), report.DataTriesVersion.NumNotMigrated)
+
+	require.Equal(t, numTriesToPrint, len(report.LargestDataTries))
+	largestDataTrie := report.LargestDataTries[0]
+	assert.Equal(t, fmt.Sprintf("address%d", numDataTries-1), largestDataTrie.Address)
+	assert.Equal(t, hex.EncodeToString([]byte(fmt.Sprintf("rootHash%d", numDataTries-1))), largestDataTrie.RootHash)
+	assert.Equal(t, uint64(2), largestDataTrie.NumNodes)
+	assert.Equal(t, uint64(10+numDataTries), largestDataTrie.Size)
+	for i := 1; i < len(report.LargestDataTries); i++ {
+		assert.True(t, report.LargestDataTries[i-1].Size >= report.LargestDataTries[i].Size)
+	}
+}
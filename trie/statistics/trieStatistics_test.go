@@ -111,6 +111,12 @@ func TestTrieStatistics_GetTrieStats(t *testing.T) {
 	assert.Equal(t, uint64(numExtensions), ts.GetNumExtensionNodes())
 	assert.Equal(t, uint64(numLeaves), ts.GetNumLeafNodes())
 	assert.Equal(t, uint64(numLeaves), ts.GetLeavesMigrationStats()[0])
+
+	nodesPerDepth := ts.GetNodesPerDepth()
+	assert.Equal(t, numBranches, len(nodesPerDepth))
+	assert.Equal(t, uint64(3), nodesPerDepth[0])
+	assert.Equal(t, uint64(2), nodesPerDepth[uint32(numExtensions)])
+	assert.Equal(t, uint64(1), nodesPerDepth[uint32(numBranches-1)])
 }
 
 func TestTrieStatistics_MergeTriesStatistics(t *testing.T) {
@@ -161,6 +167,13 @@ func TestTrieStatistics_MergeTriesStatistics(t *testing.T) {
 	assert.Equal(t, uint64(4), ts.GetNumLeafNodes())
 	assert.Equal(t, uint64(2), ts.GetLeavesMigrationStats()[0])
 	assert.Equal(t, uint64(2), ts.GetLeavesMigrationStats()[1])
+	expectedNodesPerDepth := map[uint32]uint64{
+		1: 3,
+		2: 1,
+		3: 3,
+		4: 1,
+	}
+	assert.Equal(t, expectedNodesPerDepth, ts.GetNodesPerDepth())
 
 	address := "address"
 	rootHash := []byte("rootHash")
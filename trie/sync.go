@@ -60,6 +60,8 @@ type ArgTrieSyncer struct {
 	CheckNodesOnDisk          bool
 	TimeoutHandler            TimeoutHandler
 	LeavesChan                chan core.KeyValueHolder
+	LeavesRangeTopic          string
+	InterceptedLeavesRanges   storage.Cacher
 }
 
 // NewTrieSyncer creates a new instance of trieSyncer
@@ -10,6 +10,7 @@ const (
 	initialVersion = 1
 	secondVersion  = 2
 	thirdVersion   = 3
+	fourthVersion  = 4
 )
 
 // TrieSyncer synchronizes the trie, asking on the network for the missing nodes
@@ -32,6 +33,8 @@ func CreateTrieSyncer(arg ArgTrieSyncer, trieSyncerVersion int) (TrieSyncer, err
 		return NewDoubleListTrieSyncer(arg)
 	case thirdVersion:
 		return NewDepthFirstTrieSyncer(arg)
+	case fourthVersion:
+		return NewRangeTrieSyncer(arg)
 	default:
 		return nil, fmt.Errorf("%w, unknown value %d", ErrInvalidTrieSyncerVersion, trieSyncerVersion)
 	}
@@ -39,7 +42,7 @@ func CreateTrieSyncer(arg ArgTrieSyncer, trieSyncerVersion int) (TrieSyncer, err
 
 // CheckTrieSyncerVersion can check if the syncer version has a correct value
 func CheckTrieSyncerVersion(trieSyncerVersion int) error {
-	isCorrectVersion := trieSyncerVersion >= initialVersion && trieSyncerVersion <= thirdVersion
+	isCorrectVersion := trieSyncerVersion >= initialVersion && trieSyncerVersion <= fourthVersion
 	if isCorrectVersion {
 		return nil
 	}
@@ -0,0 +1,133 @@
+package trie
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/state/accounts"
+	"github.com/multiversx/mx-chain-go/state/parsers"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+)
+
+// WalkTrieNodes calls the handler for each encoded node of the given trie, in depth first order. All the nodes are
+// loaded from the storage, so an error is returned if any of them is missing
+func WalkTrieNodes(ctx context.Context, tr common.Trie, handler func(encodedNode []byte) error) error {
+	it, err := NewDFSIterator(tr)
+	if err != nil {
+		return err
+	}
+
+	encodedNode, err := it.MarshalizedNode()
+	if err != nil {
+		return err
+	}
+
+	err = handler(encodedNode)
+	if err != nil {
+		return err
+	}
+
+	for it.HasNext() {
+		if common.IsContextDone(ctx) {
+			return core.ErrContextClosing
+		}
+
+		err = it.Next()
+		if err != nil {
+			return err
+		}
+
+		encodedNode, err = it.MarshalizedNode()
+		if err != nil {
+			return err
+		}
+
+		err = handler(encodedNode)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WalkDataTries calls the handler once for each distinct data trie found in the accounts of the main trie, returning
+// the number of data tries
+func WalkDataTries(
+	ctx context.Context,
+	mainTrie common.Trie,
+	rootHash []byte,
+	marshaller marshal.Marshalizer,
+	handler func(dataTrie common.Trie) error,
+) (uint64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	leavesChannels := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err := mainTrie.GetAllLeavesOnChannel(leavesChannels, ctx, rootHash, keyBuilder.NewKeyBuilder(), parsers.NewMainTrieLeafParser())
+	if err != nil {
+		return 0, err
+	}
+
+	dataTries := make(map[string]struct{})
+	for leaf := range leavesChannels.LeavesChan {
+		err = handleAccountLeaf(mainTrie, leaf, marshaller, dataTries, handler)
+		if err != nil {
+			cancel()
+			drainLeavesChannel(leavesChannels.LeavesChan)
+			return 0, err
+		}
+	}
+
+	err = leavesChannels.ErrChan.ReadFromChanNonBlocking()
+	if err != nil {
+		return 0, err
+	}
+	if common.IsContextDone(ctx) {
+		return 0, core.ErrContextClosing
+	}
+
+	return uint64(len(dataTries)), nil
+}
+
+func handleAccountLeaf(
+	mainTrie common.Trie,
+	leaf core.KeyValueHolder,
+	marshaller marshal.Marshalizer,
+	dataTries map[string]struct{},
+	handler func(dataTrie common.Trie) error,
+) error {
+	accountData := &accounts.UserAccountData{}
+	err := marshaller.Unmarshal(accountData, leaf.Value())
+	if err != nil {
+		log.Trace("this must be a leaf with code", "leaf key", leaf.Key(), "err", err)
+		return nil
+	}
+
+	if common.IsEmptyTrie(accountData.RootHash) {
+		return nil
+	}
+	_, isWalked := dataTries[string(accountData.RootHash)]
+	if isWalked {
+		return nil
+	}
+	dataTries[string(accountData.RootHash)] = struct{}{}
+
+	dataTrie, err := mainTrie.Recreate(accountData.RootHash)
+	if err != nil {
+		return err
+	}
+
+	return handler(dataTrie)
+}
+
+func drainLeavesChannel(leavesChan chan core.KeyValueHolder) {
+	for range leavesChan {
+	}
+}
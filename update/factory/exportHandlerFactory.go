@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"math"
 	"os"
-	"path"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -24,7 +23,6 @@ import (
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/cache"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
-	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/trie"
 	"github.com/multiversx/mx-chain-go/update"
 	"github.com/multiversx/mx-chain-go/update/genesis"
@@ -501,11 +499,11 @@ func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
 		}
 	}()
 
-	keysStorer, err = createStorer(e.exportStateKeysConfig, e.exportFolder)
+	keysStorer, err = storageFactory.CreateStorerInFolder(e.exportStateKeysConfig, e.exportFolder)
 	if err != nil {
 		return nil, fmt.Errorf("%w while creating keys storer", err)
 	}
-	keysVals, err = createStorer(e.exportStateStorageConfig, e.exportFolder)
+	keysVals, err = storageFactory.CreateStorerInFolder(e.exportStateStorageConfig, e.exportFolder)
 	if err != nil {
 		return nil, fmt.Errorf("%w while creating keys-values storer", err)
 	}
@@ -604,28 +602,6 @@ func (e *exportHandlerFactory) createInterceptors() error {
 	return nil
 }
 
-func createStorer(storageConfig config.StorageConfig, folder string) (storage.Storer, error) {
-	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
-	dbConfig.FilePath = path.Join(folder, storageConfig.DB.FilePath)
-
-	dbConfigHandler := storageFactory.NewDBConfigHandler(storageConfig.DB)
-	persisterFactory, err := storageFactory.NewPersisterFactory(dbConfigHandler)
-	if err != nil {
-		return nil, err
-	}
-
-	accountsTrieStorage, err := storageunit.NewStorageUnitFromConf(
-		storageFactory.GetCacherFromConfig(storageConfig.Cache),
-		dbConfig,
-		persisterFactory,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return accountsTrieStorage, nil
-}
-
 // IsInterfaceNil returns true if underlying object is nil
 func (e *exportHandlerFactory) IsInterfaceNil() bool {
 	return e == nil